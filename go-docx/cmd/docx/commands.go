@@ -0,0 +1,208 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/vortex/go-docx/pkg/docx"
+)
+
+// errNoOutput reports a command that modifies a document run without -o.
+var errNoOutput = errors.New("-o is required; use -o - for standard output")
+
+// save writes d to path, or to stdout for "-".
+func save(d *docx.Document, path string, stdout io.Writer) error {
+	w, closeFn, err := create(path, stdout)
+	if err != nil {
+		return err
+	}
+	if err := d.Save(w); err != nil {
+		closeFn()
+		return err
+	}
+	return closeFn()
+}
+
+func runText(args []string, stdout io.Writer) error {
+	fs := newFlagSet("text", "<file>")
+	headers := fs.Bool("headers", false, "add the text of headers and footers after the body")
+	if err := parseArgs(fs, args, 1, 1); err != nil || fs.NArg() == 0 {
+		return err
+	}
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	text, err := docx.ExtractTextFastWithOptions(f, &docx.ExtractTextOptions{HeadersFooters: *headers})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(stdout, text)
+	return err
+}
+
+func runReplace(args []string, stdout io.Writer) error {
+	fs := newFlagSet("replace", "<file>")
+	data := fs.String("data", "", "JSON file holding an object of placeholder names and values")
+	left := fs.String("left", "{{", "text opening a placeholder")
+	right := fs.String("right", "}}", "text closing a placeholder")
+	out := fs.String("o", "", "output file")
+	if err := parseArgs(fs, args, 1, 1); err != nil || fs.NArg() == 0 {
+		return err
+	}
+	if *data == "" {
+		return errors.New("-data is required")
+	}
+	if *out == "" {
+		return errNoOutput
+	}
+	blob, err := os.ReadFile(*data)
+	if err != nil {
+		return err
+	}
+	var values map[string]string
+	if err := json.Unmarshal(blob, &values); err != nil {
+		return fmt.Errorf("reading %s: %w", *data, err)
+	}
+	d, err := docx.OpenFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := d.ReplaceText(*left+name+*right, values[name]); err != nil {
+			return fmt.Errorf("replacing %s: %w", name, err)
+		}
+	}
+	return save(d, *out, stdout)
+}
+
+func runMerge(args []string, stdout io.Writer) error {
+	fs := newFlagSet("merge", "<file> <file>...")
+	continuous := fs.Bool("continuous", false, "continue the last section instead of starting a new page")
+	out := fs.String("o", "", "output file")
+	if err := parseArgs(fs, args, 2, -1); err != nil || fs.NArg() == 0 {
+		return err
+	}
+	if *out == "" {
+		return errNoOutput
+	}
+	d, err := docx.OpenFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	for _, path := range fs.Args()[1:] {
+		src, err := docx.OpenFile(path)
+		if err != nil {
+			return err
+		}
+		if err := d.AppendDocument(src, &docx.AppendOptions{NoSectionBreak: *continuous}); err != nil {
+			return fmt.Errorf("appending %s: %w", path, err)
+		}
+	}
+	return save(d, *out, stdout)
+}
+
+// coreProperty is a text core property the props command reads and sets.
+type coreProperty struct {
+	name string
+	get  func(*docx.CoreProperties) string
+	set  func(*docx.CoreProperties, string) error
+}
+
+var coreProperties = []coreProperty{
+	{"title", (*docx.CoreProperties).Title, (*docx.CoreProperties).SetTitle},
+	{"subject", (*docx.CoreProperties).Subject, (*docx.CoreProperties).SetSubject},
+	{"author", (*docx.CoreProperties).Author, (*docx.CoreProperties).SetAuthor},
+	{"keywords", (*docx.CoreProperties).Keywords, (*docx.CoreProperties).SetKeywords},
+	{"category", (*docx.CoreProperties).Category, (*docx.CoreProperties).SetCategory},
+	{"comments", (*docx.CoreProperties).Comments, (*docx.CoreProperties).SetComments},
+	{"lastModifiedBy", (*docx.CoreProperties).LastModifiedBy, (*docx.CoreProperties).SetLastModifiedBy},
+	{"contentStatus", (*docx.CoreProperties).ContentStatus, (*docx.CoreProperties).SetContentStatus},
+	{"identifier", (*docx.CoreProperties).Identifier, (*docx.CoreProperties).SetIdentifier},
+	{"language", (*docx.CoreProperties).Language, (*docx.CoreProperties).SetLanguage},
+	{"version", (*docx.CoreProperties).Version, (*docx.CoreProperties).SetVersion},
+}
+
+func runProps(args []string, stdout io.Writer) error {
+	fs := newFlagSet("props", "<file>")
+	set := keyValues{}
+	fs.Var(set, "set", "set a property, as name=value; repeatable")
+	out := fs.String("o", "", "output file, required with -set")
+	if err := parseArgs(fs, args, 1, 1); err != nil || fs.NArg() == 0 {
+		return err
+	}
+	d, err := docx.OpenFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	cp, err := d.CoreProperties()
+	if err != nil {
+		return err
+	}
+	if len(set) == 0 {
+		props := map[string]string{}
+		for _, p := range coreProperties {
+			if v := p.get(cp); v != "" {
+				props[p.name] = v
+			}
+		}
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(props)
+	}
+	if *out == "" {
+		return errNoOutput
+	}
+	for name, value := range set {
+		p := findCoreProperty(name)
+		if p == nil {
+			return fmt.Errorf("unknown property %q", name)
+		}
+		if err := p.set(cp, value); err != nil {
+			return fmt.Errorf("setting %s: %w", name, err)
+		}
+	}
+	return save(d, *out, stdout)
+}
+
+// findCoreProperty returns the core property called name, or nil.
+func findCoreProperty(name string) *coreProperty {
+	for i := range coreProperties {
+		if coreProperties[i].name == name {
+			return &coreProperties[i]
+		}
+	}
+	return nil
+}
+
+func runStrip(args []string, stdout io.Writer) error {
+	fs := newFlagSet("strip", "<file>")
+	comments := fs.Bool("comments", false, "remove comments")
+	revisions := fs.Bool("revisions", false, "accept tracked changes")
+	out := fs.String("o", "", "output file")
+	if err := parseArgs(fs, args, 1, 1); err != nil || fs.NArg() == 0 {
+		return err
+	}
+	if *out == "" {
+		return errNoOutput
+	}
+	d, err := docx.OpenFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	opts := &docx.PersonalInfoOptions{RemoveComments: *comments, AcceptRevisions: *revisions}
+	if err := d.RemovePersonalInformation(opts); err != nil {
+		return err
+	}
+	return save(d, *out, stdout)
+}
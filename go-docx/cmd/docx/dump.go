@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/vortex/go-docx/pkg/docx"
+	"gopkg.in/yaml.v3"
+)
+
+func runDump(args []string, stdout io.Writer) error {
+	fs := newFlagSet("dump", "<file>")
+	format := fs.String("format", "json", "output format, json or yaml")
+	if err := parseArgs(fs, args, 1, 1); err != nil || fs.NArg() == 0 {
+		return err
+	}
+	if *format != "json" && *format != "yaml" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	d, err := docx.OpenFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	data, err := d.MarshalStructure()
+	if err != nil {
+		return err
+	}
+	if *format == "json" {
+		var out []byte
+		if out, err = json.MarshalIndent(json.RawMessage(data), "", "  "); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(stdout, "%s\n", out)
+		return err
+	}
+	// JSON is YAML, so decoding it into a node keeps the field order and
+	// names of the JSON encoding; only its styles need resetting to
+	// print as block YAML.
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return err
+	}
+	resetStyle(&node)
+	enc := yaml.NewEncoder(stdout)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// resetStyle clears the flow and quoting styles of n and its descendants.
+func resetStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		resetStyle(c)
+	}
+}
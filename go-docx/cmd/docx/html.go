@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/vortex/go-docx/pkg/docx"
+)
+
+func runHTML(args []string, stdout io.Writer) error {
+	fs := newFlagSet("html", "<file>")
+	out := fs.String("o", "-", "output file")
+	if err := parseArgs(fs, args, 1, 1); err != nil || fs.NArg() == 0 {
+		return err
+	}
+	d, err := docx.OpenFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	w, closeFn, err := create(*out, stdout)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	if err := writeHTML(bw, d); err != nil {
+		closeFn()
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		closeFn()
+		return err
+	}
+	return closeFn()
+}
+
+// writeHTML writes the body of d as a standalone HTML page. Paragraphs
+// with an outline level become headings, and bold, italic, underline and
+// hyperlinks are kept; other formatting, pictures and list numbering are
+// not converted.
+func writeHTML(w *bufio.Writer, d *docx.Document) error {
+	title := ""
+	if cp, err := d.CoreProperties(); err == nil {
+		title = cp.Title()
+	}
+	items, err := d.IterInnerContent()
+	if err != nil {
+		return err
+	}
+	w.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	w.WriteString("<title>" + html.EscapeString(title) + "</title>\n</head>\n<body>\n")
+	if err := writeHTMLBlocks(w, items); err != nil {
+		return err
+	}
+	w.WriteString("</body>\n</html>\n")
+	return nil
+}
+
+func writeHTMLBlocks(w *bufio.Writer, items []*docx.InnerContentItem) error {
+	for _, it := range items {
+		if it.IsTable() {
+			if err := writeHTMLTable(w, it.Table()); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := writeHTMLParagraph(w, it.Paragraph()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeHTMLParagraph(w *bufio.Writer, p *docx.Paragraph) error {
+	level, err := p.OutlineLevel()
+	if err != nil {
+		return err
+	}
+	tag := "p"
+	if level > 0 {
+		tag = "h" + strconv.Itoa(min(level, 6))
+	}
+	w.WriteString("<" + tag + ">")
+	for _, item := range p.IterInnerContent() {
+		if !item.IsHyperlink() {
+			writeHTMLRun(w, item.Run())
+			continue
+		}
+		h := item.Hyperlink()
+		href := h.URL()
+		if href == "" && h.Fragment() != "" {
+			href = "#" + h.Fragment()
+		}
+		w.WriteString("<a href=\"" + html.EscapeString(href) + "\">")
+		for _, r := range h.Runs() {
+			writeHTMLRun(w, r)
+		}
+		w.WriteString("</a>")
+	}
+	w.WriteString("</" + tag + ">\n")
+	return nil
+}
+
+func writeHTMLRun(w *bufio.Writer, r *docx.Run) {
+	text := r.Text()
+	if text == "" {
+		return
+	}
+	var open, end []string
+	if v := r.Bold(); v != nil && *v {
+		open, end = append(open, "<strong>"), append([]string{"</strong>"}, end...)
+	}
+	if v := r.Italic(); v != nil && *v {
+		open, end = append(open, "<em>"), append([]string{"</em>"}, end...)
+	}
+	if u, err := r.Underline(); err == nil && u != nil && !u.IsNone() {
+		open, end = append(open, "<u>"), append([]string{"</u>"}, end...)
+	}
+	w.WriteString(strings.Join(open, ""))
+	w.WriteString(strings.ReplaceAll(html.EscapeString(text), "\n", "<br>"))
+	w.WriteString(strings.Join(end, ""))
+}
+
+func writeHTMLTable(w *bufio.Writer, t *docx.Table) error {
+	w.WriteString("<table>\n")
+	for _, row := range t.Rows().Iter() {
+		w.WriteString("<tr>")
+		for _, c := range row.Cells() {
+			if span := c.GridSpan(); span > 1 {
+				w.WriteString("<td colspan=\"" + strconv.Itoa(span) + "\">")
+			} else {
+				w.WriteString("<td>")
+			}
+			if err := writeHTMLBlocks(w, c.IterInnerContent()); err != nil {
+				return err
+			}
+			w.WriteString("</td>")
+		}
+		w.WriteString("</tr>\n")
+	}
+	w.WriteString("</table>\n")
+	return nil
+}
@@ -0,0 +1,141 @@
+// Command docx exposes common operations of the docx library on the
+// command line, for use from scripts and other languages.
+//
+// Usage:
+//
+//	docx text [-headers] <file>
+//	docx replace -data <values.json> [-left {{] [-right }}] -o <out> <file>
+//	docx merge [-continuous] -o <out> <file> <file>...
+//	docx dump [-format json|yaml] <file>
+//	docx props [-set name=value]... [-o <out>] <file>
+//	docx strip [-comments] [-revisions] -o <out> <file>
+//	docx html [-o <out>] <file>
+//
+// An output of "-" is standard output.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// command is a subcommand of the tool.
+type command struct {
+	name    string
+	summary string
+	run     func(args []string, stdout io.Writer) error
+}
+
+var commands = []command{
+	{"text", "print the text of a document", runText},
+	{"replace", "replace {{placeholders}} with values from a JSON object", runReplace},
+	{"merge", "append documents to the first one", runMerge},
+	{"dump", "print the structure of a document as JSON or YAML", runDump},
+	{"props", "print or set the core properties", runProps},
+	{"strip", "remove personal information and metadata", runStrip},
+	{"html", "convert a document to HTML", runHTML},
+}
+
+// errUsage reports wrong arguments, after the flag set printed its usage.
+var errUsage = errors.New("usage")
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintf(os.Stderr, "docx: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// run runs the subcommand named by args[0].
+func run(args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "help" {
+		usage(stderr)
+		if len(args) == 0 {
+			return errUsage
+		}
+		return nil
+	}
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(args[1:], stdout)
+		}
+	}
+	usage(stderr)
+	return fmt.Errorf("unknown command %q", args[0])
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: docx <command> [flags] <file>...\n\nCommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-8s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintf(w, "\nRun \"docx <command> -h\" for the flags of a command.\n")
+}
+
+// newFlagSet returns the flag set of a subcommand taking the operands
+// described by operands.
+func newFlagSet(name, operands string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: docx %s [flags] %s\n", name, operands)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseArgs parses the flags of fs from args and checks that at least min
+// operands follow them; max < 0 means no limit.
+func parseArgs(fs *flag.FlagSet, args []string, min, max int) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+	if n := fs.NArg(); n < min || (max >= 0 && n > max) {
+		fs.Usage()
+		return errUsage
+	}
+	return nil
+}
+
+// create opens path for writing, or returns stdout for "-". The returned
+// function closes the file.
+func create(path string, stdout io.Writer) (io.Writer, func() error, error) {
+	if path == "-" {
+		return stdout, func() error { return nil }, nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, f.Close, nil
+}
+
+// keyValues is a repeatable flag of name=value pairs.
+type keyValues map[string]string
+
+func (kv keyValues) String() string {
+	keys := make([]string, 0, len(kv))
+	for k := range kv {
+		keys = append(keys, k+"="+kv[k])
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+func (kv keyValues) Set(s string) error {
+	k, v, ok := strings.Cut(s, "=")
+	if !ok || k == "" {
+		return fmt.Errorf("%q is not name=value", s)
+	}
+	kv[k] = v
+	return nil
+}
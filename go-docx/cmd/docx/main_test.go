@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx"
+)
+
+// writeDoc saves a small document to a temporary file and returns its path.
+func writeDoc(t *testing.T, build func(d *docx.Document)) string {
+	t.Helper()
+	d, err := docx.New()
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	build(d)
+	path := filepath.Join(t.TempDir(), "in.docx")
+	if err := d.SaveFile(path); err != nil {
+		t.Fatalf("SaveFile: %v", err)
+	}
+	return path
+}
+
+func runOK(t *testing.T, args ...string) string {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	if err := run(args, &stdout, &stderr); err != nil {
+		t.Fatalf("run %v: %v\n%s", args, err, stderr.String())
+	}
+	return stdout.String()
+}
+
+func TestTextAndReplace(t *testing.T) {
+	in := writeDoc(t, func(d *docx.Document) {
+		d.AddParagraph("Dear {{name}},")
+		d.AddParagraph("Total: {{total}} & more")
+	})
+	data := filepath.Join(t.TempDir(), "values.json")
+	os.WriteFile(data, []byte(`{"name": "Ada", "total": "42"}`), 0o644)
+	out := filepath.Join(t.TempDir(), "out.docx")
+
+	runOK(t, "replace", "-data", data, "-o", out, in)
+	if got := runOK(t, "text", out); got != "Dear Ada,\nTotal: 42 & more\n" {
+		t.Errorf("text = %q", got)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a := writeDoc(t, func(d *docx.Document) { d.AddParagraph("first") })
+	b := writeDoc(t, func(d *docx.Document) { d.AddParagraph("second") })
+	out := filepath.Join(t.TempDir(), "out.docx")
+
+	runOK(t, "merge", "-continuous", "-o", out, a, b)
+	if got := runOK(t, "text", out); got != "first\nsecond\n" {
+		t.Errorf("text = %q", got)
+	}
+}
+
+func TestDump(t *testing.T) {
+	in := writeDoc(t, func(d *docx.Document) {
+		d.AddHeading("Title", 1)
+		tbl, _ := d.AddTable(1, 2)
+		c, _ := tbl.CellAt(0, 1)
+		c.SetText("cell")
+	})
+	var got docx.Structure
+	if err := json.Unmarshal([]byte(runOK(t, "dump", in)), &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(got.Sections) != 1 || len(got.Sections[0].Blocks) != 2 {
+		t.Fatalf("structure = %+v", got)
+	}
+	body := got.Sections[0].Blocks
+	if h := body[0]; h.Type != "paragraph" || h.Style != "Heading 1" || h.Text != "Title" {
+		t.Errorf("heading = %+v", h)
+	}
+	tbl := body[1]
+	if tbl.Type != "table" || len(tbl.Rows) != 1 || len(tbl.Rows[0].Cells) != 2 || tbl.Rows[0].Cells[1].Blocks[0].Text != "cell" {
+		t.Errorf("table = %+v", tbl)
+	}
+
+	yml := runOK(t, "dump", "-format", "yaml", in)
+	if !strings.Contains(yml, "style: Heading 1") {
+		t.Errorf("yaml = %s", yml)
+	}
+}
+
+func TestPropsAndStrip(t *testing.T) {
+	in := writeDoc(t, func(d *docx.Document) { d.AddParagraph("x") })
+	set := filepath.Join(t.TempDir(), "set.docx")
+	runOK(t, "props", "-set", "title=Report", "-set", "author=Ada", "-o", set, in)
+
+	var props map[string]string
+	json.Unmarshal([]byte(runOK(t, "props", set)), &props)
+	if props["title"] != "Report" || props["author"] != "Ada" {
+		t.Errorf("props = %v", props)
+	}
+
+	stripped := filepath.Join(t.TempDir(), "stripped.docx")
+	runOK(t, "strip", "-o", stripped, set)
+	props = nil
+	json.Unmarshal([]byte(runOK(t, "props", stripped)), &props)
+	if props["title"] != "Report" || props["author"] != "" {
+		t.Errorf("stripped props = %v", props)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := run([]string{"props", "-set", "colour=red", "-o", set, in}, &stdout, &stderr); err == nil {
+		t.Error("unknown property accepted")
+	}
+}
+
+func TestHTML(t *testing.T) {
+	in := writeDoc(t, func(d *docx.Document) {
+		d.AddHeading("A <b>", 2)
+		p, _ := d.AddParagraph("")
+		r, _ := p.AddRun("bold")
+		bold := true
+		r.SetBold(&bold)
+	})
+	got := runOK(t, "html", in)
+	for _, want := range []string{"<h2>A &lt;b&gt;</h2>", "<p><strong>bold</strong></p>"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("html lacks %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestUsage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if err := run([]string{"frobnicate"}, &stdout, &stderr); err == nil {
+		t.Error("unknown command accepted")
+	}
+	if err := run([]string{"replace", "-o", "x.docx"}, &stdout, &stderr); err == nil {
+		t.Error("missing operand accepted")
+	}
+	if !strings.Contains(stderr.String(), "Usage:") {
+		t.Errorf("stderr = %q", stderr.String())
+	}
+}
@@ -75,6 +75,22 @@ type elementData struct {
 	OptionalAttributes    []attrData
 	RequiredAttributes    []attrData
 	ChoiceGroups          []choiceGroupData
+	Rule                  ruleData
+}
+
+// ruleData is the compiled content model of an element, emitted as a
+// schemaRule registration for oxml.ValidateAgainstSchema.
+type ruleData struct {
+	Children      []ruleChildData
+	RequiredAttrs []string
+}
+
+type ruleChildData struct {
+	Tag        string   // XML tag
+	Min        int      // minimum occurrences
+	Max        int      // maximum occurrences; -1 means unbounded
+	Group      string   // choice group name, empty for plain children
+	Successors []string // tags that must not precede this child
 }
 
 type childData struct {
@@ -138,19 +154,25 @@ func (g *Generator) buildTemplateData() templateData {
 				Successors: ch.Successors,
 			}
 
+			rc := ruleChildData{Tag: ch.Tag, Successors: ch.Successors}
 			switch ch.Cardinality {
 			case ZeroOrOne:
 				ed.ZeroOrOneChildren = append(ed.ZeroOrOneChildren, cd)
+				rc.Min, rc.Max = 0, 1
 			case ZeroOrMore:
 				ed.ZeroOrMoreChildren = append(ed.ZeroOrMoreChildren, cd)
+				rc.Min, rc.Max = 0, -1
 			case OneAndOnlyOne:
 				ed.OneAndOnlyOneChildren = append(ed.OneAndOnlyOneChildren, cd)
+				rc.Min, rc.Max = 1, 1
 			case OneOrMore:
 				ed.OneOrMoreChildren = append(ed.OneOrMoreChildren, cd)
+				rc.Min, rc.Max = 1, -1
 			default:
 				panic(fmt.Sprintf("codegen: unhandled cardinality %q for %s.%s (should be caught by Validate)",
 					ch.Cardinality, el.Name, ch.Name))
 			}
+			ed.Rule.Children = append(ed.Rule.Children, rc)
 		}
 
 		for _, attr := range el.Attributes {
@@ -170,6 +192,7 @@ func (g *Generator) buildTemplateData() templateData {
 			if attr.Required {
 				ad.ZeroExpr = rt.ZeroExpr
 				ed.RequiredAttributes = append(ed.RequiredAttributes, ad)
+				ed.Rule.RequiredAttrs = append(ed.Rule.RequiredAttrs, attr.AttrName)
 			} else {
 				ad.DefaultExpr = rt.DefaultExpr
 				if attr.Default != nil {
@@ -192,6 +215,11 @@ func (g *Generator) buildTemplateData() templateData {
 					GroupName:  ExportName(cg.Name),
 					Successors: cg.Successors,
 				}
+				ed.Rule.Children = append(ed.Rule.Children, ruleChildData{
+					Tag: ch.Tag, Min: 0, Max: 1,
+					Group:      ExportName(cg.Name),
+					Successors: cg.Successors,
+				})
 			}
 			ed.ChoiceGroups = append(ed.ChoiceGroups, choiceGroupData{
 				GoName:     ExportName(cg.Name),
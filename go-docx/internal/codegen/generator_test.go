@@ -740,3 +740,29 @@ func collectMethodNames(f *ast.File, receiverType string) map[string]bool {
 	}
 	return names
 }
+
+// --- Schema rules ---
+
+func TestGenerate_EmitsSchemaRules(t *testing.T) {
+	t.Parallel()
+	code := generateCode(t, Schema{
+		Package: "oxml",
+		Elements: []Element{
+			{Name: "CT_PPr", Tag: "w:pPr"},
+			{
+				Name: "CT_P",
+				Tag:  "w:p",
+				Children: []Child{
+					{Name: "PPr", Tag: "w:pPr", Type: "CT_PPr", Cardinality: ZeroOrOne, Successors: []string{"w:r"}},
+					{Name: "R", Tag: "w:r", Type: "CT_R", Cardinality: OneOrMore},
+				},
+				Attributes: []Attribute{{Name: "Id", AttrName: "w:id", Type: "int", Required: true}},
+			},
+		},
+	})
+
+	assertContains(t, code, `registerSchemaRule(schemaRule{`)
+	assertContains(t, code, `{tag: "w:pPr", min: 0, max: 1, successors: []string{"w:r"}}`)
+	assertContains(t, code, `{tag: "w:r", min: 1, max: -1}`)
+	assertContains(t, code, `requiredAttrs: []string{"w:id"}`)
+}
@@ -0,0 +1,60 @@
+// Package xmlintern deduplicates the strings of a parsed etree tree.
+//
+// etree allocates a fresh string for every tag, prefix and attribute it
+// reads. In WordprocessingML most of these repeat: the same handful of
+// prefixes and tags, w:val values, style ids and the revision-save ids
+// (rsids) stamped on every paragraph and run. Interning them once after
+// parsing lets the copies be collected, which cuts the memory a large
+// document holds while open.
+package xmlintern
+
+import (
+	"github.com/beevik/etree"
+)
+
+// MaxValueLen is the length above which attribute values are left alone.
+// Longer values, such as VML paths and data URIs, rarely repeat.
+const MaxValueLen = 64
+
+// Tree interns the tags, prefixes and short attribute values of root and
+// its descendants. Text is not interned.
+func Tree(root *etree.Element) {
+	if root == nil {
+		return
+	}
+	t := table{}
+	stack := []*etree.Element{root}
+	for len(stack) > 0 {
+		el := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		el.Space = t.intern(el.Space)
+		el.Tag = t.intern(el.Tag)
+		for i := range el.Attr {
+			a := &el.Attr[i]
+			a.Space = t.intern(a.Space)
+			a.Key = t.intern(a.Key)
+			if len(a.Value) <= MaxValueLen {
+				a.Value = t.intern(a.Value)
+			}
+		}
+		for _, tok := range el.Child {
+			if child, ok := tok.(*etree.Element); ok {
+				stack = append(stack, child)
+			}
+		}
+	}
+}
+
+// table maps each string seen to its first copy.
+type table map[string]string
+
+func (t table) intern(s string) string {
+	if s == "" {
+		return ""
+	}
+	if c, ok := t[s]; ok {
+		return c
+	}
+	t[s] = s
+	return s
+}
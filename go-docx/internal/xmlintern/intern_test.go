@@ -0,0 +1,38 @@
+package xmlintern
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/beevik/etree"
+)
+
+func TestTree(t *testing.T) {
+	long := strings.Repeat("m0,0 l", 20)
+	doc := etree.NewDocument()
+	err := doc.ReadFromString(`<w:body xmlns:w="urn:w">` +
+		`<w:p w:rsidR="00AB12CD"><w:r w:rsidR="00AB12CD"><w:t>a</w:t></w:r></w:p>` +
+		`<w:p w:rsidR="00AB12CD" w:path="` + long + `"/><w:p w:path="` + long + `"/></w:body>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	Tree(doc.Root())
+
+	ps := doc.Root().SelectElements("p")
+	r := ps[0].SelectElement("r")
+	same := func(a, b string) bool { return unsafe.StringData(a) == unsafe.StringData(b) }
+	if !same(ps[0].Attr[0].Value, r.Attr[0].Value) || !same(ps[0].Attr[0].Value, ps[1].Attr[0].Value) {
+		t.Error("rsid values not shared")
+	}
+	if !same(ps[0].Tag, ps[1].Tag) || !same(ps[0].Space, r.Space) || !same(ps[0].Attr[0].Key, r.Attr[0].Key) {
+		t.Error("names not shared")
+	}
+	if same(ps[1].Attr[1].Value, ps[2].Attr[0].Value) {
+		t.Error("long value interned")
+	}
+	if got := r.SelectElement("t").Text(); got != "a" {
+		t.Errorf("text = %q", got)
+	}
+	Tree(nil)
+}
@@ -0,0 +1,269 @@
+// Package xmltok is a minimal XML tokenizer for read-only passes over part
+// XML. It returns tokens as slices of the input instead of building a tree
+// or allocating per token, which makes it several times faster than
+// encoding/xml for scans such as depth checks and text extraction. It does
+// not resolve namespaces or validate names, and decodes entities only when
+// asked to by AppendText; parts that need more are parsed with etree.
+//
+// It is not a document model. The oxml element tree stays on etree; the
+// scanner serves only passes that need no tree: the checks made when a
+// package is opened, fast text extraction and reading Word 2003 XML.
+// Callers must treat a scan error as a failure, not as the end of input.
+package xmltok
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Kind identifies the type of a Token.
+type Kind uint8
+
+const (
+	// StartElement is a start tag or an empty-element tag.
+	StartElement Kind = iota + 1
+	// EndElement is an end tag.
+	EndElement
+	// CharData is text between tags, or a CDATA section.
+	CharData
+	// Comment is an XML comment.
+	Comment
+	// ProcInst is a processing instruction, the XML declaration included.
+	ProcInst
+	// Directive is a markup declaration such as <!DOCTYPE ...>.
+	Directive
+)
+
+// Token is one token of the input. Its slices alias the input and are
+// valid as long as it is.
+type Token struct {
+	Kind Kind
+	// Name is the qualified name of an element, prefix included, for
+	// StartElement and EndElement tokens.
+	Name []byte
+	// Raw is the token as written in the input.
+	Raw []byte
+	// SelfClosing reports whether a StartElement is an empty-element tag,
+	// which has no matching EndElement.
+	SelfClosing bool
+}
+
+// Scanner splits XML into tokens.
+type Scanner struct {
+	data []byte
+	pos  int
+}
+
+// NewScanner returns a Scanner reading data.
+func NewScanner(data []byte) *Scanner {
+	return &Scanner{data: data}
+}
+
+// Offset returns the input offset of the next token.
+func (s *Scanner) Offset() int { return s.pos }
+
+// Next returns the next token. It returns io.EOF after the last token, and
+// an error for a token that is not terminated or has no name.
+func (s *Scanner) Next() (Token, error) {
+	data, start := s.data, s.pos
+	if start >= len(data) {
+		return Token{}, io.EOF
+	}
+	if data[start] != '<' {
+		end := bytes.IndexByte(data[start:], '<')
+		if end < 0 {
+			end = len(data) - start
+		}
+		s.pos = start + end
+		return Token{Kind: CharData, Raw: data[start:s.pos]}, nil
+	}
+	rest := data[start:]
+	switch {
+	case bytes.HasPrefix(rest, []byte("<!--")):
+		return s.until(Comment, 4, "-->")
+	case bytes.HasPrefix(rest, []byte("<![CDATA[")):
+		return s.until(CharData, 9, "]]>")
+	case bytes.HasPrefix(rest, []byte("<?")):
+		return s.until(ProcInst, 2, "?>")
+	case bytes.HasPrefix(rest, []byte("<!")):
+		return s.directive()
+	case bytes.HasPrefix(rest, []byte("</")):
+		name := scanName(rest[2:])
+		end := bytes.IndexByte(rest, '>')
+		if len(name) == 0 || end < 0 {
+			return Token{}, s.errorf("malformed end tag")
+		}
+		s.pos = start + end + 1
+		return Token{Kind: EndElement, Name: name, Raw: rest[:end+1]}, nil
+	}
+	name := scanName(rest[1:])
+	if len(name) == 0 {
+		return Token{}, s.errorf("malformed start tag")
+	}
+	var quote byte
+	for i := 1 + len(name); i < len(rest); i++ {
+		c := rest[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			s.pos = start + i + 1
+			return Token{Kind: StartElement, Name: name, Raw: rest[:i+1], SelfClosing: rest[i-1] == '/'}, nil
+		}
+	}
+	return Token{}, s.errorf("unterminated start tag")
+}
+
+// until returns a token of kind k that starts skip bytes into the input
+// and runs through the terminator end.
+func (s *Scanner) until(k Kind, skip int, end string) (Token, error) {
+	rest := s.data[s.pos:]
+	i := bytes.Index(rest[skip:], []byte(end))
+	if i < 0 {
+		return Token{}, s.errorf("unterminated markup")
+	}
+	n := skip + i + len(end)
+	s.pos += n
+	return Token{Kind: k, Raw: rest[:n]}, nil
+}
+
+// directive returns a markup declaration, which may hold an internal
+// subset in brackets and quoted literals containing '>'.
+func (s *Scanner) directive() (Token, error) {
+	rest := s.data[s.pos:]
+	var quote byte
+	depth := 0
+	for i := 2; i < len(rest); i++ {
+		c := rest[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case c == '>' && depth <= 0:
+			s.pos += i + 1
+			return Token{Kind: Directive, Raw: rest[:i+1]}, nil
+		}
+	}
+	return Token{}, s.errorf("unterminated directive")
+}
+
+func (s *Scanner) errorf(format string, args ...any) error {
+	return fmt.Errorf("xmltok: %s at offset %d", fmt.Sprintf(format, args...), s.pos)
+}
+
+// scanName returns the name at the start of b: the bytes up to white
+// space, '/', '>' or the end of b.
+func scanName(b []byte) []byte {
+	for i, c := range b {
+		switch c {
+		case ' ', '\t', '\r', '\n', '/', '>':
+			return b[:i]
+		}
+	}
+	return b
+}
+
+// IsDoctype reports whether t is a document type declaration.
+func (t Token) IsDoctype() bool {
+	return t.Kind == Directive && bytes.HasPrefix(bytes.TrimLeft(t.Raw[2:], " \t\r\n"), []byte("DOCTYPE"))
+}
+
+// Attr returns the raw value of the attribute with qualified name name of
+// a StartElement, entity references undecoded.
+func (t Token) Attr(name string) ([]byte, bool) {
+	if t.Kind != StartElement {
+		return nil, false
+	}
+	rest := t.Raw[1+len(t.Name):]
+	for {
+		rest = bytes.TrimLeft(rest, " \t\r\n")
+		eq := bytes.IndexByte(rest, '=')
+		if eq < 0 {
+			return nil, false
+		}
+		key := bytes.TrimRight(rest[:eq], " \t\r\n")
+		rest = bytes.TrimLeft(rest[eq+1:], " \t\r\n")
+		if len(rest) == 0 || (rest[0] != '"' && rest[0] != '\'') {
+			return nil, false
+		}
+		end := bytes.IndexByte(rest[1:], rest[0])
+		if end < 0 {
+			return nil, false
+		}
+		if string(key) == name {
+			return rest[1 : 1+end], true
+		}
+		rest = rest[end+2:]
+	}
+}
+
+// AppendText appends the text of a CharData token to dst, with entity and
+// character references decoded and CDATA markup removed. Unknown entities
+// are kept as written.
+func (t Token) AppendText(dst []byte) []byte {
+	raw := t.Raw
+	if bytes.HasPrefix(raw, []byte("<![CDATA[")) {
+		return append(dst, raw[9:len(raw)-3]...)
+	}
+	for {
+		amp := bytes.IndexByte(raw, '&')
+		if amp < 0 {
+			return append(dst, raw...)
+		}
+		dst = append(dst, raw[:amp]...)
+		raw = raw[amp:]
+		semi := bytes.IndexByte(raw, ';')
+		if semi < 0 {
+			return append(dst, raw...)
+		}
+		if r, ok := decodeEntity(raw[1:semi]); ok {
+			dst = utf8.AppendRune(dst, r)
+		} else {
+			dst = append(dst, raw[:semi+1]...)
+		}
+		raw = raw[semi+1:]
+	}
+}
+
+// decodeEntity returns the character an entity or character reference
+// name stands for, e.g. "amp" or "#x20".
+func decodeEntity(name []byte) (rune, bool) {
+	switch string(name) {
+	case "amp":
+		return '&', true
+	case "lt":
+		return '<', true
+	case "gt":
+		return '>', true
+	case "quot":
+		return '"', true
+	case "apos":
+		return '\'', true
+	}
+	if len(name) < 2 || name[0] != '#' {
+		return 0, false
+	}
+	base, digits := 10, name[1:]
+	if digits[0] == 'x' {
+		base, digits = 16, digits[1:]
+	}
+	n, err := strconv.ParseUint(string(digits), base, 32)
+	if err != nil || !utf8.ValidRune(rune(n)) {
+		return 0, false
+	}
+	return rune(n), true
+}
@@ -0,0 +1,151 @@
+package xmltok
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func scanAll(t *testing.T, input string) []Token {
+	t.Helper()
+	sc := NewScanner([]byte(input))
+	var toks []Token
+	for {
+		tok, err := sc.Next()
+		if errors.Is(err, io.EOF) {
+			return toks
+		}
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		toks = append(toks, tok)
+	}
+}
+
+func TestScanner(t *testing.T) {
+	input := `<?xml version="1.0"?>` +
+		`<w:document xmlns:w="urn:w"><!-- c > d -->` +
+		`<w:p w:attr='a>b'><w:r><w:t xml:space="preserve"> x </w:t><w:tab/></w:r></w:p>` +
+		`<![CDATA[<raw>]]></w:document>`
+	want := []struct {
+		kind Kind
+		name string
+		raw  string
+		self bool
+	}{
+		{ProcInst, "", `<?xml version="1.0"?>`, false},
+		{StartElement, "w:document", `<w:document xmlns:w="urn:w">`, false},
+		{Comment, "", `<!-- c > d -->`, false},
+		{StartElement, "w:p", `<w:p w:attr='a>b'>`, false},
+		{StartElement, "w:r", `<w:r>`, false},
+		{StartElement, "w:t", `<w:t xml:space="preserve">`, false},
+		{CharData, "", ` x `, false},
+		{EndElement, "w:t", `</w:t>`, false},
+		{StartElement, "w:tab", `<w:tab/>`, true},
+		{EndElement, "w:r", `</w:r>`, false},
+		{EndElement, "w:p", `</w:p>`, false},
+		{CharData, "", `<![CDATA[<raw>]]>`, false},
+		{EndElement, "w:document", `</w:document>`, false},
+	}
+	got := scanAll(t, input)
+	if len(got) != len(want) {
+		t.Fatalf("got %d tokens, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.Kind != w.kind || string(g.Name) != w.name || string(g.Raw) != w.raw || g.SelfClosing != w.self {
+			t.Errorf("token %d = {%d %q %q %v}, want %+v", i, g.Kind, g.Name, g.Raw, g.SelfClosing, w)
+		}
+	}
+}
+
+func TestScanner_Doctype(t *testing.T) {
+	toks := scanAll(t, `<!DOCTYPE r [<!ENTITY a "x>y">]><r/>`)
+	if len(toks) != 2 || !toks[0].IsDoctype() {
+		t.Fatalf("tokens = %+v", toks)
+	}
+	if toks[1].Kind != StartElement || !toks[1].SelfClosing {
+		t.Errorf("root = %+v", toks[1])
+	}
+}
+
+func TestScanner_Malformed(t *testing.T) {
+	for _, input := range []string{`<a`, `<a b="c>`, `<!-- x`, `</a`, `< a>`, `<![CDATA[x`} {
+		sc := NewScanner([]byte(input))
+		var err error
+		for err == nil {
+			_, err = sc.Next()
+		}
+		if errors.Is(err, io.EOF) {
+			t.Errorf("%q: no error", input)
+		}
+	}
+}
+
+// benchmarkXML is a body of n paragraphs, as in a long document part.
+func benchmarkXML(n int) []byte {
+	var b bytes.Buffer
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?><w:document xmlns:w="urn:w"><w:body>`)
+	p := `<w:p><w:pPr><w:pStyle w:val="Normal"/></w:pPr><w:r><w:rPr><w:b/></w:rPr><w:t xml:space="preserve">Lorem ipsum dolor sit amet</w:t></w:r></w:p>`
+	b.WriteString(strings.Repeat(p, n))
+	b.WriteString(`</w:body></w:document>`)
+	return b.Bytes()
+}
+
+func BenchmarkScanner(b *testing.B) {
+	data := benchmarkXML(2000)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sc := NewScanner(data)
+		for {
+			if _, err := sc.Next(); err != nil {
+				break
+			}
+		}
+	}
+}
+
+// BenchmarkEncodingXML is the same pass with encoding/xml, for comparison.
+func BenchmarkEncodingXML(b *testing.B) {
+	data := benchmarkXML(2000)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dec := xml.NewDecoder(bytes.NewReader(data))
+		for {
+			if _, err := dec.RawToken(); err != nil {
+				break
+			}
+		}
+	}
+}
+
+func TestToken_Attr(t *testing.T) {
+	toks := scanAll(t, `<w:br w:type = "page" w:clear='all' xml:lang="a&amp;b"/>`)
+	for name, want := range map[string]string{"w:type": "page", "w:clear": "all", "xml:lang": "a&amp;b"} {
+		if got, ok := toks[0].Attr(name); !ok || string(got) != want {
+			t.Errorf("Attr(%q) = %q, %v", name, got, ok)
+		}
+	}
+	if _, ok := toks[0].Attr("type"); ok {
+		t.Error("unqualified name matched")
+	}
+}
+
+func TestToken_AppendText(t *testing.T) {
+	for input, want := range map[string]string{
+		`<t>a &lt;b&gt; &amp; &quot;c&apos;</t>`: `a <b> & "c'`,
+		`<t>&#233;&#xE9;&nbsp;&#xZZ;</t>`:        `éé&nbsp;&#xZZ;`,
+		`<t><![CDATA[x &amp; y]]></t>`:           `x &amp; y`,
+		`<t>tail &amp</t>`:                       `tail &amp`,
+	} {
+		toks := scanAll(t, input)
+		if got := string(toks[1].AppendText(nil)); got != want {
+			t.Errorf("%s: text = %q, want %q", input, got, want)
+		}
+	}
+}
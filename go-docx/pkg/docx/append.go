@@ -0,0 +1,466 @@
+package docx
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// AppendOptions configures AppendDocument.
+type AppendOptions struct {
+	// NoSectionBreak continues the last section of the document with the
+	// appended content. By default the appended content starts a section
+	// of its own, laid out as in its source document.
+	NoSectionBreak bool
+
+	// SectionStart selects where the appended section starts; nil means on
+	// a new page. Ignored with NoSectionBreak.
+	SectionStart *enum.WdSectionStart
+
+	// InheritHeadersFooters drops the headers and footers of the appended
+	// sections, so they continue those of the document. By default they
+	// keep their own.
+	InheritHeadersFooters bool
+
+	// Progress, if set, is called as the blocks of src are copied
+	// ("copy"), once the styles, lists, notes and parts they use are
+	// imported ("import"), and as the blocks are added to the document
+	// ("insert").
+	Progress ProgressFunc
+}
+
+// AppendDocument appends the body of src to the end of this document.
+// src is not modified.
+//
+// Styles used by the appended content are copied unless the document
+// already has a style with the same id, in which case its definition wins,
+// as when pasting in Word. Lists keep their own numbering, and images,
+// hyperlinks, headers, footers, footnotes, endnotes and comments come
+// along. Bookmarks whose name is already taken are dropped.
+func (d *Document) AppendDocument(src *Document, opts *AppendOptions) error {
+	if opts == nil {
+		opts = &AppendOptions{}
+	}
+	srcBody, err := src.getBody()
+	if err != nil {
+		return err
+	}
+	dstBody, err := d.getBody()
+	if err != nil {
+		return err
+	}
+
+	progress := opts.Progress
+	if progress == nil {
+		progress = func(string, int, int) {}
+	}
+
+	wrapper := etree.NewElement("wrapper")
+	var srcSectPr *etree.Element
+	blocks := srcBody.element.ChildElements()
+	for i, child := range blocks {
+		if child.Space == "w" && child.Tag == "sectPr" {
+			srcSectPr = child.Copy()
+		} else {
+			wrapper.AddChild(child.Copy())
+		}
+		progress("copy", i+1, len(blocks))
+	}
+	if opts.NoSectionBreak {
+		srcSectPr = nil
+	}
+	if srcSectPr != nil {
+		wrapper.AddChild(srcSectPr)
+	}
+	if opts.InheritHeadersFooters {
+		for _, sectPr := range wrapper.FindElements(".//w:sectPr") {
+			removeHeaderFooterRefs(sectPr)
+		}
+	}
+
+	imp := newDocImporter(d, src)
+	if err := imp.importStory(wrapper, &src.part.StoryPart, &d.part.StoryPart); err != nil {
+		return fmt.Errorf("docx: appending document: %w", err)
+	}
+	progress("import", 1, 1)
+	if srcSectPr != nil {
+		wrapper.RemoveChild(srcSectPr)
+	}
+
+	bodyEl := dstBody.element
+	dstSectPr := bodyEl.SelectElement("w:sectPr")
+	if !opts.NoSectionBreak && dstSectPr != nil {
+		newSectPr := srcSectPr
+		if newSectPr == nil {
+			newSectPr = dstSectPr.Copy()
+			removeHeaderFooterRefs(newSectPr)
+		}
+		start := enum.WdSectionStartNewPage
+		if opts.SectionStart != nil {
+			start = *opts.SectionStart
+		}
+		if err := (&oxml.CT_SectPr{Element: oxml.WrapElement(newSectPr)}).SetStartType(start); err != nil {
+			return err
+		}
+		// The last section of d now ends with a paragraph of its own.
+		bodyEl.RemoveChild(dstSectPr)
+		brk := oxml.OxmlElement("w:p")
+		brk.CreateElement("w:pPr").AddChild(dstSectPr)
+		bodyEl.AddChild(brk)
+		bodyEl.AddChild(newSectPr)
+	}
+	inserted := wrapper.ChildElements()
+	for i, el := range inserted {
+		dstBody.insertBeforeSectPr(el)
+		progress("insert", i+1, len(inserted))
+	}
+	return nil
+}
+
+// removeHeaderFooterRefs removes the header and footer references of
+// sectPr.
+func removeHeaderFooterRefs(sectPr *etree.Element) {
+	for _, child := range sectPr.ChildElements() {
+		if child.Space == "w" && (child.Tag == "headerReference" || child.Tag == "footerReference") {
+			sectPr.RemoveChild(child)
+		}
+	}
+}
+
+// docImporter adapts content copied from src for use in dst: it brings
+// over the styles, lists, notes, comments and parts the content refers to
+// and renumbers ids that must be unique.
+type docImporter struct {
+	dst, src *Document
+	parts    *parts.PartImporter
+	styles   map[string]bool // style ids already handled
+	nums     map[int]int     // source numId → destination numId
+	numsKept bool            // numbering part adopted whole; ids kept
+	notes    map[string]map[string]string
+	kept     map[string]bool // relationship types of note parts adopted whole
+}
+
+func newDocImporter(dst, src *Document) *docImporter {
+	imp := &docImporter{
+		dst:    dst,
+		src:    src,
+		parts:  parts.NewPartImporter(dst.wmlPkg),
+		styles: map[string]bool{},
+		nums:   map[int]int{},
+		notes:  map[string]map[string]string{},
+		kept:   map[string]bool{},
+	}
+	imp.parts.OnCopy = imp.onCopy
+	return imp
+}
+
+// importStory adapts root, content copied from srcPart, for dstPart.
+func (imp *docImporter) importStory(root *etree.Element, srcPart, dstPart *parts.StoryPart) error {
+	renumberBookmarks(root, dstPart.Element())
+	for _, docPr := range root.FindElements(".//wp:docPr") {
+		docPr.CreateAttr("id", strconv.Itoa(dstPart.NextID()))
+	}
+	if err := imp.importStyleRefs(root); err != nil {
+		return err
+	}
+	if err := imp.importNumRefs(root); err != nil {
+		return err
+	}
+	if err := imp.importNoteRefs(root); err != nil {
+		return err
+	}
+	return imp.parts.ImportRefs(root, srcPart.Rels(), dstPart.Rels())
+}
+
+// onCopy adapts the content of parts copied whole, such as headers.
+func (imp *docImporter) onCopy(_, cp opc.Part) error {
+	xp, ok := cp.(interface{ Element() *etree.Element })
+	if !ok || xp.Element() == nil {
+		return nil
+	}
+	if err := imp.importStyleRefs(xp.Element()); err != nil {
+		return err
+	}
+	return imp.importNumRefs(xp.Element())
+}
+
+// renumberBookmarks gives the bookmarks under root ids unused in dstRoot
+// and drops those whose name dstRoot already uses.
+func renumberBookmarks(root, dstRoot *etree.Element) {
+	taken := map[string]bool{}
+	next := 0
+	if dstRoot != nil {
+		for _, bs := range dstRoot.FindElements(".//w:bookmarkStart") {
+			taken[bs.SelectAttrValue("w:name", "")] = true
+		}
+		next = oxml.NextBookmarkID(dstRoot)
+	}
+	ids := map[string]string{}
+	for _, bs := range root.FindElements(".//w:bookmarkStart") {
+		id := bs.SelectAttrValue("w:id", "")
+		if name := bs.SelectAttrValue("w:name", ""); taken[name] {
+			ids[id] = ""
+			bs.Parent().RemoveChild(bs)
+			continue
+		}
+		ids[id] = strconv.Itoa(next)
+		bs.CreateAttr("w:id", ids[id])
+		next++
+	}
+	for _, be := range root.FindElements(".//w:bookmarkEnd") {
+		newID, ok := ids[be.SelectAttrValue("w:id", "")]
+		switch {
+		case !ok:
+		case newID == "":
+			be.Parent().RemoveChild(be)
+		default:
+			be.CreateAttr("w:id", newID)
+		}
+	}
+}
+
+// importStyleRefs copies the styles referenced under root that dst lacks.
+func (imp *docImporter) importStyleRefs(root *etree.Element) error {
+	for _, el := range root.FindElements(".//*") {
+		if el.Space != "w" {
+			continue
+		}
+		switch el.Tag {
+		case "pStyle", "rStyle", "tblStyle", "numStyleLink", "styleLink":
+			if err := imp.importStyle(el.SelectAttrValue("w:val", "")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// importStyle copies the style with the given id, and the styles it is
+// based on or linked to, unless dst already has it.
+func (imp *docImporter) importStyle(id string) error {
+	if id == "" || imp.styles[id] {
+		return nil
+	}
+	imp.styles[id] = true
+	srcStyles := relatedRoot(imp.src.part, opc.RTStyles)
+	if srcStyles == nil {
+		return nil
+	}
+	style := (&oxml.CT_Styles{Element: oxml.WrapElement(srcStyles)}).GetByID(id)
+	if style == nil {
+		return nil
+	}
+	dstStyles, err := imp.dst.part.Styles()
+	if err != nil {
+		return err
+	}
+	if dstStyles.GetByID(id) != nil {
+		return nil
+	}
+	cp := style.RawElement().Copy()
+	dstStyles.RawElement().AddChild(cp)
+	for _, tag := range []string{"w:basedOn", "w:next", "w:link"} {
+		if el := cp.SelectElement(tag); el != nil {
+			if err := imp.importStyle(el.SelectAttrValue("w:val", "")); err != nil {
+				return err
+			}
+		}
+	}
+	return imp.importNumRefs(cp)
+}
+
+// importNumRefs points the list references under root to copies of their
+// source definitions in dst.
+func (imp *docImporter) importNumRefs(root *etree.Element) error {
+	for _, el := range root.FindElements(".//w:numId") {
+		v, err := strconv.Atoi(el.SelectAttrValue("w:val", ""))
+		if err != nil || v == 0 {
+			continue
+		}
+		id, err := imp.numID(v)
+		if err != nil {
+			return err
+		}
+		el.CreateAttr("w:val", strconv.Itoa(id))
+	}
+	return nil
+}
+
+// numID returns the destination numId for the source numId v.
+func (imp *docImporter) numID(v int) (int, error) {
+	if id, ok := imp.nums[v]; ok {
+		return id, nil
+	}
+	srcNP, err := imp.src.part.NumberingPart()
+	if err != nil {
+		return v, nil
+	}
+	dstNP, err := imp.dst.part.NumberingPart()
+	if err != nil && !imp.numsKept {
+		// Without lists of its own, dst adopts those of src as they are.
+		imp.numsKept = true
+		cp, err := imp.parts.ImportPart(srcNP, opc.RTNumbering)
+		if err != nil {
+			return 0, err
+		}
+		imp.dst.part.Rels().GetOrAdd(opc.RTNumbering, cp)
+	}
+	if imp.numsKept {
+		return v, nil
+	}
+
+	srcNumbering := &oxml.CT_Numbering{Element: oxml.WrapElement(srcNP.Element())}
+	num := srcNumbering.NumHavingNumId(v)
+	if num == nil {
+		return v, nil
+	}
+	absRef, err := num.AbstractNumId()
+	if err != nil {
+		return 0, err
+	}
+	absID, err := absRef.Val()
+	if err != nil {
+		return 0, err
+	}
+	abs := srcNumbering.AbstractNumElement(absID)
+	if abs == nil {
+		return v, nil
+	}
+	if err := imp.importStyleRefs(abs); err != nil {
+		return 0, err
+	}
+	dstNumbering := &oxml.CT_Numbering{Element: oxml.WrapElement(dstNP.Element())}
+	id, err := dstNumbering.ImportNum(num, abs)
+	if err != nil {
+		return 0, err
+	}
+	imp.nums[v] = id
+	return id, nil
+}
+
+// noteKinds lists the stories kept in parts of their own and referenced
+// by id from the content: notes and comments.
+var noteKinds = []struct {
+	relType string
+	item    string
+	refs    []string
+}{
+	{opc.RTFootnotes, "footnote", []string{"footnoteReference"}},
+	{opc.RTEndnotes, "endnote", []string{"endnoteReference"}},
+	{opc.RTComments, "comment", []string{"commentRangeStart", "commentRangeEnd", "commentReference"}},
+}
+
+// importNoteRefs copies the footnotes, endnotes and comments referenced
+// under root into dst, renumbering them after those dst already has.
+func (imp *docImporter) importNoteRefs(root *etree.Element) error {
+	for _, kind := range noteKinds {
+		var refs []*etree.Element
+		for _, tag := range kind.refs {
+			refs = append(refs, root.FindElements(".//w:"+tag)...)
+		}
+		if len(refs) == 0 {
+			continue
+		}
+		srcRel, err := imp.src.part.Rels().GetByRelType(kind.relType)
+		if err != nil || srcRel.TargetPart == nil {
+			continue
+		}
+		srcPart := srcRel.TargetPart
+		dstPart := relatedPart(imp.dst.part, kind.relType)
+		if dstPart == nil && !imp.kept[kind.relType] {
+			// dst has none: adopt the source part as it is.
+			imp.kept[kind.relType] = true
+			cp, err := imp.parts.ImportPart(srcPart, kind.relType)
+			if err != nil {
+				return err
+			}
+			imp.dst.part.Rels().GetOrAdd(kind.relType, cp)
+		}
+		if imp.kept[kind.relType] {
+			continue
+		}
+
+		ids := imp.notes[kind.relType]
+		if ids == nil {
+			ids = map[string]string{}
+			imp.notes[kind.relType] = ids
+		}
+		srcRoot := relatedRoot(imp.src.part, kind.relType)
+		dstRoot := relatedRoot(imp.dst.part, kind.relType)
+		if srcRoot == nil || dstRoot == nil {
+			continue
+		}
+		for _, ref := range refs {
+			old := ref.SelectAttrValue("w:id", "")
+			id, ok := ids[old]
+			if !ok {
+				item := findByID(srcRoot, kind.item, old)
+				if item == nil {
+					continue
+				}
+				cp := item.Copy()
+				id = strconv.Itoa(maxID(dstRoot, kind.item) + 1)
+				cp.CreateAttr("w:id", id)
+				if err := imp.importStyleRefs(cp); err != nil {
+					return err
+				}
+				if err := imp.importNumRefs(cp); err != nil {
+					return err
+				}
+				if err := imp.parts.ImportRefs(cp, srcPart.Rels(), dstPart.Rels()); err != nil {
+					return err
+				}
+				dstRoot.AddChild(cp)
+				ids[old] = id
+			}
+			ref.CreateAttr("w:id", id)
+		}
+	}
+	return nil
+}
+
+// relatedPart returns the part dp relates to with relType, or nil.
+func relatedPart(dp *parts.DocumentPart, relType string) opc.Part {
+	rel, err := dp.Rels().GetByRelType(relType)
+	if err != nil {
+		return nil
+	}
+	return rel.TargetPart
+}
+
+// relatedRoot returns the root element of the XML part dp relates to with
+// relType, or nil if there is none. Unlike the DocumentPart accessors it
+// never creates the part.
+func relatedRoot(dp *parts.DocumentPart, relType string) *etree.Element {
+	part, ok := relatedPart(dp, relType).(interface{ Element() *etree.Element })
+	if !ok {
+		return nil
+	}
+	return part.Element()
+}
+
+// findByID returns the w:<tag> child of root with the given w:id.
+func findByID(root *etree.Element, tag, id string) *etree.Element {
+	for _, el := range root.SelectElements("w:" + tag) {
+		if el.SelectAttrValue("w:id", "") == id {
+			return el
+		}
+	}
+	return nil
+}
+
+// maxID returns the largest w:id of the w:<tag> children of root, or 0.
+func maxID(root *etree.Element, tag string) int {
+	maxID := 0
+	for _, el := range root.SelectElements("w:" + tag) {
+		if v, err := strconv.Atoi(el.SelectAttrValue("w:id", "")); err == nil && v > maxID {
+			maxID = v
+		}
+	}
+	return maxID
+}
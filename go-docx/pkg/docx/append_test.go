@@ -0,0 +1,187 @@
+package docx
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// appendSource builds a document exercising what AppendDocument carries
+// over: a custom style, a list, a picture, a header, a comment and a
+// bookmark.
+func appendSource(t *testing.T) *Document {
+	t.Helper()
+	src := mustNewDoc(t)
+	styles, err := src.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := styles.AddStyle("Fancy Para", enum.WdStyleTypeParagraph, false); err != nil {
+		t.Fatal(err)
+	}
+	p, err := src.AddParagraph("second", StyleName("Fancy Para"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.AddBookmark("shared"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.Comments(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.AddComment(p.Runs(), "check this", "Ann", nil); err != nil {
+		t.Fatal(err)
+	}
+	item, err := src.AddParagraph("item")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pPr := item.p.RawElement().CreateElement("w:pPr")
+	pPr.CreateElement("w:numPr").CreateElement("w:numId").CreateAttr("w:val", "1")
+	if _, err := src.AddPicture(bytes.NewReader(minimalPNG()), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	hdr := src.Sections().Iter()[0].Header()
+	if err := hdr.SetIsLinkedToPrevious(false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := hdr.AddParagraph("source header"); err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+func TestDocument_AppendDocument(t *testing.T) {
+	dst := mustNewDoc(t)
+	first, err := dst.AddParagraph("first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := first.AddBookmark("shared"); err != nil {
+		t.Fatal(err)
+	}
+	src := appendSource(t)
+	srcXML := src.element.RawElement().Copy()
+
+	if err := dst.AppendDocument(src, nil); err != nil {
+		t.Fatalf("AppendDocument: %v", err)
+	}
+
+	var texts []string
+	for _, p := range mustParagraphs(t, dst) {
+		texts = append(texts, p.Text())
+	}
+	if len(texts) < 4 || texts[0] != "first" || texts[2] != "second" || texts[3] != "item" {
+		t.Errorf("paragraphs = %q", texts)
+	}
+	sections := dst.Sections().Iter()
+	if len(sections) != 2 {
+		t.Fatalf("sections = %d, want 2", len(sections))
+	}
+	if !sections[0].Header().IsLinkedToPrevious() {
+		t.Error("first section gained a header")
+	}
+	hp, err := sections[1].Header().Paragraphs()
+	if err != nil || len(hp) == 0 || hp[len(hp)-1].Text() != "source header" {
+		t.Errorf("appended section header = %v, %v", hp, err)
+	}
+
+	styles, err := dst.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !styles.Contains("Fancy Para") {
+		t.Error("style Fancy Para not copied")
+	}
+	if got := len(oxml.ParagraphBookmarkNames(mustParagraphs(t, dst)[2].p.RawElement())); got != 0 {
+		t.Errorf("duplicate bookmark kept: %d bookmarks in appended paragraph", got)
+	}
+
+	numPr := mustParagraphs(t, dst)[3].p.RawElement().FindElement(".//w:numId")
+	numID, _ := strconv.Atoi(numPr.SelectAttrValue("w:val", ""))
+	if numID == 1 || numID == 0 {
+		t.Errorf("appended list numId = %d, want a fresh id", numID)
+	}
+	np, err := dst.part.NumberingPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (&oxml.CT_Numbering{Element: oxml.WrapElement(np.Element())}).NumHavingNumId(numID) == nil {
+		t.Errorf("numbering has no num %d", numID)
+	}
+
+	comments, err := dst.Comments()
+	if err != nil || comments.Len() != 1 {
+		t.Errorf("comments = %v, %v; want 1", comments, err)
+	}
+	shapes, err := dst.InlineShapes()
+	if err != nil || shapes.Len() != 1 {
+		t.Errorf("inline shapes: %v, %v; want 1", shapes, err)
+	}
+
+	// A second copy merges into the comments part adopted by the first.
+	if err := dst.AppendDocument(src, nil); err != nil {
+		t.Fatalf("second AppendDocument: %v", err)
+	}
+	if comments, _ := dst.Comments(); comments.Len() != 2 {
+		t.Errorf("comments after second append = %d, want 2", comments.Len())
+	}
+
+	if oxml.SerializeForReading(srcXML) != oxml.SerializeForReading(src.element.RawElement()) {
+		t.Error("source document was modified")
+	}
+
+	var buf bytes.Buffer
+	if err := dst.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	reopened, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	if got := reopened.Sections().Len(); got != 3 {
+		t.Errorf("reopened sections = %d, want 3", got)
+	}
+}
+
+func TestDocument_AppendDocument_NoBreakInheritHeaders(t *testing.T) {
+	dst := mustNewDoc(t)
+	if _, err := dst.AddParagraph("first"); err != nil {
+		t.Fatal(err)
+	}
+	src := appendSource(t)
+	err := dst.AppendDocument(src, &AppendOptions{NoSectionBreak: true, InheritHeadersFooters: true})
+	if err != nil {
+		t.Fatalf("AppendDocument: %v", err)
+	}
+	sections := dst.Sections().Iter()
+	if len(sections) != 1 {
+		t.Fatalf("sections = %d, want 1", len(sections))
+	}
+	if !sections[0].Header().IsLinkedToPrevious() {
+		t.Error("source header was carried over")
+	}
+	if got := mustParagraphs(t, dst)[1].Text(); got != "second" {
+		t.Errorf("paragraph after first = %q, want second", got)
+	}
+}
+
+func TestDocument_AppendDocument_SectionStart(t *testing.T) {
+	dst := mustNewDoc(t)
+	src := mustNewDoc(t)
+	start := enum.WdSectionStartContinuous
+	if err := dst.AppendDocument(src, &AppendOptions{SectionStart: &start}); err != nil {
+		t.Fatal(err)
+	}
+	sections := dst.Sections().Iter()
+	if len(sections) != 2 {
+		t.Fatalf("sections = %d, want 2", len(sections))
+	}
+	got, err := sections[1].StartType()
+	if err != nil || got != enum.WdSectionStartContinuous {
+		t.Errorf("StartType = %v, %v; want continuous", got, err)
+	}
+}
@@ -0,0 +1,81 @@
+// Package barcode encodes QR codes and linear barcodes (Code 128 and
+// EAN-13) and renders them as images, for embedding in documents without
+// an external image service.
+//
+// An encoder returns a Code: a grid of dark and light modules, one module
+// high for linear barcodes. Code.Image draws it at any scale.
+package barcode
+
+import (
+	"image"
+	"image/color"
+)
+
+// Code is an encoded symbol: a grid of dark and light modules.
+type Code struct {
+	width, height int
+	dark          []bool
+}
+
+// newCode returns an all-light code of the given size.
+func newCode(width, height int) *Code {
+	return &Code{width: width, height: height, dark: make([]bool, width*height)}
+}
+
+// Width returns the width of the code in modules, without quiet zone.
+func (c *Code) Width() int { return c.width }
+
+// Height returns the height of the code in modules: 1 for linear codes.
+func (c *Code) Height() int { return c.height }
+
+// Dark reports whether the module at column x and row y is dark.
+func (c *Code) Dark(x, y int) bool { return c.dark[y*c.width+x] }
+
+// set makes the module at column x and row y dark or light.
+func (c *Code) set(x, y int, dark bool) { c.dark[y*c.width+x] = dark }
+
+// Image draws the code in black on white, each module xScale pixels wide
+// and yScale pixels high, inside a quiet zone of quiet light modules on
+// the left and right, and also above and below for two-dimensional codes.
+func (c *Code) Image(xScale, yScale, quiet int) *image.Paletted {
+	qx, qy := quiet, quiet
+	if c.height == 1 {
+		qy = 0
+	}
+	w := (c.width + 2*qx) * xScale
+	h := (c.height + 2*qy) * yScale
+	img := image.NewPaletted(image.Rect(0, 0, w, h), color.Palette{color.White, color.Black})
+	for y := 0; y < c.height; y++ {
+		for x := 0; x < c.width; x++ {
+			if !c.Dark(x, y) {
+				continue
+			}
+			x0, y0 := (x+qx)*xScale, (y+qy)*yScale
+			for py := y0; py < y0+yScale; py++ {
+				row := img.Pix[py*img.Stride:]
+				for px := x0; px < x0+xScale; px++ {
+					row[px] = 1
+				}
+			}
+		}
+	}
+	return img
+}
+
+// linear returns a one-module-high code from bar widths, alternating
+// dark and light starting with dark.
+func linear(widths []int) *Code {
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+	c := newCode(total, 1)
+	x := 0
+	for i, w := range widths {
+		for range w {
+			c.set(x, 0, i%2 == 0)
+			x++
+		}
+	}
+	return c
+}
@@ -0,0 +1,184 @@
+package barcode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// code128Patterns are the bar and space widths of the Code 128 symbols by
+// value: 0-102 data and control symbols, 103-105 the start symbols A, B
+// and C, and 106 the stop symbol.
+var code128Patterns = [...]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+// Code 128 symbol values.
+const (
+	code128CodeC  = 99
+	code128CodeB  = 100
+	code128StartB = 104
+	code128StartC = 105
+	code128Stop   = 106
+)
+
+// Code128 encodes value, printable ASCII, as a Code 128 barcode. Runs of
+// digits are packed two to a symbol (code set C) where that makes the
+// code shorter; other text uses code set B.
+func Code128(value string) (*Code, error) {
+	if value == "" {
+		return nil, fmt.Errorf("barcode: empty Code 128 value")
+	}
+	for i := 0; i < len(value); i++ {
+		if value[i] < ' ' || value[i] > '~' {
+			return nil, fmt.Errorf("barcode: Code 128 cannot encode %q", value[i])
+		}
+	}
+
+	var symbols []int
+	setC := false
+	for i := 0; i < len(value); {
+		digits := digitRun(value[i:])
+		// Code set C pays off for a whole value of two or more digits,
+		// or for four or more digits at either end or six in the middle.
+		useC := digits >= 2 && digits == len(value) ||
+			digits >= 4 && (i == 0 || i+digits == len(value)) ||
+			digits >= 6
+		switch {
+		case useC && !setC:
+			if len(symbols) == 0 {
+				symbols = append(symbols, code128StartC)
+			} else {
+				if digits%2 == 1 && i > 0 {
+					// Keep the odd digit in code set B.
+					symbols = append(symbols, int(value[i]-' '))
+					i++
+				}
+				symbols = append(symbols, code128CodeC)
+			}
+			setC = true
+		case setC && digits < 2:
+			symbols = append(symbols, code128CodeB)
+			setC = false
+		case len(symbols) == 0:
+			symbols = append(symbols, code128StartB)
+		}
+		if setC {
+			symbols = append(symbols, int(value[i]-'0')*10+int(value[i+1]-'0'))
+			i += 2
+		} else {
+			symbols = append(symbols, int(value[i]-' '))
+			i++
+		}
+	}
+
+	check := symbols[0]
+	for i, s := range symbols[1:] {
+		check += (i + 1) * s
+	}
+	symbols = append(symbols, check%103, code128Stop)
+
+	var widths []int
+	for _, s := range symbols {
+		for _, w := range code128Patterns[s] {
+			widths = append(widths, int(w-'0'))
+		}
+	}
+	return linear(widths), nil
+}
+
+// digitRun returns the number of ASCII digits s starts with.
+func digitRun(s string) int {
+	n := 0
+	for n < len(s) && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+	return n
+}
+
+// ean13Digits are the EAN-13 left-hand odd parity (L) digit patterns; the
+// right-hand (R) patterns are their complements and the even parity (G)
+// patterns the R patterns reversed.
+var ean13Digits = [10]string{
+	"0001101", "0011001", "0010011", "0111101", "0100011",
+	"0110001", "0101111", "0111011", "0110111", "0001011",
+}
+
+// ean13Parity gives, for each first digit, the parity of the six left-hand
+// digits.
+var ean13Parity = [10]string{
+	"LLLLLL", "LLGLGG", "LLGGLG", "LLGGGL", "LGLLGG",
+	"LGGLLG", "LGGGLL", "LGLGLG", "LGLGGL", "LGGLGL",
+}
+
+// EAN13 encodes an EAN-13 barcode from 12 digits, adding the check digit,
+// or from 13 digits, verifying it.
+func EAN13(value string) (*Code, error) {
+	if (len(value) != 12 && len(value) != 13) || digitRun(value) != len(value) {
+		return nil, fmt.Errorf("barcode: EAN-13 value %q is not 12 or 13 digits", value)
+	}
+	check := ean13Check(value[:12])
+	if len(value) == 13 && value[12] != check {
+		return nil, fmt.Errorf("barcode: EAN-13 value %q has check digit %c, want %c", value, value[12], check)
+	}
+	value = value[:12] + string(check)
+
+	var sb strings.Builder
+	sb.WriteString("101")
+	parity := ean13Parity[value[0]-'0']
+	for i := 1; i <= 6; i++ {
+		pattern := ean13Digits[value[i]-'0']
+		if parity[i-1] == 'G' {
+			pattern = reverse(complement(pattern))
+		}
+		sb.WriteString(pattern)
+	}
+	sb.WriteString("01010")
+	for i := 7; i <= 12; i++ {
+		sb.WriteString(complement(ean13Digits[value[i]-'0']))
+	}
+	sb.WriteString("101")
+
+	bits := sb.String()
+	c := newCode(len(bits), 1)
+	for x := range len(bits) {
+		c.set(x, 0, bits[x] == '1')
+	}
+	return c, nil
+}
+
+// ean13Check returns the check digit of the first 12 digits of an EAN-13.
+func ean13Check(digits string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		d := int(digits[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// complement swaps the 0s and 1s of a module pattern.
+func complement(pattern string) string {
+	return strings.Map(func(r rune) rune { return '0' + '1' - r }, pattern)
+}
+
+// reverse reverses a module pattern.
+func reverse(pattern string) string {
+	b := []byte(pattern)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
@@ -0,0 +1,115 @@
+package barcode
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// code128Symbols reads the symbol values back from a Code 128 barcode.
+func code128Symbols(t *testing.T, c *Code) []int {
+	t.Helper()
+	var widths strings.Builder
+	run := 1
+	for x := 1; x <= c.Width(); x++ {
+		if x < c.Width() && c.Dark(x, 0) == c.Dark(x-1, 0) {
+			run++
+			continue
+		}
+		widths.WriteByte(byte('0' + run))
+		run = 1
+	}
+	s := widths.String()
+	var symbols []int
+	for len(s) > 0 {
+		n := min(6, len(s))
+		if len(s) == 7 {
+			n = 7
+		}
+		i := slices.Index(code128Patterns[:], s[:n])
+		if i < 0 {
+			t.Fatalf("no Code 128 symbol with widths %s", s[:n])
+		}
+		symbols = append(symbols, i)
+		s = s[n:]
+	}
+	return symbols
+}
+
+func TestCode128(t *testing.T) {
+	for i, p := range code128Patterns {
+		sum := 0
+		for _, w := range p {
+			sum += int(w - '0')
+		}
+		if want := map[bool]int{true: 13, false: 11}[i == code128Stop]; sum != want {
+			t.Errorf("pattern %d is %d modules, want %d", i, sum, want)
+		}
+	}
+	for _, tt := range []struct {
+		value string
+		want  []int
+	}{
+		{"AB123456", []int{104, 33, 34, 99, 12, 34, 56, 26, 106}},
+		{"123456", []int{105, 12, 34, 56, 44, 106}},
+		{"Ab1", []int{104, 33, 66, 17, 11, 106}},
+		{"12345", []int{105, 12, 34, 100, 21, 54, 106}},
+	} {
+		c, err := Code128(tt.value)
+		if err != nil {
+			t.Fatalf("Code128(%q): %v", tt.value, err)
+		}
+		if got := code128Symbols(t, c); !slices.Equal(got, tt.want) {
+			t.Errorf("Code128(%q) symbols = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+	for _, bad := range []string{"", "tab\there", "é"} {
+		if _, err := Code128(bad); err == nil {
+			t.Errorf("Code128(%q): expected error", bad)
+		}
+	}
+}
+
+func TestEAN13(t *testing.T) {
+	c, err := EAN13("400638133393")
+	if err != nil {
+		t.Fatalf("EAN13: %v", err)
+	}
+	if c.Width() != 95 || c.Height() != 1 {
+		t.Errorf("EAN13 is %dx%d modules, want 95x1", c.Width(), c.Height())
+	}
+	var bits strings.Builder
+	for x := range c.Width() {
+		bits.WriteByte(map[bool]byte{true: '1', false: '0'}[c.Dark(x, 0)])
+	}
+	s := bits.String()
+	if !strings.HasPrefix(s, "101") || !strings.HasSuffix(s, "101") || s[45:50] != "01010" {
+		t.Errorf("EAN13 guards missing in %s", s)
+	}
+	// The last digit is the check digit 1, in its right-hand pattern.
+	if got := s[85:92]; got != "1100110" {
+		t.Errorf("check digit pattern = %s, want 1100110", got)
+	}
+	if _, err := EAN13("4006381333931"); err != nil {
+		t.Errorf("EAN13 with valid check digit: %v", err)
+	}
+	for _, bad := range []string{"4006381333932", "40063813339", "40063813339a"} {
+		if _, err := EAN13(bad); err == nil {
+			t.Errorf("EAN13(%q): expected error", bad)
+		}
+	}
+}
+
+func TestCode_Image(t *testing.T) {
+	c, err := EAN13("400638133393")
+	if err != nil {
+		t.Fatalf("EAN13: %v", err)
+	}
+	img := c.Image(2, 40, 11)
+	if b := img.Bounds(); b.Dx() != (95+22)*2 || b.Dy() != 40 {
+		t.Errorf("image is %dx%d, want %dx40", b.Dx(), b.Dy(), (95+22)*2)
+	}
+	if img.ColorIndexAt(0, 0) != 0 || img.ColorIndexAt(22, 39) != 1 {
+		t.Error("expected a light quiet zone and a dark start guard")
+	}
+}
@@ -0,0 +1,467 @@
+package barcode
+
+import "fmt"
+
+// ECLevel is the error correction level of a QR code: the share of the
+// symbol that can be damaged and still be read.
+type ECLevel int
+
+const (
+	ECLevelL ECLevel = iota // about 7% recoverable
+	ECLevelM                // about 15% recoverable
+	ECLevelQ                // about 25% recoverable
+	ECLevelH                // about 30% recoverable
+)
+
+// formatBits returns the two bits encoding the level in format information.
+func (l ECLevel) formatBits() int {
+	return [...]int{1, 0, 3, 2}[l]
+}
+
+// qrECCodewordsPerBlock and qrECBlocks give, by level and version, the
+// error correction codewords of each block and the number of blocks.
+var (
+	qrECCodewordsPerBlock = [4][41]int{
+		{-1, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+		{-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+		{-1, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+		{-1, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	}
+	qrECBlocks = [4][41]int{
+		{-1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+		{-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+		{-1, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+		{-1, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+	}
+)
+
+// QR encodes data as a QR code in byte mode, in the smallest version
+// (size) holding it at error correction level.
+func QR(data []byte, level ECLevel) (*Code, error) {
+	if level < ECLevelL || level > ECLevelH {
+		return nil, fmt.Errorf("barcode: invalid QR error correction level %d", level)
+	}
+	version := 0
+	for v := 1; v <= 40; v++ {
+		if qrDataBits(data, v) <= qrDataCodewords(v, level)*8 {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("barcode: %d bytes do not fit in a QR code", len(data))
+	}
+
+	var bb bitBuffer
+	bb.append(0x4, 4) // byte mode
+	bb.append(len(data), qrCountBits(version))
+	for _, b := range data {
+		bb.append(int(b), 8)
+	}
+	capacity := qrDataCodewords(version, level) * 8
+	bb.append(0, min(4, capacity-len(bb)))
+	bb.append(0, (8-len(bb)%8)%8)
+	for pad := 0xEC; len(bb) < capacity; pad ^= 0xEC ^ 0x11 {
+		bb.append(pad, 8)
+	}
+
+	q := newQRSymbol(version)
+	q.drawFunctionPatterns()
+	q.drawCodewords(qrAddErrorCorrection(bb.bytes(), version, level))
+	best, bestPenalty := 0, -1
+	for mask := range 8 {
+		q.applyMask(mask)
+		q.drawFormatBits(level, mask)
+		if p := q.penalty(); bestPenalty < 0 || p < bestPenalty {
+			best, bestPenalty = mask, p
+		}
+		q.applyMask(mask) // XOR undoes the mask
+	}
+	q.applyMask(best)
+	q.drawFormatBits(level, best)
+	return q.Code, nil
+}
+
+// qrDataBits returns the bits data takes in byte mode in version.
+func qrDataBits(data []byte, version int) int {
+	return 4 + qrCountBits(version) + 8*len(data)
+}
+
+// qrCountBits returns the length of the byte mode character count.
+func qrCountBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+// qrRawDataModules returns the modules of version left for data and
+// error correction once the function patterns are drawn.
+func qrRawDataModules(version int) int {
+	n := (16*version+128)*version + 64
+	if version >= 2 {
+		align := version/7 + 2
+		n -= (25*align-10)*align - 55
+		if version >= 7 {
+			n -= 36
+		}
+	}
+	return n
+}
+
+// qrDataCodewords returns the data codewords of version at level.
+func qrDataCodewords(version int, level ECLevel) int {
+	return qrRawDataModules(version)/8 - qrECCodewordsPerBlock[level][version]*qrECBlocks[level][version]
+}
+
+// qrAddErrorCorrection splits data into blocks, appends the Reed-Solomon
+// error correction codewords of each and interleaves the blocks.
+func qrAddErrorCorrection(data []byte, version int, level ECLevel) []byte {
+	numBlocks := qrECBlocks[level][version]
+	ecLen := qrECCodewordsPerBlock[level][version]
+	raw := qrRawDataModules(version) / 8
+	numShort := numBlocks - raw%numBlocks
+	shortLen := raw / numBlocks
+
+	divisor := rsDivisor(ecLen)
+	blocks := make([][]byte, numBlocks)
+	k := 0
+	for i := range blocks {
+		n := shortLen - ecLen
+		if i >= numShort {
+			n++
+		}
+		dat := data[k : k+n]
+		k += n
+		block := append([]byte(nil), dat...)
+		if i < numShort {
+			block = append(block, 0) // aligns the short blocks
+		}
+		blocks[i] = append(block, rsRemainder(dat, divisor)...)
+	}
+
+	result := make([]byte, 0, raw)
+	for i := range blocks[0] {
+		for j, block := range blocks {
+			if i != shortLen-ecLen || j >= numShort {
+				result = append(result, block[i])
+			}
+		}
+	}
+	return result
+}
+
+// rsDivisor returns the Reed-Solomon generator polynomial of degree,
+// highest coefficient first and the leading 1 dropped.
+func rsDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for range degree {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+// rsRemainder returns the Reed-Solomon error correction codewords of data.
+func rsRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, d := range divisor {
+			result[i] ^= gfMultiply(d, factor)
+		}
+	}
+	return result
+}
+
+// gfMultiply multiplies x and y in GF(2^8) modulo x^8+x^4+x^3+x^2+1.
+func gfMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+// bitBuffer is a sequence of bits, most significant first.
+type bitBuffer []bool
+
+// append appends the low n bits of v.
+func (bb *bitBuffer) append(v, n int) {
+	for i := n - 1; i >= 0; i-- {
+		*bb = append(*bb, (v>>i)&1 != 0)
+	}
+}
+
+// bytes packs the bits, a multiple of 8, into bytes.
+func (bb bitBuffer) bytes() []byte {
+	result := make([]byte, len(bb)/8)
+	for i, bit := range bb {
+		if bit {
+			result[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return result
+}
+
+// qrSymbol is a QR code being drawn.
+type qrSymbol struct {
+	*Code
+	version  int
+	function []bool // modules of the function patterns
+}
+
+func newQRSymbol(version int) *qrSymbol {
+	size := version*4 + 17
+	return &qrSymbol{Code: newCode(size, size), version: version, function: make([]bool, size*size)}
+}
+
+// setFunction draws a function pattern module.
+func (q *qrSymbol) setFunction(x, y int, dark bool) {
+	q.set(x, y, dark)
+	q.function[y*q.width+x] = true
+}
+
+// drawFunctionPatterns draws the finder, timing and alignment patterns
+// and the version information, and reserves the format information.
+func (q *qrSymbol) drawFunctionPatterns() {
+	size := q.width
+	for i := range size {
+		q.setFunction(6, i, i%2 == 0)
+		q.setFunction(i, 6, i%2 == 0)
+	}
+	for _, c := range [][2]int{{3, 3}, {size - 4, 3}, {3, size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := c[0]+dx, c[1]+dy
+				if x >= 0 && x < size && y >= 0 && y < size {
+					d := max(abs(dx), abs(dy))
+					q.setFunction(x, y, d != 2 && d != 4)
+				}
+			}
+		}
+	}
+	pos := qrAlignmentPositions(q.version)
+	for i := range pos {
+		for j := range pos {
+			if i == 0 && j == 0 || i == 0 && j == len(pos)-1 || i == len(pos)-1 && j == 0 {
+				continue // finder corners
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					q.setFunction(pos[i]+dx, pos[j]+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+	q.drawFormatBits(ECLevelL, 0)
+	q.drawVersion()
+}
+
+// qrAlignmentPositions returns the centre coordinates of the alignment
+// patterns of version along each axis.
+func qrAlignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	n := version/7 + 2
+	step := (version*4 + n*2 + 1) / (n*2 - 2) * 2
+	if version == 32 {
+		step = 26
+	}
+	pos := make([]int, n)
+	pos[0] = 6
+	for i, p := n-1, version*4+10; i >= 1; i, p = i-1, p-step {
+		pos[i] = p
+	}
+	return pos
+}
+
+// qrFormatBits returns the 15 bits of format information for level and
+// mask: 5 data bits, 10 BCH error correction bits, XOR-masked.
+func qrFormatBits(level ECLevel, mask int) int {
+	data := level.formatBits()<<3 | mask
+	rem := data
+	for range 10 {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+// drawFormatBits draws both copies of the format information.
+func (q *qrSymbol) drawFormatBits(level ECLevel, mask int) {
+	bits := qrFormatBits(level, mask)
+	bit := func(i int) bool { return (bits>>i)&1 != 0 }
+	size := q.width
+	for i := 0; i <= 5; i++ {
+		q.setFunction(8, i, bit(i))
+	}
+	q.setFunction(8, 7, bit(6))
+	q.setFunction(8, 8, bit(7))
+	q.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		q.setFunction(14-i, 8, bit(i))
+	}
+	for i := 0; i < 8; i++ {
+		q.setFunction(size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		q.setFunction(8, size-15+i, bit(i))
+	}
+	q.setFunction(8, size-8, true) // the dark module
+}
+
+// drawVersion draws both copies of the version information of versions
+// 7 and up.
+func (q *qrSymbol) drawVersion() {
+	if q.version < 7 {
+		return
+	}
+	rem := q.version
+	for range 12 {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	bits := q.version<<12 | rem
+	for i := range 18 {
+		dark := (bits>>i)&1 != 0
+		a, b := q.width-11+i%3, i/3
+		q.setFunction(a, b, dark)
+		q.setFunction(b, a, dark)
+	}
+}
+
+// drawCodewords places the codewords in the zigzag of two-module columns
+// from the bottom right, skipping the function patterns.
+func (q *qrSymbol) drawCodewords(data []byte) {
+	size := q.width
+	i := 0
+	for right := size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5 // skip the vertical timing pattern
+		}
+		for vert := range size {
+			for j := range 2 {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = size - 1 - vert // upward
+				}
+				if !q.function[y*size+x] && i < len(data)*8 {
+					q.set(x, y, (data[i>>3]>>(7-i&7))&1 != 0)
+					i++
+				}
+			}
+		}
+	}
+}
+
+// qrMask reports whether mask pattern mask inverts the module at x, y.
+func qrMask(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// applyMask inverts the data modules selected by mask.
+func (q *qrSymbol) applyMask(mask int) {
+	for y := range q.height {
+		for x := range q.width {
+			if !q.function[y*q.width+x] && qrMask(mask, x, y) {
+				q.set(x, y, !q.Dark(x, y))
+			}
+		}
+	}
+}
+
+// penalty scores the symbol by the rules choosing the mask: long runs of
+// one colour, 2x2 blocks, finder-like patterns and dark/light imbalance.
+func (q *qrSymbol) penalty() int {
+	size := q.width
+	score := 0
+	for _, horizontal := range []bool{true, false} {
+		at := func(i, j int) bool {
+			if horizontal {
+				return q.Dark(j, i)
+			}
+			return q.Dark(i, j)
+		}
+		for i := range size {
+			run := 1
+			for j := 1; j <= size; j++ {
+				if j < size && at(i, j) == at(i, j-1) {
+					run++
+					continue
+				}
+				if run >= 5 {
+					score += run - 2
+				}
+				run = 1
+			}
+			for j := 0; j+11 <= size; j++ {
+				var pattern [11]bool
+				for k := range pattern {
+					pattern[k] = at(i, j+k)
+				}
+				if pattern == qrFinderLike || pattern == qrFinderLikeReversed {
+					score += 40
+				}
+			}
+		}
+	}
+	dark := 0
+	for y := range size {
+		for x := range size {
+			if q.Dark(x, y) {
+				dark++
+			}
+			if x+1 < size && y+1 < size {
+				c := q.Dark(x, y)
+				if c == q.Dark(x+1, y) && c == q.Dark(x, y+1) && c == q.Dark(x+1, y+1) {
+					score += 3
+				}
+			}
+		}
+	}
+	total := size * size
+	score += ((abs(dark*20-total*10)+total-1)/total - 1) * 10
+	return score
+}
+
+// qrFinderLike and its reverse are the 1:1:3:1:1 patterns, with four light
+// modules beside them, that scanners could mistake for a finder.
+var (
+	qrFinderLike         = [11]bool{true, false, true, true, true, false, true, false, false, false, false}
+	qrFinderLikeReversed = [11]bool{false, false, false, false, true, false, true, true, true, false, true}
+)
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,186 @@
+package barcode
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestRSRemainder(t *testing.T) {
+	// "HELLO WORLD" in version 1-M, from the worked example of the
+	// QR code specification tutorials.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	if got := rsRemainder(data, rsDivisor(10)); !bytes.Equal(got, want) {
+		t.Errorf("rsRemainder = %v, want %v", got, want)
+	}
+}
+
+func TestQRFormatBits(t *testing.T) {
+	for _, tt := range []struct {
+		level ECLevel
+		mask  int
+		want  int
+	}{
+		{ECLevelL, 0, 0b111011111000100},
+		{ECLevelM, 0, 0b101010000010010},
+		{ECLevelQ, 0, 0b011010101011111},
+		{ECLevelH, 0, 0b001011010001001},
+		{ECLevelM, 5, 0b100000011001110},
+	} {
+		if got := qrFormatBits(tt.level, tt.mask); got != tt.want {
+			t.Errorf("qrFormatBits(%d, %d) = %015b, want %015b", tt.level, tt.mask, got, tt.want)
+		}
+	}
+}
+
+func TestQRDataCodewords(t *testing.T) {
+	for _, tt := range []struct {
+		version int
+		level   ECLevel
+		want    int
+	}{
+		{1, ECLevelL, 19}, {1, ECLevelM, 16}, {1, ECLevelH, 9},
+		{10, ECLevelQ, 154}, {40, ECLevelL, 2956}, {40, ECLevelH, 1276},
+	} {
+		if got := qrDataCodewords(tt.version, tt.level); got != tt.want {
+			t.Errorf("qrDataCodewords(%d, %d) = %d, want %d", tt.version, tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestQR_RoundTrip(t *testing.T) {
+	for _, tt := range []struct {
+		data  string
+		level ECLevel
+		size  int
+	}{
+		{"https://example.com", ECLevelM, 25},
+		{"", ECLevelL, 21},
+		{string(bytes.Repeat([]byte("Shipment 42/"), 30)), ECLevelM, 73},
+		{string(bytes.Repeat([]byte{0xFF, 0x00, 'x'}, 400)), ECLevelH, 173},
+	} {
+		code, err := QR([]byte(tt.data), tt.level)
+		if err != nil {
+			t.Fatalf("QR(%d bytes): %v", len(tt.data), err)
+		}
+		if code.Width() != tt.size || code.Height() != tt.size {
+			t.Errorf("QR(%d bytes) is %dx%d, want %d", len(tt.data), code.Width(), code.Height(), tt.size)
+		}
+		got, err := decodeQR(code)
+		if err != nil {
+			t.Fatalf("decoding QR(%d bytes): %v", len(tt.data), err)
+		}
+		if string(got) != tt.data {
+			t.Errorf("QR round trip = %q, want %q", got, tt.data)
+		}
+	}
+	if _, err := QR(make([]byte, 3000), ECLevelL); err == nil {
+		t.Error("expected error for data too long")
+	}
+}
+
+// decodeQR reads the byte mode data back from a QR code, checking the
+// format information and the error correction of every block.
+func decodeQR(code *Code) ([]byte, error) {
+	size := code.Width()
+	version := (size - 17) / 4
+	format := 0
+	for i := 0; i <= 5; i++ {
+		format |= b2i(code.Dark(8, i)) << i
+	}
+	format |= b2i(code.Dark(8, 7))<<6 | b2i(code.Dark(8, 8))<<7 | b2i(code.Dark(7, 8))<<8
+	for i := 9; i < 15; i++ {
+		format |= b2i(code.Dark(14-i, 8)) << i
+	}
+	level, mask := ECLevel(-1), -1
+	for l := ECLevelL; l <= ECLevelH; l++ {
+		for m := range 8 {
+			if qrFormatBits(l, m) == format {
+				level, mask = l, m
+			}
+		}
+	}
+	if mask < 0 {
+		return nil, fmt.Errorf("invalid format bits %015b", format)
+	}
+
+	ref := newQRSymbol(version)
+	ref.drawFunctionPatterns()
+	var codewords []byte
+	var cur, n int
+	for right := size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := range size {
+			for j := range 2 {
+				x, y := right-j, vert
+				if (right+1)&2 == 0 {
+					y = size - 1 - vert
+				}
+				if ref.function[y*size+x] {
+					continue
+				}
+				cur = cur<<1 | b2i(code.Dark(x, y) != qrMask(mask, x, y))
+				if n++; n%8 == 0 {
+					codewords = append(codewords, byte(cur))
+					cur = 0
+				}
+			}
+		}
+	}
+
+	numBlocks := qrECBlocks[level][version]
+	ecLen := qrECCodewordsPerBlock[level][version]
+	raw := qrRawDataModules(version) / 8
+	numShort := numBlocks - raw%numBlocks
+	shortData := raw/numBlocks - ecLen
+	blocks := make([][]byte, numBlocks)
+	k := 0
+	for i := 0; i <= shortData; i++ {
+		for j := range blocks {
+			if i < shortData || j >= numShort {
+				blocks[j] = append(blocks[j], codewords[k])
+				k++
+			}
+		}
+	}
+	var data []byte
+	for j := range blocks {
+		ec := codewords[k+j : k+j+1]
+		for i := 1; i < ecLen; i++ {
+			ec = append(append([]byte(nil), ec...), codewords[k+i*numBlocks+j])
+		}
+		if !bytes.Equal(rsRemainder(blocks[j], rsDivisor(ecLen)), ec) {
+			return nil, fmt.Errorf("block %d fails error correction", j)
+		}
+		data = append(data, blocks[j]...)
+	}
+
+	bit := func(i int) int { return int(data[i/8]>>(7-i%8)) & 1 }
+	read := func(pos, n int) int {
+		v := 0
+		for i := range n {
+			v = v<<1 | bit(pos+i)
+		}
+		return v
+	}
+	if m := read(0, 4); m != 4 {
+		return nil, fmt.Errorf("mode %d, want byte mode", m)
+	}
+	count := read(4, qrCountBits(version))
+	pos := 4 + qrCountBits(version)
+	result := make([]byte, count)
+	for i := range result {
+		result[i] = byte(read(pos+8*i, 8))
+	}
+	return result, nil
+}
+
+func b2i(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,69 @@
+package docx
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+)
+
+func TestRun_AddQRCodeAndBarcode(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	run, err := p.AddRun("")
+	if err != nil {
+		t.Fatalf("AddRun: %v", err)
+	}
+
+	qr, err := run.AddQRCode("https://example.com/t/42", Cm(3))
+	if err != nil {
+		t.Fatalf("AddQRCode: %v", err)
+	}
+	if w, _ := qr.Width(); w != Cm(3) {
+		t.Errorf("QR width = %d, want %d", w, Cm(3))
+	}
+	if h, _ := qr.Height(); h != Cm(3) {
+		t.Errorf("QR height = %d, want %d", h, Cm(3))
+	}
+	if qr.AltText() != "https://example.com/t/42" {
+		t.Errorf("QR alt text = %q", qr.AltText())
+	}
+	blob, err := qr.ImageBytes()
+	if err != nil {
+		t.Fatalf("ImageBytes: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(blob))
+	if err != nil {
+		t.Fatalf("png.Decode: %v", err)
+	}
+	// Version 2 (25 modules) plus the quiet zone, 8 pixels per module.
+	if b := img.Bounds(); b.Dx() != 33*8 || b.Dy() != 33*8 {
+		t.Errorf("QR image is %dx%d, want %dx%d", b.Dx(), b.Dy(), 33*8, 33*8)
+	}
+
+	ean, err := run.AddBarcode(BarcodeEAN13, "400638133393")
+	if err != nil {
+		t.Fatalf("AddBarcode: %v", err)
+	}
+	if w, _ := ean.Width(); w != 117*barcodeModule {
+		t.Errorf("EAN-13 width = %d, want %d", w, 117*barcodeModule)
+	}
+	if h, _ := ean.Height(); h != Mm(15) {
+		t.Errorf("EAN-13 height = %d, want %d", h, Mm(15))
+	}
+	if len(run.Pictures()) != 2 {
+		t.Errorf("run has %d pictures, want 2", len(run.Pictures()))
+	}
+
+	if _, err := run.AddBarcode(BarcodeCode128, "ZX-0042-17"); err != nil {
+		t.Errorf("AddBarcode(Code128): %v", err)
+	}
+	if _, err := run.AddBarcode(BarcodeEAN13, "12345"); err == nil {
+		t.Error("expected error for invalid EAN-13 value")
+	}
+	if _, err := run.AddQRCode("x", 0); err == nil {
+		t.Error("expected error for zero QR size")
+	}
+}
@@ -1,6 +1,7 @@
 package docx
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/beevik/etree"
@@ -59,6 +60,9 @@ func (c *BlockItemContainer) AddParagraph(text string, style ...StyleRef) (*Para
 			return nil, fmt.Errorf("docx: setting paragraph style: %w", err)
 		}
 	}
+	if err := paragraphAdded(para); err != nil {
+		return nil, err
+	}
 	return para, nil
 }
 
@@ -72,19 +76,35 @@ func (c *BlockItemContainer) AddTable(rows, cols int, widthTwips int) (*Table, e
 	return newTable(tbl, c.part), nil
 }
 
+// AddTableLength is AddTable with the total width given as a Length, shared
+// evenly between the columns.
+func (c *BlockItemContainer) AddTableLength(rows, cols int, width Length) (*Table, error) {
+	return c.AddTable(rows, cols, width.Twips())
+}
+
 // IterInnerContent returns a slice of InnerContentItems (Paragraph or Table)
-// in document order.
+// in document order. Block content inside mc:AlternateContent is read from
+// its first mc:Choice, or its mc:Fallback when it has none.
 //
 // Mirrors Python BlockItemContainer.iter_inner_content.
 func (c *BlockItemContainer) IterInnerContent() []*InnerContentItem {
-	var result []*InnerContentItem
-	for _, child := range c.element.ChildElements() {
+	return c.appendInnerContent(nil, c.element)
+}
+
+// appendInnerContent appends the paragraphs and tables among the children
+// of parent to result.
+func (c *BlockItemContainer) appendInnerContent(result []*InnerContentItem, parent *etree.Element) []*InnerContentItem {
+	for _, child := range parent.ChildElements() {
 		if child.Space == "w" && child.Tag == "p" {
 			p := &oxml.CT_P{Element: oxml.WrapElement(child)}
 			result = append(result, &InnerContentItem{paragraph: newParagraph(p, c.part)})
 		} else if child.Space == "w" && child.Tag == "tbl" {
 			tbl := &oxml.CT_Tbl{Element: oxml.WrapElement(child)}
 			result = append(result, &InnerContentItem{table: newTable(tbl, c.part)})
+		} else if oxml.IsAlternateContent(child) {
+			if branch := oxml.AlternateContentBranch(child); branch != nil {
+				result = c.appendInnerContent(result, branch)
+			}
 		}
 	}
 	return result
@@ -119,18 +139,82 @@ func (c *BlockItemContainer) Tables() []*Table {
 }
 
 // ReplaceText replaces all occurrences of old with new in all paragraphs
-// and tables of this container, recursively. Returns the total number of
-// replacements performed.
+// and tables of this container, recursively. Text inside block-level
+// content controls (<w:sdt>) and inside text boxes anchored in the
+// paragraphs is replaced as well. Returns the total number of replacements
+// performed.
+//
+// Legacy VML copies of text boxes (mc:Fallback) are kept in sync with the
+// preferred rendering but are not counted, so each visible occurrence is
+// counted once.
 func (c *BlockItemContainer) ReplaceText(old, new string) int {
+	count, _ := c.replaceText(context.Background(), old, new)
+	return count
+}
+
+// replaceText is ReplaceText, stopping with the context's error once ctx
+// is done; ctx is checked before each paragraph and table. The count of
+// replacements made until then is returned with the error.
+func (c *BlockItemContainer) replaceText(ctx context.Context, old, new string) (int, error) {
+	count := 0
+	for _, child := range c.element.ChildElements() {
+		if child.Space != "w" {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+		switch child.Tag {
+		case "p":
+			p := &oxml.CT_P{Element: oxml.WrapElement(child)}
+			count += newParagraph(p, c.part).ReplaceText(old, new)
+			n, err := c.replaceTextInTextBoxes(ctx, child, old, new)
+			count += n
+			if err != nil {
+				return count, err
+			}
+		case "tbl":
+			tbl := &oxml.CT_Tbl{Element: oxml.WrapElement(child)}
+			n, err := newTable(tbl, c.part).replaceText(ctx, old, new)
+			count += n
+			if err != nil {
+				return count, err
+			}
+		case "sdt":
+			if content := child.SelectElement("w:sdtContent"); content != nil {
+				sdtc := newBlockItemContainer(content, c.part)
+				n, err := sdtc.replaceText(ctx, old, new)
+				count += n
+				if err != nil {
+					return count, err
+				}
+			}
+		}
+	}
+	return count, nil
+}
+
+// replaceTextInTextBoxes replaces text in the text boxes anchored in the
+// paragraph element pElem. Only replacements in preferred renderings are
+// counted.
+func (c *BlockItemContainer) replaceTextInTextBoxes(ctx context.Context, pElem *etree.Element, old, new string) (int, error) {
+	preferred, fallback := oxml.FindOuterTxbxContents(pElem)
 	count := 0
-	for _, item := range c.IterInnerContent() {
-		if item.IsParagraph() {
-			count += item.Paragraph().ReplaceText(old, new)
-		} else if item.IsTable() {
-			count += item.Table().ReplaceText(old, new)
+	for _, el := range preferred {
+		box := newBlockItemContainer(el, c.part)
+		n, err := box.replaceText(ctx, old, new)
+		count += n
+		if err != nil {
+			return count, err
 		}
 	}
-	return count
+	for _, el := range fallback {
+		box := newBlockItemContainer(el, c.part)
+		if _, err := box.replaceText(ctx, old, new); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
 }
 
 // Element returns the backing etree element.
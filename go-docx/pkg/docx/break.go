@@ -0,0 +1,61 @@
+package docx
+
+import (
+	"fmt"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// Break is a break inside a run (w:br): a page, column or line break, as
+// Run.AddBreak adds. Unlike a RenderedPageBreak it is part of the content
+// and forces the break wherever it falls.
+type Break struct {
+	br *oxml.CT_Br
+}
+
+// Breaks returns the breaks in the run, in document order.
+func (run *Run) Breaks() []*Break {
+	lst := run.r.BrList()
+	result := make([]*Break, len(lst))
+	for i, br := range lst {
+		result[i] = &Break{br: br}
+	}
+	return result
+}
+
+// Type returns the kind of break: WdBreakTypePage, WdBreakTypeColumn,
+// WdBreakTypeLine, or for a line break that also clears floating objects,
+// WdBreakTypeLineClearLeft, WdBreakTypeLineClearRight or
+// WdBreakTypeLineClearAll.
+func (b *Break) Type() (enum.WdBreakType, error) {
+	switch typ := b.br.Type(); typ {
+	case "page":
+		return enum.WdBreakTypePage, nil
+	case "column":
+		return enum.WdBreakTypeColumn, nil
+	case "textWrapping":
+		switch clear := b.br.Clear(); clear {
+		case "", "none":
+			return enum.WdBreakTypeLine, nil
+		case "left":
+			return enum.WdBreakTypeLineClearLeft, nil
+		case "right":
+			return enum.WdBreakTypeLineClearRight, nil
+		case "all":
+			return enum.WdBreakTypeLineClearAll, nil
+		default:
+			return 0, fmt.Errorf("docx: unknown break clear %q", clear)
+		}
+	default:
+		return 0, fmt.Errorf("docx: unknown break type %q", typ)
+	}
+}
+
+// Remove deletes the break from its run. The rest of the run, and the run
+// itself, are kept even if the break was all it held.
+func (b *Break) Remove() {
+	if parent := b.br.RawElement().Parent(); parent != nil {
+		parent.RemoveChild(b.br.RawElement())
+	}
+}
@@ -0,0 +1,47 @@
+package docx
+
+import (
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+)
+
+func TestRun_Breaks(t *testing.T) {
+	run := newRun(makeR(t, `<w:t>a</w:t><w:br w:type="page"/><w:t>b</w:t><w:br/><w:br w:type="column"/><w:br w:clear="left"/>`), nil)
+	want := []enum.WdBreakType{enum.WdBreakTypePage, enum.WdBreakTypeLine, enum.WdBreakTypeColumn, enum.WdBreakTypeLineClearLeft}
+	breaks := run.Breaks()
+	if len(breaks) != len(want) {
+		t.Fatalf("len(Breaks()) = %d, want %d", len(breaks), len(want))
+	}
+	for i, b := range breaks {
+		if got, err := b.Type(); err != nil || got != want[i] {
+			t.Errorf("Breaks()[%d].Type() = %v, %v; want %v", i, got, err, want[i])
+		}
+	}
+
+	breaks[0].Remove()
+	if n := len(run.Breaks()); n != 3 {
+		t.Errorf("len(Breaks()) after Remove = %d, want 3", n)
+	}
+	if got := run.Text(); got != "ab\n\n" {
+		t.Errorf("Text() after Remove = %q", got)
+	}
+}
+
+func TestBreak_Type_RoundTrip(t *testing.T) {
+	for _, bt := range []enum.WdBreakType{
+		enum.WdBreakTypeLine, enum.WdBreakTypePage, enum.WdBreakTypeColumn,
+		enum.WdBreakTypeLineClearLeft, enum.WdBreakTypeLineClearRight, enum.WdBreakTypeLineClearAll,
+	} {
+		run := newRun(makeR(t, ""), nil)
+		if err := run.AddBreak(bt); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := run.Breaks()[0].Type(); err != nil || got != bt {
+			t.Errorf("Type() = %v, %v; want %v", got, err, bt)
+		}
+	}
+	if _, err := newRun(makeR(t, `<w:br w:type="bogus"/>`), nil).Breaks()[0].Type(); err == nil {
+		t.Error("expected error for an unknown break type")
+	}
+}
@@ -0,0 +1,357 @@
+package docx
+
+import (
+	"fmt"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+)
+
+// --------------------------------------------------------------------------
+// ParagraphBuilder
+// --------------------------------------------------------------------------
+
+// ParagraphBuilder adds a paragraph through chained calls:
+//
+//	para, err := doc.Para().Style("Heading 1").Align(enum.WdParagraphAlignmentCenter).
+//		Run("Quarterly report").Bold().Color(NewRGBColor(0x1F, 0x4E, 0x79)).
+//		Done()
+//
+// The first error stops further changes and is returned by Done, so the
+// individual calls need no error checks. The paragraph is added to its
+// container as soon as the builder is created.
+type ParagraphBuilder struct {
+	para *Paragraph
+	err  error
+}
+
+// Para starts a new paragraph at the end of the document body.
+func (d *Document) Para() *ParagraphBuilder {
+	para, err := d.AddParagraph("")
+	return &ParagraphBuilder{para: para, err: err}
+}
+
+// Para starts a new paragraph at the end of this container.
+func (c *BlockItemContainer) Para() *ParagraphBuilder {
+	para, err := c.AddParagraph("")
+	return &ParagraphBuilder{para: para, err: err}
+}
+
+// Para starts a new paragraph at the end of this header/footer.
+func (b *baseHeaderFooter) Para() *ParagraphBuilder {
+	para, err := b.AddParagraph("")
+	return &ParagraphBuilder{para: para, err: err}
+}
+
+// do applies fn unless an earlier step failed.
+func (b *ParagraphBuilder) do(fn func() error) *ParagraphBuilder {
+	if b.err == nil {
+		b.err = fn()
+	}
+	return b
+}
+
+// Style applies the paragraph style with the given name, e.g. "Heading 1".
+func (b *ParagraphBuilder) Style(name string) *ParagraphBuilder {
+	return b.do(func() error { return b.para.SetStyle(StyleName(name)) })
+}
+
+// Align sets the paragraph alignment.
+func (b *ParagraphBuilder) Align(v enum.WdParagraphAlignment) *ParagraphBuilder {
+	return b.do(func() error { return b.para.SetAlignment(&v) })
+}
+
+// SpaceBefore sets the space above the paragraph.
+func (b *ParagraphBuilder) SpaceBefore(v Length) *ParagraphBuilder {
+	return b.do(func() error { return b.para.ParagraphFormat().SetSpaceBeforeLength(&v) })
+}
+
+// SpaceAfter sets the space below the paragraph.
+func (b *ParagraphBuilder) SpaceAfter(v Length) *ParagraphBuilder {
+	return b.do(func() error { return b.para.ParagraphFormat().SetSpaceAfterLength(&v) })
+}
+
+// Indent sets the left indent of the paragraph.
+func (b *ParagraphBuilder) Indent(v Length) *ParagraphBuilder {
+	return b.do(func() error { return b.para.ParagraphFormat().SetLeftIndentLength(&v) })
+}
+
+// FirstLineIndent sets the first-line indent; a negative value makes a
+// hanging indent.
+func (b *ParagraphBuilder) FirstLineIndent(v Length) *ParagraphBuilder {
+	return b.do(func() error { return b.para.ParagraphFormat().SetFirstLineIndentLength(&v) })
+}
+
+// LineSpacing sets the line spacing as a multiple of single spacing.
+func (b *ParagraphBuilder) LineSpacing(multiple float64) *ParagraphBuilder {
+	v := LineSpacingMultiple(multiple)
+	return b.do(func() error { return b.para.ParagraphFormat().SetLineSpacing(&v) })
+}
+
+// KeepWithNext keeps the paragraph on the same page as the next one.
+func (b *ParagraphBuilder) KeepWithNext() *ParagraphBuilder {
+	return b.do(func() error { return b.para.ParagraphFormat().SetKeepWithNext(Ptr(true)) })
+}
+
+// PageBreakBefore starts the paragraph on a new page.
+func (b *ParagraphBuilder) PageBreakBefore() *ParagraphBuilder {
+	return b.do(func() error { return b.para.ParagraphFormat().SetPageBreakBefore(Ptr(true)) })
+}
+
+// Text appends a run of unformatted text.
+func (b *ParagraphBuilder) Text(text string) *ParagraphBuilder {
+	return b.do(func() error {
+		_, err := b.para.AddRun(text)
+		return err
+	})
+}
+
+// Run appends a run of text and returns a builder for its formatting.
+func (b *ParagraphBuilder) Run(text string) *RunBuilder {
+	rb := &RunBuilder{pb: b}
+	b.do(func() error {
+		run, err := b.para.AddRun(text)
+		rb.run = run
+		return err
+	})
+	return rb
+}
+
+// Paragraph returns the paragraph being built, or nil if it could not be
+// created.
+func (b *ParagraphBuilder) Paragraph() *Paragraph { return b.para }
+
+// Err returns the first error recorded by the builder.
+func (b *ParagraphBuilder) Err() error { return b.err }
+
+// Done returns the paragraph and the first error recorded by the builder.
+func (b *ParagraphBuilder) Done() (*Paragraph, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.para, nil
+}
+
+// --------------------------------------------------------------------------
+// RunBuilder
+// --------------------------------------------------------------------------
+
+// RunBuilder formats a run added by ParagraphBuilder.Run. Run and Text
+// continue the same paragraph; Done finishes it.
+type RunBuilder struct {
+	pb  *ParagraphBuilder
+	run *Run
+}
+
+// do applies fn to the run unless an earlier step failed.
+func (b *RunBuilder) do(fn func() error) *RunBuilder {
+	b.pb.do(fn)
+	return b
+}
+
+// Bold makes the run bold.
+func (b *RunBuilder) Bold() *RunBuilder {
+	return b.do(func() error { return b.run.SetBold(Ptr(true)) })
+}
+
+// Italic makes the run italic.
+func (b *RunBuilder) Italic() *RunBuilder {
+	return b.do(func() error { return b.run.SetItalic(Ptr(true)) })
+}
+
+// Underline underlines the run with a single line.
+func (b *RunBuilder) Underline() *RunBuilder {
+	u := UnderlineSingle()
+	return b.do(func() error { return b.run.SetUnderline(&u) })
+}
+
+// Strike draws a line through the run.
+func (b *RunBuilder) Strike() *RunBuilder {
+	return b.do(func() error { return b.run.Font().SetStrike(Ptr(true)) })
+}
+
+// Color sets the text color.
+func (b *RunBuilder) Color(c RGBColor) *RunBuilder {
+	return b.do(func() error { return b.run.Font().Color().SetRGB(&c) })
+}
+
+// Highlight sets the highlight color.
+func (b *RunBuilder) Highlight(c enum.WdColorIndex) *RunBuilder {
+	return b.do(func() error { return b.run.Font().SetHighlightColor(&c) })
+}
+
+// Size sets the font size, e.g. Pt(14).
+func (b *RunBuilder) Size(v Length) *RunBuilder {
+	return b.do(func() error { return b.run.Font().SetSize(&v) })
+}
+
+// Font sets the typeface name, e.g. "Arial".
+func (b *RunBuilder) Font(name string) *RunBuilder {
+	return b.do(func() error { return b.run.Font().SetName(&name) })
+}
+
+// Style applies the character style with the given name.
+func (b *RunBuilder) Style(name string) *RunBuilder {
+	return b.do(func() error { return b.run.SetStyle(StyleName(name)) })
+}
+
+// Run appends another run to the paragraph.
+func (b *RunBuilder) Run(text string) *RunBuilder { return b.pb.Run(text) }
+
+// Text appends a run of unformatted text to the paragraph.
+func (b *RunBuilder) Text(text string) *RunBuilder {
+	b.pb.Text(text)
+	return b
+}
+
+// Get returns the run being formatted, or nil if it could not be created.
+func (b *RunBuilder) Get() *Run { return b.run }
+
+// Done returns the paragraph and the first error recorded while building
+// it.
+func (b *RunBuilder) Done() (*Paragraph, error) { return b.pb.Done() }
+
+// --------------------------------------------------------------------------
+// TableBuilder
+// --------------------------------------------------------------------------
+
+// TableBuilder collects the rows of a table and adds it on Done:
+//
+//	tbl, err := doc.BuildTable(3).Style("Table Grid").
+//		Header("Item", "Qty", "Price").
+//		Row("Pen", "2", "1.50").
+//		ColumnWidths(Inches(3), Inches(1), Inches(1)).
+//		Done()
+type TableBuilder struct {
+	doc    *Document
+	cols   int
+	style  string
+	header bool
+	rows   [][]string
+	widths []Length
+	align  *enum.WdTableAlignment
+	err    error
+}
+
+// BuildTable starts a table with cols columns at the end of the document
+// body.
+func (d *Document) BuildTable(cols int) *TableBuilder {
+	b := &TableBuilder{doc: d, cols: cols}
+	if cols < 1 {
+		b.err = fmt.Errorf("docx: table needs at least one column, got %d", cols)
+	}
+	return b
+}
+
+// Style applies the table style with the given name, e.g. "Table Grid".
+func (b *TableBuilder) Style(name string) *TableBuilder {
+	b.style = name
+	return b
+}
+
+// Header adds a row of bold column headings. It must precede Row.
+func (b *TableBuilder) Header(cells ...string) *TableBuilder {
+	if b.err == nil && len(b.rows) > 0 {
+		b.err = fmt.Errorf("docx: table header must be the first row")
+	}
+	b.header = true
+	return b.Row(cells...)
+}
+
+// Row adds a row of cell texts. Missing cells are left empty.
+func (b *TableBuilder) Row(cells ...string) *TableBuilder {
+	if b.err == nil && len(cells) > b.cols {
+		b.err = fmt.Errorf("docx: table row has %d cells, want at most %d", len(cells), b.cols)
+	}
+	b.rows = append(b.rows, cells)
+	return b
+}
+
+// ColumnWidths sets the widths of the first len(widths) columns.
+func (b *TableBuilder) ColumnWidths(widths ...Length) *TableBuilder {
+	if b.err == nil && len(widths) > b.cols {
+		b.err = fmt.Errorf("docx: %d column widths given for %d columns", len(widths), b.cols)
+	}
+	b.widths = widths
+	return b
+}
+
+// Align sets the alignment of the table between the margins.
+func (b *TableBuilder) Align(v enum.WdTableAlignment) *TableBuilder {
+	b.align = &v
+	return b
+}
+
+// Done adds the table to the document and returns it, or the first error
+// recorded by the builder.
+func (b *TableBuilder) Done() (*Table, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	var style []StyleRef
+	if b.style != "" {
+		style = append(style, StyleName(b.style))
+	}
+	tbl, err := b.doc.AddTable(len(b.rows), b.cols, style...)
+	if err != nil {
+		return nil, err
+	}
+	for r, cells := range b.rows {
+		for c, text := range cells {
+			cell, err := tbl.CellAt(r, c)
+			if err != nil {
+				return nil, err
+			}
+			cell.SetText(text)
+			if b.header && r == 0 {
+				if err := boldCellText(cell); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if len(b.widths) > 0 {
+		if err := tbl.SetAutofit(false); err != nil {
+			return nil, err
+		}
+		cols, err := tbl.Columns()
+		if err != nil {
+			return nil, err
+		}
+		for i, w := range b.widths {
+			col, err := cols.Get(i)
+			if err != nil {
+				return nil, err
+			}
+			if err := col.SetWidthLength(&w); err != nil {
+				return nil, err
+			}
+			for r := range b.rows {
+				cell, err := tbl.CellAt(r, i)
+				if err != nil {
+					return nil, err
+				}
+				if err := cell.SetWidthLength(w); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if b.align != nil {
+		if err := tbl.SetAlignment(b.align); err != nil {
+			return nil, err
+		}
+	}
+	return tbl, nil
+}
+
+// boldCellText makes every run in cell bold.
+func boldCellText(cell *Cell) error {
+	for _, p := range cell.Paragraphs() {
+		for _, r := range p.Runs() {
+			if err := r.SetBold(Ptr(true)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package docx
+
+import (
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+)
+
+func TestParagraphBuilder(t *testing.T) {
+	doc := mustNewDoc(t)
+	blue := NewRGBColor(0x1F, 0x4E, 0x79)
+	para, err := doc.Para().Style("Heading 1").Align(enum.WdParagraphAlignmentCenter).
+		Run("Quarterly ").Bold().Color(blue).Size(Pt(20)).
+		Text("report").
+		Done()
+	if err != nil {
+		t.Fatalf("Done: %v", err)
+	}
+	if got := para.Text(); got != "Quarterly report" {
+		t.Errorf("Text() = %q", got)
+	}
+	if a, _ := para.Alignment(); a == nil || *a != enum.WdParagraphAlignmentCenter {
+		t.Errorf("Alignment() = %v, want CENTER", a)
+	}
+	runs := para.Runs()
+	if len(runs) != 2 {
+		t.Fatalf("Runs() = %d, want 2", len(runs))
+	}
+	if b := runs[0].Bold(); b == nil || !*b {
+		t.Error("first run is not bold")
+	}
+	if c, _ := runs[0].Font().Color().RGB(); c == nil || *c != blue {
+		t.Errorf("first run color = %v, want %v", c, blue)
+	}
+	if b := runs[1].Bold(); b != nil {
+		t.Error("Text() run should be unformatted")
+	}
+}
+
+func TestParagraphBuilder_StopsAtFirstError(t *testing.T) {
+	doc := mustNewDoc(t)
+	_, err := doc.Para().Style("No Such Style").Run("x").Bold().Done()
+	if err == nil {
+		t.Fatal("expected an error for an unknown style")
+	}
+}
+
+func TestTableBuilder(t *testing.T) {
+	doc := mustNewDoc(t)
+	tbl, err := doc.BuildTable(3).
+		Header("Item", "Qty", "Price").
+		Row("Pen", "2", "1.50").
+		Row("Ink").
+		ColumnWidths(Inches(3), Inches(1)).
+		Done()
+	if err != nil {
+		t.Fatalf("Done: %v", err)
+	}
+	if n := tbl.Rows().Len(); n != 3 {
+		t.Fatalf("Rows().Len() = %d, want 3", n)
+	}
+	cell, _ := tbl.CellAt(1, 2)
+	if cell.Text() != "1.50" {
+		t.Errorf("cell(1,2) = %q, want 1.50", cell.Text())
+	}
+	head, _ := tbl.CellAt(0, 0)
+	if b := head.Paragraphs()[0].Runs()[0].Bold(); b == nil || !*b {
+		t.Error("header cell is not bold")
+	}
+	cols, _ := tbl.Columns()
+	col, _ := cols.Get(0)
+	if w, _ := col.WidthLength(); w == nil || *w != Inches(3) {
+		t.Errorf("column 0 width = %v, want %v", w, Inches(3))
+	}
+
+	if _, err := doc.BuildTable(2).Row("a", "b", "c").Done(); err == nil {
+		t.Error("expected an error for a row wider than the table")
+	}
+}
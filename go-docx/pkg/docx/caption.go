@@ -0,0 +1,257 @@
+package docx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// --------------------------------------------------------------------------
+// Bookmarks
+// --------------------------------------------------------------------------
+
+// AddBookmark brackets the content of this paragraph with a bookmark named
+// name. Names must be unique in the story, start with a letter (or "_" for
+// hidden bookmarks) and be at most 40 characters.
+func (para *Paragraph) AddBookmark(name string) error {
+	if name == "" || len(name) > 40 {
+		return fmt.Errorf("docx: invalid bookmark name %q", name)
+	}
+	root := para.storyRoot()
+	if oxml.HasBookmark(root, name) {
+		return fmt.Errorf("docx: bookmark %q already exists", name)
+	}
+	oxml.WrapParagraphInBookmark(para.p.RawElement(), oxml.NextBookmarkID(root), name)
+	return nil
+}
+
+// Bookmarks returns the names of the bookmarks starting in this paragraph.
+func (para *Paragraph) Bookmarks() []string {
+	return oxml.ParagraphBookmarkNames(para.p.RawElement())
+}
+
+// ReferenceBookmark returns the name of a hidden "_Ref" bookmark covering
+// this paragraph, creating one if needed, so the paragraph (typically a
+// heading) can be the target of AddCrossReference.
+func (para *Paragraph) ReferenceBookmark() (string, error) {
+	for _, name := range para.Bookmarks() {
+		if strings.HasPrefix(name, "_Ref") {
+			return name, nil
+		}
+	}
+	name := newRefBookmarkName(para.storyRoot())
+	if err := para.AddBookmark(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// storyRoot returns the root element of the story containing this
+// paragraph: the story part element, or the topmost ancestor for a
+// paragraph without a part.
+func (para *Paragraph) storyRoot() *etree.Element {
+	if para.part != nil {
+		if el := para.part.Element(); el != nil {
+			return el
+		}
+	}
+	root := para.p.RawElement()
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+	return root
+}
+
+// newRefBookmarkName returns an unused hidden bookmark name in the style
+// Word uses for cross-reference targets, e.g. "_Ref100000001".
+func newRefBookmarkName(root *etree.Element) string {
+	for n := 100000000 + oxml.NextBookmarkID(root); ; n++ {
+		name := "_Ref" + strconv.Itoa(n)
+		if !oxml.HasBookmark(root, name) {
+			return name
+		}
+	}
+}
+
+// --------------------------------------------------------------------------
+// Captions
+// --------------------------------------------------------------------------
+
+// CaptionTarget is a block that can receive a caption: a *Table, a
+// *Paragraph or an *InlineShape (captioned below its paragraph).
+type CaptionTarget interface {
+	// captionAnchor returns the block element the caption follows and its
+	// story part, or a nil part when unknown.
+	captionAnchor() (*etree.Element, *parts.StoryPart, error)
+}
+
+func (t *Table) captionAnchor() (*etree.Element, *parts.StoryPart, error) {
+	return t.tbl.RawElement(), t.part, nil
+}
+
+func (para *Paragraph) captionAnchor() (*etree.Element, *parts.StoryPart, error) {
+	return para.p.RawElement(), para.part, nil
+}
+
+func (s *InlineShape) captionAnchor() (*etree.Element, *parts.StoryPart, error) {
+	for el := s.inline.RawElement().Parent(); el != nil; el = el.Parent() {
+		if el.Space == "w" && el.Tag == "p" {
+			return el, s.part, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("docx: inline shape is not in a paragraph")
+}
+
+// Caption is a numbered caption paragraph created by AddCaption.
+type Caption struct {
+	// Paragraph is the caption paragraph.
+	Paragraph *Paragraph
+	// Label is the sequence label, e.g. "Figure".
+	Label string
+	// Number is the caption's number at the time it was added.
+	Number int
+	// Bookmark brackets the label and number; pass it to AddCrossReference
+	// to refer to the caption as "Figure 2".
+	Bookmark string
+}
+
+// AddCaption inserts a caption paragraph directly below target, reading
+// "<label> <n>: <text>" (or "<label> <n>" for empty text), where n is a
+// SEQ field numbering label captions in document order. The Caption style
+// is applied when the document defines it. Cached numbers of the
+// following captions with the same label are renumbered.
+func (d *Document) AddCaption(target CaptionTarget, label, text string) (*Caption, error) {
+	if strings.TrimSpace(label) == "" || strings.ContainsAny(label, " \t") {
+		return nil, fmt.Errorf("docx: invalid caption label %q", label)
+	}
+	anchor, part, err := target.captionAnchor()
+	if err != nil {
+		return nil, err
+	}
+	if part == nil {
+		part = &d.part.StoryPart
+	}
+	parent := anchor.Parent()
+	if parent == nil {
+		return nil, fmt.Errorf("docx: caption target is not in a document")
+	}
+
+	pEl := oxml.OxmlElement("w:p")
+	parent.InsertChildAt(childIndex(parent, anchor)+1, pEl)
+	para := newParagraph(&oxml.CT_P{Element: oxml.WrapElement(pEl)}, part)
+	if styles, err := d.Styles(); err == nil && styles.Contains("Caption") {
+		if err := para.SetStyle(StyleName("Caption")); err != nil {
+			return nil, err
+		}
+	}
+
+	labelRun, err := para.AddRun(label + " ")
+	if err != nil {
+		return nil, err
+	}
+	field, err := para.AddField(SeqFieldCode(label), "1")
+	if err != nil {
+		return nil, err
+	}
+	if text != "" {
+		if _, err := para.AddRun(": " + text); err != nil {
+			return nil, err
+		}
+	}
+
+	root := para.storyRoot()
+	name := newRefBookmarkName(root)
+	endRun := field.span.End.Parent()
+	oxml.WrapRunsInBookmark(labelRun.r.RawElement(), endRun, oxml.NextBookmarkID(root), name)
+
+	numbers := renumberSeq(root, label)
+	return &Caption{Paragraph: para, Label: label, Number: numbers[field.span.Begin], Bookmark: name}, nil
+}
+
+// renumberSeq sets the cached results of the unlocked SEQ fields for
+// identifier under root to their sequence numbers, honouring the \r (reset)
+// and \c (repeat) switches, and returns the number of each field keyed by
+// its begin element (or fldSimple element).
+func renumberSeq(root *etree.Element, identifier string) map[*etree.Element]int {
+	numbers := map[*etree.Element]int{}
+	n := 0
+	for _, span := range oxml.ScanFields(root) {
+		words := splitFieldCode(span.Instruction())
+		if len(words) < 2 || !strings.EqualFold(words[0], "SEQ") || words[1] != identifier {
+			continue
+		}
+		next := n + 1
+		for i := 2; i < len(words); i++ {
+			switch strings.ToLower(words[i]) {
+			case `\c`:
+				next = n
+			case `\r`:
+				if i+1 < len(words) {
+					if v, err := strconv.Atoi(words[i+1]); err == nil {
+						next = v
+					}
+				}
+			}
+		}
+		n = next
+		numbers[fieldAnchor(span)] = n
+		if !span.Locked() && (span.Simple != nil || span.Separate != nil || span.End != nil) {
+			span.SetResult(strconv.Itoa(n))
+		}
+	}
+	return numbers
+}
+
+// --------------------------------------------------------------------------
+// Cross-references
+// --------------------------------------------------------------------------
+
+// CrossRefFormat selects what a cross-reference displays.
+type CrossRefFormat int
+
+const (
+	// CrossRefText shows the bookmarked text, e.g. "Figure 2" for a
+	// caption or the heading text for a heading.
+	CrossRefText CrossRefFormat = iota
+	// CrossRefPageNumber shows the page number of the bookmark.
+	CrossRefPageNumber
+	// CrossRefAboveBelow shows "above" or "below" relative to the
+	// reference.
+	CrossRefAboveBelow
+)
+
+// AddCrossReference appends a field referring to bookmark (from a Caption,
+// ReferenceBookmark or AddBookmark) and returns it. The reference is a
+// hyperlink to its target. Text references are given the bookmarked text
+// as cached result; page-number and above/below references are flagged
+// dirty so Word computes them when the document is opened.
+func (para *Paragraph) AddCrossReference(bookmark string, format CrossRefFormat) (*Field, error) {
+	root := para.storyRoot()
+	text, ok := oxml.BookmarkText(root, bookmark)
+	if !ok {
+		return nil, fmt.Errorf("docx: bookmark %q not found", bookmark)
+	}
+	var code, result string
+	switch format {
+	case CrossRefText:
+		code, result = RefFieldCode(bookmark, true), text
+	case CrossRefPageNumber:
+		code, result = "PAGEREF "+bookmark+` \h`, "1"
+	case CrossRefAboveBelow:
+		code, result = RefFieldCode(bookmark, true)+` \p`, "below"
+	default:
+		return nil, fmt.Errorf("docx: unknown cross-reference format %d", format)
+	}
+	f, err := para.AddField(code, result)
+	if err != nil {
+		return nil, err
+	}
+	if format != CrossRefText {
+		f.SetDirty(true)
+	}
+	return f, nil
+}
@@ -0,0 +1,138 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDocument_AddCaption_NumbersAndRenumbers(t *testing.T) {
+	doc := mustNewDoc(t)
+	tbl1, err := doc.AddTable(1, 1)
+	if err != nil {
+		t.Fatalf("AddTable: %v", err)
+	}
+	tbl2, err := doc.AddTable(1, 1)
+	if err != nil {
+		t.Fatalf("AddTable: %v", err)
+	}
+
+	c2, err := doc.AddCaption(tbl2, "Table", "Totals")
+	if err != nil {
+		t.Fatalf("AddCaption: %v", err)
+	}
+	if c2.Number != 1 {
+		t.Errorf("first caption number = %d, want 1", c2.Number)
+	}
+	// Captioning the earlier table renumbers the later caption.
+	c1, err := doc.AddCaption(tbl1, "Table", "")
+	if err != nil {
+		t.Fatalf("AddCaption: %v", err)
+	}
+	if c1.Number != 1 {
+		t.Errorf("inserted caption number = %d, want 1", c1.Number)
+	}
+	if got := c1.Paragraph.Text(); got != "Table 1" {
+		t.Errorf("caption 1 text = %q", got)
+	}
+	if got := c2.Paragraph.Text(); got != "Table 2: Totals" {
+		t.Errorf("caption 2 text = %q", got)
+	}
+	style, err := c2.Paragraph.Style()
+	if err != nil || style == nil {
+		t.Fatalf("caption style = %v, %v", style, err)
+	}
+	if name, _ := style.NameVal(); name != "caption" {
+		t.Errorf("caption style name = %q, want caption", name)
+	}
+
+	ref, err := doc.AddParagraph("See ")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	f, err := ref.AddCrossReference(c2.Bookmark, CrossRefText)
+	if err != nil {
+		t.Fatalf("AddCrossReference: %v", err)
+	}
+	if got := f.Result(); got != "Table 2" {
+		t.Errorf("cross-reference result = %q, want %q", got, "Table 2")
+	}
+	if _, err := ref.AddCrossReference("_RefMissing", CrossRefText); err == nil {
+		t.Error("expected error for unknown bookmark")
+	}
+	if _, err := doc.AddCaption(tbl1, "Bad Label", ""); err == nil {
+		t.Error("expected error for label with space")
+	}
+}
+
+func TestParagraph_ReferenceBookmark_Heading(t *testing.T) {
+	doc := mustNewDoc(t)
+	h, err := doc.AddParagraph("Introduction")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	name, err := h.ReferenceBookmark()
+	if err != nil {
+		t.Fatalf("ReferenceBookmark: %v", err)
+	}
+	again, _ := h.ReferenceBookmark()
+	if again != name {
+		t.Errorf("ReferenceBookmark not stable: %q then %q", name, again)
+	}
+	if err := h.AddBookmark(name); err == nil {
+		t.Error("expected error for duplicate bookmark")
+	}
+
+	p, _ := doc.AddParagraph("")
+	f, err := p.AddCrossReference(name, CrossRefPageNumber)
+	if err != nil {
+		t.Fatalf("AddCrossReference: %v", err)
+	}
+	if f.Type() != "PAGEREF" || !f.Dirty() {
+		t.Errorf("page reference: type %q dirty %v", f.Type(), f.Dirty())
+	}
+	f, err = p.AddCrossReference(name, CrossRefText)
+	if err != nil {
+		t.Fatalf("AddCrossReference: %v", err)
+	}
+	if got := f.Result(); got != "Introduction" {
+		t.Errorf("heading reference = %q", got)
+	}
+}
+
+func TestDocument_AddCaption_HeaderPicture(t *testing.T) {
+	doc := mustNewDoc(t)
+	hdr := doc.Sections().Iter()[0].Header()
+	if err := hdr.SetIsLinkedToPrevious(false); err != nil {
+		t.Fatal(err)
+	}
+	p, err := hdr.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := p.AddRun("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape, err := r.AddPicture(bytes.NewReader(minimalPNG()), nil, nil)
+	if err != nil {
+		t.Fatalf("AddPicture: %v", err)
+	}
+
+	c, err := doc.AddCaption(shape, "Figure", "Logo")
+	if err != nil {
+		t.Fatalf("AddCaption: %v", err)
+	}
+	if c.Paragraph.part != p.part {
+		t.Error("caption paragraph is not bound to the header part")
+	}
+	if got := c.Paragraph.Text(); got != "Figure 1: Logo" {
+		t.Errorf("caption text = %q", got)
+	}
+	hp, err := hdr.Paragraphs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hp) < 2 || hp[len(hp)-1].Text() != "Figure 1: Logo" {
+		t.Errorf("caption is not the last header paragraph")
+	}
+}
@@ -1,8 +1,12 @@
 package docx
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/vortex/go-docx/pkg/docx/oxml"
 )
 
 // -----------------------------------------------------------------------
@@ -188,3 +192,58 @@ func TestComment_Paragraphs(t *testing.T) {
 		t.Errorf("para[0] = %q, want %q", paras[0].Text(), "para 1")
 	}
 }
+
+func TestDocument_AddCommentAt(t *testing.T) {
+	doc := mustNewDoc(t)
+	para, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := para.AddRun("The quick "); err != nil {
+		t.Fatal(err)
+	}
+	bold, err := para.AddRun("brown fox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	on := true
+	if err := bold.SetBold(&on); err != nil {
+		t.Fatal(err)
+	}
+
+	// "quick brown": from inside the first run to inside the second.
+	if _, err := doc.AddCommentAt(para, 4, 15, "adjectives", "Ann", nil); err != nil {
+		t.Fatalf("AddCommentAt: %v", err)
+	}
+	if got := para.Text(); got != "The quick brown fox" {
+		t.Errorf("text changed: %q", got)
+	}
+	var inRange []string
+	in := false
+	for _, child := range para.p.RawElement().ChildElements() {
+		switch child.Tag {
+		case "commentRangeStart":
+			in = true
+		case "commentRangeEnd":
+			in = false
+		case "r":
+			if in {
+				inRange = append(inRange, newRun(&oxml.CT_R{Element: oxml.WrapElement(child)}, para.part).Text())
+			}
+		}
+	}
+	if strings.Join(inRange, "|") != "quick |brown" {
+		t.Errorf("commented runs = %q, want [quick  brown]", inRange)
+	}
+	for _, r := range para.Runs() {
+		if b := r.Bold(); (r.Text() == "brown" || r.Text() == " fox") && (b == nil || !*b) {
+			t.Errorf("split half %q lost its bold", r.Text())
+		}
+	}
+
+	for _, r := range [][2]int{{5, 5}, {-1, 3}, {0, 100}} {
+		if _, err := doc.AddCommentAt(para, r[0], r[1], "x", "Ann", nil); !errors.Is(err, ErrInvalidIndex) {
+			t.Errorf("range %v: err = %v, want ErrInvalidIndex", r, err)
+		}
+	}
+}
@@ -0,0 +1,1095 @@
+package docx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// ChangeKind classifies a difference found by Compare.
+type ChangeKind int
+
+const (
+	// Unchanged content is the same in both documents.
+	Unchanged ChangeKind = iota
+	// Inserted content exists only in the revised document.
+	Inserted
+	// Deleted content exists only in the original document.
+	Deleted
+	// Modified paragraphs exist in both documents with different text.
+	Modified
+)
+
+// String returns the name of the change kind.
+func (k ChangeKind) String() string {
+	switch k {
+	case Unchanged:
+		return "unchanged"
+	case Inserted:
+		return "inserted"
+	case Deleted:
+		return "deleted"
+	case Modified:
+		return "modified"
+	}
+	return "ChangeKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// TextChange is a stretch of paragraph text and how it changed: Unchanged,
+// Inserted or Deleted.
+type TextChange struct {
+	Kind ChangeKind
+	Text string
+}
+
+// ParagraphChange describes a paragraph of the original document, of the
+// revised document, or of both.
+type ParagraphChange struct {
+	Kind ChangeKind
+	// Original is the paragraph in the original document; nil when
+	// inserted.
+	Original *Paragraph
+	// Revised is the paragraph in the revised document; nil when deleted.
+	Revised *Paragraph
+	// Text lists the changes word by word. For paragraphs that are not
+	// Modified it holds the whole text as a single change.
+	Text []TextChange
+}
+
+// Diff is the result of Compare: the paragraphs of both documents, in
+// document order, including those in table cells.
+type Diff struct {
+	Paragraphs []ParagraphChange
+}
+
+// HasChanges reports whether the documents differ.
+func (d *Diff) HasChanges() bool {
+	for _, p := range d.Paragraphs {
+		if p.Kind != Unchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare compares the body text of two documents, like Word's Compare.
+// Paragraphs are matched first; matched paragraphs whose text differs are
+// then compared word by word. Tables with the same number of rows and
+// cells are compared cell by cell, other tables as a whole. Formatting
+// changes, headers, footers and notes are not compared.
+func Compare(original, revised *Document) (*Diff, error) {
+	return CompareWithOptions(original, revised, nil)
+}
+
+// CompareContext is like Compare but stops with the context's error once
+// ctx is done, which is checked as blocks are aligned and periodically
+// while long sequences are diffed.
+func CompareContext(ctx context.Context, original, revised *Document) (*Diff, error) {
+	return CompareWithOptions(original, revised, &CompareOptions{Context: ctx})
+}
+
+// CompareOptions configures CompareWithOptions.
+type CompareOptions struct {
+	// Context cancels the comparison when it is done; the error returned
+	// then wraps the context's error. nil means it cannot be canceled.
+	Context context.Context
+
+	// Progress, if set, is called as the top-level blocks of both bodies
+	// are indexed ("index") and as their changes are collected ("diff").
+	Progress ProgressFunc
+}
+
+// CompareWithOptions compares two documents as Compare does, applying
+// opts, which may be nil.
+func CompareWithOptions(original, revised *Document, opts *CompareOptions) (*Diff, error) {
+	if opts == nil {
+		opts = &CompareOptions{}
+	}
+	ob, err := original.getBody()
+	if err != nil {
+		return nil, err
+	}
+	rb, err := revised.getBody()
+	if err != nil {
+		return nil, err
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	progress := opts.Progress
+	if progress == nil {
+		progress = func(string, int, int) {}
+	}
+
+	df := &differ{ctx: ctx}
+	a, b := blockElements(ob.element), blockElements(rb.element)
+	indexed := 0
+	step := func() {
+		indexed++
+		progress("index", indexed, len(a)+len(b))
+	}
+	blocks := df.alignBlocks(a, b, blockKeys(a, step), blockKeys(b, step))
+	diff := &Diff{}
+	for i := range blocks {
+		df.flattenChanges(diff, blocks[i:i+1], &original.part.StoryPart, &revised.part.StoryPart)
+		progress("diff", i+1, len(blocks))
+	}
+	if df.err != nil {
+		return nil, fmt.Errorf("docx: comparing documents: %w", df.err)
+	}
+	return diff, nil
+}
+
+// RedlineOptions configures Redline.
+type RedlineOptions struct {
+	// Author is recorded on every tracked change; defaults to "docx".
+	Author string
+	// Date is recorded on every tracked change; defaults to now.
+	Date time.Time
+
+	// Context cancels the comparison when it is done; the error returned
+	// then wraps the context's error. nil means it cannot be canceled.
+	Context context.Context
+}
+
+// Redline compares two documents as Compare does and returns a third:
+// a copy of revised in which the differences are tracked changes, so
+// accepting all changes yields revised and rejecting them all yields the
+// text of original.
+func Redline(original, revised *Document, opts *RedlineOptions) (*Document, error) {
+	if opts == nil {
+		opts = &RedlineOptions{}
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	out, err := revised.Clone()
+	if err != nil {
+		return nil, err
+	}
+	ob, err := original.getBody()
+	if err != nil {
+		return nil, err
+	}
+	outBody, err := out.getBody()
+	if err != nil {
+		return nil, err
+	}
+	rl := &redliner{
+		imp:    newDocImporter(out, original),
+		src:    &original.part.StoryPart,
+		dst:    &out.part.StoryPart,
+		author: opts.Author,
+		date:   opts.Date,
+		nextID: maxWID(outBody.element) + 1,
+	}
+	if rl.author == "" {
+		rl.author = "docx"
+	}
+	if rl.date.IsZero() {
+		rl.date = time.Now()
+	}
+	df := &differ{ctx: ctx}
+	blocks := df.diffBlocks(ob.element, outBody.element)
+	if df.err != nil {
+		return nil, fmt.Errorf("docx: redline: %w", df.err)
+	}
+	if err := rl.apply(outBody.element, blocks); err != nil {
+		return nil, fmt.Errorf("docx: redline: %w", err)
+	}
+	return out, nil
+}
+
+// RedlineContext is like Redline but stops with the context's error once
+// ctx is done, as CompareContext does. It overrides opts.Context.
+func RedlineContext(ctx context.Context, original, revised *Document, opts *RedlineOptions) (*Document, error) {
+	o := RedlineOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	o.Context = ctx
+	return Redline(original, revised, &o)
+}
+
+// --------------------------------------------------------------------------
+// Block alignment
+// --------------------------------------------------------------------------
+
+// blockChange pairs a block (w:p or w:tbl) of the original container with
+// one of the revised container.
+type blockChange struct {
+	kind      ChangeKind
+	orig, rev *etree.Element
+	words     []tokenOp       // Modified paragraphs
+	cells     [][]blockChange // Modified tables, one per cell in row order
+}
+
+// differ aligns blocks, giving up once its context is done.
+type differ struct {
+	ctx context.Context
+	err error // the context's error, once it is done
+}
+
+// done reports whether the context is done, recording its error.
+func (df *differ) done() bool {
+	if df.err == nil {
+		df.err = df.ctx.Err()
+	}
+	return df.err != nil
+}
+
+// diffBlocks aligns the paragraphs and tables directly under two
+// containers.
+func (df *differ) diffBlocks(origEl, revEl *etree.Element) []blockChange {
+	a, b := blockElements(origEl), blockElements(revEl)
+	return df.alignBlocks(a, b, blockKeys(a, nil), blockKeys(b, nil))
+}
+
+// blockKeys returns the keys of blocks, calling step, if set, after each.
+func blockKeys(blocks []*etree.Element, step func()) []string {
+	keys := make([]string, len(blocks))
+	for i, el := range blocks {
+		keys[i] = blockKey(el)
+		if step != nil {
+			step()
+		}
+	}
+	return keys
+}
+
+// alignBlocks aligns blocks a and b by their keys ak and bk. It returns
+// nil once the context is done.
+func (df *differ) alignBlocks(a, b []*etree.Element, ak, bk []string) []blockChange {
+	if df.done() {
+		return nil
+	}
+	var result []blockChange
+	var dels, inss []*etree.Element
+	flush := func() {
+		result = append(result, df.pairBlocks(dels, inss)...)
+		dels, inss = nil, nil
+	}
+	for _, op := range df.diffKeys(ak, bk) {
+		switch op.kind {
+		case Deleted:
+			dels = append(dels, a[op.i])
+		case Inserted:
+			inss = append(inss, b[op.j])
+		default:
+			flush()
+			result = append(result, blockChange{kind: Unchanged, orig: a[op.i], rev: b[op.j]})
+		}
+	}
+	flush()
+	return result
+}
+
+// pairBlocks matches the deleted and inserted blocks of one gap between
+// unchanged blocks: in order, paragraphs with similar text and tables of
+// the same shape become Modified; the rest stay deleted or inserted.
+func (df *differ) pairBlocks(dels, inss []*etree.Element) []blockChange {
+	var result []blockChange
+	j := 0
+	for _, d := range dels {
+		if df.done() {
+			return result
+		}
+		paired := false
+		for k := j; k < len(inss) && !paired; k++ {
+			ch, ok := df.modifiedBlock(d, inss[k])
+			if !ok {
+				continue
+			}
+			for _, ins := range inss[j:k] {
+				result = append(result, blockChange{kind: Inserted, rev: ins})
+			}
+			result = append(result, ch)
+			j, paired = k+1, true
+		}
+		if !paired {
+			result = append(result, blockChange{kind: Deleted, orig: d})
+		}
+	}
+	for _, ins := range inss[j:] {
+		result = append(result, blockChange{kind: Inserted, rev: ins})
+	}
+	return result
+}
+
+// modifiedBlock compares two blocks found in the same gap and reports
+// whether they are versions of one another.
+func (df *differ) modifiedBlock(a, b *etree.Element) (blockChange, bool) {
+	switch {
+	case a.Tag == "p" && b.Tag == "p":
+		at, bt := paragraphTokens(a), paragraphTokens(b)
+		ops := df.diffKeys(tokenKeys(at), tokenKeys(bt))
+		same, total := 0, len(at)+len(bt)
+		for _, op := range ops {
+			if op.kind == Unchanged {
+				same += 2
+			}
+		}
+		if total > 0 && float64(same)/float64(total) < 0.5 {
+			return blockChange{}, false
+		}
+		return blockChange{kind: Modified, orig: a, rev: b, words: tokenOps(ops, at, bt)}, true
+	case a.Tag == "tbl" && b.Tag == "tbl":
+		ac, bc := tableCells(a), tableCells(b)
+		if ac == nil || len(ac) != len(bc) {
+			return blockChange{}, false
+		}
+		ch := blockChange{kind: Modified, orig: a, rev: b}
+		for i := range ac {
+			if len(ac[i]) != len(bc[i]) {
+				return blockChange{}, false
+			}
+			for c := range ac[i] {
+				ch.cells = append(ch.cells, df.diffBlocks(ac[i][c], bc[i][c]))
+			}
+		}
+		return ch, true
+	}
+	return blockChange{}, false
+}
+
+// blockElements returns the w:p and w:tbl children of container.
+func blockElements(container *etree.Element) []*etree.Element {
+	var result []*etree.Element
+	for _, child := range container.ChildElements() {
+		if child.Space == "w" && (child.Tag == "p" || child.Tag == "tbl") {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+// blockKey returns the text by which blocks are matched.
+func blockKey(el *etree.Element) string {
+	if el.Tag == "p" {
+		return "p\x00" + strings.Join(tokenKeys(paragraphTokens(el)), "\x00")
+	}
+	var sb strings.Builder
+	sb.WriteString("t")
+	for _, row := range tableCells(el) {
+		sb.WriteString("\x01")
+		for _, cell := range row {
+			sb.WriteString("\x02")
+			for _, b := range blockElements(cell) {
+				sb.WriteString(blockKey(b))
+				sb.WriteString("\x03")
+			}
+		}
+	}
+	return sb.String()
+}
+
+// tableCells returns the w:tc elements of tbl by row.
+func tableCells(tbl *etree.Element) [][]*etree.Element {
+	var rows [][]*etree.Element
+	for _, tr := range tbl.SelectElements("w:tr") {
+		rows = append(rows, tr.SelectElements("w:tc"))
+	}
+	return rows
+}
+
+// --------------------------------------------------------------------------
+// Paragraph tokens
+// --------------------------------------------------------------------------
+
+// token is a word, a stretch of spaces or punctuation, or a non-text run
+// item (tab, break, drawing, ...) of a paragraph.
+type token struct {
+	key  string
+	text string         // text of word tokens
+	el   *etree.Element // non-text run item
+	rPr  *etree.Element // properties of the run holding the token
+	// marks are bookmark boundaries found just before the token.
+	marks []*etree.Element
+}
+
+// tokenOp is a diffKeys result resolved to its tokens.
+type tokenOp struct {
+	kind ChangeKind
+	tok  *token
+}
+
+// paragraphTokens splits the text and run content of p into tokens.
+func paragraphTokens(p *etree.Element) []*token {
+	var result []*token
+	var marks []*etree.Element
+	var walk func(el *etree.Element)
+	walk = func(el *etree.Element) {
+		for _, child := range el.ChildElements() {
+			if child.Space != "w" {
+				continue
+			}
+			switch child.Tag {
+			case "bookmarkStart", "bookmarkEnd":
+				marks = append(marks, child)
+			case "r":
+				rPr := child.SelectElement("w:rPr")
+				for _, item := range child.ChildElements() {
+					for _, tok := range runItemTokens(item) {
+						tok.rPr = rPr
+						tok.marks, marks = marks, nil
+						result = append(result, tok)
+					}
+				}
+			case "pPr", "proofErr":
+			default:
+				walk(child)
+			}
+		}
+	}
+	walk(p)
+	if len(marks) > 0 {
+		result = append(result, &token{marks: marks})
+	}
+	return result
+}
+
+// runItemTokens returns the tokens of a run child.
+func runItemTokens(item *etree.Element) []*token {
+	if item.Space != "w" {
+		return []*token{{key: "x:" + oxml.SerializeForReading(item), el: item}}
+	}
+	switch item.Tag {
+	case "rPr", "lastRenderedPageBreak":
+		return nil
+	case "t":
+		var result []*token
+		for _, w := range splitWords(item.Text()) {
+			result = append(result, &token{key: "t:" + w, text: w})
+		}
+		return result
+	case "tab", "cr", "noBreakHyphen", "softHyphen":
+		return []*token{{key: item.Tag, el: item}}
+	}
+	return []*token{{key: "x:" + oxml.SerializeForReading(item), el: item}}
+}
+
+// splitWords splits s into words, runs of white space and single other
+// characters.
+func splitWords(s string) []string {
+	var result []string
+	class := func(r rune) int {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return 1
+		case unicode.IsSpace(r):
+			return 2
+		}
+		return 0
+	}
+	start := 0
+	prev := -1
+	for i, r := range s {
+		c := class(r)
+		if i > start && (c != prev || c == 0) {
+			result = append(result, s[start:i])
+			start = i
+		}
+		prev = c
+	}
+	if start < len(s) {
+		result = append(result, s[start:])
+	}
+	return result
+}
+
+// tokenKeys returns the keys of the tokens that take part in matching.
+func tokenKeys(toks []*token) []string {
+	keys := make([]string, 0, len(toks))
+	for _, t := range toks {
+		keys = append(keys, t.key)
+	}
+	return keys
+}
+
+// tokenOps resolves the ops of df.diffKeys(tokenKeys(a), tokenKeys(b)).
+func tokenOps(ops []diffOp, a, b []*token) []tokenOp {
+	result := make([]tokenOp, 0, len(ops))
+	for _, op := range ops {
+		if op.kind == Deleted {
+			result = append(result, tokenOp{kind: Deleted, tok: a[op.i]})
+		} else {
+			result = append(result, tokenOp{kind: op.kind, tok: b[op.j]})
+		}
+	}
+	return result
+}
+
+// tokenText returns the text a token contributes to Paragraph.Text.
+func tokenText(t *token) string {
+	if t.el == nil {
+		return t.text
+	}
+	switch t.el.Tag {
+	case "tab":
+		return "\t"
+	case "cr", "br":
+		return "\n"
+	case "noBreakHyphen":
+		return oxml.NoBreakHyphen
+	case "softHyphen":
+		return oxml.SoftHyphen
+	}
+	return ""
+}
+
+// --------------------------------------------------------------------------
+// Structured diff
+// --------------------------------------------------------------------------
+
+// flattenChanges appends the paragraph changes of blocks to diff.
+func (df *differ) flattenChanges(diff *Diff, blocks []blockChange, op, rp *parts.StoryPart) {
+	para := func(el *etree.Element, part *parts.StoryPart) *Paragraph {
+		return newParagraph(&oxml.CT_P{Element: oxml.WrapElement(el)}, part)
+	}
+	whole := func(kind ChangeKind, el *etree.Element, part *parts.StoryPart) {
+		var ps []*etree.Element
+		if el.Tag == "p" {
+			ps = []*etree.Element{el}
+		} else {
+			ps = el.FindElements(".//w:p")
+		}
+		for _, p := range ps {
+			pc := ParagraphChange{Kind: kind}
+			if kind == Deleted {
+				pc.Original = para(p, part)
+			} else {
+				pc.Revised = para(p, part)
+			}
+			text := pc.paragraph().Text()
+			if text != "" {
+				pc.Text = []TextChange{{Kind: kind, Text: text}}
+			}
+			diff.Paragraphs = append(diff.Paragraphs, pc)
+		}
+	}
+
+	for _, bc := range blocks {
+		switch {
+		case bc.kind == Deleted:
+			whole(Deleted, bc.orig, op)
+		case bc.kind == Inserted:
+			whole(Inserted, bc.rev, rp)
+		case bc.kind == Modified && bc.orig.Tag == "tbl":
+			for _, cell := range bc.cells {
+				df.flattenChanges(diff, cell, op, rp)
+			}
+		case bc.kind == Modified:
+			pc := ParagraphChange{Kind: Modified, Original: para(bc.orig, op), Revised: para(bc.rev, rp)}
+			for _, w := range bc.words {
+				text := tokenText(w.tok)
+				if text == "" {
+					continue
+				}
+				if n := len(pc.Text); n > 0 && pc.Text[n-1].Kind == w.kind {
+					pc.Text[n-1].Text += text
+				} else {
+					pc.Text = append(pc.Text, TextChange{Kind: w.kind, Text: text})
+				}
+			}
+			diff.Paragraphs = append(diff.Paragraphs, pc)
+		default:
+			if bc.orig.Tag == "p" {
+				pc := ParagraphChange{Kind: Unchanged, Original: para(bc.orig, op), Revised: para(bc.rev, rp)}
+				if text := pc.Revised.Text(); text != "" {
+					pc.Text = []TextChange{{Kind: Unchanged, Text: text}}
+				}
+				diff.Paragraphs = append(diff.Paragraphs, pc)
+				continue
+			}
+			oc, rc := tableCells(bc.orig), tableCells(bc.rev)
+			for i := range rc {
+				for c := range rc[i] {
+					df.flattenChanges(diff, df.diffBlocks(oc[i][c], rc[i][c]), op, rp)
+				}
+			}
+		}
+	}
+}
+
+// paragraph returns the paragraph the change is about, preferring the
+// revised one.
+func (pc *ParagraphChange) paragraph() *Paragraph {
+	if pc.Revised != nil {
+		return pc.Revised
+	}
+	return pc.Original
+}
+
+// --------------------------------------------------------------------------
+// Tracked changes
+// --------------------------------------------------------------------------
+
+// redliner writes a block alignment into the revised document as tracked
+// changes.
+type redliner struct {
+	imp      *docImporter
+	src, dst *parts.StoryPart
+	author   string
+	date     time.Time
+	nextID   int
+}
+
+// apply marks the changes of blocks in container, a body or cell of the
+// revised copy, inserting copies of the deleted original blocks.
+func (rl *redliner) apply(container *etree.Element, blocks []blockChange) error {
+	var prev *etree.Element
+	for _, bc := range blocks {
+		switch {
+		case bc.kind == Deleted:
+			el, err := rl.insertDeleted(container, prev, bc.orig)
+			if err != nil {
+				return err
+			}
+			prev = el
+			continue
+		case bc.kind == Inserted:
+			rl.markBlock(bc.rev, "ins")
+		case bc.kind == Modified && bc.rev.Tag == "tbl":
+			i := 0
+			for _, row := range tableCells(bc.rev) {
+				for _, cell := range row {
+					if err := rl.apply(cell, bc.cells[i]); err != nil {
+						return err
+					}
+					i++
+				}
+			}
+		case bc.kind == Modified && simpleParagraph(bc.orig) && simpleParagraph(bc.rev):
+			if err := rl.rewriteParagraph(bc.rev, bc.words); err != nil {
+				return err
+			}
+		case bc.kind == Modified:
+			// Hyperlinks, fields and the like cannot be split word by
+			// word; replace the whole paragraph instead.
+			if _, err := rl.insertDeleted(container, prev, bc.orig); err != nil {
+				return err
+			}
+			rl.markBlock(bc.rev, "ins")
+		}
+		prev = bc.rev
+	}
+	return nil
+}
+
+// insertDeleted inserts a deleted copy of orig, a block of the original
+// document, into container after prev.
+func (rl *redliner) insertDeleted(container, prev, orig *etree.Element) (*etree.Element, error) {
+	el := orig.Copy()
+	for _, sectPr := range el.FindElements(".//w:pPr/w:sectPr") {
+		sectPr.Parent().RemoveChild(sectPr)
+	}
+	if err := rl.importOriginal(el); err != nil {
+		return nil, err
+	}
+	insertBlockAfter(container, prev, el)
+	rl.markBlock(el, "del")
+	return el, nil
+}
+
+// simpleParagraph reports whether p holds only plain runs and bookmarks,
+// so rewriteParagraph can rebuild it from its tokens.
+func simpleParagraph(p *etree.Element) bool {
+	for _, child := range p.ChildElements() {
+		if child.Space != "w" {
+			return false
+		}
+		switch child.Tag {
+		case "pPr", "proofErr", "bookmarkStart", "bookmarkEnd":
+		case "r":
+			for _, item := range child.ChildElements() {
+				if item.Space == "w" && (item.Tag == "fldChar" || item.Tag == "instrText") {
+					return false
+				}
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// importOriginal adapts content copied from the original document to the
+// revised copy.
+func (rl *redliner) importOriginal(el *etree.Element) error {
+	wrapper := etree.NewElement("wrapper")
+	wrapper.AddChild(el)
+	err := rl.imp.importStory(wrapper, rl.src, rl.dst)
+	wrapper.RemoveChild(el)
+	return err
+}
+
+// insertBlockAfter inserts el into container after prev, or before the
+// first block when prev is nil.
+func insertBlockAfter(container, prev, el *etree.Element) {
+	if prev != nil {
+		container.InsertChildAt(childIndex(container, prev)+1, el)
+		return
+	}
+	for _, child := range container.ChildElements() {
+		if child.Space == "w" && (child.Tag == "p" || child.Tag == "tbl" || child.Tag == "sectPr") {
+			container.InsertChildAt(childIndex(container, child), el)
+			return
+		}
+	}
+	container.AddChild(el)
+}
+
+// mark returns a new w:ins or w:del revision element.
+func (rl *redliner) mark(tag string) *etree.Element {
+	el := etree.NewElement("w:" + tag)
+	el.CreateAttr("w:id", strconv.Itoa(rl.nextID))
+	el.CreateAttr("w:author", rl.author)
+	el.CreateAttr("w:date", rl.date.UTC().Format("2006-01-02T15:04:05Z"))
+	rl.nextID++
+	return el
+}
+
+// markBlock marks a whole paragraph or table as inserted or deleted (tag
+// "ins" or "del").
+func (rl *redliner) markBlock(el *etree.Element, tag string) {
+	for _, tr := range el.FindElements(".//w:tr") {
+		trPr := tr.SelectElement("w:trPr")
+		if trPr == nil {
+			trPr = etree.NewElement("w:trPr")
+			idx := 0
+			if ex := tr.SelectElement("w:tblPrEx"); ex != nil {
+				idx = childIndex(tr, ex) + 1
+			}
+			tr.InsertChildAt(idx, trPr)
+		}
+		trPr.AddChild(rl.mark(tag))
+	}
+	ps := el.FindElements(".//w:p")
+	if el.Tag == "p" {
+		ps = append(ps, el)
+	}
+	for _, p := range ps {
+		for _, r := range p.FindElements(".//w:r") {
+			if parent := r.Parent(); parent.Space == "w" && (parent.Tag == "ins" || parent.Tag == "del") {
+				continue
+			}
+			wrap := rl.mark(tag)
+			r.Parent().InsertChildAt(childIndex(r.Parent(), r), wrap)
+			r.Parent().RemoveChild(r)
+			wrap.AddChild(r)
+			if tag == "del" {
+				toDeletedText(r)
+			}
+		}
+		rl.markParagraphMark(p, tag)
+	}
+}
+
+// markParagraphMark marks the paragraph mark of p as inserted or deleted.
+func (rl *redliner) markParagraphMark(p *etree.Element, tag string) {
+	paragraphMarkRPr(p).InsertChildAt(0, rl.mark(tag))
+}
+
+// paragraphMarkRPr returns the run properties of the paragraph mark of p,
+// adding them, and the paragraph properties, if missing.
+func paragraphMarkRPr(p *etree.Element) *etree.Element {
+	pPr := p.SelectElement("w:pPr")
+	if pPr == nil {
+		pPr = etree.NewElement("w:pPr")
+		p.InsertChildAt(0, pPr)
+	}
+	rPr := pPr.SelectElement("w:rPr")
+	if rPr == nil {
+		rPr = etree.NewElement("w:rPr")
+		idx := len(pPr.Child)
+		for _, tag := range []string{"sectPr", "pPrChange"} {
+			if el := pPr.SelectElement("w:" + tag); el != nil && childIndex(pPr, el) < idx {
+				idx = childIndex(pPr, el)
+			}
+		}
+		pPr.InsertChildAt(idx, rPr)
+	}
+	return rPr
+}
+
+// toDeletedText turns the text of run r into deleted text.
+func toDeletedText(r *etree.Element) {
+	for _, child := range r.ChildElements() {
+		if child.Space != "w" {
+			continue
+		}
+		switch child.Tag {
+		case "t":
+			child.Tag = "delText"
+		case "instrText":
+			child.Tag = "delInstrText"
+		}
+	}
+}
+
+// rewriteParagraph replaces the runs of the revised paragraph p with the
+// word-level changes ops, deleted words coming from the original.
+func (rl *redliner) rewriteParagraph(p *etree.Element, ops []tokenOp) error {
+	for _, child := range p.ChildElements() {
+		if !(child.Space == "w" && child.Tag == "pPr") {
+			p.RemoveChild(child)
+		}
+	}
+
+	var wrap, run *etree.Element
+	var wrapKind ChangeKind
+	var runRPr *etree.Element
+	var text *etree.Element
+	for _, op := range ops {
+		tok := op.tok
+		if op.kind != Deleted {
+			for _, m := range tok.marks {
+				p.AddChild(m)
+				wrap, run = nil, nil
+			}
+		}
+		if tok.key == "" {
+			continue
+		}
+		if wrap == nil && run == nil || op.kind != wrapKind {
+			wrapKind = op.kind
+			wrap, run = nil, nil
+			if op.kind != Unchanged {
+				wrap = rl.mark(map[ChangeKind]string{Inserted: "ins", Deleted: "del"}[op.kind])
+				p.AddChild(wrap)
+			}
+		}
+		if run == nil || tok.rPr != runRPr {
+			run = etree.NewElement("w:r")
+			if tok.rPr != nil {
+				run.AddChild(tok.rPr.Copy())
+			}
+			runRPr, text = tok.rPr, nil
+			if wrap != nil {
+				wrap.AddChild(run)
+			} else {
+				p.AddChild(run)
+			}
+		}
+		if tok.el != nil {
+			run.AddChild(tok.el.Copy())
+			text = nil
+		} else {
+			if text == nil {
+				tag := "w:t"
+				if op.kind == Deleted {
+					tag = "w:delText"
+				}
+				text = run.CreateElement(tag)
+				text.CreateAttr("xml:space", "preserve")
+			}
+			text.SetText(text.Text() + tok.text)
+		}
+	}
+
+	// Deleted words come from the original document.
+	for _, del := range p.SelectElements("w:del") {
+		if err := rl.importOriginalRuns(del); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importOriginalRuns adapts the runs of a w:del element built from the
+// original document.
+func (rl *redliner) importOriginalRuns(del *etree.Element) error {
+	wrapper := etree.NewElement("wrapper")
+	parent := del.Parent()
+	idx := childIndex(parent, del)
+	parent.RemoveChild(del)
+	wrapper.AddChild(del)
+	err := rl.imp.importStory(wrapper, rl.src, rl.dst)
+	wrapper.RemoveChild(del)
+	parent.InsertChildAt(idx, del)
+	return err
+}
+
+// maxWID returns the largest numeric w:id under root.
+func maxWID(root *etree.Element) int {
+	maxID := 0
+	stack := []*etree.Element{root}
+	for len(stack) > 0 {
+		el := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if v, err := strconv.Atoi(el.SelectAttrValue("w:id", "")); err == nil && v > maxID {
+			maxID = v
+		}
+		stack = append(stack, el.ChildElements()...)
+	}
+	return maxID
+}
+
+// --------------------------------------------------------------------------
+// Sequence diff
+// --------------------------------------------------------------------------
+
+// diffOp is one step of an edit script: a[i] equals b[j] (Unchanged), a[i]
+// is deleted, or b[j] is inserted.
+type diffOp struct {
+	kind ChangeKind
+	i, j int
+}
+
+// maxEditDistance bounds the work of diffKeys; sequences further apart are
+// reported as replaced wholesale.
+const maxEditDistance = 2000
+
+// cancelCheckRounds is how many rounds of myers run between checks of the
+// context; a round costs O(d) for edit distance d.
+const cancelCheckRounds = 64
+
+// diffKeys returns a shortest edit script turning a into b (Myers'
+// algorithm), deletions before insertions within each change. It returns
+// nil once the context is done.
+func (df *differ) diffKeys(a, b []string) []diffOp {
+	var ops []diffOp
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		ops = append(ops, diffOp{Unchanged, pre, pre})
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+	mid := df.myers(a[pre:len(a)-suf], b[pre:len(b)-suf], pre)
+	if df.err != nil {
+		return nil
+	}
+	ops = append(ops, mid...)
+	for k := suf; k > 0; k-- {
+		ops = append(ops, diffOp{Unchanged, len(a) - k, len(b) - k})
+	}
+	return ops
+}
+
+// myers diffs a and b, reporting indices shifted by off. It checks the
+// context every cancelCheckRounds rounds and returns nil once it is done.
+func (df *differ) myers(a, b []string, off int) []diffOp {
+	n, m := len(a), len(b)
+	wholesale := func() []diffOp {
+		ops := make([]diffOp, 0, n+m)
+		for i := range a {
+			ops = append(ops, diffOp{Deleted, off + i, -1})
+		}
+		for j := range b {
+			ops = append(ops, diffOp{Inserted, -1, off + j})
+		}
+		return ops
+	}
+	if n == 0 || m == 0 {
+		return wholesale()
+	}
+
+	// trace[d] holds v for diagonals -d-1..d+1 before round d.
+	v := map[int]int{1: 0}
+	var trace []map[int]int
+	found := -1
+	for d := 0; d <= n+m && found < 0; d++ {
+		if d > maxEditDistance {
+			return wholesale()
+		}
+		if d%cancelCheckRounds == 0 && df.done() {
+			return nil
+		}
+		snap := make(map[int]int, 2*d+3)
+		for k := -d - 1; k <= d+1; k++ {
+			if x, ok := v[k]; ok {
+				snap[k] = x
+			}
+		}
+		trace = append(trace, snap)
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[k-1] < v[k+1]) {
+				x = v[k+1]
+			} else {
+				x = v[k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[k] = x
+			if x >= n && y >= m {
+				found = d
+				break
+			}
+		}
+	}
+
+	var rev []diffOp
+	x, y := n, m
+	for d := found; d > 0; d-- {
+		vd := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && vd[k-1] < vd[k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := vd[prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			rev = append(rev, diffOp{Unchanged, off + x, off + y})
+		}
+		if x == prevX {
+			y--
+			rev = append(rev, diffOp{Inserted, -1, off + y})
+		} else {
+			x--
+			rev = append(rev, diffOp{Deleted, off + x, -1})
+		}
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		rev = append(rev, diffOp{Unchanged, off + x, off + y})
+	}
+
+	ops := make([]diffOp, 0, len(rev))
+	for i := len(rev) - 1; i >= 0; i-- {
+		ops = append(ops, rev[i])
+	}
+	// Put deletions before insertions within each change.
+	for i := 0; i < len(ops); {
+		if ops[i].kind == Unchanged {
+			i++
+			continue
+		}
+		j := i
+		for j < len(ops) && ops[j].kind != Unchanged {
+			j++
+		}
+		var dels, inss []diffOp
+		for _, op := range ops[i:j] {
+			if op.kind == Deleted {
+				dels = append(dels, op)
+			} else {
+				inss = append(inss, op)
+			}
+		}
+		copy(ops[i:], append(dels, inss...))
+		i = j
+	}
+	return ops
+}
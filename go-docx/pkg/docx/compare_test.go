@@ -0,0 +1,226 @@
+package docx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// compareDocs builds an original and a revised document from paragraph
+// texts.
+func compareDocs(t *testing.T, original, revised []string) (*Document, *Document) {
+	t.Helper()
+	build := func(texts []string) *Document {
+		d := mustNewDoc(t)
+		for _, text := range texts {
+			if _, err := d.AddParagraph(text); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return d
+	}
+	return build(original), build(revised)
+}
+
+func TestCompare(t *testing.T) {
+	orig, rev := compareDocs(t,
+		[]string{"Intro", "The quick brown fox.", "Removed paragraph", "Outro"},
+		[]string{"Intro", "The slow brown fox!", "Outro", "Added paragraph"})
+
+	diff, err := Compare(orig, rev)
+	if err != nil {
+		t.Fatalf("Compare: %v", err)
+	}
+	if !diff.HasChanges() {
+		t.Fatal("HasChanges = false")
+	}
+	var kinds []ChangeKind
+	for _, pc := range diff.Paragraphs {
+		kinds = append(kinds, pc.Kind)
+	}
+	want := []ChangeKind{Unchanged, Modified, Deleted, Unchanged, Inserted}
+	if !reflect.DeepEqual(kinds, want) {
+		t.Fatalf("kinds = %v, want %v", kinds, want)
+	}
+	got := diff.Paragraphs[1].Text
+	wantText := []TextChange{
+		{Unchanged, "The "}, {Deleted, "quick"}, {Inserted, "slow"},
+		{Unchanged, " brown fox"}, {Deleted, "."}, {Inserted, "!"},
+	}
+	if !reflect.DeepEqual(got, wantText) {
+		t.Errorf("modified text = %v, want %v", got, wantText)
+	}
+	if diff.Paragraphs[2].Original.Text() != "Removed paragraph" || diff.Paragraphs[2].Revised != nil {
+		t.Errorf("deleted paragraph = %+v", diff.Paragraphs[2])
+	}
+
+	same, err := Compare(orig, orig)
+	if err != nil || same.HasChanges() {
+		t.Errorf("Compare(orig, orig) HasChanges = %v, %v", same.HasChanges(), err)
+	}
+}
+
+func TestCompare_Tables(t *testing.T) {
+	orig, rev := compareDocs(t, nil, nil)
+	for i, d := range []*Document{orig, rev} {
+		tbl, err := d.AddTable(1, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cells := []string{"left", "right"}
+		if i == 1 {
+			cells[1] = "right side"
+		}
+		for c, text := range cells {
+			cell, err := tbl.CellAt(0, c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cell.SetText(text)
+		}
+	}
+	diff, err := Compare(orig, rev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var modified []string
+	for _, pc := range diff.Paragraphs {
+		if pc.Kind == Modified {
+			modified = append(modified, pc.Revised.Text())
+		}
+	}
+	if !reflect.DeepEqual(modified, []string{"right side"}) {
+		t.Errorf("modified paragraphs = %q", modified)
+	}
+}
+
+func TestRedline(t *testing.T) {
+	orig, rev := compareDocs(t,
+		[]string{"Intro", "The quick brown fox.", "Removed paragraph", "Outro"},
+		[]string{"Intro", "The slow brown fox!", "Outro", "Added paragraph"})
+	revXML := rev.element.RawElement().Copy()
+	date := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	out, err := Redline(orig, rev, &RedlineOptions{Author: "Ann", Date: date})
+	if err != nil {
+		t.Fatalf("Redline: %v", err)
+	}
+	if oxml.SerializeForReading(revXML) != oxml.SerializeForReading(rev.element.RawElement()) {
+		t.Error("revised document was modified")
+	}
+
+	body, err := out.getBody()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ins := body.element.FindElements(".//w:ins")
+	dels := body.element.FindElements(".//w:del")
+	if len(ins) == 0 || len(dels) == 0 {
+		t.Fatalf("ins = %d, del = %d", len(ins), len(dels))
+	}
+	for _, el := range append(ins, dels...) {
+		if el.SelectAttrValue("w:author", "") != "Ann" || el.SelectAttrValue("w:date", "") != "2024-05-01T12:00:00Z" {
+			t.Errorf("revision attributes = %v", el.Attr)
+		}
+	}
+
+	// Accepting the changes gives the revised text, rejecting them the
+	// original text.
+	text := func(skip string) []string {
+		var result []string
+		for _, p := range body.element.SelectElements("w:p") {
+			if p.FindElement("./w:pPr/w:rPr/w:"+skip) != nil {
+				continue
+			}
+			var sb strings.Builder
+			var walk func(el *etree.Element, inside string)
+			walk = func(el *etree.Element, inside string) {
+				for _, child := range el.ChildElements() {
+					switch {
+					case child.Tag == "ins" || child.Tag == "del":
+						walk(child, child.Tag)
+					case child.Tag == "t" && inside != skip:
+						sb.WriteString(child.Text())
+					case child.Tag == "delText" && skip != "del":
+						sb.WriteString(child.Text())
+					default:
+						walk(child, inside)
+					}
+				}
+			}
+			walk(p, "")
+			result = append(result, sb.String())
+		}
+		return result
+	}
+	if got := text("del"); !reflect.DeepEqual(got, []string{"Intro", "The slow brown fox!", "Outro", "Added paragraph"}) {
+		t.Errorf("accepted = %q", got)
+	}
+	if got := text("ins"); !reflect.DeepEqual(got, []string{"Intro", "The quick brown fox.", "Removed paragraph", "Outro"}) {
+		t.Errorf("rejected = %q", got)
+	}
+
+	var buf bytes.Buffer
+	if err := out.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if _, err := OpenBytes(buf.Bytes()); err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+}
+
+func TestDiffKeys(t *testing.T) {
+	a := strings.Split("a b c e f", " ")
+	b := strings.Split("a c d e g", " ")
+	var got []string
+	df := &differ{ctx: context.Background()}
+	for _, op := range df.diffKeys(a, b) {
+		switch op.kind {
+		case Unchanged:
+			got = append(got, " "+a[op.i])
+		case Deleted:
+			got = append(got, "-"+a[op.i])
+		case Inserted:
+			got = append(got, "+"+b[op.j])
+		}
+	}
+	want := []string{" a", "-b", " c", "+d", " e", "-f", "+g"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffKeys = %q, want %q", got, want)
+	}
+}
+
+// cancelAfter is a context whose Err reports it canceled after n calls.
+type cancelAfter struct {
+	context.Context
+	n int
+}
+
+func (c *cancelAfter) Err() error {
+	if c.n--; c.n < 0 {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestDiffKeys_Canceled(t *testing.T) {
+	var a, b []string
+	for i := range 500 {
+		a = append(a, fmt.Sprint("a", i))
+		b = append(b, fmt.Sprint("b", i))
+	}
+	// The first check passes; the context is done by the next, which
+	// myers makes within the diff.
+	df := &differ{ctx: &cancelAfter{Context: context.Background(), n: 1}}
+	if ops := df.diffKeys(a, b); ops != nil || !errors.Is(df.err, context.Canceled) {
+		t.Errorf("diffKeys = %d ops, err %v; want none, context.Canceled", len(ops), df.err)
+	}
+}
@@ -0,0 +1,328 @@
+package docx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// ContentControl is a content control (structured document tag, w:sdt): a
+// region of the document identified by a tag and title, often a form field
+// and possibly bound to a node of a custom XML part.
+type ContentControl struct {
+	sdt *etree.Element
+	doc *Document
+}
+
+// DataBinding links a content control to a node of a custom XML part.
+type DataBinding struct {
+	// StoreItemID is the item ID of the custom XML part.
+	StoreItemID string
+	// XPath selects the node, e.g. /ns0:order[1]/ns0:customer[1].
+	XPath string
+	// PrefixMappings declares the prefixes XPath uses, e.g.
+	// "xmlns:ns0='urn:orders'".
+	PrefixMappings string
+}
+
+// ContentControls returns the content controls of the body, headers and
+// footers, outer controls before the controls nested in them.
+func (d *Document) ContentControls() []*ContentControl {
+	var result []*ContentControl
+	for _, part := range d.wmlPkg.IterParts() {
+		var sp *parts.StoryPart
+		switch p := part.(type) {
+		case *parts.DocumentPart:
+			sp = &p.StoryPart
+		case *parts.HeaderPart:
+			sp = &p.StoryPart
+		case *parts.FooterPart:
+			sp = &p.StoryPart
+		default:
+			continue
+		}
+		if sp.Element() == nil {
+			continue
+		}
+		for _, sdt := range sp.Element().FindElements(".//w:sdt") {
+			result = append(result, &ContentControl{sdt: sdt, doc: d})
+		}
+	}
+	return result
+}
+
+// ContentControlsByTag returns the content controls with the given tag.
+func (d *Document) ContentControlsByTag(tag string) []*ContentControl {
+	var result []*ContentControl
+	for _, cc := range d.ContentControls() {
+		if cc.Tag() == tag {
+			result = append(result, cc)
+		}
+	}
+	return result
+}
+
+// properties returns the w:sdtPr element, creating it if absent.
+func (c *ContentControl) properties() *etree.Element {
+	if pr := c.sdt.SelectElement("w:sdtPr"); pr != nil {
+		return pr
+	}
+	pr := etree.NewElement("w:sdtPr")
+	c.sdt.InsertChildAt(0, pr)
+	return pr
+}
+
+// property returns the w:val of the sdtPr child tag, or "".
+func (c *ContentControl) property(tag string) string {
+	if el := c.sdt.FindElement("w:sdtPr/w:" + tag); el != nil {
+		return el.SelectAttrValue("w:val", "")
+	}
+	return ""
+}
+
+// Tag returns the tag identifying the control to programs, or "".
+func (c *ContentControl) Tag() string { return c.property("tag") }
+
+// Title returns the title shown to the user (w:alias), or "".
+func (c *ContentControl) Title() string { return c.property("alias") }
+
+// IsShowingPlaceholder reports whether the control shows its placeholder
+// text rather than content.
+func (c *ContentControl) IsShowingPlaceholder() bool {
+	return c.sdt.FindElement("w:sdtPr/w:showingPlcHdr") != nil
+}
+
+// content returns the w:sdtContent element, creating it if absent.
+func (c *ContentControl) content() *etree.Element {
+	if content := c.sdt.SelectElement("w:sdtContent"); content != nil {
+		return content
+	}
+	return c.sdt.CreateElement("w:sdtContent")
+}
+
+// Text returns the text of the control, paragraphs separated by "\n".
+func (c *ContentControl) Text() string {
+	var sb strings.Builder
+	content := c.sdt.SelectElement("w:sdtContent")
+	if content == nil {
+		return ""
+	}
+	first := true
+	var walk func(el *etree.Element)
+	walk = func(el *etree.Element) {
+		for _, child := range el.ChildElements() {
+			switch {
+			case child.Space != "w":
+			case child.Tag == "p":
+				if !first {
+					sb.WriteString("\n")
+				}
+				first = false
+				walk(child)
+			case child.Tag == "r":
+				sb.WriteString((&oxml.CT_R{Element: oxml.WrapElement(child)}).RunText())
+			case child.Tag != "del":
+				walk(child)
+			}
+		}
+	}
+	walk(content)
+	return sb.String()
+}
+
+// SetText replaces the content of the control with text, in the
+// formatting of its first run ("\t" becomes a tab, "\n" a line break).
+// A bound control also stores text in its custom XML node, so Word shows
+// the same text when it next refreshes the binding.
+func (c *ContentControl) SetText(text string) error {
+	if b := c.DataBinding(); b != nil {
+		if err := c.doc.setBoundValue(b, text); err != nil {
+			return err
+		}
+	}
+	return c.setContentText(text)
+}
+
+// setContentText replaces the content of the control with text.
+func (c *ContentControl) setContentText(text string) error {
+	content := c.content()
+	placeholder := c.IsShowingPlaceholder()
+	var rPr *etree.Element
+	if placeholder {
+		rPr = c.sdt.FindElement("w:sdtPr/w:rPr")
+	} else {
+		rPr = content.FindElement(".//w:r/w:rPr")
+	}
+	run := etree.NewElement("w:r")
+	if rPr != nil {
+		run.AddChild(rPr.Copy())
+	}
+	(&oxml.CT_R{Element: oxml.WrapElement(run)}).SetRunText(text)
+
+	switch c.level() {
+	case "cell", "row":
+		return fmt.Errorf("docx: content control %q spans table cells; set the text of its cells", c.Tag())
+	case "block":
+		p := content.SelectElement("w:p")
+		if p == nil {
+			p = etree.NewElement("w:p")
+		}
+		for _, child := range content.ChildElements() {
+			content.RemoveChild(child)
+		}
+		for _, child := range p.ChildElements() {
+			if !(child.Space == "w" && child.Tag == "pPr") {
+				p.RemoveChild(child)
+			}
+		}
+		p.AddChild(run)
+		content.AddChild(p)
+	default:
+		for _, child := range content.ChildElements() {
+			content.RemoveChild(child)
+		}
+		content.AddChild(run)
+	}
+	if placeholder {
+		pr := c.properties()
+		pr.RemoveChild(pr.SelectElement("w:showingPlcHdr"))
+	}
+	return nil
+}
+
+// level returns what the control holds: "run" content within a
+// paragraph, "cell"s of a table row, table "row"s, or "block" content.
+func (c *ContentControl) level() string {
+	if content := c.sdt.SelectElement("w:sdtContent"); content != nil {
+		for _, child := range content.ChildElements() {
+			if child.Space != "w" {
+				continue
+			}
+			switch child.Tag {
+			case "p", "tbl":
+				return "block"
+			case "tc":
+				return "cell"
+			case "tr":
+				return "row"
+			case "r":
+				return "run"
+			}
+		}
+	}
+	parent := c.sdt.Parent()
+	if parent == nil {
+		return "block"
+	}
+	switch parent.Tag {
+	case "p", "hyperlink", "smartTag", "fldSimple", "ins", "del":
+		return "run"
+	case "tr":
+		return "cell"
+	case "tbl":
+		return "row"
+	case "sdtContent":
+		return (&ContentControl{sdt: parent.Parent()}).level()
+	}
+	return "block"
+}
+
+// DataBinding returns the binding of the control to a custom XML node, or
+// nil if it is not bound.
+func (c *ContentControl) DataBinding() *DataBinding {
+	el := c.sdt.FindElement("w:sdtPr/w:dataBinding")
+	if el == nil {
+		return nil
+	}
+	return &DataBinding{
+		StoreItemID:    el.SelectAttrValue("w:storeItemID", ""),
+		XPath:          el.SelectAttrValue("w:xpath", ""),
+		PrefixMappings: el.SelectAttrValue("w:prefixMappings", ""),
+	}
+}
+
+// SetDataBinding binds the control to a custom XML node and shows the
+// node's text, or removes the binding when b is nil. The node must exist.
+func (c *ContentControl) SetDataBinding(b *DataBinding) error {
+	pr := c.properties()
+	if old := pr.SelectElement("w:dataBinding"); old != nil {
+		pr.RemoveChild(old)
+	}
+	if b == nil {
+		return nil
+	}
+	value, err := c.doc.boundValue(b)
+	if err != nil {
+		return err
+	}
+	el := etree.NewElement("w:dataBinding")
+	if b.PrefixMappings != "" {
+		el.CreateAttr("w:prefixMappings", b.PrefixMappings)
+	}
+	el.CreateAttr("w:xpath", b.XPath)
+	el.CreateAttr("w:storeItemID", b.StoreItemID)
+	insertSdtPrChild(pr, el)
+	return c.setContentText(value)
+}
+
+// sdtPrTypeTags are the sdtPr children choosing the kind of control,
+// which follow w:dataBinding.
+var sdtPrTypeTags = map[string]bool{
+	"equation": true, "comboBox": true, "date": true, "docPartObj": true,
+	"docPartList": true, "dropDownList": true, "picture": true,
+	"richText": true, "text": true, "citation": true, "group": true,
+	"bibliography": true,
+}
+
+// insertSdtPrChild inserts el, a w:dataBinding or other property that
+// precedes the control type, into pr in schema order.
+func insertSdtPrChild(pr, el *etree.Element) {
+	for _, child := range pr.ChildElements() {
+		if child.Space == "w" && sdtPrTypeTags[child.Tag] {
+			pr.InsertChildAt(childIndex(pr, child), el)
+			return
+		}
+	}
+	pr.AddChild(el)
+}
+
+// boundValue returns the text of the custom XML node b selects.
+func (d *Document) boundValue(b *DataBinding) (string, error) {
+	cp := d.CustomXmlParts().Get(b.StoreItemID)
+	if cp == nil {
+		return "", fmt.Errorf("docx: no custom XML part %s", b.StoreItemID)
+	}
+	return cp.Value(b.XPath, b.PrefixMappings)
+}
+
+// setBoundValue stores value in the custom XML node b selects.
+func (d *Document) setBoundValue(b *DataBinding, value string) error {
+	cp := d.CustomXmlParts().Get(b.StoreItemID)
+	if cp == nil {
+		return fmt.Errorf("docx: no custom XML part %s", b.StoreItemID)
+	}
+	return cp.SetValue(b.XPath, b.PrefixMappings, value)
+}
+
+// RefreshDataBindings shows in every bound content control the current
+// text of its custom XML node, as Word does on opening the document. Call
+// it after changing custom XML parts directly. Controls bound to parts or
+// nodes that do not exist keep their text.
+func (d *Document) RefreshDataBindings() {
+	for _, cc := range d.ContentControls() {
+		b := cc.DataBinding()
+		if b == nil {
+			continue
+		}
+		value, err := d.boundValue(b)
+		if err != nil {
+			continue
+		}
+		if cc.Text() != value {
+			_ = cc.setContentText(value)
+		}
+	}
+}
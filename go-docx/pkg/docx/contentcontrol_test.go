@@ -0,0 +1,128 @@
+package docx
+
+import (
+	"testing"
+
+	"github.com/beevik/etree"
+)
+
+// addContentControl appends a paragraph holding a run-level content
+// control with the given tag and text.
+func addContentControl(t *testing.T, d *Document, tag, text string) *ContentControl {
+	t.Helper()
+	p, err := d.AddParagraph("Name: ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdt := p.p.RawElement().CreateElement("w:sdt")
+	pr := sdt.CreateElement("w:sdtPr")
+	pr.CreateElement("w:tag").CreateAttr("w:val", tag)
+	pr.CreateElement("w:text")
+	r := sdt.CreateElement("w:sdtContent").CreateElement("w:r")
+	r.CreateElement("w:rPr").CreateElement("w:b")
+	r.CreateElement("w:t").SetText(text)
+	for _, cc := range d.ContentControlsByTag(tag) {
+		if cc.sdt == sdt {
+			return cc
+		}
+	}
+	t.Fatalf("content control %q not found", tag)
+	return nil
+}
+
+func TestContentControl_SetText(t *testing.T) {
+	d := mustNewDoc(t)
+	cc := addContentControl(t, d, "name", "Click here")
+	if cc.Tag() != "name" || cc.Text() != "Click here" {
+		t.Errorf("Tag, Text = %q, %q", cc.Tag(), cc.Text())
+	}
+	if err := cc.SetText("Ann\tLee"); err != nil {
+		t.Fatalf("SetText: %v", err)
+	}
+	if cc.Text() != "Ann\tLee" {
+		t.Errorf("Text = %q", cc.Text())
+	}
+	if cc.sdt.FindElement(".//w:r/w:rPr/w:b") == nil {
+		t.Error("run formatting lost")
+	}
+	if got := mustParagraphs(t, d)[0].Text(); got != "Name: " {
+		t.Errorf("paragraph text = %q", got)
+	}
+}
+
+func TestContentControl_DataBinding(t *testing.T) {
+	d := mustNewDoc(t)
+	cp, err := d.CustomXmlParts().Add([]byte(orderXML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc := addContentControl(t, d, "customer", "")
+	binding := &DataBinding{StoreItemID: cp.ItemID(), XPath: "/ns0:order[1]/ns0:customer[1]", PrefixMappings: orderMappings}
+	if err := cc.SetDataBinding(binding); err != nil {
+		t.Fatalf("SetDataBinding: %v", err)
+	}
+	if cc.Text() != "Ann" {
+		t.Errorf("bound Text = %q, want Ann", cc.Text())
+	}
+	if got := cc.DataBinding(); got == nil || *got != *binding {
+		t.Errorf("DataBinding = %+v", got)
+	}
+	pr := cc.sdt.SelectElement("w:sdtPr")
+	if last := pr.ChildElements()[len(pr.ChildElements())-1]; last.Tag != "text" {
+		t.Errorf("w:dataBinding not placed before w:text: last child %s", last.Tag)
+	}
+
+	if err := cc.SetText("Bob"); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := cp.Value(binding.XPath, orderMappings); v != "Bob" {
+		t.Errorf("custom XML value = %q, want Bob", v)
+	}
+
+	if err := cp.SetValue(binding.XPath, orderMappings, "Cy"); err != nil {
+		t.Fatal(err)
+	}
+	d.RefreshDataBindings()
+	if cc.Text() != "Cy" {
+		t.Errorf("refreshed Text = %q, want Cy", cc.Text())
+	}
+
+	bad := *binding
+	bad.XPath = "/ns0:order[1]/ns0:nothing[1]"
+	if err := cc.SetDataBinding(&bad); err == nil {
+		t.Error("binding to a missing node succeeded")
+	}
+	if err := cc.SetDataBinding(nil); err != nil || cc.DataBinding() != nil {
+		t.Errorf("removing binding: %v, %+v", err, cc.DataBinding())
+	}
+}
+
+func TestContentControl_BlockLevelPlaceholder(t *testing.T) {
+	d := mustNewDoc(t)
+	body, err := d.getBody()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdt := etree.NewElement("w:sdt")
+	pr := sdt.CreateElement("w:sdtPr")
+	pr.CreateElement("w:tag").CreateAttr("w:val", "notes")
+	pr.CreateElement("w:showingPlcHdr")
+	p := sdt.CreateElement("w:sdtContent").CreateElement("w:p")
+	p.CreateElement("w:pPr").CreateElement("w:jc").CreateAttr("w:val", "center")
+	p.CreateElement("w:r").CreateElement("w:t").SetText("Enter notes")
+	body.insertBeforeSectPr(sdt)
+
+	cc := d.ContentControlsByTag("notes")[0]
+	if !cc.IsShowingPlaceholder() {
+		t.Error("IsShowingPlaceholder = false")
+	}
+	if err := cc.SetText("line one\nline two"); err != nil {
+		t.Fatal(err)
+	}
+	if cc.IsShowingPlaceholder() || cc.Text() != "line one\nline two" {
+		t.Errorf("placeholder = %v, Text = %q", cc.IsShowingPlaceholder(), cc.Text())
+	}
+	if sdt.FindElement("w:sdtContent/w:p/w:pPr/w:jc") == nil {
+		t.Error("paragraph properties lost")
+	}
+}
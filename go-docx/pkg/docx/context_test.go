@@ -0,0 +1,58 @@
+package docx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestContextCancellation(t *testing.T) {
+	d := mustNewDoc(t)
+	for range 3 {
+		if _, err := d.AddParagraph("old text"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, err := d.SaveBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := OpenBytesContext(canceled, data); !errors.Is(err, context.Canceled) {
+		t.Errorf("OpenBytesContext err = %v", err)
+	}
+	var buf bytes.Buffer
+	if err := d.SaveContext(canceled, &buf); !errors.Is(err, context.Canceled) {
+		t.Errorf("SaveContext err = %v", err)
+	}
+	if n, err := d.ReplaceTextContext(canceled, "old", "new"); !errors.Is(err, context.Canceled) || n != 0 {
+		t.Errorf("ReplaceTextContext = %d, %v", n, err)
+	}
+	if _, err := CompareContext(canceled, d, d); !errors.Is(err, context.Canceled) {
+		t.Errorf("CompareContext err = %v", err)
+	}
+	if _, err := RedlineContext(canceled, d, d, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("RedlineContext err = %v", err)
+	}
+
+	ctx := context.Background()
+	reopened, err := OpenBytesContext(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := reopened.ReplaceTextContext(ctx, "old", "new"); err != nil || n != 3 {
+		t.Errorf("ReplaceTextContext = %d, %v; want 3", n, err)
+	}
+	if diff, err := CompareContext(ctx, d, reopened); err != nil || !diff.HasChanges() {
+		t.Errorf("CompareContext = %v, %v", diff, err)
+	}
+	if _, err := RedlineContext(ctx, d, reopened, nil); err != nil {
+		t.Errorf("RedlineContext: %v", err)
+	}
+	if err := reopened.SaveContext(ctx, &buf); err != nil {
+		t.Errorf("SaveContext: %v", err)
+	}
+}
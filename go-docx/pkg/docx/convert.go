@@ -0,0 +1,42 @@
+package docx
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+// Converter converts a .docx package to another format, such as PDF. This
+// package renders nothing itself: implementations drive an external
+// engine, e.g. convert.LibreOffice, or a conversion service.
+type Converter interface {
+	// Convert writes the package docx, converted to format, to w. format
+	// is a file extension such as "pdf". Convert stops with the context's
+	// error once ctx is done.
+	Convert(ctx context.Context, docx []byte, format string, w io.Writer) error
+}
+
+// ConverterFunc adapts a function to the Converter interface.
+type ConverterFunc func(ctx context.Context, docx []byte, format string, w io.Writer) error
+
+// Convert calls f(ctx, docx, format, w).
+func (f ConverterFunc) Convert(ctx context.Context, docx []byte, format string, w io.Writer) error {
+	return f(ctx, docx, format, w)
+}
+
+// ExportPDF writes this document as PDF to w, converted by c. The
+// document is saved first, as by SaveContext, and is not changed.
+func (d *Document) ExportPDF(ctx context.Context, w io.Writer, c Converter) error {
+	if c == nil {
+		return fmt.Errorf("docx: exporting PDF: no converter")
+	}
+	var buf bytes.Buffer
+	if err := d.SaveContext(ctx, &buf); err != nil {
+		return fmt.Errorf("docx: exporting PDF: %w", err)
+	}
+	if err := c.Convert(ctx, buf.Bytes(), "pdf", w); err != nil {
+		return fmt.Errorf("docx: exporting PDF: %w", err)
+	}
+	return nil
+}
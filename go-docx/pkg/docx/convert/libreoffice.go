@@ -0,0 +1,84 @@
+// Package convert provides converters for docx.Document.ExportPDF and
+// other uses of docx.Converter.
+package convert
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// LibreOffice converts documents by running LibreOffice headless, one
+// process per conversion. Each conversion uses a profile directory of its
+// own, so conversions may run concurrently.
+//
+//	var c convert.LibreOffice
+//	err := doc.ExportPDF(ctx, w, c)
+type LibreOffice struct {
+	// Path is the soffice executable; empty means "soffice", looked up
+	// in PATH.
+	Path string
+	// TempDir is where the input, output and profile are written; empty
+	// means os.TempDir.
+	TempDir string
+}
+
+// Convert writes docx, converted to format, to w. format is an extension
+// LibreOffice converts to, such as "pdf" or "odt", optionally followed by
+// ":" and a filter name, e.g. "pdf:writer_pdf_Export". The process is
+// killed once ctx is done.
+func (lo LibreOffice) Convert(ctx context.Context, docx []byte, format string, w io.Writer) error {
+	ext, _, _ := strings.Cut(format, ":")
+	if ext == "" {
+		return fmt.Errorf("convert: no output format")
+	}
+	path := lo.Path
+	if path == "" {
+		path = "soffice"
+	}
+	dir, err := os.MkdirTemp(lo.TempDir, "docx-convert-")
+	if err != nil {
+		return fmt.Errorf("convert: creating work directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	in := filepath.Join(dir, "document.docx")
+	if err := os.WriteFile(in, docx, 0o600); err != nil {
+		return fmt.Errorf("convert: writing input: %w", err)
+	}
+	outDir := filepath.Join(dir, "out")
+	profile := url.URL{Scheme: "file", Path: filepath.ToSlash(filepath.Join(dir, "profile"))}
+
+	cmd := exec.CommandContext(ctx, path,
+		"--headless", "--norestore", "--nolockcheck",
+		"-env:UserInstallation="+profile.String(),
+		"--convert-to", format,
+		"--outdir", outDir,
+		in)
+	var stderr bytes.Buffer
+	cmd.Stdout = io.Discard
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("convert: running %s: %w: %s", path, err, strings.TrimSpace(stderr.String()))
+	}
+
+	f, err := os.Open(filepath.Join(outDir, "document."+ext))
+	if err != nil {
+		// soffice exits with status 0 when the conversion fails.
+		return fmt.Errorf("convert: %s wrote no %s output: %s", path, ext, strings.TrimSpace(stderr.String()))
+	}
+	defer f.Close()
+	if _, err := io.Copy(w, f); err != nil {
+		return fmt.Errorf("convert: copying output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package convert
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// fakeSoffice writes a shell script that stands in for soffice: it copies
+// its input to the output directory with the requested extension, or
+// fails when the input is "fail".
+func fakeSoffice(t *testing.T) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script stand-in")
+	}
+	script := `#!/bin/sh
+while [ $# -gt 1 ]; do
+  case "$1" in
+    --convert-to) format="${2%%:*}"; shift ;;
+    --outdir) outdir="$2"; shift ;;
+  esac
+  shift
+done
+if [ "$(cat "$1")" = fail ]; then echo "bad input" >&2; exit 1; fi
+if [ "$(cat "$1")" = none ]; then exit 0; fi
+mkdir -p "$outdir" && { printf 'converted:'; cat "$1"; } > "$outdir/document.$format"
+`
+	path := filepath.Join(t.TempDir(), "soffice")
+	if err := os.WriteFile(path, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLibreOffice_Convert(t *testing.T) {
+	lo := LibreOffice{Path: fakeSoffice(t), TempDir: t.TempDir()}
+	var out bytes.Buffer
+	if err := lo.Convert(context.Background(), []byte("doc"), "pdf:writer_pdf_Export", &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "converted:doc" {
+		t.Errorf("output = %q", out.String())
+	}
+	if entries, _ := os.ReadDir(lo.TempDir); len(entries) != 0 {
+		t.Errorf("work directory left behind: %v", entries)
+	}
+}
+
+func TestLibreOffice_ConvertErrors(t *testing.T) {
+	lo := LibreOffice{Path: fakeSoffice(t)}
+	var out bytes.Buffer
+	err := lo.Convert(context.Background(), []byte("fail"), "pdf", &out)
+	if err == nil || !strings.Contains(err.Error(), "bad input") {
+		t.Errorf("failing process: err = %v", err)
+	}
+	if err := lo.Convert(context.Background(), []byte("none"), "pdf", &out); err == nil {
+		t.Error("missing output: expected an error")
+	}
+	if err := lo.Convert(context.Background(), nil, "", &out); err == nil {
+		t.Error("empty format: expected an error")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := lo.Convert(ctx, []byte("doc"), "pdf", &out); err != context.Canceled {
+		t.Errorf("canceled: err = %v", err)
+	}
+	if err := (LibreOffice{Path: filepath.Join(t.TempDir(), "missing")}).Convert(context.Background(), nil, "pdf", &out); err == nil {
+		t.Error("missing executable: expected an error")
+	}
+}
@@ -0,0 +1,48 @@
+package docx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestDocument_ExportPDF(t *testing.T) {
+	d := mustNewDoc(t)
+	if _, err := d.AddParagraph("Hello"); err != nil {
+		t.Fatal(err)
+	}
+	var got []byte
+	c := ConverterFunc(func(ctx context.Context, docx []byte, format string, w io.Writer) error {
+		if format != "pdf" {
+			t.Errorf("format = %q", format)
+		}
+		got = docx
+		_, err := w.Write([]byte("%PDF-1.7"))
+		return err
+	})
+	var out bytes.Buffer
+	if err := d.ExportPDF(context.Background(), &out, c); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "%PDF-1.7" {
+		t.Errorf("output = %q", out.String())
+	}
+	d2, err := OpenBytes(got)
+	if err != nil {
+		t.Fatalf("converter got an unreadable package: %v", err)
+	}
+	if paras, _ := d2.Paragraphs(); len(paras) == 0 || paras[len(paras)-1].Text() != "Hello" {
+		t.Error("converter got other content")
+	}
+
+	fail := errors.New("engine down")
+	err = d.ExportPDF(context.Background(), &out, ConverterFunc(func(context.Context, []byte, string, io.Writer) error { return fail }))
+	if !errors.Is(err, fail) {
+		t.Errorf("err = %v, want %v", err, fail)
+	}
+	if err := d.ExportPDF(context.Background(), &out, nil); err == nil {
+		t.Error("nil converter: expected an error")
+	}
+}
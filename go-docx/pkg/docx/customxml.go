@@ -0,0 +1,242 @@
+package docx
+
+import (
+	"crypto/rand"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// --------------------------------------------------------------------------
+// CustomXmlParts
+// --------------------------------------------------------------------------
+
+// CustomXmlParts is the collection of custom XML data parts of a document:
+// XML of the author's own schema stored with it, which content controls
+// can be bound to (see ContentControl.SetDataBinding).
+type CustomXmlParts struct {
+	doc *Document
+}
+
+// CustomXmlParts returns the custom XML parts of this document.
+func (d *Document) CustomXmlParts() *CustomXmlParts {
+	return &CustomXmlParts{doc: d}
+}
+
+// Iter returns the custom XML parts in relationship order.
+func (cs *CustomXmlParts) Iter() []*CustomXmlPart {
+	var result []*CustomXmlPart
+	for _, rel := range cs.doc.part.Rels().AllByRelType(opc.RTCustomXml) {
+		if cp, ok := rel.TargetPart.(*parts.CustomXmlPart); ok {
+			result = append(result, &CustomXmlPart{part: cp})
+		}
+	}
+	return result
+}
+
+// Len returns the number of custom XML parts.
+func (cs *CustomXmlParts) Len() int {
+	return len(cs.Iter())
+}
+
+// Get returns the custom XML part with the given item ID, or nil. IDs are
+// GUIDs and compared without regard to case.
+func (cs *CustomXmlParts) Get(itemID string) *CustomXmlPart {
+	for _, cp := range cs.Iter() {
+		if strings.EqualFold(cp.ItemID(), itemID) {
+			return cp
+		}
+	}
+	return nil
+}
+
+// Add stores data, an XML document, as a new custom XML part with a new
+// item ID. schemaRefs optionally lists the namespaces of the schemas it
+// conforms to.
+func (cs *CustomXmlParts) Add(data []byte, schemaRefs ...string) (*CustomXmlPart, error) {
+	root, err := oxml.ParseXml(data)
+	if err != nil {
+		return nil, fmt.Errorf("docx: parsing custom XML: %w", err)
+	}
+	itemID, err := newGUID()
+	if err != nil {
+		return nil, err
+	}
+	cp := parts.NewCustomXmlPart(cs.doc.wmlPkg.OpcPackage, root, itemID, schemaRefs)
+	cs.doc.part.Rels().GetOrAdd(opc.RTCustomXml, cp)
+	return &CustomXmlPart{part: cp}, nil
+}
+
+// Delete removes the custom XML part with the given item ID and reports
+// whether there was one. Content controls bound to it keep their text.
+func (cs *CustomXmlParts) Delete(itemID string) bool {
+	rels := cs.doc.part.Rels()
+	for _, rel := range rels.AllByRelType(opc.RTCustomXml) {
+		cp, ok := rel.TargetPart.(*parts.CustomXmlPart)
+		if ok && strings.EqualFold(cp.ItemID(), itemID) {
+			rels.Delete(rel.RID)
+			return true
+		}
+	}
+	return false
+}
+
+// newGUID returns a random GUID in the braced upper-case form Word uses.
+func newGUID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("docx: generating GUID: %w", err)
+	}
+	b[6] = b[6]&0x0F | 0x40
+	b[8] = b[8]&0x3F | 0x80
+	return fmt.Sprintf("{%X-%X-%X-%X-%X}", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
+// --------------------------------------------------------------------------
+// CustomXmlPart
+// --------------------------------------------------------------------------
+
+// CustomXmlPart is one custom XML data part.
+type CustomXmlPart struct {
+	part *parts.CustomXmlPart
+}
+
+// ItemID returns the GUID naming this part, which data bindings refer to.
+func (p *CustomXmlPart) ItemID() string { return p.part.ItemID() }
+
+// SchemaRefs returns the namespaces of the schemas the data conforms to.
+func (p *CustomXmlPart) SchemaRefs() []string { return p.part.SchemaRefs() }
+
+// Element returns the root element of the data, for direct editing.
+func (p *CustomXmlPart) Element() *etree.Element { return p.part.Element() }
+
+// Blob returns the data serialized as an XML document.
+func (p *CustomXmlPart) Blob() ([]byte, error) { return p.part.Blob() }
+
+// Value returns the text of the node xpath selects. xpath and
+// prefixMappings are as in a data binding: a path of child steps such as
+// /ns0:order[1]/ns0:customer[1] or ending in an attribute, with
+// prefixMappings declaring the prefixes, e.g. "xmlns:ns0='urn:orders'".
+func (p *CustomXmlPart) Value(xpath, prefixMappings string) (string, error) {
+	node, err := selectXPath(p.part.Element(), xpath, prefixMappings)
+	if err != nil {
+		return "", err
+	}
+	return node.value(), nil
+}
+
+// SetValue sets the text of the node xpath selects, replacing any child
+// elements. The node must exist.
+func (p *CustomXmlPart) SetValue(xpath, prefixMappings, value string) error {
+	node, err := selectXPath(p.part.Element(), xpath, prefixMappings)
+	if err != nil {
+		return err
+	}
+	node.setValue(value)
+	return nil
+}
+
+// --------------------------------------------------------------------------
+// XPath subset
+// --------------------------------------------------------------------------
+
+// xpathNode is an element, or an attribute of it, selected by an XPath.
+type xpathNode struct {
+	el   *etree.Element
+	attr *etree.Attr
+}
+
+func (n xpathNode) value() string {
+	if n.attr != nil {
+		return n.attr.Value
+	}
+	return n.el.Text()
+}
+
+func (n xpathNode) setValue(v string) {
+	if n.attr != nil {
+		n.attr.Value = v
+		return
+	}
+	for _, child := range n.el.ChildElements() {
+		n.el.RemoveChild(child)
+	}
+	n.el.SetText(v)
+}
+
+var (
+	prefixMappingRe = regexp.MustCompile(`xmlns:([\w.-]+)\s*=\s*(?:'([^']*)'|"([^"]*)")`)
+	xpathStepRe     = regexp.MustCompile(`^(@?)(?:([\w.-]+):)?([\w.-]+)(?:\[(\d+)\])?$`)
+)
+
+// selectXPath evaluates the absolute location paths Word writes in data
+// bindings: child steps with optional position predicates, optionally
+// ending in an attribute step.
+func selectXPath(root *etree.Element, xpath, prefixMappings string) (xpathNode, error) {
+	if root == nil {
+		return xpathNode{}, fmt.Errorf("docx: custom XML part is empty")
+	}
+	ns := map[string]string{}
+	for _, m := range prefixMappingRe.FindAllStringSubmatch(prefixMappings, -1) {
+		ns[m[1]] = m[2] + m[3]
+	}
+	if !strings.HasPrefix(xpath, "/") || strings.HasPrefix(xpath, "//") {
+		return xpathNode{}, fmt.Errorf("docx: unsupported XPath %q: want an absolute path of child steps", xpath)
+	}
+
+	// A virtual document node holds the root so the first step selects it
+	// like any other child.
+	candidates := []*etree.Element{root}
+	var el *etree.Element
+	steps := strings.Split(xpath[1:], "/")
+	for i, step := range steps {
+		m := xpathStepRe.FindStringSubmatch(step)
+		if m == nil {
+			return xpathNode{}, fmt.Errorf("docx: unsupported XPath step %q in %q", step, xpath)
+		}
+		isAttr, prefix, local, pos := m[1] == "@", m[2], m[3], m[4]
+		uri := ""
+		if prefix != "" {
+			var ok bool
+			if uri, ok = ns[prefix]; !ok {
+				return xpathNode{}, fmt.Errorf("docx: XPath %q uses undeclared prefix %q", xpath, prefix)
+			}
+		}
+		if isAttr {
+			if i != len(steps)-1 || el == nil {
+				return xpathNode{}, fmt.Errorf("docx: unsupported XPath %q: attribute step must come last", xpath)
+			}
+			for j := range el.Attr {
+				a := &el.Attr[j]
+				if a.Key == local && a.Space != "xmlns" && a.NamespaceURI() == uri {
+					return xpathNode{el: el, attr: a}, nil
+				}
+			}
+			return xpathNode{}, fmt.Errorf("docx: XPath %q selects no node", xpath)
+		}
+		n := 1
+		if pos != "" {
+			n, _ = strconv.Atoi(pos)
+		}
+		el = nil
+		for _, c := range candidates {
+			if c.Tag == local && c.NamespaceURI() == uri {
+				if n--; n == 0 {
+					el = c
+					break
+				}
+			}
+		}
+		if el == nil {
+			return xpathNode{}, fmt.Errorf("docx: XPath %q selects no node", xpath)
+		}
+		candidates = el.ChildElements()
+	}
+	return xpathNode{el: el}, nil
+}
@@ -0,0 +1,73 @@
+package docx
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const orderXML = `<order xmlns="urn:orders" id="42"><customer>Ann</customer><total>10</total></order>`
+
+const orderMappings = "xmlns:ns0='urn:orders'"
+
+func TestCustomXmlParts(t *testing.T) {
+	d := mustNewDoc(t)
+	parts := d.CustomXmlParts()
+	base := parts.Len()
+	cp, err := parts.Add([]byte(orderXML), "urn:orders")
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	id := cp.ItemID()
+	if len(id) != 38 || id[0] != '{' {
+		t.Errorf("ItemID = %q", id)
+	}
+	if _, err := parts.Add([]byte(`<other/>`)); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	reopened, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	parts = reopened.CustomXmlParts()
+	if parts.Len() != base+2 {
+		t.Fatalf("reopened Len = %d, want %d", parts.Len(), base+2)
+	}
+	got := parts.Get(strings.ToLower(id))
+	if got == nil {
+		t.Fatal("Get by item ID returned nil")
+	}
+	if refs := got.SchemaRefs(); len(refs) != 1 || refs[0] != "urn:orders" {
+		t.Errorf("SchemaRefs = %q", refs)
+	}
+	if v, err := got.Value("/ns0:order[1]/ns0:customer[1]", orderMappings); err != nil || v != "Ann" {
+		t.Errorf("Value = %q, %v", v, err)
+	}
+	if v, err := got.Value("/ns0:order[1]/@id", orderMappings); err != nil || v != "42" {
+		t.Errorf("attribute Value = %q, %v", v, err)
+	}
+	if err := got.SetValue("/ns0:order[1]/ns0:total[1]", orderMappings, "12"); err != nil {
+		t.Fatal(err)
+	}
+	if blob, _ := got.Blob(); !bytes.Contains(blob, []byte("<total>12</total>")) {
+		t.Errorf("Blob = %s", blob)
+	}
+
+	for _, xpath := range []string{"/ns0:order[1]/ns0:missing[1]", "/ns1:order[1]", "//ns0:customer", "/ns0:order/ns0:customer/text()"} {
+		if _, err := got.Value(xpath, orderMappings); err == nil {
+			t.Errorf("Value(%q) succeeded", xpath)
+		}
+	}
+
+	if !parts.Delete(id) || parts.Len() != base+1 || parts.Get(id) != nil {
+		t.Error("Delete did not remove the part")
+	}
+	if parts.Delete(id) {
+		t.Error("second Delete reported a part")
+	}
+}
@@ -0,0 +1,229 @@
+package docx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// DateFieldType selects the date a date field shows.
+type DateFieldType int
+
+const (
+	// DateFieldDate shows the current date (DATE).
+	DateFieldDate DateFieldType = iota
+	// DateFieldTime shows the current time (TIME).
+	DateFieldTime
+	// DateFieldCreated shows when the document was created (CREATEDATE).
+	DateFieldCreated
+	// DateFieldSaved shows when the document was last saved (SAVEDATE).
+	DateFieldSaved
+	// DateFieldPrinted shows when the document was last printed
+	// (PRINTDATE).
+	DateFieldPrinted
+)
+
+// dateFieldNames are the field types, indexed by DateFieldType.
+var dateFieldNames = [...]string{"DATE", "TIME", "CREATEDATE", "SAVEDATE", "PRINTDATE"}
+
+// String returns the field type of the date field.
+func (t DateFieldType) String() string {
+	if t < 0 || int(t) >= len(dateFieldNames) {
+		return fmt.Sprintf("DateFieldType(%d)", int(t))
+	}
+	return dateFieldNames[t]
+}
+
+// AddDateField inserts a DATE field showing today's date after this run,
+// formatted by the Word date picture format, such as "d MMMM yyyy"; ""
+// uses Word's default. With fixed, the field is locked so the date it was
+// added on is kept; otherwise Word updates it whenever the document is
+// opened or printed.
+func (run *Run) AddDateField(format string, fixed bool) (*Field, error) {
+	return run.AddDateFieldOfType(DateFieldDate, format, fixed)
+}
+
+// AddDateFieldOfType inserts a date field of type typ after this run, like
+// AddDateField. The field takes the formatting of the run, and its cached
+// result is the date formatted as Word would. A creation, save or print
+// date the document does not record yet is left empty and the field is
+// flagged for update.
+func (run *Run) AddDateFieldOfType(typ DateFieldType, format string, fixed bool) (*Field, error) {
+	if typ < 0 || int(typ) >= len(dateFieldNames) {
+		return nil, fmt.Errorf("docx: unknown date field type %d", typ)
+	}
+	code := typ.String()
+	if format != "" {
+		code += ` \@ "` + format + `"`
+	}
+	picture := format
+	if picture == "" {
+		picture = "M/d/yyyy"
+		if typ == DateFieldTime {
+			picture = "h:mm am/pm"
+		}
+	}
+	result := ""
+	date, err := run.fieldDate(typ)
+	if err != nil {
+		return nil, err
+	}
+	if date != nil {
+		result = FormatDatePicture(*date, picture)
+	}
+
+	r := run.r.RawElement()
+	runs, span := oxml.NewComplexFieldRuns(code, result, r.SelectElement("w:rPr"))
+	parent := r.Parent()
+	at := childIndex(parent, r) + 1
+	for i, fr := range runs {
+		parent.InsertChildAt(at+i, fr)
+	}
+	f := &Field{span: span, part: run.part}
+	if fixed {
+		f.SetLocked(true)
+	} else if date == nil {
+		f.SetDirty(true)
+	}
+	return f, nil
+}
+
+// fieldDate returns the date a field of type typ shows now, or nil if the
+// document does not record it.
+func (run *Run) fieldDate(typ DateFieldType) (*time.Time, error) {
+	if typ == DateFieldDate || typ == DateFieldTime {
+		now := time.Now()
+		return &now, nil
+	}
+	dp, err := run.part.DocumentPart()
+	if err != nil {
+		return nil, fmt.Errorf("docx: date field: %w", err)
+	}
+	part, err := dp.Package().RelatedPart(opc.RTCoreProperties)
+	if err != nil {
+		return nil, nil
+	}
+	cpp, ok := part.(*parts.CorePropertiesPart)
+	if !ok {
+		return nil, nil
+	}
+	ct, err := cpp.CT()
+	if err != nil {
+		return nil, fmt.Errorf("docx: date field: %w", err)
+	}
+	var date *time.Time
+	switch typ {
+	case DateFieldCreated:
+		date, err = ct.CreatedDatetime()
+	case DateFieldSaved:
+		date, err = ct.ModifiedDatetime()
+	default:
+		date, err = ct.LastPrintedDatetime()
+	}
+	if err != nil || date == nil {
+		return nil, err
+	}
+	local := date.Local()
+	return &local, nil
+}
+
+// FormatDatePicture formats t by a Word date-time picture, the argument of
+// a field's \@ switch: d, dd, ddd and dddd for the day, M to MMMM for the
+// month, yy and yyyy for the year, h, hh, H and HH for the hour, m and mm
+// for minutes, s and ss for seconds, am/pm or AM/PM for the period, and
+// 'quoted' literal text. Other characters are copied as they are. Names
+// are in English.
+func FormatDatePicture(t time.Time, picture string) string {
+	return formatDatePicture(t, picture, localeFor(""))
+}
+
+// formatDatePicture is FormatDatePicture with the month and day names of
+// loc.
+func formatDatePicture(t time.Time, picture string, loc *locale) string {
+	var sb strings.Builder
+	rs := []rune(picture)
+	for i := 0; i < len(rs); {
+		ch := rs[i]
+		if ch == '\'' {
+			end := i + 1
+			for end < len(rs) && rs[end] != '\'' {
+				end++
+			}
+			sb.WriteString(string(rs[i+1 : min(end, len(rs))]))
+			i = end + 1
+			continue
+		}
+		if rest := strings.ToLower(string(rs[i:])); strings.HasPrefix(rest, "am/pm") {
+			period := "AM"
+			if t.Hour() >= 12 {
+				period = "PM"
+			}
+			if rs[i] == 'a' {
+				period = strings.ToLower(period)
+			}
+			sb.WriteString(period)
+			i += len("am/pm")
+			continue
+		}
+		n := 1
+		for i+n < len(rs) && rs[i+n] == ch {
+			n++
+		}
+		i += n
+		switch ch {
+		case 'd':
+			switch n {
+			case 1:
+				sb.WriteString(strconv.Itoa(t.Day()))
+			case 2:
+				fmt.Fprintf(&sb, "%02d", t.Day())
+			default:
+				sb.WriteString(loc.dayName(t.Weekday(), n == 3))
+			}
+		case 'M':
+			switch n {
+			case 1:
+				sb.WriteString(strconv.Itoa(int(t.Month())))
+			case 2:
+				fmt.Fprintf(&sb, "%02d", int(t.Month()))
+			default:
+				sb.WriteString(loc.monthName(t.Month(), n == 3))
+			}
+		case 'y':
+			if n <= 2 {
+				fmt.Fprintf(&sb, "%02d", t.Year()%100)
+			} else {
+				sb.WriteString(strconv.Itoa(t.Year()))
+			}
+		case 'h':
+			h := t.Hour() % 12
+			if h == 0 {
+				h = 12
+			}
+			writeDatePart(&sb, h, n)
+		case 'H':
+			writeDatePart(&sb, t.Hour(), n)
+		case 'm':
+			writeDatePart(&sb, t.Minute(), n)
+		case 's':
+			writeDatePart(&sb, t.Second(), n)
+		default:
+			sb.WriteString(strings.Repeat(string(ch), n))
+		}
+	}
+	return sb.String()
+}
+
+// writeDatePart writes v, zero-padded to two digits if n is 2 or more.
+func writeDatePart(sb *strings.Builder, v, n int) {
+	if n >= 2 {
+		fmt.Fprintf(sb, "%02d", v)
+		return
+	}
+	sb.WriteString(strconv.Itoa(v))
+}
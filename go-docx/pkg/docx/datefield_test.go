@@ -0,0 +1,95 @@
+package docx
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatDatePicture(t *testing.T) {
+	ts := time.Date(2024, time.March, 5, 14, 7, 9, 0, time.UTC)
+	tests := []struct{ picture, want string }{
+		{"M/d/yyyy", "3/5/2024"},
+		{"dd.MM.yy", "05.03.24"},
+		{"dddd, d MMMM yyyy", "Tuesday, 5 March 2024"},
+		{"ddd MMM d", "Tue Mar 5"},
+		{"h:mm am/pm", "2:07 pm"},
+		{"hh:mm:ss AM/PM", "02:07:09 PM"},
+		{"HH:mm", "14:07"},
+		{"'Week of' d MMM", "Week of 5 Mar"},
+	}
+	for _, tt := range tests {
+		if got := FormatDatePicture(ts, tt.picture); got != tt.want {
+			t.Errorf("FormatDatePicture(%q) = %q, want %q", tt.picture, got, tt.want)
+		}
+	}
+}
+
+func TestFormatDatePicture_Locales(t *testing.T) {
+	june := time.Date(2024, time.June, 3, 0, 0, 0, 0, time.UTC)
+	july := time.Date(2024, time.July, 3, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		lang string
+		t    time.Time
+		want string
+	}{
+		// Months cut to three letters would read "jui" for both.
+		{"fr-FR", june, "lun. 3 juin"},
+		{"fr-FR", july, "mer. 3 juil."},
+		{"de-DE", time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC), "So. 3 März"},
+		{"es-ES", time.Date(2024, time.September, 3, 0, 0, 0, 0, time.UTC), "mar 3 sept"},
+		{"sv-SE", june, "mån 3 juni"},
+		{"en-US", july, "Wed 3 Jul"},
+	}
+	for _, tt := range tests {
+		if got := formatDatePicture(tt.t, "ddd d MMM", localeFor(tt.lang)); got != tt.want {
+			t.Errorf("%s: formatDatePicture(%s) = %q, want %q", tt.lang, tt.t.Format("Jan"), got, tt.want)
+		}
+	}
+}
+
+func TestRun_AddDateField(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run, err := p.AddRun("Date: ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run.SetBold(Ptr(true))
+	if _, err := p.AddRun(" (end)"); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := run.AddDateField("yyyy-MM-dd", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.Instruction(); strings.TrimSpace(got) != `DATE \@ "yyyy-MM-dd"` {
+		t.Errorf("Instruction = %q", got)
+	}
+	if want := time.Now().Format("2006-01-02"); f.Result() != want {
+		t.Errorf("Result = %q, want %q", f.Result(), want)
+	}
+	if !f.Locked() {
+		t.Error("fixed date field should be locked")
+	}
+	if got := p.Text(); !strings.HasPrefix(got, "Date: ") || !strings.HasSuffix(got, " (end)") {
+		t.Errorf("field not inserted after the run: %q", got)
+	}
+	for _, r := range f.Span().ResultRuns {
+		if r.FindElement("w:rPr/w:b") == nil {
+			t.Error("field runs should take the run's formatting")
+		}
+	}
+
+	f, err = run.AddDateFieldOfType(DateFieldPrinted, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(f.Instruction()) != "PRINTDATE" || f.Result() != "" || !f.Dirty() {
+		t.Errorf("PRINTDATE field = %q, %q, dirty %v", f.Instruction(), f.Result(), f.Dirty())
+	}
+}
@@ -9,5 +9,8 @@
 // Package docx is not safe for concurrent use. A single [Document] and all
 // objects derived from it (paragraphs, runs, tables, sections, etc.) must be
 // accessed from one goroutine at a time, or protected by an external mutex.
-// Independent Document instances may be used concurrently.
+// Independent Document instances may be used concurrently. To share one
+// Document between goroutines, wrap it with [Document.Synchronized] (or
+// create it with [NewConcurrent]) and access it through
+// [SyncDocument.Read] and [SyncDocument.Write].
 package docx
@@ -1,10 +1,15 @@
 package docx
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
 
+	"github.com/beevik/etree"
 	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/opc/encryption"
 	"github.com/vortex/go-docx/pkg/docx/oxml"
 	"github.com/vortex/go-docx/pkg/docx/parts"
 )
@@ -33,6 +38,14 @@ func newDocument(docPart *parts.DocumentPart, wmlPkg *parts.WmlPackage) (*Docume
 	}, nil
 }
 
+// Strict reports whether the document was opened from a file in ISO 29500
+// Strict conformance. Its content was read with the Transitional
+// namespaces this package works with, and is saved that way unless
+// SaveOptions.Strict is set.
+func (d *Document) Strict() bool {
+	return d.wmlPkg.OpcPackage.Strict()
+}
+
 // --------------------------------------------------------------------------
 // Content mutation
 // --------------------------------------------------------------------------
@@ -141,6 +154,30 @@ func (d *Document) AddTable(rows, cols int, style ...StyleRef) (*Table, error) {
 	return table, nil
 }
 
+// AddAltChunk appends an alternative format import (w:altChunk) to the end
+// of the document body. content is stored in its own part and merged into
+// the document by Word when the file is opened; contentType selects the
+// format: opc.CTHtml, opc.CTXhtml, opc.CTMht, opc.CTRtf, opc.CTTextPlain or
+// opc.CTWmlDocumentMain for an embedded .docx.
+//
+// The imported content is not visible through this library's object model
+// until Word has opened and re-saved the file.
+func (d *Document) AddAltChunk(content []byte, contentType string) error {
+	b, err := d.getBody()
+	if err != nil {
+		return err
+	}
+	rId, err := d.part.AddAltChunkPart(content, contentType)
+	if err != nil {
+		return fmt.Errorf("docx: adding altChunk: %w", err)
+	}
+	chunk := etree.NewElement("altChunk")
+	chunk.Space = "w"
+	chunk.CreateAttr("r:id", rId)
+	b.insertBeforeSectPr(chunk)
+	return nil
+}
+
 // AddComment adds a comment anchored to the specified runs.
 // runs must contain at least one Run; the first and last are used to
 // delimit the comment range. text, author, and initials populate the
@@ -172,8 +209,21 @@ func (d *Document) AddComment(runs []*Run, text, author string, initials *string
 	return comment, nil
 }
 
+// AddCommentAt adds a comment anchored to the text of para between byte
+// offsets start and end, as Paragraph.IsolateRuns counts them. Runs are
+// split where the range starts or ends inside them, so the comment covers
+// exactly that text.
+func (d *Document) AddCommentAt(para *Paragraph, start, end int, text, author string, initials *string) (*Comment, error) {
+	runs, err := para.IsolateRuns(start, end)
+	if err != nil {
+		return nil, fmt.Errorf("docx: anchoring comment: %w", err)
+	}
+	return d.AddComment(runs, text, author, initials)
+}
+
 // ReplaceText replaces all occurrences of old with new throughout the entire
-// document: body, headers, footers, and comments of all sections.
+// document: body, headers, footers, comments, footnotes and endnotes. Text
+// in content controls and text boxes within these stories is included.
 //
 // Headers/footers without their own definition (linked to previous) are
 // skipped. Additionally, already-processed StoryParts are tracked by pointer
@@ -182,6 +232,13 @@ func (d *Document) AddComment(runs []*Run, text, author string, initials *string
 //
 // Returns the total number of replacements performed.
 func (d *Document) ReplaceText(old, new string) (int, error) {
+	return d.ReplaceTextContext(context.Background(), old, new)
+}
+
+// ReplaceTextContext is like ReplaceText but stops with the context's
+// error once ctx is done, which is checked before each paragraph and
+// table. The replacements made until then are kept and counted.
+func (d *Document) ReplaceTextContext(ctx context.Context, old, new string) (int, error) {
 	if old == "" {
 		return 0, nil
 	}
@@ -191,7 +248,10 @@ func (d *Document) ReplaceText(old, new string) (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	count := b.ReplaceText(old, new)
+	count, err := b.replaceText(ctx, old, new)
+	if err != nil {
+		return count, fmt.Errorf("docx: replacing text: %w", err)
+	}
 
 	// 2. Headers/footers of all sections, with deduplication.
 	seen := map[*parts.StoryPart]bool{}
@@ -205,27 +265,88 @@ func (d *Document) ReplaceText(old, new string) (int, error) {
 			&sect.FirstPageFooter().baseHeaderFooter,
 		}
 		for _, hf := range hfs {
-			n, err := hf.replaceTextDedup(old, new, seen)
+			n, err := hf.replaceTextDedup(ctx, old, new, seen)
+			count += n
 			if err != nil {
 				return count, fmt.Errorf("docx: replacing text in %s: %w", hf.ops.kind(), err)
 			}
-			count += n
 		}
 	}
 
 	// 3. Comments.
-	n, err := d.replaceTextInComments(old, new)
+	n, err := d.replaceTextInComments(ctx, old, new)
+	count += n
 	if err != nil {
 		return count, err
 	}
+
+	// 4. Footnotes and endnotes.
+	n, err = d.replaceTextInNotes(ctx, old, new)
 	count += n
+	if err != nil {
+		return count, fmt.Errorf("docx: replacing text in notes: %w", err)
+	}
 
 	return count, nil
 }
 
+// headerFooterContainers returns the block-item containers of every
+// header and footer defined by the document's sections, each part once.
+// Headers/footers linked to previous are skipped and none are created.
+func (d *Document) headerFooterContainers() ([]*BlockItemContainer, error) {
+	var result []*BlockItemContainer
+	seen := map[*parts.StoryPart]bool{}
+	for _, sect := range d.Sections().Iter() {
+		hfs := []*baseHeaderFooter{
+			&sect.Header().baseHeaderFooter,
+			&sect.Footer().baseHeaderFooter,
+			&sect.EvenPageHeader().baseHeaderFooter,
+			&sect.EvenPageFooter().baseHeaderFooter,
+			&sect.FirstPageHeader().baseHeaderFooter,
+			&sect.FirstPageFooter().baseHeaderFooter,
+		}
+		for _, hf := range hfs {
+			bic, err := hf.definedContainerDedup(seen)
+			if err != nil {
+				return nil, fmt.Errorf("docx: reading %s: %w", hf.ops.kind(), err)
+			}
+			if bic != nil {
+				result = append(result, bic)
+			}
+		}
+	}
+	return result, nil
+}
+
+// replaceTextInNotes replaces text in all footnotes and endnotes. Parts
+// that do not exist are skipped; none are created.
+func (d *Document) replaceTextInNotes(ctx context.Context, old, new string) (int, error) {
+	var stories []*parts.StoryPart
+	if fp := d.part.FootnotesPart(); fp != nil {
+		stories = append(stories, &fp.StoryPart)
+	}
+	if ep := d.part.EndnotesPart(); ep != nil {
+		stories = append(stories, &ep.StoryPart)
+	}
+	count := 0
+	for _, sp := range stories {
+		for _, note := range sp.Element().ChildElements() {
+			if note.Space == "w" && (note.Tag == "footnote" || note.Tag == "endnote") {
+				bic := newBlockItemContainer(note, sp)
+				n, err := bic.replaceText(ctx, old, new)
+				count += n
+				if err != nil {
+					return count, err
+				}
+			}
+		}
+	}
+	return count, nil
+}
+
 // replaceTextInComments replaces text in all comments. Returns 0 if
 // no comments part exists (avoids creating one as a side effect).
-func (d *Document) replaceTextInComments(old, new string) (int, error) {
+func (d *Document) replaceTextInComments(ctx context.Context, old, new string) (int, error) {
 	if !d.part.HasCommentsPart() {
 		return 0, nil
 	}
@@ -233,7 +354,15 @@ func (d *Document) replaceTextInComments(old, new string) (int, error) {
 	if err != nil {
 		return 0, fmt.Errorf("docx: replacing text in comments: %w", err)
 	}
-	return comments.ReplaceText(old, new), nil
+	count := 0
+	for _, c := range comments.Iter() {
+		n, err := c.replaceText(ctx, old, new)
+		count += n
+		if err != nil {
+			return count, fmt.Errorf("docx: replacing text in comments: %w", err)
+		}
+	}
+	return count, nil
 }
 
 // --------------------------------------------------------------------------
@@ -278,7 +407,7 @@ func (d *Document) InlineShapes() (*InlineShapes, error) {
 	if body == nil || body.RawElement() == nil {
 		return nil, fmt.Errorf("docx: document has no body element")
 	}
-	return newInlineShapes(body.RawElement()), nil
+	return newInlineShapes(body.RawElement(), &d.part.StoryPart), nil
 }
 
 // IterInnerContent returns all paragraphs and tables in document order.
@@ -350,14 +479,77 @@ func (d *Document) Tables() ([]*Table, error) {
 	return b.Tables(), nil
 }
 
+// ValidateSchema checks every XML part reachable from the package
+// relationships against the compiled OOXML content model and returns all
+// violations found. Each violation carries the partname and element path
+// it was found at. An empty result means the markup is structurally valid
+// as far as the schema models it; see [oxml.ValidateAgainstSchema].
+func (d *Document) ValidateSchema() []oxml.SchemaViolation {
+	var result []oxml.SchemaViolation
+	for _, part := range d.wmlPkg.Parts() {
+		xp, ok := part.(interface{ ReadElement() *etree.Element })
+		if !ok {
+			continue
+		}
+		result = append(result, oxml.ValidateAgainstSchema(string(part.PartName()), xp.ReadElement())...)
+	}
+	return result
+}
+
+// Validate is ValidateSchema as an error: nil when no violations are found,
+// otherwise the violations joined, each a *ValidationError that errors.As
+// finds.
+func (d *Document) Validate() error {
+	var errs []error
+	for _, v := range d.ValidateSchema() {
+		errs = append(errs, NewValidationError(v.Part, v.Path, v.Msg))
+	}
+	return errors.Join(errs...)
+}
+
 // --------------------------------------------------------------------------
 // Save
 // --------------------------------------------------------------------------
 
+// Clone returns an independent copy of this document, made without saving
+// and re-opening it. Parts are copied on write: the copy shares the XML of
+// styles, numbering, headers and the other parts with d until either
+// document changes them, and images and other binary parts until either
+// replaces them. The main document part, and parts d has handed out for
+// editing, are copied up front. Changes to the copy never affect d, and
+// the reverse.
+//
+// Cloning skips the unzipping and XML parsing of opening the document
+// again, so a template can be opened once and cloned for each document to
+// render. Clone only reads d, so goroutines may clone the same template
+// concurrently as long as nothing modifies the template itself.
+func (d *Document) Clone() (*Document, error) {
+	pkg, err := d.wmlPkg.OpcPackage.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("docx: cloning package: %w", err)
+	}
+	return documentFromPackage(pkg)
+}
+
+// CompactMedia shrinks the package by merging identical images into one
+// media part and removing images and other parts nothing refers to any
+// more. It is most useful on documents assembled from several templates,
+// which often carry many copies of the same logo.
+func (d *Document) CompactMedia() (parts.MediaStats, error) {
+	stats, err := d.wmlPkg.CompactMedia()
+	if err != nil {
+		return stats, fmt.Errorf("docx: compacting media: %w", err)
+	}
+	return stats, nil
+}
+
 // Save writes this document to w.
 //
 // Mirrors Python Document.save(stream).
 func (d *Document) Save(w io.Writer) error {
+	if err := d.beforeSave(); err != nil {
+		return err
+	}
 	return d.wmlPkg.Save(w)
 }
 
@@ -365,9 +557,44 @@ func (d *Document) Save(w io.Writer) error {
 //
 // Mirrors Python Document.save(path).
 func (d *Document) SaveFile(path string) error {
+	if err := d.beforeSave(); err != nil {
+		return err
+	}
 	return d.wmlPkg.SaveToFile(path)
 }
 
+// SaveEncrypted writes this document to w as a password-protected package
+// using agile encryption (AES-256, SHA-512).
+func (d *Document) SaveEncrypted(w io.Writer, password string) error {
+	if err := d.beforeSave(); err != nil {
+		return err
+	}
+	data, err := d.wmlPkg.SaveToBytes()
+	if err != nil {
+		return err
+	}
+	enc, err := encryption.Encrypt(data, password)
+	if err != nil {
+		return fmt.Errorf("docx: encrypting package: %w", err)
+	}
+	_, err = w.Write(enc)
+	return err
+}
+
+// SaveFileEncrypted writes this document to a password-protected file.
+// Open it again with OpenFileWithPassword.
+func (d *Document) SaveFileEncrypted(path, password string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("docx: creating %q: %w", path, err)
+	}
+	if err := d.SaveEncrypted(f, password); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
 // --------------------------------------------------------------------------
 // Internal
 // --------------------------------------------------------------------------
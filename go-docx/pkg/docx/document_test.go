@@ -2,9 +2,11 @@ package docx
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 
 	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/opc"
 )
 
 func mustNewDoc(t *testing.T) *Document {
@@ -303,6 +305,28 @@ func TestDocument_IterInnerContent(t *testing.T) {
 	}
 }
 
+func TestDocument_IterInnerContent_AlternateContent(t *testing.T) {
+	doc := mustNewDoc(t)
+	b, err := doc.getBody()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ac := mustParseXml(t, `<mc:AlternateContent `+wNS+` xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006">`+
+		`<mc:Choice Requires="w14"><w:p><w:r><w:t>choice</w:t></w:r></w:p></mc:Choice>`+
+		`<mc:Fallback><w:p><w:r><w:t>fallback</w:t></w:r></w:p></mc:Fallback></mc:AlternateContent>`)
+	b.insertBeforeSectPr(ac.RawElement())
+
+	var texts []string
+	for _, it := range mustIterInnerContent(t, doc) {
+		if it.IsParagraph() {
+			texts = append(texts, it.Paragraph().Text())
+		}
+	}
+	if len(texts) != 1 || texts[0] != "choice" {
+		t.Errorf("paragraph texts = %q, want [choice]", texts)
+	}
+}
+
 // --------------------------------------------------------------------------
 // blockWidth
 // --------------------------------------------------------------------------
@@ -402,3 +426,261 @@ func TestDocument_Save_ByteStability(t *testing.T) {
 			len(mustParagraphs(t, doc2)), len(mustParagraphs(t, doc3)))
 	}
 }
+
+func TestDocument_ValidateSchema_GeneratedContentIsValid(t *testing.T) {
+	doc := mustNewDoc(t)
+	para, err := doc.AddParagraph("Heading", StyleName("Heading 1"))
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	run, err := para.AddRun("bold")
+	if err != nil {
+		t.Fatalf("AddRun: %v", err)
+	}
+	bold := true
+	if err := run.SetBold(&bold); err != nil {
+		t.Fatalf("SetBold: %v", err)
+	}
+	if _, err := doc.AddTable(2, 2); err != nil {
+		t.Fatalf("AddTable: %v", err)
+	}
+	if _, err := doc.AddComment([]*Run{run}, "note", "author", nil); err != nil {
+		t.Fatalf("AddComment: %v", err)
+	}
+
+	if got := doc.ValidateSchema(); len(got) != 0 {
+		t.Errorf("expected no schema violations, got %v", got)
+	}
+}
+
+func TestDocument_ValidateSchema_ReportsPartAndPath(t *testing.T) {
+	doc := mustNewDoc(t)
+	para, err := doc.AddParagraph("text")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	// Move pPr after the run to break sequence order.
+	pPr := para.CT_P().GetOrAddPPr()
+	para.CT_P().RawElement().RemoveChild(pPr.RawElement())
+	para.CT_P().RawElement().AddChild(pPr.RawElement())
+
+	got := doc.ValidateSchema()
+	if len(got) != 1 {
+		t.Fatalf("expected 1 violation, got %v", got)
+	}
+	if got[0].Part != "/word/document.xml" {
+		t.Errorf("Part = %q, want /word/document.xml", got[0].Part)
+	}
+	if got[0].Path == "" {
+		t.Error("expected a non-empty element path")
+	}
+
+	var ve *ValidationError
+	if err := doc.Validate(); !errors.As(err, &ve) || ve.Part != got[0].Part || ve.XPath != got[0].Path {
+		t.Errorf("Validate() = %v, want a *ValidationError for %v", err, got[0])
+	}
+	if err := mustNewDoc(t).Validate(); err != nil {
+		t.Errorf("Validate() on a new document = %v", err)
+	}
+}
+
+func TestDocument_AddAltChunk(t *testing.T) {
+	doc := mustNewDoc(t)
+	if _, err := doc.AddParagraph("before"); err != nil {
+		t.Fatal(err)
+	}
+	html := []byte("<html><body><p>Hello <b>world</b></p></body></html>")
+	if err := doc.AddAltChunk(html, opc.CTHtml); err != nil {
+		t.Fatalf("AddAltChunk: %v", err)
+	}
+	if err := doc.AddAltChunk([]byte("x"), "application/pdf"); err == nil {
+		t.Error("expected error for unsupported content type")
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+
+	body := doc2.element.Body().RawElement()
+	children := body.ChildElements()
+	chunk := children[len(children)-2] // last is w:sectPr
+	if chunk.Space != "w" || chunk.Tag != "altChunk" {
+		t.Fatalf("expected w:altChunk before sectPr, got %s:%s", chunk.Space, chunk.Tag)
+	}
+	rel := doc2.part.Rels().GetByRID(chunk.SelectAttrValue("r:id", ""))
+	if rel == nil || rel.RelType != opc.RTAFChunk {
+		t.Fatalf("altChunk r:id does not resolve to an aFChunk relationship")
+	}
+	if rel.TargetPart.PartName() != "/word/afchunk1.html" || rel.TargetPart.ContentType() != opc.CTHtml {
+		t.Errorf("chunk part = %s (%s)", rel.TargetPart.PartName(), rel.TargetPart.ContentType())
+	}
+	if blob, _ := rel.TargetPart.Blob(); !bytes.Equal(blob, html) {
+		t.Errorf("chunk content = %q", blob)
+	}
+	if v := doc2.ValidateSchema(); len(v) != 0 {
+		t.Errorf("unexpected schema violations: %v", v)
+	}
+}
+
+func TestDocument_AddAltChunk_Docx(t *testing.T) {
+	src := mustNewDoc(t)
+	if _, err := src.AddParagraph("imported"); err != nil {
+		t.Fatal(err)
+	}
+	var chunk bytes.Buffer
+	if err := src.Save(&chunk); err != nil {
+		t.Fatal(err)
+	}
+
+	doc := mustNewDoc(t)
+	if err := doc.AddAltChunk(chunk.Bytes(), opc.CTWmlDocumentMain); err != nil {
+		t.Fatalf("AddAltChunk: %v", err)
+	}
+	for _, strict := range []bool{false, true} {
+		var buf bytes.Buffer
+		var err error
+		if strict {
+			err = doc.SaveWithOptions(&buf, &SaveOptions{Strict: true})
+		} else {
+			err = doc.Save(&buf)
+		}
+		if err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+		doc2, err := OpenBytes(buf.Bytes())
+		if err != nil {
+			t.Fatalf("OpenBytes (strict %v): %v", strict, err)
+		}
+		var part opc.Part
+		for _, rel := range doc2.part.Rels().All() {
+			if rel.RelType == opc.RTAFChunk {
+				part = rel.TargetPart
+			}
+		}
+		if part == nil {
+			t.Fatalf("no aFChunk relationship after reopening (strict %v)", strict)
+		}
+		if part.PartName() != "/word/afchunk1.docx" {
+			t.Errorf("chunk part = %s", part.PartName())
+		}
+		if blob, _ := part.Blob(); !bytes.Equal(blob, chunk.Bytes()) {
+			t.Errorf("chunk content changed in save and reopen (strict %v)", strict)
+		}
+	}
+}
+
+func TestDocument_Clone(t *testing.T) {
+	tmpl := mustNewDoc(t)
+	if _, err := tmpl.AddParagraph("Dear {name},"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tmpl.AddPicture(bytes.NewReader(minimalPNG()), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 8
+	clones := make([]*Document, n)
+	errs := make(chan error, n)
+	done := make(chan struct{})
+	for i := range clones {
+		go func(i int) {
+			defer func() { done <- struct{}{} }()
+			c, err := tmpl.Clone()
+			if err != nil {
+				errs <- err
+				return
+			}
+			clones[i] = c
+		}(i)
+	}
+	for range clones {
+		<-done
+	}
+	close(errs)
+	for err := range errs {
+		t.Fatalf("Clone: %v", err)
+	}
+
+	c := clones[0]
+	paras := mustParagraphs(t, c)
+	paras[0].SetText("Dear Ada,")
+	if _, err := c.AddParagraph("Only in the copy"); err != nil {
+		t.Fatal(err)
+	}
+	if got := mustParagraphs(t, tmpl)[0].Text(); got != "Dear {name}," {
+		t.Errorf("template text = %q after editing the clone", got)
+	}
+	if got, want := len(mustParagraphs(t, tmpl)), len(paras); got != want {
+		t.Errorf("template has %d paragraphs, want %d", got, want)
+	}
+
+	if got, want := len(c.wmlPkg.ImageParts().All()), len(tmpl.wmlPkg.ImageParts().All()); got != want || got == 0 {
+		t.Errorf("clone has %d image parts, template %d", got, want)
+	}
+	if c.part == tmpl.part || c.part.Package() == tmpl.part.Package() {
+		t.Error("clone shares its document part with the template")
+	}
+
+	var buf bytes.Buffer
+	if err := c.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	reopened, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	rp := mustParagraphs(t, reopened)
+	if rp[0].Text() != "Dear Ada," || rp[len(rp)-1].Text() != "Only in the copy" {
+		t.Errorf("reopened clone text = %q ... %q", rp[0].Text(), rp[len(rp)-1].Text())
+	}
+	shapes, err := reopened.InlineShapes()
+	if err != nil || shapes.Len() != 1 {
+		t.Errorf("reopened clone inline shapes: %v, %v", shapes, err)
+	}
+}
+
+func TestDocument_Clone_CopyOnWrite(t *testing.T) {
+	tmpl := mustNewDoc(t)
+	tsp, err := tmpl.part.StylesPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tsp.ReadElement()
+	c, err := tmpl.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	csp, err := c.part.StylesPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if csp.ReadElement() != tsp.ReadElement() {
+		t.Fatal("clone does not share the styles of the template")
+	}
+
+	styles, err := c.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := styles.AddStyle("Only In Copy", enum.WdStyleTypeParagraph, false); err != nil {
+		t.Fatal(err)
+	}
+	if ts, _ := tmpl.Styles(); ts.Contains("Only In Copy") {
+		t.Error("style added to the clone appears in the template")
+	}
+	settings, err := tmpl.Settings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := settings.SetOddAndEvenPagesHeaderFooter(true); err != nil {
+		t.Fatal(err)
+	}
+	if cs, _ := c.Settings(); cs.OddAndEvenPagesHeaderFooter() {
+		t.Error("setting changed in the template appears in the clone")
+	}
+}
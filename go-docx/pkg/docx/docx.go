@@ -1,23 +1,100 @@
 package docx
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/opc/encryption"
 	"github.com/vortex/go-docx/pkg/docx/parts"
 	"github.com/vortex/go-docx/pkg/docx/templates"
 )
 
-// New creates a new empty Document from the built-in default template.
+// NewOption configures New.
+type NewOption func(*newConfig)
+
+// newConfig holds the settings NewOption functions apply.
+type newConfig struct {
+	path string
+	data []byte
+}
+
+// WithTemplate makes New start from the .docx, .dotx or .dotm file at path
+// instead of the built-in default template.
+func WithTemplate(path string) NewOption {
+	return func(c *newConfig) { c.path, c.data = path, nil }
+}
+
+// WithTemplateBytes makes New start from the given .docx, .dotx or .dotm
+// package instead of the built-in default template.
+func WithTemplateBytes(data []byte) NewOption {
+	return func(c *newConfig) { c.path, c.data = "", data }
+}
+
+// New creates a new Document. Without options it starts from the built-in
+// default template, which DefaultTemplate returns; WithTemplate and
+// WithTemplateBytes start from another one. The template's styles,
+// numbering, headers, footers and body content are all kept.
+//
+// A template (.dotx or .dotm) gives a plain document, as creating a
+// document from a template in Word does: the main part takes the document
+// content type and the VBA project of a .dotm is dropped.
 //
 // Mirrors Python: Document(None) → loads default.docx from templates.
-func New() (*Document, error) {
-	defaultDocx, err := templates.FS.ReadFile("default.docx")
+func New(opts ...NewOption) (*Document, error) {
+	var cfg newConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	data := cfg.data
+	switch {
+	case cfg.path != "":
+		var err error
+		if data, err = os.ReadFile(cfg.path); err != nil {
+			return nil, fmt.Errorf("docx: reading template %q: %w", cfg.path, err)
+		}
+	case data == nil:
+		var err error
+		if data, err = DefaultTemplate(); err != nil {
+			return nil, err
+		}
+	}
+	return newFromTemplateBytes(data)
+}
+
+// NewFromTemplate creates a new Document from the .docx, .dotx or .dotm
+// package read from r, like New with WithTemplateBytes.
+func NewFromTemplate(r io.Reader) (*Document, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("docx: reading template: %w", err)
+	}
+	return newFromTemplateBytes(data)
+}
+
+// DefaultTemplate returns the built-in template New starts from, as a .docx
+// package. Open it with OpenBytes to inspect the styles new documents get.
+func DefaultTemplate() ([]byte, error) {
+	data, err := templates.FS.ReadFile("default.docx")
 	if err != nil {
 		return nil, fmt.Errorf("docx: reading default template: %w", err)
 	}
-	return OpenBytes(defaultDocx)
+	return data, nil
+}
+
+// newFromTemplateBytes opens template data and turns a template flavor
+// into a plain document.
+func newFromTemplateBytes(data []byte) (*Document, error) {
+	doc, err := OpenBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	if doc.ContentTypeKind().IsTemplate() {
+		doc.stripMacros()
+	}
+	return doc, nil
 }
 
 // Open creates a Document from an io.ReaderAt.
@@ -27,7 +104,7 @@ func Open(r io.ReaderAt, size int64) (*Document, error) {
 	factory := parts.NewDocxPartFactory()
 	pkg, err := opc.Open(r, size, factory)
 	if err != nil {
-		return nil, fmt.Errorf("docx: opening package: %w", err)
+		return nil, fmt.Errorf("docx: opening package: %w", legacyError(err, readerAtBytes(r, size)))
 	}
 	return documentFromPackage(pkg)
 }
@@ -39,7 +116,7 @@ func OpenFile(path string) (*Document, error) {
 	factory := parts.NewDocxPartFactory()
 	pkg, err := opc.OpenFile(path, factory)
 	if err != nil {
-		return nil, fmt.Errorf("docx: opening file %q: %w", path, err)
+		return nil, fmt.Errorf("docx: opening file %q: %w", path, legacyError(err, fileBytes(path)))
 	}
 	return documentFromPackage(pkg)
 }
@@ -49,11 +126,85 @@ func OpenBytes(data []byte) (*Document, error) {
 	factory := parts.NewDocxPartFactory()
 	pkg, err := opc.OpenBytes(data, factory)
 	if err != nil {
-		return nil, fmt.Errorf("docx: opening bytes: %w", err)
+		return nil, fmt.Errorf("docx: opening bytes: %w", legacyError(err, constBytes(data)))
+	}
+	return documentFromPackage(pkg)
+}
+
+// ProgressFunc receives the progress of a long operation, such as saving
+// or comparing: done of total steps of the named stage are complete.
+type ProgressFunc = opc.ProgressFunc
+
+// OpenWithOptions is like Open but applies the resource limits of opts,
+// which may be nil; use it for documents from untrusted sources.
+func OpenWithOptions(r io.ReaderAt, size int64, opts *opc.OpenOptions) (*Document, error) {
+	pkg, err := opc.OpenWithOptions(r, size, parts.NewDocxPartFactory(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("docx: opening package: %w", legacyError(err, readerAtBytes(r, size)))
+	}
+	return documentFromPackage(pkg)
+}
+
+// OpenFileWithOptions is like OpenFile but applies the resource limits of
+// opts, which may be nil.
+func OpenFileWithOptions(path string, opts *opc.OpenOptions) (*Document, error) {
+	pkg, err := opc.OpenFileWithOptions(path, parts.NewDocxPartFactory(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("docx: opening file %q: %w", path, legacyError(err, fileBytes(path)))
+	}
+	return documentFromPackage(pkg)
+}
+
+// OpenBytesWithOptions is like OpenBytes but applies the resource limits
+// of opts, which may be nil.
+func OpenBytesWithOptions(data []byte, opts *opc.OpenOptions) (*Document, error) {
+	pkg, err := opc.OpenBytesWithOptions(data, parts.NewDocxPartFactory(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("docx: opening bytes: %w", legacyError(err, constBytes(data)))
 	}
 	return documentFromPackage(pkg)
 }
 
+// OpenContext is like Open but stops with the context's error once ctx is
+// done, so a deadline bounds the time spent on a large or hostile package.
+func OpenContext(ctx context.Context, r io.ReaderAt, size int64) (*Document, error) {
+	return OpenWithOptions(r, size, &opc.OpenOptions{Context: ctx})
+}
+
+// OpenBytesContext is like OpenContext but reads the document from a byte
+// slice.
+func OpenBytesContext(ctx context.Context, data []byte) (*Document, error) {
+	return OpenBytesWithOptions(data, &opc.OpenOptions{Context: ctx})
+}
+
+// OpenFileWithPassword opens a password-protected (agile-encrypted) .docx
+// file. Files that are not encrypted are opened as usual and the password
+// is ignored. A wrong password yields an error wrapping
+// encryption.ErrWrongPassword.
+func OpenFileWithPassword(path, password string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("docx: opening file %q: %w", path, err)
+	}
+	return OpenBytesWithPassword(data, password)
+}
+
+// OpenBytesWithPassword is like OpenFileWithPassword but reads the
+// document from a byte slice.
+func OpenBytesWithPassword(data []byte, password string) (*Document, error) {
+	if !encryption.IsEncrypted(data) {
+		return OpenBytes(data)
+	}
+	if f, ok := DetectLegacyFormat(data); ok {
+		return nil, &LegacyFormatError{Format: f}
+	}
+	plain, err := encryption.Decrypt(data, password)
+	if err != nil {
+		return nil, fmt.Errorf("docx: decrypting package: %w", err)
+	}
+	return OpenBytes(plain)
+}
+
 // documentFromPackage wires up a Document from a loaded OpcPackage.
 //
 // Mirrors Python api.py logic:
@@ -70,9 +221,10 @@ func documentFromPackage(pkg *opc.OpcPackage) (*Document, error) {
 	if !ok {
 		return nil, fmt.Errorf("docx: main part is %T, expected *DocumentPart", mainPart)
 	}
-	// Validate content type (mirrors Python check: CT.WML_DOCUMENT_MAIN).
+	// Validate content type (mirrors Python check: CT.WML_DOCUMENT_MAIN),
+	// additionally accepting the macro-enabled and template flavors.
 	ct := docPart.ContentType()
-	if ct != opc.CTWmlDocumentMain && ct != opc.CTWmlDocument {
+	if _, ok := contentTypeKinds[ct]; !ok && ct != opc.CTWmlDocument {
 		return nil, fmt.Errorf("docx: not a Word file, content type is %q", ct)
 	}
 	// Create WmlPackage wrapper, run AfterUnmarshal to gather image parts.
@@ -84,5 +236,12 @@ func documentFromPackage(pkg *opc.OpcPackage) (*Document, error) {
 	// OpcPackage — every Part._package IS the WML-level Package.
 	pkg.SetAppPackage(wmlPkg)
 
+	if pkg.Strict() {
+		// The parts now use Transitional namespaces; so does the document
+		// until saved with SaveOptions.Strict.
+		if el := docPart.Element(); el != nil {
+			el.RemoveAttr("w:conformance")
+		}
+	}
 	return newDocument(docPart, wmlPkg)
 }
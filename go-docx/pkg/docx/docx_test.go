@@ -2,7 +2,14 @@ package docx
 
 import (
 	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/opc/encryption"
 )
 
 func TestNew(t *testing.T) {
@@ -59,3 +66,121 @@ func TestOpenBytes_RoundTrip(t *testing.T) {
 		t.Fatal("OpenBytes() returned nil")
 	}
 }
+
+func TestOpenFileWithPassword_RoundTrip(t *testing.T) {
+	doc, err := New()
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	if _, err := doc.AddParagraph("secret text"); err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "locked.docx")
+	if err := doc.SaveFileEncrypted(path, "pa55"); err != nil {
+		t.Fatalf("SaveFileEncrypted: %v", err)
+	}
+
+	if _, err := OpenFile(path); !errors.Is(err, opc.ErrEncryptedPackage) {
+		t.Errorf("OpenFile on encrypted file: expected ErrEncryptedPackage, got %v", err)
+	}
+	if _, err := OpenFileWithPassword(path, "nope"); !errors.Is(err, encryption.ErrWrongPassword) {
+		t.Errorf("expected ErrWrongPassword, got %v", err)
+	}
+
+	doc2, err := OpenFileWithPassword(path, "pa55")
+	if err != nil {
+		t.Fatalf("OpenFileWithPassword: %v", err)
+	}
+	paras, err := doc2.Paragraphs()
+	if err != nil {
+		t.Fatalf("Paragraphs: %v", err)
+	}
+	if len(paras) != 1 || paras[0].Text() != "secret text" {
+		t.Errorf("unexpected paragraphs after decrypt: %d", len(paras))
+	}
+}
+
+func TestOpenBytesWithPassword_PlainPackage(t *testing.T) {
+	doc, err := New()
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	if _, err := OpenBytesWithPassword(buf.Bytes(), "ignored"); err != nil {
+		t.Fatalf("OpenBytesWithPassword on unencrypted package: %v", err)
+	}
+}
+
+func TestNew_WithTemplate(t *testing.T) {
+	src := mustNewDoc(t)
+	styles, err := src.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := styles.AddStyle("Corporate Body", enum.WdStyleTypeParagraph, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.AddParagraph("Letterhead"); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := src.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "corporate.docx")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, opt := range map[string]NewOption{
+		"path":  WithTemplate(path),
+		"bytes": WithTemplateBytes(buf.Bytes()),
+	} {
+		doc, err := New(opt)
+		if err != nil {
+			t.Fatalf("%s: New: %v", name, err)
+		}
+		if ss, err := doc.Styles(); err != nil || !ss.Contains("Corporate Body") {
+			t.Errorf("%s: template style missing", name)
+		}
+		paras, err := doc.Paragraphs()
+		if err != nil || len(paras) != 1 || paras[0].Text() != "Letterhead" {
+			t.Errorf("%s: template content not kept", name)
+		}
+	}
+
+	if _, err := New(WithTemplate(filepath.Join(t.TempDir(), "missing.dotx"))); err == nil {
+		t.Error("expected error for a missing template")
+	}
+}
+
+func TestNewFromTemplate_Dotm(t *testing.T) {
+	data := makeFlavoredPackage(t, opc.CTWmlTemplateMacroEnabled, true)
+	doc, err := NewFromTemplate(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := doc.ContentTypeKind(); got != KindDocument {
+		t.Errorf("ContentTypeKind = %s, want %s", got, KindDocument)
+	}
+	if doc.HasMacros() {
+		t.Error("macros kept from the template")
+	}
+}
+
+func TestDefaultTemplate(t *testing.T) {
+	data, err := DefaultTemplate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := OpenBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ss, err := doc.Styles(); err != nil || !ss.Contains("Heading 1") {
+		t.Error("default template lacks Heading 1")
+	}
+}
@@ -254,6 +254,23 @@ func TestParagraph_Runs(t *testing.T) {
 	}
 }
 
+func TestParagraph_Runs_AlternateContent(t *testing.T) {
+	p := makeP(t, `<mc:AlternateContent xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006">`+
+		`<mc:Choice Requires="w14"><w:r><w:t>A</w:t></w:r></mc:Choice>`+
+		`<mc:Fallback><w:r><w:t>old</w:t></w:r></mc:Fallback></mc:AlternateContent><w:r><w:t>B</w:t></w:r>`)
+	para := newParagraph(p, nil)
+	runs := para.Runs()
+	if len(runs) != 2 {
+		t.Fatalf("len(Runs) = %d, want 2", len(runs))
+	}
+	if runs[0].Text() != "A" || runs[1].Text() != "B" {
+		t.Errorf("run texts = %q, %q, want A, B", runs[0].Text(), runs[1].Text())
+	}
+	if para.Text() != "AB" {
+		t.Errorf("Text() = %q, want AB", para.Text())
+	}
+}
+
 func TestParagraph_AddRun(t *testing.T) {
 	p := makeP(t, "")
 	para := newParagraph(p, nil)
@@ -776,7 +793,7 @@ func TestInlineShapes_Len(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	iss := newInlineShapes(el)
+	iss := newInlineShapes(el, nil)
 	if iss.Len() != 2 {
 		t.Errorf("Len() = %d, want 2", iss.Len())
 	}
@@ -790,7 +807,7 @@ func TestInlineShapes_Empty(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	iss := newInlineShapes(el)
+	iss := newInlineShapes(el, nil)
 	if iss.Len() != 0 {
 		t.Errorf("Len() = %d, want 0", iss.Len())
 	}
@@ -61,7 +61,7 @@ func (d *Drawing) ImagePart() (*parts.ImagePart, error) {
 	relParts := rels.RelatedParts()
 	p, ok := relParts[rId]
 	if !ok {
-		return nil, fmt.Errorf("docx: no related part for rId %q", rId)
+		return nil, fmt.Errorf("docx: no related part for rId %q: %w", rId, ErrPartNotFound)
 	}
 	ip, ok := p.(*parts.ImagePart)
 	if !ok {
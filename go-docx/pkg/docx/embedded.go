@@ -0,0 +1,112 @@
+package docx
+
+import (
+	"fmt"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// officeNS is the namespace of the VML Office extensions (o:OLEObject).
+const officeNS = "urn:schemas-microsoft-com:office:office"
+
+// EmbeddedObject is an object embedded in the document, such as a
+// worksheet, a presentation or a PDF: either an OLE object (a binary .bin
+// part) or a whole package (an .xlsx, .pptx or .docx part).
+//
+// The object's bytes are kept exactly as read. What the document shows in
+// its place is a separate preview image, which Word refreshes when the
+// object is next opened.
+type EmbeddedObject struct {
+	el   *etree.Element
+	rel  *opc.Relationship
+	part opc.Part
+}
+
+// EmbeddedObjects returns the objects embedded in the body, headers,
+// footers, notes and comments, in document order within each of them.
+func (d *Document) EmbeddedObjects() []*EmbeddedObject {
+	var result []*EmbeddedObject
+	for _, part := range d.wmlPkg.IterParts() {
+		xp, ok := part.(interface{ ReadElement() *etree.Element })
+		if !ok || xp.ReadElement() == nil || part.Rels() == nil {
+			continue
+		}
+		stack := []*etree.Element{xp.ReadElement()}
+		for len(stack) > 0 {
+			el := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for i := len(el.Child) - 1; i >= 0; i-- {
+				if child, ok := el.Child[i].(*etree.Element); ok {
+					stack = append(stack, child)
+				}
+			}
+			if !isEmbedElement(el) {
+				continue
+			}
+			rel := part.Rels().GetByRID(el.SelectAttrValue("r:id", ""))
+			if rel == nil || rel.IsExternal || rel.TargetPart == nil {
+				continue // linked objects live outside the package
+			}
+			if rel.RelType != opc.RTOleObject && rel.RelType != opc.RTPackage {
+				continue
+			}
+			result = append(result, &EmbeddedObject{el: el, rel: rel, part: rel.TargetPart})
+		}
+	}
+	return result
+}
+
+// isEmbedElement reports whether el refers to an embedded object: the
+// VML o:OLEObject of classic objects or the w:objectEmbed of newer ones.
+func isEmbedElement(el *etree.Element) bool {
+	switch el.Tag {
+	case "OLEObject":
+		return el.NamespaceURI() == officeNS && el.SelectAttrValue("Type", "Embed") == "Embed"
+	case "objectEmbed":
+		return el.Space == "w"
+	}
+	return false
+}
+
+// ProgID returns the program that edits the object, e.g. "Excel.Sheet.12"
+// or "AcroExch.Document.DC", or "" when the document does not say.
+func (o *EmbeddedObject) ProgID() string {
+	if v := o.el.SelectAttrValue("ProgID", ""); v != "" {
+		return v
+	}
+	return o.el.SelectAttrValue("w:progId", "")
+}
+
+// IsPackage reports whether the object is stored as a package in its own
+// format (e.g. .xlsx) rather than as an OLE compound file.
+func (o *EmbeddedObject) IsPackage() bool { return o.rel.RelType == opc.RTPackage }
+
+// PartName returns the name of the part holding the object, e.g.
+// /word/embeddings/Microsoft_Excel_Worksheet.xlsx.
+func (o *EmbeddedObject) PartName() string { return string(o.part.PartName()) }
+
+// ContentType returns the content type of the part holding the object.
+func (o *EmbeddedObject) ContentType() string { return o.part.ContentType() }
+
+// Blob returns the bytes of the object: the file itself for package
+// objects, the OLE compound file otherwise.
+func (o *EmbeddedObject) Blob() ([]byte, error) {
+	blob, err := o.part.Blob()
+	if err != nil {
+		return nil, fmt.Errorf("docx: reading embedded object %s: %w", o.part.PartName(), err)
+	}
+	return blob, nil
+}
+
+// Replace stores blob as the new content of the object. It must be in the
+// same format as before: a package of the same type, or an OLE compound
+// file for OLE objects.
+func (o *EmbeddedObject) Replace(blob []byte) error {
+	bp, ok := o.part.(interface{ SetBlob([]byte) })
+	if !ok {
+		return fmt.Errorf("docx: embedded object %s cannot be replaced", o.part.PartName())
+	}
+	bp.SetBlob(blob)
+	return nil
+}
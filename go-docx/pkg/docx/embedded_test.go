@@ -0,0 +1,78 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// addEmbeddedObject embeds blob in d the way Word does, as a w:object
+// whose o:OLEObject refers to the embedding part.
+func addEmbeddedObject(t *testing.T, d *Document, pn, contentType, relType, progID string, blob []byte) {
+	t.Helper()
+	part := opc.NewBasePart(opc.PackURI(pn), contentType, blob, d.wmlPkg.OpcPackage)
+	d.wmlPkg.AddPart(part)
+	rID := d.part.Rels().GetOrAdd(relType, part).RID
+	p, err := d.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj := p.p.RawElement().CreateElement("w:r").CreateElement("w:object")
+	ole := obj.CreateElement("o:OLEObject")
+	ole.CreateAttr("xmlns:o", officeNS)
+	ole.CreateAttr("Type", "Embed")
+	ole.CreateAttr("ProgID", progID)
+	ole.CreateAttr("r:id", rID)
+}
+
+func TestDocument_EmbeddedObjects(t *testing.T) {
+	d := mustNewDoc(t)
+	xlsx := []byte("PK\x03\x04 not really a workbook \x00\xff")
+	ole := []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1, 0x00, 0x0A}
+	addEmbeddedObject(t, d, "/word/embeddings/Microsoft_Excel_Worksheet.xlsx",
+		opc.CTSmlSheet, opc.RTPackage, "Excel.Sheet.12", xlsx)
+	addEmbeddedObject(t, d, "/word/embeddings/oleObject1.bin",
+		opc.CTOfcOleObject, opc.RTOleObject, "AcroExch.Document.DC", ole)
+
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	reopened, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	objs := reopened.EmbeddedObjects()
+	if len(objs) != 2 {
+		t.Fatalf("EmbeddedObjects = %d, want 2", len(objs))
+	}
+	if !objs[0].IsPackage() || objs[0].ProgID() != "Excel.Sheet.12" || objs[0].ContentType() != opc.CTSmlSheet {
+		t.Errorf("first object = %s %q %s", objs[0].PartName(), objs[0].ProgID(), objs[0].ContentType())
+	}
+	if objs[1].IsPackage() || objs[1].ProgID() != "AcroExch.Document.DC" {
+		t.Errorf("second object = %s %q", objs[1].PartName(), objs[1].ProgID())
+	}
+	for i, want := range [][]byte{xlsx, ole} {
+		got, err := objs[i].Blob()
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("object %d blob = %x, %v; want %x", i, got, err, want)
+		}
+	}
+
+	replaced := []byte("PK\x03\x04 another workbook")
+	if err := objs[0].Replace(replaced); err != nil {
+		t.Fatalf("Replace: %v", err)
+	}
+	buf.Reset()
+	if err := reopened.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	again, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := again.EmbeddedObjects()[0].Blob(); !bytes.Equal(got, replaced) {
+		t.Errorf("replaced blob = %q", got)
+	}
+}
@@ -76,3 +76,37 @@ func (v MsoThemeColorIndex) ToXml() (string, error) {
 func MsoThemeColorIndexFromXml(s string) (MsoThemeColorIndex, error) {
 	return FromXml(msoThemeColorIndexFromXml, s)
 }
+
+// ---------------------------------------------------------------------------
+// MsoGradientType — a:path/@path values
+// ---------------------------------------------------------------------------
+
+// MsoGradientType specifies how the colors of a gradient fill blend: along
+// a line at an angle, or outward from the center in the given shape.
+type MsoGradientType int
+
+const (
+	MsoGradientLinear      MsoGradientType = 0
+	MsoGradientRadial      MsoGradientType = 1
+	MsoGradientRectangular MsoGradientType = 2
+	MsoGradientPath        MsoGradientType = 3
+)
+
+// msoGradientTypeToXml maps the path gradients to a:path/@path; a linear
+// gradient is written as a:lin instead and has no value.
+var msoGradientTypeToXml = map[MsoGradientType]string{
+	MsoGradientRadial:      "circle",
+	MsoGradientRectangular: "rect",
+	MsoGradientPath:        "shape",
+}
+
+var msoGradientTypeFromXml = invertMap(msoGradientTypeToXml)
+
+// ToXml returns the a:path/@path value for this gradient type.
+// LINEAR has no XML representation (UNMAPPED).
+func (v MsoGradientType) ToXml() (string, error) { return ToXml(msoGradientTypeToXml, v) }
+
+// MsoGradientTypeFromXml returns the gradient type for the given a:path/@path value.
+func MsoGradientTypeFromXml(s string) (MsoGradientType, error) {
+	return FromXml(msoGradientTypeFromXml, s)
+}
@@ -458,3 +458,58 @@ func TestToXmlReturnsErrorForUnmappedValues(t *testing.T) {
 		t.Error("expected error for WdParagraphAlignment(999).ToXml(), got nil")
 	}
 }
+
+func TestWdTextOrientationRoundTrip(t *testing.T) {
+	t.Parallel()
+	for val, xml := range wdTextOrientationToXml {
+		got, err := WdTextOrientationFromXml(xml)
+		if err != nil {
+			t.Fatalf("round-trip error for %q: %v", xml, err)
+		}
+		if got != val {
+			t.Errorf("round-trip failed: xml=%q", xml)
+		}
+	}
+	if got, err := WdTextOrientationFromXml("lr"); err != nil || got != WdTextOrientationUpward {
+		t.Errorf("strict lr = %v, %v", got, err)
+	}
+}
+
+func TestWdTextureIndexRoundTrip(t *testing.T) {
+	t.Parallel()
+	for val, xml := range wdTextureIndexToXml {
+		got, err := WdTextureIndexFromXml(xml)
+		if err != nil {
+			t.Fatalf("round-trip error for %q: %v", xml, err)
+		}
+		if got != val {
+			t.Errorf("round-trip failed: xml=%q", xml)
+		}
+	}
+	if got, err := WdTextureIndexFromXml("nil"); err != nil || got != WdTextureNone {
+		t.Errorf("nil = %v, %v", got, err)
+	}
+}
+
+func TestMsoGradientTypeRoundTrip(t *testing.T) {
+	t.Parallel()
+	for val, xml := range msoGradientTypeToXml {
+		got, err := MsoGradientTypeFromXml(xml)
+		if err != nil || got != val {
+			t.Errorf("round-trip %q = %v, %v", xml, got, err)
+		}
+	}
+	if _, err := MsoGradientLinear.ToXml(); err == nil {
+		t.Error("MsoGradientLinear.ToXml() succeeded")
+	}
+}
+
+func TestWdVerticalAlignmentRoundTrip(t *testing.T) {
+	t.Parallel()
+	for val, xml := range wdVerticalAlignmentToXml {
+		got, err := WdVerticalAlignmentFromXml(xml)
+		if err != nil || got != val {
+			t.Errorf("round-trip %q = %v, %v", xml, got, err)
+		}
+	}
+}
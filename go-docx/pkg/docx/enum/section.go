@@ -100,3 +100,36 @@ func (v WdSectionStart) ToXml() (string, error) { return ToXml(wdSectionStartToX
 func WdSectionStartFromXml(s string) (WdSectionStart, error) {
 	return FromXml(wdSectionStartFromXml, s)
 }
+
+// ---------------------------------------------------------------------------
+// WdVerticalAlignment
+// ---------------------------------------------------------------------------
+
+// WdVerticalAlignment specifies how the text of a section is placed
+// between the top and bottom margins of its pages.
+// MS API name: WdVerticalAlignment
+type WdVerticalAlignment int
+
+const (
+	WdVerticalAlignmentTop     WdVerticalAlignment = 0
+	WdVerticalAlignmentCenter  WdVerticalAlignment = 1
+	WdVerticalAlignmentJustify WdVerticalAlignment = 2
+	WdVerticalAlignmentBottom  WdVerticalAlignment = 3
+)
+
+var wdVerticalAlignmentToXml = map[WdVerticalAlignment]string{
+	WdVerticalAlignmentTop:     "top",
+	WdVerticalAlignmentCenter:  "center",
+	WdVerticalAlignmentJustify: "both",
+	WdVerticalAlignmentBottom:  "bottom",
+}
+
+var wdVerticalAlignmentFromXml = invertMap(wdVerticalAlignmentToXml)
+
+// ToXml returns the XML attribute value for this vertical alignment.
+func (v WdVerticalAlignment) ToXml() (string, error) { return ToXml(wdVerticalAlignmentToXml, v) }
+
+// WdVerticalAlignmentFromXml returns the vertical alignment for the given XML value.
+func WdVerticalAlignmentFromXml(s string) (WdVerticalAlignment, error) {
+	return FromXml(wdVerticalAlignmentFromXml, s)
+}
@@ -345,3 +345,158 @@ func (v WdUnderline) String() string {
 	}
 	return fmt.Sprintf("WdUnderline(%d)", int(v))
 }
+
+// ---------------------------------------------------------------------------
+// WdTextOrientation
+// ---------------------------------------------------------------------------
+
+// WdTextOrientation specifies the direction text flows in a table cell,
+// text frame or section.
+// MS API name: WdTextOrientation
+type WdTextOrientation int
+
+const (
+	WdTextOrientationHorizontal               WdTextOrientation = 0
+	WdTextOrientationVerticalFarEast          WdTextOrientation = 1
+	WdTextOrientationUpward                   WdTextOrientation = 2
+	WdTextOrientationDownward                 WdTextOrientation = 3
+	WdTextOrientationHorizontalRotatedFarEast WdTextOrientation = 4
+	WdTextOrientationVerticalRotatedFarEast   WdTextOrientation = 5
+)
+
+var wdTextOrientationToXml = map[WdTextOrientation]string{
+	WdTextOrientationHorizontal:               "lrTb",
+	WdTextOrientationVerticalFarEast:          "tbRlV",
+	WdTextOrientationUpward:                   "btLr",
+	WdTextOrientationDownward:                 "tbRl",
+	WdTextOrientationHorizontalRotatedFarEast: "lrTbV",
+	WdTextOrientationVerticalRotatedFarEast:   "tbLrV",
+}
+
+// wdTextOrientationFromXml also accepts the values of Strict documents.
+var wdTextOrientationFromXml = func() map[string]WdTextOrientation {
+	m := invertMap(wdTextOrientationToXml)
+	m["tb"] = WdTextOrientationHorizontal
+	m["rl"] = WdTextOrientationDownward
+	m["lr"] = WdTextOrientationUpward
+	m["tbV"] = WdTextOrientationHorizontalRotatedFarEast
+	m["rlV"] = WdTextOrientationVerticalFarEast
+	m["lrV"] = WdTextOrientationVerticalRotatedFarEast
+	return m
+}()
+
+// ToXml returns the XML attribute value for this text orientation.
+func (v WdTextOrientation) ToXml() (string, error) { return ToXml(wdTextOrientationToXml, v) }
+
+// WdTextOrientationFromXml returns the text orientation for the given XML value.
+func WdTextOrientationFromXml(s string) (WdTextOrientation, error) {
+	return FromXml(wdTextOrientationFromXml, s)
+}
+
+// ---------------------------------------------------------------------------
+// WdTextureIndex (alias: WdTexture)
+// ---------------------------------------------------------------------------
+
+// WdTextureIndex specifies the pattern of shading laid over a fill color:
+// a percentage of the pattern color, or stripes and crosses of it.
+// MS API name: WdTextureIndex
+type WdTextureIndex int
+
+const (
+	WdTextureNone              WdTextureIndex = 0
+	WdTexture5Percent          WdTextureIndex = 50
+	WdTexture10Percent         WdTextureIndex = 100
+	WdTexture12Pt5Percent      WdTextureIndex = 125
+	WdTexture15Percent         WdTextureIndex = 150
+	WdTexture20Percent         WdTextureIndex = 200
+	WdTexture25Percent         WdTextureIndex = 250
+	WdTexture30Percent         WdTextureIndex = 300
+	WdTexture35Percent         WdTextureIndex = 350
+	WdTexture37Pt5Percent      WdTextureIndex = 375
+	WdTexture40Percent         WdTextureIndex = 400
+	WdTexture45Percent         WdTextureIndex = 450
+	WdTexture50Percent         WdTextureIndex = 500
+	WdTexture55Percent         WdTextureIndex = 550
+	WdTexture60Percent         WdTextureIndex = 600
+	WdTexture62Pt5Percent      WdTextureIndex = 625
+	WdTexture65Percent         WdTextureIndex = 650
+	WdTexture70Percent         WdTextureIndex = 700
+	WdTexture75Percent         WdTextureIndex = 750
+	WdTexture80Percent         WdTextureIndex = 800
+	WdTexture85Percent         WdTextureIndex = 850
+	WdTexture87Pt5Percent      WdTextureIndex = 875
+	WdTexture90Percent         WdTextureIndex = 900
+	WdTexture95Percent         WdTextureIndex = 950
+	WdTextureSolid             WdTextureIndex = 1000
+	WdTextureDarkHorizontal    WdTextureIndex = -1
+	WdTextureDarkVertical      WdTextureIndex = -2
+	WdTextureDarkDiagonalDown  WdTextureIndex = -3
+	WdTextureDarkDiagonalUp    WdTextureIndex = -4
+	WdTextureDarkCross         WdTextureIndex = -5
+	WdTextureDarkDiagonalCross WdTextureIndex = -6
+	WdTextureHorizontal        WdTextureIndex = -7
+	WdTextureVertical          WdTextureIndex = -8
+	WdTextureDiagonalDown      WdTextureIndex = -9
+	WdTextureDiagonalUp        WdTextureIndex = -10
+	WdTextureCross             WdTextureIndex = -11
+	WdTextureDiagonalCross     WdTextureIndex = -12
+)
+
+// WdTexture is an alias for WdTextureIndex.
+type WdTexture = WdTextureIndex
+
+var wdTextureIndexToXml = map[WdTextureIndex]string{
+	WdTextureNone:              "clear",
+	WdTexture5Percent:          "pct5",
+	WdTexture10Percent:         "pct10",
+	WdTexture12Pt5Percent:      "pct12",
+	WdTexture15Percent:         "pct15",
+	WdTexture20Percent:         "pct20",
+	WdTexture25Percent:         "pct25",
+	WdTexture30Percent:         "pct30",
+	WdTexture35Percent:         "pct35",
+	WdTexture37Pt5Percent:      "pct37",
+	WdTexture40Percent:         "pct40",
+	WdTexture45Percent:         "pct45",
+	WdTexture50Percent:         "pct50",
+	WdTexture55Percent:         "pct55",
+	WdTexture60Percent:         "pct60",
+	WdTexture62Pt5Percent:      "pct62",
+	WdTexture65Percent:         "pct65",
+	WdTexture70Percent:         "pct70",
+	WdTexture75Percent:         "pct75",
+	WdTexture80Percent:         "pct80",
+	WdTexture85Percent:         "pct85",
+	WdTexture87Pt5Percent:      "pct87",
+	WdTexture90Percent:         "pct90",
+	WdTexture95Percent:         "pct95",
+	WdTextureSolid:             "solid",
+	WdTextureDarkHorizontal:    "horzStripe",
+	WdTextureDarkVertical:      "vertStripe",
+	WdTextureDarkDiagonalDown:  "diagStripe",
+	WdTextureDarkDiagonalUp:    "reverseDiagStripe",
+	WdTextureDarkCross:         "horzCross",
+	WdTextureDarkDiagonalCross: "diagCross",
+	WdTextureHorizontal:        "thinHorzStripe",
+	WdTextureVertical:          "thinVertStripe",
+	WdTextureDiagonalDown:      "thinDiagStripe",
+	WdTextureDiagonalUp:        "thinReverseDiagStripe",
+	WdTextureCross:             "thinHorzCross",
+	WdTextureDiagonalCross:     "thinDiagCross",
+}
+
+// wdTextureIndexFromXml also reads "nil", no shading at all, as no
+// pattern.
+var wdTextureIndexFromXml = func() map[string]WdTextureIndex {
+	m := invertMap(wdTextureIndexToXml)
+	m["nil"] = WdTextureNone
+	return m
+}()
+
+// ToXml returns the w:shd/@w:val value for this pattern.
+func (v WdTextureIndex) ToXml() (string, error) { return ToXml(wdTextureIndexToXml, v) }
+
+// WdTextureIndexFromXml returns the pattern for the given w:shd/@w:val value.
+func WdTextureIndexFromXml(s string) (WdTextureIndex, error) {
+	return FromXml(wdTextureIndexFromXml, s)
+}
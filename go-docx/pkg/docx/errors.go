@@ -1,9 +1,39 @@
 package docx
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// Sentinel errors for the kinds of failure callers commonly branch on. They
+// are wrapped with context, so test for them with errors.Is.
+var (
+	// ErrPartNotFound reports that a part the operation needs, such as the
+	// target of a relationship, is missing from the package.
+	ErrPartNotFound = opc.ErrPartNotFound
+
+	// ErrInvalidIndex reports an index outside a collection.
+	ErrInvalidIndex = errors.New("docx: index out of range")
+
+	// ErrStyleNotFound reports that no style has the requested name.
+	ErrStyleNotFound = oxml.ErrStyleNotFound
+
+	// ErrWrongStyleType reports a style applied where a style of another
+	// type is needed, such as a table style given to a paragraph.
+	ErrWrongStyleType = oxml.ErrWrongStyleType
+
+	// ErrNotRectangularSpan reports that the cells given to Cell.Merge do
+	// not bound a rectangular region of the table.
+	ErrNotRectangularSpan = oxml.ErrNotRectangularSpan
+)
 
 // DocxError is the base error type for all go-docx errors.
 // It implements Unwrap() so errors.Is / errors.As traverse the chain.
+// The typed errors below embed it, and errors.As with a **DocxError target
+// matches them too.
 type DocxError struct {
 	msg   string
 	cause error
@@ -12,6 +42,16 @@ type DocxError struct {
 func (e *DocxError) Error() string { return e.msg }
 func (e *DocxError) Unwrap() error { return e.cause }
 
+// As sets a **DocxError target to e. Being promoted to the typed errors
+// embedding DocxError, it lets errors.As find their DocxError.
+func (e *DocxError) As(target any) bool {
+	if t, ok := target.(**DocxError); ok {
+		*t = e
+		return true
+	}
+	return false
+}
+
 // NewDocxError creates a DocxError. cause may be nil.
 func NewDocxError(cause error, msg string, args ...any) *DocxError {
 	return &DocxError{msg: fmt.Sprintf(msg, args...), cause: cause}
@@ -38,7 +78,43 @@ func NewInvalidSpanError(cause error, msg string, args ...any) *InvalidSpanError
 	return &InvalidSpanError{DocxError{msg: fmt.Sprintf(msg, args...), cause: cause}}
 }
 
+// StyleNotFoundError reports a style name that matches no style, with the
+// UI names of similarly spelled styles in Suggestions. Adding content with
+// an unknown StyleName returns one; it matches ErrStyleNotFound.
+type StyleNotFoundError = oxml.StyleNotFoundError
+
+// ValidationError describes markup that breaks the OOXML content model,
+// locating it by partname and element path. Document.Validate returns one
+// per violation found.
+type ValidationError struct {
+	DocxError
+	Part  string // partname, e.g. "/word/document.xml"
+	XPath string // element location, e.g. "/w:document/w:body/w:p[2]/w:pPr"
+	Msg   string // human-readable description
+}
+
+// NewValidationError creates a ValidationError for markup at element path
+// xpath of part part.
+func NewValidationError(part, xpath, msg string) *ValidationError {
+	return &ValidationError{Part: part, XPath: xpath, Msg: msg}
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("docx: invalid markup at %s%s: %s", e.Part, e.XPath, e.Msg)
+}
+
+// As sets a **DocxError target to a DocxError carrying the message of e,
+// which is formatted from its fields rather than stored in the embedded
+// DocxError, so that a ValidationError built as a literal matches too.
+func (e *ValidationError) As(target any) bool {
+	if t, ok := target.(**DocxError); ok {
+		*t = &DocxError{msg: e.Error(), cause: e.cause}
+		return true
+	}
+	return false
+}
+
 // errIndexOutOfRange returns an IndexError-equivalent for collections.
 func errIndexOutOfRange(collection string, idx, length int) error {
-	return fmt.Errorf("docx: %s index [%d] out of range (len=%d)", collection, idx, length)
+	return fmt.Errorf("docx: %s index [%d] out of range (len=%d): %w", collection, idx, length, ErrInvalidIndex)
 }
@@ -0,0 +1,262 @@
+package docx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/vortex/go-docx/internal/xmltok"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// ExtractTextOptions configures ExtractTextFastWithOptions. The zero value
+// extracts the body only.
+type ExtractTextOptions struct {
+	// HeadersFooters adds the text of the headers and footers after the
+	// body, in the order the main part's relationships list them.
+	HeadersFooters bool
+	// Open limits the sizes read from the package; nil applies the
+	// defaults of opc.OpenOptions.
+	Open *opc.OpenOptions
+}
+
+// ExtractTextFast returns the text of the body of the Word package read
+// from r, one line per paragraph. It reads only the parts it needs and
+// scans their XML as a token stream, building no document tree, which
+// makes it suited to indexing large numbers of documents.
+//
+// The text of a paragraph is that of its runs, including those in
+// hyperlinks, content controls and field results, with tabs as "\t" and
+// line breaks as "\n". Deleted text, field codes, fallback content and
+// phonetic guides (ruby text) are skipped. Tables contribute one line per
+// cell paragraph; the paragraphs of a text box are lines of their own,
+// inside the line of the paragraph anchoring it.
+//
+// r is read to the end unless it is an io.ReaderAt with a Size method,
+// such as *bytes.Reader, or an *os.File.
+func ExtractTextFast(r io.Reader) (string, error) {
+	return ExtractTextFastWithOptions(r, nil)
+}
+
+// ExtractTextFastWithOptions is ExtractTextFast with options. opts may be
+// nil.
+func ExtractTextFastWithOptions(r io.Reader, opts *ExtractTextOptions) (string, error) {
+	if opts == nil {
+		opts = &ExtractTextOptions{}
+	}
+	phys, err := fastTextReader(r, opts.Open)
+	if err != nil {
+		return "", fmt.Errorf("docx: extracting text: %w", err)
+	}
+	defer phys.Close()
+
+	main, err := fastTextMainPart(phys)
+	if err != nil {
+		return "", fmt.Errorf("docx: extracting text: %w", err)
+	}
+	uris := []opc.PackURI{main}
+	if opts.HeadersFooters {
+		blob, err := phys.RelsXmlFor(main)
+		if err != nil {
+			return "", fmt.Errorf("docx: extracting text: %w", err)
+		}
+		rels, err := opc.ParseRelationships(blob, main.BaseURI())
+		if err != nil {
+			return "", fmt.Errorf("docx: extracting text: %w", err)
+		}
+		for _, rel := range rels {
+			if rel.IsExternal() {
+				continue
+			}
+			if rt := opc.NormalizeRelType(rel.RelType); rt == opc.RTHeader || rt == opc.RTFooter {
+				uris = append(uris, rel.TargetPartname())
+			}
+		}
+	}
+
+	var text []byte
+	for i, uri := range uris {
+		blob, err := phys.BlobFor(uri)
+		if err != nil {
+			return "", fmt.Errorf("docx: extracting text: %w", err)
+		}
+		if i > 0 {
+			text = append(text, '\n')
+		}
+		if text, err = appendStoryText(text, blob); err != nil {
+			return "", fmt.Errorf("docx: extracting text from %s: %w", uri, err)
+		}
+	}
+	return string(text), nil
+}
+
+// fastTextReader opens the zip read from r without copying it when r
+// supports random access.
+func fastTextReader(r io.Reader, opts *opc.OpenOptions) (*opc.PhysPkgReader, error) {
+	var (
+		ra   io.ReaderAt
+		size int64
+	)
+	switch src := r.(type) {
+	case *os.File:
+		info, err := src.Stat()
+		if err != nil {
+			return nil, err
+		}
+		ra, size = src, info.Size()
+	case interface {
+		io.ReaderAt
+		Size() int64
+	}:
+		ra, size = src, src.Size()
+	default:
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		ra, size = bytes.NewReader(data), int64(len(data))
+	}
+	return opc.NewPhysPkgReaderWithOptions(ra, size, opts)
+}
+
+// fastTextMainPart returns the partname of the main document part, after
+// checking that it is WordprocessingML.
+func fastTextMainPart(phys *opc.PhysPkgReader) (opc.PackURI, error) {
+	blob, err := phys.RelsXmlFor(opc.PackageURI)
+	if err != nil {
+		return "", err
+	}
+	rels, err := opc.ParseRelationships(blob, "/")
+	if err != nil {
+		return "", err
+	}
+	var main opc.PackURI
+	for _, rel := range rels {
+		if !rel.IsExternal() && opc.NormalizeRelType(rel.RelType) == opc.RTOfficeDocument {
+			main = rel.TargetPartname()
+			break
+		}
+	}
+	if main == "" {
+		return "", errors.New("no main document part")
+	}
+	blob, err = phys.ContentTypesXml()
+	if err != nil {
+		return "", err
+	}
+	cts, err := opc.ParseContentTypes(blob)
+	if err != nil {
+		return "", err
+	}
+	ct, err := cts.ContentType(main)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := contentTypeKinds[ct]; !ok && ct != opc.CTWmlDocument {
+		return "", fmt.Errorf("not a Word file, content type is %q", ct)
+	}
+	return main, nil
+}
+
+// fastTextSkipped are the elements whose content is not text: fallback
+// content repeats its alternative, and deleted runs hold removed text.
+var fastTextSkipped = map[string]bool{"mc:Fallback": true, "w:del": true, "w:moveFrom": true, "w:rt": true}
+
+// appendStoryText appends the text of the paragraphs of story part XML
+// blob to dst, one line per paragraph.
+func appendStoryText(dst, blob []byte) ([]byte, error) {
+	return appendStoryTextSkipping(dst, blob, fastTextSkipped)
+}
+
+// appendStoryTextSkipping is appendStoryText with the content of the
+// elements in skipped left out.
+func appendStoryTextSkipping(dst, blob []byte, skipped map[string]bool) ([]byte, error) {
+	sc := xmltok.NewScanner(blob)
+	start := len(dst)
+	var (
+		runs     int  // depth of open w:r elements
+		inText   bool // inside a w:t
+		skipping int  // depth inside skipped content; 0 when not skipping
+	)
+	for {
+		tok, err := sc.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if skipping > 0 {
+			switch {
+			case tok.Kind == xmltok.StartElement && !tok.SelfClosing:
+				skipping++
+			case tok.Kind == xmltok.EndElement:
+				skipping--
+			}
+			continue
+		}
+		switch tok.Kind {
+		case xmltok.CharData:
+			if inText {
+				dst = tok.AppendText(dst)
+			}
+		case xmltok.EndElement:
+			switch string(tok.Name) {
+			case "w:t":
+				inText = false
+			case "w:r":
+				runs--
+			case "w:p":
+				dst = append(dst, '\n')
+			}
+		case xmltok.StartElement:
+			// Compared as string(name) so that no string is allocated.
+			name := tok.Name
+			if skipped[string(name)] {
+				if !tok.SelfClosing {
+					skipping = 1
+				}
+				continue
+			}
+			if string(name) == "w:p" && tok.SelfClosing {
+				dst = append(dst, '\n')
+				continue
+			}
+			if string(name) == "w:r" && !tok.SelfClosing {
+				runs++
+				continue
+			}
+			if runs == 0 {
+				continue
+			}
+			switch string(name) {
+			case "w:t":
+				inText = !tok.SelfClosing
+			case "w:tab", "w:ptab":
+				dst = append(dst, '\t')
+			case "w:cr":
+				dst = append(dst, '\n')
+			case "w:noBreakHyphen":
+				dst = append(dst, oxml.NoBreakHyphen...)
+			case "w:softHyphen":
+				dst = append(dst, oxml.SoftHyphen...)
+			case "w:sym":
+				font, _ := tok.Attr("w:font")
+				char, _ := tok.Attr("w:char")
+				dst = append(dst, oxml.SymbolText(string(font), string(char))...)
+			case "w:br":
+				if typ, ok := tok.Attr("w:type"); !ok || string(typ) == "textWrapping" {
+					dst = append(dst, '\n')
+				}
+			}
+		}
+	}
+	// Paragraphs are separated, not terminated, by newlines.
+	if len(dst) > start && dst[len(dst)-1] == '\n' {
+		dst = dst[:len(dst)-1]
+	}
+	return dst, nil
+}
@@ -0,0 +1,131 @@
+package docx
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+func TestExtractTextFast(t *testing.T) {
+	d := mustNewDoc(t)
+	if _, err := d.AddParagraph("Tom & \"Jerry\" <3"); err != nil {
+		t.Fatal(err)
+	}
+	p, err := d.AddParagraph("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := p.p.RawElement().CreateElement("w:r")
+	r.CreateElement("w:tab")
+	r.CreateElement("w:t").SetText("b")
+	r.CreateElement("w:br")
+	r.CreateElement("w:br").CreateAttr("w:type", "page")
+	r.CreateElement("w:t").SetText("c")
+	r.CreateElement("w:noBreakHyphen")
+	r.CreateElement("w:softHyphen")
+	del := p.p.RawElement().CreateElement("w:del").CreateElement("w:r")
+	del.CreateElement("w:tab")
+	del.CreateElement("w:delText").SetText("gone")
+	runs, _ := oxml.NewComplexFieldRuns("PAGE", "7", nil)
+	for _, r := range runs {
+		p.p.RawElement().AddChild(r)
+	}
+	tbl, err := d.AddTable(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, text := range []string{"x", "y"} {
+		c, err := tbl.CellAt(0, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.SetText(text)
+	}
+	if _, err := d.Sections().Iter()[0].Header().AddParagraph("head"); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ExtractTextFast(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "Tom & \"Jerry\" <3\na\tb\nc\u2011\u00ad7\nx\ny"
+	if got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+
+	// A plain io.Reader is read to the end first.
+	got, err = ExtractTextFastWithOptions(strings.NewReader(buf.String()), &ExtractTextOptions{HeadersFooters: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(got, want+"\n") || !strings.HasSuffix(got, "head") {
+		t.Errorf("with headers = %q", got)
+	}
+}
+
+func TestExtractTextFast_NotWord(t *testing.T) {
+	data := makeFlavoredPackage(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml", false)
+	if _, err := ExtractTextFast(bytes.NewReader(data)); err == nil || !strings.Contains(err.Error(), "not a Word file") {
+		t.Errorf("err = %v", err)
+	}
+	if _, err := ExtractTextFast(strings.NewReader("not a zip")); err == nil {
+		t.Error("no error for garbage")
+	}
+}
+
+func BenchmarkExtractTextFast(b *testing.B) {
+	data := benchmarkDocument(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ExtractTextFast(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkExtractTextOpen extracts the same text through the document
+// tree, for comparison.
+func BenchmarkExtractTextOpen(b *testing.B) {
+	data := benchmarkDocument(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d, err := OpenBytes(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+		paras, err := d.Paragraphs()
+		if err != nil {
+			b.Fatal(err)
+		}
+		for _, p := range paras {
+			_ = p.Text()
+		}
+	}
+}
+
+func benchmarkDocument(b *testing.B) []byte {
+	b.Helper()
+	d, err := New()
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < 2000; i++ {
+		if _, err := d.AddParagraph("The quick brown fox jumps over the lazy dog."); err != nil {
+			b.Fatal(err)
+		}
+	}
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		b.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,23 @@
+package docx
+
+import (
+	"fmt"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// RoundtripFidelity opens data, saves it unchanged and reports what the
+// saved package lost or changed compared to data: parts dropped, elements
+// and attributes lost, and relationship changes. Use it to measure what
+// the docx layer does not preserve for a class of documents.
+func RoundtripFidelity(data []byte) (*opc.FidelityReport, error) {
+	d, err := OpenBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	saved, err := d.SaveBytes()
+	if err != nil {
+		return nil, fmt.Errorf("docx: saving roundtrip: %w", err)
+	}
+	return opc.CompareFidelity(data, saved)
+}
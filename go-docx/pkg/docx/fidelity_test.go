@@ -0,0 +1,27 @@
+package docx
+
+import (
+	"testing"
+)
+
+func TestRoundtripFidelity(t *testing.T) {
+	d := mustNewDoc(t)
+	if _, err := d.AddParagraph("Hello"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := d.SaveBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := RoundtripFidelity(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Lossless() {
+		t.Errorf("saved document lost content on a roundtrip: %+v", r)
+	}
+
+	if _, err := RoundtripFidelity([]byte("not a package")); err == nil {
+		t.Error("expected an error for invalid input")
+	}
+}
@@ -0,0 +1,208 @@
+package docx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// Field is a proxy for a field in a story: either a simple field
+// (<w:fldSimple>) or a complex field made of begin / separate / end
+// <w:fldChar> runs. It gives access to the field code (instruction) and to
+// the cached result Word displays until the field is updated.
+type Field struct {
+	span *oxml.FieldSpan
+	part *parts.StoryPart
+}
+
+// Type returns the field type: the first word of the instruction, in upper
+// case, e.g. "DATE" or "MERGEFIELD". Returns "" for an empty instruction.
+func (f *Field) Type() string {
+	words := strings.Fields(f.span.Instruction())
+	if len(words) == 0 {
+		return ""
+	}
+	return strings.ToUpper(words[0])
+}
+
+// Instruction returns the field code, e.g. `REF _Ref123 \h`.
+func (f *Field) Instruction() string { return f.span.Instruction() }
+
+// SetInstruction replaces the field code. The cached result is left as is;
+// call SetResult or SetDirty to bring it up to date.
+func (f *Field) SetInstruction(instr string) { f.span.SetInstruction(instr) }
+
+// Result returns the cached result text of the field.
+func (f *Field) Result() string { return f.span.Result() }
+
+// SetResult replaces the cached result with text. The result takes the
+// formatting of the first run of the old result. Fields nested in the old
+// result are removed, and result runs in following paragraphs of a
+// multi-paragraph field are removed while the paragraphs are kept.
+func (f *Field) SetResult(text string) error {
+	if f.span.Simple == nil && f.span.Separate == nil && f.span.End == nil {
+		return fmt.Errorf("docx: field %q is not terminated", f.Instruction())
+	}
+	f.span.SetResult(text)
+	return nil
+}
+
+// IsSimple reports whether the field is a <w:fldSimple> field.
+func (f *Field) IsSimple() bool { return f.span.Simple != nil }
+
+// Locked reports whether the field is locked, so Word does not update it.
+func (f *Field) Locked() bool { return f.span.Locked() }
+
+// SetLocked locks or unlocks the field.
+func (f *Field) SetLocked(v bool) { f.span.SetLocked(v) }
+
+// Dirty reports whether the field is flagged to be updated when the
+// document is next opened.
+func (f *Field) Dirty() bool { return f.span.Dirty() }
+
+// SetDirty flags the field for update when the document is next opened.
+func (f *Field) SetDirty(v bool) { f.span.SetDirty(v) }
+
+// Span returns the underlying oxml field description.
+func (f *Field) Span() *oxml.FieldSpan { return f.span }
+
+// --------------------------------------------------------------------------
+// Enumeration
+// --------------------------------------------------------------------------
+
+// Fields returns the fields in this container in document order, including
+// fields nested in other fields and fields in tables, content controls and
+// text boxes.
+func (c *BlockItemContainer) Fields() []*Field {
+	spans := oxml.ScanFields(c.element)
+	result := make([]*Field, len(spans))
+	for i, s := range spans {
+		result[i] = &Field{span: s, part: c.part}
+	}
+	return result
+}
+
+// Fields returns the fields in this paragraph. A complex field that starts
+// in this paragraph but continues into the next one is reported with only
+// the part of its result that lies in this paragraph.
+func (para *Paragraph) Fields() []*Field {
+	spans := oxml.ScanFields(para.p.RawElement())
+	result := make([]*Field, len(spans))
+	for i, s := range spans {
+		result[i] = &Field{span: s, part: para.part}
+	}
+	return result
+}
+
+// Fields returns the fields in the document body.
+func (d *Document) Fields() ([]*Field, error) {
+	b, err := d.getBody()
+	if err != nil {
+		return nil, err
+	}
+	return b.Fields(), nil
+}
+
+// Fields returns the fields in this header/footer.
+func (b *baseHeaderFooter) Fields() ([]*Field, error) {
+	bic, err := b.blockItemContainer()
+	if err != nil {
+		return nil, fmt.Errorf("docx: %s fields: %w", b.ops.kind(), err)
+	}
+	return bic.Fields(), nil
+}
+
+// --------------------------------------------------------------------------
+// Creation
+// --------------------------------------------------------------------------
+
+// AddField appends a complex field with the given instruction and cached
+// result to this paragraph and returns it. Use the *FieldCode helpers to
+// build common instructions. The result is shown until Word updates the
+// field; call SetDirty(true) to have it updated on open.
+func (para *Paragraph) AddField(instr, result string) (*Field, error) {
+	if strings.TrimSpace(instr) == "" {
+		return nil, fmt.Errorf("docx: field instruction is empty")
+	}
+	runs, span := oxml.NewComplexFieldRuns(instr, result, nil)
+	pe := para.p.RawElement()
+	for _, r := range runs {
+		pe.AddChild(r)
+	}
+	return &Field{span: span, part: para.part}, nil
+}
+
+// quoteFieldArg quotes a field argument if it contains spaces.
+func quoteFieldArg(s string) string {
+	if strings.ContainsAny(s, " \t") {
+		return `"` + s + `"`
+	}
+	return s
+}
+
+// DateFieldCode returns a DATE field instruction. format is a Word date
+// picture such as "d MMMM yyyy"; "" uses the default format.
+func DateFieldCode(format string) string {
+	if format == "" {
+		return "DATE"
+	}
+	return `DATE \@ "` + format + `"`
+}
+
+// RefFieldCode returns a REF field instruction referring to bookmark. With
+// hyperlink the result links to the bookmark (\h).
+func RefFieldCode(bookmark string, hyperlink bool) string {
+	code := "REF " + bookmark
+	if hyperlink {
+		code += ` \h`
+	}
+	return code
+}
+
+// SeqFieldCode returns a SEQ field instruction numbering items of the
+// sequence identifier, e.g. "Figure".
+func SeqFieldCode(identifier string) string {
+	return "SEQ " + quoteFieldArg(identifier) + ` \* ARABIC`
+}
+
+// DocPropertyFieldCode returns a DOCPROPERTY field instruction showing the
+// named document property.
+func DocPropertyFieldCode(name string) string {
+	return "DOCPROPERTY " + quoteFieldArg(name)
+}
+
+// MergeFieldCode returns a MERGEFIELD field instruction for the named data
+// column.
+func MergeFieldCode(name string) string {
+	return "MERGEFIELD " + quoteFieldArg(name)
+}
+
+// splitFieldCode splits a field instruction into its words. Double-quoted
+// arguments are returned without the quotes and may contain spaces.
+func splitFieldCode(instr string) []string {
+	var words []string
+	var sb strings.Builder
+	inQuote, inWord := false, false
+	for _, ch := range instr {
+		switch {
+		case ch == '"':
+			inQuote = !inQuote
+			inWord = true
+		case !inQuote && (ch == ' ' || ch == '\t'):
+			if inWord {
+				words = append(words, sb.String())
+				sb.Reset()
+				inWord = false
+			}
+		default:
+			sb.WriteRune(ch)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, sb.String())
+	}
+	return words
+}
@@ -0,0 +1,72 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParagraph_AddField_RoundTrip(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("Figure ")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	if _, err := p.AddField(SeqFieldCode("Figure"), "1"); err != nil {
+		t.Fatalf("AddField: %v", err)
+	}
+	f, err := p.AddField(DocPropertyFieldCode("Client Name"), "ACME")
+	if err != nil {
+		t.Fatalf("AddField: %v", err)
+	}
+	f.SetLocked(true)
+	if _, err := p.AddField("  ", ""); err == nil {
+		t.Error("expected error for empty instruction")
+	}
+	if got := p.Text(); got != "Figure 1ACME" {
+		t.Errorf("paragraph text = %q", got)
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	fields, err := doc2.Fields()
+	if err != nil {
+		t.Fatalf("Fields: %v", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("expected 2 fields, got %d", len(fields))
+	}
+	if fields[0].Type() != "SEQ" || fields[0].Instruction() != `SEQ Figure \* ARABIC` {
+		t.Errorf("field 0: type %q instruction %q", fields[0].Type(), fields[0].Instruction())
+	}
+	if fields[1].Instruction() != `DOCPROPERTY "Client Name"` || !fields[1].Locked() {
+		t.Errorf("field 1: instruction %q locked %v", fields[1].Instruction(), fields[1].Locked())
+	}
+	if err := fields[1].SetResult("Globex"); err != nil {
+		t.Fatalf("SetResult: %v", err)
+	}
+	if got := fields[1].Result(); got != "Globex" {
+		t.Errorf("Result() = %q, want Globex", got)
+	}
+}
+
+func TestFieldCodes(t *testing.T) {
+	cases := map[string]string{
+		DateFieldCode(""):             "DATE",
+		DateFieldCode("d MMMM yyyy"):  `DATE \@ "d MMMM yyyy"`,
+		RefFieldCode("_Ref1", true):   `REF _Ref1 \h`,
+		RefFieldCode("_Ref1", false):  "REF _Ref1",
+		MergeFieldCode("FirstName"):   "MERGEFIELD FirstName",
+		DocPropertyFieldCode("Title"): "DOCPROPERTY Title",
+	}
+	for got, want := range cases {
+		if got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package docx
+
+import (
+	"io"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// ContentTypeKind identifies the flavor of WordprocessingML package, as
+// declared by the content type of its main document part.
+type ContentTypeKind int
+
+const (
+	// KindDocument is a plain document (.docx).
+	KindDocument ContentTypeKind = iota
+	// KindMacroEnabledDocument is a macro-enabled document (.docm).
+	KindMacroEnabledDocument
+	// KindTemplate is a template (.dotx).
+	KindTemplate
+	// KindMacroEnabledTemplate is a macro-enabled template (.dotm).
+	KindMacroEnabledTemplate
+)
+
+// contentTypeKinds maps each accepted main-part content type to its kind.
+var contentTypeKinds = map[string]ContentTypeKind{
+	opc.CTWmlDocumentMain:         KindDocument,
+	opc.CTWmlDocumentMacroEnabled: KindMacroEnabledDocument,
+	opc.CTWmlTemplateMain:         KindTemplate,
+	opc.CTWmlTemplateMacroEnabled: KindMacroEnabledTemplate,
+}
+
+// String returns the conventional file extension without the dot, e.g.
+// "docm".
+func (k ContentTypeKind) String() string {
+	switch k {
+	case KindMacroEnabledDocument:
+		return "docm"
+	case KindTemplate:
+		return "dotx"
+	case KindMacroEnabledTemplate:
+		return "dotm"
+	}
+	return "docx"
+}
+
+// IsMacroEnabled reports whether the kind may carry a VBA project.
+func (k ContentTypeKind) IsMacroEnabled() bool {
+	return k == KindMacroEnabledDocument || k == KindMacroEnabledTemplate
+}
+
+// IsTemplate reports whether the kind is a template.
+func (k ContentTypeKind) IsTemplate() bool {
+	return k == KindTemplate || k == KindMacroEnabledTemplate
+}
+
+// ContentTypeKind returns the flavor of package this document was opened
+// from. Documents created with New are KindDocument.
+func (d *Document) ContentTypeKind() ContentTypeKind {
+	return contentTypeKinds[d.part.ContentType()]
+}
+
+// HasMacros reports whether the document carries a VBA project part.
+func (d *Document) HasMacros() bool {
+	return len(d.part.Rels().AllByRelType(opc.RTVbaProject)) > 0
+}
+
+// SaveAsDocx converts the document to a plain .docx and writes it to w.
+// The VBA project and its data (vbaProject.bin, vbaData.xml) are removed,
+// with the attached template, which may hold more macros. Document
+// variables, key bindings and toolbars are kept. The main part content
+// type is changed to the document flavor, so templates become ordinary
+// documents too.
+//
+// The conversion applies to d itself: later saves also produce a .docx.
+func (d *Document) SaveAsDocx(w io.Writer) error {
+	d.stripMacros()
+	return d.Save(w)
+}
+
+// SaveFileAsDocx is like SaveAsDocx but writes to a file.
+func (d *Document) SaveFileAsDocx(path string) error {
+	d.stripMacros()
+	return d.SaveFile(path)
+}
+
+// macroRelTypes are the types of the relationships of the main part to
+// macro content. The VBA project relates its vbaData.xml itself.
+var macroRelTypes = []string{
+	opc.RTVbaProject,
+	opc.RTWordVbaData,
+}
+
+// stripMacros drops the relationships to macro content and the attached
+// template, sets the main part content type
+// to CTWmlDocumentMain, and drops the parts no longer reachable, so no
+// relationship or content type override is left pointing at them.
+func (d *Document) stripMacros() {
+	rels := d.part.Rels()
+	for _, relType := range macroRelTypes {
+		for _, rel := range rels.AllByRelType(relType) {
+			rels.Delete(rel.RID)
+		}
+	}
+	for _, rel := range rels.AllByRelType(opc.RTSettings) {
+		if sp, ok := rel.TargetPart.(*parts.SettingsPart); ok {
+			stripMacroSettings(sp)
+		}
+	}
+	d.part.SetContentType(opc.CTWmlDocumentMain)
+	if pkg := d.part.Package(); pkg != nil {
+		pkg.DropUnreachableParts()
+	}
+}
+
+// stripMacroSettings removes w:attachedTemplate, with its relationship,
+// from the settings part.
+func stripMacroSettings(sp *parts.SettingsPart) {
+	for _, rel := range sp.Rels().AllByRelType(opc.RTAttachedTemplate) {
+		sp.Rels().Delete(rel.RID)
+	}
+	root := sp.Element()
+	if root == nil {
+		return
+	}
+	for _, child := range root.ChildElements() {
+		if child.Space == "w" && child.Tag == "attachedTemplate" {
+			root.RemoveChild(child)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/templates"
+)
+
+// makeFlavoredPackage returns the default template re-saved with the given
+// main-part content type and, optionally, a VBA project part.
+func makeFlavoredPackage(t *testing.T, ct string, withVba bool) []byte {
+	t.Helper()
+	data, err := templates.FS.ReadFile("default.docx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := opc.OpenBytes(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	main.(interface{ SetContentType(string) }).SetContentType(ct)
+	if withVba {
+		vba := opc.NewBasePart("/word/vbaProject.bin", opc.CTOfficeVbaProject, []byte("VBA-BYTES"), pkg)
+		pkg.AddPart(vba)
+		main.Rels().GetOrAdd(opc.RTVbaProject, vba)
+	}
+	out, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestContentTypeKind(t *testing.T) {
+	tests := []struct {
+		ct   string
+		want ContentTypeKind
+	}{
+		{opc.CTWmlDocumentMain, KindDocument},
+		{opc.CTWmlDocumentMacroEnabled, KindMacroEnabledDocument},
+		{opc.CTWmlTemplateMain, KindTemplate},
+		{opc.CTWmlTemplateMacroEnabled, KindMacroEnabledTemplate},
+	}
+	for _, tt := range tests {
+		doc, err := OpenBytes(makeFlavoredPackage(t, tt.ct, false))
+		if err != nil {
+			t.Fatalf("%s: OpenBytes: %v", tt.want, err)
+		}
+		if got := doc.ContentTypeKind(); got != tt.want {
+			t.Errorf("ContentTypeKind() = %s, want %s", got, tt.want)
+		}
+	}
+}
+
+func TestDocm_RoundTripPreservesVbaProject(t *testing.T) {
+	doc, err := OpenBytes(makeFlavoredPackage(t, opc.CTWmlDocumentMacroEnabled, true))
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	if !doc.HasMacros() {
+		t.Fatal("HasMacros() = false for docm with vbaProject")
+	}
+	if _, err := doc.AddParagraph("edited"); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	pkg, err := opc.OpenBytes(buf.Bytes(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vba, ok := pkg.PartByName("/word/vbaProject.bin")
+	if !ok {
+		t.Fatal("vbaProject.bin lost on save")
+	}
+	if blob, _ := vba.Blob(); string(blob) != "VBA-BYTES" {
+		t.Errorf("vbaProject.bin content = %q", blob)
+	}
+	if vba.ContentType() != opc.CTOfficeVbaProject {
+		t.Errorf("vbaProject.bin content type = %q", vba.ContentType())
+	}
+	main, _ := pkg.MainDocumentPart()
+	if main.ContentType() != opc.CTWmlDocumentMacroEnabled {
+		t.Errorf("main part content type = %q", main.ContentType())
+	}
+}
+
+func TestSaveAsDocx_StripsMacros(t *testing.T) {
+	doc, err := OpenBytes(makeFlavoredPackage(t, opc.CTWmlTemplateMacroEnabled, true))
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := doc.SaveAsDocx(&buf); err != nil {
+		t.Fatalf("SaveAsDocx: %v", err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte("vbaProject")) {
+		t.Error("saved package still references vbaProject")
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("re-open: %v", err)
+	}
+	if doc2.ContentTypeKind() != KindDocument {
+		t.Errorf("ContentTypeKind() = %s, want docx", doc2.ContentTypeKind())
+	}
+	if doc2.HasMacros() {
+		t.Error("HasMacros() = true after SaveAsDocx")
+	}
+}
+
+// makeFullDocm returns a macro-enabled document carrying every part and
+// reference a .docm may use for macros.
+func makeFullDocm(t *testing.T) []byte {
+	t.Helper()
+	data, err := templates.FS.ReadFile("default.docx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := opc.OpenBytes(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	main.(interface{ SetContentType(string) }).SetContentType(opc.CTWmlDocumentMacroEnabled)
+	add := func(source opc.Part, pn opc.PackURI, ct, relType string, blob string) opc.Part {
+		part := opc.NewBasePart(pn, ct, []byte(blob), pkg)
+		pkg.AddPart(part)
+		source.Rels().GetOrAdd(relType, part)
+		return part
+	}
+	vba := add(main, "/word/vbaProject.bin", opc.CTOfficeVbaProject, opc.RTVbaProject, "VBA-BYTES")
+	add(vba, "/word/vbaData.xml", opc.CTWmlVbaData, opc.RTWordVbaData, `<wne:vbaSuppData xmlns:wne="http://schemas.microsoft.com/office/word/2006/wordml"/>`)
+	add(main, "/word/customizations.xml", "application/vnd.ms-word.keyMapCustomizations+xml", opc.RTKeyMapCustomizations,
+		`<wne:tcg xmlns:wne="http://schemas.microsoft.com/office/word/2006/wordml"/>`)
+	add(main, "/word/attachedToolbars.bin", "application/vnd.ms-word.attachedToolbars", opc.RTAttachedToolbars, "TOOLBARS")
+
+	settings, err := main.Rels().GetByRelType(opc.RTSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rID := settings.TargetPart.Rels().GetOrAddExtRel(opc.RTAttachedTemplate, "file:///C:/Templates/Macros.dotm")
+	blob, err := settings.TargetPart.Blob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	end := bytes.LastIndex(blob, []byte("</w:settings>"))
+	blob = append(blob[:end:end], []byte(`<w:attachedTemplate r:id="`+rID+`"/><w:docVars><w:docVar w:name="v" w:val="1"/></w:docVars></w:settings>`)...)
+	settings.TargetPart.(interface{ SetBlob([]byte) }).SetBlob(blob)
+
+	out, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestSaveAsDocx_LeavesNoDanglingReferences(t *testing.T) {
+	doc, err := OpenBytes(makeFullDocm(t))
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := doc.SaveAsDocx(&buf); err != nil {
+		t.Fatalf("SaveAsDocx: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	members := map[string][]byte{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		members[f.Name] = b
+	}
+	for _, name := range []string{"word/vbaProject.bin", "word/vbaData.xml"} {
+		if _, ok := members[name]; ok {
+			t.Errorf("%s is still in the package", name)
+		}
+	}
+	for _, name := range []string{"word/customizations.xml", "word/attachedToolbars.bin"} {
+		if _, ok := members[name]; !ok {
+			t.Errorf("%s was removed", name)
+		}
+	}
+
+	// Every override names a member, and none has a macro content type.
+	ct := etree.NewDocument()
+	if err := ct.ReadFromBytes(members["[Content_Types].xml"]); err != nil {
+		t.Fatal(err)
+	}
+	for _, o := range ct.Root().SelectElements("Override") {
+		pn := o.SelectAttrValue("PartName", "")
+		if _, ok := members[strings.TrimPrefix(pn, "/")]; !ok {
+			t.Errorf("override for missing part %s", pn)
+		}
+		if v := o.SelectAttrValue("ContentType", ""); strings.Contains(v, "macroEnabled") || strings.Contains(v, "ms-office.vbaProject") {
+			t.Errorf("override %s has macro content type %s", pn, v)
+		}
+	}
+
+	// Every relationship targets a member, and none leads to macro content.
+	for name, b := range members {
+		if !strings.HasSuffix(name, ".rels") {
+			continue
+		}
+		source := opc.PackURI("/" + strings.Replace(strings.TrimSuffix(name, ".rels"), "_rels/", "", 1))
+		srels, err := opc.ParseRelationships(b, source.BaseURI())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, sr := range srels {
+			switch sr.RelType {
+			case opc.RTVbaProject, opc.RTWordVbaData, opc.RTAttachedTemplate:
+				t.Errorf("%s keeps a %s relationship", name, sr.RelType)
+			}
+			if sr.IsExternal() {
+				continue
+			}
+			if _, ok := members[sr.TargetPartname().Membername()]; !ok {
+				t.Errorf("%s: relationship %s targets missing part %s", name, sr.RID, sr.TargetPartname())
+			}
+		}
+	}
+
+	settings := string(members["word/settings.xml"])
+	if strings.Contains(settings, "attachedTemplate") {
+		t.Errorf("settings keep the attached template: %s", settings)
+	}
+	if !strings.Contains(settings, `<w:docVar w:name="v" w:val="1"`) {
+		t.Errorf("settings lost the document variables: %s", settings)
+	}
+}
@@ -0,0 +1,129 @@
+package docx
+
+import "strings"
+
+// FontMetrics gives the horizontal and vertical extent of the characters of
+// a font, as used by EstimateLayout.
+type FontMetrics struct {
+	// Widths are the advance widths of the printable ASCII characters,
+	// U+0020 to U+007E, in thousandths of an em.
+	Widths [95]int
+	// LineHeight is the single line spacing of the font (ascent, descent
+	// and line gap) in thousandths of an em.
+	LineHeight int
+}
+
+// CharWidth returns the advance width of r in thousandths of an em.
+// Characters outside the table are estimated: wide East Asian characters
+// take a full em, others the width of "n".
+func (m *FontMetrics) CharWidth(r rune) int {
+	switch {
+	case r >= 0x20 && r <= 0x7E:
+		return m.Widths[r-0x20]
+	case r == 0xA0:
+		return m.Widths[0]
+	case isWideRune(r):
+		return 1000
+	}
+	return m.Widths['n'-0x20]
+}
+
+// isWideRune reports whether r is a full-width East Asian character.
+func isWideRune(r rune) bool {
+	return r >= 0x1100 && r <= 0x115F ||
+		r >= 0x2E80 && r <= 0xA4CF ||
+		r >= 0xAC00 && r <= 0xD7A3 ||
+		r >= 0xF900 && r <= 0xFAFF ||
+		r >= 0xFE30 && r <= 0xFE4F ||
+		r >= 0xFF00 && r <= 0xFF60 ||
+		r >= 0xFFE0 && r <= 0xFFE6
+}
+
+// Metric tables of common fonts. Helvetica and Times are those of the
+// standard PostScript fonts, which Arial and Times New Roman match; the
+// others are close approximations.
+var (
+	helveticaMetrics = &FontMetrics{LineHeight: 1150, Widths: [95]int{
+		278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+		1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+		333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+		556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+	}}
+	timesMetrics = &FontMetrics{LineHeight: 1150, Widths: [95]int{
+		250, 333, 408, 500, 500, 833, 778, 180, 333, 333, 500, 564, 250, 333, 250, 278,
+		500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 278, 278, 564, 564, 564, 444,
+		921, 722, 667, 667, 722, 611, 556, 722, 722, 333, 389, 722, 611, 889, 722, 722,
+		556, 722, 667, 556, 611, 722, 722, 944, 722, 722, 611, 333, 278, 333, 469, 500,
+		333, 444, 500, 444, 500, 444, 333, 500, 500, 278, 278, 500, 278, 778, 500, 500,
+		500, 500, 333, 389, 278, 500, 500, 722, 500, 500, 444, 480, 200, 480, 541,
+	}}
+	calibriMetrics = &FontMetrics{LineHeight: 1220, Widths: [95]int{
+		226, 326, 401, 498, 507, 715, 682, 221, 303, 303, 498, 498, 250, 306, 252, 386,
+		507, 507, 507, 507, 507, 507, 507, 507, 507, 507, 268, 268, 498, 498, 498, 463,
+		894, 579, 544, 533, 615, 488, 459, 631, 623, 252, 319, 520, 420, 855, 646, 662,
+		517, 673, 543, 459, 487, 642, 567, 890, 519, 487, 468, 307, 386, 307, 498, 498,
+		291, 479, 525, 423, 525, 498, 305, 471, 525, 230, 239, 455, 230, 799, 525, 527,
+		525, 525, 349, 391, 335, 525, 452, 715, 433, 453, 395, 314, 460, 314, 498,
+	}}
+	cambriaMetrics  = &FontMetrics{LineHeight: 1172, Widths: timesMetrics.Widths}
+	courierMetrics  = monospaceMetrics(600, 1133)
+	consolasMetrics = monospaceMetrics(550, 1171)
+)
+
+// monospaceMetrics returns the metrics of a monospaced font.
+func monospaceMetrics(width, lineHeight int) *FontMetrics {
+	m := &FontMetrics{LineHeight: lineHeight}
+	for i := range m.Widths {
+		m.Widths[i] = width
+	}
+	return m
+}
+
+// builtinFontMetrics maps lower-case font names to their metrics,
+// including the metric-compatible free fonts.
+var builtinFontMetrics = map[string]*FontMetrics{
+	"arial":            helveticaMetrics,
+	"helvetica":        helveticaMetrics,
+	"liberation sans":  helveticaMetrics,
+	"arimo":            helveticaMetrics,
+	"times new roman":  timesMetrics,
+	"times":            timesMetrics,
+	"liberation serif": timesMetrics,
+	"tinos":            timesMetrics,
+	"calibri":          calibriMetrics,
+	"carlito":          calibriMetrics,
+	"cambria":          cambriaMetrics,
+	"caladea":          cambriaMetrics,
+	"courier new":      courierMetrics,
+	"courier":          courierMetrics,
+	"liberation mono":  courierMetrics,
+	"cousine":          courierMetrics,
+	"consolas":         consolasMetrics,
+}
+
+// lookupFontMetrics returns the metrics of the named font from extra or the
+// built-in tables, falling back to a font of the same kind: monospaced,
+// serif or sans serif.
+func lookupFontMetrics(name string, extra map[string]*FontMetrics) *FontMetrics {
+	for k, m := range extra {
+		if strings.EqualFold(k, name) {
+			return m
+		}
+	}
+	key := strings.ToLower(strings.TrimSpace(name))
+	if m, ok := builtinFontMetrics[key]; ok {
+		return m
+	}
+	switch {
+	case strings.Contains(key, "mono") || strings.Contains(key, "courier") || strings.Contains(key, "code"):
+		return courierMetrics
+	case strings.Contains(key, "sans") || key == "":
+		return helveticaMetrics
+	case strings.Contains(key, "serif") || strings.Contains(key, "roman") || strings.Contains(key, "georgia") ||
+		strings.Contains(key, "garamond") || strings.Contains(key, "book"):
+		return timesMetrics
+	}
+	return helveticaMetrics
+}
@@ -0,0 +1,47 @@
+package docx
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// allStories lists every story kind, in the order ReplaceText visits them.
+var allStories = []StoryKind{StoryBody, StoryHeader, StoryFooter, StoryComment, StoryFootnote, StoryEndnote}
+
+// FormatMatches calls format with the font of the text of every match of
+// re, so the matched text can be highlighted, colored or emphasized. It
+// searches the same stories as ReplaceText, each paragraph on its own;
+// use regexp.QuoteMeta to match a literal string. Runs are split where a
+// match starts or ends inside them, so the text around a match keeps its
+// formatting, and format is called once for each run the match covers. It
+// returns the number of matches formatted.
+//
+// An error from format stops the search and is returned with the count
+// of the matches formatted until then.
+func (d *Document) FormatMatches(re *regexp.Regexp, format func(f *Font) error) (int, error) {
+	count := 0
+	var err error
+	d.eachStory(allStories, func(root *etree.Element, _ *parts.StoryPart) bool {
+		for _, p := range root.FindElements(".//w:p") {
+			for _, m := range re.FindAllStringIndex(oxml.InlineText(p), -1) {
+				runs := oxml.IsolateRuns(p, m[0], m[1])
+				if len(runs) == 0 {
+					continue
+				}
+				for _, r := range runs {
+					if err = format(newFont(&oxml.CT_R{Element: oxml.WrapElement(r)})); err != nil {
+						err = fmt.Errorf("docx: formatting match: %w", err)
+						return false
+					}
+				}
+				count++
+			}
+		}
+		return true
+	})
+	return count, err
+}
@@ -0,0 +1,61 @@
+package docx
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+)
+
+func TestDocument_FormatMatches(t *testing.T) {
+	doc := mustNewDoc(t)
+	para, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, text := range []string{"call ACME", " Corp or ACME Corp", " today"} {
+		if _, err := para.AddRun(text); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sect, err := doc.Sections().Get(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sect.Footer().AddParagraph("ACME Corp confidential"); err != nil {
+		t.Fatal(err)
+	}
+
+	yellow := enum.WdColorIndexYellow
+	n, err := doc.FormatMatches(regexp.MustCompile(regexp.QuoteMeta("ACME Corp")), func(f *Font) error {
+		return f.SetHighlightColor(&yellow)
+	})
+	if err != nil {
+		t.Fatalf("FormatMatches: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("matches = %d, want 3", n)
+	}
+	if got := para.Text(); got != "call ACME Corp or ACME Corp today" {
+		t.Errorf("text changed: %q", got)
+	}
+	var highlighted string
+	for _, r := range para.Runs() {
+		hl, err := r.Font().HighlightColor()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hl != nil && *hl == yellow {
+			highlighted += "[" + r.Text() + "]"
+		}
+	}
+	if highlighted != "[ACME][ Corp][ACME Corp]" {
+		t.Errorf("highlighted runs = %s", highlighted)
+	}
+
+	boom := errors.New("boom")
+	if _, err := doc.FormatMatches(regexp.MustCompile("today"), func(*Font) error { return boom }); !errors.Is(err, boom) {
+		t.Errorf("err = %v, want boom", err)
+	}
+}
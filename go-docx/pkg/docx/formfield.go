@@ -0,0 +1,317 @@
+package docx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/beevik/etree"
+)
+
+// FormFieldType identifies the kind of a legacy form field.
+type FormFieldType int
+
+const (
+	// FormFieldText is a text form field (FORMTEXT).
+	FormFieldText FormFieldType = iota
+	// FormFieldCheckBox is a check box form field (FORMCHECKBOX).
+	FormFieldCheckBox
+	// FormFieldDropDown is a drop-down form field (FORMDROPDOWN).
+	FormFieldDropDown
+)
+
+// String returns the field type of the kind, e.g. "FORMTEXT".
+func (t FormFieldType) String() string {
+	switch t {
+	case FormFieldCheckBox:
+		return "FORMCHECKBOX"
+	case FormFieldDropDown:
+		return "FORMDROPDOWN"
+	}
+	return "FORMTEXT"
+}
+
+// formFieldTypes maps field types to form field kinds.
+var formFieldTypes = map[string]FormFieldType{
+	"FORMTEXT":     FormFieldText,
+	"FORMCHECKBOX": FormFieldCheckBox,
+	"FORMDROPDOWN": FormFieldDropDown,
+}
+
+// emptyFormText is the result Word shows for an empty text form field:
+// five en spaces.
+const emptyFormText = "     "
+
+// FormField is a legacy form field: a FORMTEXT, FORMCHECKBOX or
+// FORMDROPDOWN field whose settings and state are kept in the <w:ffData>
+// of its begin <w:fldChar>. These predate content controls and are still
+// common in forms protected for filling in.
+type FormField struct {
+	*Field
+}
+
+// FormFields returns the legacy form fields in this container in document
+// order.
+func (c *BlockItemContainer) FormFields() []*FormField {
+	var result []*FormField
+	for _, f := range c.Fields() {
+		if _, ok := formFieldTypes[f.Type()]; ok && f.span.Begin != nil {
+			result = append(result, &FormField{Field: f})
+		}
+	}
+	return result
+}
+
+// FormFields returns the legacy form fields in the document body.
+func (d *Document) FormFields() ([]*FormField, error) {
+	b, err := d.getBody()
+	if err != nil {
+		return nil, err
+	}
+	return b.FormFields(), nil
+}
+
+// FormField returns the legacy form field of the document body with the
+// given name, or nil.
+func (d *Document) FormField(name string) (*FormField, error) {
+	fields, err := d.FormFields()
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		if f.Name() == name {
+			return f, nil
+		}
+	}
+	return nil, nil
+}
+
+// FormType returns the kind of the form field.
+func (f *FormField) FormType() FormFieldType { return formFieldTypes[f.Type()] }
+
+// ffData returns the <w:ffData> of the field, creating it with the element
+// for the field's kind if absent.
+func (f *FormField) ffData() *etree.Element {
+	ff := f.span.Begin.SelectElement("w:ffData")
+	if ff == nil {
+		ff = f.span.Begin.CreateElement("w:ffData")
+		ff.CreateElement("w:name").CreateAttr("w:val", "")
+		ff.CreateElement("w:enabled")
+		ff.CreateElement("w:calcOnExit").CreateAttr("w:val", "0")
+	}
+	tag := map[FormFieldType]string{
+		FormFieldText:     "textInput",
+		FormFieldCheckBox: "checkBox",
+		FormFieldDropDown: "ddList",
+	}[f.FormType()]
+	if ff.SelectElement("w:"+tag) == nil {
+		el := ff.CreateElement("w:" + tag)
+		if tag == "checkBox" {
+			el.CreateElement("w:sizeAuto")
+		}
+	}
+	return ff
+}
+
+// ffVal returns the w:val of the element at path under <w:ffData>, or def
+// when the element is absent. An element present without w:val reads as
+// "1", the OOXML on/off convention.
+func (f *FormField) ffVal(path, def string) string {
+	ff := f.span.Begin.SelectElement("w:ffData")
+	if ff == nil {
+		return def
+	}
+	el := ff.FindElement(path)
+	if el == nil {
+		return def
+	}
+	return el.SelectAttrValue("w:val", "1")
+}
+
+// Name returns the name of the form field, which is also the name of the
+// bookmark Word places around it.
+func (f *FormField) Name() string { return f.ffVal("w:name", "") }
+
+// Enabled reports whether the user may fill in the field.
+func (f *FormField) Enabled() bool { return isOn(f.ffVal("w:enabled", "1")) }
+
+// Value returns the current value of the field: the text of a text field,
+// "true" or "false" for a check box, and the selected entry of a
+// drop-down.
+func (f *FormField) Value() string {
+	switch f.FormType() {
+	case FormFieldCheckBox:
+		return strconv.FormatBool(f.Checked())
+	case FormFieldDropDown:
+		entries := f.Entries()
+		if i := f.selectedIndex(); i < len(entries) {
+			return entries[i]
+		}
+		return ""
+	}
+	if text := f.Result(); text != emptyFormText {
+		return text
+	}
+	return ""
+}
+
+// SetValue sets the value of the field: the text of a text field, a check
+// box value as FillForm takes, or an entry of a drop-down.
+func (f *FormField) SetValue(value string) error {
+	switch f.FormType() {
+	case FormFieldCheckBox:
+		checked, err := parseCheckValue(value)
+		if err != nil {
+			return fmt.Errorf("docx: form field %q: %w", f.Name(), err)
+		}
+		f.SetChecked(checked)
+		return nil
+	case FormFieldDropDown:
+		return f.Select(value)
+	}
+	return f.SetText(value)
+}
+
+// --------------------------------------------------------------------------
+// Text fields
+// --------------------------------------------------------------------------
+
+// MaxLength returns the maximum number of characters of a text field, or 0
+// for no limit.
+func (f *FormField) MaxLength() int {
+	n, _ := strconv.Atoi(f.ffVal("w:textInput/w:maxLength", "0"))
+	return n
+}
+
+// Default returns the text a text field is filled with initially.
+func (f *FormField) Default() string {
+	return f.ffVal("w:textInput/w:default", "")
+}
+
+// SetText sets the text of a text field. It fails if the text is longer
+// than MaxLength or, for a number field, is not a number. The case
+// conversion set for the field, such as UPPERCASE, is applied.
+func (f *FormField) SetText(text string) error {
+	if f.FormType() != FormFieldText {
+		return fmt.Errorf("docx: form field %q is not a text field", f.Name())
+	}
+	if f.span.End == nil {
+		return fmt.Errorf("docx: form field %q is not terminated", f.Name())
+	}
+	if max := f.MaxLength(); max > 0 && utf8.RuneCountInString(text) > max {
+		return fmt.Errorf("docx: form field %q takes at most %d characters", f.Name(), max)
+	}
+	switch f.ffVal("w:textInput/w:type", "regular") {
+	case "number":
+		if _, err := strconv.ParseFloat(strings.TrimSpace(text), 64); text != "" && err != nil {
+			return fmt.Errorf("docx: form field %q takes a number, not %q", f.Name(), text)
+		}
+	case "calculated", "currentDate", "currentTime":
+		return fmt.Errorf("docx: form field %q is computed by Word", f.Name())
+	}
+	switch f.ffVal("w:textInput/w:format", "") {
+	case "UPPERCASE":
+		text = strings.ToUpper(text)
+	case "LOWERCASE":
+		text = strings.ToLower(text)
+	case "FIRST CAPITAL":
+		if r, size := utf8.DecodeRuneInString(text); size > 0 {
+			text = string(unicode.ToUpper(r)) + text[size:]
+		}
+	case "TITLE CASE":
+		words := strings.Fields(text)
+		for i, w := range words {
+			r, size := utf8.DecodeRuneInString(w)
+			words[i] = string(unicode.ToUpper(r)) + w[size:]
+		}
+		if len(words) > 0 {
+			text = strings.Join(words, " ")
+		}
+	}
+	f.ffData()
+	if text == "" {
+		text = emptyFormText
+	}
+	f.span.SetResult(text)
+	return nil
+}
+
+// --------------------------------------------------------------------------
+// Check boxes
+// --------------------------------------------------------------------------
+
+// Checked reports whether a check box field is checked: its state if set,
+// its default otherwise.
+func (f *FormField) Checked() bool {
+	if v := f.ffVal("w:checkBox/w:checked", ""); v != "" {
+		return isOn(v)
+	}
+	return isOn(f.ffVal("w:checkBox/w:default", "0"))
+}
+
+// SetChecked checks or clears a check box field. It does nothing to other
+// fields.
+func (f *FormField) SetChecked(checked bool) {
+	if f.FormType() != FormFieldCheckBox {
+		return
+	}
+	box := f.ffData().SelectElement("w:checkBox")
+	el := box.SelectElement("w:checked")
+	if el == nil {
+		el = box.CreateElement("w:checked")
+	}
+	el.RemoveAttr("w:val")
+	if !checked {
+		el.CreateAttr("w:val", "0")
+	}
+}
+
+// --------------------------------------------------------------------------
+// Drop-downs
+// --------------------------------------------------------------------------
+
+// Entries returns the entries of a drop-down field.
+func (f *FormField) Entries() []string {
+	ff := f.span.Begin.SelectElement("w:ffData")
+	if ff == nil {
+		return nil
+	}
+	var result []string
+	for _, el := range ff.FindElements("w:ddList/w:listEntry") {
+		result = append(result, el.SelectAttrValue("w:val", ""))
+	}
+	return result
+}
+
+// selectedIndex returns the index of the selected entry of a drop-down.
+func (f *FormField) selectedIndex() int {
+	i, err := strconv.Atoi(f.ffVal("w:ddList/w:result", ""))
+	if err != nil {
+		i, _ = strconv.Atoi(f.ffVal("w:ddList/w:default", "0"))
+	}
+	return i
+}
+
+// Select selects entry in a drop-down field. It fails if the field has no
+// such entry.
+func (f *FormField) Select(entry string) error {
+	if f.FormType() != FormFieldDropDown {
+		return fmt.Errorf("docx: form field %q is not a drop-down", f.Name())
+	}
+	for i, e := range f.Entries() {
+		if e != entry {
+			continue
+		}
+		list := f.ffData().SelectElement("w:ddList")
+		el := list.SelectElement("w:result")
+		if el == nil {
+			el = etree.NewElement("w:result")
+			list.InsertChildAt(0, el)
+		}
+		el.CreateAttr("w:val", strconv.Itoa(i))
+		return nil
+	}
+	return fmt.Errorf("docx: %q is not an entry of drop-down form field %q", entry, f.Name())
+}
@@ -0,0 +1,155 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// addLegacyField appends a paragraph holding a legacy form field with the
+// given ffData children, instruction and result ("" for none).
+func addLegacyField(t *testing.T, d *Document, ffData, instr, result string) {
+	t.Helper()
+	p, err := d.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	xml := `<w:p ` + wNS + `><w:r><w:fldChar w:fldCharType="begin"><w:ffData>` + ffData + `</w:ffData></w:fldChar></w:r>` +
+		`<w:r><w:instrText xml:space="preserve"> ` + instr + ` </w:instrText></w:r>`
+	if result != "" {
+		xml += `<w:r><w:fldChar w:fldCharType="separate"/></w:r><w:r><w:rPr><w:b/></w:rPr><w:t xml:space="preserve">` + result + `</w:t></w:r>`
+	}
+	xml += `<w:r><w:fldChar w:fldCharType="end"/></w:r></w:p>`
+	src, err := oxml.ParseXml([]byte(xml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range src.ChildElements() {
+		p.p.RawElement().AddChild(r.Copy())
+	}
+}
+
+func newLegacyFormDoc(t *testing.T) *Document {
+	t.Helper()
+	d := mustNewDoc(t)
+	addLegacyField(t, d, `<w:name w:val="Surname"/><w:enabled/><w:calcOnExit w:val="0"/>`+
+		`<w:textInput><w:maxLength w:val="10"/><w:format w:val="UPPERCASE"/></w:textInput>`, "FORMTEXT", emptyFormText)
+	addLegacyField(t, d, `<w:name w:val="Age"/><w:enabled/><w:calcOnExit w:val="0"/>`+
+		`<w:textInput><w:type w:val="number"/></w:textInput>`, "FORMTEXT", "30")
+	addLegacyField(t, d, `<w:name w:val="Resident"/><w:enabled/><w:calcOnExit w:val="0"/>`+
+		`<w:checkBox><w:sizeAuto/><w:default w:val="0"/></w:checkBox>`, "FORMCHECKBOX", "")
+	addLegacyField(t, d, `<w:name w:val="State"/><w:enabled/><w:calcOnExit w:val="0"/>`+
+		`<w:ddList><w:listEntry w:val="Ohio"/><w:listEntry w:val="Utah"/></w:ddList>`, "FORMDROPDOWN", "")
+	p, err := d.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.AddField("DATE", "today"); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func mustFormField(t *testing.T, d *Document, name string) *FormField {
+	t.Helper()
+	f, err := d.FormField(name)
+	if err != nil || f == nil {
+		t.Fatalf("FormField(%q) = %v, %v", name, f, err)
+	}
+	return f
+}
+
+func TestFormFields(t *testing.T) {
+	d := newLegacyFormDoc(t)
+	fields, err := d.FormFields()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range fields {
+		names = append(names, f.FormType().String()+":"+f.Name()+"="+f.Value())
+	}
+	want := []string{"FORMTEXT:Surname=", "FORMTEXT:Age=30", "FORMCHECKBOX:Resident=false", "FORMDROPDOWN:State=Ohio"}
+	if len(names) != len(want) {
+		t.Fatalf("fields = %q, want %q", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("field %d = %q, want %q", i, names[i], want[i])
+		}
+	}
+
+	for name, value := range map[string]string{"Surname": "lee", "Age": "41", "Resident": "yes", "State": "Utah"} {
+		if err := mustFormField(t, d, name).SetValue(value); err != nil {
+			t.Fatalf("SetValue(%q, %q): %v", name, value, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	d, err = OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{"Surname": "LEE", "Age": "41", "Resident": "true", "State": "Utah"} {
+		if got := mustFormField(t, d, name).Value(); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	surname := mustFormField(t, d, "Surname")
+	if r := surname.span.ResultRuns; len(r) != 1 || r[0].FindElement("w:rPr/w:b") == nil {
+		t.Error("text field result lost its formatting")
+	}
+	if el := mustFormField(t, d, "State").span.Begin.FindElement(".//w:ddList/w:result"); el == nil || el.SelectAttrValue("w:val", "") != "1" {
+		t.Error("drop-down result not recorded")
+	}
+
+	resident := mustFormField(t, d, "Resident")
+	resident.SetChecked(false)
+	if resident.Checked() {
+		t.Error("Checked after SetChecked(false)")
+	}
+	if err := surname.SetText(""); err != nil || surname.Result() != emptyFormText || surname.Value() != "" {
+		t.Errorf("clearing text: %v, result %q", err, surname.Result())
+	}
+}
+
+func TestFormField_Validation(t *testing.T) {
+	d := newLegacyFormDoc(t)
+	tests := []struct{ name, value string }{
+		{"Surname", "much too long a name"},
+		{"Age", "forty"},
+		{"Resident", "perhaps"},
+		{"State", "Texas"},
+	}
+	for _, tt := range tests {
+		if err := mustFormField(t, d, tt.name).SetValue(tt.value); err == nil {
+			t.Errorf("SetValue(%q, %q) succeeded", tt.name, tt.value)
+		}
+	}
+	if f, err := d.FormField("Nope"); f != nil || err != nil {
+		t.Errorf("FormField(Nope) = %v, %v", f, err)
+	}
+}
+
+func TestFormField_CreatesFFData(t *testing.T) {
+	d := mustNewDoc(t)
+	p, err := d.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.AddField("FORMCHECKBOX", ""); err != nil {
+		t.Fatal(err)
+	}
+	fields, err := d.FormFields()
+	if err != nil || len(fields) != 1 {
+		t.Fatalf("FormFields = %v, %v", fields, err)
+	}
+	fields[0].SetChecked(true)
+	if !fields[0].Checked() || fields[0].span.Begin.FindElement("w:ffData/w:checkBox/w:sizeAuto") == nil {
+		t.Error("ffData not created for check box")
+	}
+}
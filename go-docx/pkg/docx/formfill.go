@@ -0,0 +1,431 @@
+package docx
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+)
+
+// ContentControlType identifies the kind of a content control, as declared
+// by its properties.
+type ContentControlType int
+
+const (
+	// ContentControlRichText holds formatted content; it is the kind of
+	// controls that declare none.
+	ContentControlRichText ContentControlType = iota
+	// ContentControlPlainText holds unformatted text (w:text).
+	ContentControlPlainText
+	// ContentControlCheckBox is a check box (w14:checkbox).
+	ContentControlCheckBox
+	// ContentControlDate is a date picker (w:date).
+	ContentControlDate
+	// ContentControlDropDownList offers a fixed list of choices.
+	ContentControlDropDownList
+	// ContentControlComboBox offers a list of choices or free text.
+	ContentControlComboBox
+	// ContentControlPicture holds a picture.
+	ContentControlPicture
+	// ContentControlOther is any other kind, such as a building block
+	// gallery, citation or group.
+	ContentControlOther
+)
+
+// String returns the name of the kind, e.g. "checkBox".
+func (t ContentControlType) String() string {
+	switch t {
+	case ContentControlRichText:
+		return "richText"
+	case ContentControlPlainText:
+		return "text"
+	case ContentControlCheckBox:
+		return "checkBox"
+	case ContentControlDate:
+		return "date"
+	case ContentControlDropDownList:
+		return "dropDownList"
+	case ContentControlComboBox:
+		return "comboBox"
+	case ContentControlPicture:
+		return "picture"
+	}
+	return "other"
+}
+
+// Type returns the kind of the control.
+func (c *ContentControl) Type() ContentControlType {
+	pr := c.sdt.SelectElement("w:sdtPr")
+	if pr == nil {
+		return ContentControlRichText
+	}
+	for _, child := range pr.ChildElements() {
+		switch {
+		case child.Space == "w14" && child.Tag == "checkbox":
+			return ContentControlCheckBox
+		case child.Space != "w":
+		case child.Tag == "richText":
+			return ContentControlRichText
+		case child.Tag == "text":
+			return ContentControlPlainText
+		case child.Tag == "date":
+			return ContentControlDate
+		case child.Tag == "dropDownList":
+			return ContentControlDropDownList
+		case child.Tag == "comboBox":
+			return ContentControlComboBox
+		case child.Tag == "picture":
+			return ContentControlPicture
+		case sdtPrTypeTags[child.Tag]:
+			return ContentControlOther
+		}
+	}
+	return ContentControlRichText
+}
+
+// FillForm sets the content controls whose tags are the keys of values,
+// every control with a tag, to the corresponding value:
+//
+//   - text controls take the value as their text; plain text controls
+//     only take line breaks when they are multi-line;
+//   - check boxes take "true" or "false" (or "1", "0", "yes", "no", "x"
+//     and "" for false);
+//   - date pickers take a date as 2006-01-02, as RFC 3339, or in the
+//     control's own date format, and show it in that format;
+//   - drop-down lists take the value or the display text of one of their
+//     entries; combo boxes also take free text.
+//
+// All values are validated before any control is changed, so on error the
+// document is as it was. Bound controls also store their values in their
+// custom XML parts.
+func (d *Document) FillForm(values map[string]string) error {
+	tags := make([]string, 0, len(values))
+	for tag := range values {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	byTag := map[string][]*ContentControl{}
+	for _, cc := range d.ContentControls() {
+		if tag := cc.Tag(); tag != "" {
+			byTag[tag] = append(byTag[tag], cc)
+		}
+	}
+	var fills []func() error
+	for _, tag := range tags {
+		controls := byTag[tag]
+		if len(controls) == 0 {
+			return fmt.Errorf("docx: no content control tagged %q", tag)
+		}
+		for _, cc := range controls {
+			fill, err := cc.prepareValue(values[tag])
+			if err != nil {
+				return err
+			}
+			fills = append(fills, fill)
+		}
+	}
+	for _, fill := range fills {
+		if err := fill(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetValue sets the control to value as FillForm does.
+func (c *ContentControl) SetValue(value string) error {
+	fill, err := c.prepareValue(value)
+	if err != nil {
+		return err
+	}
+	return fill()
+}
+
+// prepareValue validates value for the control and returns the function
+// setting it.
+func (c *ContentControl) prepareValue(value string) (func() error, error) {
+	if level := c.level(); level == "cell" || level == "row" {
+		return nil, fmt.Errorf("docx: content control %q spans table cells; fill the controls in its cells", c.Tag())
+	}
+	var display, stored string
+	var update func()
+	switch c.Type() {
+	case ContentControlRichText:
+		display, stored = value, value
+	case ContentControlPlainText:
+		multi := c.sdt.FindElement("w:sdtPr/w:text")
+		if strings.Contains(value, "\n") && !isOn(multi.SelectAttrValue("w:multiLine", "")) {
+			return nil, fmt.Errorf("docx: content control %q is single-line; value has a line break", c.Tag())
+		}
+		display, stored = value, value
+	case ContentControlCheckBox:
+		checked, err := parseCheckValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("docx: content control %q: %w", c.Tag(), err)
+		}
+		display, stored = c.checkBoxSymbol(checked), strconv.FormatBool(checked)
+		update = func() { c.setChecked(checked) }
+	case ContentControlDate:
+		date, err := c.parseDate(value)
+		if err != nil {
+			return nil, err
+		}
+		display = date.Format(wordDateLayout(c.DateFormat()))
+		var storeAs string
+		if el := c.sdt.FindElement("w:sdtPr/w:date/w:storeMappedDataAs"); el != nil {
+			storeAs = el.SelectAttrValue("w:val", "")
+		}
+		switch storeAs {
+		case "dateTime":
+			stored = date.Format("2006-01-02T15:04:05")
+		case "date":
+			stored = date.Format("2006-01-02")
+		default:
+			stored = display
+		}
+		update = func() {
+			c.sdt.FindElement("w:sdtPr/w:date").CreateAttr("w:fullDate", date.Format("2006-01-02T15:04:05Z"))
+		}
+	case ContentControlDropDownList, ContentControlComboBox:
+		item, ok := c.findListItem(value)
+		if !ok && c.Type() == ContentControlDropDownList {
+			return nil, fmt.Errorf("docx: %q is not an entry of drop-down list %q", value, c.Tag())
+		}
+		display, stored = value, value
+		if ok {
+			display, stored = item.DisplayText, item.Value
+		}
+		update = func() {
+			list := c.listElement()
+			if list.SelectAttr("w:lastValue") != nil {
+				list.CreateAttr("w:lastValue", stored)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("docx: %s content control %q cannot be filled with text", c.Type(), c.Tag())
+	}
+
+	b := c.DataBinding()
+	if b != nil {
+		if _, err := c.doc.boundValue(b); err != nil {
+			return nil, err
+		}
+	}
+	return func() error {
+		if b != nil {
+			if err := c.doc.setBoundValue(b, stored); err != nil {
+				return err
+			}
+		}
+		if update != nil {
+			update()
+		}
+		return c.setContentText(display)
+	}, nil
+}
+
+// isOn reports whether an OOXML boolean attribute value is true.
+func isOn(v string) bool {
+	return v == "1" || v == "true" || v == "on"
+}
+
+// --------------------------------------------------------------------------
+// Check boxes
+// --------------------------------------------------------------------------
+
+// parseCheckValue parses the value of a check box.
+func parseCheckValue(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "1", "yes", "y", "x", "on", "checked":
+		return true, nil
+	case "false", "0", "no", "n", "", "off", "unchecked":
+		return false, nil
+	}
+	return false, fmt.Errorf("%q is not a check box value", value)
+}
+
+// Checked reports whether a check box control is checked.
+func (c *ContentControl) Checked() bool {
+	el := c.sdt.FindElement("w:sdtPr/w14:checkbox/w14:checked")
+	return el != nil && isOn(el.SelectAttrValue("w14:val", ""))
+}
+
+// SetChecked checks or clears a check box control.
+func (c *ContentControl) SetChecked(checked bool) error {
+	if c.Type() != ContentControlCheckBox {
+		return fmt.Errorf("docx: content control %q is not a check box", c.Tag())
+	}
+	return c.SetValue(strconv.FormatBool(checked))
+}
+
+// setChecked records the state of a check box.
+func (c *ContentControl) setChecked(checked bool) {
+	box := c.sdt.FindElement("w:sdtPr/w14:checkbox")
+	el := box.SelectElement("w14:checked")
+	if el == nil {
+		el = etree.NewElement("w14:checked")
+		box.InsertChildAt(0, el)
+	}
+	el.CreateAttr("w14:val", map[bool]string{true: "1", false: "0"}[checked])
+}
+
+// checkBoxSymbol returns the character a check box shows in the given
+// state: the one its properties declare, or Word's default ballot boxes.
+func (c *ContentControl) checkBoxSymbol(checked bool) string {
+	tag, def := "w14:uncheckedState", '☐'
+	if checked {
+		tag, def = "w14:checkedState", '☒'
+	}
+	if el := c.sdt.FindElement("w:sdtPr/w14:checkbox/" + tag); el != nil {
+		if code, err := strconv.ParseUint(el.SelectAttrValue("w14:val", ""), 16, 32); err == nil {
+			return string(rune(code))
+		}
+	}
+	return string(def)
+}
+
+// --------------------------------------------------------------------------
+// Date pickers
+// --------------------------------------------------------------------------
+
+// DateFormat returns the format a date picker control shows its date in,
+// in Word's notation, e.g. "M/d/yyyy".
+func (c *ContentControl) DateFormat() string {
+	if el := c.sdt.FindElement("w:sdtPr/w:date/w:dateFormat"); el != nil {
+		if v := el.SelectAttrValue("w:val", ""); v != "" {
+			return v
+		}
+	}
+	return "M/d/yyyy"
+}
+
+// Date returns the date chosen in a date picker control, and false if none
+// is.
+func (c *ContentControl) Date() (time.Time, bool) {
+	el := c.sdt.FindElement("w:sdtPr/w:date")
+	if el == nil {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, el.SelectAttrValue("w:fullDate", ""))
+	return t, err == nil
+}
+
+// SetDate sets the date of a date picker control.
+func (c *ContentControl) SetDate(date time.Time) error {
+	if c.Type() != ContentControlDate {
+		return fmt.Errorf("docx: content control %q is not a date picker", c.Tag())
+	}
+	return c.SetValue(date.Format(time.RFC3339))
+}
+
+// parseDate parses the value of a date picker.
+func (c *ContentControl) parseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{"2006-01-02", time.RFC3339, "2006-01-02T15:04:05", wordDateLayout(c.DateFormat())} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("docx: %q is not a date as 2006-01-02 or in the format %q of content control %q", value, c.DateFormat(), c.Tag())
+}
+
+// wordDateTokens maps the fields of Word date formats to Go layout
+// elements, longest first. Go has no unpadded 24-hour hour, so H is
+// written padded.
+var wordDateTokens = []struct{ word, layout string }{
+	{"dddd", "Monday"}, {"ddd", "Mon"}, {"dd", "02"}, {"d", "2"},
+	{"MMMM", "January"}, {"MMM", "Jan"}, {"MM", "01"}, {"M", "1"},
+	{"yyyy", "2006"}, {"yy", "06"},
+	{"HH", "15"}, {"H", "15"}, {"hh", "03"}, {"h", "3"},
+	{"mm", "04"}, {"m", "4"}, {"ss", "05"}, {"s", "5"},
+	{"AM/PM", "PM"}, {"am/pm", "pm"},
+}
+
+// wordDateLayout converts a Word date format such as "dddd, MMMM d, yyyy"
+// to a Go time layout. Text in single quotes is literal.
+func wordDateLayout(format string) string {
+	var sb strings.Builder
+next:
+	for i := 0; i < len(format); {
+		if format[i] == '\'' {
+			end := strings.IndexByte(format[i+1:], '\'')
+			if end < 0 {
+				sb.WriteString(format[i+1:])
+				break
+			}
+			sb.WriteString(format[i+1 : i+1+end])
+			i += end + 2
+			continue
+		}
+		for _, tok := range wordDateTokens {
+			if strings.HasPrefix(format[i:], tok.word) {
+				sb.WriteString(tok.layout)
+				i += len(tok.word)
+				continue next
+			}
+		}
+		sb.WriteByte(format[i])
+		i++
+	}
+	return sb.String()
+}
+
+// --------------------------------------------------------------------------
+// Lists
+// --------------------------------------------------------------------------
+
+// ListItem is an entry of a drop-down list or combo box control.
+type ListItem struct {
+	// DisplayText is the text shown for the entry.
+	DisplayText string
+	// Value is the value stored for the entry.
+	Value string
+}
+
+// listElement returns the w:dropDownList or w:comboBox of the control, or
+// nil.
+func (c *ContentControl) listElement() *etree.Element {
+	if el := c.sdt.FindElement("w:sdtPr/w:dropDownList"); el != nil {
+		return el
+	}
+	return c.sdt.FindElement("w:sdtPr/w:comboBox")
+}
+
+// ListItems returns the entries of a drop-down list or combo box control.
+// An entry without display text shows its value.
+func (c *ContentControl) ListItems() []ListItem {
+	list := c.listElement()
+	if list == nil {
+		return nil
+	}
+	var result []ListItem
+	for _, el := range list.SelectElements("w:listItem") {
+		value := el.SelectAttrValue("w:value", "")
+		result = append(result, ListItem{
+			DisplayText: el.SelectAttrValue("w:displayText", value),
+			Value:       value,
+		})
+	}
+	return result
+}
+
+// findListItem returns the entry whose value or, failing that, display
+// text is value.
+func (c *ContentControl) findListItem(value string) (ListItem, bool) {
+	items := c.ListItems()
+	for _, item := range items {
+		if item.Value == value {
+			return item, true
+		}
+	}
+	for _, item := range items {
+		if item.DisplayText == value {
+			return item, true
+		}
+	}
+	return ListItem{}, false
+}
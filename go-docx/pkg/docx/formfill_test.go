@@ -0,0 +1,160 @@
+package docx
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// addRunControl appends a paragraph holding the run-level content control
+// whose sdtPr children are props.
+func addRunControl(t *testing.T, d *Document, props, text string) {
+	t.Helper()
+	p, err := d.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdt, err := oxml.ParseXml([]byte(`<w:sdt ` + wNS + ` xmlns:w14="http://schemas.microsoft.com/office/word/2010/wordml">` +
+		`<w:sdtPr>` + props + `</w:sdtPr><w:sdtContent><w:r><w:t>` + text + `</w:t></w:r></w:sdtContent></w:sdt>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.p.RawElement().AddChild(sdt)
+}
+
+func newFormDoc(t *testing.T) *Document {
+	t.Helper()
+	d := mustNewDoc(t)
+	addRunControl(t, d, `<w:tag w:val="name"/><w:text/>`, "Your name")
+	addRunControl(t, d, `<w:tag w:val="agree"/><w14:checkbox><w14:checked w14:val="0"/>`+
+		`<w14:checkedState w14:val="2612" w14:font="MS Gothic"/><w14:uncheckedState w14:val="2610" w14:font="MS Gothic"/></w14:checkbox>`, "☐")
+	addRunControl(t, d, `<w:tag w:val="due"/><w:date><w:dateFormat w:val="d MMMM yyyy"/><w:lid w:val="en-GB"/></w:date>`, "Pick a date")
+	addRunControl(t, d, `<w:tag w:val="size"/><w:dropDownList w:lastValue="">`+
+		`<w:listItem w:displayText="Small" w:value="S"/><w:listItem w:displayText="Large" w:value="L"/></w:dropDownList>`, "Choose")
+	addRunControl(t, d, `<w:tag w:val="color"/><w:comboBox><w:listItem w:displayText="Red" w:value="r"/></w:comboBox>`, "Choose")
+	return d
+}
+
+func formControl(t *testing.T, d *Document, tag string) *ContentControl {
+	t.Helper()
+	controls := d.ContentControlsByTag(tag)
+	if len(controls) != 1 {
+		t.Fatalf("%d controls tagged %q", len(controls), tag)
+	}
+	return controls[0]
+}
+
+func TestFillForm(t *testing.T) {
+	d := newFormDoc(t)
+	err := d.FillForm(map[string]string{
+		"name":  "Ann Lee",
+		"agree": "yes",
+		"due":   "2024-03-05",
+		"size":  "Large",
+		"color": "teal",
+	})
+	if err != nil {
+		t.Fatalf("FillForm: %v", err)
+	}
+
+	tests := []struct {
+		tag  string
+		typ  ContentControlType
+		text string
+	}{
+		{"name", ContentControlPlainText, "Ann Lee"},
+		{"agree", ContentControlCheckBox, "☒"},
+		{"due", ContentControlDate, "5 March 2024"},
+		{"size", ContentControlDropDownList, "Large"},
+		{"color", ContentControlComboBox, "teal"},
+	}
+	for _, tt := range tests {
+		cc := formControl(t, d, tt.tag)
+		if cc.Type() != tt.typ || cc.Text() != tt.text {
+			t.Errorf("%s: Type, Text = %v, %q; want %v, %q", tt.tag, cc.Type(), cc.Text(), tt.typ, tt.text)
+		}
+	}
+	if !formControl(t, d, "agree").Checked() {
+		t.Error("check box not checked")
+	}
+	if date, ok := formControl(t, d, "due").Date(); !ok || !date.Equal(time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Date = %v, %v", date, ok)
+	}
+	if v := formControl(t, d, "size").sdt.FindElement(".//w:dropDownList").SelectAttrValue("w:lastValue", ""); v != "L" {
+		t.Errorf("lastValue = %q, want L", v)
+	}
+
+	// Dates in the control's own format are accepted too.
+	if err := formControl(t, d, "due").SetValue("7 April 2025"); err != nil {
+		t.Fatal(err)
+	}
+	if got := formControl(t, d, "due").Text(); got != "7 April 2025" {
+		t.Errorf("due = %q", got)
+	}
+	if err := formControl(t, d, "agree").SetChecked(false); err != nil || formControl(t, d, "agree").Text() != "☐" {
+		t.Errorf("SetChecked(false): %v, %q", err, formControl(t, d, "agree").Text())
+	}
+}
+
+func TestFillForm_Validation(t *testing.T) {
+	tests := []struct {
+		values map[string]string
+		want   string
+	}{
+		{map[string]string{"missing": "x"}, "no content control"},
+		{map[string]string{"size": "Medium"}, "not an entry"},
+		{map[string]string{"due": "yesterday"}, "not a date"},
+		{map[string]string{"agree": "maybe"}, "not a check box value"},
+		{map[string]string{"name": "two\nlines"}, "single-line"},
+	}
+	for _, tt := range tests {
+		d := newFormDoc(t)
+		values := map[string]string{"name": "Ann"}
+		for k, v := range tt.values {
+			values[k] = v
+		}
+		err := d.FillForm(values)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("FillForm(%v) = %v, want error containing %q", tt.values, err, tt.want)
+		}
+		if got := formControl(t, d, "name").Text(); got != "Your name" {
+			t.Errorf("FillForm(%v) changed the form: name = %q", tt.values, got)
+		}
+	}
+}
+
+func TestFillForm_Binding(t *testing.T) {
+	d := newFormDoc(t)
+	cp, err := d.CustomXmlParts().Add([]byte(`<form><agree>false</agree></form>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc := formControl(t, d, "agree")
+	if err := cc.SetDataBinding(&DataBinding{StoreItemID: cp.ItemID(), XPath: "/form[1]/agree[1]"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.FillForm(map[string]string{"agree": "1"}); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := cp.Value("/form[1]/agree[1]", ""); v != "true" {
+		t.Errorf("bound value = %q, want true", v)
+	}
+}
+
+func TestWordDateLayout(t *testing.T) {
+	date := time.Date(2024, 3, 5, 14, 7, 9, 0, time.UTC)
+	tests := map[string]string{
+		"M/d/yyyy":           "3/5/2024",
+		"dd.MM.yy":           "05.03.24",
+		"dddd, MMMM d, yyyy": "Tuesday, March 5, 2024",
+		"h:mm AM/PM":         "2:07 PM",
+		"'Week of' MMM d":    "Week of Mar 5",
+	}
+	for format, want := range tests {
+		if got := date.Format(wordDateLayout(format)); got != want {
+			t.Errorf("%q: got %q, want %q", format, got, want)
+		}
+	}
+}
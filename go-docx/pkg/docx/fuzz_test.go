@@ -0,0 +1,113 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// exerciseDocument walks the content of d the way a server handling an
+// upload would, so that the fuzz targets reach the lazily parsed parts.
+func exerciseDocument(d *Document) {
+	if paras, err := d.Paragraphs(); err == nil {
+		for _, p := range paras {
+			_ = p.Text()
+		}
+	}
+	if tables, err := d.Tables(); err == nil {
+		for _, tbl := range tables {
+			for _, row := range tbl.Rows().Iter() {
+				for _, c := range row.Cells() {
+					_ = c.Text()
+				}
+			}
+		}
+	}
+	for _, s := range d.Sections().Iter() {
+		_, _ = s.Orientation()
+	}
+	_, _ = d.Outline()
+	_, _ = d.Structure()
+	_, _ = d.SaveBytes()
+}
+
+// FuzzOpenBytes checks that no input makes OpenBytes, or reading and
+// saving what it opened, panic.
+func FuzzOpenBytes(f *testing.F) {
+	d, err := New()
+	if err != nil {
+		f.Fatal(err)
+	}
+	if _, err := d.AddParagraph("Hello", StyleName("Heading 1")); err != nil {
+		f.Fatal(err)
+	}
+	if _, err := d.AddTable(2, 2); err != nil {
+		f.Fatal(err)
+	}
+	data, err := d.SaveBytes()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(data)
+	paths, _ := filepath.Glob("../../visual-regtest/test-files/*.docx")
+	for _, path := range paths {
+		if data, err := os.ReadFile(path); err == nil {
+			f.Add(data)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		d, err := OpenBytes(data)
+		if err != nil {
+			return
+		}
+		exerciseDocument(d)
+	})
+}
+
+// FuzzOpenDocumentXML fuzzes the main document part of an otherwise
+// valid package, which mutations of the zip bytes rarely reach.
+func FuzzOpenDocumentXML(f *testing.F) {
+	d, err := New()
+	if err != nil {
+		f.Fatal(err)
+	}
+	data, err := d.SaveBytes()
+	if err != nil {
+		f.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body><w:p><w:r><w:t>x</w:t></w:r></w:p><w:sectPr/></w:body></w:document>`)
+	f.Add(`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body><w:tbl><w:tr><w:tc><w:tcPr><w:gridSpan w:val="3"/><w:vMerge/></w:tcPr></w:tc></w:tr></w:tbl><w:p><w:pPr><w:sectPr><w:pgSz w:w="-1" w:orient="landscape"/></w:sectPr></w:pPr></w:p></w:body></w:document>`)
+
+	f.Fuzz(func(t *testing.T, documentXml string) {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for _, zf := range zr.File {
+			if zf.Name == "word/document.xml" {
+				w, err := zw.Create(zf.Name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write([]byte(documentXml))
+				continue
+			}
+			if err := zw.Copy(zf); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := zw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		d, err := OpenBytes(buf.Bytes())
+		if err != nil {
+			return
+		}
+		exerciseDocument(d)
+	})
+}
@@ -0,0 +1,196 @@
+package docx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// --------------------------------------------------------------------------
+// BuildingBlocks
+// --------------------------------------------------------------------------
+
+// BuildingBlocks is the collection of building blocks of a document:
+// named pieces of content kept in its glossary document, such as the
+// AutoText entries and Quick Parts a template offers.
+type BuildingBlocks struct {
+	doc *Document
+}
+
+// BuildingBlocks returns the building blocks of this document.
+func (d *Document) BuildingBlocks() *BuildingBlocks {
+	return &BuildingBlocks{doc: d}
+}
+
+// Iter returns the building blocks in document order.
+func (bs *BuildingBlocks) Iter() []*BuildingBlock {
+	gp := bs.doc.part.GlossaryDocumentPart()
+	if gp == nil || gp.Element() == nil {
+		return nil
+	}
+	var result []*BuildingBlock
+	if docParts := gp.Element().SelectElement("w:docParts"); docParts != nil {
+		for _, el := range docParts.SelectElements("w:docPart") {
+			result = append(result, newBuildingBlock(el, gp))
+		}
+	}
+	return result
+}
+
+// Len returns the number of building blocks.
+func (bs *BuildingBlocks) Len() int {
+	return len(bs.Iter())
+}
+
+// Get returns the first building block with the given name, compared
+// without regard to case as Word does, or nil.
+func (bs *BuildingBlocks) Get(name string) *BuildingBlock {
+	for _, b := range bs.Iter() {
+		if strings.EqualFold(b.Name(), name) {
+			return b
+		}
+	}
+	return nil
+}
+
+// Add adds an empty building block to the gallery named gallery, e.g.
+// "docParts" for Quick Parts or "autoTxt" for AutoText, under category,
+// and returns it. Content is added to it like to any other container. A
+// glossary document is created if the document has none.
+func (bs *BuildingBlocks) Add(name, gallery, category string) (*BuildingBlock, error) {
+	if name == "" {
+		return nil, fmt.Errorf("docx: building block name is empty")
+	}
+	gp, err := bs.doc.part.GetOrAddGlossaryDocumentPart()
+	if err != nil {
+		return nil, fmt.Errorf("docx: adding glossary document: %w", err)
+	}
+	guid, err := newGUID()
+	if err != nil {
+		return nil, err
+	}
+	root := gp.Element()
+	docParts := root.SelectElement("w:docParts")
+	if docParts == nil {
+		docParts = oxml.OxmlElement("w:docParts")
+		root.AddChild(docParts)
+	}
+
+	docPart := oxml.OxmlElement("w:docPart")
+	pr := docPart.CreateElement("w:docPartPr")
+	pr.CreateElement("w:name").CreateAttr("w:val", name)
+	cat := pr.CreateElement("w:category")
+	cat.CreateElement("w:name").CreateAttr("w:val", category)
+	cat.CreateElement("w:gallery").CreateAttr("w:val", gallery)
+	pr.CreateElement("w:behaviors").CreateElement("w:behavior").CreateAttr("w:val", "content")
+	pr.CreateElement("w:guid").CreateAttr("w:val", guid)
+	docPart.CreateElement("w:docPartBody")
+	docParts.AddChild(docPart)
+	return newBuildingBlock(docPart, gp), nil
+}
+
+// --------------------------------------------------------------------------
+// BuildingBlock
+// --------------------------------------------------------------------------
+
+// BuildingBlock is one entry of the glossary document. Its content is a
+// block-item container, read and edited like the body.
+type BuildingBlock struct {
+	BlockItemContainer
+	docPart *etree.Element
+	part    *parts.GlossaryDocumentPart
+}
+
+// newBuildingBlock creates a BuildingBlock proxy for the w:docPart el.
+func newBuildingBlock(el *etree.Element, gp *parts.GlossaryDocumentPart) *BuildingBlock {
+	body := el.SelectElement("w:docPartBody")
+	if body == nil {
+		body = el.CreateElement("w:docPartBody")
+	}
+	return &BuildingBlock{
+		BlockItemContainer: newBlockItemContainer(body, &gp.StoryPart),
+		docPart:            el,
+		part:               gp,
+	}
+}
+
+// property returns the w:val of the element at path under w:docPartPr, or
+// "".
+func (b *BuildingBlock) property(path string) string {
+	pr := b.docPart.SelectElement("w:docPartPr")
+	if pr == nil {
+		return ""
+	}
+	el := pr.FindElement(path)
+	if el == nil {
+		return ""
+	}
+	return el.SelectAttrValue("w:val", "")
+}
+
+// Name returns the name of the building block.
+func (b *BuildingBlock) Name() string { return b.property("w:name") }
+
+// Gallery returns the gallery the building block appears in, e.g.
+// "docParts", "autoTxt", "hdrs" or "coverPg".
+func (b *BuildingBlock) Gallery() string { return b.property("w:category/w:gallery") }
+
+// Category returns the category of the building block within its gallery.
+func (b *BuildingBlock) Category() string { return b.property("w:category/w:name") }
+
+// Description returns the description of the building block, or "".
+func (b *BuildingBlock) Description() string { return b.property("w:description") }
+
+// Text returns the text of the paragraphs of the building block, one line
+// per paragraph.
+func (b *BuildingBlock) Text() string {
+	var lines []string
+	for _, p := range b.Paragraphs() {
+		lines = append(lines, p.Text())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// --------------------------------------------------------------------------
+// Insertion
+// --------------------------------------------------------------------------
+
+// InsertBuildingBlock appends a copy of the content of the building block
+// named name to the end of the body and returns the inserted paragraphs
+// and tables. As with AppendDocument, the styles, lists and images the
+// content uses are brought over from the glossary document.
+func (d *Document) InsertBuildingBlock(name string) ([]*InnerContentItem, error) {
+	b := d.BuildingBlocks().Get(name)
+	if b == nil {
+		return nil, fmt.Errorf("docx: no building block named %q", name)
+	}
+	body, err := d.getBody()
+	if err != nil {
+		return nil, err
+	}
+
+	wrapper := etree.NewElement("wrapper")
+	for _, child := range b.element.ChildElements() {
+		if child.Space == "w" && child.Tag == "sectPr" {
+			continue
+		}
+		wrapper.AddChild(child.Copy())
+	}
+	// The importer reads styles, lists and notes through the relationships
+	// of its source document part, here those of the glossary.
+	src := &Document{part: parts.NewDocumentPart(b.part.XmlPart), wmlPkg: d.wmlPkg}
+	imp := newDocImporter(d, src)
+	if err := imp.importStory(wrapper, &b.part.StoryPart, &d.part.StoryPart); err != nil {
+		return nil, fmt.Errorf("docx: inserting building block %q: %w", name, err)
+	}
+
+	moved := newBlockItemContainer(wrapper, body.part)
+	inserted := moved.IterInnerContent()
+	for _, el := range wrapper.ChildElements() {
+		body.insertBeforeSectPr(el)
+	}
+	return inserted, nil
+}
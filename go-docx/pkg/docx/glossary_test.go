@@ -0,0 +1,97 @@
+package docx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildingBlocks_Existing(t *testing.T) {
+	data := newPreservationDoc(t)
+	data = rewriteZip(t, data, func(name, body string) string {
+		if name == "word/glossary/document.xml" {
+			body = strings.Replace(body, `<w:docPartBody><w:p/></w:docPartBody>`,
+				`<w:docPartBody><w:p><w:pPr><w:pStyle w:val="G"/></w:pPr><w:r><w:t>Signed, the author</w:t></w:r></w:p></w:docPartBody>`, 1)
+		}
+		return body
+	}, nil)
+	d, err := OpenBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs := d.BuildingBlocks()
+	if bs.Len() != 1 {
+		t.Fatalf("Len() = %d, want 1", bs.Len())
+	}
+	b := bs.Get("block")
+	if b == nil {
+		t.Fatal("Get(block) = nil")
+	}
+	if b.Name() != "Block" || b.Text() != "Signed, the author" {
+		t.Errorf("block = %q, %q", b.Name(), b.Text())
+	}
+
+	items, err := d.InsertBuildingBlock("Block")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || !items[0].IsParagraph() || items[0].Paragraph().Text() != "Signed, the author" {
+		t.Fatalf("inserted %d items", len(items))
+	}
+	styles, err := d.part.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if styles.GetByID("G") == nil {
+		t.Error("style G of the glossary was not imported")
+	}
+	if b.Text() != "Signed, the author" {
+		t.Errorf("building block changed to %q", b.Text())
+	}
+	if _, err := d.InsertBuildingBlock("missing"); err == nil {
+		t.Error("expected an error for a missing building block")
+	}
+}
+
+func TestBuildingBlocks_Add(t *testing.T) {
+	d := mustNewDoc(t)
+	if d.BuildingBlocks().Len() != 0 {
+		t.Fatal("new document has building blocks")
+	}
+	b, err := d.BuildingBlocks().Add("Disclaimer", "docParts", "General")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.AddParagraph("Not legal advice."); err != nil {
+		t.Fatal(err)
+	}
+	data, err := d.SaveBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct := zipMember(t, data, "[Content_Types].xml"); !strings.Contains(ct, "document.glossary+xml") {
+		t.Error("glossary content type not declared")
+	}
+
+	d2, err := OpenBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2 := d2.BuildingBlocks().Get("Disclaimer")
+	if b2 == nil {
+		t.Fatal("building block lost on save")
+	}
+	if b2.Gallery() != "docParts" || b2.Category() != "General" || b2.Text() != "Not legal advice." {
+		t.Errorf("block = %q, %q, %q", b2.Gallery(), b2.Category(), b2.Text())
+	}
+	if _, err := d2.InsertBuildingBlock("disclaimer"); err != nil {
+		t.Fatal(err)
+	}
+	paras, err := d2.Paragraphs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := paras[len(paras)-1].Text(); got != "Not legal advice." {
+		t.Errorf("last paragraph = %q", got)
+	}
+}
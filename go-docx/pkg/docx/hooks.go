@@ -0,0 +1,123 @@
+package docx
+
+import (
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// Hooks are callbacks a Document calls when it is changed or saved through
+// this package, so that applications can enforce house rules, such as a
+// ban on direct formatting, in one place. Any hook may be nil.
+//
+// Hooks are not called for changes a hook makes itself, so a hook may
+// apply a style without being called again.
+type Hooks struct {
+	// OnParagraphAdded is called with each paragraph added to a story of
+	// the document by AddParagraph or InsertParagraphBefore, after its text
+	// and style are set. An error removes the paragraph again and is
+	// returned by the adding method.
+	OnParagraphAdded func(p *Paragraph) error
+
+	// OnStyleApplied is called after a style is applied to a paragraph,
+	// run or table. target is the *Paragraph, *Run or *Table, and styleID
+	// the style applied, or "" when the style was removed. An error
+	// restores the previous style and is returned by the applying method.
+	OnStyleApplied func(target any, styleID string) error
+
+	// OnSave is called before the document is written by any of its Save
+	// methods. An error aborts the save and is returned.
+	OnSave func(d *Document) error
+}
+
+// hookState is what a Document keeps on its DocumentPart for Hooks.
+type hookState struct {
+	hooks  *Hooks
+	active bool // a hook is running
+}
+
+// SetHooks installs h as the hooks of the document, replacing any set
+// before. nil removes them. The hooks apply to all proxies of the
+// document, including those obtained before the call.
+func (d *Document) SetHooks(h *Hooks) {
+	if h == nil {
+		d.part.SetAppData(nil)
+		return
+	}
+	d.part.SetAppData(&hookState{hooks: h})
+}
+
+// Hooks returns the hooks of the document, or nil.
+func (d *Document) Hooks() *Hooks {
+	if hs := hooksOf(&d.part.StoryPart); hs != nil {
+		return hs.hooks
+	}
+	return nil
+}
+
+// hooksOf returns the hook state of the document part belongs to, or nil
+// when it has no hooks.
+func hooksOf(part *parts.StoryPart) *hookState {
+	if part == nil {
+		return nil
+	}
+	dp, err := part.DocumentPart()
+	if err != nil {
+		return nil
+	}
+	hs, _ := dp.AppData().(*hookState)
+	return hs
+}
+
+// run calls fn unless a hook is already running.
+func (hs *hookState) run(fn func() error) error {
+	if hs.active {
+		return nil
+	}
+	hs.active = true
+	defer func() { hs.active = false }()
+	return fn()
+}
+
+// paragraphAdded runs the OnParagraphAdded hook for para, removing para
+// when the hook fails.
+func paragraphAdded(para *Paragraph) error {
+	hs := hooksOf(para.part)
+	if hs == nil || hs.hooks.OnParagraphAdded == nil {
+		return nil
+	}
+	err := hs.run(func() error { return hs.hooks.OnParagraphAdded(para) })
+	if err != nil {
+		if el := para.p.RawElement(); el.Parent() != nil {
+			el.Parent().RemoveChild(el)
+		}
+	}
+	return err
+}
+
+// styleApplied runs the OnStyleApplied hook for target, calling restore to
+// undo the change when the hook fails.
+func styleApplied(part *parts.StoryPart, target any, styleID *string, restore func() error) error {
+	hs := hooksOf(part)
+	if hs == nil || hs.hooks.OnStyleApplied == nil {
+		return nil
+	}
+	id := ""
+	if styleID != nil {
+		id = *styleID
+	}
+	err := hs.run(func() error { return hs.hooks.OnStyleApplied(target, id) })
+	if err != nil {
+		if rerr := restore(); rerr != nil {
+			return rerr
+		}
+	}
+	return err
+}
+
+// beforeSave runs the OnSave hook of d.
+func (d *Document) beforeSave() error {
+	hs := hooksOf(&d.part.StoryPart)
+	if hs == nil || hs.hooks.OnSave == nil {
+		return nil
+	}
+	return hs.run(func() error { return hs.hooks.OnSave(d) })
+}
@@ -0,0 +1,119 @@
+package docx
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestHooks_ParagraphAdded(t *testing.T) {
+	d := mustNewDoc(t)
+	var added []string
+	d.SetHooks(&Hooks{OnParagraphAdded: func(p *Paragraph) error {
+		if strings.Contains(p.Text(), "forbidden") {
+			return errors.New("house rule")
+		}
+		added = append(added, p.Text())
+		return nil
+	}})
+	if d.Hooks() == nil {
+		t.Fatal("Hooks() = nil")
+	}
+	p, err := d.AddParagraph("one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.InsertParagraphBefore("zero"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.AddParagraph("forbidden"); err == nil || err.Error() != "house rule" {
+		t.Errorf("err = %v", err)
+	}
+	if _, err := d.Sections().Iter()[0].Header().AddParagraph("head"); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(added, ","); got != "one,zero,head" {
+		t.Errorf("added = %s", got)
+	}
+	paras, _ := d.Paragraphs()
+	for _, p := range paras {
+		if p.Text() == "forbidden" {
+			t.Error("rejected paragraph kept")
+		}
+	}
+
+	d.SetHooks(nil)
+	if _, err := d.AddParagraph("forbidden"); err != nil || d.Hooks() != nil {
+		t.Errorf("hooks still active: %v", err)
+	}
+}
+
+func TestHooks_StyleApplied(t *testing.T) {
+	d := mustNewDoc(t)
+	p, err := d.AddParagraph("text", StyleName("Heading 1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var applied []string
+	d.SetHooks(&Hooks{OnStyleApplied: func(target any, styleID string) error {
+		applied = append(applied, styleID)
+		if styleID == "Title" {
+			// Hooks are not re-entered: forcing another style is allowed.
+			return target.(*Paragraph).SetStyle(StyleName("Heading 2"))
+		}
+		if _, ok := target.(*Run); ok && styleID != "" {
+			return errors.New("no character styles")
+		}
+		return nil
+	}})
+
+	if err := p.SetStyle(StyleName("Title")); err != nil {
+		t.Fatal(err)
+	}
+	if id := styleIDOf(t, p); id != "Heading2" {
+		t.Errorf("forced style = %q", id)
+	}
+	r := p.Runs()[0]
+	if err := r.SetStyle(StyleName("Strong")); err == nil {
+		t.Error("run style accepted")
+	}
+	if id, _ := r.r.Style(); id != nil {
+		t.Errorf("run style = %q, want it restored", *id)
+	}
+	if got := strings.Join(applied, ","); got != "Title,Strong" {
+		t.Errorf("applied = %s", got)
+	}
+}
+
+func styleIDOf(t *testing.T, p *Paragraph) string {
+	t.Helper()
+	id, err := p.p.Style()
+	if err != nil || id == nil {
+		t.Fatalf("style = %v, %v", id, err)
+	}
+	return *id
+}
+
+func TestHooks_Save(t *testing.T) {
+	d := mustNewDoc(t)
+	calls := 0
+	veto := errors.New("not yet")
+	d.SetHooks(&Hooks{OnSave: func(*Document) error {
+		calls++
+		if calls > 1 {
+			return veto
+		}
+		return nil
+	}})
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.SaveBytes(); !errors.Is(err, veto) {
+		t.Errorf("SaveBytes err = %v", err)
+	}
+	if err := d.SaveEncrypted(&buf, "pw"); !errors.Is(err, veto) {
+		t.Errorf("SaveEncrypted err = %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package docx
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// ImageFit says how ReplaceImagePlaceholder sizes an image to the box of
+// the picture it replaces.
+type ImageFit int
+
+const (
+	// ImageFitContain scales the image to fit inside the box, keeping its
+	// aspect ratio; the picture shrinks to the scaled image.
+	ImageFitContain ImageFit = iota
+	// ImageFitCover scales the image to cover the box, keeping its aspect
+	// ratio, and crops what overflows equally on both sides.
+	ImageFitCover
+	// ImageFitStretch stretches the image to the box.
+	ImageFitStretch
+)
+
+// ImagePlaceholders returns the inline pictures of the body, headers and
+// footers standing for the image name: those whose alternative text or
+// title is name, and those inside content controls tagged name, such as
+// the picture content controls of Word's Developer tab.
+func (d *Document) ImagePlaceholders(name string) []*InlineShape {
+	var result []*InlineShape
+	d.eachStory([]StoryKind{StoryBody, StoryHeader, StoryFooter}, func(root *etree.Element, part *parts.StoryPart) bool {
+		for _, inline := range root.FindElements(".//wp:inline") {
+			if !findPicInGraphicData(inline) {
+				continue
+			}
+			is := newInlineShape(&oxml.CT_Inline{Element: oxml.WrapElement(inline)}, part)
+			if is.AltText() == name || is.Title() == name || placeholderControl(root, inline, name) != nil {
+				result = append(result, is)
+			}
+		}
+		return true
+	})
+	return result
+}
+
+// ReplaceImagePlaceholder swaps the image read from r into every picture
+// standing for the image name (see ImagePlaceholders), sized to the box
+// of each picture by fit. The pictures keep their alternative text and
+// other properties, and content controls holding them stop showing as
+// placeholders. It returns the number of pictures replaced.
+func (d *Document) ReplaceImagePlaceholder(name string, r io.ReadSeeker, fit ImageFit) (int, error) {
+	if fit < ImageFitContain || fit > ImageFitStretch {
+		return 0, fmt.Errorf("docx: invalid image fit %d", fit)
+	}
+	shapes := d.ImagePlaceholders(name)
+	for _, is := range shapes {
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return 0, fmt.Errorf("docx: image placeholder %q: %w", name, err)
+		}
+		if err := is.fitImage(r, fit); err != nil {
+			return 0, fmt.Errorf("docx: image placeholder %q: %w", name, err)
+		}
+		if part := is.part; part != nil {
+			if sdt := placeholderControl(part.Element(), is.inline.RawElement(), name); sdt != nil {
+				if pr := sdt.SelectElement("w:sdtPr"); pr != nil {
+					pr.RemoveChild(pr.SelectElement("w:showingPlcHdr"))
+				}
+			}
+		}
+	}
+	return len(shapes), nil
+}
+
+// fitImage replaces the picture with the image read from r and sizes it
+// to the current box of the shape by fit.
+func (is *InlineShape) fitImage(r io.ReadSeeker, fit ImageFit) error {
+	boxW, err := is.Width()
+	if err != nil {
+		return err
+	}
+	boxH, err := is.Height()
+	if err != nil {
+		return err
+	}
+	if err := is.ReplaceImage(r); err != nil {
+		return err
+	}
+	if err := is.SetCrop(Crop{}); err != nil {
+		return err
+	}
+	if fit == ImageFitStretch || boxW <= 0 || boxH <= 0 {
+		return nil
+	}
+	ip, err := is.ImagePart()
+	if err != nil {
+		return err
+	}
+	imgW, err := ip.NativeWidth()
+	if err != nil {
+		return err
+	}
+	imgH, err := ip.NativeHeight()
+	if err != nil {
+		return err
+	}
+	if imgW <= 0 || imgH <= 0 {
+		return fmt.Errorf("docx: image has no size")
+	}
+	imgAspect := float64(imgW) / float64(imgH)
+	boxAspect := float64(boxW) / float64(boxH)
+	switch {
+	case fit == ImageFitContain && imgAspect > boxAspect:
+		return is.Resize(boxW, Length(math.Round(float64(boxW)/imgAspect)))
+	case fit == ImageFitContain:
+		return is.Resize(Length(math.Round(float64(boxH)*imgAspect)), boxH)
+	case imgAspect > boxAspect:
+		side := (1 - boxAspect/imgAspect) / 2
+		return is.SetCrop(Crop{Left: side, Right: side})
+	default:
+		side := (1 - imgAspect/boxAspect) / 2
+		return is.SetCrop(Crop{Top: side, Bottom: side})
+	}
+}
+
+// placeholderControl returns the content control below root, tagged name,
+// that holds el, or nil.
+func placeholderControl(root, el *etree.Element, name string) *etree.Element {
+	for sdt := ancestorTag(root, el, "sdt"); sdt != nil; sdt = ancestorTag(root, sdt, "sdt") {
+		if (&ContentControl{sdt: sdt}).Tag() == name {
+			return sdt
+		}
+	}
+	return nil
+}
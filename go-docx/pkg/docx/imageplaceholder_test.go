@@ -0,0 +1,84 @@
+package docx
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestDocument_ReplaceImagePlaceholder(t *testing.T) {
+	doc := mustNewDoc(t)
+	side := int64(Inches(2))
+	addPicture := func() (*Paragraph, *Run, *InlineShape) {
+		t.Helper()
+		p, err := doc.AddParagraph("")
+		if err != nil {
+			t.Fatalf("AddParagraph: %v", err)
+		}
+		run, err := p.AddRun("")
+		if err != nil {
+			t.Fatalf("AddRun: %v", err)
+		}
+		is, err := run.AddPicture(bytes.NewReader(minimalPNG()), &side, &side)
+		if err != nil {
+			t.Fatalf("AddPicture: %v", err)
+		}
+		return p, run, is
+	}
+	_, _, photo := addPicture()
+	if err := photo.SetAltText("photo"); err != nil {
+		t.Fatalf("SetAltText: %v", err)
+	}
+	p, run, signature := addPicture()
+	sdt := p.p.RawElement().CreateElement("w:sdt")
+	pr := sdt.CreateElement("w:sdtPr")
+	pr.CreateElement("w:tag").CreateAttr("w:val", "signature")
+	pr.CreateElement("w:showingPlcHdr")
+	pr.CreateElement("w:picture")
+	p.p.RawElement().RemoveChild(run.r.RawElement())
+	sdt.CreateElement("w:sdtContent").AddChild(run.r.RawElement())
+	_, _, other := addPicture()
+
+	if got := len(doc.ImagePlaceholders("photo")); got != 1 {
+		t.Fatalf("ImagePlaceholders(photo) = %d, want 1", got)
+	}
+	wide := encodePNG(t, 4, 2)
+	n, err := doc.ReplaceImagePlaceholder("photo", bytes.NewReader(wide), ImageFitContain)
+	if err != nil || n != 1 {
+		t.Fatalf("ReplaceImagePlaceholder(photo) = %d, %v", n, err)
+	}
+	if w, _ := photo.Width(); w != Inches(2) {
+		t.Errorf("contain width = %d, want %d", w, Inches(2))
+	}
+	if h, _ := photo.Height(); h != Inches(1) {
+		t.Errorf("contain height = %d, want %d", h, Inches(1))
+	}
+	if photo.AltText() != "photo" {
+		t.Errorf("alt text = %q, want kept", photo.AltText())
+	}
+
+	n, err = doc.ReplaceImagePlaceholder("signature", bytes.NewReader(wide), ImageFitCover)
+	if err != nil || n != 1 {
+		t.Fatalf("ReplaceImagePlaceholder(signature) = %d, %v", n, err)
+	}
+	if w, _ := signature.Width(); w != Inches(2) {
+		t.Errorf("cover width = %d, want %d", w, Inches(2))
+	}
+	crop, err := signature.Crop()
+	if err != nil {
+		t.Fatalf("Crop: %v", err)
+	}
+	if math.Abs(crop.Left-0.25) > 1e-6 || math.Abs(crop.Right-0.25) > 1e-6 || crop.Top != 0 || crop.Bottom != 0 {
+		t.Errorf("cover crop = %+v, want 0.25 left and right", crop)
+	}
+	if pr.SelectElement("w:showingPlcHdr") != nil {
+		t.Error("content control still shows its placeholder")
+	}
+
+	if data, _ := other.ImageBytes(); !bytes.Equal(data, minimalPNG()) {
+		t.Error("untagged picture was replaced")
+	}
+	if _, err := doc.ReplaceImagePlaceholder("photo", bytes.NewReader(wide), ImageFit(7)); err == nil {
+		t.Error("expected error for invalid fit")
+	}
+}
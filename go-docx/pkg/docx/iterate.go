@@ -0,0 +1,296 @@
+package docx
+
+import (
+	"fmt"
+	"iter"
+	"regexp"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// StoryKind identifies a story of a document: its body, or the content of
+// its headers, footers, notes or comments.
+type StoryKind int
+
+const (
+	// StoryBody is the main document body.
+	StoryBody StoryKind = iota
+	// StoryHeader is the headers of all sections, each part once.
+	StoryHeader
+	// StoryFooter is the footers of all sections, each part once.
+	StoryFooter
+	// StoryFootnote is the footnotes, separators excluded.
+	StoryFootnote
+	// StoryEndnote is the endnotes, separators excluded.
+	StoryEndnote
+	// StoryComment is the comments.
+	StoryComment
+)
+
+// storyKindNames are the kind names, indexed by StoryKind.
+var storyKindNames = [...]string{"body", "header", "footer", "footnote", "endnote", "comment"}
+
+// String returns the name of the story kind, e.g. "header".
+func (k StoryKind) String() string {
+	if k < 0 || int(k) >= len(storyKindNames) {
+		return fmt.Sprintf("StoryKind(%d)", int(k))
+	}
+	return storyKindNames[k]
+}
+
+// ContentQuery selects the paragraphs AllParagraphs yields and the tables
+// AllTables yields. The zero value selects those of the body outside
+// tables, as Paragraphs and Tables do, and content controls are looked
+// into.
+type ContentQuery struct {
+	// Stories lists the stories to search, in order. Empty means the body
+	// only. Stories the document lacks are skipped; none are created.
+	Stories []StoryKind
+	// InTables descends into table cells, nested tables included.
+	InTables bool
+	// Style keeps the items whose style has this UI name or style ID. An
+	// item without a style has the default style of its type.
+	Style string
+	// Contains keeps the items whose text contains this string. The text of
+	// a table is that of its paragraphs, one per line.
+	Contains string
+	// Match keeps the items whose text matches this expression.
+	Match *regexp.Regexp
+}
+
+// AllParagraphs returns an iterator over the paragraphs q selects, in
+// document order, story by story. q may be nil. Paragraphs are found as
+// the iteration reaches them, so breaking out of a range loop early skips
+// the work for the rest of the document.
+func (d *Document) AllParagraphs(q *ContentQuery) iter.Seq[*Paragraph] {
+	return func(yield func(*Paragraph) bool) {
+		f := d.newContentFilter(q, enum.WdStyleTypeParagraph)
+		d.eachStory(f.q.Stories, func(el *etree.Element, part *parts.StoryPart) bool {
+			return scanBlocks(el, f.q.InTables, func(el *etree.Element) bool {
+				if el.Tag != "p" {
+					return true
+				}
+				p := &oxml.CT_P{Element: oxml.WrapElement(el)}
+				if !f.matchStyle(paragraphStyleID(el)) || !f.matchText(p.ParagraphText()) {
+					return true
+				}
+				return yield(newParagraph(p, part))
+			})
+		})
+	}
+}
+
+// AllTables returns an iterator over the tables q selects, in document
+// order, story by story. q may be nil. With q.InTables, a table is yielded
+// before the tables nested in it.
+func (d *Document) AllTables(q *ContentQuery) iter.Seq[*Table] {
+	return func(yield func(*Table) bool) {
+		f := d.newContentFilter(q, enum.WdStyleTypeTable)
+		d.eachStory(f.q.Stories, func(el *etree.Element, part *parts.StoryPart) bool {
+			return scanBlocks(el, f.q.InTables, func(el *etree.Element) bool {
+				if el.Tag != "tbl" || !f.matchStyle(tableStyleID(el)) {
+					return true
+				}
+				if (f.q.Contains != "" || f.q.Match != nil) && !f.matchText(blockText(el)) {
+					return true
+				}
+				return yield(newTable(&oxml.CT_Tbl{Element: oxml.WrapElement(el)}, part))
+			})
+		})
+	}
+}
+
+// contentFilter applies the filters of a ContentQuery.
+type contentFilter struct {
+	q *ContentQuery
+	// styleIDs holds the IDs of the styles q.Style names; nil when q has
+	// no style filter.
+	styleIDs map[string]bool
+	// defaultID is the ID of the default style of the item type.
+	defaultID string
+}
+
+// newContentFilter prepares the filters of q for items of styleType.
+func (d *Document) newContentFilter(q *ContentQuery, styleType enum.WdStyleType) *contentFilter {
+	if q == nil {
+		q = &ContentQuery{}
+	}
+	f := &contentFilter{q: q}
+	if q.Style == "" {
+		return f
+	}
+	f.styleIDs = map[string]bool{}
+	ss, err := d.part.Styles()
+	if err != nil {
+		return f
+	}
+	internal := UI2Internal(q.Style)
+	for _, st := range ss.StyleList() {
+		if nm, err := st.NameVal(); st.StyleId() == q.Style || err == nil && nm == internal {
+			f.styleIDs[st.StyleId()] = true
+		}
+	}
+	if def, err := ss.DefaultFor(styleType); err == nil && def != nil {
+		f.defaultID = def.StyleId()
+	}
+	return f
+}
+
+// matchStyle reports whether an item with style ID id passes the style
+// filter; "" means the item has no style.
+func (f *contentFilter) matchStyle(id string) bool {
+	if f.styleIDs == nil {
+		return true
+	}
+	if id == "" {
+		id = f.defaultID
+	}
+	return f.styleIDs[id]
+}
+
+// matchText reports whether text passes the text filters.
+func (f *contentFilter) matchText(text string) bool {
+	if f.q.Contains != "" && !strings.Contains(text, f.q.Contains) {
+		return false
+	}
+	return f.q.Match == nil || f.q.Match.MatchString(text)
+}
+
+// eachStory calls fn with the root element and part of each story of the
+// given kinds, the body alone when kinds is empty, until fn returns false.
+func (d *Document) eachStory(kinds []StoryKind, fn func(el *etree.Element, part *parts.StoryPart) bool) {
+	if len(kinds) == 0 {
+		kinds = []StoryKind{StoryBody}
+	}
+	for _, kind := range kinds {
+		if !d.eachStoryOf(kind, fn) {
+			return
+		}
+	}
+}
+
+// eachStoryOf is eachStory for a single kind; it returns false once fn
+// has.
+func (d *Document) eachStoryOf(kind StoryKind, fn func(el *etree.Element, part *parts.StoryPart) bool) bool {
+	switch kind {
+	case StoryBody:
+		if b, err := d.getBody(); err == nil {
+			return fn(b.element, b.part)
+		}
+	case StoryHeader, StoryFooter:
+		seen := map[*parts.StoryPart]bool{}
+		for _, sect := range d.Sections().Iter() {
+			hfs := []*baseHeaderFooter{
+				&sect.Header().baseHeaderFooter,
+				&sect.EvenPageHeader().baseHeaderFooter,
+				&sect.FirstPageHeader().baseHeaderFooter,
+			}
+			if kind == StoryFooter {
+				hfs = []*baseHeaderFooter{
+					&sect.Footer().baseHeaderFooter,
+					&sect.EvenPageFooter().baseHeaderFooter,
+					&sect.FirstPageFooter().baseHeaderFooter,
+				}
+			}
+			for _, hf := range hfs {
+				bic, err := hf.definedContainerDedup(seen)
+				if err == nil && bic != nil && !fn(bic.element, bic.part) {
+					return false
+				}
+			}
+		}
+	case StoryFootnote, StoryEndnote:
+		var sp *parts.StoryPart
+		if kind == StoryFootnote {
+			if fp := d.part.FootnotesPart(); fp != nil {
+				sp = &fp.StoryPart
+			}
+		} else if ep := d.part.EndnotesPart(); ep != nil {
+			sp = &ep.StoryPart
+		}
+		if sp == nil {
+			return true
+		}
+		for _, note := range sp.Element().ChildElements() {
+			if note.Space == "w" && (note.Tag == "footnote" || note.Tag == "endnote") &&
+				note.SelectAttrValue("w:type", "normal") == "normal" && !fn(note, sp) {
+				return false
+			}
+		}
+	case StoryComment:
+		if !d.part.HasCommentsPart() {
+			return true
+		}
+		comments, err := d.Comments()
+		if err != nil {
+			return true
+		}
+		for _, c := range comments.Iter() {
+			if !fn(c.element, c.part) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// scanBlocks calls visit for each paragraph and table among the block
+// content of el, in document order, looking into content controls, custom
+// XML and the read branch of mc:AlternateContent, and into table cells
+// when inTables is set. It returns false once visit has.
+func scanBlocks(el *etree.Element, inTables bool, visit func(el *etree.Element) bool) bool {
+	for _, child := range el.ChildElements() {
+		switch {
+		case child.Space == "w" && child.Tag == "p":
+			if !visit(child) {
+				return false
+			}
+		case child.Space == "w" && child.Tag == "tbl":
+			if !visit(child) || inTables && !scanBlocks(child, inTables, visit) {
+				return false
+			}
+		case child.Space == "w" && (child.Tag == "sdt" || child.Tag == "sdtContent" || child.Tag == "customXml" ||
+			child.Tag == "tr" || child.Tag == "tc"):
+			if !scanBlocks(child, inTables, visit) {
+				return false
+			}
+		case oxml.IsAlternateContent(child):
+			if branch := oxml.AlternateContentBranch(child); branch != nil && !scanBlocks(branch, inTables, visit) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// blockText returns the text of the paragraphs under el, one per line.
+func blockText(el *etree.Element) string {
+	var lines []string
+	scanBlocks(el, true, func(el *etree.Element) bool {
+		if el.Tag == "p" {
+			lines = append(lines, (&oxml.CT_P{Element: oxml.WrapElement(el)}).ParagraphText())
+		}
+		return true
+	})
+	return strings.Join(lines, "\n")
+}
+
+// paragraphStyleID returns the w:pStyle of paragraph p, or "".
+func paragraphStyleID(p *etree.Element) string {
+	if s := p.FindElement("w:pPr/w:pStyle"); s != nil {
+		return s.SelectAttrValue("w:val", "")
+	}
+	return ""
+}
+
+// tableStyleID returns the w:tblStyle of table tbl, or "".
+func tableStyleID(tbl *etree.Element) string {
+	if s := tbl.FindElement("w:tblPr/w:tblStyle"); s != nil {
+		return s.SelectAttrValue("w:val", "")
+	}
+	return ""
+}
@@ -0,0 +1,162 @@
+package docx
+
+import (
+	"bytes"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// paragraphTexts collects the texts of the paragraphs q selects.
+func paragraphTexts(d *Document, q *ContentQuery) []string {
+	var texts []string
+	for p := range d.AllParagraphs(q) {
+		texts = append(texts, p.Text())
+	}
+	return texts
+}
+
+func TestDocument_AllParagraphs(t *testing.T) {
+	doc := mustNewDoc(t)
+	for _, text := range []string{"intro", "Chapter one"} {
+		style := StyleName("Normal")
+		if text == "Chapter one" {
+			style = "Heading 1"
+		}
+		if _, err := doc.AddParagraph(text, style); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tbl, err := doc.AddTable(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cell, err := tbl.CellAt(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cell.SetText("in cell")
+	sect, err := doc.Sections().Get(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sect.Header().AddParagraph("page header"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := doc.AddParagraph("outro"); err != nil {
+		t.Fatal(err)
+	}
+
+	all := paragraphTexts(doc, nil)
+	paras := mustParagraphs(t, doc)
+	if len(all) != len(paras) || slices.Contains(all, "in cell") || slices.Contains(all, "page header") {
+		t.Errorf("AllParagraphs(nil) = %q, want the %d body paragraphs", all, len(paras))
+	}
+
+	tests := []struct {
+		name string
+		q    *ContentQuery
+		want []string
+	}{
+		{"tables", &ContentQuery{InTables: true, Contains: "o"}, []string{"intro", "Chapter one", "outro"}},
+		{"cells", &ContentQuery{InTables: true, Contains: "cell"}, []string{"in cell"}},
+		{"style name", &ContentQuery{Style: "Heading 1"}, []string{"Chapter one"}},
+		{"style id", &ContentQuery{Style: "Heading1"}, []string{"Chapter one"}},
+		{"match", &ContentQuery{Match: regexp.MustCompile(`^(in|out)`), InTables: true}, []string{"intro", "in cell", "outro"}},
+		{"headers", &ContentQuery{Stories: []StoryKind{StoryHeader}, Contains: "page"}, []string{"page header"}},
+		{"stories", &ContentQuery{Stories: []StoryKind{StoryHeader, StoryBody}, Contains: "r"},
+			[]string{"page header", "intro", "Chapter one", "outro"}},
+		{"footers", &ContentQuery{Stories: []StoryKind{StoryFooter, StoryComment}}, nil},
+	}
+	for _, tt := range tests {
+		if got := paragraphTexts(doc, tt.q); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	if doc.part.HasCommentsPart() {
+		t.Error("iterating comments created a comments part")
+	}
+
+	normal := paragraphTexts(doc, &ContentQuery{Style: "Normal", Contains: "tro"})
+	if !slices.Equal(normal, []string{"intro", "outro"}) {
+		t.Errorf("paragraphs without a style should match the default style: %q", normal)
+	}
+
+	// Breaking out early stops the iteration.
+	count := 0
+	for range doc.AllParagraphs(&ContentQuery{InTables: true}) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("count = %d", count)
+	}
+}
+
+func TestDocument_AllParagraphs_Notes(t *testing.T) {
+	doc := mustNewDoc(t)
+	pkg := doc.part.Package()
+	part := opc.NewBasePart("/word/footnotes.xml", opc.CTWmlFootnotes, []byte(`<w:footnotes `+wNS+`>`+
+		`<w:footnote w:type="separator" w:id="-1"><w:p><w:r><w:separator/></w:r></w:p></w:footnote>`+
+		`<w:footnote w:id="1"><w:p><w:r><w:t>a note</w:t></w:r></w:p></w:footnote></w:footnotes>`), pkg)
+	pkg.AddPart(part)
+	doc.part.Rels().GetOrAdd(opc.RTFootnotes, part)
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := paragraphTexts(doc2, &ContentQuery{Stories: []StoryKind{StoryFootnote, StoryEndnote}})
+	if !slices.Equal(got, []string{"a note"}) {
+		t.Errorf("footnote paragraphs = %q", got)
+	}
+}
+
+func TestDocument_AllTables(t *testing.T) {
+	doc := mustNewDoc(t)
+	outer, err := doc.AddTable(1, 1, StyleName("Table Grid"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cell, err := outer.CellAt(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner, err := cell.AddTable(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	innerCell, err := inner.CellAt(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	innerCell.SetText("deep")
+
+	count := func(q *ContentQuery) int {
+		n := 0
+		for range doc.AllTables(q) {
+			n++
+		}
+		return n
+	}
+	if n := count(nil); n != 1 {
+		t.Errorf("AllTables(nil) = %d tables, want 1", n)
+	}
+	if n := count(&ContentQuery{InTables: true}); n != 2 {
+		t.Errorf("InTables: %d tables, want 2", n)
+	}
+	if n := count(&ContentQuery{InTables: true, Style: "Table Grid"}); n != 1 {
+		t.Errorf("Style: %d tables, want 1", n)
+	}
+	if n := count(&ContentQuery{InTables: true, Contains: "deep"}); n != 2 {
+		t.Errorf("Contains: %d tables, want 2", n)
+	}
+	if StoryComment.String() != "comment" || StoryKind(42).String() != "StoryKind(42)" {
+		t.Errorf("String() = %q, %q", StoryComment, StoryKind(42))
+	}
+}
@@ -0,0 +1,127 @@
+package docx
+
+import (
+	"fmt"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// defaultShortParagraphLines is the default for
+// KeepRulesOptions.ShortParagraphLines.
+const defaultShortParagraphLines = 4
+
+// KeepRulesOptions configures ApplyKeepRules. The zero value applies every
+// rule.
+type KeepRulesOptions struct {
+	// ShortParagraphLines is the most lines a paragraph may take to be
+	// kept on one page. Zero means 4.
+	ShortParagraphLines int
+	// SkipHeadings leaves headings free to end a page.
+	SkipHeadings bool
+	// SkipShortParagraphs leaves short paragraphs free to split.
+	SkipShortParagraphs bool
+	// SkipTables leaves the paragraphs before tables free to end a page.
+	SkipTables bool
+	// Layout configures the line estimate of short paragraphs.
+	Layout *LayoutOptions
+}
+
+// ApplyKeepRules sets the pagination properties a careful editor would on
+// the paragraphs of the body outside tables, and returns the number of
+// paragraphs it changed:
+//
+//   - headings, paragraphs with an outline level, keep with the next
+//     paragraph, so no heading ends a page;
+//   - paragraphs of two lines up to KeepRulesOptions.ShortParagraphLines
+//     keep their lines together, counting lines as EstimateLayout does;
+//   - the paragraph before a table, usually its title or introduction,
+//     keeps with the table, so a table does not start at the bottom of a
+//     page away from it.
+//
+// A property already in effect through the paragraph style is not repeated
+// as direct formatting, and one turned off directly on a paragraph is left
+// off. A paragraph ending a section does not keep with what follows.
+func (d *Document) ApplyKeepRules(opts *KeepRulesOptions) (int, error) {
+	if opts == nil {
+		opts = &KeepRulesOptions{}
+	}
+	maxLines := opts.ShortParagraphLines
+	if maxLines <= 0 {
+		maxLines = defaultShortParagraphLines
+	}
+	b, err := d.getBody()
+	if err != nil {
+		return 0, err
+	}
+	styles, err := d.part.Styles()
+	if err != nil {
+		return 0, fmt.Errorf("docx: keep rules: %w", err)
+	}
+	lines := map[*etree.Element]int{}
+	if !opts.SkipShortParagraphs {
+		layout, err := d.EstimateLayout(opts.Layout)
+		if err != nil {
+			return 0, fmt.Errorf("docx: keep rules: %w", err)
+		}
+		for _, pl := range layout.Paragraphs {
+			lines[pl.Paragraph.p.RawElement()] = pl.Lines
+		}
+	}
+	fr := newFormatResolver(styles.RawElement(), "", "")
+
+	changed := map[*etree.Element]bool{}
+	keep := func(p *etree.Element, tag string) error {
+		set, err := keepOn(fr, p, tag)
+		if set {
+			changed[p] = true
+		}
+		return err
+	}
+	children := b.element.ChildElements()
+	for i, el := range children {
+		if el.Space != "w" || el.Tag != "p" {
+			continue
+		}
+		endsSection := el.FindElement("w:pPr/w:sectPr") != nil
+		if !opts.SkipHeadings && !endsSection && outlineLevel(styles.RawElement(), el) > 0 {
+			if err := keep(el, "keepNext"); err != nil {
+				return len(changed), err
+			}
+		}
+		if n := lines[el]; n >= 2 && n <= maxLines {
+			if err := keep(el, "keepLines"); err != nil {
+				return len(changed), err
+			}
+		}
+		if !opts.SkipTables && !endsSection && i+1 < len(children) {
+			if next := children[i+1]; next.Space == "w" && next.Tag == "tbl" {
+				if err := keep(el, "keepNext"); err != nil {
+					return len(changed), err
+				}
+			}
+		}
+	}
+	return len(changed), nil
+}
+
+// keepOn turns on keepNext or keepLines, given by tag, on paragraph p
+// unless its style already does or p turns it off directly, and reports
+// whether it changed p.
+func keepOn(fr *formatResolver, p *etree.Element, tag string) (bool, error) {
+	if p.FindElement("w:pPr/w:"+tag) != nil || chainOn(fr.pPrChain(p), "w:"+tag) {
+		return false, nil
+	}
+	pPr := (&oxml.CT_P{Element: oxml.WrapElement(p)}).GetOrAddPPr()
+	on := true
+	var err error
+	if tag == "keepNext" {
+		err = pPr.SetKeepNextVal(&on)
+	} else {
+		err = pPr.SetKeepLinesVal(&on)
+	}
+	if err != nil {
+		return false, fmt.Errorf("docx: keep rules: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,84 @@
+package docx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDocument_ApplyKeepRules(t *testing.T) {
+	d := letterDoc(t)
+	heading, err := d.AddHeading("Results", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outlined, err := d.AddParagraph("Outlined body text")
+	if err != nil {
+		t.Fatal(err)
+	}
+	outlined.p.GetOrAddPPr().RawElement().CreateElement("w:outlineLvl").CreateAttr("w:val", "1")
+	short, err := d.AddParagraph(strings.Repeat("short paragraph ", 15))
+	if err != nil {
+		t.Fatal(err)
+	}
+	long, err := d.AddParagraph(strings.Repeat("long paragraph ", 200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	intro, err := d.AddParagraph("Table 1: figures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.AddTable(2, 2); err != nil {
+		t.Fatal(err)
+	}
+	off := false
+	optedOut, err := d.AddParagraph("Table 2: no keep")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := optedOut.ParagraphFormat().SetKeepWithNext(&off); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.AddTable(1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := d.ApplyKeepRules(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The heading keeps with next through its style already.
+	if n != 3 {
+		t.Errorf("changed = %d, want 3", n)
+	}
+	on := func(v *bool) bool { return v != nil && *v }
+	if heading.ParagraphFormat().KeepWithNext() != nil {
+		t.Error("heading got direct keep-with-next its style already sets")
+	}
+	if !on(outlined.ParagraphFormat().KeepWithNext()) {
+		t.Error("outline-level paragraph does not keep with next")
+	}
+	if !on(short.ParagraphFormat().KeepTogether()) {
+		t.Error("short paragraph does not keep lines together")
+	}
+	if long.ParagraphFormat().KeepTogether() != nil {
+		t.Error("long paragraph keeps lines together")
+	}
+	if !on(intro.ParagraphFormat().KeepWithNext()) {
+		t.Error("paragraph before table does not keep with next")
+	}
+	if v := optedOut.ParagraphFormat().KeepWithNext(); v == nil || *v {
+		t.Errorf("explicit keep-with-next off overridden: %v", v)
+	}
+
+	if n, err := d.ApplyKeepRules(nil); err != nil || n != 0 {
+		t.Errorf("second pass changed %d, %v; want 0", n, err)
+	}
+	fresh := letterDoc(t)
+	if _, err := fresh.AddParagraph(strings.Repeat("short paragraph ", 15)); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := fresh.ApplyKeepRules(&KeepRulesOptions{SkipShortParagraphs: true}); err != nil || n != 0 {
+		t.Errorf("SkipShortParagraphs: changed %d, %v; want 0", n, err)
+	}
+}
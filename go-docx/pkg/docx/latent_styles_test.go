@@ -495,3 +495,30 @@ func TestLatentStyle_Delete(t *testing.T) {
 		t.Errorf("after delete: Len() = %d, want 0", ls.Len())
 	}
 }
+
+func TestLatentStyles_GetOrAdd(t *testing.T) {
+	ls := makeLatentStyles(t, `<w:lsdException w:name="heading 1"/>`)
+	if got := ls.GetOrAdd("Heading 1"); got.Name() != "Heading 1" || ls.Len() != 1 {
+		t.Errorf("existing: name %q, Len %d", got.Name(), ls.Len())
+	}
+	if got := ls.GetOrAdd("Quote"); got.Name() != "Quote" || ls.Len() != 2 {
+		t.Errorf("added: name %q, Len %d", got.Name(), ls.Len())
+	}
+}
+
+func TestLatentStyle_Effective(t *testing.T) {
+	ls := makeLatentStylesWithAttrs(t,
+		`w:defSemiHidden="1" w:defQFormat="0" w:defUIPriority="42"`,
+		`<w:lsdException w:name="Foo"/><w:lsdException w:name="Bar" w:semiHidden="0" w:qFormat="1" w:uiPriority="7"/>`)
+	items := ls.Iter()
+	foo, bar := items[0], items[1]
+	if !foo.EffectiveHidden() || foo.EffectiveQuickStyle() || foo.EffectivePriority() != 42 {
+		t.Errorf("Foo: hidden %v, quick %v, priority %d", foo.EffectiveHidden(), foo.EffectiveQuickStyle(), foo.EffectivePriority())
+	}
+	if bar.EffectiveHidden() || !bar.EffectiveQuickStyle() || bar.EffectivePriority() != 7 {
+		t.Errorf("Bar: hidden %v, quick %v, priority %d", bar.EffectiveHidden(), bar.EffectiveQuickStyle(), bar.EffectivePriority())
+	}
+	if p := makeLatentStyles(t, `<w:lsdException w:name="Baz"/>`).Iter()[0].EffectivePriority(); p != 99 {
+		t.Errorf("default priority = %d, want 99", p)
+	}
+}
@@ -0,0 +1,849 @@
+package docx
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// LayoutOptions configures EstimateLayout.
+type LayoutOptions struct {
+	// Fonts adds metrics for fonts without built-in tables, or replaces
+	// the built-in ones, by font name.
+	Fonts map[string]*FontMetrics
+}
+
+// Layout is an estimate of how a document paginates.
+type Layout struct {
+	// Pages is the number of pages.
+	Pages int
+	// Paragraphs are the paragraphs of the body, including those in
+	// tables, in document order.
+	Paragraphs []*ParagraphLayout
+}
+
+// ParagraphLayout is the estimated position of one paragraph.
+type ParagraphLayout struct {
+	Paragraph *Paragraph
+	// Page is the page the paragraph starts on, counting from 1.
+	Page int
+	// LastPage is the page the paragraph ends on.
+	LastPage int
+	// Lines is the number of lines the paragraph takes.
+	Lines int
+}
+
+// PageOf returns the page paragraph p starts on, or 0 if p is not part of
+// the body.
+func (l *Layout) PageOf(p *Paragraph) int {
+	for _, pl := range l.Paragraphs {
+		if pl.Paragraph.p.RawElement() == p.p.RawElement() {
+			return pl.Page
+		}
+	}
+	return 0
+}
+
+// EstimateLayout estimates where the pages of the document break, without
+// a rendering engine: it wraps the text of each paragraph using font
+// metrics (built in for common fonts, see LayoutOptions.Fonts for others)
+// and stacks the lines on pages of the size and margins of each section,
+// honoring line and paragraph spacing, indentation, explicit page and
+// column breaks, page-break-before, keep-with-next, keep-lines-together,
+// widow control, table rows and section starts.
+//
+// The result approximates Word's: it does not kern or hyphenate, ignores
+// floating objects, footnotes and headers and footers taller than the top
+// margin, and counts physical pages regardless of page numbering.
+func (d *Document) EstimateLayout(opts *LayoutOptions) (*Layout, error) {
+	if opts == nil {
+		opts = &LayoutOptions{}
+	}
+	b, err := d.getBody()
+	if err != nil {
+		return nil, err
+	}
+	styles, err := d.part.Styles()
+	if err != nil {
+		return nil, fmt.Errorf("docx: layout: %w", err)
+	}
+	major, minor := d.themeFonts()
+	lo := &layouter{
+		d:      d,
+		res:    newFormatResolver(styles.RawElement(), major, minor),
+		fonts:  opts.Fonts,
+		result: &Layout{},
+		page:   1,
+	}
+	lo.run(b.element)
+	lo.result.Pages = lo.page
+	return lo.result, nil
+}
+
+// themeFonts returns the Latin major (headings) and minor (body) fonts of
+// the document theme, or "" when there is no theme.
+func (d *Document) themeFonts() (major, minor string) {
+	return themeFonts(d.part)
+}
+
+// themeFonts returns the Latin major and minor fonts of the theme of the
+// document part dp.
+func themeFonts(dp *parts.DocumentPart) (major, minor string) {
+	rel, err := dp.Rels().GetByRelType(opc.RTTheme)
+	if err != nil || rel.TargetPart == nil {
+		return "", ""
+	}
+	var root *etree.Element
+	if xp, ok := rel.TargetPart.(interface{ ReadElement() *etree.Element }); ok {
+		root = xp.ReadElement()
+	} else if blob, err := rel.TargetPart.Blob(); err == nil {
+		root, _ = oxml.ParseXml(blob)
+	}
+	if root == nil {
+		return "", ""
+	}
+	for _, el := range root.FindElements(".//majorFont/latin") {
+		major = el.SelectAttrValue("typeface", "")
+	}
+	for _, el := range root.FindElements(".//minorFont/latin") {
+		minor = el.SelectAttrValue("typeface", "")
+	}
+	return major, minor
+}
+
+// --------------------------------------------------------------------------
+// Formatting resolution
+// --------------------------------------------------------------------------
+
+// formatResolver looks up effective paragraph and run properties through
+// direct formatting, character and paragraph styles and document defaults.
+type formatResolver struct {
+	styles               *etree.Element
+	majorFont, minorFont string
+}
+
+func newFormatResolver(styles *etree.Element, major, minor string) *formatResolver {
+	return &formatResolver{styles: styles, majorFont: major, minorFont: minor}
+}
+
+// pPrChain returns the paragraph property elements that apply to p,
+// nearest first.
+func (fr *formatResolver) pPrChain(p *etree.Element) []*etree.Element {
+	var chain []*etree.Element
+	if pPr := p.SelectElement("w:pPr"); pPr != nil {
+		chain = append(chain, pPr)
+	}
+	for _, style := range paragraphStyleChain(fr.styles, p) {
+		if pPr := style.SelectElement("w:pPr"); pPr != nil {
+			chain = append(chain, pPr)
+		}
+	}
+	if fr.styles != nil {
+		if pPr := fr.styles.FindElement("w:docDefaults/w:pPrDefault/w:pPr"); pPr != nil {
+			chain = append(chain, pPr)
+		}
+	}
+	return chain
+}
+
+// rPrChain returns the run property elements that apply to run r of
+// paragraph p, nearest first; r may be nil for the paragraph mark.
+func (fr *formatResolver) rPrChain(p, r *etree.Element) []*etree.Element {
+	var chain []*etree.Element
+	if r != nil {
+		if rPr := r.SelectElement("w:rPr"); rPr != nil {
+			chain = append(chain, rPr)
+			if el := rPr.SelectElement("w:rStyle"); el != nil {
+				style := findStyle(fr.styles, "character", el.SelectAttrValue("w:val", ""))
+				for depth := 0; style != nil && depth < 16; depth++ {
+					if rPr := style.SelectElement("w:rPr"); rPr != nil {
+						chain = append(chain, rPr)
+					}
+					based := style.SelectElement("w:basedOn")
+					if based == nil {
+						break
+					}
+					style = findStyle(fr.styles, "character", based.SelectAttrValue("w:val", ""))
+				}
+			}
+		}
+	} else if rPr := p.FindElement("w:pPr/w:rPr"); rPr != nil {
+		chain = append(chain, rPr)
+	}
+	for _, style := range paragraphStyleChain(fr.styles, p) {
+		if rPr := style.SelectElement("w:rPr"); rPr != nil {
+			chain = append(chain, rPr)
+		}
+	}
+	if fr.styles != nil {
+		if rPr := fr.styles.FindElement("w:docDefaults/w:rPrDefault/w:rPr"); rPr != nil {
+			chain = append(chain, rPr)
+		}
+	}
+	return chain
+}
+
+// chainAttr returns the first value of attribute key of child tag in chain.
+func chainAttr(chain []*etree.Element, tag, key string) (string, bool) {
+	for _, pr := range chain {
+		if el := pr.SelectElement(tag); el != nil {
+			if a := el.SelectAttr(key); a != nil {
+				return a.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// chainOn returns the first setting of on/off property tag in chain.
+func chainOn(chain []*etree.Element, tag string) bool {
+	for _, pr := range chain {
+		if el := pr.SelectElement(tag); el != nil {
+			v := el.SelectAttrValue("w:val", "1")
+			return v != "0" && v != "false" && v != "off"
+		}
+	}
+	return false
+}
+
+// chainTwips returns the first value of a twips attribute in chain, or
+// def.
+func chainTwips(chain []*etree.Element, tag, key string, def float64) float64 {
+	if v, ok := chainAttr(chain, tag, key); ok {
+		if n, err := strconv.ParseFloat(v, 64); err == nil {
+			return n
+		}
+	}
+	return def
+}
+
+// runFont returns the metrics and size in points of the text of run r.
+func (fr *formatResolver) runFont(p, r *etree.Element, fonts map[string]*FontMetrics) (*FontMetrics, float64, bool) {
+	chain := fr.rPrChain(p, r)
+	size := chainTwips(chain, "w:sz", "w:val", 20) / 2
+	name := "Times New Roman"
+	for _, pr := range chain {
+		el := pr.SelectElement("w:rFonts")
+		if el == nil {
+			continue
+		}
+		// A theme font takes precedence over the font named beside it.
+		if v := el.SelectAttrValue("w:asciiTheme", ""); v != "" {
+			font := fr.minorFont
+			if strings.HasPrefix(v, "major") {
+				font = fr.majorFont
+			}
+			if font != "" {
+				name = font
+				break
+			}
+		}
+		if v := el.SelectAttrValue("w:ascii", ""); v != "" {
+			name = v
+			break
+		}
+	}
+	return lookupFontMetrics(name, fonts), size, chainOn(chain, "w:b")
+}
+
+// --------------------------------------------------------------------------
+// Line breaking
+// --------------------------------------------------------------------------
+
+// glyph is a unit of paragraph content: a character, a tab or an inline
+// picture. Sizes are in twips.
+type glyph struct {
+	width, height float64
+	space         bool   // a break opportunity, not counted at line end
+	brk           string // "line", "page" or "column" for breaks
+}
+
+// lineBox is a laid-out line of a paragraph.
+type lineBox struct {
+	height float64
+	brk    string // "page" or "column" when a break ends the line
+}
+
+// defaultTabStop is Word's default tab stop interval, in twips.
+const defaultTabStop = 720
+
+// paragraphGlyphs returns the content of paragraph p as glyphs, and the
+// line height of its paragraph mark.
+func (lo *layouter) paragraphGlyphs(p *etree.Element) ([]glyph, float64) {
+	var glyphs []glyph
+	var walk func(el *etree.Element)
+	walk = func(el *etree.Element) {
+		for _, child := range el.ChildElements() {
+			switch {
+			case child.Space == "mc" && child.Tag == "Fallback",
+				child.Space == "w" && (child.Tag == "del" || child.Tag == "moveFrom" || child.Tag == "pPr"):
+			case child.Space == "w" && child.Tag == "r":
+				glyphs = lo.runGlyphs(p, child, glyphs)
+			default:
+				walk(child)
+			}
+		}
+	}
+	walk(p)
+	m, size, _ := lo.res.runFont(p, nil, lo.fonts)
+	return glyphs, float64(m.LineHeight) * size * 20 / 1000
+}
+
+// runGlyphs appends the glyphs of run r of paragraph p.
+func (lo *layouter) runGlyphs(p, r *etree.Element, glyphs []glyph) []glyph {
+	chain := lo.res.rPrChain(p, r)
+	if chainOn(chain, "w:vanish") {
+		return glyphs
+	}
+	m, size, bold := lo.res.runFont(p, r, lo.fonts)
+	scale := size * 20 / 1000 // thousandths of an em to twips
+	if bold {
+		scale *= 1.06
+	}
+	height := float64(m.LineHeight) * size * 20 / 1000
+	caps := chainOn(chain, "w:caps")
+	for _, child := range r.ChildElements() {
+		if child.Space != "w" {
+			continue
+		}
+		switch child.Tag {
+		case "t":
+			text := child.Text()
+			if caps {
+				text = strings.ToUpper(text)
+			}
+			for _, ch := range text {
+				glyphs = append(glyphs, glyph{width: float64(m.CharWidth(ch)) * scale, height: height, space: ch == ' '})
+			}
+		case "noBreakHyphen":
+			glyphs = append(glyphs, glyph{width: float64(m.CharWidth('-')) * scale, height: height})
+		case "sym":
+			glyphs = append(glyphs, glyph{width: float64(m.CharWidth('n')) * scale, height: height})
+		case "tab", "ptab":
+			glyphs = append(glyphs, glyph{height: height, brk: "tab"})
+		case "cr":
+			glyphs = append(glyphs, glyph{height: height, brk: "line"})
+		case "br":
+			switch child.SelectAttrValue("w:type", "textWrapping") {
+			case "page":
+				glyphs = append(glyphs, glyph{height: height, brk: "page"})
+			case "column":
+				glyphs = append(glyphs, glyph{height: height, brk: "column"})
+			default:
+				glyphs = append(glyphs, glyph{height: height, brk: "line"})
+			}
+		case "drawing":
+			if ext := child.FindElement("wp:inline/wp:extent"); ext != nil {
+				cx, _ := strconv.ParseFloat(ext.SelectAttrValue("cx", "0"), 64)
+				cy, _ := strconv.ParseFloat(ext.SelectAttrValue("cy", "0"), 64)
+				glyphs = append(glyphs, glyph{width: cx / EmusPerTwip, height: cy / EmusPerTwip})
+			}
+		}
+	}
+	return glyphs
+}
+
+// breakLines wraps glyphs into lines of the given widths: first for the
+// first line, rest for the others. A break glyph ends its line.
+func breakLines(glyphs []glyph, first, rest float64) [][]glyph {
+	var lines [][]glyph
+	var line []glyph
+	x, avail := 0.0, first
+	flush := func() {
+		lines = append(lines, line)
+		line, x, avail = nil, 0, rest
+	}
+	for i := 0; i < len(glyphs); {
+		g := glyphs[i]
+		switch {
+		case g.brk == "tab":
+			g.width = defaultTabStop - math.Mod(x, defaultTabStop)
+			if x+g.width > avail && len(line) > 0 {
+				flush()
+				g.width = defaultTabStop
+			}
+			line, x = append(line, g), x+g.width
+			i++
+			continue
+		case g.brk != "":
+			line = append(line, g)
+			flush()
+			i++
+			continue
+		case g.space:
+			line, x = append(line, g), x+g.width
+			i++
+			continue
+		}
+		// A word: the glyphs up to the next space or break.
+		j, w := i, 0.0
+		for j < len(glyphs) && !glyphs[j].space && glyphs[j].brk == "" {
+			w += glyphs[j].width
+			j++
+		}
+		if x+w > avail && len(line) > 0 {
+			flush()
+		}
+		if w > avail {
+			// Too long for any line: break between characters.
+			for ; i < j; i++ {
+				if x+glyphs[i].width > avail && len(line) > 0 {
+					flush()
+				}
+				line, x = append(line, glyphs[i]), x+glyphs[i].width
+			}
+			continue
+		}
+		line, x = append(line, glyphs[i:j]...), x+w
+		i = j
+	}
+	lines = append(lines, line)
+	return lines
+}
+
+// --------------------------------------------------------------------------
+// Pagination
+// --------------------------------------------------------------------------
+
+// pageGeometry is the text area of a section, in twips.
+type pageGeometry struct {
+	width, height float64 // of a column
+	columns       int
+}
+
+// sectionGeometry returns the text area of sectPr, with Word's defaults
+// (Letter, one-inch margins) for what it leaves out.
+func sectionGeometry(sectPr *etree.Element) pageGeometry {
+	twips := func(path, key string, def float64) float64 {
+		if sectPr == nil {
+			return def
+		}
+		if el := sectPr.FindElement(path); el != nil {
+			if n, err := strconv.ParseFloat(el.SelectAttrValue(key, ""), 64); err == nil {
+				return math.Abs(n)
+			}
+		}
+		return def
+	}
+	width := twips("w:pgSz", "w:w", 12240) - twips("w:pgMar", "w:left", 1440) -
+		twips("w:pgMar", "w:right", 1440) - twips("w:pgMar", "w:gutter", 0)
+	height := twips("w:pgSz", "w:h", 15840) - twips("w:pgMar", "w:top", 1440) - twips("w:pgMar", "w:bottom", 1440)
+	cols := int(twips("w:cols", "w:num", 1))
+	if cols < 1 {
+		cols = 1
+	}
+	space := twips("w:cols", "w:space", 720)
+	colWidth := (width - space*float64(cols-1)) / float64(cols)
+	if colWidth < 720 {
+		colWidth = 720
+	}
+	if height < 720 {
+		height = 720
+	}
+	return pageGeometry{width: colWidth, height: height, columns: cols}
+}
+
+// layouter stacks the blocks of the body on pages.
+type layouter struct {
+	d      *Document
+	res    *formatResolver
+	fonts  map[string]*FontMetrics
+	result *Layout
+
+	geo    pageGeometry
+	page   int     // current page, from 1
+	column int     // current column, from 0
+	used   float64 // height used in the current column
+}
+
+// block is a body paragraph or table with the section properties that
+// govern it.
+type block struct {
+	el     *etree.Element
+	sectPr *etree.Element
+}
+
+// run lays out the body.
+func (lo *layouter) run(body *etree.Element) {
+	var blocks, pending []block
+	var collect func(el *etree.Element)
+	collect = func(el *etree.Element) {
+		for _, child := range el.ChildElements() {
+			switch {
+			case child.Space != "w":
+				if child.Space == "mc" && child.Tag == "AlternateContent" {
+					if choice := child.SelectElement("mc:Choice"); choice != nil {
+						collect(choice)
+					}
+				}
+			case child.Tag == "p":
+				pending = append(pending, block{el: child})
+				if sectPr := child.FindElement("w:pPr/w:sectPr"); sectPr != nil {
+					for _, b := range pending {
+						b.sectPr = sectPr
+						blocks = append(blocks, b)
+					}
+					pending = nil
+				}
+			case child.Tag == "tbl":
+				pending = append(pending, block{el: child})
+			case child.Tag == "sdt":
+				if content := child.SelectElement("w:sdtContent"); content != nil {
+					collect(content)
+				}
+			case child.Tag == "customXml", child.Tag == "ins", child.Tag == "moveTo":
+				collect(child)
+			}
+		}
+	}
+	collect(body)
+	final := body.SelectElement("w:sectPr")
+	for _, b := range pending {
+		b.sectPr = final
+		blocks = append(blocks, b)
+	}
+
+	var current *etree.Element
+	for i, b := range blocks {
+		if i == 0 || b.sectPr != current {
+			lo.startSection(b.sectPr, i == 0)
+			current = b.sectPr
+		}
+		var next *etree.Element
+		if i+1 < len(blocks) && blocks[i+1].sectPr == current {
+			next = blocks[i+1].el
+		}
+		if b.el.Tag == "tbl" {
+			lo.placeTable(b.el)
+		} else {
+			lo.placeParagraph(b.el, next)
+		}
+	}
+}
+
+// startSection applies the geometry and start type of a section.
+func (lo *layouter) startSection(sectPr *etree.Element, first bool) {
+	lo.geo = sectionGeometry(sectPr)
+	if first {
+		return
+	}
+	start := "nextPage"
+	if sectPr != nil {
+		if el := sectPr.SelectElement("w:type"); el != nil {
+			start = el.SelectAttrValue("w:val", "nextPage")
+		}
+	}
+	switch start {
+	case "continuous":
+		if lo.column >= lo.geo.columns {
+			lo.newPage()
+		}
+	case "nextColumn":
+		lo.newColumn()
+	case "evenPage":
+		lo.newPage()
+		if lo.page%2 == 1 {
+			lo.page++
+		}
+	case "oddPage":
+		lo.newPage()
+		if lo.page%2 == 0 {
+			lo.page++
+		}
+	default:
+		lo.newPage()
+	}
+}
+
+func (lo *layouter) newPage() {
+	lo.page++
+	lo.column, lo.used = 0, 0
+}
+
+func (lo *layouter) newColumn() {
+	lo.column++
+	lo.used = 0
+	if lo.column >= lo.geo.columns {
+		lo.newPage()
+	}
+}
+
+// paragraphMetrics is the measured form of a paragraph.
+type paragraphMetrics struct {
+	lines                     []lineBox
+	before, after             float64
+	keepLines, keepNext       bool
+	widowControl, breakBefore bool
+}
+
+// measureParagraph wraps paragraph p to width.
+func (lo *layouter) measureParagraph(p *etree.Element, width float64) paragraphMetrics {
+	chain := lo.res.pPrChain(p)
+	left := chainTwips(chain, "w:ind", "w:left", chainTwips(chain, "w:ind", "w:start", 0))
+	right := chainTwips(chain, "w:ind", "w:right", chainTwips(chain, "w:ind", "w:end", 0))
+	firstLine := chainTwips(chain, "w:ind", "w:firstLine", 0) - chainTwips(chain, "w:ind", "w:hanging", 0)
+	avail := math.Max(width-left-right, 360)
+
+	glyphs, markHeight := lo.paragraphGlyphs(p)
+	rawLines := breakLines(glyphs, math.Max(avail-firstLine, 360), avail)
+
+	rule, _ := chainAttr(chain, "w:spacing", "w:lineRule")
+	line := chainTwips(chain, "w:spacing", "w:line", 240)
+	m := paragraphMetrics{
+		before:       chainTwips(chain, "w:spacing", "w:before", 0),
+		after:        chainTwips(chain, "w:spacing", "w:after", 0),
+		keepLines:    chainOn(chain, "w:keepLines"),
+		keepNext:     chainOn(chain, "w:keepNext"),
+		widowControl: chainOn(chain, "w:widowControl"),
+		breakBefore:  chainOn(chain, "w:pageBreakBefore"),
+	}
+	for _, glyphs := range rawLines {
+		natural := 0.0
+		brk := ""
+		for _, g := range glyphs {
+			natural = math.Max(natural, g.height)
+			if g.brk == "page" || g.brk == "column" {
+				brk = g.brk
+			}
+		}
+		if natural == 0 {
+			natural = markHeight
+		}
+		h := natural * line / 240
+		switch rule {
+		case "exact":
+			h = line
+		case "atLeast":
+			h = math.Max(line, natural)
+		}
+		m.lines = append(m.lines, lineBox{height: h, brk: brk})
+	}
+	return m
+}
+
+// height returns the total height of a measured paragraph.
+func (m paragraphMetrics) height() float64 {
+	h := m.before + m.after
+	for _, l := range m.lines {
+		h += l.height
+	}
+	return h
+}
+
+// placeParagraph places paragraph p, followed by next (nil at the end of
+// a section), on the pages.
+func (lo *layouter) placeParagraph(p, next *etree.Element) {
+	m := lo.measureParagraph(p, lo.geo.width)
+	if m.breakBefore && (lo.used > 0 || lo.column > 0) {
+		lo.newPage()
+	}
+	if lo.used > 0 {
+		lo.used += m.before
+	}
+	linesHeight := 0.0
+	for _, l := range m.lines {
+		linesHeight += l.height
+	}
+	needed := linesHeight
+	if m.keepNext && next != nil && next.Tag == "p" {
+		nm := lo.measureParagraph(next, lo.geo.width)
+		needed += m.after + nm.before + nm.lines[0].height
+	}
+	if (m.keepLines || m.keepNext) && lo.used > 0 && lo.used+needed > lo.geo.height && needed <= lo.geo.height {
+		lo.newColumn()
+	}
+
+	pl := &ParagraphLayout{
+		Paragraph: newParagraph(&oxml.CT_P{Element: oxml.WrapElement(p)}, &lo.d.part.StoryPart),
+		Page:      lo.page,
+		Lines:     len(m.lines),
+	}
+	lo.result.Paragraphs = append(lo.result.Paragraphs, pl)
+	for i := 0; i < len(m.lines); {
+		// Count the lines that fit in the current column.
+		k, h := 0, lo.used
+		for i+k < len(m.lines) && (h+m.lines[i+k].height <= lo.geo.height || h == 0) {
+			h += m.lines[i+k].height
+			k++
+			if m.lines[i+k-1].brk != "" {
+				break
+			}
+		}
+		rest := len(m.lines) - i - k
+		if m.widowControl && k > 0 && rest > 0 && m.lines[i+k-1].brk == "" {
+			switch {
+			case i == 0 && k == 1 && lo.used > 0:
+				k = 0 // orphan: move the first line along
+			case rest == 1 && k >= 2:
+				k-- // widow: take a line along to the next page
+			}
+		}
+		if i == 0 && k == 0 {
+			lo.newColumn()
+			pl.Page = lo.page
+			continue
+		}
+		for _, l := range m.lines[i : i+k] {
+			lo.used += l.height
+		}
+		i += k
+		if last := m.lines[i-1]; last.brk == "page" {
+			lo.newPage()
+		} else if last.brk == "column" || i < len(m.lines) {
+			lo.newColumn()
+		}
+	}
+	pl.LastPage = lo.page
+	lo.used += m.after
+}
+
+// placeTable places table tbl row by row. Rows move to the next page as a
+// whole when they do not fit, and header rows repeat on each page.
+func (lo *layouter) placeTable(tbl *etree.Element) {
+	widths := lo.columnWidths(tbl, lo.geo.width)
+	header := 0.0
+	inHeader := true
+	for _, tr := range tbl.SelectElements("w:tr") {
+		h, paras := lo.measureRow(tr, widths)
+		isHeader := inHeader && (&oxml.CT_Row{Element: oxml.WrapElement(tr)}).TblHeaderVal()
+		if !isHeader {
+			inHeader = false
+		}
+		if lo.used > 0 && lo.used+h > lo.geo.height {
+			lo.newColumn()
+			if !inHeader {
+				lo.used += header
+			}
+		}
+		if isHeader {
+			header += h
+		}
+		start := lo.page
+		lo.used += h
+		for lo.used > lo.geo.height {
+			over := lo.used - lo.geo.height
+			lo.newColumn()
+			lo.used = over
+		}
+		for _, p := range paras {
+			pl := &ParagraphLayout{
+				Paragraph: newParagraph(&oxml.CT_P{Element: oxml.WrapElement(p.el)}, &lo.d.part.StoryPart),
+				Page:      start,
+				LastPage:  lo.page,
+				Lines:     p.lines,
+			}
+			lo.result.Paragraphs = append(lo.result.Paragraphs, pl)
+		}
+	}
+}
+
+// cellParagraph is a paragraph measured in a table cell.
+type cellParagraph struct {
+	el    *etree.Element
+	lines int
+}
+
+// cellMargin is Word's default left and right cell margin, in twips.
+const cellMargin = 108
+
+// measureRow returns the height of row tr and its paragraphs.
+func (lo *layouter) measureRow(tr *etree.Element, widths []float64) (float64, []cellParagraph) {
+	var paras []cellParagraph
+	height, col := 0.0, 0
+	for _, tc := range tr.SelectElements("w:tc") {
+		span := 1
+		if el := tc.FindElement("w:tcPr/w:gridSpan"); el != nil {
+			span = attrInt(el, "w:val", 1)
+		}
+		w := 0.0
+		for k := col; k < col+span && k < len(widths); k++ {
+			w += widths[k]
+		}
+		col += span
+		h, ps := lo.measureBlocks(tc, math.Max(w-2*cellMargin, 360))
+		paras = append(paras, ps...)
+		if tc.FindElement("w:tcPr/w:vMerge") != nil && tc.FindElement("w:tcPr/w:vMerge").SelectAttrValue("w:val", "continue") == "continue" {
+			continue // continuation of a merged cell: its content is above
+		}
+		height = math.Max(height, h)
+	}
+	if el := tr.FindElement("w:trPr/w:trHeight"); el != nil {
+		v := float64(attrInt(el, "w:val", 0))
+		if el.SelectAttrValue("w:hRule", "atLeast") == "exact" {
+			height = v
+		} else {
+			height = math.Max(height, v)
+		}
+	}
+	return height, paras
+}
+
+// measureBlocks returns the height of the paragraphs and tables in
+// container, laid out at width without page breaks.
+func (lo *layouter) measureBlocks(container *etree.Element, width float64) (float64, []cellParagraph) {
+	var paras []cellParagraph
+	height := 0.0
+	for _, child := range container.ChildElements() {
+		if child.Space != "w" {
+			continue
+		}
+		switch child.Tag {
+		case "p":
+			m := lo.measureParagraph(child, width)
+			height += m.height()
+			paras = append(paras, cellParagraph{el: child, lines: len(m.lines)})
+		case "tbl":
+			widths := lo.columnWidths(child, width)
+			for _, tr := range child.SelectElements("w:tr") {
+				h, ps := lo.measureRow(tr, widths)
+				height += h
+				paras = append(paras, ps...)
+			}
+		case "sdt":
+			if content := child.SelectElement("w:sdtContent"); content != nil {
+				h, ps := lo.measureBlocks(content, width)
+				height += h
+				paras = append(paras, ps...)
+			}
+		}
+	}
+	return height, paras
+}
+
+// columnWidths returns the widths of the grid columns of tbl, scaled down
+// to fit width when they are wider.
+func (lo *layouter) columnWidths(tbl *etree.Element, width float64) []float64 {
+	var widths []float64
+	total := 0.0
+	for _, gc := range tbl.FindElements("w:tblGrid/w:gridCol") {
+		w := float64(attrInt(gc, "w:w", 0))
+		widths = append(widths, w)
+		total += w
+	}
+	if total == 0 {
+		n := 0
+		if tr := tbl.SelectElement("w:tr"); tr != nil {
+			n = len(tr.SelectElements("w:tc"))
+		}
+		if n == 0 {
+			n = 1
+		}
+		widths = make([]float64, n)
+		for i := range widths {
+			widths[i] = width / float64(n)
+		}
+		return widths
+	}
+	if total > width {
+		for i := range widths {
+			widths[i] *= width / total
+		}
+	}
+	return widths
+}
@@ -0,0 +1,158 @@
+package docx
+
+import (
+	"strings"
+	"testing"
+)
+
+// addExactParagraph appends a paragraph of text with exactly 720-twip
+// lines and no paragraph spacing, so 18 lines fill a Letter page with
+// one-inch margins.
+func addExactParagraph(t *testing.T, d *Document, text string) *Paragraph {
+	t.Helper()
+	p, err := d.AddParagraph(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sp := p.p.GetOrAddPPr().RawElement().CreateElement("w:spacing")
+	sp.CreateAttr("w:before", "0")
+	sp.CreateAttr("w:after", "0")
+	sp.CreateAttr("w:line", "720")
+	sp.CreateAttr("w:lineRule", "exact")
+	return p
+}
+
+func letterDoc(t *testing.T) *Document {
+	t.Helper()
+	d := mustNewDoc(t)
+	s := d.Sections().Iter()[0]
+	if err := s.SetPageSize(PageSizeLetter, 0); err != nil {
+		t.Fatal(err)
+	}
+	for _, set := range []func(*Length) error{s.SetTopMarginLength, s.SetBottomMarginLength, s.SetLeftMarginLength, s.SetRightMarginLength} {
+		m := Inches(1)
+		if err := set(&m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return d
+}
+
+func TestEstimateLayout_Pages(t *testing.T) {
+	d := letterDoc(t)
+	var paras []*Paragraph
+	for i := 0; i < 40; i++ {
+		paras = append(paras, addExactParagraph(t, d, "line"))
+	}
+	l, err := d.EstimateLayout(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Pages != 3 {
+		t.Errorf("Pages = %d, want 3", l.Pages)
+	}
+	for i, want := range map[int]int{0: 1, 17: 1, 18: 2, 35: 2, 36: 3} {
+		if got := l.PageOf(paras[i]); got != want {
+			t.Errorf("paragraph %d on page %d, want %d", i, got, want)
+		}
+	}
+}
+
+func TestEstimateLayout_Wrapping(t *testing.T) {
+	d := letterDoc(t)
+	// 100 words of 9 characters; each line of 6.5 inches holds several.
+	p := addExactParagraph(t, d, strings.TrimSpace(strings.Repeat("abcdefghi ", 100)))
+	after := addExactParagraph(t, d, "after")
+	l, err := d.EstimateLayout(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pl := l.Paragraphs[0]
+	if pl.Paragraph.p.RawElement() != p.p.RawElement() {
+		t.Fatal("first layout entry is not the first paragraph")
+	}
+	if pl.Lines < 8 || pl.Lines > 30 {
+		t.Errorf("Lines = %d, want a plausible wrap of 100 words", pl.Lines)
+	}
+	if l.PageOf(after) != 1 {
+		t.Errorf("short document spans pages")
+	}
+}
+
+func TestEstimateLayout_Breaks(t *testing.T) {
+	d := letterDoc(t)
+	addExactParagraph(t, d, "first")
+	if _, err := d.AddPageBreak(); err != nil {
+		t.Fatal(err)
+	}
+	second := addExactParagraph(t, d, "second")
+	if _, err := d.AddSection(2); err != nil { // new page
+		t.Fatal(err)
+	}
+	third := addExactParagraph(t, d, "third")
+	keep := addExactParagraph(t, d, "keeper")
+	keep.p.GetOrAddPPr().RawElement().CreateElement("w:pageBreakBefore")
+
+	l, err := d.EstimateLayout(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := []int{l.PageOf(second), l.PageOf(third), l.PageOf(keep)}; got[0] != 2 || got[1] != 3 || got[2] != 4 {
+		t.Errorf("pages = %v, want [2 3 4]", got)
+	}
+	if l.Pages != 4 {
+		t.Errorf("Pages = %d, want 4", l.Pages)
+	}
+}
+
+func TestEstimateLayout_Table(t *testing.T) {
+	d := letterDoc(t)
+	tbl, err := d.AddTable(30, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range tbl.Rows().Iter() {
+		for _, c := range row.Cells() {
+			for _, p := range c.Paragraphs() {
+				sp := p.p.GetOrAddPPr().RawElement().CreateElement("w:spacing")
+				sp.CreateAttr("w:line", "720")
+				sp.CreateAttr("w:lineRule", "exact")
+				sp.CreateAttr("w:after", "0")
+			}
+		}
+	}
+	l, err := d.EstimateLayout(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Pages != 2 {
+		t.Errorf("Pages = %d, want 2", l.Pages)
+	}
+	if n := len(l.Paragraphs); n < 60 {
+		t.Errorf("%d paragraphs laid out, want the 60 cell paragraphs", n)
+	}
+}
+
+func TestBreakLines(t *testing.T) {
+	var glyphs []glyph
+	for _, ch := range "aaa bbb ccc" {
+		glyphs = append(glyphs, glyph{width: 100, height: 240, space: ch == ' '})
+	}
+	lines := breakLines(glyphs, 750, 750)
+	if len(lines) != 2 || len(lines[0]) != 8 {
+		t.Errorf("lines = %d (first %d glyphs), want 2 (8)", len(lines), len(lines[0]))
+	}
+	if m := lookupFontMetrics("Arial", nil); m.CharWidth('W') != 944 {
+		t.Errorf("Arial W = %d", m.CharWidth('W'))
+	}
+	if m := lookupFontMetrics("Noto Sans Mono", nil); m != courierMetrics {
+		t.Error("monospace fallback not chosen")
+	}
+}
+
+func TestDocument_ThemeFonts(t *testing.T) {
+	major, minor := mustNewDoc(t).themeFonts()
+	if major == "" || minor == "" {
+		t.Errorf("themeFonts = %q, %q", major, minor)
+	}
+}
@@ -0,0 +1,177 @@
+package docx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/vortex/go-docx/internal/xmltok"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/opc/encryption"
+)
+
+// --------------------------------------------------------------------------
+// Formats of Word before 2007
+// --------------------------------------------------------------------------
+
+// ErrLegacyFormat is matched by the error of opening a file in a format of
+// Word before 2007, which this package does not read; errors.As with a
+// *LegacyFormatError tells which format it is.
+var ErrLegacyFormat = errors.New("docx: legacy Word format")
+
+// LegacyFormat identifies a file format of Word before 2007.
+type LegacyFormat int
+
+const (
+	// LegacyFormatDoc is the binary format of Word 97-2003 (.doc).
+	LegacyFormatDoc LegacyFormat = iota + 1
+	// LegacyFormatWordML2003 is the XML format of Word 2003
+	// (WordprocessingML 2003, often saved as .xml).
+	LegacyFormatWordML2003
+	// LegacyFormatRTF is the Rich Text Format, often saved as .doc.
+	LegacyFormatRTF
+)
+
+// legacyFormatNames are the names of the formats, indexed by LegacyFormat.
+var legacyFormatNames = [...]string{"", "Word 97-2003 (.doc)", "Word 2003 XML", "RTF"}
+
+// String returns the name of the format.
+func (f LegacyFormat) String() string {
+	if f <= 0 || int(f) >= len(legacyFormatNames) {
+		return fmt.Sprintf("LegacyFormat(%d)", int(f))
+	}
+	return legacyFormatNames[f]
+}
+
+// LegacyFormatError is returned when a file in a format of Word before
+// 2007 is opened. It matches ErrLegacyFormat and opc.ErrNotZipPackage.
+type LegacyFormatError struct {
+	Format LegacyFormat
+}
+
+func (e *LegacyFormatError) Error() string {
+	return fmt.Sprintf("docx: file is in the %s format, not .docx; convert it with Word or LibreOffice first", e.Format)
+}
+
+// Is reports whether target is ErrLegacyFormat or opc.ErrNotZipPackage.
+func (e *LegacyFormatError) Is(target error) bool {
+	return target == ErrLegacyFormat || target == opc.ErrNotZipPackage
+}
+
+// wordML2003Namespace is the namespace of the elements of Word 2003 XML.
+const wordML2003Namespace = "http://schemas.microsoft.com/office/word/2003/wordml"
+
+// DetectLegacyFormat reports which format of Word before 2007 data is in,
+// if any. Encrypted .docx files, which are compound files like .doc, are
+// not legacy.
+func DetectLegacyFormat(data []byte) (LegacyFormat, bool) {
+	if encryption.IsEncrypted(data) {
+		if encryption.HasStream(data, "WordDocument") {
+			return LegacyFormatDoc, true
+		}
+		return 0, false
+	}
+	head := data[:min(len(data), 4096)]
+	head = bytes.TrimPrefix(head, []byte("\xEF\xBB\xBF"))
+	head = bytes.TrimLeft(head, " \t\r\n")
+	switch {
+	case bytes.HasPrefix(head, []byte(`{\rtf`)):
+		return LegacyFormatRTF, true
+	case bytes.HasPrefix(head, []byte("<")) &&
+		(bytes.Contains(head, []byte(`progid="Word.Document"`)) || bytes.Contains(head, []byte(wordML2003Namespace))):
+		return LegacyFormatWordML2003, true
+	}
+	return 0, false
+}
+
+// legacyError returns the error for opening a legacy file in place of err,
+// the error opening it as a package, if read yields a file in a legacy
+// format. Otherwise it returns err.
+func legacyError(err error, read func() ([]byte, error)) error {
+	if !errors.Is(err, opc.ErrNotZipPackage) && !errors.Is(err, opc.ErrEncryptedPackage) {
+		return err
+	}
+	data, readErr := read()
+	if readErr != nil {
+		return err
+	}
+	if f, ok := DetectLegacyFormat(data); ok {
+		return &LegacyFormatError{Format: f}
+	}
+	return err
+}
+
+// constBytes returns a function returning data.
+func constBytes(data []byte) func() ([]byte, error) {
+	return func() ([]byte, error) { return data, nil }
+}
+
+// maxLegacySniff is the most legacyError reads of a file that failed to
+// open; larger .doc files are reported with the original error.
+const maxLegacySniff = 32 << 20
+
+// fileBytes returns a function reading the file at path.
+func fileBytes(path string) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return io.ReadAll(io.LimitReader(f, maxLegacySniff))
+	}
+}
+
+// readerAtBytes returns a function reading the size bytes of r.
+func readerAtBytes(r io.ReaderAt, size int64) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		return io.ReadAll(io.NewSectionReader(r, 0, min(size, maxLegacySniff)))
+	}
+}
+
+// wordML2003Skipped are the elements of Word 2003 XML whose paragraphs are
+// not body text: headers and footers, notes, and the content that
+// ExtractTextFast skips.
+var wordML2003Skipped = map[string]bool{
+	"w:hdr": true, "w:ftr": true, "w:footnote": true, "w:endnote": true,
+	"mc:Fallback": true, "w:del": true, "w:moveFrom": true,
+}
+
+// ExtractWordML2003Text returns the text of the body of a Word 2003 XML
+// document, one line per paragraph, as ExtractTextFast does for .docx
+// files. Word 2003 XML is read for its text only; it cannot be opened as a
+// Document.
+func ExtractWordML2003Text(r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("docx: extracting text: %w", err)
+	}
+	if f, ok := DetectLegacyFormat(data); !ok || f != LegacyFormatWordML2003 {
+		return "", fmt.Errorf("docx: extracting text: not a Word 2003 XML document")
+	}
+	sc := xmltok.NewScanner(data)
+	var body []byte
+	for {
+		tok, err := sc.Next()
+		if errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("docx: extracting text: no w:body element")
+		}
+		if err != nil {
+			return "", fmt.Errorf("docx: extracting text: %w", err)
+		}
+		if tok.Kind == xmltok.StartElement && string(tok.Name) == "w:body" {
+			if tok.SelfClosing {
+				return "", nil
+			}
+			body = data[sc.Offset():]
+			break
+		}
+	}
+	text, err := appendStoryTextSkipping(nil, body, wordML2003Skipped)
+	if err != nil {
+		return "", fmt.Errorf("docx: extracting text: %w", err)
+	}
+	return string(text), nil
+}
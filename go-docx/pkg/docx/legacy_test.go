@@ -0,0 +1,146 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/opc/encryption"
+)
+
+// minimalDoc returns a compound file with an empty WordDocument stream,
+// the shape of a Word 97-2003 file.
+func minimalDoc() []byte {
+	le := binary.LittleEndian
+	data := make([]byte, 3*512)
+	copy(data, []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1})
+	le.PutUint16(data[0x18:], 0x3E)
+	le.PutUint16(data[0x1A:], 3)
+	le.PutUint16(data[0x1C:], 0xFFFE)
+	le.PutUint16(data[0x1E:], 9)
+	le.PutUint16(data[0x20:], 6)
+	le.PutUint32(data[0x2C:], 1)          // one FAT sector
+	le.PutUint32(data[0x30:], 1)          // directory in sector 1
+	le.PutUint32(data[0x38:], 4096)       // mini stream cutoff
+	le.PutUint32(data[0x3C:], 0xFFFFFFFE) // no mini FAT
+	le.PutUint32(data[0x44:], 0xFFFFFFFE) // no DIFAT sectors
+	for i := 0; i < 109; i++ {
+		le.PutUint32(data[0x4C+4*i:], 0xFFFFFFFF)
+	}
+	le.PutUint32(data[0x4C:], 0) // the FAT is sector 0
+
+	fat := data[512:1024]
+	for i := 0; i < 128; i++ {
+		le.PutUint32(fat[4*i:], 0xFFFFFFFF)
+	}
+	le.PutUint32(fat[0:], 0xFFFFFFFD)
+	le.PutUint32(fat[4:], 0xFFFFFFFE)
+
+	entry := func(i int, name string, typ byte, child uint32) {
+		e := data[1024+128*i:]
+		u := utf16.Encode([]rune(name))
+		for j, c := range u {
+			le.PutUint16(e[2*j:], c)
+		}
+		le.PutUint16(e[0x40:], uint16(2*len(u)+2))
+		e[0x42] = typ
+		le.PutUint32(e[0x44:], 0xFFFFFFFF)
+		le.PutUint32(e[0x48:], 0xFFFFFFFF)
+		le.PutUint32(e[0x4C:], child)
+		le.PutUint32(e[0x74:], 0xFFFFFFFE)
+	}
+	entry(0, "Root Entry", 5, 1)
+	entry(1, "WordDocument", 2, 0xFFFFFFFF)
+	return data
+}
+
+const wordML2003 = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<?mso-application progid="Word.Document"?>
+<w:wordDocument xmlns:w="http://schemas.microsoft.com/office/word/2003/wordml">
+<w:docPr><w:view w:val="print"/></w:docPr>
+<w:body><wx:sect xmlns:wx="http://schemas.microsoft.com/office/word/2003/auxHint">
+<w:p><w:r><w:t>Hello</w:t><w:tab/><w:t>world</w:t></w:r></w:p>
+<w:p><w:r><w:t>Second</w:t><w:footnote><w:p><w:r><w:t>note</w:t></w:r></w:p></w:footnote></w:r></w:p>
+<w:sectPr><w:hdr w:type="odd"><w:p><w:r><w:t>Header</w:t></w:r></w:p></w:hdr></w:sectPr>
+</wx:sect></w:body></w:wordDocument>`
+
+func TestDetectLegacyFormat(t *testing.T) {
+	plain, err := mustNewDoc(t).SaveBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := encryption.Encrypt(plain, "pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		data []byte
+		want LegacyFormat
+	}{
+		{"doc", minimalDoc(), LegacyFormatDoc},
+		{"wordml", []byte(wordML2003), LegacyFormatWordML2003},
+		{"rtf", []byte("\xEF\xBB\xBF{\\rtf1\\ansi Hello}"), LegacyFormatRTF},
+		{"encrypted", encrypted, 0},
+		{"docx", plain, 0},
+		{"html", []byte("<html><body>x</body></html>"), 0},
+	}
+	for _, tt := range tests {
+		got, ok := DetectLegacyFormat(tt.data)
+		if got != tt.want || ok != (tt.want != 0) {
+			t.Errorf("%s: DetectLegacyFormat = %v, %v, want %v", tt.name, got, ok, tt.want)
+		}
+	}
+}
+
+func TestOpen_LegacyFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "old.doc")
+	if err := os.WriteFile(path, minimalDoc(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	opens := map[string]func() error{
+		"OpenBytes": func() error { _, err := OpenBytes([]byte(wordML2003)); return err },
+		"Open": func() error {
+			_, err := Open(bytes.NewReader(minimalDoc()), int64(len(minimalDoc())))
+			return err
+		},
+		"OpenFile":              func() error { _, err := OpenFile(path); return err },
+		"OpenBytesWithOptions":  func() error { _, err := OpenBytesWithOptions(minimalDoc(), nil); return err },
+		"OpenBytesWithPassword": func() error { _, err := OpenBytesWithPassword(minimalDoc(), "pw"); return err },
+	}
+	for name, open := range opens {
+		err := open()
+		if !errors.Is(err, ErrLegacyFormat) || !errors.Is(err, opc.ErrNotZipPackage) {
+			t.Errorf("%s: err = %v, want ErrLegacyFormat", name, err)
+			continue
+		}
+		var lf *LegacyFormatError
+		if !errors.As(err, &lf) || lf.Format == 0 {
+			t.Errorf("%s: no LegacyFormatError in %v", name, err)
+		}
+	}
+
+	_, err := OpenBytes([]byte("not a document"))
+	if errors.Is(err, ErrLegacyFormat) || !errors.Is(err, opc.ErrNotZipPackage) {
+		t.Errorf("plain text: err = %v", err)
+	}
+}
+
+func TestExtractWordML2003Text(t *testing.T) {
+	got, err := ExtractWordML2003Text(strings.NewReader(wordML2003))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Hello\tworld\nSecond"; got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+	if _, err := ExtractWordML2003Text(strings.NewReader("<html/>")); err == nil {
+		t.Error("expected an error for HTML")
+	}
+}
@@ -0,0 +1,122 @@
+// Package lint checks a document against house rules for templates and
+// reports what breaks them as findings a program can read, for example in
+// a CI job reviewing template changes.
+//
+// A rule is a value implementing Rule; the rules of this package are
+// structs whose fields configure them. Run applies a set of rules:
+//
+//	findings, err := lint.Run(doc,
+//		lint.HeadingLevels{},
+//		lint.FontAllowlist{Fonts: []string{"Calibri", "Cambria"}},
+//	)
+package lint
+
+import (
+	"fmt"
+
+	"github.com/vortex/go-docx/pkg/docx"
+)
+
+// Severity is how serious a finding is.
+type Severity int
+
+const (
+	// Warning is a finding that should be fixed.
+	Warning Severity = iota
+	// Error is a finding that must be fixed.
+	Error
+)
+
+// severityNames are the severity names, indexed by Severity.
+var severityNames = [...]string{"warning", "error"}
+
+// String returns "warning" or "error".
+func (s Severity) String() string {
+	if s < 0 || int(s) >= len(severityNames) {
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+	return severityNames[s]
+}
+
+// MarshalText encodes the severity as its name, as in JSON output.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a severity name.
+func (s *Severity) UnmarshalText(b []byte) error {
+	for i, name := range severityNames {
+		if string(b) == name {
+			*s = Severity(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("lint: unknown severity %q", b)
+}
+
+// Finding is one place where a document breaks a rule.
+type Finding struct {
+	// Rule is the ID of the rule broken.
+	Rule string `json:"rule"`
+	// Severity is the severity the rule is configured with.
+	Severity Severity `json:"severity"`
+	// Location is where the finding is: "paragraph N" or "table N", counted
+	// from 1 in document order over the body, table content included, or
+	// "style ID" for a style.
+	Location string `json:"location"`
+	// Message describes the finding.
+	Message string `json:"message"`
+}
+
+// String returns the finding as one line, e.g.
+// "paragraph 3: warning: heading-levels: ...".
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s: %s", f.Location, f.Severity, f.Rule, f.Message)
+}
+
+// Rule is a check run over a document.
+type Rule interface {
+	// ID returns a short, stable name for the rule, e.g.
+	// "heading-levels".
+	ID() string
+	// Check returns the findings of the rule for d, in document order.
+	Check(d *docx.Document) ([]Finding, error)
+}
+
+// DefaultRules returns the rules of this package that need no
+// configuration, with their default settings.
+func DefaultRules() []Rule {
+	return []Rule{DirectFormatting{}, HeadingLevels{}, TableHeaderRows{}}
+}
+
+// Run checks d against rules, or DefaultRules when none are given, and
+// returns the findings rule by rule.
+func Run(d *docx.Document, rules ...Rule) ([]Finding, error) {
+	if len(rules) == 0 {
+		rules = DefaultRules()
+	}
+	var findings []Finding
+	for _, r := range rules {
+		fs, err := r.Check(d)
+		if err != nil {
+			return nil, fmt.Errorf("lint: %s: %w", r.ID(), err)
+		}
+		findings = append(findings, fs...)
+	}
+	return findings, nil
+}
+
+// locator numbers the paragraphs and tables of a walk.
+type locator struct {
+	paragraphs, tables int
+}
+
+func (l *locator) paragraph() string {
+	l.paragraphs++
+	return fmt.Sprintf("paragraph %d", l.paragraphs)
+}
+
+func (l *locator) table() string {
+	l.tables++
+	return fmt.Sprintf("table %d", l.tables)
+}
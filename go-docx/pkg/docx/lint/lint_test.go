@@ -0,0 +1,121 @@
+package lint
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx"
+)
+
+func lintDocument(t *testing.T) *docx.Document {
+	t.Helper()
+	d, err := docx.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	add := func(text, style string) *docx.Paragraph {
+		t.Helper()
+		var p *docx.Paragraph
+		if style == "" {
+			p, err = d.AddParagraph(text)
+		} else {
+			p, err = d.AddParagraph(text, docx.StyleName(style))
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	add("Title", "Heading 1")
+	add("Skipped", "Heading 3")
+	p := add("", "")
+	r, err := p.AddRun("bold")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bold := true
+	if err := r.Font().SetBold(&bold); err != nil {
+		t.Fatal(err)
+	}
+	name := "Comic Sans MS"
+	if err := r.Font().SetName(&name); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.AddTable(2, 2); err != nil {
+		t.Fatal(err)
+	}
+	tbl, err := d.AddTable(2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tbl.Rows().Iter()[0].SetIsHeader(true)
+	add("Back", "Heading 2")
+	return d
+}
+
+func TestRun_Defaults(t *testing.T) {
+	findings, err := Run(lintDocument(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range findings {
+		got = append(got, f.String())
+	}
+	want := []string{
+		"paragraph 3: warning: direct-formatting: direct formatting: rFonts, b",
+		"paragraph 2: warning: heading-levels: heading level 3 follows level 1",
+		"table 1: warning: table-header-rows: first row is not a header row",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("findings =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestFontAllowlist(t *testing.T) {
+	d := lintDocument(t)
+	findings, err := Run(d, FontAllowlist{Severity: Error, Fonts: []string{"calibri", "cambria"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body []Finding
+	for _, f := range findings {
+		if strings.HasPrefix(f.Location, "paragraph") {
+			body = append(body, f)
+		}
+	}
+	if len(body) != 1 || body[0].Location != "paragraph 3" || !strings.Contains(body[0].Message, "Comic Sans MS") {
+		t.Fatalf("findings = %v", findings)
+	}
+
+	b, err := json.Marshal(body[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"rule":"font-allowlist","severity":"error","location":"paragraph 3","message":"font \"Comic Sans MS\" is not allowed"}`
+	if string(b) != want {
+		t.Errorf("json = %s", b)
+	}
+	var back Finding
+	if err := json.Unmarshal(b, &back); err != nil || back != body[0] {
+		t.Errorf("round trip = %+v, %v", back, err)
+	}
+}
+
+func TestHeadingLevels_FirstHeading(t *testing.T) {
+	d, err := docx.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.AddParagraph("Deep", docx.StyleName("Heading 2")); err != nil {
+		t.Fatal(err)
+	}
+	findings, err := Run(d, HeadingLevels{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 1 || findings[0].Message != "first heading is level 2" {
+		t.Errorf("findings = %v", findings)
+	}
+}
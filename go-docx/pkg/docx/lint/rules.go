@@ -0,0 +1,259 @@
+package lint
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx"
+)
+
+// --------------------------------------------------------------------------
+// DirectFormatting
+// --------------------------------------------------------------------------
+
+// DirectFormatting reports paragraphs formatted directly, in their
+// paragraph properties or in the properties of their runs, rather than
+// through styles.
+type DirectFormatting struct {
+	Severity Severity
+	// Allow lists the properties that do not count as direct formatting,
+	// by element name without prefix, e.g. "lang". nil allows the
+	// properties that are not formatting: style references, language,
+	// proofing, numbering, section breaks and tracked-change records.
+	Allow []string
+}
+
+// defaultAllowedProperties are the properties DirectFormatting allows
+// when Allow is nil.
+var defaultAllowedProperties = []string{
+	"pStyle", "rStyle", "numPr", "sectPr", "lang", "noProof", "webHidden", "specVanish",
+	"rtl", "cs", "ins", "del", "moveFrom", "moveTo", "rPrChange", "pPrChange",
+}
+
+// ID returns "direct-formatting".
+func (DirectFormatting) ID() string { return "direct-formatting" }
+
+// Check implements Rule.
+func (r DirectFormatting) Check(d *docx.Document) ([]Finding, error) {
+	allow := r.Allow
+	if allow == nil {
+		allow = defaultAllowedProperties
+	}
+	var (
+		findings []Finding
+		loc      locator
+		where    string
+		props    []string
+	)
+	collect := func(pr *etree.Element) {
+		if pr == nil {
+			return
+		}
+		for _, el := range pr.ChildElements() {
+			if el.Tag == "rPr" || slices.Contains(allow, el.Tag) || slices.Contains(props, el.Tag) {
+				continue
+			}
+			props = append(props, el.Tag)
+		}
+	}
+	flush := func() {
+		if len(props) > 0 {
+			findings = append(findings, Finding{
+				Rule: r.ID(), Severity: r.Severity, Location: where,
+				Message: "direct formatting: " + strings.Join(props, ", "),
+			})
+		}
+		props = nil
+	}
+	err := d.Walk(&docx.Visitor{
+		Table: func(*docx.Table) error { loc.table(); return nil },
+		Paragraph: func(p *docx.Paragraph) error {
+			flush()
+			where = loc.paragraph()
+			pPr := p.CT_P().RawElement().SelectElement("w:pPr")
+			collect(pPr)
+			if pPr != nil {
+				collect(pPr.SelectElement("w:rPr"))
+			}
+			return nil
+		},
+		Run: func(run *docx.Run) error {
+			collect(run.CT_R().RawElement().SelectElement("w:rPr"))
+			return nil
+		},
+	})
+	flush()
+	return findings, err
+}
+
+// --------------------------------------------------------------------------
+// HeadingLevels
+// --------------------------------------------------------------------------
+
+// HeadingLevels reports headings whose outline level is more than one
+// below that of the heading before them, such as a Heading 3 following a
+// Heading 1, and a first heading below level 1.
+type HeadingLevels struct {
+	Severity Severity
+}
+
+// ID returns "heading-levels".
+func (HeadingLevels) ID() string { return "heading-levels" }
+
+// Check implements Rule.
+func (r HeadingLevels) Check(d *docx.Document) ([]Finding, error) {
+	var (
+		findings []Finding
+		loc      locator
+		prev     int
+	)
+	err := d.Walk(&docx.Visitor{
+		Table: func(*docx.Table) error { loc.table(); return nil },
+		Paragraph: func(p *docx.Paragraph) error {
+			where := loc.paragraph()
+			level, err := p.OutlineLevel()
+			if err != nil {
+				return err
+			}
+			if level == 0 || strings.TrimSpace(p.Text()) == "" {
+				return nil
+			}
+			if level > prev+1 {
+				msg := fmt.Sprintf("heading level %d follows level %d", level, prev)
+				if prev == 0 {
+					msg = fmt.Sprintf("first heading is level %d", level)
+				}
+				findings = append(findings, Finding{Rule: r.ID(), Severity: r.Severity, Location: where, Message: msg})
+			}
+			prev = level
+			return nil
+		},
+	})
+	return findings, err
+}
+
+// --------------------------------------------------------------------------
+// TableHeaderRows
+// --------------------------------------------------------------------------
+
+// TableHeaderRows reports tables whose first row is not marked as a
+// header row, which screen readers and page breaks rely on.
+type TableHeaderRows struct {
+	Severity Severity
+	// MinRows is the number of rows from which a table needs a header
+	// row; 0 means 2, so that single-row layout tables pass.
+	MinRows int
+}
+
+// ID returns "table-header-rows".
+func (TableHeaderRows) ID() string { return "table-header-rows" }
+
+// Check implements Rule.
+func (r TableHeaderRows) Check(d *docx.Document) ([]Finding, error) {
+	minRows := r.MinRows
+	if minRows <= 0 {
+		minRows = 2
+	}
+	var (
+		findings []Finding
+		loc      locator
+	)
+	err := d.Walk(&docx.Visitor{
+		Paragraph: func(*docx.Paragraph) error { loc.paragraph(); return nil },
+		Table: func(t *docx.Table) error {
+			where := loc.table()
+			rows := t.Rows().Iter()
+			if len(rows) >= minRows && !rows[0].IsHeader() {
+				findings = append(findings, Finding{
+					Rule: r.ID(), Severity: r.Severity, Location: where,
+					Message: "first row is not a header row",
+				})
+			}
+			return nil
+		},
+	})
+	return findings, err
+}
+
+// --------------------------------------------------------------------------
+// FontAllowlist
+// --------------------------------------------------------------------------
+
+// FontAllowlist reports fonts named in the document that are not in an
+// allowlist: in the document defaults, in styles and in the paragraphs
+// and runs of the body. Theme font references are not checked.
+type FontAllowlist struct {
+	Severity Severity
+	// Fonts are the allowed font names, compared without regard to case.
+	Fonts []string
+}
+
+// ID returns "font-allowlist".
+func (FontAllowlist) ID() string { return "font-allowlist" }
+
+// fontAttrs are the w:rFonts attributes naming a font.
+var fontAttrs = []string{"w:ascii", "w:hAnsi", "w:eastAsia", "w:cs"}
+
+// Check implements Rule.
+func (r FontAllowlist) Check(d *docx.Document) ([]Finding, error) {
+	var findings []Finding
+	reported := map[string]bool{}
+	check := func(where string, rPr *etree.Element) {
+		if rPr == nil {
+			return
+		}
+		fonts := rPr.SelectElement("w:rFonts")
+		if fonts == nil {
+			return
+		}
+		for _, attr := range fontAttrs {
+			name := fonts.SelectAttrValue(attr, "")
+			if name == "" || r.allowed(name) || reported[where+"\x00"+name] {
+				continue
+			}
+			reported[where+"\x00"+name] = true
+			findings = append(findings, Finding{
+				Rule: r.ID(), Severity: r.Severity, Location: where,
+				Message: fmt.Sprintf("font %q is not allowed", name),
+			})
+		}
+	}
+
+	styles, err := d.Part().Styles()
+	if err != nil {
+		return nil, err
+	}
+	check("document defaults", styles.RawElement().FindElement("w:docDefaults/w:rPrDefault/w:rPr"))
+	for _, style := range styles.RawElement().SelectElements("w:style") {
+		check("style "+style.SelectAttrValue("w:styleId", ""), style.SelectElement("w:rPr"))
+	}
+
+	var (
+		loc   locator
+		where string
+	)
+	err = d.Walk(&docx.Visitor{
+		Table: func(*docx.Table) error { loc.table(); return nil },
+		Paragraph: func(p *docx.Paragraph) error {
+			where = loc.paragraph()
+			check(where, p.CT_P().RawElement().FindElement("w:pPr/w:rPr"))
+			return nil
+		},
+		Run: func(run *docx.Run) error {
+			check(where, run.CT_R().RawElement().SelectElement("w:rPr"))
+			return nil
+		},
+	})
+	return findings, err
+}
+
+func (r FontAllowlist) allowed(name string) bool {
+	for _, f := range r.Fonts {
+		if strings.EqualFold(f, name) {
+			return true
+		}
+	}
+	return false
+}
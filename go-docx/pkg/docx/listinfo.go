@@ -0,0 +1,435 @@
+package docx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// ListInfo describes the list numbering of a paragraph as Word renders it.
+type ListInfo struct {
+	// NumID is the numbering instance (w:numId) the paragraph belongs to.
+	NumID int
+	// Level is the list level (w:ilvl), 0 for the outermost.
+	Level int
+	// Format is the number format of the level, e.g. "decimal",
+	// "lowerLetter" or "bullet".
+	Format string
+	// Label is the text Word shows before the paragraph, e.g. "3.2.1." or
+	// "•", without the tab or space that follows it.
+	Label string
+}
+
+// IsBullet reports whether the paragraph is a bulleted rather than a
+// numbered list item.
+func (li *ListInfo) IsBullet() bool { return li.Format == "bullet" }
+
+// ListInfo returns the list numbering of the paragraph, or nil if it is not
+// a list item. The numbering may come from the paragraph itself or from its
+// style. Labels are computed by counting the list items that precede the
+// paragraph in its story, so this takes time proportional to the length of
+// the story.
+func (para *Paragraph) ListInfo() (*ListInfo, error) {
+	dp, err := para.part.DocumentPart()
+	if err != nil {
+		return nil, fmt.Errorf("docx: list info: %w", err)
+	}
+	np, err := dp.NumberingPart()
+	if err != nil {
+		return nil, nil // without numbering definitions nothing is numbered
+	}
+	styles, err := dp.Styles()
+	if err != nil {
+		return nil, fmt.Errorf("docx: list info: %w", err)
+	}
+	ln := newListNumberer(np.Element(), styles.RawElement())
+	target := para.p.RawElement()
+	var info *ListInfo
+	found := false
+	var walk func(el *etree.Element)
+	walk = func(el *etree.Element) {
+		for _, child := range el.ChildElements() {
+			if found {
+				return
+			}
+			switch {
+			case child.Space == "mc" && child.Tag == "Fallback":
+			case child.Space == "w" && child.Tag == "p":
+				i := ln.next(child)
+				if child == target {
+					info, found = i, true
+					return
+				}
+				walk(child) // text box content
+			default:
+				walk(child)
+			}
+		}
+	}
+	if root := para.part.Element(); root != nil {
+		walk(root)
+	}
+	if !found {
+		info = ln.next(target) // detached paragraph: numbered on its own
+	}
+	return info, nil
+}
+
+// --------------------------------------------------------------------------
+// Numbering evaluation
+// --------------------------------------------------------------------------
+
+// maxListLevels is the number of levels a list definition has.
+const maxListLevels = 9
+
+// listCounters holds the current number of each level of one list.
+type listCounters struct {
+	vals [maxListLevels]int
+	set  [maxListLevels]bool
+}
+
+// listNumberer numbers the list paragraphs of a story, passed to next in
+// document order.
+type listNumberer struct {
+	numbering, styles *etree.Element
+	counters          map[string]*listCounters
+}
+
+func newListNumberer(numbering, styles *etree.Element) *listNumberer {
+	return &listNumberer{numbering: numbering, styles: styles, counters: map[string]*listCounters{}}
+}
+
+// next advances the counters for paragraph p and returns its list info, or
+// nil if it is not a list item.
+func (ln *listNumberer) next(p *etree.Element) *ListInfo {
+	numID, ilvl, ok := ln.numPr(p)
+	if !ok || numID == 0 || ilvl < 0 || ilvl >= maxListLevels {
+		return nil
+	}
+	num := ln.num(numID)
+	if num == nil {
+		return nil
+	}
+	absID := -1
+	if el := num.SelectElement("w:abstractNumId"); el != nil {
+		absID = attrInt(el, "w:val", -1)
+	}
+	abs := ln.abstractNum(absID)
+	if abs == nil {
+		return nil
+	}
+
+	// Instances of one abstract list share their counters, as they continue
+	// each other in Word, unless an instance restarts a level.
+	key := "a" + strconv.Itoa(absID)
+	if len(num.FindElements("w:lvlOverride/w:startOverride")) > 0 {
+		key = "n" + strconv.Itoa(numID)
+	}
+	c := ln.counters[key]
+	if c == nil {
+		c = &listCounters{}
+		ln.counters[key] = c
+	}
+	levels := make([]*etree.Element, maxListLevels)
+	for k := range levels {
+		levels[k] = listLevel(num, abs, k)
+	}
+	start := func(k int) int {
+		for _, o := range num.SelectElements("w:lvlOverride") {
+			if attrInt(o, "w:ilvl", -1) == k {
+				if s := o.SelectElement("w:startOverride"); s != nil {
+					return attrInt(s, "w:val", 0)
+				}
+			}
+		}
+		return levelInt(levels[k], "w:start", 0)
+	}
+
+	if c.set[ilvl] {
+		c.vals[ilvl]++
+	} else {
+		c.vals[ilvl], c.set[ilvl] = start(ilvl), true
+	}
+	for j := ilvl + 1; j < maxListLevels; j++ {
+		// w:lvlRestart names the 1-based level after whose use this one
+		// restarts; 0 means never. By default any outer level restarts it.
+		restart := levelInt(levels[j], "w:lvlRestart", j)
+		if restart != 0 && ilvl < restart {
+			c.set[j] = false
+		}
+	}
+
+	lvl := levels[ilvl]
+	info := &ListInfo{NumID: numID, Level: ilvl, Format: levelVal(lvl, "w:numFmt", "decimal")}
+	text := levelVal(lvl, "w:lvlText", "")
+	if info.IsBullet() {
+		info.Label = bulletText(text)
+		return info
+	}
+	legal := lvl != nil && lvl.SelectElement("w:isLgl") != nil
+	var sb strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '%' && i+1 < len(text) && text[i+1] >= '1' && text[i+1] <= '9' {
+			k := int(text[i+1] - '1')
+			n := start(k)
+			if c.set[k] {
+				n = c.vals[k]
+			}
+			format := levelVal(levels[k], "w:numFmt", "decimal")
+			if legal {
+				format = "decimal"
+			}
+			sb.WriteString(formatListNumber(n, format))
+			i++
+			continue
+		}
+		sb.WriteByte(text[i])
+	}
+	info.Label = sb.String()
+	return info
+}
+
+// numPr returns the numbering instance and level of paragraph p, from its
+// own properties or its style's.
+func (ln *listNumberer) numPr(p *etree.Element) (numID, ilvl int, ok bool) {
+	numID, ilvl = -1, -1
+	if pr := p.FindElement("w:pPr/w:numPr"); pr != nil {
+		numID = childInt(pr, "w:numId", -1)
+		ilvl = childInt(pr, "w:ilvl", -1)
+	}
+	var linked *etree.Element
+	for _, style := range paragraphStyleChain(ln.styles, p) {
+		if numID >= 0 && ilvl >= 0 {
+			break
+		}
+		if pr := style.FindElement("w:pPr/w:numPr"); pr != nil {
+			if numID < 0 {
+				numID = childInt(pr, "w:numId", -1)
+				linked = style
+			}
+			if ilvl < 0 {
+				ilvl = childInt(pr, "w:ilvl", -1)
+			}
+		}
+	}
+	if numID < 0 {
+		return 0, 0, false
+	}
+	if ilvl < 0 {
+		// A level may name the style that selects it.
+		ilvl = 0
+		if num := ln.num(numID); num != nil && linked != nil {
+			id := linked.SelectAttrValue("w:styleId", "")
+			abs := ln.abstractNum(attrInt(num.SelectElement("w:abstractNumId"), "w:val", -1))
+			if abs != nil {
+				for _, lvl := range abs.SelectElements("w:lvl") {
+					if levelVal(lvl, "w:pStyle", "") == id {
+						ilvl = attrInt(lvl, "w:ilvl", 0)
+					}
+				}
+			}
+		}
+	}
+	return numID, ilvl, true
+}
+
+// num returns the w:num with the given id, or nil.
+func (ln *listNumberer) num(id int) *etree.Element {
+	for _, num := range ln.numbering.SelectElements("w:num") {
+		if attrInt(num, "w:numId", -1) == id {
+			return num
+		}
+	}
+	return nil
+}
+
+// abstractNum returns the abstract list definition with the given id,
+// following a w:numStyleLink to the definition of the numbering style.
+func (ln *listNumberer) abstractNum(id int) *etree.Element {
+	for depth := 0; depth < 8; depth++ {
+		var abs *etree.Element
+		for _, el := range ln.numbering.SelectElements("w:abstractNum") {
+			if attrInt(el, "w:abstractNumId", -1) == id {
+				abs = el
+				break
+			}
+		}
+		if abs == nil {
+			return nil
+		}
+		link := abs.SelectElement("w:numStyleLink")
+		if link == nil {
+			return abs
+		}
+		style := findStyle(ln.styles, "numbering", link.SelectAttrValue("w:val", ""))
+		if style == nil {
+			return abs
+		}
+		pr := style.FindElement("w:pPr/w:numPr")
+		if pr == nil {
+			return abs
+		}
+		num := ln.num(childInt(pr, "w:numId", -1))
+		if num == nil {
+			return abs
+		}
+		id = attrInt(num.SelectElement("w:abstractNumId"), "w:val", -1)
+	}
+	return nil
+}
+
+// listLevel returns the definition of level k of an instance: its
+// override's, or the abstract list's.
+func listLevel(num, abs *etree.Element, k int) *etree.Element {
+	for _, o := range num.SelectElements("w:lvlOverride") {
+		if attrInt(o, "w:ilvl", -1) == k {
+			if lvl := o.SelectElement("w:lvl"); lvl != nil {
+				return lvl
+			}
+		}
+	}
+	for _, lvl := range abs.SelectElements("w:lvl") {
+		if attrInt(lvl, "w:ilvl", -1) == k {
+			return lvl
+		}
+	}
+	return nil
+}
+
+// attrInt returns the integer value of attribute key of el, or def.
+func attrInt(el *etree.Element, key string, def int) int {
+	if el == nil {
+		return def
+	}
+	n, err := strconv.Atoi(el.SelectAttrValue(key, ""))
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+// childInt returns the integer w:val of child tag of el, or def.
+func childInt(el *etree.Element, tag string, def int) int {
+	return attrInt(el.SelectElement(tag), "w:val", def)
+}
+
+// levelVal returns the w:val of child tag of lvl, or def.
+func levelVal(lvl *etree.Element, tag, def string) string {
+	if lvl == nil {
+		return def
+	}
+	if el := lvl.SelectElement(tag); el != nil {
+		return el.SelectAttrValue("w:val", def)
+	}
+	return def
+}
+
+// levelInt returns the integer w:val of child tag of lvl, or def.
+func levelInt(lvl *etree.Element, tag string, def int) int {
+	if lvl == nil {
+		return def
+	}
+	return childInt(lvl, tag, def)
+}
+
+// --------------------------------------------------------------------------
+// Number formats
+// --------------------------------------------------------------------------
+
+// formatListNumber renders n in the number format of a list level.
+// Formats without a rendering here fall back to decimal.
+func formatListNumber(n int, format string) string {
+	switch format {
+	case "none":
+		return ""
+	case "decimalZero":
+		return fmt.Sprintf("%02d", n)
+	case "upperRoman":
+		return romanNumeral(n)
+	case "lowerRoman":
+		return strings.ToLower(romanNumeral(n))
+	case "upperLetter":
+		return letterNumber(n)
+	case "lowerLetter":
+		return strings.ToLower(letterNumber(n))
+	case "ordinal":
+		return strconv.Itoa(n) + ordinalSuffix(n)
+	case "decimalEnclosedParen":
+		return "(" + strconv.Itoa(n) + ")"
+	case "decimalEnclosedFullstop":
+		return strconv.Itoa(n) + "."
+	case "decimalEnclosedCircle":
+		if n >= 1 && n <= 20 {
+			return string(rune('①' + n - 1))
+		}
+	}
+	return strconv.Itoa(n)
+}
+
+// romanNumeral returns n in upper-case Roman numerals, or in decimal when
+// it has none.
+func romanNumeral(n int) string {
+	if n <= 0 || n >= 4000 {
+		return strconv.Itoa(n)
+	}
+	values := []int{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
+	symbols := []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+	var sb strings.Builder
+	for i, v := range values {
+		for n >= v {
+			sb.WriteString(symbols[i])
+			n -= v
+		}
+	}
+	return sb.String()
+}
+
+// letterNumber returns n as Word's letter numbering does: A to Z, then AA
+// to ZZ, then AAA and so on.
+func letterNumber(n int) string {
+	if n <= 0 {
+		return strconv.Itoa(n)
+	}
+	return strings.Repeat(string(rune('A'+(n-1)%26)), (n-1)/26+1)
+}
+
+// ordinalSuffix returns the English ordinal suffix of n.
+func ordinalSuffix(n int) string {
+	if n%100 >= 11 && n%100 <= 13 {
+		return "th"
+	}
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// symbolBullets maps the private-use characters bullets are written with
+// in the Symbol and Wingdings fonts to the Unicode characters they show.
+var symbolBullets = map[rune]string{
+	0xF0B7: "•", 0xF0A7: "▪", 0xF06E: "■", 0xF071: "❑", 0xF076: "❖",
+	0xF0D8: "➢", 0xF0FC: "✓", 0xF0A8: "◦", 0xF02D: "-",
+}
+
+// bulletText returns the text of a bullet level with symbol-font
+// characters replaced by their Unicode equivalents.
+func bulletText(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		if s, ok := symbolBullets[r]; ok {
+			sb.WriteString(s)
+		} else if r >= 0xF000 && r <= 0xF0FF {
+			sb.WriteString("•")
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,153 @@
+package docx
+
+import (
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// legalNumbering defines abstract list 90 ("1.", "1.1.", "1.1.1", "(a)")
+// with instances 90 and 91, the latter restarting at 5, and a bullet list
+// 92.
+const legalNumbering = `<w:numbering ` + wNS + `>
+<w:abstractNum w:abstractNumId="90">
+ <w:lvl w:ilvl="0"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:lvlText w:val="%1."/></w:lvl>
+ <w:lvl w:ilvl="1"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:lvlText w:val="%1.%2."/></w:lvl>
+ <w:lvl w:ilvl="2"><w:start w:val="1"/><w:numFmt w:val="decimal"/><w:lvlText w:val="%1.%2.%3"/></w:lvl>
+ <w:lvl w:ilvl="3"><w:start w:val="1"/><w:numFmt w:val="lowerLetter"/><w:lvlText w:val="(%4)"/></w:lvl>
+ <w:lvl w:ilvl="4"><w:start w:val="1"/><w:numFmt w:val="lowerRoman"/><w:isLgl/><w:lvlText w:val="%1.%5"/></w:lvl>
+</w:abstractNum>
+<w:abstractNum w:abstractNumId="92">
+ <w:lvl w:ilvl="0"><w:start w:val="1"/><w:numFmt w:val="bullet"/><w:lvlText w:val="` + "\uf0b7" + `"/></w:lvl>
+</w:abstractNum>
+<w:num w:numId="90"><w:abstractNumId w:val="90"/></w:num>
+<w:num w:numId="91"><w:abstractNumId w:val="90"/><w:lvlOverride w:ilvl="0"><w:startOverride w:val="5"/></w:lvlOverride></w:num>
+<w:num w:numId="92"><w:abstractNumId w:val="92"/></w:num>
+</w:numbering>`
+
+func addListDefinitions(t *testing.T, d *Document) {
+	t.Helper()
+	np, err := d.part.NumberingPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defs, err := oxml.ParseXml([]byte(legalNumbering))
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := np.Element()
+	for _, el := range defs.SelectElements("w:abstractNum") {
+		root.InsertChildAt(0, el.Copy())
+	}
+	for _, el := range defs.SelectElements("w:num") {
+		root.AddChild(el.Copy())
+	}
+}
+
+func addListParagraph(t *testing.T, d *Document, text string, numID, ilvl int) *Paragraph {
+	t.Helper()
+	p, err := d.AddParagraph(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pPr := p.p.GetOrAddPPr()
+	numPr := pPr.GetOrAddNumPr()
+	if err := numPr.SetNumIdVal(numID); err != nil {
+		t.Fatal(err)
+	}
+	if err := numPr.SetIlvlVal(ilvl); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestParagraph_ListInfo(t *testing.T) {
+	d := mustNewDoc(t)
+	addListDefinitions(t, d)
+
+	type item struct {
+		numID, ilvl int
+		want        string
+	}
+	items := []item{
+		{90, 0, "1."},
+		{90, 1, "1.1."},
+		{90, 1, "1.2."},
+		{90, 2, "1.2.1"},
+		{90, 3, "(a)"},
+		{90, 3, "(b)"},
+		{90, 0, "2."},
+		{90, 1, "2.1."},
+		{90, 4, "2.1"},
+		{92, 0, "•"},
+		{91, 0, "5."},
+		{91, 0, "6."},
+	}
+	var paras []*Paragraph
+	for _, it := range items {
+		paras = append(paras, addListParagraph(t, d, "item", it.numID, it.ilvl))
+	}
+	plain, err := d.AddParagraph("not a list item")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, it := range items {
+		info, err := paras[i].ListInfo()
+		if err != nil || info == nil {
+			t.Fatalf("item %d: ListInfo = %v, %v", i, info, err)
+		}
+		if info.Label != it.want || info.NumID != it.numID || info.Level != it.ilvl {
+			t.Errorf("item %d: got %+v, want label %q", i, *info, it.want)
+		}
+	}
+	if info, _ := paras[9].ListInfo(); !info.IsBullet() {
+		t.Error("bullet item not reported as bullet")
+	}
+	if info, err := plain.ListInfo(); info != nil || err != nil {
+		t.Errorf("plain paragraph: %+v, %v", info, err)
+	}
+}
+
+func TestParagraph_ListInfo_FromStyle(t *testing.T) {
+	d := mustNewDoc(t)
+	var paras []*Paragraph
+	for i := 0; i < 3; i++ {
+		p, err := d.AddParagraph("step", StyleName("List Number"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		paras = append(paras, p)
+	}
+	info, err := paras[2].ListInfo()
+	if err != nil || info == nil {
+		t.Fatalf("ListInfo = %v, %v", info, err)
+	}
+	if info.Label != "3." || info.Level != 0 {
+		t.Errorf("ListInfo = %+v, want label 3.", *info)
+	}
+}
+
+func TestFormatListNumber(t *testing.T) {
+	tests := []struct {
+		n      int
+		format string
+		want   string
+	}{
+		{4, "upperRoman", "IV"},
+		{1994, "lowerRoman", "mcmxciv"},
+		{28, "upperLetter", "BB"},
+		{3, "lowerLetter", "c"},
+		{7, "decimalZero", "07"},
+		{12, "ordinal", "12th"},
+		{22, "ordinal", "22nd"},
+		{2, "decimalEnclosedCircle", "②"},
+		{5, "none", ""},
+		{5, "chineseCounting", "5"},
+	}
+	for _, tt := range tests {
+		if got := formatListNumber(tt.n, tt.format); got != tt.want {
+			t.Errorf("formatListNumber(%d, %q) = %q, want %q", tt.n, tt.format, got, tt.want)
+		}
+	}
+}
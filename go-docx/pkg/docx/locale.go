@@ -0,0 +1,137 @@
+package docx
+
+import (
+	"strings"
+	"time"
+)
+
+// locale holds the conventions of a language for formatting numbers and
+// dates in field results.
+type locale struct {
+	// decimal and group are the decimal and digit grouping separators.
+	decimal, group string
+	// months and days are the month names from January and the weekday
+	// names from Sunday; nil uses the English names.
+	months, days []string
+	// shortMonths and shortDays are the abbreviated names, those of the
+	// CLDR format context; nil uses the English ones.
+	shortMonths, shortDays []string
+}
+
+// German names, shared by de and de-CH.
+var (
+	deMonths      = []string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"}
+	deDays        = []string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"}
+	deShortMonths = []string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."}
+	deShortDays   = []string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."}
+)
+
+// locales maps lowercase language tags, with a region where it changes
+// the conventions, to their conventions.
+var locales = map[string]*locale{
+	"en": {decimal: ".", group: ","},
+	"de": {decimal: ",", group: ".",
+		months: deMonths, days: deDays, shortMonths: deShortMonths, shortDays: deShortDays},
+	"de-ch": {decimal: ".", group: "’",
+		months: deMonths, days: deDays, shortMonths: deShortMonths, shortDays: deShortDays},
+	"es": {decimal: ",", group: ".",
+		months:      []string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		days:        []string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		shortMonths: []string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		shortDays:   []string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"}},
+	"fr": {decimal: ",", group: "\u202f",
+		months:      []string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		days:        []string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		shortMonths: []string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		shortDays:   []string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."}},
+	"it": {decimal: ",", group: ".",
+		months:      []string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		days:        []string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		shortMonths: []string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		shortDays:   []string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"}},
+	"nl": {decimal: ",", group: ".",
+		months:      []string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		days:        []string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		shortMonths: []string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		shortDays:   []string{"zo", "ma", "di", "wo", "do", "vr", "za"}},
+	"pt": {decimal: ",", group: ".",
+		months:      []string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		days:        []string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		shortMonths: []string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+		shortDays:   []string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."}},
+	"sv": {decimal: ",", group: "\u00a0",
+		months:      []string{"januari", "februari", "mars", "april", "maj", "juni", "juli", "augusti", "september", "oktober", "november", "december"},
+		days:        []string{"söndag", "måndag", "tisdag", "onsdag", "torsdag", "fredag", "lördag"},
+		shortMonths: []string{"jan.", "feb.", "mars", "apr.", "maj", "juni", "juli", "aug.", "sep.", "okt.", "nov.", "dec."},
+		shortDays:   []string{"sön", "mån", "tis", "ons", "tors", "fre", "lör"}},
+}
+
+// ResolveLocale returns the locale whose number and date conventions
+// MailMerge applies for language tag tag, such as "de-DE": the tag itself
+// when it has conventions of its own, such as "de-ch", otherwise its
+// primary language, such as "de", in lowercase. ok is false when neither
+// is supported, in which case the English conventions, "en", are used.
+// An empty tag resolves to "en" with ok true.
+//
+// The supported languages are en, de, es, fr, it, nl, pt and sv, with
+// de-CH for Swiss number grouping. Tags are matched by their text, not by
+// BCP 47 language matching, so "nb" does not fall back to "sv".
+func ResolveLocale(tag string) (resolved string, ok bool) {
+	if tag == "" {
+		return "en", true
+	}
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+	if _, ok := locales[tag]; ok {
+		return tag, true
+	}
+	primary, _, _ := strings.Cut(tag, "-")
+	if _, ok := locales[primary]; ok {
+		return primary, true
+	}
+	return "en", false
+}
+
+// localeFor returns the conventions of language tag lang, as resolved by
+// ResolveLocale.
+func localeFor(lang string) *locale {
+	resolved, _ := ResolveLocale(lang)
+	return locales[resolved]
+}
+
+// lookupLanguage looks language tag lang up in m, whose keys are
+// lowercase tags: the whole tag first, then its primary language.
+func lookupLanguage[V any](m map[string]V, lang string) (V, bool) {
+	lang = strings.ToLower(strings.ReplaceAll(lang, "_", "-"))
+	if v, ok := m[lang]; ok {
+		return v, true
+	}
+	primary, _, _ := strings.Cut(lang, "-")
+	v, ok := m[primary]
+	return v, ok
+}
+
+// monthName returns the name of month m, abbreviated if short.
+func (l *locale) monthName(m time.Month, short bool) string {
+	switch {
+	case short && l.shortMonths != nil:
+		return l.shortMonths[m-1]
+	case short:
+		return m.String()[:3]
+	case l.months != nil:
+		return l.months[m-1]
+	}
+	return m.String()
+}
+
+// dayName returns the name of weekday d, abbreviated if short.
+func (l *locale) dayName(d time.Weekday, short bool) string {
+	switch {
+	case short && l.shortDays != nil:
+		return l.shortDays[d]
+	case short:
+		return d.String()[:3]
+	case l.days != nil:
+		return l.days[d]
+	}
+	return d.String()
+}
@@ -0,0 +1,27 @@
+package docx
+
+import "testing"
+
+func TestResolveLocale(t *testing.T) {
+	tests := []struct {
+		tag, want string
+		ok        bool
+	}{
+		{"", "en", true},
+		{"en-GB", "en", true},
+		{"de-DE", "de", true},
+		{"de_CH", "de-ch", true},
+		{"FR", "fr", true},
+		{"ja-JP", "en", false},
+		{"nb", "en", false},
+	}
+	for _, tt := range tests {
+		got, ok := ResolveLocale(tt.tag)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ResolveLocale(%q) = %q, %v, want %q, %v", tt.tag, got, ok, tt.want, tt.ok)
+		}
+		if localeFor(tt.tag) != locales[tt.want] {
+			t.Errorf("localeFor(%q) is not the %q locale", tt.tag, tt.want)
+		}
+	}
+}
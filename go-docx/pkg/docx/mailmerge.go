@@ -0,0 +1,535 @@
+package docx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// Region delimiters recognised by MailMerge, following the common
+// TableStart/TableEnd convention: MERGEFIELD TableStart:Items and
+// MERGEFIELD TableEnd:Items bracket content repeated once per data row.
+const (
+	regionStartPrefix = "TableStart:"
+	regionEndPrefix   = "TableEnd:"
+)
+
+// Condition delimiters recognised by MailMerge: MERGEFIELD IfStart:Name
+// and MERGEFIELD IfEnd:Name bracket content kept only when the field Name
+// is set, and IfStart:!Name and IfEnd:!Name content kept only when it is
+// not.
+const (
+	conditionStartPrefix = "IfStart:"
+	conditionEndPrefix   = "IfEnd:"
+)
+
+// MailMergeOptions configures MailMerge.
+type MailMergeOptions struct {
+	// SingleOutput merges all records into one document, each record
+	// starting a new section (new page). By default one document is
+	// produced per record.
+	//
+	// In single output, headers and footers are shared and are merged
+	// with the first record.
+	SingleOutput bool
+
+	// Regions returns the rows of the named region for a record. Fields in
+	// a region row are looked up in the row first, then in the record.
+	// When nil, every region repeats over all records, which produces a
+	// list or catalog from a single template.
+	Regions func(record map[string]string, region string) []map[string]string
+
+	// KeepUnmatchedFields leaves MERGEFIELDs whose name is not in the
+	// record in place. By default they are removed.
+	KeepUnmatchedFields bool
+
+	// Locale sets the decimal and grouping separators of \# numeric
+	// pictures and the month and day names of \@ date pictures, as a
+	// BCP 47 tag such as "de-DE", so one template serves several regions.
+	// Empty means English. Languages without conventions of their own
+	// also fall back to English; ResolveLocale reports the locale a tag
+	// resolves to and whether it fell back.
+	Locale string
+}
+
+// MailMerge fills the MERGEFIELD fields of doc with records and returns the
+// merged documents. doc itself is not modified.
+//
+// Field names are matched exactly, then case-insensitively. The \b (text
+// before), \f (text after) and \* Upper/Lower/Caps/FirstCap switches are
+// applied, as are \# numeric pictures such as "#,##0.00" to values that
+// are numbers and \@ date pictures such as "d MMMM yyyy" to values that
+// are dates, in the form "2006-01-02", optionally with a time, or RFC
+// 3339; merged fields are replaced by plain text. Content between
+// TableStart:Name and TableEnd:Name fields is repeated for each row
+// returned by opts.Regions: table rows when both fields are in rows of the
+// same table, otherwise the paragraphs (or runs) spanning them.
+//
+// Content between IfStart:Name and IfEnd:Name fields is removed unless the
+// field Name has a value other than "", "0", "false" or "no"; with
+// IfStart:!Name and IfEnd:!Name it is removed unless it has not. The
+// content is delimited as for regions, so a condition can remove runs,
+// paragraphs, table rows or whole sections, and it is evaluated per row
+// inside regions. The condition fields themselves are removed along with
+// the paragraphs they leave empty.
+//
+// The mail-merge data source settings are removed from the output.
+func MailMerge(doc *Document, records []map[string]string, opts *MailMergeOptions) ([]*Document, error) {
+	if opts == nil {
+		opts = &MailMergeOptions{}
+	}
+	mm := &mailMerger{opts: opts, records: records, locale: localeFor(opts.Locale)}
+	if opts.SingleOutput {
+		out, err := mm.mergeSingle(doc)
+		if err != nil {
+			return nil, err
+		}
+		return []*Document{out}, nil
+	}
+	result := make([]*Document, 0, len(records))
+	for i, rec := range records {
+		out, err := doc.Clone()
+		if err != nil {
+			return nil, fmt.Errorf("docx: mail merge record %d: %w", i, err)
+		}
+		body, err := out.getBody()
+		if err != nil {
+			return nil, err
+		}
+		if err := mm.mergeStory(body.element, []map[string]string{rec}); err != nil {
+			return nil, fmt.Errorf("docx: mail merge record %d: %w", i, err)
+		}
+		if err := mm.finish(out, rec); err != nil {
+			return nil, fmt.Errorf("docx: mail merge record %d: %w", i, err)
+		}
+		result = append(result, out)
+	}
+	return result, nil
+}
+
+type mailMerger struct {
+	opts    *MailMergeOptions
+	records []map[string]string
+	locale  *locale
+}
+
+// mergeSingle merges every record into a copy of doc's body content,
+// separating records with section breaks.
+func (mm *mailMerger) mergeSingle(doc *Document) (*Document, error) {
+	out, err := doc.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("docx: mail merge: %w", err)
+	}
+	body, err := out.getBody()
+	if err != nil {
+		return nil, err
+	}
+	bodyEl := body.element
+
+	var template []*etree.Element
+	var sectPr *etree.Element
+	for _, child := range bodyEl.ChildElements() {
+		if child.Space == "w" && child.Tag == "sectPr" {
+			sectPr = child
+			continue
+		}
+		template = append(template, child)
+		bodyEl.RemoveChild(child)
+	}
+
+	for i, rec := range mm.records {
+		if i > 0 && sectPr != nil {
+			brk := oxml.OxmlElement("w:p")
+			brk.CreateElement("w:pPr").AddChild(sectPr.Copy())
+			body.insertBeforeSectPr(brk)
+		}
+		wrapper := etree.NewElement("wrapper")
+		for _, el := range template {
+			wrapper.AddChild(el.Copy())
+		}
+		if err := mm.mergeStory(wrapper, []map[string]string{rec}); err != nil {
+			return nil, fmt.Errorf("docx: mail merge record %d: %w", i, err)
+		}
+		for _, el := range wrapper.ChildElements() {
+			body.insertBeforeSectPr(el)
+		}
+	}
+
+	var first map[string]string
+	if len(mm.records) > 0 {
+		first = mm.records[0]
+	}
+	if err := mm.finish(out, first); err != nil {
+		return nil, fmt.Errorf("docx: mail merge: %w", err)
+	}
+	return out, nil
+}
+
+// finish merges headers and footers with rec and removes the mail-merge
+// data source settings of out.
+func (mm *mailMerger) finish(out *Document, rec map[string]string) error {
+	hfs, err := out.headerFooterContainers()
+	if err != nil {
+		return err
+	}
+	for _, bic := range hfs {
+		if err := mm.mergeStory(bic.element, []map[string]string{rec}); err != nil {
+			return err
+		}
+	}
+	settings, err := out.Settings()
+	if err != nil {
+		return err
+	}
+	settings.settings.RemoveAll("w:mailMerge")
+	return nil
+}
+
+// mergeStory expands the regions under root and then fills its
+// MERGEFIELDs. scopes are searched innermost first.
+func (mm *mailMerger) mergeStory(root *etree.Element, scopes []map[string]string) error {
+	if err := mm.expandRegions(root, scopes); err != nil {
+		return err
+	}
+	if err := applyConditions(root, scopes); err != nil {
+		return err
+	}
+	for _, span := range oxml.ScanFields(root) {
+		words := splitFieldCode(span.Instruction())
+		if len(words) < 2 || !strings.EqualFold(words[0], "MERGEFIELD") {
+			continue
+		}
+		if span.Simple == nil && span.Separate == nil && span.End == nil {
+			continue
+		}
+		value, ok := lookupMergeValue(scopes, words[1])
+		if !ok && mm.opts.KeepUnmatchedFields {
+			continue
+		}
+		span.SetResult(formatMergeValue(value, words[2:], mm.locale))
+		span.Unlink()
+	}
+	return nil
+}
+
+// expandRegions repeats every TableStart/TableEnd region under root, outer
+// regions first; nested regions are expanded per row.
+func (mm *mailMerger) expandRegions(root *etree.Element, scopes []map[string]string) error {
+	for {
+		spans := oxml.ScanFields(root)
+		start, name := findRegionField(spans, regionStartPrefix, "")
+		if start == nil {
+			return nil
+		}
+		end, _ := findRegionField(spans, regionEndPrefix, name)
+		if end == nil {
+			return fmt.Errorf("region %q has no %s%s field", name, regionEndPrefix, name)
+		}
+		first, last := regionBounds(root, fieldAnchor(start), fieldAnchor(end))
+		if first == nil {
+			return fmt.Errorf("region %q: %s%s precedes %s%s", name, regionEndPrefix, name, regionStartPrefix, name)
+		}
+		// A region within one paragraph repeats the paragraph: the bounds
+		// are runs, which removing the markers may detach.
+		if p := ancestorTag(root, first, "p"); p != nil {
+			first, last = p, p
+		}
+		start.Remove()
+		end.Remove()
+
+		parent := first.Parent()
+		if parent == nil {
+			return fmt.Errorf("region %q: markers enclose no block content", name)
+		}
+		var template []*etree.Element
+		for i := childIndex(parent, first); i <= childIndex(parent, last); i++ {
+			if el, ok := parent.Child[i].(*etree.Element); ok {
+				template = append(template, el)
+			}
+		}
+
+		var rows []map[string]string
+		if mm.opts.Regions != nil {
+			rows = mm.opts.Regions(scopes[0], name)
+		} else {
+			rows = mm.records
+		}
+		for _, row := range rows {
+			wrapper := etree.NewElement("wrapper")
+			for _, el := range template {
+				wrapper.AddChild(el.Copy())
+			}
+			rowScopes := append([]map[string]string{row}, scopes...)
+			if err := mm.mergeStory(wrapper, rowScopes); err != nil {
+				return err
+			}
+			for _, el := range wrapper.ChildElements() {
+				parent.InsertChildAt(childIndex(parent, first), el)
+			}
+		}
+		for _, el := range template {
+			parent.RemoveChild(el)
+		}
+	}
+}
+
+// applyConditions keeps or removes every IfStart/IfEnd condition under
+// root, outer conditions first, looking the fields up in scopes.
+func applyConditions(root *etree.Element, scopes []map[string]string) error {
+	for {
+		spans := oxml.ScanFields(root)
+		start, name := findRegionField(spans, conditionStartPrefix, "")
+		if start == nil {
+			return nil
+		}
+		end, _ := findRegionField(spans, conditionEndPrefix, name)
+		if end == nil {
+			return fmt.Errorf("condition %q has no %s%s field", name, conditionEndPrefix, name)
+		}
+		first, last := regionBounds(root, fieldAnchor(start), fieldTail(end))
+		if first == nil {
+			return fmt.Errorf("condition %q: %s%s precedes %s%s", name, conditionEndPrefix, name, conditionStartPrefix, name)
+		}
+
+		if mergeCondition(scopes, name) {
+			startP := ancestorTag(root, fieldAnchor(start), "p")
+			endP := ancestorTag(root, fieldTail(end), "p")
+			start.Remove()
+			end.Remove()
+			removeMarkerParagraph(startP)
+			removeMarkerParagraph(endP)
+			continue
+		}
+
+		parent := first.Parent()
+		var doomed []*etree.Element
+		for i := childIndex(parent, first); i <= childIndex(parent, last); i++ {
+			if el, ok := parent.Child[i].(*etree.Element); ok {
+				doomed = append(doomed, el)
+			}
+		}
+		for _, el := range doomed {
+			parent.RemoveChild(el)
+		}
+		switch {
+		case parent.Space == "w" && parent.Tag == "tbl" && parent.SelectElement("w:tr") == nil:
+			parent.Parent().RemoveChild(parent)
+		case parent.Space == "w" && parent.Tag == "tc" && parent.SelectElement("w:p") == nil:
+			parent.AddChild(oxml.OxmlElement("w:p"))
+		}
+	}
+}
+
+// mergeCondition reports whether the condition on field name, negated by
+// a leading "!", holds in scopes.
+func mergeCondition(scopes []map[string]string, name string) bool {
+	negate := strings.HasPrefix(name, "!")
+	value, _ := lookupMergeValue(scopes, strings.TrimPrefix(name, "!"))
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "0", "false", "no":
+		return negate
+	}
+	return !negate
+}
+
+// removeMarkerParagraph removes paragraph p when removing the condition
+// fields left it empty, unless it ends a table cell.
+func removeMarkerParagraph(p *etree.Element) {
+	if p == nil || p.Parent() == nil || !isEmptyParagraph(p) {
+		return
+	}
+	parent := p.Parent()
+	if parent.Space == "w" && parent.Tag == "tc" {
+		if children := parent.ChildElements(); children[len(children)-1] == p {
+			return
+		}
+	}
+	parent.RemoveChild(p)
+}
+
+// findRegionField returns the first MERGEFIELD whose name starts with
+// prefix (and continues with name, when name is non-empty), along with the
+// region name.
+func findRegionField(spans []*oxml.FieldSpan, prefix, name string) (*oxml.FieldSpan, string) {
+	for _, span := range spans {
+		words := splitFieldCode(span.Instruction())
+		if len(words) < 2 || !strings.EqualFold(words[0], "MERGEFIELD") {
+			continue
+		}
+		arg := words[1]
+		if len(arg) <= len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+			continue
+		}
+		if name == "" || arg[len(prefix):] == name {
+			return span, arg[len(prefix):]
+		}
+	}
+	return nil, ""
+}
+
+// fieldAnchor returns the element marking the start of a field.
+func fieldAnchor(span *oxml.FieldSpan) *etree.Element {
+	if span.Simple != nil {
+		return span.Simple
+	}
+	return span.Begin
+}
+
+// fieldTail returns the element marking the end of a field.
+func fieldTail(span *oxml.FieldSpan) *etree.Element {
+	switch {
+	case span.Simple != nil:
+		return span.Simple
+	case span.End != nil:
+		return span.End
+	}
+	return span.Begin
+}
+
+// regionBounds returns the first and last sibling elements spanning a and
+// b: the table rows containing them when both are in rows of the same
+// table but not in the same cell, otherwise the children of their closest
+// common ancestor. Returns nils when b precedes a.
+func regionBounds(root, a, b *etree.Element) (*etree.Element, *etree.Element) {
+	ra, rb := ancestorTag(root, a, "tr"), ancestorTag(root, b, "tr")
+	sameCell := ra == rb && ancestorTag(root, a, "tc") == ancestorTag(root, b, "tc")
+	if ra != nil && rb != nil && ra.Parent() == rb.Parent() && !sameCell {
+		a, b = ra, rb
+	} else {
+		pathA, pathB := ancestorPath(root, a), ancestorPath(root, b)
+		i := 0
+		for i < len(pathA) && i < len(pathB) && pathA[i] == pathB[i] {
+			i++
+		}
+		if i == len(pathA) || i == len(pathB) {
+			return nil, nil
+		}
+		a, b = pathA[i], pathB[i]
+	}
+	parent := a.Parent()
+	if childIndex(parent, a) > childIndex(parent, b) {
+		return nil, nil
+	}
+	return a, b
+}
+
+// ancestorTag returns the nearest w:<tag> ancestor of el below root.
+func ancestorTag(root, el *etree.Element, tag string) *etree.Element {
+	for p := el.Parent(); p != nil && p != root; p = p.Parent() {
+		if p.Space == "w" && p.Tag == tag {
+			return p
+		}
+	}
+	return nil
+}
+
+// ancestorPath returns the ancestors of el below root, outermost first,
+// ending with el itself.
+func ancestorPath(root, el *etree.Element) []*etree.Element {
+	var path []*etree.Element
+	for e := el; e != nil && e != root; e = e.Parent() {
+		path = append([]*etree.Element{e}, path...)
+	}
+	return path
+}
+
+// childIndex returns the index of child in parent's tokens, or -1.
+func childIndex(parent, child *etree.Element) int {
+	for i, tok := range parent.Child {
+		if tok == etree.Token(child) {
+			return i
+		}
+	}
+	return -1
+}
+
+// lookupMergeValue finds name in scopes, innermost first: exactly, then
+// case-insensitively.
+func lookupMergeValue(scopes []map[string]string, name string) (string, bool) {
+	for _, scope := range scopes {
+		if v, ok := scope[name]; ok {
+			return v, true
+		}
+	}
+	for _, scope := range scopes {
+		for k, v := range scope {
+			if strings.EqualFold(k, name) {
+				return v, true
+			}
+		}
+	}
+	return "", false
+}
+
+// mergeDateLayouts are the forms of the dates \@ pictures format.
+var mergeDateLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// formatMergeValue applies the MERGEFIELD switches to value, formatting
+// numbers and dates with the conventions of loc.
+func formatMergeValue(value string, switches []string, loc *locale) string {
+	var before, after string
+	for i := 0; i < len(switches); i++ {
+		sw := switches[i]
+		if i+1 >= len(switches) {
+			break
+		}
+		switch strings.ToLower(sw) {
+		case `\b`:
+			i++
+			before = switches[i]
+		case `\f`:
+			i++
+			after = switches[i]
+		case `\*`:
+			i++
+			value = applyCaseFormat(value, switches[i])
+		case `\#`:
+			i++
+			if v, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+				value = formatNumberPicture(v, switches[i], loc)
+			}
+		case `\@`:
+			i++
+			for _, layout := range mergeDateLayouts {
+				if t, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+					value = formatDatePicture(t, switches[i], loc)
+					break
+				}
+			}
+		}
+	}
+	if value == "" {
+		return ""
+	}
+	return before + value + after
+}
+
+// applyCaseFormat applies a \* general formatting switch.
+func applyCaseFormat(value, format string) string {
+	switch strings.ToLower(format) {
+	case "upper":
+		return strings.ToUpper(value)
+	case "lower":
+		return strings.ToLower(value)
+	case "firstcap":
+		r := []rune(value)
+		if len(r) > 0 {
+			r[0] = unicode.ToUpper(r[0])
+		}
+		return string(r)
+	case "caps":
+		words := strings.Fields(value)
+		for i, w := range words {
+			r := []rune(w)
+			r[0] = unicode.ToUpper(r[0])
+			words[i] = string(r)
+		}
+		return strings.Join(words, " ")
+	}
+	return value
+}
@@ -0,0 +1,361 @@
+package docx
+
+import (
+	"strings"
+	"testing"
+)
+
+func mustAddMergeField(t *testing.T, p *Paragraph, instr string) {
+	t.Helper()
+	if _, err := p.AddField(instr, "«"+instr+"»"); err != nil {
+		t.Fatalf("AddField: %v", err)
+	}
+}
+
+func newMergeTemplate(t *testing.T) *Document {
+	t.Helper()
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("Dear ")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	mustAddMergeField(t, p, `MERGEFIELD "First Name" \* Upper`)
+	mustAddMergeField(t, p, `MERGEFIELD Title \b " (" \f ")"`)
+
+	tbl, err := doc.AddTable(2, 2)
+	if err != nil {
+		t.Fatalf("AddTable: %v", err)
+	}
+	c0, _ := tbl.CellAt(1, 0)
+	c1, _ := tbl.CellAt(1, 1)
+	p0 := c0.Paragraphs()[0]
+	mustAddMergeField(t, p0, "MERGEFIELD TableStart:Items")
+	mustAddMergeField(t, p0, "MERGEFIELD Item")
+	p1 := c1.Paragraphs()[0]
+	mustAddMergeField(t, p1, "MERGEFIELD Qty")
+	mustAddMergeField(t, p1, "MERGEFIELD TableEnd:Items")
+	return doc
+}
+
+func TestMailMerge_PerRecordWithRegions(t *testing.T) {
+	doc := newMergeTemplate(t)
+	records := []map[string]string{
+		{"First Name": "ada", "Title": "Dr"},
+		{"first name": "bob"},
+	}
+	items := map[string][]map[string]string{
+		"ada": {{"Item": "Pen", "Qty": "2"}, {"Item": "Ink", "Qty": "1"}},
+	}
+	docs, err := MailMerge(doc, records, &MailMergeOptions{
+		Regions: func(rec map[string]string, region string) []map[string]string {
+			if region != "Items" {
+				t.Errorf("unexpected region %q", region)
+			}
+			return items[rec["First Name"]]
+		},
+	})
+	if err != nil {
+		t.Fatalf("MailMerge: %v", err)
+	}
+	if len(docs) != 2 {
+		t.Fatalf("expected 2 documents, got %d", len(docs))
+	}
+
+	paras := mustParagraphs(t, docs[0])
+	if got := paras[len(paras)-1].Text(); got != "Dear ADA (Dr)" {
+		t.Errorf("record 0 text = %q", got)
+	}
+	tables, _ := docs[0].Tables()
+	if n := len(tables[0].Rows().Iter()); n != 3 {
+		t.Fatalf("record 0: expected 3 rows, got %d", n)
+	}
+	c, _ := tables[0].CellAt(2, 0)
+	if got := c.Text(); got != "Ink" {
+		t.Errorf("row 2 item = %q, want Ink", got)
+	}
+	if fields, _ := docs[0].Fields(); len(fields) != 0 {
+		t.Errorf("expected no fields left, got %d", len(fields))
+	}
+
+	paras = mustParagraphs(t, docs[1])
+	if got := paras[len(paras)-1].Text(); got != "Dear BOB" {
+		t.Errorf("record 1 text = %q", got)
+	}
+	tables, _ = docs[1].Tables()
+	if n := len(tables[0].Rows().Iter()); n != 1 {
+		t.Errorf("record 1: expected only the header row, got %d", n)
+	}
+
+	// The template is untouched.
+	if fields, _ := doc.Fields(); len(fields) != 6 {
+		t.Errorf("template fields = %d, want 6", len(fields))
+	}
+}
+
+func TestMailMerge_SingleOutput(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("Hello ")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	mustAddMergeField(t, p, "MERGEFIELD Name")
+	mustAddMergeField(t, p, "MERGEFIELD Missing")
+
+	docs, err := MailMerge(doc, []map[string]string{{"Name": "A"}, {"Name": "B"}, {"Name": "C"}},
+		&MailMergeOptions{SingleOutput: true, KeepUnmatchedFields: true})
+	if err != nil {
+		t.Fatalf("MailMerge: %v", err)
+	}
+	if len(docs) != 1 {
+		t.Fatalf("expected 1 document, got %d", len(docs))
+	}
+	var texts []string
+	for _, p := range mustParagraphs(t, docs[0]) {
+		if s := p.Text(); strings.HasPrefix(s, "Hello") {
+			texts = append(texts, s)
+		}
+	}
+	want := "Hello A«MERGEFIELD Missing»|Hello B«MERGEFIELD Missing»|Hello C«MERGEFIELD Missing»"
+	if got := strings.Join(texts, "|"); got != want {
+		t.Errorf("merged = %q, want %q", got, want)
+	}
+	if n := len(docs[0].Sections().Iter()); n != 3 {
+		t.Errorf("expected 3 sections, got %d", n)
+	}
+	fields, _ := docs[0].Fields()
+	if len(fields) != 3 {
+		t.Errorf("expected 3 unmatched fields kept, got %d", len(fields))
+	}
+}
+
+func TestMailMerge_DoesNotRunSaveHook(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("Hello ")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	mustAddMergeField(t, p, "MERGEFIELD Name")
+	calls := 0
+	doc.SetHooks(&Hooks{OnSave: func(*Document) error {
+		calls++
+		return nil
+	}})
+
+	records := []map[string]string{{"Name": "A"}, {"Name": "B"}}
+	for _, single := range []bool{false, true} {
+		if _, err := MailMerge(doc, records, &MailMergeOptions{SingleOutput: single}); err != nil {
+			t.Fatalf("MailMerge(SingleOutput: %v): %v", single, err)
+		}
+	}
+	if calls != 0 {
+		t.Errorf("OnSave called %d times, want 0", calls)
+	}
+}
+
+func TestMailMerge_UnterminatedRegion(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, _ := doc.AddParagraph("")
+	mustAddMergeField(t, p, "MERGEFIELD TableStart:X")
+	if _, err := MailMerge(doc, []map[string]string{{}}, nil); err == nil {
+		t.Error("expected error for region without TableEnd")
+	}
+}
+
+func TestMailMerge_SingleParagraphRegion(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("- ")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	mustAddMergeField(t, p, "MERGEFIELD TableStart:Items")
+	mustAddMergeField(t, p, "MERGEFIELD Item")
+	mustAddMergeField(t, p, "MERGEFIELD TableEnd:Items")
+
+	docs, err := MailMerge(doc, []map[string]string{{}}, &MailMergeOptions{
+		Regions: func(map[string]string, string) []map[string]string {
+			return []map[string]string{{"Item": "Pen"}, {"Item": "Ink"}}
+		},
+	})
+	if err != nil {
+		t.Fatalf("MailMerge: %v", err)
+	}
+	var texts []string
+	for _, p := range mustParagraphs(t, docs[0]) {
+		if s := p.Text(); s != "" {
+			texts = append(texts, s)
+		}
+	}
+	if got, want := strings.Join(texts, "|"), "- Pen|- Ink"; got != want {
+		t.Errorf("merged = %q, want %q", got, want)
+	}
+}
+
+func TestMailMerge_Conditions(t *testing.T) {
+	doc := mustNewDoc(t)
+	addParagraph := func(text string, fields ...string) *Paragraph {
+		p, err := doc.AddParagraph(text)
+		if err != nil {
+			t.Fatalf("AddParagraph: %v", err)
+		}
+		for _, f := range fields {
+			mustAddMergeField(t, p, f)
+		}
+		return p
+	}
+	addParagraph("Intro")
+	addParagraph("", "MERGEFIELD IfStart:Discount")
+	addParagraph("Discount: ", "MERGEFIELD Discount")
+	addParagraph("", "MERGEFIELD IfEnd:Discount")
+	p := addParagraph("Delivery ", "MERGEFIELD IfStart:!Rush")
+	if _, err := p.AddRun("in 30 days"); err != nil {
+		t.Fatalf("AddRun: %v", err)
+	}
+	mustAddMergeField(t, p, "MERGEFIELD IfEnd:!Rush")
+	tbl, err := doc.AddTable(3, 1)
+	if err != nil {
+		t.Fatalf("AddTable: %v", err)
+	}
+	c0, _ := tbl.CellAt(1, 0)
+	c1, _ := tbl.CellAt(2, 0)
+	mustAddMergeField(t, c0.Paragraphs()[0], "MERGEFIELD IfStart:VIP")
+	mustAddMergeField(t, c1.Paragraphs()[0], "MERGEFIELD IfEnd:VIP")
+
+	docs, err := MailMerge(doc, []map[string]string{
+		{"Discount": "10%", "Rush": "yes", "VIP": ""},
+		{"Discount": "", "Rush": "no", "VIP": "1"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("MailMerge: %v", err)
+	}
+	wants := []struct {
+		texts []string
+		rows  int
+	}{
+		{[]string{"Intro", "Discount: 10%", "Delivery "}, 1},
+		{[]string{"Intro", "Delivery in 30 days"}, 3},
+	}
+	for i, want := range wants {
+		if got := bodyTexts(t, docs[i]); strings.Join(got, "|") != strings.Join(want.texts, "|") {
+			t.Errorf("record %d: paragraphs = %q, want %q", i, got, want.texts)
+		}
+		tables, err := docs[i].Tables()
+		if err != nil {
+			t.Fatalf("Tables: %v", err)
+		}
+		if got := tables[0].Rows().Len(); got != want.rows {
+			t.Errorf("record %d: %d rows, want %d", i, got, want.rows)
+		}
+		if fields, _ := docs[i].Fields(); len(fields) != 0 {
+			t.Errorf("record %d: %d fields left", i, len(fields))
+		}
+	}
+}
+
+func TestMailMerge_ConditionInCell(t *testing.T) {
+	doc := mustNewDoc(t)
+	tbl, err := doc.AddTable(1, 2)
+	if err != nil {
+		t.Fatalf("AddTable: %v", err)
+	}
+	c0, _ := tbl.CellAt(0, 0)
+	c1, _ := tbl.CellAt(0, 1)
+	p := c0.Paragraphs()[0]
+	if _, err := p.AddRun("Name "); err != nil {
+		t.Fatalf("AddRun: %v", err)
+	}
+	mustAddMergeField(t, p, "MERGEFIELD IfStart:Title")
+	mustAddMergeField(t, p, "MERGEFIELD Title")
+	if _, err := p.AddRun(" "); err != nil {
+		t.Fatalf("AddRun: %v", err)
+	}
+	mustAddMergeField(t, p, "MERGEFIELD IfEnd:Title")
+	mustAddMergeField(t, p, "MERGEFIELD Name")
+	c1.SetText("unrelated")
+
+	docs, err := MailMerge(doc, []map[string]string{
+		{"Title": "", "Name": "Ann"},
+		{"Title": "Dr", "Name": "Bo"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("MailMerge: %v", err)
+	}
+	for i, want := range []string{"Name Ann", "Name Dr Bo"} {
+		tables, err := docs[i].Tables()
+		if err != nil {
+			t.Fatalf("Tables: %v", err)
+		}
+		if got := tables[0].Rows().Len(); got != 1 {
+			t.Fatalf("record %d: %d rows, want 1", i, got)
+		}
+		cell, _ := tables[0].CellAt(0, 0)
+		if got := cell.Text(); got != want {
+			t.Errorf("record %d: cell (0,0) = %q, want %q", i, got, want)
+		}
+		cell, _ = tables[0].CellAt(0, 1)
+		if got := cell.Text(); got != "unrelated" {
+			t.Errorf("record %d: cell (0,1) = %q, want %q", i, got, "unrelated")
+		}
+	}
+}
+
+func TestMailMerge_UnterminatedCondition(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, _ := doc.AddParagraph("")
+	mustAddMergeField(t, p, "MERGEFIELD IfStart:X")
+	if _, err := MailMerge(doc, []map[string]string{{}}, nil); err == nil {
+		t.Error("expected error for condition without IfEnd")
+	}
+}
+
+func TestFormatNumberPicture(t *testing.T) {
+	en, de := localeFor("en-US"), localeFor("de-DE")
+	tests := []struct {
+		v       float64
+		picture string
+		loc     *locale
+		want    string
+	}{
+		{1234567.891, "#,##0.00", en, "1,234,567.89"},
+		{1234567.891, "#,##0.00", de, "1.234.567,89"},
+		{1234.5, "$#,##0.00", en, "$1,234.50"},
+		{-1234.5, "#,##0.00", en, "-1,234.50"},
+		{-1234.5, "#,##0.00;(#,##0.00)", en, "(1,234.50)"},
+		{0, "#,##0;-#,##0;'nil'", en, "nil"},
+		{0.5, "0", en, "1"},
+		{7, "000", en, "007"},
+		{2.5, "0.##", en, "2.5"},
+		{12, "0 'pcs'", en, "12 pcs"},
+		{-0.001, "0.00", en, "0.00"},
+	}
+	for _, tt := range tests {
+		if got := formatNumberPicture(tt.v, tt.picture, tt.loc); got != tt.want {
+			t.Errorf("formatNumberPicture(%v, %q) = %q, want %q", tt.v, tt.picture, got, tt.want)
+		}
+	}
+}
+
+func TestMailMerge_NumberAndDatePictures(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	mustAddMergeField(t, p, `MERGEFIELD Amount \# "#,##0.00"`)
+	mustAddMergeField(t, p, `MERGEFIELD Due \@ "d MMMM yyyy" \b " / "`)
+	mustAddMergeField(t, p, `MERGEFIELD Note \# "0.00"`)
+
+	rec := map[string]string{"Amount": "1234.5", "Due": "2024-03-05", "Note": "n/a"}
+	for _, tt := range []struct{ locale, want string }{
+		{"", "1,234.50 / 5 March 2024n/a"},
+		{"de-DE", "1.234,50 / 5 März 2024n/a"},
+		{"fr-FR", "1\u202f234,50 / 5 mars 2024n/a"},
+	} {
+		docs, err := MailMerge(doc, []map[string]string{rec}, &MailMergeOptions{Locale: tt.locale})
+		if err != nil {
+			t.Fatalf("MailMerge: %v", err)
+		}
+		if got := mustParagraphs(t, docs[0])[0].Text(); got != tt.want {
+			t.Errorf("%q: merged = %q, want %q", tt.locale, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+func TestDocument_CompactMedia(t *testing.T) {
+	doc := mustNewDoc(t)
+	addPic := func() *InlineShape {
+		t.Helper()
+		p, err := doc.AddParagraph("")
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := p.AddRun("")
+		if err != nil {
+			t.Fatal(err)
+		}
+		shape, err := r.AddPicture(bytes.NewReader(minimalPNG()), nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return shape
+	}
+	addPic()
+	second := addPic()
+
+	// Simulate content merged from another template: a second copy of the
+	// same image used by the second picture, and an unreferenced third copy.
+	pkg := doc.wmlPkg.OpcPackage
+	dup := parts.NewImagePart("/word/media/image8.png", "image/png", minimalPNG(), pkg)
+	orphan := parts.NewImagePart("/word/media/image9.png", "image/png", minimalPNG(), pkg)
+	pkg.AddPart(dup)
+	pkg.AddPart(orphan)
+	rels := doc.part.Rels()
+	second.blip().CreateAttr("r:embed", rels.GetOrAdd(opc.RTImage, dup).RID)
+	rels.GetOrAdd(opc.RTImage, orphan)
+
+	stats, err := doc.CompactMedia()
+	if err != nil {
+		t.Fatalf("CompactMedia: %v", err)
+	}
+	want := parts.MediaStats{
+		DuplicatesMerged: 1, // dup; the orphan copy is removed, not merged
+		RelsRemoved:      1,
+		PartsRemoved:     2,
+		BytesSaved:       int64(2 * len(minimalPNG())),
+	}
+	if stats != want {
+		t.Errorf("CompactMedia() = %+v, want %+v", stats, want)
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	images := 0
+	for _, part := range doc2.wmlPkg.Parts() {
+		if _, ok := part.(*parts.ImagePart); ok {
+			images++
+		}
+	}
+	if images != 1 {
+		t.Errorf("image parts after compaction = %d, want 1", images)
+	}
+	var pics []*InlineShape
+	for _, p := range mustParagraphs(t, doc2) {
+		for _, r := range p.Runs() {
+			pics = append(pics, r.Pictures()...)
+		}
+	}
+	if len(pics) != 2 {
+		t.Fatalf("pictures = %d, want 2", len(pics))
+	}
+	for i, pic := range pics {
+		if data, err := pic.ImageBytes(); err != nil || !bytes.Equal(data, minimalPNG()) {
+			t.Errorf("picture %d: image not resolvable after compaction: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package docx
+
+import (
+	"slices"
+	"strings"
+)
+
+// The standard colors of Word's color picker, the row below the theme
+// colors.
+var (
+	ColorDarkRed    = RGBColor{0xC0, 0x00, 0x00}
+	ColorRed        = RGBColor{0xFF, 0x00, 0x00}
+	ColorOrange     = RGBColor{0xFF, 0xC0, 0x00}
+	ColorYellow     = RGBColor{0xFF, 0xFF, 0x00}
+	ColorLightGreen = RGBColor{0x92, 0xD0, 0x50}
+	ColorGreen      = RGBColor{0x00, 0xB0, 0x50}
+	ColorLightBlue  = RGBColor{0x00, 0xB0, 0xF0}
+	ColorBlue       = RGBColor{0x00, 0x70, 0xC0}
+	ColorDarkBlue   = RGBColor{0x00, 0x20, 0x60}
+	ColorPurple     = RGBColor{0x70, 0x30, 0xA0}
+	ColorBlack      = RGBColor{0x00, 0x00, 0x00}
+	ColorWhite      = RGBColor{0xFF, 0xFF, 0xFF}
+)
+
+// namedColors maps the names ColorByName knows, lowercase without spaces,
+// to their colors: Word's standard colors, then the CSS basic colors a
+// Word name does not already cover.
+var namedColors = map[string]RGBColor{
+	"darkred":    ColorDarkRed,
+	"red":        ColorRed,
+	"orange":     ColorOrange,
+	"yellow":     ColorYellow,
+	"lightgreen": ColorLightGreen,
+	"green":      ColorGreen,
+	"lightblue":  ColorLightBlue,
+	"blue":       ColorBlue,
+	"darkblue":   ColorDarkBlue,
+	"purple":     ColorPurple,
+	"black":      ColorBlack,
+	"white":      ColorWhite,
+	"silver":     {0xC0, 0xC0, 0xC0},
+	"gray":       {0x80, 0x80, 0x80},
+	"grey":       {0x80, 0x80, 0x80},
+	"maroon":     {0x80, 0x00, 0x00},
+	"olive":      {0x80, 0x80, 0x00},
+	"lime":       {0x00, 0xFF, 0x00},
+	"aqua":       {0x00, 0xFF, 0xFF},
+	"cyan":       {0x00, 0xFF, 0xFF},
+	"teal":       {0x00, 0x80, 0x80},
+	"navy":       {0x00, 0x00, 0x80},
+	"fuchsia":    {0xFF, 0x00, 0xFF},
+	"magenta":    {0xFF, 0x00, 0xFF},
+}
+
+// ColorByName returns the color with the given name, ignoring case and
+// spaces: one of Word's standard colors ("Dark Red", "Light Blue", ...),
+// as in the Color variables, or a CSS basic color ("navy", "teal", ...).
+// Where both define a name, such as "green", Word's color is returned.
+func ColorByName(name string) (RGBColor, bool) {
+	c, ok := namedColors[strings.ToLower(strings.ReplaceAll(name, " ", ""))]
+	return c, ok
+}
+
+// ColorNames returns the names ColorByName knows, sorted.
+func ColorNames() []string {
+	names := make([]string, 0, len(namedColors))
+	for name := range namedColors {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
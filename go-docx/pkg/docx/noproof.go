@@ -0,0 +1,74 @@
+package docx
+
+import (
+	"regexp"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// SetNoProof turns spelling and grammar checking off for all the text of
+// the paragraph, including text typed later at its end, or, with false,
+// removes that setting so the text is checked unless its style says
+// otherwise. It suits code blocks, part numbers and other text that is
+// not prose.
+func (para *Paragraph) SetNoProof(v bool) error {
+	p := para.p.RawElement()
+	for _, r := range p.FindElements(".//w:r") {
+		if err := setRunNoProof(r, v); err != nil {
+			return err
+		}
+	}
+	if !v {
+		if rPr := p.FindElement("w:pPr/w:rPr"); rPr != nil {
+			return setRPrNoProof(rPr, false)
+		}
+		return nil
+	}
+	return setRPrNoProof(paragraphMarkRPr(p), true)
+}
+
+// MarkRegionNoProof turns spelling and grammar checking off for every
+// match of re in the paragraphs of the document body, tables included.
+// Runs are split where a match starts or ends inside them, so the text
+// around the match is still checked. Each paragraph is matched on its own.
+// It returns the number of matches.
+func (d *Document) MarkRegionNoProof(re *regexp.Regexp) (int, error) {
+	b, err := d.getBody()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, p := range b.element.FindElements(".//w:p") {
+		for _, m := range re.FindAllStringIndex(oxml.InlineText(p), -1) {
+			runs := oxml.IsolateRuns(p, m[0], m[1])
+			if len(runs) == 0 {
+				continue
+			}
+			for _, r := range runs {
+				if err := setRunNoProof(r, true); err != nil {
+					return count, err
+				}
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// setRunNoProof sets or removes the noProof property of run r.
+func setRunNoProof(r *etree.Element, v bool) error {
+	if !v && r.SelectElement("w:rPr") == nil {
+		return nil
+	}
+	return setRPrNoProof((&oxml.CT_R{Element: oxml.WrapElement(r)}).GetOrAddRPr().RawElement(), v)
+}
+
+// setRPrNoProof sets or removes the noProof property of rPr.
+func setRPrNoProof(rPr *etree.Element, v bool) error {
+	var val *bool
+	if v {
+		val = &v
+	}
+	return (&oxml.CT_RPr{Element: oxml.WrapElement(rPr)}).SetNoProofVal(val)
+}
@@ -0,0 +1,66 @@
+package docx
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestParagraph_SetNoProof(t *testing.T) {
+	d := mustNewDoc(t)
+	p, err := d.AddParagraph("fmt.Println(x)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.AddRun(" // comment"); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetNoProof(true); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range p.Runs() {
+		if v := r.Font().NoProof(); v == nil || !*v {
+			t.Errorf("run %q not marked noProof", r.Text())
+		}
+	}
+	if p.p.RawElement().FindElement("w:pPr/w:rPr/w:noProof") == nil {
+		t.Error("paragraph mark not marked noProof")
+	}
+	if err := p.SetNoProof(false); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.p.RawElement().FindElements(".//w:noProof")) != 0 {
+		t.Error("noProof left after SetNoProof(false)")
+	}
+}
+
+func TestDocument_MarkRegionNoProof(t *testing.T) {
+	d := mustNewDoc(t)
+	p, err := d.AddParagraph("Order XK-2291 and XK-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.AddRun("7 today"); err != nil {
+		t.Fatal(err)
+	}
+	n, err := d.MarkRegionNoProof(regexp.MustCompile(`XK-\d+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("got %d matches, want 2", n)
+	}
+	var marked, plain string
+	for _, r := range p.Runs() {
+		if v := r.Font().NoProof(); v != nil && *v {
+			marked += r.Text() + "|"
+		} else {
+			plain += r.Text() + "|"
+		}
+	}
+	if marked != "XK-2291|XK-|7|" || plain != "Order | and | today|" {
+		t.Errorf("marked %q, plain %q", marked, plain)
+	}
+	if p.Text() != "Order XK-2291 and XK-7 today" {
+		t.Errorf("text changed to %q", p.Text())
+	}
+}
@@ -0,0 +1,416 @@
+package docx
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// --------------------------------------------------------------------------
+// Note numbering and placement
+// --------------------------------------------------------------------------
+
+// NotePosition is where footnotes or endnotes are placed.
+type NotePosition int
+
+const (
+	// NotePositionPageBottom puts footnotes at the bottom of the page.
+	NotePositionPageBottom NotePosition = iota
+	// NotePositionBeneathText puts footnotes right below the last line of
+	// text on the page.
+	NotePositionBeneathText
+	// NotePositionSectionEnd puts notes at the end of the section.
+	NotePositionSectionEnd
+	// NotePositionDocumentEnd puts notes at the end of the document.
+	NotePositionDocumentEnd
+)
+
+// notePositionNames are the ST_FtnPos and ST_EdnPos values, indexed by
+// NotePosition.
+var notePositionNames = [...]string{"pageBottom", "beneathText", "sectEnd", "docEnd"}
+
+// String returns the XML value of the position.
+func (p NotePosition) String() string {
+	if p < 0 || int(p) >= len(notePositionNames) {
+		return fmt.Sprintf("NotePosition(%d)", int(p))
+	}
+	return notePositionNames[p]
+}
+
+// NoteRestart is when note numbering starts over.
+type NoteRestart int
+
+const (
+	// NoteRestartContinuous numbers notes through the document.
+	NoteRestartContinuous NoteRestart = iota
+	// NoteRestartEachSection restarts numbering in each section.
+	NoteRestartEachSection
+	// NoteRestartEachPage restarts numbering on each page.
+	NoteRestartEachPage
+)
+
+// noteRestartNames are the ST_RestartNumber values, indexed by NoteRestart.
+var noteRestartNames = [...]string{"continuous", "eachSect", "eachPage"}
+
+// String returns the XML value of the restart rule.
+func (r NoteRestart) String() string {
+	if r < 0 || int(r) >= len(noteRestartNames) {
+		return fmt.Sprintf("NoteRestart(%d)", int(r))
+	}
+	return noteRestartNames[r]
+}
+
+// NoteProperties is how footnotes or endnotes are numbered and placed, as
+// in Word's Footnote and Endnote dialog. Unset fields are inherited: from
+// the document settings for a section, and from Word's defaults for the
+// settings.
+type NoteProperties struct {
+	// Format is the number format of the reference marks: "decimal",
+	// "lowerRoman", "upperRoman", "lowerLetter", "upperLetter", "chicago"
+	// (*, †, ‡, §) or another ST_NumberFormat value. Empty means unset.
+	Format string
+	// Start is the number of the first note; 0 means unset.
+	Start int
+	// Restart is when numbering starts over; nil means unset.
+	Restart *NoteRestart
+	// Position is where the notes are placed; nil means unset. Footnotes
+	// take PageBottom or BeneathText, endnotes SectionEnd or DocumentEnd.
+	Position *NotePosition
+}
+
+// noteProps reads the numbering children of a w:footnotePr or w:endnotePr.
+func noteProps(pr *etree.Element) (*NoteProperties, error) {
+	np := &NoteProperties{}
+	if el := pr.SelectElement("w:numFmt"); el != nil {
+		np.Format = el.SelectAttrValue("w:val", "")
+	}
+	if el := pr.SelectElement("w:numStart"); el != nil {
+		v := el.SelectAttrValue("w:val", "")
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("docx: invalid note numbering start %q", v)
+		}
+		np.Start = n
+	}
+	if el := pr.SelectElement("w:numRestart"); el != nil {
+		v := el.SelectAttrValue("w:val", "")
+		r := NoteRestart(-1)
+		for i, name := range noteRestartNames {
+			if name == v {
+				r = NoteRestart(i)
+			}
+		}
+		if r < 0 {
+			return nil, fmt.Errorf("docx: invalid note numbering restart %q", v)
+		}
+		np.Restart = &r
+	}
+	if el := pr.SelectElement("w:pos"); el != nil {
+		v := el.SelectAttrValue("w:val", "")
+		p := NotePosition(-1)
+		for i, name := range notePositionNames {
+			if name == v {
+				p = NotePosition(i)
+			}
+		}
+		if p < 0 {
+			return nil, fmt.Errorf("docx: invalid note position %q", v)
+		}
+		np.Position = &p
+	}
+	return np, nil
+}
+
+// setNoteProps replaces the numbering children of pr with those of np,
+// keeping any other children, such as the separator references of the
+// settings. tag is "footnote" or "endnote".
+func setNoteProps(pr *etree.Element, np *NoteProperties, tag string) error {
+	if np.Start < 0 {
+		return fmt.Errorf("docx: negative %s numbering start %d", tag, np.Start)
+	}
+	if np.Restart != nil && (*np.Restart < 0 || int(*np.Restart) >= len(noteRestartNames)) {
+		return fmt.Errorf("docx: invalid %s numbering restart %v", tag, *np.Restart)
+	}
+	if p := np.Position; p != nil {
+		valid := *p == NotePositionSectionEnd || *p == NotePositionDocumentEnd
+		if tag == "footnote" {
+			valid = *p == NotePositionPageBottom || *p == NotePositionBeneathText
+		}
+		if !valid {
+			return fmt.Errorf("docx: %s cannot be placed at %v", tag, *p)
+		}
+	}
+	for _, name := range []string{"w:pos", "w:numFmt", "w:numStart", "w:numRestart"} {
+		if el := pr.SelectElement(name); el != nil {
+			pr.RemoveChild(el)
+		}
+	}
+	var children []*etree.Element
+	add := func(name, val string) {
+		el := oxml.OxmlElement(name)
+		el.CreateAttr("w:val", val)
+		children = append(children, el)
+	}
+	if np.Position != nil {
+		add("w:pos", np.Position.String())
+	}
+	if np.Format != "" {
+		add("w:numFmt", np.Format)
+	}
+	if np.Start != 0 {
+		add("w:numStart", strconv.Itoa(np.Start))
+	}
+	if np.Restart != nil {
+		add("w:numRestart", np.Restart.String())
+	}
+	for i, el := range children {
+		pr.InsertChildAt(i, el)
+	}
+	return nil
+}
+
+// notePropsOf returns the note properties held by the tag+"Pr" child of
+// parent, or nil if there is none.
+func notePropsOf(parent *etree.Element, tag string) (*NoteProperties, error) {
+	pr := parent.SelectElement("w:" + tag + "Pr")
+	if pr == nil {
+		return nil, nil
+	}
+	return noteProps(pr)
+}
+
+// setNotePropsOf sets the note properties held by the tag+"Pr" child of
+// parent, which is inserted before successors when missing. Passing nil
+// clears them; the child is dropped once it is empty.
+func setNotePropsOf(parent *oxml.Element, tag string, np *NoteProperties, successors ...string) error {
+	name := "w:" + tag + "Pr"
+	pr := parent.RawElement().SelectElement(name)
+	if np == nil {
+		np = &NoteProperties{}
+	}
+	if pr == nil {
+		pr = oxml.OxmlElement(name)
+		if err := setNoteProps(pr, np, tag); err != nil {
+			return err
+		}
+		if len(pr.ChildElements()) > 0 {
+			parent.InsertElementBefore(pr, successors...)
+		}
+		return nil
+	}
+	if err := setNoteProps(pr, np, tag); err != nil {
+		return err
+	}
+	if len(pr.ChildElements()) == 0 {
+		parent.RawElement().RemoveChild(pr)
+	}
+	return nil
+}
+
+// sectPrNoteSuccessors are the w:sectPr children that follow w:endnotePr.
+var sectPrNoteSuccessors = []string{
+	"w:type", "w:pgSz", "w:pgMar", "w:paperSrc", "w:pgBorders", "w:lnNumType", "w:pgNumType",
+	"w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection",
+	"w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange",
+}
+
+// FootnoteProperties returns the footnote numbering and placement of this
+// section, or nil if it inherits them all from the document settings.
+func (s *Section) FootnoteProperties() (*NoteProperties, error) {
+	return notePropsOf(s.sectPr.RawElement(), "footnote")
+}
+
+// SetFootnoteProperties sets the footnote numbering and placement of this
+// section. Passing nil inherits them from the document settings.
+func (s *Section) SetFootnoteProperties(np *NoteProperties) error {
+	return setNotePropsOf(&s.sectPr.Element, "footnote", np, append([]string{"w:endnotePr"}, sectPrNoteSuccessors...)...)
+}
+
+// EndnoteProperties returns the endnote numbering and placement of this
+// section, or nil if it inherits them all from the document settings.
+func (s *Section) EndnoteProperties() (*NoteProperties, error) {
+	return notePropsOf(s.sectPr.RawElement(), "endnote")
+}
+
+// SetEndnoteProperties sets the endnote numbering and placement of this
+// section. Passing nil inherits them from the document settings.
+func (s *Section) SetEndnoteProperties(np *NoteProperties) error {
+	return setNotePropsOf(&s.sectPr.Element, "endnote", np, sectPrNoteSuccessors...)
+}
+
+// settingsNoteSuccessors are the w:settings children that follow
+// w:endnotePr.
+var settingsNoteSuccessors = []string{
+	"w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang",
+	"w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures",
+	"w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType",
+	"sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol",
+	"w:listSeparator",
+}
+
+// FootnoteProperties returns the document-wide footnote numbering and
+// placement, or nil if Word's defaults apply.
+func (s *Settings) FootnoteProperties() (*NoteProperties, error) {
+	return notePropsOf(s.settings.RawElement(), "footnote")
+}
+
+// SetFootnoteProperties sets the document-wide footnote numbering and
+// placement, which sections may override. Passing nil restores Word's
+// defaults. The references to the separator notes are kept.
+func (s *Settings) SetFootnoteProperties(np *NoteProperties) error {
+	return setNotePropsOf(&s.settings.Element, "footnote", np, append([]string{"w:endnotePr"}, settingsNoteSuccessors...)...)
+}
+
+// EndnoteProperties returns the document-wide endnote numbering and
+// placement, or nil if Word's defaults apply.
+func (s *Settings) EndnoteProperties() (*NoteProperties, error) {
+	return notePropsOf(s.settings.RawElement(), "endnote")
+}
+
+// SetEndnoteProperties sets the document-wide endnote numbering and
+// placement, which sections may override. Passing nil restores Word's
+// defaults. The references to the separator notes are kept.
+func (s *Settings) SetEndnoteProperties(np *NoteProperties) error {
+	return setNotePropsOf(&s.settings.Element, "endnote", np, settingsNoteSuccessors...)
+}
+
+// --------------------------------------------------------------------------
+// Custom reference marks
+// --------------------------------------------------------------------------
+
+// noteReference returns the footnote or endnote reference of the run, or
+// nil if it has none.
+func (run *Run) noteReference() *etree.Element {
+	for _, child := range run.r.RawElement().ChildElements() {
+		if child.Space == "w" && (child.Tag == "footnoteReference" || child.Tag == "endnoteReference") {
+			return child
+		}
+	}
+	return nil
+}
+
+// NoteCustomMark returns the custom reference mark shown instead of the
+// number of the footnote or endnote referenced by this run, or "" if the
+// note is numbered.
+func (run *Run) NoteCustomMark() string {
+	ref := run.noteReference()
+	if ref == nil || !isOn(ref.SelectAttrValue("w:customMarkFollows", "")) {
+		return ""
+	}
+	mark := ""
+	for _, child := range run.r.RawElement().ChildElements()[childIndex(run.r.RawElement(), ref)+1:] {
+		if child.Space == "w" && child.Tag == "t" {
+			mark += child.Text()
+		}
+	}
+	return mark
+}
+
+// SetNoteCustomMark shows mark, such as "*", instead of the number of the
+// footnote or endnote referenced by this run. Passing "" numbers the note
+// again. It is an error if the run holds no note reference.
+func (run *Run) SetNoteCustomMark(mark string) error {
+	ref := run.noteReference()
+	if ref == nil {
+		return fmt.Errorf("docx: run has no footnote or endnote reference")
+	}
+	r := run.r.RawElement()
+	for _, child := range r.ChildElements()[childIndex(r, ref)+1:] {
+		if child.Space == "w" && child.Tag == "t" {
+			r.RemoveChild(child)
+		}
+	}
+	if mark == "" {
+		ref.RemoveAttr("w:customMarkFollows")
+		return nil
+	}
+	ref.CreateAttr("w:customMarkFollows", "1")
+	t := oxml.OxmlElement("w:t")
+	t.SetText(mark)
+	if mark[0] == ' ' || mark[len(mark)-1] == ' ' {
+		t.CreateAttr("xml:space", "preserve")
+	}
+	r.InsertChildAt(childIndex(r, ref)+1, t)
+	return nil
+}
+
+// --------------------------------------------------------------------------
+// Separators
+// --------------------------------------------------------------------------
+
+// NoteSeparator is the content Word draws between the body text and the
+// notes: a short line by default. It is read and edited with the usual
+// BlockItemContainer methods.
+type NoteSeparator struct {
+	BlockItemContainer
+}
+
+// FootnoteSeparator returns the separator above the footnotes, or the one
+// above footnotes continued from the previous page if continuation is set.
+// It returns nil if the document has no footnotes.
+func (d *Document) FootnoteSeparator(continuation bool) *NoteSeparator {
+	fp := d.part.FootnotesPart()
+	if fp == nil {
+		return nil
+	}
+	return noteSeparator(&fp.StoryPart, "footnote", continuation)
+}
+
+// EndnoteSeparator returns the separator above the endnotes, or the one
+// above endnotes continued from the previous page if continuation is set.
+// It returns nil if the document has no endnotes.
+func (d *Document) EndnoteSeparator(continuation bool) *NoteSeparator {
+	ep := d.part.EndnotesPart()
+	if ep == nil {
+		return nil
+	}
+	return noteSeparator(&ep.StoryPart, "endnote", continuation)
+}
+
+// noteSeparator finds the separator note of the given kind in sp.
+func noteSeparator(sp *parts.StoryPart, tag string, continuation bool) *NoteSeparator {
+	typ := "separator"
+	if continuation {
+		typ = "continuationSeparator"
+	}
+	for _, note := range sp.Element().ChildElements() {
+		if note.Space == "w" && note.Tag == tag && note.SelectAttrValue("w:type", "") == typ {
+			return &NoteSeparator{BlockItemContainer: newBlockItemContainer(note, sp)}
+		}
+	}
+	return nil
+}
+
+// SetText replaces the separator with a paragraph holding text. Passing ""
+// leaves an empty paragraph, so no separator is drawn.
+func (ns *NoteSeparator) SetText(text string) error {
+	ns.clear()
+	_, err := ns.AddParagraph(text)
+	return err
+}
+
+// Reset restores Word's standard separator line.
+func (ns *NoteSeparator) Reset() {
+	mark := "w:separator"
+	if ns.element.SelectAttrValue("w:type", "") == "continuationSeparator" {
+		mark = "w:continuationSeparator"
+	}
+	ns.clear()
+	p := oxml.OxmlElement("w:p")
+	spacing := p.CreateElement("w:pPr").CreateElement("w:spacing")
+	spacing.CreateAttr("w:after", "0")
+	spacing.CreateAttr("w:line", "240")
+	spacing.CreateAttr("w:lineRule", "auto")
+	p.CreateElement("w:r").AddChild(oxml.OxmlElement(mark))
+	ns.element.AddChild(p)
+}
+
+// clear removes the content of the separator note.
+func (ns *NoteSeparator) clear() {
+	for _, child := range ns.element.ChildElements() {
+		ns.element.RemoveChild(child)
+	}
+}
@@ -0,0 +1,148 @@
+package docx
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+func TestSection_FootnoteProperties(t *testing.T) {
+	sec := newSection(makeSectPr(t, `<w:pgSz w:w="12240" w:h="15840"/>`), nil)
+	if np, err := sec.FootnoteProperties(); err != nil || np != nil {
+		t.Fatalf("FootnoteProperties = %+v, %v; want nil", np, err)
+	}
+	want := &NoteProperties{
+		Format:   "lowerRoman",
+		Start:    3,
+		Restart:  Ptr(NoteRestartEachPage),
+		Position: Ptr(NotePositionBeneathText),
+	}
+	if err := sec.SetFootnoteProperties(want); err != nil {
+		t.Fatal(err)
+	}
+	if err := sec.SetEndnoteProperties(&NoteProperties{Format: "chicago"}); err != nil {
+		t.Fatal(err)
+	}
+	var tags []string
+	for _, child := range sec.sectPr.RawElement().ChildElements() {
+		tags = append(tags, child.Tag)
+	}
+	if strings.Join(tags, ",") != "footnotePr,endnotePr,pgSz" {
+		t.Errorf("children = %v", tags)
+	}
+	np, err := sec.FootnoteProperties()
+	if err != nil || np == nil {
+		t.Fatalf("FootnoteProperties = %+v, %v", np, err)
+	}
+	if np.Format != "lowerRoman" || np.Start != 3 || *np.Restart != NoteRestartEachPage || *np.Position != NotePositionBeneathText {
+		t.Errorf("FootnoteProperties = %+v", np)
+	}
+
+	if err := sec.SetEndnoteProperties(&NoteProperties{Position: Ptr(NotePositionPageBottom)}); err == nil {
+		t.Error("expected error placing endnotes at the page bottom")
+	}
+	if err := sec.SetFootnoteProperties(nil); err != nil {
+		t.Fatal(err)
+	}
+	if np, _ := sec.FootnoteProperties(); np != nil {
+		t.Errorf("FootnoteProperties after reset = %+v", np)
+	}
+}
+
+func TestSettings_FootnotePropertiesKeepSeparatorRefs(t *testing.T) {
+	doc := mustNewDoc(t)
+	settings, err := doc.Settings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr := settings.settings.RawElement().CreateElement("w:footnotePr")
+	pr.CreateElement("w:footnote").CreateAttr("w:id", "-1")
+
+	if err := settings.SetFootnoteProperties(&NoteProperties{Format: "upperLetter"}); err != nil {
+		t.Fatal(err)
+	}
+	children := pr.ChildElements()
+	if len(children) != 2 || children[0].Tag != "numFmt" || children[1].Tag != "footnote" {
+		t.Fatalf("footnotePr children not in schema order")
+	}
+	if np, err := settings.FootnoteProperties(); err != nil || np.Format != "upperLetter" {
+		t.Errorf("FootnoteProperties = %+v, %v", np, err)
+	}
+	if err := settings.SetFootnoteProperties(nil); err != nil {
+		t.Fatal(err)
+	}
+	if settings.settings.RawElement().SelectElement("w:footnotePr") == nil {
+		t.Error("footnotePr holding separator references was removed")
+	}
+}
+
+func TestRun_NoteCustomMark(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run, err := p.AddRun("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := run.SetNoteCustomMark("*"); err == nil {
+		t.Error("expected error for a run without a note reference")
+	}
+	run.r.RawElement().CreateElement("w:footnoteReference").CreateAttr("w:id", "1")
+
+	if err := run.SetNoteCustomMark("†"); err != nil {
+		t.Fatal(err)
+	}
+	if got := run.NoteCustomMark(); got != "†" {
+		t.Errorf("NoteCustomMark = %q, want †", got)
+	}
+	if err := run.SetNoteCustomMark(""); err != nil {
+		t.Fatal(err)
+	}
+	if got := run.NoteCustomMark(); got != "" {
+		t.Errorf("NoteCustomMark after reset = %q", got)
+	}
+	if run.r.RawElement().SelectElement("w:t") != nil {
+		t.Error("custom mark text left behind")
+	}
+}
+
+func TestDocument_FootnoteSeparator(t *testing.T) {
+	doc := mustNewDoc(t)
+	if doc.FootnoteSeparator(false) != nil {
+		t.Fatal("FootnoteSeparator without footnotes part should be nil")
+	}
+	pkg := doc.part.Package()
+	part := opc.NewBasePart("/word/footnotes.xml", opc.CTWmlFootnotes, []byte(
+		`<w:footnotes `+wNS+`><w:footnote w:type="separator" w:id="-1"><w:p><w:r><w:separator/></w:r></w:p></w:footnote>`+
+			`<w:footnote w:type="continuationSeparator" w:id="0"><w:p><w:r><w:continuationSeparator/></w:r></w:p></w:footnote></w:footnotes>`), pkg)
+	pkg.AddPart(part)
+	doc.part.Rels().GetOrAdd(opc.RTFootnotes, part)
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	doc, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sep := doc.FootnoteSeparator(false)
+	if sep == nil || len(sep.Paragraphs()) != 1 {
+		t.Fatal("separator not found")
+	}
+	if err := sep.SetText("* * *"); err != nil {
+		t.Fatal(err)
+	}
+	if got := sep.Paragraphs()[0].Text(); got != "* * *" {
+		t.Errorf("separator text = %q", got)
+	}
+	cont := doc.FootnoteSeparator(true)
+	cont.Reset()
+	if cont.element.FindElement(".//w:continuationSeparator") == nil {
+		t.Error("Reset did not restore the continuation separator line")
+	}
+}
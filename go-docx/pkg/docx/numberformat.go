@@ -0,0 +1,120 @@
+package docx
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// formatNumberPicture formats v by a Word numeric picture, the argument
+// of a field's \# switch, with the separators of loc. In the picture 0 is
+// a digit always shown, # and x a digit shown if significant, "," groups
+// the digits by thousands and "." starts the decimals; other characters
+// and 'quoted' text around the digits are copied. A picture of two or
+// three sections separated by ";" formats negative numbers, without
+// their sign, by the second and zero by the third.
+func formatNumberPicture(v float64, picture string, loc *locale) string {
+	sections := splitPictureSections(picture)
+	section := sections[0]
+	sign := ""
+	switch {
+	case v < 0 && len(sections) > 1:
+		section, v = sections[1], -v
+	case v == 0 && len(sections) > 2:
+		section = sections[2]
+	case v < 0:
+		sign, v = "-", -v
+	}
+
+	rs := []rune(section)
+	first, last := -1, -1
+	for i, r := range rs {
+		if r == '0' || r == '#' || r == 'x' {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		return unquotePicture(section)
+	}
+	prefix, digits, suffix := unquotePicture(string(rs[:first])), string(rs[first:last+1]), unquotePicture(string(rs[last+1:]))
+	intPic, fracPic, _ := strings.Cut(digits, ".")
+	minInt := strings.Count(intPic, "0")
+	decimals := len(fracPic) - strings.Count(fracPic, ",")
+	minFrac := strings.Count(fracPic, "0")
+
+	scale := math.Pow(10, float64(decimals))
+	s := strconv.FormatFloat(math.Round(v*scale)/scale, 'f', decimals, 64)
+	if strings.Trim(s, "0.") == "" {
+		sign = "" // rounds to zero
+	}
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	fracPart = strings.TrimRight(fracPart, "0")
+	if len(fracPart) < minFrac {
+		fracPart += strings.Repeat("0", minFrac-len(fracPart))
+	}
+	if intPart == "0" {
+		intPart = ""
+	}
+	if len(intPart) < minInt {
+		intPart = strings.Repeat("0", minInt-len(intPart)) + intPart
+	}
+	if strings.Contains(intPic, ",") {
+		intPart = groupDigits(intPart, loc.group)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(sign)
+	sb.WriteString(prefix)
+	sb.WriteString(intPart)
+	if fracPart != "" {
+		sb.WriteString(loc.decimal)
+		sb.WriteString(fracPart)
+	}
+	sb.WriteString(suffix)
+	return sb.String()
+}
+
+// splitPictureSections splits a numeric picture at the ";" outside quotes.
+func splitPictureSections(picture string) []string {
+	var sections []string
+	inQuote := false
+	start := 0
+	for i, r := range picture {
+		switch {
+		case r == '\'':
+			inQuote = !inQuote
+		case r == ';' && !inQuote:
+			sections = append(sections, picture[start:i])
+			start = i + 1
+		}
+	}
+	return append(sections, picture[start:])
+}
+
+// unquotePicture returns literal picture text with its quotes removed.
+func unquotePicture(s string) string {
+	return strings.ReplaceAll(s, "'", "")
+}
+
+// groupDigits inserts sep between each group of three digits of digits,
+// counting from the right.
+func groupDigits(digits, sep string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	var sb strings.Builder
+	lead := len(digits) % 3
+	if lead > 0 {
+		sb.WriteString(digits[:lead])
+	}
+	for i := lead; i < len(digits); i += 3 {
+		if sb.Len() > 0 {
+			sb.WriteString(sep)
+		}
+		sb.WriteString(digits[i : i+3])
+	}
+	return sb.String()
+}
@@ -18,7 +18,9 @@ const (
 	CTDmlDiagramLayout          = "application/vnd.openxmlformats-officedocument.drawingml.diagramLayout+xml"
 	CTDmlDiagramStyle           = "application/vnd.openxmlformats-officedocument.drawingml.diagramStyle+xml"
 	CTGif                       = "image/gif"
+	CTHtml                      = "text/html"
 	CTJpeg                      = "image/jpeg"
+	CTMht                       = "message/rfc822"
 	CTMsPhoto                   = "image/vnd.ms-photo"
 	CTOfcCustomProperties       = "application/vnd.openxmlformats-officedocument.custom-properties+xml"
 	CTOfcCustomXmlProperties    = "application/vnd.openxmlformats-officedocument.customXmlProperties+xml"
@@ -35,11 +37,18 @@ const (
 	CTOpcDigitalSignatureXmlsig = "application/vnd.openxmlformats-package.digital-signature-xmlsignature+xml"
 	CTOpcRelationships          = "application/vnd.openxmlformats-package.relationships+xml"
 	CTPng                       = "image/png"
+	CTRtf                       = "application/rtf"
+	CTTextPlain                 = "text/plain"
 	CTTiff                      = "image/tiff"
 	CTWmlComments               = "application/vnd.openxmlformats-officedocument.wordprocessingml.comments+xml"
 	CTWmlDocument               = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
 	CTWmlDocumentGlossary       = "application/vnd.openxmlformats-officedocument.wordprocessingml.document.glossary+xml"
 	CTWmlDocumentMain           = "application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"
+	CTWmlDocumentMacroEnabled   = "application/vnd.ms-word.document.macroEnabled.main+xml"
+	CTWmlTemplateMain           = "application/vnd.openxmlformats-officedocument.wordprocessingml.template.main+xml"
+	CTWmlTemplateMacroEnabled   = "application/vnd.ms-word.template.macroEnabledTemplate.main+xml"
+	CTWmlVbaData                = "application/vnd.ms-word.vbaData+xml"
+	CTOfficeVbaProject          = "application/vnd.ms-office.vbaProject"
 	CTWmlEndnotes               = "application/vnd.openxmlformats-officedocument.wordprocessingml.endnotes+xml"
 	CTWmlFontTable              = "application/vnd.openxmlformats-officedocument.wordprocessingml.fontTable+xml"
 	CTWmlFooter                 = "application/vnd.openxmlformats-officedocument.wordprocessingml.footer+xml"
@@ -50,6 +59,7 @@ const (
 	CTWmlSettings               = "application/vnd.openxmlformats-officedocument.wordprocessingml.settings+xml"
 	CTWmlStyles                 = "application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"
 	CTWmlWebSettings            = "application/vnd.openxmlformats-officedocument.wordprocessingml.webSettings+xml"
+	CTXhtml                     = "application/xhtml+xml"
 	CTXml                       = "application/xml"
 	CTXEmf                      = "image/x-emf"
 	CTXFontdata                 = "application/x-fontdata"
@@ -100,10 +110,16 @@ const (
 //	"http://purl.oclc.org/ooxml/officeDocument/relationships/officeDocument"
 //	→ "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
 func NormalizeRelType(relType string) string {
-	if strings.HasPrefix(relType, nsStrictOfcRel) {
-		return nsTransitionalOfcRel + relType[len(nsStrictOfcRel):]
+	if !strings.HasPrefix(relType, nsStrictOfcRel) {
+		return relType
 	}
-	return relType
+	name := relType[len(nsStrictOfcRel):]
+	for _, n := range strictRelTypeNames {
+		if name == n[0] {
+			name = n[1]
+		}
+	}
+	return nsTransitionalOfcRel + name
 }
 
 // --------------------------------------------------------------------------
@@ -120,38 +136,56 @@ const (
 // --------------------------------------------------------------------------
 
 const (
-	RTOfficeDocument     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
-	RTStyles             = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles"
-	RTNumbering          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/numbering"
-	RTSettings           = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/settings"
-	RTComments           = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/comments"
-	RTHeader             = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/header"
-	RTFooter             = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/footer"
-	RTImage              = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/image"
-	RTCoreProperties     = "http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties"
-	RTHyperlink          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink"
-	RTFontTable          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/fontTable"
-	RTTheme              = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/theme"
-	RTWebSettings        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/webSettings"
-	RTEndnotes           = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/endnotes"
-	RTFootnotes          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/footnotes"
-	RTExtendedProperties = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/extended-properties"
-	RTCustomProperties   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/custom-properties"
-	RTGlossaryDocument   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/glossaryDocument"
-	RTThumbnail          = "http://schemas.openxmlformats.org/package/2006/relationships/metadata/thumbnail"
-	RTDrawing            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/drawing"
-	RTChart              = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/chart"
-	RTCustomXml          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXml"
-	RTCustomXmlProps     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXmlProps"
-	RTSlide              = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/slide"
-	RTSlideLayout        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/slideLayout"
-	RTSlideMaster        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/slideMaster"
-	RTPresProps          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/presProps"
-	RTViewProps          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/viewProps"
-	RTTableStyles        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/tableStyles"
-	RTPrinterSettings    = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/printerSettings"
-	RTVmlDrawing         = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/vmlDrawing"
-	RTPackage            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/package"
+	RTOfficeDocument       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
+	RTStyles               = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles"
+	RTNumbering            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/numbering"
+	RTSettings             = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/settings"
+	RTComments             = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/comments"
+	RTHeader               = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/header"
+	RTFooter               = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/footer"
+	RTImage                = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/image"
+	RTCoreProperties       = "http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties"
+	RTHyperlink            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink"
+	RTFontTable            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/fontTable"
+	RTTheme                = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/theme"
+	RTWebSettings          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/webSettings"
+	RTEndnotes             = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/endnotes"
+	RTFootnotes            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/footnotes"
+	RTExtendedProperties   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/extended-properties"
+	RTCustomProperties     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/custom-properties"
+	RTGlossaryDocument     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/glossaryDocument"
+	RTThumbnail            = "http://schemas.openxmlformats.org/package/2006/relationships/metadata/thumbnail"
+	RTDrawing              = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/drawing"
+	RTChart                = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/chart"
+	RTCustomXml            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXml"
+	RTCustomXmlProps       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXmlProps"
+	RTSlide                = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/slide"
+	RTSlideLayout          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/slideLayout"
+	RTSlideMaster          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/slideMaster"
+	RTPresProps            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/presProps"
+	RTViewProps            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/viewProps"
+	RTTableStyles          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/tableStyles"
+	RTPrinterSettings      = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/printerSettings"
+	RTVmlDrawing           = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/vmlDrawing"
+	RTOleObject            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/oleObject"
+	RTPackage              = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/package"
+	RTAFChunk              = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/aFChunk"
+	RTVbaProject           = "http://schemas.microsoft.com/office/2006/relationships/vbaProject"
+	RTWordVbaData          = "http://schemas.microsoft.com/office/2006/relationships/wordVbaData"
+	RTKeyMapCustomizations = "http://schemas.microsoft.com/office/2006/relationships/keyMapCustomizations"
+	RTAttachedToolbars     = "http://schemas.microsoft.com/office/2006/relationships/attachedToolbars"
+	RTAttachedTemplate     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/attachedTemplate"
+	RTControl              = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/control"
+	RTActiveXControlBinary = "http://schemas.microsoft.com/office/2006/relationships/activeXControlBinary"
+
+	RTCommentsExtended   = "http://schemas.microsoft.com/office/2011/relationships/commentsExtended"
+	RTCommentsIds        = "http://schemas.microsoft.com/office/2016/09/relationships/commentsIds"
+	RTCommentsExtensible = "http://schemas.microsoft.com/office/2018/08/relationships/commentsExtensible"
+	RTPeople             = "http://schemas.microsoft.com/office/2011/relationships/people"
+
+	RTDigitalSignatureOrigin      = "http://schemas.openxmlformats.org/package/2006/relationships/digital-signature/origin"
+	RTDigitalSignatureSignature   = "http://schemas.openxmlformats.org/package/2006/relationships/digital-signature/signature"
+	RTDigitalSignatureCertificate = "http://schemas.openxmlformats.org/package/2006/relationships/digital-signature/certificate"
 )
 
 // --------------------------------------------------------------------------
@@ -10,7 +10,7 @@ import (
 // buildTestZip creates a minimal .docx ZIP in memory from a map of member
 // names to contents.  This allows tests to craft packages with intentional
 // structural defects (missing parts, incomplete content types, etc.).
-func buildTestZip(t *testing.T, members map[string]string) []byte {
+func buildTestZip(t testing.TB, members map[string]string) []byte {
 	t.Helper()
 	var buf bytes.Buffer
 	zw := zip.NewWriter(&buf)
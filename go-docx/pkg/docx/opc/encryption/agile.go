@@ -0,0 +1,551 @@
+// Package encryption reads and writes password-protected OOXML packages
+// ([MS-OFFCRYPTO] agile encryption).
+//
+// An encrypted .docx is not a ZIP archive: it is an OLE2 compound file
+// holding an EncryptionInfo stream (the key-derivation parameters) and an
+// EncryptedPackage stream (the AES-encrypted ZIP package). Decrypt turns
+// such a file back into the ZIP bytes the opc package understands; Encrypt
+// does the reverse.
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash"
+	"unicode/utf16"
+)
+
+// ErrWrongPassword is returned by Decrypt when the password does not
+// unlock the package.
+var ErrWrongPassword = errors.New("encryption: wrong password")
+
+// ErrUnsupportedEncryption is returned by Decrypt for encryption schemes
+// other than agile encryption with AES (e.g. standard or RC4 encryption).
+var ErrUnsupportedEncryption = errors.New("encryption: unsupported encryption scheme")
+
+// ErrIntegrity is returned by Decrypt when the package decrypts but its
+// HMAC does not match, indicating the encrypted stream was altered.
+var ErrIntegrity = errors.New("encryption: data integrity check failed")
+
+// Block keys from [MS-OFFCRYPTO] §2.3.4.11–13.
+var (
+	blockVerifierInput = []byte{0xfe, 0xa7, 0xd2, 0x76, 0x3b, 0x4b, 0x9e, 0x79}
+	blockVerifierValue = []byte{0xd7, 0xaa, 0x0f, 0x6d, 0x30, 0x61, 0x34, 0x4e}
+	blockKeyValue      = []byte{0x14, 0x6e, 0x0b, 0xe7, 0xab, 0xac, 0xd0, 0xd6}
+	blockHmacKey       = []byte{0x5f, 0xb2, 0xad, 0x01, 0x0c, 0xb9, 0xe1, 0xf6}
+	blockHmacValue     = []byte{0xa0, 0x67, 0x7f, 0x02, 0xb2, 0x2c, 0x84, 0x33}
+)
+
+const (
+	segmentSize      = 4096
+	defaultSpinCount = 100000
+	maxSpinCount     = 10000000
+
+	nsEncryption        = "http://schemas.microsoft.com/office/2006/encryption"
+	nsPasswordEncryptor = "http://schemas.microsoft.com/office/2006/keyEncryptor/password"
+	nsCertEncryptor     = "http://schemas.microsoft.com/office/2006/keyEncryptor/certificate"
+)
+
+// IsEncrypted reports whether data looks like an encrypted OOXML package,
+// i.e. an OLE2 compound file rather than a ZIP archive.
+func IsEncrypted(data []byte) bool {
+	return len(data) >= len(cfbMagic) && bytes.Equal(data[:len(cfbMagic)], cfbMagic)
+}
+
+// --------------------------------------------------------------------------
+// EncryptionInfo
+// --------------------------------------------------------------------------
+
+// cipherParams are the attributes shared by keyData and p:encryptedKey.
+type cipherParams struct {
+	SaltSize        int
+	BlockSize       int
+	KeyBits         int
+	HashSize        int
+	CipherAlgorithm string
+	CipherChaining  string
+	HashAlgorithm   string
+	SaltValue       []byte
+}
+
+// encryptedKey is the password key encryptor (p:encryptedKey).
+type encryptedKey struct {
+	cipherParams
+	SpinCount                  int
+	EncryptedVerifierHashInput []byte
+	EncryptedVerifierHashValue []byte
+	EncryptedKeyValue          []byte
+}
+
+type keyEncryptor struct {
+	URI          string
+	EncryptedKey *encryptedKey // nil for non-password encryptors
+}
+
+type dataIntegrity struct {
+	EncryptedHmacKey   []byte
+	EncryptedHmacValue []byte
+}
+
+// encryptionInfo is the decoded XML descriptor of an agile EncryptionInfo
+// stream.
+type encryptionInfo struct {
+	KeyData       cipherParams
+	DataIntegrity *dataIntegrity
+	KeyEncryptors []keyEncryptor
+}
+
+// rawCipherParams and rawEncryptionInfo mirror the XML; binary values are
+// still base64 strings.
+type rawCipherParams struct {
+	SaltSize        int    `xml:"saltSize,attr"`
+	BlockSize       int    `xml:"blockSize,attr"`
+	KeyBits         int    `xml:"keyBits,attr"`
+	HashSize        int    `xml:"hashSize,attr"`
+	CipherAlgorithm string `xml:"cipherAlgorithm,attr"`
+	CipherChaining  string `xml:"cipherChaining,attr"`
+	HashAlgorithm   string `xml:"hashAlgorithm,attr"`
+	SaltValue       string `xml:"saltValue,attr"`
+}
+
+type rawEncryptionInfo struct {
+	XMLName       xml.Name        `xml:"http://schemas.microsoft.com/office/2006/encryption encryption"`
+	KeyData       rawCipherParams `xml:"keyData"`
+	DataIntegrity *struct {
+		EncryptedHmacKey   string `xml:"encryptedHmacKey,attr"`
+		EncryptedHmacValue string `xml:"encryptedHmacValue,attr"`
+	} `xml:"dataIntegrity"`
+	KeyEncryptors []struct {
+		URI          string `xml:"uri,attr"`
+		EncryptedKey *struct {
+			rawCipherParams
+			SpinCount                  int    `xml:"spinCount,attr"`
+			EncryptedVerifierHashInput string `xml:"encryptedVerifierHashInput,attr"`
+			EncryptedVerifierHashValue string `xml:"encryptedVerifierHashValue,attr"`
+			EncryptedKeyValue          string `xml:"encryptedKeyValue,attr"`
+		} `xml:"http://schemas.microsoft.com/office/2006/keyEncryptor/password encryptedKey"`
+	} `xml:"keyEncryptors>keyEncryptor"`
+}
+
+// parseEncryptionInfo decodes an EncryptionInfo stream. Only agile
+// encryption (version 4.4) is supported.
+func parseEncryptionInfo(stream []byte) (*encryptionInfo, error) {
+	if len(stream) < 8 {
+		return nil, fmt.Errorf("encryption: EncryptionInfo stream too short")
+	}
+	major, minor := binary.LittleEndian.Uint16(stream[0:]), binary.LittleEndian.Uint16(stream[2:])
+	if major != 4 || minor != 4 {
+		return nil, fmt.Errorf("%w: EncryptionInfo version %d.%d", ErrUnsupportedEncryption, major, minor)
+	}
+	var raw rawEncryptionInfo
+	if err := xml.Unmarshal(stream[8:], &raw); err != nil {
+		return nil, fmt.Errorf("encryption: parsing EncryptionInfo: %w", err)
+	}
+
+	var err error
+	b64 := func(s string) []byte {
+		if err != nil {
+			return nil
+		}
+		var v []byte
+		if v, err = base64.StdEncoding.DecodeString(s); err != nil {
+			err = fmt.Errorf("encryption: EncryptionInfo: %w", err)
+		}
+		return v
+	}
+	params := func(r rawCipherParams) cipherParams {
+		return cipherParams{
+			SaltSize: r.SaltSize, BlockSize: r.BlockSize, KeyBits: r.KeyBits, HashSize: r.HashSize,
+			CipherAlgorithm: r.CipherAlgorithm, CipherChaining: r.CipherChaining,
+			HashAlgorithm: r.HashAlgorithm, SaltValue: b64(r.SaltValue),
+		}
+	}
+
+	info := &encryptionInfo{KeyData: params(raw.KeyData)}
+	if di := raw.DataIntegrity; di != nil {
+		info.DataIntegrity = &dataIntegrity{
+			EncryptedHmacKey:   b64(di.EncryptedHmacKey),
+			EncryptedHmacValue: b64(di.EncryptedHmacValue),
+		}
+	}
+	for _, ke := range raw.KeyEncryptors {
+		out := keyEncryptor{URI: ke.URI}
+		if ek := ke.EncryptedKey; ek != nil {
+			out.EncryptedKey = &encryptedKey{
+				cipherParams:               params(ek.rawCipherParams),
+				SpinCount:                  ek.SpinCount,
+				EncryptedVerifierHashInput: b64(ek.EncryptedVerifierHashInput),
+				EncryptedVerifierHashValue: b64(ek.EncryptedVerifierHashValue),
+				EncryptedKeyValue:          b64(ek.EncryptedKeyValue),
+			}
+		}
+		info.KeyEncryptors = append(info.KeyEncryptors, out)
+	}
+	return info, err
+}
+
+// check validates the cipher parameters this package can process.
+func (p *cipherParams) check() error {
+	if p.CipherAlgorithm != "AES" || p.CipherChaining != "ChainingModeCBC" {
+		return fmt.Errorf("%w: cipher %s/%s", ErrUnsupportedEncryption, p.CipherAlgorithm, p.CipherChaining)
+	}
+	if p.KeyBits != 128 && p.KeyBits != 192 && p.KeyBits != 256 {
+		return fmt.Errorf("%w: key size %d", ErrUnsupportedEncryption, p.KeyBits)
+	}
+	if p.BlockSize != aes.BlockSize {
+		return fmt.Errorf("%w: block size %d", ErrUnsupportedEncryption, p.BlockSize)
+	}
+	if _, err := newHash(p.HashAlgorithm); err != nil {
+		return err
+	}
+	return nil
+}
+
+// newHash returns a constructor for the named hash algorithm.
+func newHash(name string) (func() hash.Hash, error) {
+	switch name {
+	case "SHA1":
+		return sha1.New, nil
+	case "SHA256":
+		return sha256.New, nil
+	case "SHA384":
+		return sha512.New384, nil
+	case "SHA512":
+		return sha512.New, nil
+	}
+	return nil, fmt.Errorf("%w: hash algorithm %q", ErrUnsupportedEncryption, name)
+}
+
+// --------------------------------------------------------------------------
+// Decrypt
+// --------------------------------------------------------------------------
+
+// Decrypt decrypts an agile-encrypted OOXML file and returns the plain ZIP
+// package bytes. It returns ErrWrongPassword when password is incorrect
+// and ErrUnsupportedEncryption for other encryption schemes.
+func Decrypt(data []byte, password string) ([]byte, error) {
+	cfb, err := readCFB(data)
+	if err != nil {
+		return nil, err
+	}
+	infoStream, err := cfb.stream("EncryptionInfo")
+	if err != nil {
+		return nil, err
+	}
+	info, err := parseEncryptionInfo(infoStream)
+	if err != nil {
+		return nil, err
+	}
+	if err := info.KeyData.check(); err != nil {
+		return nil, err
+	}
+
+	var ek *encryptedKey
+	for _, ke := range info.KeyEncryptors {
+		if ke.URI == nsPasswordEncryptor && ke.EncryptedKey != nil {
+			ek = ke.EncryptedKey
+			break
+		}
+	}
+	if ek == nil {
+		return nil, fmt.Errorf("%w: no password key encryptor", ErrUnsupportedEncryption)
+	}
+	if err := ek.check(); err != nil {
+		return nil, err
+	}
+	if ek.SpinCount < 0 || ek.SpinCount > maxSpinCount {
+		return nil, fmt.Errorf("%w: spin count %d", ErrUnsupportedEncryption, ek.SpinCount)
+	}
+
+	secretKey, err := ek.unlock(password)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgStream, err := cfb.stream("EncryptedPackage")
+	if err != nil {
+		return nil, err
+	}
+	if info.DataIntegrity != nil {
+		if err := info.KeyData.checkIntegrity(secretKey, info.DataIntegrity, pkgStream); err != nil {
+			return nil, err
+		}
+	}
+	return info.KeyData.decryptPackage(secretKey, pkgStream)
+}
+
+// unlock derives the password key, checks the verifier and returns the
+// decrypted secret (intermediate) key.
+func (ek *encryptedKey) unlock(password string) ([]byte, error) {
+	h, _ := newHash(ek.HashAlgorithm)
+	base := passwordHash(h, ek.SaltValue, password, ek.SpinCount)
+
+	decrypt := func(block, data []byte) ([]byte, error) {
+		return cbc(false, deriveKey(h, base, block, ek.KeyBits/8), ek.SaltValue, data)
+	}
+	verifierInput, err := decrypt(blockVerifierInput, ek.EncryptedVerifierHashInput)
+	if err != nil {
+		return nil, err
+	}
+	verifierHash, err := decrypt(blockVerifierValue, ek.EncryptedVerifierHashValue)
+	if err != nil {
+		return nil, err
+	}
+	if len(verifierInput) < ek.SaltSize || len(verifierHash) < ek.HashSize {
+		return nil, fmt.Errorf("encryption: malformed password verifier")
+	}
+	if !hmac.Equal(hashOf(h, verifierInput[:ek.SaltSize]), verifierHash[:ek.HashSize]) {
+		return nil, ErrWrongPassword
+	}
+	key, err := decrypt(blockKeyValue, ek.EncryptedKeyValue)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) < ek.KeyBits/8 {
+		return nil, fmt.Errorf("encryption: malformed encrypted key")
+	}
+	return key[:ek.KeyBits/8], nil
+}
+
+// decryptPackage decrypts an EncryptedPackage stream segment by segment.
+func (p *cipherParams) decryptPackage(key, stream []byte) ([]byte, error) {
+	if len(stream) < 8 {
+		return nil, fmt.Errorf("encryption: EncryptedPackage stream too short")
+	}
+	size := binary.LittleEndian.Uint64(stream)
+	body := stream[8:]
+	if size > uint64(len(body)) {
+		return nil, fmt.Errorf("encryption: EncryptedPackage declares %d bytes but holds %d", size, len(body))
+	}
+	h, _ := newHash(p.HashAlgorithm)
+	out := make([]byte, 0, len(body))
+	for i := 0; len(body) > 0; i++ {
+		n := min(segmentSize, len(body))
+		n -= n % p.BlockSize
+		if n == 0 {
+			break
+		}
+		plain, err := cbc(false, key, p.segmentIV(h, uint32(i)), body[:n])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, plain...)
+		body = body[n:]
+	}
+	if uint64(len(out)) < size {
+		return nil, fmt.Errorf("encryption: EncryptedPackage is truncated")
+	}
+	return out[:size], nil
+}
+
+// checkIntegrity verifies the HMAC over the EncryptedPackage stream.
+func (p *cipherParams) checkIntegrity(key []byte, di *dataIntegrity, stream []byte) error {
+	h, _ := newHash(p.HashAlgorithm)
+	hmacKey, err := cbc(false, key, p.blockIV(h, blockHmacKey), di.EncryptedHmacKey)
+	if err != nil {
+		return err
+	}
+	want, err := cbc(false, key, p.blockIV(h, blockHmacValue), di.EncryptedHmacValue)
+	if err != nil {
+		return err
+	}
+	if len(hmacKey) < p.HashSize || len(want) < p.HashSize {
+		return fmt.Errorf("encryption: malformed data integrity record")
+	}
+	mac := hmac.New(h, hmacKey[:p.HashSize])
+	mac.Write(stream)
+	if !hmac.Equal(mac.Sum(nil), want[:p.HashSize]) {
+		return ErrIntegrity
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------
+// Encrypt
+// --------------------------------------------------------------------------
+
+// Encrypt wraps the ZIP package bytes pkg in an agile-encrypted compound
+// file protected by password, using AES-256 and SHA-512 as current Word
+// versions do.
+func Encrypt(pkg []byte, password string) ([]byte, error) {
+	keyData := cipherParams{
+		SaltSize: 16, BlockSize: aes.BlockSize, KeyBits: 256, HashSize: sha512.Size,
+		CipherAlgorithm: "AES", CipherChaining: "ChainingModeCBC", HashAlgorithm: "SHA512",
+		SaltValue: randomBytes(16),
+	}
+	ek := &encryptedKey{cipherParams: keyData, SpinCount: defaultSpinCount}
+	ek.SaltValue = randomBytes(16)
+	h := sha512.New
+
+	secretKey := randomBytes(keyData.KeyBits / 8)
+	base := passwordHash(h, ek.SaltValue, password, ek.SpinCount)
+	encrypt := func(block, data []byte) []byte {
+		out, _ := cbc(true, deriveKey(h, base, block, ek.KeyBits/8), ek.SaltValue, pad(data, ek.BlockSize))
+		return out
+	}
+	verifierInput := randomBytes(ek.SaltSize)
+	ek.EncryptedVerifierHashInput = encrypt(blockVerifierInput, verifierInput)
+	ek.EncryptedVerifierHashValue = encrypt(blockVerifierValue, hashOf(h, verifierInput))
+	ek.EncryptedKeyValue = encrypt(blockKeyValue, secretKey)
+
+	// EncryptedPackage: plaintext size, then 4096-byte segments.
+	stream := make([]byte, 8, 8+len(pkg)+segmentSize)
+	binary.LittleEndian.PutUint64(stream, uint64(len(pkg)))
+	for i := 0; i*segmentSize < len(pkg); i++ {
+		seg := pkg[i*segmentSize : min((i+1)*segmentSize, len(pkg))]
+		enc, err := cbc(true, secretKey, keyData.segmentIV(h, uint32(i)), pad(seg, keyData.BlockSize))
+		if err != nil {
+			return nil, err
+		}
+		stream = append(stream, enc...)
+	}
+
+	hmacKey := randomBytes(keyData.HashSize)
+	mac := hmac.New(h, hmacKey)
+	mac.Write(stream)
+	encHmacKey, err := cbc(true, secretKey, keyData.blockIV(h, blockHmacKey), pad(hmacKey, keyData.BlockSize))
+	if err != nil {
+		return nil, err
+	}
+	encHmacValue, err := cbc(true, secretKey, keyData.blockIV(h, blockHmacValue), pad(mac.Sum(nil), keyData.BlockSize))
+	if err != nil {
+		return nil, err
+	}
+
+	info := encryptionInfoStream(&keyData, ek, &dataIntegrity{EncryptedHmacKey: encHmacKey, EncryptedHmacValue: encHmacValue})
+	return writeCFB([]*cfbNode{
+		{name: "EncryptionInfo", data: info},
+		{name: "EncryptedPackage", data: stream},
+		dataSpacesStorage(),
+	})
+}
+
+// encryptionInfoStream serializes the agile EncryptionInfo stream.
+func encryptionInfoStream(kd *cipherParams, ek *encryptedKey, di *dataIntegrity) []byte {
+	b64 := base64.StdEncoding.EncodeToString
+	attrs := func(p *cipherParams) string {
+		return fmt.Sprintf(`saltSize="%d" blockSize="%d" keyBits="%d" hashSize="%d" cipherAlgorithm="%s" cipherChaining="%s" hashAlgorithm="%s" saltValue="%s"`,
+			p.SaltSize, p.BlockSize, p.KeyBits, p.HashSize, p.CipherAlgorithm, p.CipherChaining, p.HashAlgorithm, b64(p.SaltValue))
+	}
+	var buf bytes.Buffer
+	buf.Write([]byte{0x04, 0x00, 0x04, 0x00, 0x40, 0x00, 0x00, 0x00}) // version 4.4, flags
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\r\n")
+	fmt.Fprintf(&buf, `<encryption xmlns="%s" xmlns:p="%s" xmlns:c="%s">`, nsEncryption, nsPasswordEncryptor, nsCertEncryptor)
+	fmt.Fprintf(&buf, `<keyData %s/>`, attrs(kd))
+	fmt.Fprintf(&buf, `<dataIntegrity encryptedHmacKey="%s" encryptedHmacValue="%s"/>`, b64(di.EncryptedHmacKey), b64(di.EncryptedHmacValue))
+	fmt.Fprintf(&buf, `<keyEncryptors><keyEncryptor uri="%s">`, nsPasswordEncryptor)
+	fmt.Fprintf(&buf, `<p:encryptedKey spinCount="%d" %s encryptedVerifierHashInput="%s" encryptedVerifierHashValue="%s" encryptedKeyValue="%s"/>`,
+		ek.SpinCount, attrs(&ek.cipherParams), b64(ek.EncryptedVerifierHashInput), b64(ek.EncryptedVerifierHashValue), b64(ek.EncryptedKeyValue))
+	buf.WriteString(`</keyEncryptor></keyEncryptors></encryption>`)
+	return buf.Bytes()
+}
+
+// --------------------------------------------------------------------------
+// Primitives
+// --------------------------------------------------------------------------
+
+// passwordHash computes the iterated password hash of §2.3.4.11:
+// H0 = H(salt + password), Hn = H(iterator + Hn-1).
+func passwordHash(h func() hash.Hash, salt []byte, password string, spinCount int) []byte {
+	pw := utf16.Encode([]rune(password))
+	pwBytes := make([]byte, 2*len(pw))
+	for i, u := range pw {
+		binary.LittleEndian.PutUint16(pwBytes[2*i:], u)
+	}
+	sum := hashOf(h, salt, pwBytes)
+	var iter [4]byte
+	hh := h()
+	for i := 0; i < spinCount; i++ {
+		binary.LittleEndian.PutUint32(iter[:], uint32(i))
+		hh.Reset()
+		hh.Write(iter[:])
+		hh.Write(sum)
+		sum = hh.Sum(sum[:0])
+	}
+	return sum
+}
+
+// deriveKey hashes base with a block key and sizes the result to keyLen,
+// padding with 0x36 when the hash is shorter.
+func deriveKey(h func() hash.Hash, base, blockKey []byte, keyLen int) []byte {
+	return fitTo(hashOf(h, base, blockKey), keyLen, 0x36)
+}
+
+// segmentIV returns the IV for package segment i.
+func (p *cipherParams) segmentIV(h func() hash.Hash, i uint32) []byte {
+	var idx [4]byte
+	binary.LittleEndian.PutUint32(idx[:], i)
+	return fitTo(hashOf(h, p.SaltValue, idx[:]), p.BlockSize, 0x36)
+}
+
+// blockIV returns the IV for data-integrity values.
+func (p *cipherParams) blockIV(h func() hash.Hash, blockKey []byte) []byte {
+	return fitTo(hashOf(h, p.SaltValue, blockKey), p.BlockSize, 0x36)
+}
+
+func hashOf(h func() hash.Hash, parts ...[]byte) []byte {
+	hh := h()
+	for _, p := range parts {
+		hh.Write(p)
+	}
+	return hh.Sum(nil)
+}
+
+// fitTo truncates b to n bytes or pads it with fill.
+func fitTo(b []byte, n int, fill byte) []byte {
+	if len(b) >= n {
+		return b[:n]
+	}
+	out := make([]byte, n)
+	copy(out, b)
+	for i := len(b); i < n; i++ {
+		out[i] = fill
+	}
+	return out
+}
+
+// pad zero-pads data to a multiple of blockSize.
+func pad(data []byte, blockSize int) []byte {
+	if r := len(data) % blockSize; r != 0 || len(data) == 0 {
+		return append(append([]byte(nil), data...), make([]byte, blockSize-r)...)
+	}
+	return data
+}
+
+// cbc encrypts or decrypts data with AES-CBC. The IV is sized to the block.
+func cbc(encrypt bool, key, iv, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: %w", err)
+	}
+	if len(data)%block.BlockSize() != 0 {
+		return nil, fmt.Errorf("encryption: ciphertext is not a multiple of the block size")
+	}
+	iv = fitTo(iv, block.BlockSize(), 0x36)
+	out := make([]byte, len(data))
+	if encrypt {
+		cipher.NewCBCEncrypter(block, iv).CryptBlocks(out, data)
+	} else {
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(out, data)
+	}
+	return out, nil
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic("encryption: crypto/rand failed: " + err.Error())
+	}
+	return b
+}
@@ -0,0 +1,157 @@
+package encryption
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestEncryptDecrypt_RoundTrip(t *testing.T) {
+	t.Parallel()
+	// Span several segments with a ragged tail.
+	plain := bytes.Repeat([]byte("PK\x03\x04 package bytes "), 1000)
+	enc, err := Encrypt(plain, "s3cret")
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if !IsEncrypted(enc) {
+		t.Fatal("IsEncrypted = false for encrypted output")
+	}
+	got, err := Decrypt(enc, "s3cret")
+	if err != nil {
+		t.Fatalf("Decrypt: %v", err)
+	}
+	if !bytes.Equal(got, plain) {
+		t.Fatalf("round-trip mismatch: got %d bytes, want %d", len(got), len(plain))
+	}
+}
+
+func TestDecrypt_WrongPassword(t *testing.T) {
+	t.Parallel()
+	enc, err := Encrypt([]byte("hello"), "right")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Decrypt(enc, "wrong"); !errors.Is(err, ErrWrongPassword) {
+		t.Fatalf("expected ErrWrongPassword, got %v", err)
+	}
+}
+
+func TestDecrypt_DetectsTamperedPackage(t *testing.T) {
+	t.Parallel()
+	plain := bytes.Repeat([]byte{0x42}, 3*segmentSize)
+	enc, err := Encrypt(plain, "pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfb, err := readCFB(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream, err := cfb.stream("EncryptedPackage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Flip a byte of the first ciphertext segment in place.
+	idx := bytes.Index(enc, stream[8:24])
+	if idx < 0 {
+		t.Fatal("ciphertext not found in container")
+	}
+	enc[idx] ^= 0xFF
+	if _, err := Decrypt(enc, "pw"); !errors.Is(err, ErrIntegrity) {
+		t.Fatalf("expected ErrIntegrity, got %v", err)
+	}
+}
+
+func TestDecrypt_RejectsNonAgile(t *testing.T) {
+	t.Parallel()
+	info := []byte{0x03, 0x00, 0x02, 0x00, 0x24, 0x00, 0x00, 0x00}
+	data, err := writeCFB([]*cfbNode{
+		{name: "EncryptionInfo", data: info},
+		{name: "EncryptedPackage", data: make([]byte, 16)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Decrypt(data, "pw"); !errors.Is(err, ErrUnsupportedEncryption) {
+		t.Fatalf("expected ErrUnsupportedEncryption, got %v", err)
+	}
+}
+
+func TestCFB_RoundTrip(t *testing.T) {
+	t.Parallel()
+	small := []byte("small stream")
+	large := bytes.Repeat([]byte{1, 2, 3, 4, 5}, 5000)
+	data, err := writeCFB([]*cfbNode{
+		{name: "Small", data: small},
+		{name: "Large", data: large},
+		{name: "Empty", data: []byte{}},
+		{name: "Storage", children: []*cfbNode{
+			{name: "Nested", data: []byte("nested")},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := readCFB(data)
+	if err != nil {
+		t.Fatalf("readCFB: %v", err)
+	}
+	for _, tc := range []struct {
+		path []string
+		want []byte
+	}{
+		{[]string{"Small"}, small},
+		{[]string{"large"}, large}, // names are case-insensitive
+		{[]string{"Empty"}, []byte{}},
+		{[]string{"Storage", "Nested"}, []byte("nested")},
+	} {
+		got, err := r.stream(tc.path...)
+		if err != nil {
+			t.Errorf("stream(%v): %v", tc.path, err)
+			continue
+		}
+		if !bytes.Equal(got, tc.want) {
+			t.Errorf("stream(%v) = %d bytes, want %d", tc.path, len(got), len(tc.want))
+		}
+	}
+	if _, err := r.stream("Missing"); err == nil {
+		t.Error("expected error for missing stream")
+	}
+}
+
+func TestHasStream(t *testing.T) {
+	t.Parallel()
+	data, err := writeCFB([]*cfbNode{
+		{name: "WordDocument", data: []byte("binary")},
+		{name: "Storage", children: []*cfbNode{{name: "Nested", data: []byte("x")}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !HasStream(data, "WordDocument") || !HasStream(data, "worddocument") {
+		t.Error("HasStream(WordDocument) = false")
+	}
+	if HasStream(data, "Nested") || HasStream(data, "EncryptedPackage") {
+		t.Error("HasStream found an entry not at the root")
+	}
+	if HasStream([]byte("PK\x03\x04"), "WordDocument") {
+		t.Error("HasStream(zip) = true")
+	}
+}
+
+func TestDataSpacesStorage_Layout(t *testing.T) {
+	t.Parallel()
+	ds := dataSpacesStorage()
+	var dataSpaceMap []byte
+	for _, c := range ds.children {
+		if c.name == "DataSpaceMap" {
+			dataSpaceMap = c.data
+		}
+	}
+	// HeaderLength 8, EntryCount 1, entry length 0x68 per [MS-OFFCRYPTO].
+	want := []byte{8, 0, 0, 0, 1, 0, 0, 0, 0x68, 0, 0, 0}
+	if !bytes.HasPrefix(dataSpaceMap, want) {
+		t.Errorf("DataSpaceMap prefix = % x, want % x", dataSpaceMap[:12], want)
+	}
+}
@@ -0,0 +1,496 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// --------------------------------------------------------------------------
+// Compound File Binary format ([MS-CFB]) — the OLE2 container that wraps
+// encrypted OOXML packages. Only what encryption needs is implemented:
+// reading streams by path and writing a small storage tree.
+// --------------------------------------------------------------------------
+
+// cfbMagic is the compound file header signature.
+var cfbMagic = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+// Special sector numbers.
+const (
+	secFree       uint32 = 0xFFFFFFFF
+	secEndOfChain uint32 = 0xFFFFFFFE
+	secFAT        uint32 = 0xFFFFFFFD
+	secDIFAT      uint32 = 0xFFFFFFFC
+	noStream      uint32 = 0xFFFFFFFF
+)
+
+// Directory entry object types.
+const (
+	objStorage byte = 1
+	objStream  byte = 2
+	objRoot    byte = 5
+)
+
+const (
+	dirEntrySize     = 128
+	miniSectorSize   = 64
+	miniStreamCutoff = 4096
+	headerDIFATCount = 109
+)
+
+var errCorruptCFB = errors.New("encryption: corrupt compound file")
+
+// cfbEntry is one parsed directory entry.
+type cfbEntry struct {
+	name        string
+	objType     byte
+	left, right uint32
+	child       uint32
+	start       uint32
+	size        uint64
+}
+
+// cfbReader reads streams from an in-memory compound file.
+type cfbReader struct {
+	data       []byte
+	sectorSize int
+	fat        []uint32
+	miniFAT    []uint32
+	entries    []cfbEntry
+	miniStream []byte
+}
+
+// readCFB parses the header, FAT, directory and mini stream of data.
+func readCFB(data []byte) (*cfbReader, error) {
+	if len(data) < 512 || !bytes.Equal(data[:8], cfbMagic) {
+		return nil, fmt.Errorf("encryption: not a compound file")
+	}
+	le := binary.LittleEndian
+	shift := le.Uint16(data[0x1E:])
+	if shift != 9 && shift != 12 {
+		return nil, fmt.Errorf("%w: sector shift %d", errCorruptCFB, shift)
+	}
+	r := &cfbReader{data: data, sectorSize: 1 << shift}
+
+	// DIFAT: 109 entries in the header, then a chain of DIFAT sectors.
+	numFAT := int(le.Uint32(data[0x2C:]))
+	var fatSectors []uint32
+	for i := 0; i < headerDIFATCount && len(fatSectors) < numFAT; i++ {
+		fatSectors = append(fatSectors, le.Uint32(data[0x4C+4*i:]))
+	}
+	perSector := r.sectorSize/4 - 1
+	for sec, n := le.Uint32(data[0x44:]), 0; sec < secDIFAT && len(fatSectors) < numFAT; n++ {
+		if n > len(data)/r.sectorSize {
+			return nil, fmt.Errorf("%w: DIFAT chain loops", errCorruptCFB)
+		}
+		buf, err := r.sector(sec)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < perSector && len(fatSectors) < numFAT; i++ {
+			fatSectors = append(fatSectors, le.Uint32(buf[4*i:]))
+		}
+		sec = le.Uint32(buf[4*perSector:])
+	}
+	for _, sec := range fatSectors {
+		buf, err := r.sector(sec)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < r.sectorSize; i += 4 {
+			r.fat = append(r.fat, le.Uint32(buf[i:]))
+		}
+	}
+
+	dir, err := r.chain(le.Uint32(data[0x30:]), -1)
+	if err != nil {
+		return nil, err
+	}
+	for off := 0; off+dirEntrySize <= len(dir); off += dirEntrySize {
+		e := parseDirEntry(dir[off : off+dirEntrySize])
+		if shift == 9 {
+			// Version 3 files may leave garbage in the high size bits.
+			e.size &= 0xFFFFFFFF
+		}
+		r.entries = append(r.entries, e)
+	}
+	if len(r.entries) == 0 || r.entries[0].objType != objRoot {
+		return nil, fmt.Errorf("%w: missing root entry", errCorruptCFB)
+	}
+
+	if first := le.Uint32(data[0x3C:]); first < secDIFAT {
+		mf, err := r.chain(first, -1)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i+4 <= len(mf); i += 4 {
+			r.miniFAT = append(r.miniFAT, le.Uint32(mf[i:]))
+		}
+	}
+	root := r.entries[0]
+	if root.start < secDIFAT {
+		if r.miniStream, err = r.chain(root.start, int64(root.size)); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func parseDirEntry(b []byte) cfbEntry {
+	le := binary.LittleEndian
+	nameLen := int(le.Uint16(b[64:]))
+	if nameLen > 64 {
+		nameLen = 64
+	}
+	units := make([]uint16, 0, nameLen/2)
+	for i := 0; i+1 < nameLen; i += 2 {
+		if u := le.Uint16(b[i:]); u != 0 {
+			units = append(units, u)
+		}
+	}
+	return cfbEntry{
+		name:    string(utf16.Decode(units)),
+		objType: b[66],
+		left:    le.Uint32(b[68:]),
+		right:   le.Uint32(b[72:]),
+		child:   le.Uint32(b[76:]),
+		start:   le.Uint32(b[116:]),
+		size:    le.Uint64(b[120:]),
+	}
+}
+
+// sector returns the bytes of regular sector sec.
+func (r *cfbReader) sector(sec uint32) ([]byte, error) {
+	off := (int64(sec) + 1) * int64(r.sectorSize)
+	if off+int64(r.sectorSize) > int64(len(r.data)) {
+		// The final sector of a file may be truncated; pad it.
+		if off >= int64(len(r.data)) {
+			return nil, fmt.Errorf("%w: sector %d out of range", errCorruptCFB, sec)
+		}
+		buf := make([]byte, r.sectorSize)
+		copy(buf, r.data[off:])
+		return buf, nil
+	}
+	return r.data[off : off+int64(r.sectorSize)], nil
+}
+
+// chain concatenates the regular-sector chain starting at start, truncated
+// to size bytes when size >= 0.
+func (r *cfbReader) chain(start uint32, size int64) ([]byte, error) {
+	var out []byte
+	for sec, n := start, 0; sec < secDIFAT; n++ {
+		if int(sec) >= len(r.fat) || n > len(r.fat) {
+			return nil, fmt.Errorf("%w: bad sector chain", errCorruptCFB)
+		}
+		buf, err := r.sector(sec)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, buf...)
+		if size >= 0 && int64(len(out)) >= size {
+			break
+		}
+		sec = r.fat[sec]
+	}
+	if size >= 0 {
+		if int64(len(out)) < size {
+			return nil, fmt.Errorf("%w: stream shorter than its size", errCorruptCFB)
+		}
+		out = out[:size]
+	}
+	return out, nil
+}
+
+// miniChain reads a stream stored in the mini stream.
+func (r *cfbReader) miniChain(start uint32, size int64) ([]byte, error) {
+	var out []byte
+	for sec, n := start, 0; sec < secDIFAT && int64(len(out)) < size; n++ {
+		off := int(sec) * miniSectorSize
+		if int(sec) >= len(r.miniFAT) || n > len(r.miniFAT) || off+miniSectorSize > len(r.miniStream) {
+			return nil, fmt.Errorf("%w: bad mini sector chain", errCorruptCFB)
+		}
+		out = append(out, r.miniStream[off:off+miniSectorSize]...)
+		sec = r.miniFAT[sec]
+	}
+	if int64(len(out)) < size {
+		return nil, fmt.Errorf("%w: stream shorter than its size", errCorruptCFB)
+	}
+	return out[:size], nil
+}
+
+// stream returns the content of the stream at the given path of storage
+// names, e.g. stream("EncryptionInfo").
+func (r *cfbReader) stream(path ...string) ([]byte, error) {
+	idx := uint32(0)
+	for _, name := range path {
+		found, ok := r.findChild(r.entries[idx].child, name, 0)
+		if !ok {
+			return nil, fmt.Errorf("encryption: stream %q not found", strings.Join(path, "/"))
+		}
+		idx = found
+	}
+	e := r.entries[idx]
+	if e.objType != objStream {
+		return nil, fmt.Errorf("encryption: %q is not a stream", strings.Join(path, "/"))
+	}
+	if e.size < miniStreamCutoff {
+		return r.miniChain(e.start, int64(e.size))
+	}
+	return r.chain(e.start, int64(e.size))
+}
+
+// findChild searches the sibling tree rooted at idx for name. Names are
+// compared case-insensitively, as the format requires.
+func (r *cfbReader) findChild(idx uint32, name string, depth int) (uint32, bool) {
+	if idx == noStream || int(idx) >= len(r.entries) || depth > len(r.entries) {
+		return 0, false
+	}
+	e := r.entries[idx]
+	if strings.EqualFold(e.name, name) {
+		return idx, true
+	}
+	if found, ok := r.findChild(e.left, name, depth+1); ok {
+		return found, true
+	}
+	return r.findChild(e.right, name, depth+1)
+}
+
+// HasStream reports whether data is a compound file with an entry called
+// name at its root, e.g. "WordDocument", the stream of a binary Word
+// 97-2003 document, or "EncryptedPackage".
+func HasStream(data []byte, name string) bool {
+	r, err := readCFB(data)
+	if err != nil || len(r.entries) == 0 {
+		return false
+	}
+	_, ok := r.findChild(r.entries[0].child, name, 0)
+	return ok
+}
+
+// --------------------------------------------------------------------------
+// Writer
+// --------------------------------------------------------------------------
+
+// cfbNode is a storage or stream to be written.
+type cfbNode struct {
+	name     string
+	data     []byte     // stream content; nil for storages
+	children []*cfbNode // storage children; nil for streams
+}
+
+func (n *cfbNode) isStorage() bool { return n.children != nil }
+
+// writeCFB serializes the storage tree rooted at the children of root as a
+// version 4 compound file (4096-byte sectors).
+func writeCFB(root []*cfbNode) ([]byte, error) {
+	const sectorSize = 4096
+	le := binary.LittleEndian
+
+	// Flatten the tree into directory order: root first, then depth-first.
+	type flatEntry struct {
+		node               *cfbNode
+		left, right, child uint32
+		start              uint32
+		size               uint64
+		objType            byte
+	}
+	entries := []*flatEntry{{node: &cfbNode{name: "Root Entry", children: root}, objType: objRoot}}
+	var flatten func(parent int)
+	flatten = func(parent int) {
+		kids := append([]*cfbNode(nil), entries[parent].node.children...)
+		sort.Slice(kids, func(i, j int) bool { return cfbLess(kids[i].name, kids[j].name) })
+		idx := make([]uint32, len(kids))
+		for i, k := range kids {
+			idx[i] = uint32(len(entries))
+			typ := objStream
+			if k.isStorage() {
+				typ = objStorage
+			}
+			entries = append(entries, &flatEntry{node: k, objType: typ, left: noStream, right: noStream, child: noStream})
+		}
+		// Siblings form a balanced binary search tree.
+		var build func(lo, hi int) uint32
+		build = func(lo, hi int) uint32 {
+			if lo >= hi {
+				return noStream
+			}
+			mid := (lo + hi) / 2
+			e := entries[idx[mid]]
+			e.left, e.right = build(lo, mid), build(mid+1, hi)
+			return idx[mid]
+		}
+		entries[parent].child = build(0, len(kids))
+		for i, k := range kids {
+			if k.isStorage() {
+				flatten(int(idx[i]))
+			}
+		}
+	}
+	entries[0].left, entries[0].right = noStream, noStream
+	flatten(0)
+
+	// Lay out small streams in the mini stream and large ones in sectors.
+	var miniStream []byte
+	var miniFAT []uint32
+	var large []*flatEntry
+	for _, e := range entries[1:] {
+		if e.objType != objStream {
+			continue
+		}
+		e.size = uint64(len(e.node.data))
+		if len(e.node.data) == 0 {
+			e.start = secEndOfChain
+			continue
+		}
+		if len(e.node.data) >= miniStreamCutoff {
+			large = append(large, e)
+			continue
+		}
+		e.start = uint32(len(miniStream) / miniSectorSize)
+		n := (len(e.node.data) + miniSectorSize - 1) / miniSectorSize
+		for i := 0; i < n; i++ {
+			next := e.start + uint32(i) + 1
+			if i == n-1 {
+				next = secEndOfChain
+			}
+			miniFAT = append(miniFAT, next)
+		}
+		miniStream = append(miniStream, e.node.data...)
+		miniStream = append(miniStream, make([]byte, n*miniSectorSize-len(e.node.data))...)
+	}
+
+	sectors := func(n int) int { return (n + sectorSize - 1) / sectorSize }
+	var fat []uint32
+	var body bytes.Buffer
+	// allocate appends data as a new sector chain and returns its start.
+	allocate := func(data []byte) uint32 {
+		n := sectors(len(data))
+		if n == 0 {
+			return secEndOfChain
+		}
+		start := uint32(len(fat))
+		for i := 0; i < n; i++ {
+			next := start + uint32(i) + 1
+			if i == n-1 {
+				next = secEndOfChain
+			}
+			fat = append(fat, next)
+		}
+		body.Write(data)
+		body.Write(make([]byte, n*sectorSize-len(data)))
+		return start
+	}
+
+	entries[0].start = allocate(miniStream)
+	entries[0].size = uint64(len(miniStream))
+	if len(miniStream) == 0 {
+		entries[0].start = secEndOfChain
+	}
+	for _, e := range large {
+		e.start = allocate(e.node.data)
+	}
+	miniFATBytes := make([]byte, 4*len(miniFAT))
+	for i, v := range miniFAT {
+		le.PutUint32(miniFATBytes[4*i:], v)
+	}
+	miniFATStart := allocate(miniFATBytes)
+
+	dir := make([]byte, 0, len(entries)*dirEntrySize)
+	for _, e := range entries {
+		b := make([]byte, dirEntrySize)
+		units := utf16.Encode([]rune(e.node.name))
+		if len(units) > 31 {
+			return nil, fmt.Errorf("encryption: compound file name %q too long", e.node.name)
+		}
+		for i, u := range units {
+			le.PutUint16(b[2*i:], u)
+		}
+		le.PutUint16(b[64:], uint16(2*(len(units)+1)))
+		b[66] = e.objType
+		b[67] = 1 // black
+		le.PutUint32(b[68:], e.left)
+		le.PutUint32(b[72:], e.right)
+		le.PutUint32(b[76:], e.child)
+		le.PutUint32(b[116:], e.start)
+		le.PutUint64(b[120:], e.size)
+		dir = append(dir, b...)
+	}
+	// Pad the directory with unused entries to a whole sector.
+	for len(dir)%sectorSize != 0 {
+		b := make([]byte, dirEntrySize)
+		le.PutUint32(b[68:], noStream)
+		le.PutUint32(b[72:], noStream)
+		le.PutUint32(b[76:], noStream)
+		dir = append(dir, b...)
+	}
+	dirStart := allocate(dir)
+
+	// FAT sectors describe every sector including themselves.
+	perFAT := sectorSize / 4
+	numFAT := 0
+	for numFAT*perFAT < len(fat)+numFAT {
+		numFAT++
+	}
+	if numFAT > headerDIFATCount {
+		return nil, fmt.Errorf("encryption: package too large for compound file writer")
+	}
+	fatStart := uint32(len(fat))
+	for i := 0; i < numFAT; i++ {
+		fat = append(fat, secFAT)
+	}
+	fatBytes := make([]byte, numFAT*sectorSize)
+	for i := range fatBytes[:len(fatBytes)/4] {
+		v := secFree
+		if i < len(fat) {
+			v = fat[i]
+		}
+		le.PutUint32(fatBytes[4*i:], v)
+	}
+	body.Write(fatBytes)
+
+	header := make([]byte, sectorSize)
+	copy(header, cfbMagic)
+	le.PutUint16(header[0x18:], 0x003E) // minor version
+	le.PutUint16(header[0x1A:], 0x0004) // major version
+	le.PutUint16(header[0x1C:], 0xFFFE) // byte order
+	le.PutUint16(header[0x1E:], 12)     // sector shift
+	le.PutUint16(header[0x20:], 6)      // mini sector shift
+	le.PutUint32(header[0x28:], uint32(sectors(len(dir))))
+	le.PutUint32(header[0x2C:], uint32(numFAT))
+	le.PutUint32(header[0x30:], dirStart)
+	le.PutUint32(header[0x38:], miniStreamCutoff)
+	le.PutUint32(header[0x3C:], miniFATStart)
+	le.PutUint32(header[0x40:], uint32(sectors(len(miniFATBytes))))
+	le.PutUint32(header[0x44:], secEndOfChain)
+	for i := 0; i < headerDIFATCount; i++ {
+		v := secFree
+		if i < numFAT {
+			v = fatStart + uint32(i)
+		}
+		le.PutUint32(header[0x4C+4*i:], v)
+	}
+	if len(miniFATBytes) == 0 {
+		le.PutUint32(header[0x3C:], secEndOfChain)
+	}
+
+	return append(header, body.Bytes()...), nil
+}
+
+// cfbLess orders sibling names as the format requires: shorter names
+// first, then by upper-cased UTF-16 code units.
+func cfbLess(a, b string) bool {
+	ua, ub := utf16.Encode([]rune(strings.ToUpper(a))), utf16.Encode([]rune(strings.ToUpper(b)))
+	if len(ua) != len(ub) {
+		return len(ua) < len(ub)
+	}
+	for i := range ua {
+		if ua[i] != ub[i] {
+			return ua[i] < ub[i]
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/binary"
+	"unicode/utf16"
+)
+
+// dataSpacesStorage builds the \x06DataSpaces storage ([MS-OFFCRYPTO]
+// §2.1) that declares the EncryptedPackage stream as transformed by the
+// strong encryption transform. Office requires it alongside the
+// EncryptionInfo and EncryptedPackage streams.
+func dataSpacesStorage() *cfbNode {
+	const (
+		dataSpaceName = "StrongEncryptionDataSpace"
+		transformName = "StrongEncryptionTransform"
+	)
+
+	var version bytes.Buffer
+	writeLPP4(&version, "Microsoft.Container.DataSpaces")
+	writeVersions(&version)
+
+	// DataSpaceMap: one entry mapping EncryptedPackage to the data space.
+	var entry bytes.Buffer
+	writeU32(&entry, 1) // ReferenceComponentCount
+	writeU32(&entry, 0) // ReferenceComponentType: stream
+	writeLPP4(&entry, "EncryptedPackage")
+	writeLPP4(&entry, dataSpaceName)
+	var dataSpaceMap bytes.Buffer
+	writeU32(&dataSpaceMap, 8) // HeaderLength
+	writeU32(&dataSpaceMap, 1) // EntryCount
+	writeU32(&dataSpaceMap, uint32(entry.Len()+4))
+	dataSpaceMap.Write(entry.Bytes())
+
+	var dataSpaceDef bytes.Buffer
+	writeU32(&dataSpaceDef, 8) // HeaderLength
+	writeU32(&dataSpaceDef, 1) // TransformReferenceCount
+	writeLPP4(&dataSpaceDef, transformName)
+
+	// \x06Primary: TransformInfoHeader followed by EncryptionTransformInfo.
+	var id bytes.Buffer
+	writeU32(&id, 1) // TransformType
+	writeLPP4(&id, "{FF9A3F03-56EF-4613-BDD5-5A41C1D07246}")
+	var primary bytes.Buffer
+	writeU32(&primary, uint32(id.Len()+4)) // TransformLength
+	primary.Write(id.Bytes())
+	writeLPP4(&primary, "Microsoft.Container.EncryptionTransform")
+	writeVersions(&primary)
+	writeU32(&primary, 0) // EncryptionName (empty)
+	writeU32(&primary, 0) // EncryptionBlockSize
+	writeU32(&primary, 0) // CipherMode
+	writeU32(&primary, 4) // Reserved
+
+	return &cfbNode{name: "\x06DataSpaces", children: []*cfbNode{
+		{name: "Version", data: version.Bytes()},
+		{name: "DataSpaceMap", data: dataSpaceMap.Bytes()},
+		{name: "DataSpaceInfo", children: []*cfbNode{
+			{name: dataSpaceName, data: dataSpaceDef.Bytes()},
+		}},
+		{name: "TransformInfo", children: []*cfbNode{
+			{name: transformName, children: []*cfbNode{
+				{name: "\x06Primary", data: primary.Bytes()},
+			}},
+		}},
+	}}
+}
+
+func writeU32(buf *bytes.Buffer, v uint32) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	buf.Write(b[:])
+}
+
+// writeLPP4 writes a UNICODE-LP-P4 string: a byte length, UTF-16LE
+// characters, and zero padding to a 4-byte boundary.
+func writeLPP4(buf *bytes.Buffer, s string) {
+	units := utf16.Encode([]rune(s))
+	writeU32(buf, uint32(2*len(units)))
+	for _, u := range units {
+		buf.WriteByte(byte(u))
+		buf.WriteByte(byte(u >> 8))
+	}
+	if n := (2 * len(units)) % 4; n != 0 {
+		buf.Write(make([]byte, 4-n))
+	}
+}
+
+// writeVersions writes reader, updater and writer versions, all 1.0.
+func writeVersions(buf *bytes.Buffer) {
+	for i := 0; i < 3; i++ {
+		writeU32(buf, 1) // major 1, minor 0 as two little-endian uint16s
+	}
+}
@@ -0,0 +1,370 @@
+package opc
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FidelityReport lists what was lost or changed between an original
+// package and the package saved from it: parts dropped or added, content
+// types changed, XML elements and attributes lost, and relationships
+// dropped, added or retargeted.
+type FidelityReport struct {
+	PartsDropped  []PartChange         `json:"partsDropped,omitempty"`
+	PartsAdded    []PartChange         `json:"partsAdded,omitempty"`
+	ContentTypes  []ContentTypeChange  `json:"contentTypes,omitempty"`
+	Elements      []NameLoss           `json:"elementsLost,omitempty"`
+	Attributes    []NameLoss           `json:"attributesLost,omitempty"`
+	Relationships []RelationshipChange `json:"relationships,omitempty"`
+}
+
+// PartChange is a member present in only one of the packages.
+type PartChange struct {
+	Part        PackURI `json:"part"`
+	ContentType string  `json:"contentType,omitempty"`
+}
+
+// ContentTypeChange is a part whose content type changed.
+type ContentTypeChange struct {
+	Part      PackURI `json:"part"`
+	Original  string  `json:"original"`
+	Roundtrip string  `json:"roundtrip"`
+}
+
+// NameLoss is an element or attribute name occurring fewer times in a
+// part of the roundtrip package than in the original. Names carry the
+// prefix the original declares for their namespace; attributes are
+// written element@attribute.
+type NameLoss struct {
+	Part      PackURI `json:"part"`
+	Name      string  `json:"name"`
+	Original  int     `json:"original"`
+	Roundtrip int     `json:"roundtrip"`
+}
+
+// RelationshipChange is a relationship, identified by its source and
+// rId, that was dropped, added or changed. Internal targets are resolved
+// to part names. Type and Target are empty for an added relationship,
+// NewType and NewTarget for a dropped one.
+type RelationshipChange struct {
+	Source    PackURI `json:"source"`
+	ID        string  `json:"id"`
+	Type      string  `json:"type,omitempty"`
+	Target    string  `json:"target,omitempty"`
+	NewType   string  `json:"newType,omitempty"`
+	NewTarget string  `json:"newTarget,omitempty"`
+}
+
+// Lossless reports whether r found no differences.
+func (r *FidelityReport) Lossless() bool {
+	return len(r.PartsDropped) == 0 && len(r.PartsAdded) == 0 &&
+		len(r.ContentTypes) == 0 && len(r.Elements) == 0 &&
+		len(r.Attributes) == 0 && len(r.Relationships) == 0
+}
+
+// CompareFidelity compares the original package with the roundtrip
+// package saved from it. Both are read with the default resource limits;
+// XML that fails to parse is compared up to the error.
+func CompareFidelity(original, roundtrip []byte) (*FidelityReport, error) {
+	a, err := newFidelityPackage(original)
+	if err != nil {
+		return nil, fmt.Errorf("opc: reading original: %w", err)
+	}
+	b, err := newFidelityPackage(roundtrip)
+	if err != nil {
+		return nil, fmt.Errorf("opc: reading roundtrip: %w", err)
+	}
+
+	r := &FidelityReport{}
+	for _, name := range a.names {
+		uri := NewPackURI(name)
+		if uri == ContentTypesURI {
+			continue
+		}
+		if _, ok := b.reader.files[name]; !ok {
+			r.PartsDropped = append(r.PartsDropped, PartChange{Part: uri, ContentType: a.contentType(uri)})
+			continue
+		}
+		if isRelsURI(uri) {
+			if err := r.compareRels(a, b, uri); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		ctA, ctB := a.contentType(uri), b.contentType(uri)
+		if ctA != ctB {
+			r.ContentTypes = append(r.ContentTypes, ContentTypeChange{Part: uri, Original: ctA, Roundtrip: ctB})
+		}
+		if isXMLContentType(ctA) {
+			if err := r.compareXML(a, b, uri); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, name := range b.names {
+		uri := NewPackURI(name)
+		if _, ok := a.reader.files[name]; !ok && uri != ContentTypesURI {
+			r.PartsAdded = append(r.PartsAdded, PartChange{Part: uri, ContentType: b.contentType(uri)})
+		}
+	}
+	return r, nil
+}
+
+// fidelityPackage is one side of a fidelity comparison.
+type fidelityPackage struct {
+	reader *PhysPkgReader
+	names  []string // member names, sorted
+	cts    *ContentTypeMap
+}
+
+func newFidelityPackage(data []byte) (*fidelityPackage, error) {
+	reader, err := NewPhysPkgReaderFromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	p := &fidelityPackage{reader: reader, cts: NewContentTypeMap()}
+	for name := range reader.files {
+		p.names = append(p.names, name)
+	}
+	sort.Strings(p.names)
+	if blob, err := reader.ContentTypesXml(); err == nil {
+		if cts, err := ParseContentTypes(blob); err == nil {
+			p.cts = cts
+		}
+	}
+	return p, nil
+}
+
+// contentType returns the content type of uri, or "" when there is none.
+func (p *fidelityPackage) contentType(uri PackURI) string {
+	ct, _ := p.cts.ContentType(uri)
+	return ct
+}
+
+// isRelsURI reports whether uri names a relationships member.
+func isRelsURI(uri PackURI) bool {
+	return strings.HasSuffix(uri.BaseURI(), "_rels") && uri.Ext() == "rels"
+}
+
+// relsSource returns the source part of the relationships member uri,
+// PackageURI for the package relationships.
+func relsSource(uri PackURI) PackURI {
+	dir := strings.TrimSuffix(strings.TrimSuffix(uri.BaseURI(), "_rels"), "/")
+	return NewPackURI(dir + "/" + strings.TrimSuffix(uri.Filename(), ".rels"))
+}
+
+func (r *FidelityReport) compareRels(a, b *fidelityPackage, uri PackURI) error {
+	source := relsSource(uri)
+	relsA, err := a.rels(uri, source)
+	if err != nil {
+		return err
+	}
+	relsB, err := b.rels(uri, source)
+	if err != nil {
+		return err
+	}
+	var ids []string
+	for id := range relsA {
+		ids = append(ids, id)
+	}
+	for id := range relsB {
+		if _, ok := relsA[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		ra, rb := relsA[id], relsB[id]
+		if ra == rb {
+			continue
+		}
+		r.Relationships = append(r.Relationships, RelationshipChange{
+			Source: source, ID: id,
+			Type: ra[0], Target: ra[1],
+			NewType: rb[0], NewTarget: rb[1],
+		})
+	}
+	return nil
+}
+
+// rels returns the type and resolved target of the relationships in
+// member uri by rId. Unparsable relationships count as none.
+func (p *fidelityPackage) rels(uri, source PackURI) (map[string][2]string, error) {
+	blob, err := p.reader.BlobFor(uri)
+	if err != nil {
+		return nil, err
+	}
+	srels, _ := ParseRelationships(blob, source.BaseURI())
+	rels := make(map[string][2]string, len(srels))
+	for _, sr := range srels {
+		target := sr.TargetRef
+		if !sr.IsExternal() {
+			target = string(FromRelRef(sr.BaseURI, sr.TargetRef))
+		}
+		rels[sr.RID] = [2]string{sr.RelType, target}
+	}
+	return rels, nil
+}
+
+func (r *FidelityReport) compareXML(a, b *fidelityPackage, uri PackURI) error {
+	countsA, err := a.xmlNames(uri)
+	if err != nil {
+		return err
+	}
+	countsB, err := b.xmlNames(uri)
+	if err != nil {
+		return err
+	}
+	prefixes := countsA.prefixes
+	for space, prefix := range countsB.prefixes {
+		if _, ok := prefixes[space]; !ok {
+			prefixes[space] = prefix
+		}
+	}
+	r.Elements = append(r.Elements, lostNames(countsA.elems, countsB.elems, uri, func(k xmlNameKey) string {
+		return qualifiedName(k.elem, prefixes)
+	})...)
+	r.Attributes = append(r.Attributes, lostNames(countsA.attrs, countsB.attrs, uri, func(k xmlNameKey) string {
+		return qualifiedName(k.elem, prefixes) + "@" + qualifiedName(k.attr, prefixes)
+	})...)
+	return nil
+}
+
+// lostNames returns the keys occurring less often in b than in a, named
+// by name and sorted by name.
+func lostNames(a, b map[xmlNameKey]int, part PackURI, name func(xmlNameKey) string) []NameLoss {
+	var losses []NameLoss
+	for k, n := range a {
+		if m := b[k]; m < n {
+			losses = append(losses, NameLoss{Part: part, Name: name(k), Original: n, Roundtrip: m})
+		}
+	}
+	sort.Slice(losses, func(i, j int) bool { return losses[i].Name < losses[j].Name })
+	return losses
+}
+
+// xmlNameKey identifies an element, or an attribute of an element.
+type xmlNameKey struct {
+	elem, attr xml.Name
+}
+
+// xmlNameCounts counts the element and attribute names of an XML part.
+type xmlNameCounts struct {
+	elems    map[xmlNameKey]int
+	attrs    map[xmlNameKey]int
+	prefixes map[string]string // namespace URI → first prefix declared for it
+}
+
+func (p *fidelityPackage) xmlNames(uri PackURI) (*xmlNameCounts, error) {
+	blob, err := p.reader.BlobFor(uri)
+	if err != nil {
+		return nil, err
+	}
+	c := &xmlNameCounts{
+		elems:    map[xmlNameKey]int{},
+		attrs:    map[xmlNameKey]int{},
+		prefixes: map[string]string{},
+	}
+	if err := p.reader.checkXML(uri, blob); err != nil {
+		return c, nil
+	}
+	dec := xml.NewDecoder(bytes.NewReader(blob))
+	dec.Strict = false
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return c, nil
+		}
+		se, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		c.elems[xmlNameKey{elem: se.Name}]++
+		for _, attr := range se.Attr {
+			if attr.Name.Space == "xmlns" {
+				if _, ok := c.prefixes[attr.Value]; !ok {
+					c.prefixes[attr.Value] = attr.Name.Local
+				}
+			}
+			c.attrs[xmlNameKey{elem: se.Name, attr: attr.Name}]++
+		}
+	}
+}
+
+// qualifiedName writes name with the prefix of its namespace, or with the
+// namespace in braces when it has no known prefix.
+func qualifiedName(name xml.Name, prefixes map[string]string) string {
+	switch {
+	case name.Space == "":
+		return name.Local
+	case name.Space == "xmlns":
+		return "xmlns:" + name.Local
+	case prefixes[name.Space] != "":
+		return prefixes[name.Space] + ":" + name.Local
+	}
+	return "{" + name.Space + "}" + name.Local
+}
+
+// FidelitySummary aggregates the fidelity reports of many roundtrips,
+// counting for each kind of loss the number of files showing it.
+type FidelitySummary struct {
+	Files    int `json:"files"`
+	Lossless int `json:"lossless"`
+	// PartsDropped counts files by the content type of dropped parts.
+	PartsDropped map[string]int `json:"partsDropped,omitempty"`
+	// ElementsLost and AttributesLost count files by lost name.
+	ElementsLost   map[string]int `json:"elementsLost,omitempty"`
+	AttributesLost map[string]int `json:"attributesLost,omitempty"`
+	// RelationshipsChanged counts files by the type of changed
+	// relationships.
+	RelationshipsChanged map[string]int `json:"relationshipsChanged,omitempty"`
+}
+
+// Add adds report r of one file to s.
+func (s *FidelitySummary) Add(r *FidelityReport) {
+	s.Files++
+	if r.Lossless() {
+		s.Lossless++
+		return
+	}
+	var dropped, elems, attrs, rels []string
+	for _, p := range r.PartsDropped {
+		dropped = append(dropped, p.ContentType)
+	}
+	for _, l := range r.Elements {
+		elems = append(elems, l.Name)
+	}
+	for _, l := range r.Attributes {
+		attrs = append(attrs, l.Name)
+	}
+	for _, c := range r.Relationships {
+		if c.Type != "" {
+			rels = append(rels, c.Type)
+		} else {
+			rels = append(rels, c.NewType)
+		}
+	}
+	countFiles(&s.PartsDropped, dropped)
+	countFiles(&s.ElementsLost, elems)
+	countFiles(&s.AttributesLost, attrs)
+	countFiles(&s.RelationshipsChanged, rels)
+}
+
+// countFiles counts each distinct key of one file in *m, creating the map
+// when there are keys.
+func countFiles(m *map[string]int, keys []string) {
+	seen := map[string]bool{}
+	for _, k := range keys {
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		if *m == nil {
+			*m = map[string]int{}
+		}
+		(*m)[k]++
+	}
+}
@@ -0,0 +1,110 @@
+package opc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompareFidelity_Lossless(t *testing.T) {
+	members := map[string]string{
+		"[Content_Types].xml": minimalContentTypes,
+		"_rels/.rels":         limitsPkgRels,
+		"word/document.xml":   minimalDocumentXml,
+	}
+	pkg, err := OpenBytes(buildTestZip(t, members), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := CompareFidelity(buildTestZip(t, members), saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Lossless() {
+		t.Errorf("roundtrip not lossless: %+v", r)
+	}
+}
+
+func TestCompareFidelity_Losses(t *testing.T) {
+	docRels := `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+  <Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink" Target="https://example.com" TargetMode="External"/>
+</Relationships>`
+	original := buildTestZip(t, map[string]string{
+		"[Content_Types].xml":          minimalContentTypes,
+		"_rels/.rels":                  limitsPkgRels,
+		"word/_rels/document.xml.rels": docRels,
+		"word/styles.xml":              `<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"/>`,
+		"word/document.xml": `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:w14="http://schemas.microsoft.com/office/word/2010/wordml">
+  <w:body><w:p w14:paraId="1"><w:r><w:t>a</w:t></w:r><w:r><w:t>b</w:t></w:r></w:p></w:body>
+</w:document>`,
+	})
+	roundtrip := buildTestZip(t, map[string]string{
+		"[Content_Types].xml":          minimalContentTypes,
+		"_rels/.rels":                  limitsPkgRels,
+		"word/_rels/document.xml.rels": strings.Replace(strings.Replace(docRels, "https://example.com", "https://example.org", 1), "styles.xml", "other.xml", 1),
+		"word/other.xml":               `<x/>`,
+		"word/document.xml": `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">
+  <w:body><w:p><w:r><w:t>ab</w:t></w:r></w:p></w:body>
+</w:document>`,
+	})
+
+	r, err := CompareFidelity(original, roundtrip)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.PartsDropped) != 1 || r.PartsDropped[0].Part != "/word/styles.xml" || r.PartsDropped[0].ContentType != "application/xml" {
+		t.Errorf("PartsDropped = %+v", r.PartsDropped)
+	}
+	if len(r.PartsAdded) != 1 || r.PartsAdded[0].Part != "/word/other.xml" {
+		t.Errorf("PartsAdded = %+v", r.PartsAdded)
+	}
+
+	var elems []string
+	for _, l := range r.Elements {
+		elems = append(elems, l.Name)
+	}
+	if got := strings.Join(elems, ","); got != "w:r,w:t" {
+		t.Errorf("elements lost = %s", got)
+	}
+	if r.Elements[0].Original != 2 || r.Elements[0].Roundtrip != 1 || r.Elements[0].Part != "/word/document.xml" {
+		t.Errorf("w:r loss = %+v", r.Elements[0])
+	}
+	var attrs []string
+	for _, l := range r.Attributes {
+		attrs = append(attrs, l.Name)
+	}
+	if got := strings.Join(attrs, ","); got != "w:document@xmlns:w14,w:p@w14:paraId" {
+		t.Errorf("attributes lost = %s", got)
+	}
+
+	if len(r.Relationships) != 2 {
+		t.Fatalf("Relationships = %+v", r.Relationships)
+	}
+	styles, link := r.Relationships[0], r.Relationships[1]
+	if styles.Source != "/word/document.xml" || styles.Target != "/word/styles.xml" || styles.NewTarget != "/word/other.xml" {
+		t.Errorf("styles change = %+v", styles)
+	}
+	if link.ID != "rId2" || link.Target != "https://example.com" || link.NewTarget != "https://example.org" {
+		t.Errorf("hyperlink change = %+v", link)
+	}
+
+	var s FidelitySummary
+	s.Add(r)
+	s.Add(&FidelityReport{})
+	if s.Files != 2 || s.Lossless != 1 {
+		t.Errorf("summary files = %d, lossless = %d", s.Files, s.Lossless)
+	}
+	if s.ElementsLost["w:r"] != 1 || s.PartsDropped["application/xml"] != 1 || s.RelationshipsChanged[RTHyperlink] != 1 {
+		t.Errorf("summary = %+v", s)
+	}
+}
+
+func TestCompareFidelity_NotZip(t *testing.T) {
+	if _, err := CompareFidelity([]byte("nope"), nil); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -0,0 +1,61 @@
+package opc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/templates"
+)
+
+// addRoundtripSeeds adds the .docx files of the visual regression corpus,
+// when there are any, to the seed corpus of f.
+func addRoundtripSeeds(f *testing.F) {
+	paths, _ := filepath.Glob("../../../visual-regtest/test-files/*.docx")
+	for _, path := range paths {
+		if data, err := os.ReadFile(path); err == nil {
+			f.Add(data)
+		}
+	}
+}
+
+// FuzzOpenBytes checks that no input makes OpenBytes, or saving what it
+// opened, panic.
+func FuzzOpenBytes(f *testing.F) {
+	if data, err := templates.FS.ReadFile("default.docx"); err == nil {
+		f.Add(data)
+	}
+	f.Add(buildLimitsZip(f, minimalDocumentXml))
+	addRoundtripSeeds(f)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		pkg, err := OpenBytes(data, nil)
+		if err != nil {
+			return
+		}
+		if _, err := pkg.SaveToBytes(); err != nil {
+			return
+		}
+	})
+}
+
+// FuzzOpenPackageXML fuzzes the package-level XML of a well-formed zip,
+// which random changes to zip bytes rarely reach past the checksums: the
+// content types, the package relationships and the main part.
+func FuzzOpenPackageXML(f *testing.F) {
+	f.Add(minimalContentTypes, limitsPkgRels, minimalDocumentXml)
+	f.Add(minimalContentTypes, `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="../../word/document.xml" TargetMode="Internal"/></Relationships>`, `<a/>`)
+
+	f.Fuzz(func(t *testing.T, contentTypes, rels, document string) {
+		data := buildTestZip(t, map[string]string{
+			"[Content_Types].xml": contentTypes,
+			"_rels/.rels":         rels,
+			"word/document.xml":   document,
+		})
+		pkg, err := OpenBytes(data, nil)
+		if err != nil {
+			return
+		}
+		pkg.SaveToBytes()
+	})
+}
@@ -0,0 +1,147 @@
+package opc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/vortex/go-docx/internal/xmltok"
+)
+
+// --------------------------------------------------------------------------
+// Resource limits for opening untrusted packages
+// --------------------------------------------------------------------------
+
+// ErrPackageTooLarge is returned when the decompressed parts of a package
+// together exceed MaxTotalSize, as in a zip bomb made of many parts that
+// are each under the per-part limit.
+var ErrPackageTooLarge = errors.New("opc: decompressed package exceeds size limit")
+
+// ErrXMLTooDeep is returned when an XML part nests elements deeper than
+// MaxXMLDepth.
+var ErrXMLTooDeep = errors.New("opc: XML nesting exceeds depth limit")
+
+// ErrXMLDTD is returned when an XML part carries a document type
+// declaration. OPC forbids DTDs, and rejecting them rules out entity
+// expansion attacks such as "billion laughs".
+var ErrXMLDTD = errors.New("opc: XML part has a DTD")
+
+// ErrXMLMalformed is returned when an XML part cannot be scanned for the
+// checks above, such as a tag that is never closed.
+var ErrXMLMalformed = errors.New("opc: XML part is malformed")
+
+// DefaultMaxTotalSize is the default maximum decompressed size of all the
+// members read from a package (1 GB).
+const DefaultMaxTotalSize int64 = 1 << 30
+
+// DefaultMaxXMLDepth is the default maximum element nesting of an XML
+// part. Deeply nested tables in Word stay well below a few hundred.
+const DefaultMaxXMLDepth = 1024
+
+// OpenOptions limits the resources opening a package may use, for
+// packages from untrusted sources. The zero value of each limit selects
+// its default; the defaults also apply to Open, OpenFile and OpenBytes.
+type OpenOptions struct {
+	// Context cancels opening when it is done; the error returned then
+	// wraps the context's error. nil means opening cannot be canceled.
+	Context context.Context
+	// MaxTotalSize caps the decompressed size of all parts together;
+	// 0 means DefaultMaxTotalSize.
+	MaxTotalSize int64
+	// MaxPartSize caps the decompressed size of each part; 0 means
+	// DefaultMaxPartSize.
+	MaxPartSize int64
+	// MaxParts caps the number of entries in the ZIP archive; 0 means
+	// DefaultMaxEntries.
+	MaxParts int
+	// MaxXMLDepth caps the element nesting of XML parts; 0 means
+	// DefaultMaxXMLDepth.
+	MaxXMLDepth int
+	// Progress, if set, is called as parts are read ("read", counted
+	// against the entries of the archive, which ends at total even if some
+	// entries are not parts) and then loaded ("load").
+	Progress ProgressFunc
+}
+
+// ProgressFunc receives the progress of a long operation: done of total
+// steps of the named stage are complete. It is called on the goroutine
+// running the operation, so it should return quickly.
+type ProgressFunc func(stage string, done, total int)
+
+// canceled returns the error of the reader's context once it is done.
+func (p *PhysPkgReader) canceled() error {
+	if p.ctx == nil {
+		return nil
+	}
+	if err := p.ctx.Err(); err != nil {
+		return fmt.Errorf("opc: opening package: %w", err)
+	}
+	return nil
+}
+
+// checkXML rejects XML member uri if it nests elements deeper than
+// MaxXMLDepth or declares a DTD, or if it cannot be scanned: markup the
+// check cannot see through could hide either.
+func (p *PhysPkgReader) checkXML(uri PackURI, blob []byte) error {
+	maxDepth := p.MaxXMLDepth
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxXMLDepth
+	}
+	sc := xmltok.NewScanner(blob)
+	depth := 0
+	for {
+		tok, err := sc.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrXMLMalformed, uri, err)
+		}
+		switch tok.Kind {
+		case xmltok.StartElement:
+			depth++
+			if depth > maxDepth {
+				return fmt.Errorf("%w: %s nests more than %d elements", ErrXMLTooDeep, uri, maxDepth)
+			}
+			if tok.SelfClosing {
+				depth--
+			}
+		case xmltok.EndElement:
+			depth--
+		case xmltok.Directive:
+			if tok.IsDoctype() {
+				return fmt.Errorf("%w: %s", ErrXMLDTD, uri)
+			}
+		}
+	}
+}
+
+// isXMLContentType reports whether ct is the content type of an XML part.
+func isXMLContentType(ct string) bool {
+	ct, _, _ = strings.Cut(ct, ";")
+	return strings.HasSuffix(ct, "+xml") || strings.HasSuffix(ct, "/xml")
+}
+
+// isOpaqueRelType reports whether the parts relType relates to are kept
+// as opaque blobs whatever their content type: the sources of alternative
+// format imports (w:altChunk), where the WordprocessingML main content
+// type stands for a whole .docx package rather than an XML part.
+func isOpaqueRelType(relType string) bool {
+	return relType == RTAFChunk
+}
+
+// ctxReader fails reads once its context is done, so inflating a large
+// member stops promptly on cancellation.
+type ctxReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *ctxReader) Read(b []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(b)
+}
@@ -0,0 +1,64 @@
+package opc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+const limitsPkgRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1"
+    Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
+    Target="word/document.xml"/>
+</Relationships>`
+
+// buildLimitsZip returns a minimal package whose main part is documentXml.
+func buildLimitsZip(t testing.TB, documentXml string) []byte {
+	t.Helper()
+	return buildTestZip(t, map[string]string{
+		"[Content_Types].xml": minimalContentTypes,
+		"_rels/.rels":         limitsPkgRels,
+		"word/document.xml":   documentXml,
+	})
+}
+
+func TestOpenWithOptions_Limits(t *testing.T) {
+	long := `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body><w:p><w:r><w:t>` +
+		strings.Repeat("x", 4000) + `</w:t></w:r></w:p></w:body></w:document>`
+	deep := `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		strings.Repeat("<w:sdt>", 40) + strings.Repeat("</w:sdt>", 40) + `</w:document>`
+	dtd := `<?xml version="1.0"?><!DOCTYPE lol [<!ENTITY lol "lol">]>` + minimalDocumentXml[strings.Index(minimalDocumentXml, "<w:document"):]
+	// An unterminated comment must not let the DTD after it through.
+	malformed := `<?xml version="1.0"?><!-- <!DOCTYPE lol [<!ENTITY lol "lol">]>` + minimalDocumentXml[strings.Index(minimalDocumentXml, "<w:document"):]
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		doc  string
+		opts *OpenOptions
+		want error
+	}{
+		{"total size", long, &OpenOptions{MaxTotalSize: 3000}, ErrPackageTooLarge},
+		{"part size", long, &OpenOptions{MaxPartSize: 3000}, ErrPartTooLarge},
+		{"parts", minimalDocumentXml, &OpenOptions{MaxParts: 2}, ErrTooManyEntries},
+		{"depth", deep, &OpenOptions{MaxXMLDepth: 20}, ErrXMLTooDeep},
+		{"dtd", dtd, nil, ErrXMLDTD},
+		{"malformed", malformed, nil, ErrXMLMalformed},
+		{"context", minimalDocumentXml, &OpenOptions{Context: canceled}, context.Canceled},
+	}
+	for _, tt := range tests {
+		_, err := OpenBytesWithOptions(buildLimitsZip(t, tt.doc), nil, tt.opts)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	for _, doc := range []string{long, deep} {
+		if _, err := OpenBytesWithOptions(buildLimitsZip(t, doc), nil, nil); err != nil {
+			t.Errorf("default limits: %v", err)
+		}
+	}
+}
@@ -59,7 +59,10 @@ func SerializeContentTypes(parts []PartInfo) ([]byte, error) {
 	for _, p := range parts {
 		ext := p.PartName.Ext()
 		ct := p.ContentType
-		if IsDefaultContentType(ext, ct) {
+		// An extension has one default; parts sharing it with another
+		// content type (a printer-settings .bin next to an embedded OLE
+		// .bin, say) need overrides to keep their own.
+		if IsDefaultContentType(ext, ct) && (!defaults.Has(ext) || defaults.Get(ext) == ct) {
 			defaults.Set(ext, ct)
 		} else {
 			overrides[string(p.PartName)] = ct
@@ -146,14 +149,21 @@ func ParseRelationships(blob []byte, baseURI string) ([]SerializedRelationship,
 // recomputed from the part's current partname — matching Python's dynamic
 // _Relationship.target_ref property behavior.
 func SerializeRelationships(rels *Relationships) ([]byte, error) {
+	return serializeRelationships(rels.All(), rels.BaseURI())
+}
+
+// serializeRelationships serializes list, relationships from baseURI.
+func serializeRelationships(list []*Relationship, baseURI string) ([]byte, error) {
 	xrels := xmlRelationships{}
 
-	for _, rel := range rels.All() {
+	for _, rel := range list {
 		targetRef := rel.TargetRef
 		// Recompute target_ref for internal rels with resolved parts,
-		// matching Python: target.partname.relative_ref(self._baseURI)
-		if !rel.IsExternal && rel.TargetPart != nil {
-			targetRef = rel.TargetPart.PartName().RelativeRef(rels.BaseURI())
+		// matching Python: target.partname.relative_ref(self._baseURI).
+		// A reference that still resolves to the part is kept as written,
+		// so a relationship read as "/word/document.xml" stays so.
+		if !rel.IsExternal && rel.TargetPart != nil && FromRelRef(baseURI, targetRef) != rel.TargetPart.PartName() {
+			targetRef = rel.TargetPart.PartName().RelativeRef(baseURI)
 		}
 		xr := xmlRelationship{
 			ID:     rel.RID,
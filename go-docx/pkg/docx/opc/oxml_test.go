@@ -328,3 +328,24 @@ func TestContentType_InferenceCaseInsensitive(t *testing.T) {
 		}
 	}
 }
+
+func TestSerializeContentTypes_SharedExtension(t *testing.T) {
+	parts := []PartInfo{
+		{PartName: "/word/printerSettings/printerSettings1.bin", ContentType: CTWmlPrinterSettings},
+		{PartName: "/word/embeddings/printerSettings2.bin", ContentType: CTPmlPrinterSettings},
+	}
+	blob, err := SerializeContentTypes(parts)
+	if err != nil {
+		t.Fatalf("SerializeContentTypes: %v", err)
+	}
+	ct, err := ParseContentTypes(blob)
+	if err != nil {
+		t.Fatalf("ParseContentTypes: %v", err)
+	}
+	for _, p := range parts {
+		got, err := ct.ContentType(p.PartName)
+		if err != nil || got != p.ContentType {
+			t.Errorf("%s: got %q, %v; want %q", p.PartName, got, err, p.ContentType)
+		}
+	}
+}
@@ -2,17 +2,27 @@ package opc
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"sort"
 )
 
+// ErrPartNotFound is returned when a relationship that should lead to a
+// part is missing or has no target part in the package.
+var ErrPartNotFound = errors.New("opc: part not found")
+
 // OpcPackage is the root object representing an OPC package.
 type OpcPackage struct {
 	rels        *Relationships
 	partFactory *PartFactory
 	parts       map[PackURI]Part
 	appPkg      any // application-level package (e.g. *parts.WmlPackage); mirrors Python Package(OpcPackage) inheritance
+
+	// strict records that parts were read in Strict conformance and
+	// normalized to Transitional.
+	strict bool
 }
 
 // NewOpcPackage creates an empty OpcPackage.
@@ -48,17 +58,23 @@ func (p *OpcPackage) SetAppPackage(app any) {
 
 // Open reads an OPC package from an io.ReaderAt.
 func Open(r io.ReaderAt, size int64, factory *PartFactory) (*OpcPackage, error) {
-	physReader, err := NewPhysPkgReader(r, size)
-	if err != nil {
-		return nil, err
-	}
-	defer physReader.Close()
-	return openFromPhysReader(physReader, factory)
+	return OpenWithOptions(r, size, factory, nil)
 }
 
 // OpenFile opens an OPC package from a file path.
 func OpenFile(path string, factory *PartFactory) (*OpcPackage, error) {
-	physReader, err := NewPhysPkgReaderFromFile(path)
+	return OpenFileWithOptions(path, factory, nil)
+}
+
+// OpenBytes opens an OPC package from in-memory bytes.
+func OpenBytes(data []byte, factory *PartFactory) (*OpcPackage, error) {
+	return OpenBytesWithOptions(data, factory, nil)
+}
+
+// OpenWithOptions is like Open but applies the resource limits of opts,
+// which may be nil.
+func OpenWithOptions(r io.ReaderAt, size int64, factory *PartFactory, opts *OpenOptions) (*OpcPackage, error) {
+	physReader, err := newPhysPkgReader(r, size, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -66,9 +82,10 @@ func OpenFile(path string, factory *PartFactory) (*OpcPackage, error) {
 	return openFromPhysReader(physReader, factory)
 }
 
-// OpenBytes opens an OPC package from in-memory bytes.
-func OpenBytes(data []byte, factory *PartFactory) (*OpcPackage, error) {
-	physReader, err := NewPhysPkgReaderFromBytes(data)
+// OpenFileWithOptions is like OpenFile but applies the resource limits of
+// opts, which may be nil.
+func OpenFileWithOptions(path string, factory *PartFactory, opts *OpenOptions) (*OpcPackage, error) {
+	physReader, err := newPhysPkgReaderFromFile(path, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +93,12 @@ func OpenBytes(data []byte, factory *PartFactory) (*OpcPackage, error) {
 	return openFromPhysReader(physReader, factory)
 }
 
+// OpenBytesWithOptions is like OpenBytes but applies the resource limits
+// of opts, which may be nil.
+func OpenBytesWithOptions(data []byte, factory *PartFactory, opts *OpenOptions) (*OpcPackage, error) {
+	return OpenWithOptions(bytes.NewReader(data), int64(len(data)), factory, opts)
+}
+
 func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory) (*OpcPackage, error) {
 	if factory == nil {
 		factory = NewPartFactory()
@@ -90,12 +113,18 @@ func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory) (*OpcPa
 
 	// Unmarshal: create parts
 	parts := make(map[PackURI]Part, len(result.SParts))
-	for _, sp := range result.SParts {
+	for i, sp := range result.SParts {
+		if err := physReader.canceled(); err != nil {
+			return nil, err
+		}
 		part, err := factory.New(sp.Partname, sp.ContentType, sp.RelType, sp.Blob, pkg)
 		if err != nil {
 			return nil, fmt.Errorf("opc: creating part %q: %w", sp.Partname, err)
 		}
 		parts[sp.Partname] = part
+		if physReader.progress != nil {
+			physReader.progress("load", i+1, len(result.SParts))
+		}
 	}
 
 	// Wire up package-level relationships.
@@ -116,6 +145,7 @@ func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory) (*OpcPa
 		}
 		pkg.rels.Load(srel.RID, srel.RelType, srel.TargetRef, targetPart, srel.IsExternal())
 	}
+	pkg.rels.setStored(result.pkgRelsBlob, result.PkgSRels)
 
 	// Wire up part-level relationships.
 	// Mirrors the same Python loop with source = parts[source_uri].
@@ -134,11 +164,21 @@ func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory) (*OpcPa
 			}
 			rels.Load(srel.RID, srel.RelType, srel.TargetRef, targetPart, srel.IsExternal())
 		}
+		rels.setStored(sp.relsBlob, sp.SRels)
 		part.SetRels(rels)
 	}
 
 	pkg.parts = parts
 
+	// Remember the stored members for incremental save, before anything
+	// can modify the parts.
+	for _, sp := range result.SParts {
+		pkg.strict = pkg.strict || sp.strict
+		if stored, ok := parts[sp.Partname].(storedPart); ok && sp.raw != nil {
+			stored.setStoredMember(sp.raw)
+		}
+	}
+
 	// Call AfterUnmarshal on all parts in load order (Python iterates
 	// parts.values() which preserves insertion order from iter_sparts).
 	for _, sp := range result.SParts {
@@ -148,12 +188,24 @@ func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory) (*OpcPa
 	return pkg, nil
 }
 
+// Strict reports whether the package was read from a Strict (ISO 29500
+// Strict) document. Its parts then were normalized to Transitional
+// namespaces when read; save with PackageWriter.Strict to write them in
+// Strict form again.
+func (p *OpcPackage) Strict() bool { return p.strict }
+
 // --------------------------------------------------------------------------
 // Save
 // --------------------------------------------------------------------------
 
 // Save writes the package to an io.Writer.
 func (p *OpcPackage) Save(w io.Writer) error {
+	return p.SaveWith(w, &PackageWriter{})
+}
+
+// SaveWith writes the package to w using pw, which selects canonical
+// output and the member timestamps.
+func (p *OpcPackage) SaveWith(w io.Writer, pw *PackageWriter) error {
 	// Collect parts once via deterministic DFS traversal (mirrors Python
 	// Package.save which calls self.parts → list(self.iter_parts()) for
 	// both before_marshal and PackageWriter.write).
@@ -163,8 +215,12 @@ func (p *OpcPackage) Save(w io.Writer) error {
 		part.BeforeMarshal()
 	}
 
-	pw := &PackageWriter{}
-	return pw.Write(w, p.rels, parts)
+	if pw.Canonical {
+		return pw.Write(w, p.rels, parts)
+	}
+	incremental := *pw
+	incremental.incremental = true
+	return incremental.Write(w, p.rels, parts)
 }
 
 // SaveToFile writes the package to a file.
@@ -219,7 +275,7 @@ func (p *OpcPackage) RelatedPart(relType string) (Part, error) {
 		return nil, err
 	}
 	if rel.IsExternal || rel.TargetPart == nil {
-		return nil, fmt.Errorf("opc: relationship %q is external or unresolved", relType)
+		return nil, fmt.Errorf("%w: relationship %q is external or unresolved", ErrPartNotFound, relType)
 	}
 	return rel.TargetPart, nil
 }
@@ -240,6 +296,108 @@ func (p *OpcPackage) AddPart(part Part) {
 	p.parts[part.PartName()] = part
 }
 
+// DropUnreachableParts removes the parts that can no longer be reached
+// through the relationship graph and returns them, sorted by partname.
+// Such parts are never saved, but they still reserve their partnames.
+func (p *OpcPackage) DropUnreachableParts() []Part {
+	reachable := make(map[Part]bool, len(p.parts))
+	for _, part := range p.IterParts() {
+		reachable[part] = true
+	}
+	var dropped []Part
+	for pn, part := range p.parts {
+		if !reachable[part] {
+			dropped = append(dropped, part)
+			delete(p.parts, pn)
+		}
+	}
+	sort.Slice(dropped, func(i, j int) bool { return dropped[i].PartName() < dropped[j].PartName() })
+	return dropped
+}
+
+// Clone returns an independent copy of the package, built without
+// serializing or re-parsing it. The copy is made on write: the XML parts
+// of both packages share their trees until one of them hands its tree out
+// for writing (XmlPart.Element or SetElement), which copies the tree
+// first. Trees already handed out by p may be referenced by callers, so
+// those are copied up front. Binary parts (images, fonts, embedded files)
+// share their bytes with the original, which is safe because parts only
+// ever replace their blob, never modify it in place. Only the parts
+// reachable through the relationship graph are copied, as for Save.
+//
+// Clone does not change the content of p, so several goroutines may clone
+// the same package at once as long as none of them modifies it.
+func (p *OpcPackage) Clone() (*OpcPackage, error) {
+	clone := NewOpcPackage(p.partFactory)
+	src := p.IterParts()
+
+	// The part factory picks some part types by relationship type.
+	relTypes := make(map[Part]string, len(src))
+	for _, rel := range p.IterRels() {
+		if !rel.IsExternal && rel.TargetPart != nil {
+			if _, ok := relTypes[rel.TargetPart]; !ok {
+				relTypes[rel.TargetPart] = rel.RelType
+			}
+		}
+	}
+
+	copies := make(map[Part]Part, len(src))
+	for _, part := range src {
+		var cp Part
+		if xp, ok := part.(interface{ xmlPart() *XmlPart }); ok && xp.xmlPart() != nil {
+			stub, err := xp.xmlPart().stubBlob()
+			if err != nil {
+				return nil, err
+			}
+			cp, err = p.partFactory.New(part.PartName(), part.ContentType(), relTypes[part], stub, clone)
+			if err != nil {
+				return nil, fmt.Errorf("opc: cloning part %q: %w", part.PartName(), err)
+			}
+			cxp, ok := cp.(interface{ xmlPart() *XmlPart })
+			if !ok || cxp.xmlPart() == nil {
+				return nil, fmt.Errorf("opc: cloning part %q: factory built %T, not an XML part", part.PartName(), cp)
+			}
+			xp.xmlPart().cloneInto(cxp.xmlPart())
+		} else {
+			blob, err := part.Blob()
+			if err != nil {
+				return nil, fmt.Errorf("opc: cloning part %q: %w", part.PartName(), err)
+			}
+			cp, err = p.partFactory.New(part.PartName(), part.ContentType(), relTypes[part], blob, clone)
+			if err != nil {
+				return nil, fmt.Errorf("opc: cloning part %q: %w", part.PartName(), err)
+			}
+		}
+		if stored, ok := cp.(storedPart); ok {
+			stored.setStoredMember(storedMemberOf(part))
+		}
+		copies[part] = cp
+		clone.parts[cp.PartName()] = cp
+	}
+
+	copyRels := func(from, to *Relationships) {
+		for _, rel := range from.All() {
+			var target Part
+			if !rel.IsExternal && rel.TargetPart != nil {
+				target = copies[rel.TargetPart]
+			}
+			to.Load(rel.RID, rel.RelType, rel.TargetRef, target, rel.IsExternal)
+		}
+		to.setStored(from.stored, from.storedRels)
+	}
+	copyRels(p.rels, clone.rels)
+	for _, part := range src {
+		cp := copies[part]
+		rels := NewRelationships(cp.PartName().BaseURI())
+		copyRels(part.Rels(), rels)
+		cp.SetRels(rels)
+	}
+	for _, part := range src {
+		copies[part].AfterUnmarshal()
+	}
+	return clone, nil
+}
+
 // NextPartname returns the next available partname matching the template (printf-style).
 // E.g. NextPartname("/word/header%d.xml") might return "/word/header1.xml".
 func (p *OpcPackage) NextPartname(template string) PackURI {
@@ -1,9 +1,17 @@
 package opc
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
 
 	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/internal/xmlintern"
+	"github.com/vortex/go-docx/internal/xmltok"
 )
 
 // --------------------------------------------------------------------------
@@ -32,6 +40,13 @@ type BasePart struct {
 	blob        []byte
 	rels        *Relationships
 	pkg         *OpcPackage
+
+	// stored is the ZIP member the part was loaded from, for incremental
+	// save (see source.go). It is used while modified is 0.
+	stored *rawMember
+	// modified is set to 1, atomically, once the part may have changed
+	// since it was loaded, see MarkDirty.
+	modified uint32
 }
 
 // NewBasePart creates a new BasePart.
@@ -59,9 +74,25 @@ func (p *BasePart) SetPartName(pn PackURI) {
 	p.partName = pn
 }
 
+// SetContentType changes the content type written to [Content_Types].xml,
+// e.g. when converting a macro-enabled main part to a plain document.
+func (p *BasePart) SetContentType(ct string) {
+	p.contentType = ct
+}
+
 // SetBlob replaces the blob.
 func (p *BasePart) SetBlob(blob []byte) {
 	p.blob = blob
+	p.stored = nil
+	p.MarkDirty()
+}
+
+// MarkDirty records that the content of the part may have changed since
+// it was loaded, so Save serializes it rather than copying the stored
+// member. It is safe to call concurrently, e.g. from readers that hand
+// out mutable elements.
+func (p *BasePart) MarkDirty() {
+	atomic.StoreUint32(&p.modified, 1)
 }
 
 // --------------------------------------------------------------------------
@@ -77,9 +108,30 @@ const xmlProcInst = `version="1.0" encoding="UTF-8" standalone="yes"`
 // *etree.Element. This lets Blob() serialize the tree directly without
 // the deep-copy that would be required if we had to re-parent the element
 // into a temporary Document via SetRoot on every call.
+//
+// The XML is decoded lazily: NewXmlPart only checks that it is well
+// formed, and the tree, elements and attributes, is built the first time
+// it is needed. Parts a caller never reads are never decoded, and are
+// saved by copying their stored member (see source.go).
+//
+// Clones of a package share the trees of the XML parts until a part hands
+// its tree out for writing: Element copies a shared tree first.
 type XmlPart struct {
 	BasePart
+
+	mu  sync.Mutex // guards the fields below
 	doc *etree.Document
+	// raw is the XML the part was created from, until it is decoded into
+	// doc. decodeErr is the error decoding it, if any.
+	raw       []byte
+	decodeErr error
+	// shared is set while doc is shared with parts of other packages, see
+	// OpcPackage.Clone. A shared tree is never modified.
+	shared bool
+	// exposed is set once the tree may be referenced from outside the
+	// part: it was handed out by Element, or adopted by SetElement or
+	// NewXmlPartFromElement. Such a tree is never shared.
+	exposed bool
 }
 
 // newXmlDoc creates a Document pre-configured with the standard OPC XML
@@ -107,21 +159,73 @@ func ensureProcInst(doc *etree.Document) {
 	doc.Child = append([]etree.Token{pi}, doc.Child...)
 }
 
-// NewXmlPart creates an XmlPart by parsing the blob as XML.
+// NewXmlPart creates an XmlPart from the XML in blob. The blob is checked
+// to be well formed, and decoded when the part is first read.
 func NewXmlPart(partName PackURI, contentType string, blob []byte, pkg *OpcPackage) (*XmlPart, error) {
+	if err := checkWellFormed(blob); err != nil {
+		return nil, err
+	}
+	return &XmlPart{
+		BasePart: *NewBasePart(partName, contentType, nil, pkg),
+		raw:      blob,
+	}, nil
+}
+
+// decodeXml parses blob into a Document, interning its strings.
+func decodeXml(blob []byte) (*etree.Document, error) {
 	doc := etree.NewDocument()
 	doc.ReadSettings.Permissive = true
 	doc.WriteSettings.CanonicalEndTags = true
 	if err := doc.ReadFromBytes(blob); err != nil {
 		return nil, err
 	}
+	xmlintern.Tree(doc.Root())
 	// Normalize the declaration so Blob() output matches the previous
 	// implementation that always wrote a fresh standalone="yes" header.
 	ensureProcInst(doc)
-	return &XmlPart{
-		BasePart: *NewBasePart(partName, contentType, nil, pkg),
-		doc:      doc,
-	}, nil
+	return doc, nil
+}
+
+// checkWellFormed reports an error if blob is not well-formed XML as far
+// as a scan can tell: every token is terminated and end tags match their
+// start tags. It builds no tree.
+func checkWellFormed(blob []byte) error {
+	sc := xmltok.NewScanner(blob)
+	var open [][]byte
+	for {
+		tok, err := sc.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch tok.Kind {
+		case xmltok.StartElement:
+			if !tok.SelfClosing {
+				open = append(open, tok.Name)
+			}
+		case xmltok.EndElement:
+			if len(open) == 0 || !bytes.Equal(open[len(open)-1], tok.Name) {
+				return fmt.Errorf("opc: unexpected end tag </%s> at offset %d", tok.Name, sc.Offset())
+			}
+			open = open[:len(open)-1]
+		}
+	}
+	if len(open) > 0 {
+		return fmt.Errorf("opc: element <%s> is not closed", open[len(open)-1])
+	}
+	return nil
+}
+
+// decode builds the tree of the part if it has not been yet. p.mu must be
+// held. A part that cannot be decoded has no tree; Blob reports the error.
+func (p *XmlPart) decode() {
+	if p.raw == nil {
+		return
+	}
+	p.doc, p.decodeErr = decodeXml(p.raw)
+	p.raw = nil
 }
 
 // NewXmlPartFromElement creates an XmlPart from an existing element.
@@ -133,26 +237,116 @@ func NewXmlPartFromElement(partName PackURI, contentType string, element *etree.
 	return &XmlPart{
 		BasePart: *NewBasePart(partName, contentType, nil, pkg),
 		doc:      doc,
+		exposed:  true,
 	}
 }
 
 // Element returns the root XML element, or nil if the document is empty.
+// Callers may change the tree, so the part is marked dirty (see MarkDirty)
+// and serialized anew on save. Code that only reads the tree uses
+// ReadElement instead.
 func (p *XmlPart) Element() *etree.Element {
+	p.MarkDirty()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.decode()
 	if p.doc == nil {
 		return nil
 	}
+	if p.shared {
+		p.doc = p.doc.Copy()
+		p.shared = false
+	}
+	p.exposed = true
 	return p.doc.Root()
 }
 
+// ReadElement returns the root XML element, or nil if the document is
+// empty, for reading only: the part is not marked dirty, so callers must
+// not change the tree.
+func (p *XmlPart) ReadElement() *etree.Element {
+	if doc, _ := p.document(); doc != nil {
+		return doc.Root()
+	}
+	return nil
+}
+
 // SetElement replaces the root XML element.
 // The element is adopted by the internal Document.
 func (p *XmlPart) SetElement(el *etree.Element) {
-	if p.doc == nil {
+	p.stored = nil
+	p.MarkDirty()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.raw, p.decodeErr = nil, nil
+	if p.doc == nil || p.shared {
 		p.doc = newXmlDoc()
+		p.shared = false
 	}
+	p.exposed = true
 	p.doc.SetRoot(el)
 }
 
+// document returns the XML document of the part, for reading, and the
+// error decoding it.
+func (p *XmlPart) document() (*etree.Document, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.decode()
+	return p.doc, p.decodeErr
+}
+
+// xmlPart gives Clone access to the XmlPart embedded in a typed part.
+func (p *XmlPart) xmlPart() *XmlPart { return p }
+
+// cloneInto gives cp, the copy of p in a cloned package, the tree of p.
+// The tree is shared until either part hands it out for writing, unless it
+// is exposed already; then cp gets a copy. A part not decoded yet gives cp
+// its XML instead, for cp to decode when it is read.
+func (p *XmlPart) cloneInto(cp *XmlPart) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if p.raw != nil {
+		cp.doc, cp.raw, cp.decodeErr, cp.shared, cp.exposed = nil, p.raw, nil, false, false
+		return
+	}
+	cp.raw, cp.decodeErr = nil, p.decodeErr
+	cp.exposed = false
+	if p.exposed {
+		cp.doc, cp.shared = p.doc.Copy(), false
+		return
+	}
+	p.shared = true
+	cp.doc, cp.shared = p.doc, true
+}
+
+// stubBlob returns the root element of the part without its content,
+// serialized: enough for a part constructor to accept, cheap to parse. A
+// part not decoded yet returns its XML, which is no more costly to take.
+func (p *XmlPart) stubBlob() ([]byte, error) {
+	p.mu.Lock()
+	raw, doc := p.raw, p.doc
+	p.mu.Unlock()
+	if raw != nil {
+		return raw, nil
+	}
+	var root *etree.Element
+	if doc != nil {
+		root = doc.Root()
+	}
+	if root == nil {
+		return nil, fmt.Errorf("opc: XML part %q has no root element", p.partName)
+	}
+	stub := etree.NewElement(root.Tag)
+	stub.Space = root.Space
+	stub.Attr = append([]etree.Attr(nil), root.Attr...)
+	out := newXmlDoc()
+	out.SetRoot(stub)
+	return out.WriteToBytes()
+}
+
 // Blob serializes the XML document to bytes.
 // Output is compact (no insignificant whitespace), with a standard
 // XML declaration — matching Python's serialize_part_xml behavior.
@@ -160,10 +354,14 @@ func (p *XmlPart) SetElement(el *etree.Element) {
 // Unlike the previous implementation, no deep-copy of the element tree
 // is performed: the Document already owns the root element.
 func (p *XmlPart) Blob() ([]byte, error) {
-	if p.doc == nil || p.doc.Root() == nil {
+	doc, err := p.document()
+	if err != nil {
+		return nil, fmt.Errorf("opc: decoding XML part %q: %w", p.partName, err)
+	}
+	if doc == nil || doc.Root() == nil {
 		return nil, nil
 	}
-	b, err := p.doc.WriteToBytes()
+	b, err := doc.WriteToBytes()
 	if err != nil {
 		return nil, fmt.Errorf("opc: serializing XML part %q: %w", p.partName, err)
 	}
@@ -176,6 +374,49 @@ func (p *XmlPart) Blob() ([]byte, error) {
 	return b, nil
 }
 
+// canonicalBlob serializes the XML document as Blob does, with the
+// attributes of every element sorted: namespace declarations first, then
+// by prefix and name. The document itself is not changed.
+func (p *XmlPart) canonicalBlob() ([]byte, error) {
+	doc, err := p.document()
+	if err != nil {
+		return nil, fmt.Errorf("opc: decoding XML part %q: %w", p.partName, err)
+	}
+	if doc == nil || doc.Root() == nil {
+		return nil, nil
+	}
+	doc = doc.Copy()
+	sortAttrs(doc.Root())
+	b, err := doc.WriteToBytes()
+	if err != nil {
+		return nil, fmt.Errorf("opc: serializing XML part %q: %w", p.partName, err)
+	}
+	return escapeAttrWhitespace(b), nil
+}
+
+// sortAttrs sorts the attributes of el and its descendants.
+func sortAttrs(el *etree.Element) {
+	rank := func(a etree.Attr) int {
+		if a.Space == "xmlns" || (a.Space == "" && a.Key == "xmlns") {
+			return 0
+		}
+		return 1
+	}
+	sort.SliceStable(el.Attr, func(i, j int) bool {
+		a, b := el.Attr[i], el.Attr[j]
+		if rank(a) != rank(b) {
+			return rank(a) < rank(b)
+		}
+		if a.Space != b.Space {
+			return a.Space < b.Space
+		}
+		return a.Key < b.Key
+	})
+	for _, child := range el.ChildElements() {
+		sortAttrs(child)
+	}
+}
+
 // escapeAttrWhitespace re-encodes literal \n, \r, and \t inside XML
 // attribute values to their character-reference forms (&#10; &#13; &#9;).
 //
@@ -286,8 +527,13 @@ func (f *PartFactory) SetSelector(sel func(contentType, relType string) PartCons
 }
 
 // New creates a Part using the registered constructors.
-// Falls back to BasePart if no constructor matches.
+// Falls back to BasePart if no constructor matches. Parts related by an
+// opaque relationship type, such as the sources of w:altChunk, are always
+// BaseParts.
 func (f *PartFactory) New(partName PackURI, contentType, relType string, blob []byte, pkg *OpcPackage) (Part, error) {
+	if isOpaqueRelType(relType) {
+		return NewBasePart(partName, contentType, blob, pkg), nil
+	}
 	// Try selector first
 	if f.selector != nil {
 		if ctor := f.selector(contentType, relType); ctor != nil {
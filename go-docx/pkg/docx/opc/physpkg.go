@@ -3,11 +3,13 @@ package opc
 import (
 	"archive/zip"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"strings"
+	"time"
 )
 
 // ErrMemberNotFound is returned by BlobFor when the requested member
@@ -23,7 +25,8 @@ var ErrNotZipPackage = errors.New("opc: not a ZIP-based OPC package")
 
 // ErrEncryptedPackage is returned when the input appears to be an OLE2
 // Compound Document (encrypted .docx).  Such files require decryption
-// before they can be opened as OPC packages.
+// before they can be opened as OPC packages; see package
+// opc/encryption.
 var ErrEncryptedPackage = errors.New("opc: file is encrypted (OLE2 Compound Document, not a ZIP-based package)")
 
 // ErrPartTooLarge is returned by BlobFor when a decompressed part
@@ -53,23 +56,43 @@ const DefaultMaxEntries = 10_000
 
 // PhysPkgReader provides low-level access to a ZIP-based OPC package.
 type PhysPkgReader struct {
-	reader      *zip.Reader
-	closer      io.Closer // non-nil when opened from a file
-	files       map[string]*zip.File
-	MaxPartSize int64 // maximum decompressed size per part; 0 means DefaultMaxPartSize
+	reader       *zip.Reader
+	closer       io.Closer // non-nil when opened from a file
+	files        map[string]*zip.File
+	MaxPartSize  int64 // maximum decompressed size per part; 0 means DefaultMaxPartSize
+	MaxTotalSize int64 // maximum decompressed size of all members read; 0 means DefaultMaxTotalSize
+	MaxXMLDepth  int   // maximum element nesting of XML members; 0 means DefaultMaxXMLDepth
+
+	ctx      context.Context // nil when reads cannot be canceled
+	progress ProgressFunc    // nil when progress is not reported
+	read     int64           // decompressed bytes read so far
 }
 
 // NewPhysPkgReader creates a PhysPkgReader from an io.ReaderAt.
 func NewPhysPkgReader(r io.ReaderAt, size int64) (*PhysPkgReader, error) {
+	return newPhysPkgReader(r, size, nil)
+}
+
+// NewPhysPkgReaderWithOptions is NewPhysPkgReader with the limits of opts,
+// which may be nil, applied to the members read.
+func NewPhysPkgReaderWithOptions(r io.ReaderAt, size int64, opts *OpenOptions) (*PhysPkgReader, error) {
+	return newPhysPkgReader(r, size, opts)
+}
+
+func newPhysPkgReader(r io.ReaderAt, size int64, opts *OpenOptions) (*PhysPkgReader, error) {
 	zr, err := zip.NewReader(r, size)
 	if err != nil {
 		return nil, wrapZipOpenError(err, r)
 	}
-	return newPhysPkgReaderFromZip(zr, nil)
+	return newPhysPkgReaderFromZip(zr, nil, opts)
 }
 
 // NewPhysPkgReaderFromFile opens a PhysPkgReader from a file path.
 func NewPhysPkgReaderFromFile(path string) (*PhysPkgReader, error) {
+	return newPhysPkgReaderFromFile(path, nil)
+}
+
+func newPhysPkgReaderFromFile(path string, opts *OpenOptions) (*PhysPkgReader, error) {
 	f, err := os.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("opc: opening file %q: %w", path, err)
@@ -85,7 +108,7 @@ func NewPhysPkgReaderFromFile(path string) (*PhysPkgReader, error) {
 		f.Close()
 		return nil, fmt.Errorf("opening %q: %w", path, wrapped)
 	}
-	return newPhysPkgReaderFromZip(zr, f)
+	return newPhysPkgReaderFromZip(zr, f, opts)
 }
 
 // NewPhysPkgReaderFromBytes creates a PhysPkgReader from in-memory bytes.
@@ -94,29 +117,47 @@ func NewPhysPkgReaderFromBytes(data []byte) (*PhysPkgReader, error) {
 	return NewPhysPkgReader(r, int64(len(data)))
 }
 
-func newPhysPkgReaderFromZip(zr *zip.Reader, closer io.Closer) (*PhysPkgReader, error) {
-	if len(zr.File) > DefaultMaxEntries {
+// newPhysPkgReaderFromZip wraps zr, applying the limits of opts, which may
+// be nil.
+func newPhysPkgReaderFromZip(zr *zip.Reader, closer io.Closer, opts *OpenOptions) (*PhysPkgReader, error) {
+	if opts == nil {
+		opts = &OpenOptions{}
+	}
+	maxEntries := opts.MaxParts
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+	if len(zr.File) > maxEntries {
 		if closer != nil {
 			closer.Close()
 		}
 		return nil, fmt.Errorf("%w: archive contains %d entries (limit %d)",
-			ErrTooManyEntries, len(zr.File), DefaultMaxEntries)
+			ErrTooManyEntries, len(zr.File), maxEntries)
 	}
 	files := make(map[string]*zip.File, len(zr.File))
 	for _, f := range zr.File {
 		files[f.Name] = f
 	}
 	return &PhysPkgReader{
-		reader: zr,
-		closer: closer,
-		files:  files,
+		reader:       zr,
+		closer:       closer,
+		files:        files,
+		MaxPartSize:  opts.MaxPartSize,
+		MaxTotalSize: opts.MaxTotalSize,
+		MaxXMLDepth:  opts.MaxXMLDepth,
+		ctx:          opts.Context,
+		progress:     opts.Progress,
 	}, nil
 }
 
 // BlobFor returns the contents of the part at the given PackURI.
 // The decompressed size is capped at MaxPartSize (or DefaultMaxPartSize
-// when MaxPartSize is 0) to guard against zip bombs.
+// when MaxPartSize is 0), and the size of all members read so far at
+// MaxTotalSize, to guard against zip bombs.
 func (p *PhysPkgReader) BlobFor(uri PackURI) ([]byte, error) {
+	if err := p.canceled(); err != nil {
+		return nil, err
+	}
 	membername := uri.Membername()
 	f, ok := p.files[membername]
 	if !ok {
@@ -128,32 +169,56 @@ func (p *PhysPkgReader) BlobFor(uri PackURI) ([]byte, error) {
 	}
 	defer rc.Close()
 
-	limit := p.MaxPartSize
-	if limit <= 0 {
-		limit = DefaultMaxPartSize
+	partLimit := p.MaxPartSize
+	if partLimit <= 0 {
+		partLimit = DefaultMaxPartSize
+	}
+	totalLimit := p.MaxTotalSize
+	if totalLimit <= 0 {
+		totalLimit = DefaultMaxTotalSize
+	}
+	limit := min(partLimit, max(totalLimit-p.read, 0))
+	var src io.Reader = rc
+	if p.ctx != nil {
+		src = &ctxReader{ctx: p.ctx, r: rc}
 	}
 	// Read up to limit+1 bytes: if we get more than limit, the part is too large.
-	lr := io.LimitReader(rc, limit+1)
+	lr := io.LimitReader(src, limit+1)
 	data, err := io.ReadAll(lr)
 	if err != nil {
 		return nil, fmt.Errorf("opc: reading member %q: %w", membername, err)
 	}
 	if int64(len(data)) > limit {
+		if limit < partLimit {
+			return nil, fmt.Errorf("%w: reading %s exceeds the %d byte limit",
+				ErrPackageTooLarge, membername, totalLimit)
+		}
 		return nil, fmt.Errorf("%w: %s (%d bytes exceeds %d byte limit)",
 			ErrPartTooLarge, membername, f.UncompressedSize64, limit)
 	}
+	p.read += int64(len(data))
 	return data, nil
 }
 
 // ContentTypesXml returns the [Content_Types].xml blob.
 func (p *PhysPkgReader) ContentTypesXml() ([]byte, error) {
-	return p.BlobFor(ContentTypesURI)
+	blob, err := p.BlobFor(ContentTypesURI)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkXML(ContentTypesURI, blob); err != nil {
+		return nil, err
+	}
+	return blob, nil
 }
 
 // RelsXmlFor returns the .rels XML for the given source URI, or nil if none exists.
 func (p *PhysPkgReader) RelsXmlFor(sourceURI PackURI) ([]byte, error) {
 	relsURI := sourceURI.RelsURI()
 	blob, err := p.BlobFor(relsURI)
+	if err == nil {
+		err = p.checkXML(relsURI, blob)
+	}
 	if err != nil {
 		// No .rels file is not an error — it simply means no relationships.
 		// This mirrors python-docx's _ZipPkgReader.rels_xml_for which
@@ -215,6 +280,10 @@ func wrapZipOpenError(zipErr error, r io.ReaderAt) error {
 // PhysPkgWriter provides low-level write access to a ZIP-based OPC package.
 type PhysPkgWriter struct {
 	writer *zip.Writer
+
+	// ModTime is the modification time recorded for each member; the
+	// zero value records none.
+	ModTime time.Time
 }
 
 // NewPhysPkgWriter creates a PhysPkgWriter backed by the given writer.
@@ -225,7 +294,11 @@ func NewPhysPkgWriter(w io.Writer) *PhysPkgWriter {
 // Write adds a member to the ZIP package.
 func (p *PhysPkgWriter) Write(uri PackURI, blob []byte) error {
 	membername := uri.Membername()
-	w, err := p.writer.Create(membername)
+	w, err := p.writer.CreateHeader(&zip.FileHeader{
+		Name:     membername,
+		Method:   zip.Deflate,
+		Modified: p.ModTime,
+	})
 	if err != nil {
 		return fmt.Errorf("opc: creating zip member %q: %w", membername, err)
 	}
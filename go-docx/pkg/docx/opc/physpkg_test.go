@@ -8,7 +8,7 @@ import (
 	"github.com/vortex/go-docx/pkg/docx/templates"
 )
 
-func loadDefaultDocx(t *testing.T) []byte {
+func loadDefaultDocx(t testing.TB) []byte {
 	t.Helper()
 	data, err := templates.FS.ReadFile("default.docx")
 	if err != nil {
@@ -36,6 +36,10 @@ type SerializedPart struct {
 	RelType     string
 	Blob        []byte
 	SRels       []SerializedRelationship
+
+	raw      *rawMember // the member as stored
+	relsBlob []byte     // the .rels member of the part as stored
+	strict   bool       // normalized from Strict namespaces
 }
 
 // --------------------------------------------------------------------------
@@ -50,6 +54,8 @@ type PackageReader struct{}
 type ReadResult struct {
 	PkgSRels []SerializedRelationship
 	SParts   []SerializedPart
+
+	pkgRelsBlob []byte // the package .rels member as stored
 }
 
 // Read reads the package and returns all serialized parts and relationships.
@@ -65,7 +71,7 @@ func (pr *PackageReader) Read(physReader *PhysPkgReader) (*ReadResult, error) {
 	}
 
 	// 2. Read package-level relationships
-	pkgSRels, err := readSRels(physReader, PackageURI)
+	pkgSRels, pkgRelsBlob, err := readSRels(physReader, PackageURI)
 	if err != nil {
 		return nil, fmt.Errorf("opc: reading package rels: %w", err)
 	}
@@ -77,10 +83,14 @@ func (pr *PackageReader) Read(physReader *PhysPkgReader) (*ReadResult, error) {
 	if err := walkParts(physReader, contentTypes, pkgSRels, &sparts, visited); err != nil {
 		return nil, err
 	}
+	if physReader.progress != nil {
+		physReader.progress("read", len(physReader.files), len(physReader.files))
+	}
 
 	return &ReadResult{
-		PkgSRels: pkgSRels,
-		SParts:   sparts,
+		PkgSRels:    pkgSRels,
+		SParts:      sparts,
+		pkgRelsBlob: pkgRelsBlob,
 	}, nil
 }
 
@@ -140,8 +150,25 @@ func walkParts(
 				// opens it fine.
 				continue
 			}
+			isXML := isXMLContentType(ct) && !isOpaqueRelType(srel.RelType)
+			if isXML {
+				if err := physReader.checkXML(partname, blob); err != nil {
+					return err
+				}
+			}
 
-			partSRels, err := readSRels(physReader, partname)
+			raw, err := physReader.rawFor(partname)
+			if err != nil {
+				return fmt.Errorf("opc: reading part %q: %w", partname, err)
+			}
+			strict := false
+			if isXML {
+				if blob, strict = normalizeStrictXML(blob); strict {
+					raw = nil // the stored member is not the normalized part
+				}
+			}
+
+			partSRels, relsBlob, err := readSRels(physReader, partname)
 			if err != nil {
 				return fmt.Errorf("opc: reading rels for %q: %w", partname, err)
 			}
@@ -152,7 +179,13 @@ func walkParts(
 				RelType:     srel.RelType,
 				Blob:        blob,
 				SRels:       partSRels,
+				raw:         raw,
+				relsBlob:    relsBlob,
+				strict:      strict,
 			})
+			if physReader.progress != nil {
+				physReader.progress("read", len(*sparts), len(physReader.files))
+			}
 
 			// Push child rels — will be processed before remaining siblings.
 			stack = append(stack, partSRels)
@@ -167,14 +200,16 @@ func walkParts(
 	return nil
 }
 
-// readSRels reads and parses the .rels file for the given source URI.
-func readSRels(physReader *PhysPkgReader, sourceURI PackURI) ([]SerializedRelationship, error) {
+// readSRels reads and parses the .rels file for the given source URI. It
+// also returns the file as read, nil if there is none.
+func readSRels(physReader *PhysPkgReader, sourceURI PackURI) ([]SerializedRelationship, []byte, error) {
 	blob, err := physReader.RelsXmlFor(sourceURI)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if blob == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
-	return ParseRelationships(blob, sourceURI.BaseURI())
+	srels, err := ParseRelationships(blob, sourceURI.BaseURI())
+	return srels, blob, err
 }
@@ -27,6 +27,9 @@ type Relationships struct {
 	rels    []*Relationship
 	byRID   map[string]*Relationship
 	nextNum int
+
+	stored     []byte                   // the .rels XML read, see StoredXML
+	storedRels []SerializedRelationship // stored, parsed
 }
 
 // NewRelationships creates an empty Relationships collection for the given base URI.
@@ -88,7 +91,7 @@ func (rs *Relationships) GetByRelType(relType string) (*Relationship, error) {
 		}
 	}
 	if len(matches) == 0 {
-		return nil, fmt.Errorf("opc: no relationship of type %q", relType)
+		return nil, fmt.Errorf("%w: no relationship of type %q", ErrPartNotFound, relType)
 	}
 	if len(matches) > 1 {
 		return nil, fmt.Errorf("opc: multiple relationships of type %q", relType)
@@ -0,0 +1,106 @@
+package opc
+
+import (
+	"sort"
+)
+
+// --------------------------------------------------------------------------
+// Relationship graph inspection
+// --------------------------------------------------------------------------
+
+// RelationshipEdge is a relationship together with the part it belongs to.
+type RelationshipEdge struct {
+	*Relationship
+	// Source is the partname of the part holding the relationship, or
+	// PackageURI for the package relationships.
+	Source PackURI
+}
+
+// Target returns the partname of the part the relationship points to, or
+// the target reference as written for an external relationship or one
+// whose part is missing.
+func (e RelationshipEdge) Target() string {
+	if !e.IsExternal && e.TargetPart != nil {
+		return string(e.TargetPart.PartName())
+	}
+	return e.TargetRef
+}
+
+// IsDangling reports whether the relationship is internal but its target
+// part is missing from the package.
+func (e RelationshipEdge) IsDangling() bool {
+	return !e.IsExternal && e.TargetPart == nil
+}
+
+// Relationships returns every relationship reachable from the package
+// relationships, with its source, in the depth-first order of IterRels.
+func (p *OpcPackage) Relationships() []RelationshipEdge {
+	var result []RelationshipEdge
+	visited := make(map[Part]bool)
+	type frame struct {
+		source PackURI
+		rels   []*Relationship
+	}
+	stack := []frame{{PackageURI, p.rels.All()}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if len(top.rels) == 0 {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		rel := top.rels[0]
+		top.rels = top.rels[1:]
+		result = append(result, RelationshipEdge{Relationship: rel, Source: top.source})
+		if rel.IsExternal || rel.TargetPart == nil || visited[rel.TargetPart] {
+			continue
+		}
+		visited[rel.TargetPart] = true
+		stack = append(stack, frame{rel.TargetPart.PartName(), rel.TargetPart.Rels().All()})
+	}
+	return result
+}
+
+// ExternalRelationships returns the reachable relationships that point
+// outside the package, such as external hyperlinks and linked images.
+func (p *OpcPackage) ExternalRelationships() []RelationshipEdge {
+	var result []RelationshipEdge
+	for _, e := range p.Relationships() {
+		if e.IsExternal {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// OrphanParts returns the parts of the package that no relationship chain
+// from the package reaches, sorted by partname. They are not saved; unlike
+// DropUnreachableParts, OrphanParts leaves them in the package.
+func (p *OpcPackage) OrphanParts() []Part {
+	reachable := make(map[Part]bool, len(p.parts))
+	for _, part := range p.IterParts() {
+		reachable[part] = true
+	}
+	var orphans []Part
+	for _, part := range p.parts {
+		if !reachable[part] {
+			orphans = append(orphans, part)
+		}
+	}
+	sort.Slice(orphans, func(i, j int) bool { return orphans[i].PartName() < orphans[j].PartName() })
+	return orphans
+}
+
+// RewriteExternalTargets calls rewrite for each reachable external
+// relationship and sets its target to the result, so links can be moved
+// to another host in bulk. Returning the current target leaves the
+// relationship unchanged. It returns the number of targets changed.
+func (p *OpcPackage) RewriteExternalTargets(rewrite func(e RelationshipEdge) string) int {
+	n := 0
+	for _, e := range p.ExternalRelationships() {
+		if target := rewrite(e); target != e.TargetRef {
+			e.TargetRef = target
+			n++
+		}
+	}
+	return n
+}
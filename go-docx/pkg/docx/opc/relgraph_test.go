@@ -0,0 +1,60 @@
+package opc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRelationships_Graph(t *testing.T) {
+	pkg := NewOpcPackage(nil)
+	doc := makePart(pkg, "/word/document.xml")
+	styles := makePart(pkg, "/word/styles.xml")
+	orphan := makePart(pkg, "/word/unused.xml")
+	link(pkg.Rels(), RTOfficeDocument, doc)
+	link(doc.Rels(), RTStyles, styles)
+	linkExt(doc.Rels(), RTHyperlink, "http://old.example.com/a")
+	linkExt(styles.Rels(), RTImage, "http://old.example.com/logo.png")
+	doc.Rels().Load("rId9", RTImage, "media/missing.png", nil, false)
+
+	edges := pkg.Relationships()
+	var got []string
+	for _, e := range edges {
+		got = append(got, string(e.Source)+">"+e.Target())
+	}
+	want := []string{
+		"/>/word/document.xml",
+		"/word/document.xml>/word/styles.xml",
+		"/word/styles.xml>http://old.example.com/logo.png",
+		"/word/document.xml>http://old.example.com/a",
+		"/word/document.xml>media/missing.png",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Relationships =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if !edges[4].IsDangling() || edges[3].IsDangling() {
+		t.Error("IsDangling wrong")
+	}
+
+	if ext := pkg.ExternalRelationships(); len(ext) != 2 {
+		t.Errorf("got %d external relationships, want 2", len(ext))
+	}
+	orphans := pkg.OrphanParts()
+	if len(orphans) != 1 || orphans[0] != Part(orphan) {
+		t.Errorf("OrphanParts = %v", partNames(orphans))
+	}
+	if _, ok := pkg.PartByName("/word/unused.xml"); !ok {
+		t.Error("OrphanParts removed the orphan")
+	}
+
+	n := pkg.RewriteExternalTargets(func(e RelationshipEdge) string {
+		return strings.Replace(e.TargetRef, "old.example.com", "new.example.com", 1)
+	})
+	if n != 2 {
+		t.Errorf("rewrote %d targets, want 2", n)
+	}
+	for _, e := range pkg.ExternalRelationships() {
+		if !strings.Contains(e.TargetRef, "new.example.com") {
+			t.Errorf("target %q not rewritten", e.TargetRef)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package signature
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// Algorithm identifiers used by OPC signatures.
+const (
+	algC14N            = "http://www.w3.org/TR/2001/REC-xml-c14n-20010315"
+	algRelationship    = "http://schemas.openxmlformats.org/package/2006/RelationshipTransform"
+	algSHA1            = "http://www.w3.org/2000/09/xmldsig#sha1"
+	algSHA256          = "http://www.w3.org/2001/04/xmlenc#sha256"
+	algRSASHA1         = "http://www.w3.org/2000/09/xmldsig#rsa-sha1"
+	algRSASHA256       = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	algECDSASHA256     = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256"
+	nsXmlDsig          = "http://www.w3.org/2000/09/xmldsig#"
+	nsDigitalSignature = "http://schemas.openxmlformats.org/package/2006/digital-signature"
+)
+
+// canonicalize returns the inclusive Canonical XML 1.0 (without comments)
+// serialization of the subtree rooted at el.
+//
+// Namespace declarations in scope at el — including those inherited from
+// ancestors — are rendered on el itself, as the specification requires for
+// a document subset. Descendants only render declarations that differ from
+// what their parent rendered.
+func canonicalize(el *etree.Element) []byte {
+	var buf bytes.Buffer
+	writeCanonical(&buf, el, inScopeNamespaces(el.Parent()), map[string]string{"": ""})
+	return buf.Bytes()
+}
+
+// inScopeNamespaces returns the prefix → URI declarations visible at el,
+// walking from the document root down. Returns an empty map for nil.
+func inScopeNamespaces(el *etree.Element) map[string]string {
+	var chain []*etree.Element
+	for e := el; e != nil; e = e.Parent() {
+		chain = append(chain, e)
+	}
+	ns := map[string]string{}
+	for i := len(chain) - 1; i >= 0; i-- {
+		for _, a := range chain[i].Attr {
+			if pfx, ok := nsDeclPrefix(a); ok {
+				ns[pfx] = a.Value
+			}
+		}
+	}
+	return ns
+}
+
+// nsDeclPrefix reports whether a is a namespace declaration and, if so,
+// the prefix it declares ("" for the default namespace).
+func nsDeclPrefix(a etree.Attr) (string, bool) {
+	switch {
+	case a.Space == "" && a.Key == "xmlns":
+		return "", true
+	case a.Space == "xmlns":
+		return a.Key, true
+	}
+	return "", false
+}
+
+// writeCanonical writes el and its descendants in canonical form. inherited
+// holds the declarations in scope from ancestors; rendered holds those
+// already written by the nearest rendered ancestor.
+func writeCanonical(buf *bytes.Buffer, el *etree.Element, inherited, rendered map[string]string) {
+	scope := make(map[string]string, len(inherited))
+	for k, v := range inherited {
+		scope[k] = v
+	}
+	var attrs []etree.Attr
+	for _, a := range el.Attr {
+		if pfx, ok := nsDeclPrefix(a); ok {
+			scope[pfx] = a.Value
+			continue
+		}
+		attrs = append(attrs, a)
+	}
+
+	var emit []string
+	for pfx, uri := range scope {
+		if pfx == "xml" {
+			continue
+		}
+		if prev, ok := rendered[pfx]; ok && prev == uri {
+			continue
+		}
+		if pfx == "" && uri == "" {
+			if _, ok := rendered[""]; !ok {
+				continue
+			}
+		}
+		emit = append(emit, pfx)
+	}
+	sort.Strings(emit)
+
+	nextRendered := rendered
+	if len(emit) > 0 {
+		nextRendered = make(map[string]string, len(rendered)+len(emit))
+		for k, v := range rendered {
+			nextRendered[k] = v
+		}
+	}
+
+	buf.WriteByte('<')
+	buf.WriteString(el.FullTag())
+	for _, pfx := range emit {
+		if pfx == "" {
+			buf.WriteString(` xmlns="`)
+		} else {
+			buf.WriteString(` xmlns:` + pfx + `="`)
+		}
+		buf.WriteString(escapeAttr(scope[pfx]))
+		buf.WriteByte('"')
+		nextRendered[pfx] = scope[pfx]
+	}
+
+	sort.SliceStable(attrs, func(i, j int) bool {
+		ui, uj := attrURI(attrs[i], scope), attrURI(attrs[j], scope)
+		if ui != uj {
+			return ui < uj
+		}
+		return attrs[i].Key < attrs[j].Key
+	})
+	for _, a := range attrs {
+		buf.WriteByte(' ')
+		buf.WriteString(a.FullKey())
+		buf.WriteString(`="`)
+		buf.WriteString(escapeAttr(a.Value))
+		buf.WriteByte('"')
+	}
+	buf.WriteByte('>')
+
+	for _, tok := range el.Child {
+		switch t := tok.(type) {
+		case *etree.Element:
+			writeCanonical(buf, t, scope, nextRendered)
+		case *etree.CharData:
+			buf.WriteString(escapeText(t.Data))
+		case *etree.ProcInst:
+			buf.WriteString("<?" + t.Target)
+			if t.Inst != "" {
+				buf.WriteString(" " + t.Inst)
+			}
+			buf.WriteString("?>")
+		}
+	}
+
+	buf.WriteString("</" + el.FullTag() + ">")
+}
+
+// attrURI resolves the namespace URI of a prefixed attribute. Unprefixed
+// attributes have no namespace and sort first.
+func attrURI(a etree.Attr, scope map[string]string) string {
+	if a.Space == "" {
+		return ""
+	}
+	if a.Space == "xml" {
+		return "http://www.w3.org/XML/1998/namespace"
+	}
+	return scope[a.Space]
+}
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+
+var attrEscaper = strings.NewReplacer(
+	"&", "&amp;", "<", "&lt;", `"`, "&quot;",
+	"\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;",
+)
+
+func escapeText(s string) string { return textEscaper.Replace(s) }
+func escapeAttr(s string) string { return attrEscaper.Replace(s) }
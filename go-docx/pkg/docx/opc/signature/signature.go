@@ -0,0 +1,769 @@
+// Package signature implements OPC digital signatures (ECMA-376 Part 2,
+// §13): signing a package with an X.509 certificate and private key,
+// enumerating the signatures already present, and verifying them.
+//
+// Signatures are XML-DSig documents stored in /_xmlsignatures/ and reached
+// from the package through a digital-signature origin part. Each signature
+// covers every part and relationship present when it was created; the
+// package relationship to the origin part is excluded so that later
+// signatures do not invalidate earlier ones.
+//
+// Signing must be the last modification before the package is saved: any
+// subsequent change to a signed part invalidates the signature.
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "crypto/sha1" // registers crypto.SHA1 for verifying legacy signatures
+	_ "crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// ErrSignatureInvalid is returned by Verify when a signature does not match
+// the package content or its SignedInfo. Callers can test for it with
+// errors.Is to distinguish tampering from malformed signature markup.
+var ErrSignatureInvalid = errors.New("signature: signature is invalid")
+
+// Partnames used for signature parts.
+const (
+	originPartname    opc.PackURI = "/_xmlsignatures/origin.sigs"
+	signaturePartname             = "/_xmlsignatures/sig%d.xml"
+)
+
+// signatureTimeFormat is the mdssi:Format value written by Sign.
+const signatureTimeFormat = "YYYY-MM-DDThh:mm:ssTZD"
+
+// SignOptions configures Sign. The zero value is valid.
+type SignOptions struct {
+	// SigningTime is recorded in the signature properties. Defaults to
+	// the current time.
+	SigningTime time.Time
+}
+
+// Signature is one OPC digital signature found in a package.
+type Signature struct {
+	pkg         *opc.OpcPackage
+	partName    opc.PackURI
+	blob        []byte
+	cert        *x509.Certificate
+	signingTime time.Time
+	parseErr    error
+}
+
+// PartName returns the partname of the XML signature part.
+func (s *Signature) PartName() opc.PackURI { return s.partName }
+
+// Certificate returns the signer certificate embedded in the signature,
+// or nil if none could be parsed.
+func (s *Signature) Certificate() *x509.Certificate { return s.cert }
+
+// SigningTime returns the signing time recorded in the signature
+// properties, or the zero time if absent.
+func (s *Signature) SigningTime() time.Time { return s.signingTime }
+
+// --------------------------------------------------------------------------
+// Sign
+// --------------------------------------------------------------------------
+
+// Sign adds a digital signature to pkg covering every part and relationship
+// currently in the package, and returns it. key must be an RSA or ECDSA
+// private key matching cert. opts may be nil.
+func Sign(pkg *opc.OpcPackage, cert *x509.Certificate, key crypto.Signer, opts *SignOptions) (*Signature, error) {
+	if cert == nil || key == nil {
+		return nil, fmt.Errorf("signature: certificate and key are required")
+	}
+	sigMethod, err := signatureMethodFor(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	signingTime := time.Now()
+	if opts != nil && !opts.SigningTime.IsZero() {
+		signingTime = opts.SigningTime
+	}
+
+	origin := originPart(pkg)
+	covered := signableParts(pkg)
+
+	doc := etree.NewDocument()
+	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8" standalone="yes"`)
+	root := doc.CreateElement("Signature")
+	root.CreateAttr("xmlns", nsXmlDsig)
+	root.CreateAttr("Id", "idPackageSignature")
+
+	signedInfo := root.CreateElement("SignedInfo")
+	signedInfo.CreateElement("CanonicalizationMethod").CreateAttr("Algorithm", algC14N)
+	signedInfo.CreateElement("SignatureMethod").CreateAttr("Algorithm", sigMethod)
+	objRef := signedInfo.CreateElement("Reference")
+	objRef.CreateAttr("Type", nsXmlDsig+"Object")
+	objRef.CreateAttr("URI", "#idPackageObject")
+	objRef.CreateElement("DigestMethod").CreateAttr("Algorithm", algSHA256)
+	objDigest := objRef.CreateElement("DigestValue")
+
+	sigValue := root.CreateElement("SignatureValue")
+	root.CreateElement("KeyInfo").CreateElement("X509Data").CreateElement("X509Certificate").
+		SetText(base64.StdEncoding.EncodeToString(cert.Raw))
+
+	object := root.CreateElement("Object")
+	object.CreateAttr("Id", "idPackageObject")
+	if err := writeManifest(object.CreateElement("Manifest"), pkg, covered); err != nil {
+		return nil, err
+	}
+	writeSignatureTime(object.CreateElement("SignatureProperties"), signingTime)
+
+	objDigest.SetText(base64.StdEncoding.EncodeToString(digest(crypto.SHA256, canonicalize(object))))
+	sv, err := signBytes(key, canonicalize(signedInfo))
+	if err != nil {
+		return nil, err
+	}
+	sigValue.SetText(base64.StdEncoding.EncodeToString(sv))
+
+	doc.WriteSettings.CanonicalEndTags = true
+	blob, err := doc.WriteToBytes()
+	if err != nil {
+		return nil, fmt.Errorf("signature: serializing signature: %w", err)
+	}
+
+	pn := pkg.NextPartname(signaturePartname)
+	sigPart := opc.NewBasePart(pn, opc.CTOpcDigitalSignatureXmlsig, blob, pkg)
+	pkg.AddPart(sigPart)
+	origin.Rels().GetOrAdd(opc.RTDigitalSignatureSignature, sigPart)
+
+	return &Signature{
+		pkg:         pkg,
+		partName:    pn,
+		blob:        blob,
+		cert:        cert,
+		signingTime: signingTime,
+	}, nil
+}
+
+// originPart returns the digital-signature origin part of pkg, creating and
+// relating it if absent.
+func originPart(pkg *opc.OpcPackage) opc.Part {
+	for _, rel := range pkg.Rels().AllByRelType(opc.RTDigitalSignatureOrigin) {
+		if !rel.IsExternal && rel.TargetPart != nil {
+			return rel.TargetPart
+		}
+	}
+	part := opc.NewBasePart(originPartname, opc.CTOpcDigitalSignatureOrigin, nil, pkg)
+	pkg.AddPart(part)
+	pkg.RelateTo(part, opc.RTDigitalSignatureOrigin)
+	return part
+}
+
+// signableParts returns the parts of pkg covered by a new signature: every
+// reachable part except the signature infrastructure itself.
+func signableParts(pkg *opc.OpcPackage) []opc.Part {
+	var result []opc.Part
+	for _, part := range pkg.Parts() {
+		if isSignaturePart(part) {
+			continue
+		}
+		result = append(result, part)
+	}
+	return result
+}
+
+func isSignaturePart(part opc.Part) bool {
+	switch part.ContentType() {
+	case opc.CTOpcDigitalSignatureOrigin, opc.CTOpcDigitalSignatureXmlsig, opc.CTOpcDigitalSignatureCert:
+		return true
+	}
+	return false
+}
+
+func isSignatureRelType(relType string) bool {
+	switch relType {
+	case opc.RTDigitalSignatureOrigin, opc.RTDigitalSignatureSignature, opc.RTDigitalSignatureCertificate:
+		return true
+	}
+	return false
+}
+
+// writeManifest adds one Reference per covered part and per relationships
+// part to manifest.
+func writeManifest(manifest *etree.Element, pkg *opc.OpcPackage, covered []opc.Part) error {
+	if err := addRelsReference(manifest, opc.PackageURI, pkg.Rels()); err != nil {
+		return err
+	}
+	for _, part := range covered {
+		blob, err := part.Blob()
+		if err != nil {
+			return fmt.Errorf("signature: reading part %q: %w", part.PartName(), err)
+		}
+		ref := manifest.CreateElement("Reference")
+		ref.CreateAttr("URI", string(part.PartName())+"?ContentType="+part.ContentType())
+		data := blob
+		if isXmlContentType(part.ContentType()) {
+			ref.CreateElement("Transforms").CreateElement("Transform").CreateAttr("Algorithm", algC14N)
+			if data, err = canonicalizeBlob(blob); err != nil {
+				return fmt.Errorf("signature: part %q: %w", part.PartName(), err)
+			}
+		}
+		addDigest(ref, data)
+
+		if part.Rels() != nil && part.Rels().Len() > 0 {
+			if err := addRelsReference(manifest, part.PartName(), part.Rels()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addRelsReference adds a RelationshipTransform reference for the
+// relationships of source, excluding signature relationships. Nothing is
+// added when no relationship qualifies.
+func addRelsReference(manifest *etree.Element, source opc.PackURI, rels *opc.Relationships) error {
+	var selectors []relSelector
+	for _, rel := range rels.All() {
+		if !isSignatureRelType(rel.RelType) {
+			selectors = append(selectors, relSelector{sourceID: rel.RID})
+		}
+	}
+	if len(selectors) == 0 {
+		return nil
+	}
+	blob, err := relsXml(rels)
+	if err != nil {
+		return err
+	}
+	data, err := relationshipTransform(blob, selectors)
+	if err != nil {
+		return err
+	}
+
+	ref := manifest.CreateElement("Reference")
+	ref.CreateAttr("URI", string(source.RelsURI())+"?ContentType="+opc.CTOpcRelationships)
+	transforms := ref.CreateElement("Transforms")
+	relTransform := transforms.CreateElement("Transform")
+	relTransform.CreateAttr("Algorithm", algRelationship)
+	for _, sel := range selectors {
+		rr := relTransform.CreateElement("RelationshipReference")
+		rr.Space = "mdssi"
+		rr.CreateAttr("xmlns:mdssi", nsDigitalSignature)
+		rr.CreateAttr("SourceId", sel.sourceID)
+	}
+	transforms.CreateElement("Transform").CreateAttr("Algorithm", algC14N)
+	addDigest(ref, data)
+	return nil
+}
+
+// addDigest appends SHA-256 DigestMethod and DigestValue children to ref.
+func addDigest(ref *etree.Element, data []byte) {
+	ref.CreateElement("DigestMethod").CreateAttr("Algorithm", algSHA256)
+	ref.CreateElement("DigestValue").SetText(base64.StdEncoding.EncodeToString(digest(crypto.SHA256, data)))
+}
+
+// writeSignatureTime records t as an mdssi:SignatureTime property.
+func writeSignatureTime(props *etree.Element, t time.Time) {
+	prop := props.CreateElement("SignatureProperty")
+	prop.CreateAttr("Id", "idSignatureTime")
+	prop.CreateAttr("Target", "#idPackageSignature")
+	st := prop.CreateElement("SignatureTime")
+	st.Space = "mdssi"
+	st.CreateAttr("xmlns:mdssi", nsDigitalSignature)
+	format := st.CreateElement("Format")
+	format.Space = "mdssi"
+	format.SetText(signatureTimeFormat)
+	value := st.CreateElement("Value")
+	value.Space = "mdssi"
+	value.SetText(t.UTC().Format(time.RFC3339))
+}
+
+// isXmlContentType reports whether parts of content type ct hold XML and
+// are therefore canonicalized before digesting.
+func isXmlContentType(ct string) bool {
+	return strings.HasSuffix(ct, "+xml") || ct == opc.CTXml
+}
+
+// signatureMethodFor returns the XML-DSig SignatureMethod URI for pub.
+func signatureMethodFor(pub crypto.PublicKey) (string, error) {
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		return algRSASHA256, nil
+	case *ecdsa.PublicKey:
+		return algECDSASHA256, nil
+	}
+	return "", fmt.Errorf("signature: unsupported key type %T", pub)
+}
+
+// signBytes signs the SHA-256 digest of data with key. ECDSA signatures are
+// converted from ASN.1 to the fixed-width r||s form XML-DSig requires.
+func signBytes(key crypto.Signer, data []byte) ([]byte, error) {
+	sig, err := key.Sign(rand.Reader, digest(crypto.SHA256, data), crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("signature: signing: %w", err)
+	}
+	pub, ok := key.Public().(*ecdsa.PublicKey)
+	if !ok {
+		return sig, nil
+	}
+	var rs struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(sig, &rs); err != nil {
+		return nil, fmt.Errorf("signature: decoding ECDSA signature: %w", err)
+	}
+	size := (pub.Curve.Params().BitSize + 7) / 8
+	out := make([]byte, 2*size)
+	rs.R.FillBytes(out[:size])
+	rs.S.FillBytes(out[size:])
+	return out, nil
+}
+
+// --------------------------------------------------------------------------
+// Enumerate
+// --------------------------------------------------------------------------
+
+// Signatures returns the digital signatures present in pkg, in
+// relationship order. A package without an origin part has none.
+// Signatures whose markup cannot be parsed are still returned; their
+// Verify method reports the parse failure.
+func Signatures(pkg *opc.OpcPackage) []*Signature {
+	var result []*Signature
+	for _, rel := range pkg.Rels().AllByRelType(opc.RTDigitalSignatureOrigin) {
+		if rel.IsExternal || rel.TargetPart == nil {
+			continue
+		}
+		for _, srel := range rel.TargetPart.Rels().AllByRelType(opc.RTDigitalSignatureSignature) {
+			if srel.IsExternal || srel.TargetPart == nil {
+				continue
+			}
+			result = append(result, loadSignature(pkg, srel.TargetPart))
+		}
+	}
+	return result
+}
+
+// loadSignature parses the certificate and signing time of a signature part.
+func loadSignature(pkg *opc.OpcPackage, part opc.Part) *Signature {
+	s := &Signature{pkg: pkg, partName: part.PartName()}
+	s.blob, s.parseErr = part.Blob()
+	if s.parseErr != nil {
+		return s
+	}
+	root, err := parseSignature(s.blob)
+	if err != nil {
+		s.parseErr = err
+		return s
+	}
+	if el := findDsig(root, "KeyInfo", "X509Data", "X509Certificate"); el != nil {
+		if der, err := decodeBase64(el.Text()); err == nil {
+			s.cert, _ = x509.ParseCertificate(der)
+		}
+	}
+	for _, el := range root.FindElements("//SignatureTime/Value") {
+		if t, err := time.Parse(time.RFC3339, strings.TrimSpace(el.Text())); err == nil {
+			s.signingTime = t
+		}
+	}
+	return s
+}
+
+// parseSignature parses a signature part and checks its root element.
+func parseSignature(blob []byte) (*etree.Element, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(blob); err != nil {
+		return nil, fmt.Errorf("signature: parsing signature part: %w", err)
+	}
+	root := doc.Root()
+	if root == nil || root.Tag != "Signature" || root.NamespaceURI() != nsXmlDsig {
+		return nil, fmt.Errorf("signature: part is not an XML-DSig Signature")
+	}
+	return root, nil
+}
+
+// --------------------------------------------------------------------------
+// Verify
+// --------------------------------------------------------------------------
+
+// Verify checks the signature value against the embedded certificate and
+// every digest against the current package content, and that the
+// signature covers the package: every relationship and the parts they
+// reach, except those of the signatures, the package properties and the
+// thumbnail. Relationship parts are digested as stored. It returns
+// nil when the signature is intact, an error wrapping ErrSignatureInvalid
+// when the content or SignedInfo does not match or content is left
+// unsigned, and any other error when the signature cannot be processed.
+//
+// Verify does not validate the certificate chain; use
+// Certificate().Verify with the appropriate roots for that.
+func (s *Signature) Verify() error {
+	if s.parseErr != nil {
+		return s.parseErr
+	}
+	if s.cert == nil {
+		return fmt.Errorf("signature: %s: no signer certificate", s.partName)
+	}
+	root, err := parseSignature(s.blob)
+	if err != nil {
+		return err
+	}
+	signedInfo := findDsig(root, "SignedInfo")
+	if signedInfo == nil {
+		return fmt.Errorf("signature: %s: missing SignedInfo", s.partName)
+	}
+	if cm := findDsig(signedInfo, "CanonicalizationMethod"); cm == nil || cm.SelectAttrValue("Algorithm", "") != algC14N {
+		return fmt.Errorf("signature: %s: unsupported canonicalization method", s.partName)
+	}
+	sm := findDsig(signedInfo, "SignatureMethod")
+	sv := findDsig(root, "SignatureValue")
+	if sm == nil || sv == nil {
+		return fmt.Errorf("signature: %s: missing SignatureMethod or SignatureValue", s.partName)
+	}
+	sigBytes, err := decodeBase64(sv.Text())
+	if err != nil {
+		return fmt.Errorf("signature: %s: decoding SignatureValue: %w", s.partName, err)
+	}
+	if err := verifyBytes(s.cert.PublicKey, sm.SelectAttrValue("Algorithm", ""), canonicalize(signedInfo), sigBytes); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrSignatureInvalid, s.partName, err)
+	}
+
+	manifests := 0
+	for _, ref := range childrenDsig(signedInfo, "Reference") {
+		uri := ref.SelectAttrValue("URI", "")
+		if !strings.HasPrefix(uri, "#") {
+			return fmt.Errorf("signature: %s: unsupported SignedInfo reference %q", s.partName, uri)
+		}
+		target := findByID(root, uri[1:])
+		if target == nil {
+			return fmt.Errorf("signature: %s: reference %q not found", s.partName, uri)
+		}
+		if err := checkDigest(ref, canonicalize(target)); err != nil {
+			return fmt.Errorf("%w: %s: reference %q: %v", ErrSignatureInvalid, s.partName, uri, err)
+		}
+		if manifest := findDsig(target, "Manifest"); manifest != nil {
+			if err := s.verifyManifest(manifest); err != nil {
+				return err
+			}
+			manifests++
+		}
+	}
+	if manifests == 0 {
+		return fmt.Errorf("%w: %s: no package manifest", ErrSignatureInvalid, s.partName)
+	}
+	return nil
+}
+
+// unsignedRelTypes are the relationship types a signature need not cover:
+// those of the signature parts and of the package properties, which Office
+// leaves unsigned so that editing the properties keeps signatures valid.
+var unsignedRelTypes = map[string]bool{
+	opc.RTDigitalSignatureOrigin:      true,
+	opc.RTDigitalSignatureSignature:   true,
+	opc.RTDigitalSignatureCertificate: true,
+	opc.RTCoreProperties:              true,
+	opc.RTExtendedProperties:          true,
+	opc.RTCustomProperties:            true,
+	opc.RTThumbnail:                   true,
+}
+
+// verifyManifest checks each part reference in manifest against the
+// package content, then that the references cover the package.
+func (s *Signature) verifyManifest(manifest *etree.Element) error {
+	if err := s.verifyReferences(manifest); err != nil {
+		return err
+	}
+	return s.verifyCoverage(manifest)
+}
+
+// verifyReferences checks the digest of each reference in manifest.
+func (s *Signature) verifyReferences(manifest *etree.Element) error {
+	for _, ref := range childrenDsig(manifest, "Reference") {
+		uri := ref.SelectAttrValue("URI", "")
+		pn, _, _ := strings.Cut(uri, "?")
+		data, err := s.referencedContent(opc.PackURI(pn), ref)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrSignatureInvalid, s.partName, err)
+		}
+		if err := checkDigest(ref, data); err != nil {
+			return fmt.Errorf("%w: %s: part %q: %v", ErrSignatureInvalid, s.partName, pn, err)
+		}
+	}
+	return nil
+}
+
+// verifyCoverage checks that manifest signs every relationship of the
+// package and, transitively, of every part they target, except those of
+// unsignedRelTypes, and every such target part. A signature that left
+// content out would stay valid whatever that content became.
+func (s *Signature) verifyCoverage(manifest *etree.Element) error {
+	signedParts := map[opc.PackURI]bool{}
+	selectors := map[opc.PackURI][]relSelector{} // by source
+	wholeRels := map[opc.PackURI]bool{}          // signed without a RelationshipTransform
+	for _, ref := range childrenDsig(manifest, "Reference") {
+		uri, _, _ := strings.Cut(ref.SelectAttrValue("URI", ""), "?")
+		pn := opc.PackURI(uri)
+		source, ok := relsSource(pn)
+		if !ok {
+			signedParts[pn] = true
+			continue
+		}
+		var transformed bool
+		if transforms := findDsig(ref, "Transforms"); transforms != nil {
+			for _, tr := range childrenDsig(transforms, "Transform") {
+				if tr.SelectAttrValue("Algorithm", "") == algRelationship {
+					selectors[source] = append(selectors[source], relSelectors(tr)...)
+					transformed = true
+				}
+			}
+		}
+		if !transformed {
+			wholeRels[source] = true
+		}
+	}
+
+	type relsOf struct {
+		source opc.PackURI
+		rels   *opc.Relationships
+	}
+	queue := []relsOf{{opc.PackageURI, s.pkg.Rels()}}
+	visited := map[opc.PackURI]bool{}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		if next.rels == nil {
+			continue
+		}
+		for _, rel := range next.rels.All() {
+			if unsignedRelTypes[rel.RelType] {
+				continue
+			}
+			if !wholeRels[next.source] && !selects(selectors[next.source], rel) {
+				return fmt.Errorf("%w: %s: relationship %s of %q is not signed",
+					ErrSignatureInvalid, s.partName, rel.RID, next.source)
+			}
+			if rel.IsExternal || rel.TargetPart == nil {
+				continue
+			}
+			pn := rel.TargetPart.PartName()
+			if visited[pn] {
+				continue
+			}
+			visited[pn] = true
+			if !signedParts[pn] {
+				return fmt.Errorf("%w: %s: part %q is not signed", ErrSignatureInvalid, s.partName, pn)
+			}
+			queue = append(queue, relsOf{pn, rel.TargetPart.Rels()})
+		}
+	}
+	return nil
+}
+
+// selects reports whether one of selectors selects rel.
+func selects(selectors []relSelector, rel *opc.Relationship) bool {
+	for _, sel := range selectors {
+		if (sel.sourceID != "" && sel.sourceID == rel.RID) ||
+			(sel.sourceType != "" && opc.NormalizeRelType(sel.sourceType) == rel.RelType) {
+			return true
+		}
+	}
+	return false
+}
+
+// referencedContent returns the bytes of the part named by pn after
+// applying the transforms declared on ref.
+func (s *Signature) referencedContent(pn opc.PackURI, ref *etree.Element) ([]byte, error) {
+	var blob []byte
+	if source, ok := relsSource(pn); ok {
+		rels, err := s.relsFor(source)
+		if err != nil {
+			return nil, err
+		}
+		if blob, err = relsXml(rels); err != nil {
+			return nil, err
+		}
+	} else {
+		part, ok := s.pkg.PartByName(pn)
+		if !ok {
+			return nil, fmt.Errorf("signed part %q is missing", pn)
+		}
+		var err error
+		if blob, err = part.Blob(); err != nil {
+			return nil, err
+		}
+	}
+
+	transforms := findDsig(ref, "Transforms")
+	if transforms == nil {
+		return blob, nil
+	}
+	data := blob
+	for _, tr := range childrenDsig(transforms, "Transform") {
+		var err error
+		switch alg := tr.SelectAttrValue("Algorithm", ""); alg {
+		case algRelationship:
+			data, err = relationshipTransform(data, relSelectors(tr))
+		case algC14N:
+			data, err = canonicalizeBlob(data)
+		default:
+			err = fmt.Errorf("unsupported transform %q", alg)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("part %q: %w", pn, err)
+		}
+	}
+	return data, nil
+}
+
+// relsXml returns the .rels XML of rels as the package stores it: the
+// bytes read while the relationships are unchanged, which Save writes
+// back as they are, else their serialization.
+func relsXml(rels *opc.Relationships) ([]byte, error) {
+	if stored := rels.StoredXML(); stored != nil {
+		return stored, nil
+	}
+	return opc.SerializeRelationships(rels)
+}
+
+// relsFor returns the relationships whose source is source.
+func (s *Signature) relsFor(source opc.PackURI) (*opc.Relationships, error) {
+	if source == opc.PackageURI {
+		return s.pkg.Rels(), nil
+	}
+	part, ok := s.pkg.PartByName(source)
+	if !ok {
+		return nil, fmt.Errorf("relationship source %q is missing", source)
+	}
+	return part.Rels(), nil
+}
+
+// relsSource maps a relationships partname to its source partname, e.g.
+// "/word/_rels/document.xml.rels" → "/word/document.xml".
+func relsSource(pn opc.PackURI) (opc.PackURI, bool) {
+	dir, file := pn.BaseURI(), pn.Filename()
+	if !strings.HasSuffix(file, ".rels") || !strings.HasSuffix(dir, "_rels") {
+		return "", false
+	}
+	parent := strings.TrimSuffix(strings.TrimSuffix(dir, "_rels"), "/")
+	name := strings.TrimSuffix(file, ".rels")
+	if name == "" {
+		return opc.PackageURI, true
+	}
+	return opc.PackURI(parent + "/" + name), true
+}
+
+// relSelectors reads the RelationshipReference and
+// RelationshipsGroupReference children of a RelationshipTransform.
+func relSelectors(tr *etree.Element) []relSelector {
+	var result []relSelector
+	for _, el := range tr.ChildElements() {
+		switch el.Tag {
+		case "RelationshipReference":
+			result = append(result, relSelector{sourceID: el.SelectAttrValue("SourceId", "")})
+		case "RelationshipsGroupReference":
+			result = append(result, relSelector{sourceType: el.SelectAttrValue("SourceType", "")})
+		}
+	}
+	return result
+}
+
+// checkDigest compares the DigestValue of ref against the digest of data.
+func checkDigest(ref *etree.Element, data []byte) error {
+	dm, dv := findDsig(ref, "DigestMethod"), findDsig(ref, "DigestValue")
+	if dm == nil || dv == nil {
+		return fmt.Errorf("missing DigestMethod or DigestValue")
+	}
+	h, err := digestAlgorithm(dm.SelectAttrValue("Algorithm", ""))
+	if err != nil {
+		return err
+	}
+	want, err := decodeBase64(dv.Text())
+	if err != nil {
+		return fmt.Errorf("decoding DigestValue: %w", err)
+	}
+	if !bytes.Equal(digest(h, data), want) {
+		return fmt.Errorf("digest mismatch")
+	}
+	return nil
+}
+
+// verifyBytes checks sig over data with the given SignatureMethod.
+func verifyBytes(pub crypto.PublicKey, method string, data, sig []byte) error {
+	var h crypto.Hash
+	switch method {
+	case algRSASHA256, algECDSASHA256:
+		h = crypto.SHA256
+	case algRSASHA1:
+		h = crypto.SHA1
+	default:
+		return fmt.Errorf("unsupported signature method %q", method)
+	}
+	sum := digest(h, data)
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, h, sum, sig)
+	case *ecdsa.PublicKey:
+		half := len(sig) / 2
+		r, s := new(big.Int).SetBytes(sig[:half]), new(big.Int).SetBytes(sig[half:])
+		if !ecdsa.Verify(key, sum, r, s) {
+			return fmt.Errorf("ECDSA verification failed")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported public key type %T", pub)
+}
+
+// --------------------------------------------------------------------------
+// XML helpers
+// --------------------------------------------------------------------------
+
+// findDsig follows a path of XML-DSig child elements from el.
+func findDsig(el *etree.Element, path ...string) *etree.Element {
+	for _, tag := range path {
+		var next *etree.Element
+		for _, c := range el.ChildElements() {
+			if c.Tag == tag && c.NamespaceURI() == nsXmlDsig {
+				next = c
+				break
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		el = next
+	}
+	return el
+}
+
+// childrenDsig returns the XML-DSig children of el with the given tag.
+func childrenDsig(el *etree.Element, tag string) []*etree.Element {
+	var result []*etree.Element
+	for _, c := range el.ChildElements() {
+		if c.Tag == tag && c.NamespaceURI() == nsXmlDsig {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// findByID returns the first element under root with an Id attribute of id.
+func findByID(root *etree.Element, id string) *etree.Element {
+	if root.SelectAttrValue("Id", "") == id {
+		return root
+	}
+	for _, c := range root.ChildElements() {
+		if found := findByID(c, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// decodeBase64 decodes s after stripping the whitespace XML-DSig permits.
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
@@ -0,0 +1,408 @@
+package signature
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/templates"
+)
+
+func mustSelfSigned(t *testing.T, key crypto.Signer) *x509.Certificate {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Test Signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatalf("CreateCertificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate: %v", err)
+	}
+	return cert
+}
+
+func mustOpenDefault(t *testing.T) *opc.OpcPackage {
+	t.Helper()
+	data, err := templates.FS.ReadFile("default.docx")
+	if err != nil {
+		t.Fatalf("reading default.docx: %v", err)
+	}
+	pkg, err := opc.OpenBytes(data, nil)
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	return pkg
+}
+
+// signAndReopen signs the default template, saves it and re-opens it.
+func signAndReopen(t *testing.T, key crypto.Signer) *opc.OpcPackage {
+	t.Helper()
+	pkg := mustOpenDefault(t)
+	cert := mustSelfSigned(t, key)
+	when := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	if _, err := Sign(pkg, cert, key, &SignOptions{SigningTime: when}); err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	saved, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatalf("SaveToBytes: %v", err)
+	}
+	reopened, err := opc.OpenBytes(saved, nil)
+	if err != nil {
+		t.Fatalf("re-open: %v", err)
+	}
+	return reopened
+}
+
+func TestSign_RoundTripVerifies(t *testing.T) {
+	t.Parallel()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, key := range map[string]crypto.Signer{"rsa": rsaKey, "ecdsa": ecKey} {
+		t.Run(name, func(t *testing.T) {
+			pkg := signAndReopen(t, key)
+			sigs := Signatures(pkg)
+			if len(sigs) != 1 {
+				t.Fatalf("expected 1 signature, got %d", len(sigs))
+			}
+			s := sigs[0]
+			if s.PartName() != "/_xmlsignatures/sig1.xml" {
+				t.Errorf("PartName = %q", s.PartName())
+			}
+			if s.Certificate() == nil || s.Certificate().Subject.CommonName != "Test Signer" {
+				t.Errorf("unexpected certificate %v", s.Certificate())
+			}
+			if want := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC); !s.SigningTime().Equal(want) {
+				t.Errorf("SigningTime = %v, want %v", s.SigningTime(), want)
+			}
+			if err := s.Verify(); err != nil {
+				t.Errorf("Verify: %v", err)
+			}
+		})
+	}
+}
+
+func TestSign_SecondSignatureKeepsFirstValid(t *testing.T) {
+	t.Parallel()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := signAndReopen(t, key)
+	if _, err := Sign(pkg, mustSelfSigned(t, key), key, nil); err != nil {
+		t.Fatalf("second Sign: %v", err)
+	}
+	sigs := Signatures(pkg)
+	if len(sigs) != 2 {
+		t.Fatalf("expected 2 signatures, got %d", len(sigs))
+	}
+	for _, s := range sigs {
+		if err := s.Verify(); err != nil {
+			t.Errorf("%s: Verify: %v", s.PartName(), err)
+		}
+	}
+}
+
+func TestVerify_DetectsTampering(t *testing.T) {
+	t.Parallel()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := signAndReopen(t, key)
+	saved, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rewrite word/document.xml inside the saved archive.
+	zr, err := zip.NewReader(bytes.NewReader(saved), int64(len(saved)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.Name == "word/document.xml" {
+			data = bytes.Replace(data, []byte("<w:body>"), []byte("<w:body><w:p/>"), 1)
+		}
+		w, err := zw.Create(f.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(data)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	tampered, err := opc.OpenBytes(buf.Bytes(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Signatures(tampered)[0].Verify()
+	if !errors.Is(err, ErrSignatureInvalid) {
+		t.Fatalf("expected ErrSignatureInvalid, got %v", err)
+	}
+}
+
+func TestVerify_DetectsRemovedRelationship(t *testing.T) {
+	t.Parallel()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := signAndReopen(t, key)
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	main.Rels().Delete(main.Rels().All()[0].RID)
+
+	err = Signatures(pkg)[0].Verify()
+	if !errors.Is(err, ErrSignatureInvalid) {
+		t.Fatalf("expected ErrSignatureInvalid, got %v", err)
+	}
+}
+
+func TestSignatures_NoneInUnsignedPackage(t *testing.T) {
+	t.Parallel()
+	if sigs := Signatures(mustOpenDefault(t)); len(sigs) != 0 {
+		t.Errorf("expected no signatures, got %d", len(sigs))
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	t.Parallel()
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(`<a:root xmlns:b="urn:b" xmlns:a="urn:a"><a:child z="1" b:y="2" a="x&amp;y">t&lt;</a:child><empty/></a:root>`); err != nil {
+		t.Fatal(err)
+	}
+	got := string(canonicalize(doc.Root().ChildElements()[0]))
+	want := `<a:child xmlns:a="urn:a" xmlns:b="urn:b" a="x&amp;y" z="1" b:y="2">t&lt;</a:child>`
+	if got != want {
+		t.Errorf("canonicalize =\n  %s\nwant\n  %s", got, want)
+	}
+	got = string(canonicalize(doc.Root()))
+	want = `<a:root xmlns:a="urn:a" xmlns:b="urn:b"><a:child a="x&amp;y" z="1" b:y="2">t&lt;</a:child><empty></empty></a:root>`
+	if got != want {
+		t.Errorf("canonicalize =\n  %s\nwant\n  %s", got, want)
+	}
+}
+
+// wordLayoutPackage returns a signed package whose signature follows the
+// layout of those Word writes: RSA-SHA1 with SHA-1 digests, one
+// RelationshipReference per signed relationship, references in URI order,
+// the package properties left unsigned, and .rels members with an
+// absolute target, Word's attribute order and CRLF line ends. It is
+// assembled here, not by Sign, so Verify is checked against markup it did
+// not produce. The reference to omit, by URI prefix, is left out of the
+// manifest, and rel2 is the SourceId selecting the styles relationship.
+func wordLayoutPackage(t *testing.T, key *rsa.PrivateKey, omit, rel2 string) []byte {
+	t.Helper()
+	const (
+		nsRels   = "http://schemas.openxmlformats.org/package/2006/relationships"
+		rtOffice = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/"
+		rtPkg    = "http://schemas.openxmlformats.org/package/2006/relationships/"
+		ctMain   = "application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"
+		ctStyles = "application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"
+		ctRels   = "application/vnd.openxmlformats-package.relationships+xml"
+		decl     = "<?xml version=\"1.0\" encoding=\"UTF-8\" standalone=\"yes\"?>\r\n"
+		nsW      = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+	)
+	document := `<w:document xmlns:w="` + nsW + `"><w:body><w:p><w:r><w:t>Signed</w:t></w:r></w:p></w:body></w:document>`
+	styles := `<w:styles xmlns:w="` + nsW + `"></w:styles>`
+	members := map[string]string{
+		"[Content_Types].xml": decl + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="` + ctRels + `"/><Default Extension="xml" ContentType="application/xml"/>` +
+			`<Default Extension="sigs" ContentType="application/vnd.openxmlformats-package.digital-signature-origin"/>` +
+			`<Override PartName="/word/document.xml" ContentType="` + ctMain + `"/>` +
+			`<Override PartName="/word/styles.xml" ContentType="` + ctStyles + `"/>` +
+			`<Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/>` +
+			`<Override PartName="/_xmlsignatures/sig1.xml" ContentType="application/vnd.openxmlformats-package.digital-signature-xmlsignature+xml"/></Types>`,
+		"_rels/.rels": decl + `<Relationships xmlns="` + nsRels + `">` +
+			`<Relationship Id="rId2" Type="` + rtPkg + `metadata/core-properties" Target="docProps/core.xml"/>` +
+			`<Relationship Id="rId3" Type="` + rtPkg + `digital-signature/origin" Target="_xmlsignatures/origin.sigs"/>` +
+			`<Relationship Id="rId1" Type="` + rtOffice + `officeDocument" Target="/word/document.xml"/></Relationships>`,
+		"word/_rels/document.xml.rels": decl + `<Relationships xmlns="` + nsRels + `">` +
+			`<Relationship Id="rId2" Type="` + rtOffice + `hyperlink" Target="https://example.com/" TargetMode="External"/>` +
+			`<Relationship Id="rId1" Type="` + rtOffice + `styles" Target="styles.xml"/></Relationships>`,
+		"word/document.xml":          decl + document,
+		"word/styles.xml":            decl + styles,
+		"docProps/core.xml":          decl + `<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties"/>`,
+		"_xmlsignatures/origin.sigs": "",
+		"_xmlsignatures/_rels/origin.sigs.rels": decl + `<Relationships xmlns="` + nsRels + `">` +
+			`<Relationship Id="rId1" Type="` + rtPkg + `digital-signature/signature" Target="sig1.xml"/></Relationships>`,
+	}
+
+	// The RelationshipTransform output of the signed relationships, in
+	// canonical form.
+	relRef := func(id, typ, target, mode string) string {
+		return `<Relationship Id="` + id + `" Target="` + target + `" TargetMode="` + mode + `" Type="` + typ + `"></Relationship>`
+	}
+	pkgRels := `<Relationships xmlns="` + nsRels + `">` + relRef("rId1", rtOffice+"officeDocument", "/word/document.xml", "Internal") + `</Relationships>`
+	docRels := `<Relationships xmlns="` + nsRels + `">` +
+		relRef("rId1", rtOffice+"styles", "styles.xml", "Internal") +
+		relRef("rId2", rtOffice+"hyperlink", "https://example.com/", "External") + `</Relationships>`
+	if rel2 != "rId2" {
+		docRels = `<Relationships xmlns="` + nsRels + `">` + relRef("rId1", rtOffice+"styles", "styles.xml", "Internal") + `</Relationships>`
+	}
+
+	sha1Digest := func(s string) string {
+		return base64.StdEncoding.EncodeToString(digest(crypto.SHA1, []byte(s)))
+	}
+	reference := func(uri, transforms, content string) string {
+		if strings.HasPrefix(uri, omit) && omit != "" {
+			return ""
+		}
+		return `<Reference URI="` + uri + `"><Transforms>` + transforms + `</Transforms>` +
+			`<DigestMethod Algorithm="` + algSHA1 + `"></DigestMethod><DigestValue>` + sha1Digest(content) + `</DigestValue></Reference>`
+	}
+	relTransform := func(ids ...string) string {
+		tr := `<Transform Algorithm="` + algRelationship + `">`
+		for _, id := range ids {
+			if id == "" {
+				continue
+			}
+			tr += `<mdssi:RelationshipReference xmlns:mdssi="` + nsDigitalSignature + `" SourceId="` + id + `"></mdssi:RelationshipReference>`
+		}
+		return tr + `</Transform><Transform Algorithm="` + algC14N + `"></Transform>`
+	}
+	c14n := `<Transform Algorithm="` + algC14N + `"></Transform>`
+
+	object := `<Manifest>` +
+		reference("/_rels/.rels?ContentType="+ctRels, relTransform("rId1"), pkgRels) +
+		reference("/word/_rels/document.xml.rels?ContentType="+ctRels, relTransform("rId1", rel2), docRels) +
+		reference("/word/document.xml?ContentType="+ctMain, c14n, document) +
+		reference("/word/styles.xml?ContentType="+ctStyles, c14n, styles) +
+		`</Manifest><SignatureProperties><SignatureProperty Id="idSignatureTime" Target="#idPackageSignature">` +
+		`<mdssi:SignatureTime xmlns:mdssi="` + nsDigitalSignature + `"><mdssi:Format>` + signatureTimeFormat + `</mdssi:Format>` +
+		`<mdssi:Value>2024-05-01T12:00:00Z</mdssi:Value></mdssi:SignatureTime></SignatureProperty></SignatureProperties>`
+	signedInfo := `<CanonicalizationMethod Algorithm="` + algC14N + `"></CanonicalizationMethod>` +
+		`<SignatureMethod Algorithm="` + algRSASHA1 + `"></SignatureMethod>` +
+		`<Reference Type="http://www.w3.org/2000/09/xmldsig#Object" URI="#idPackageObject">` +
+		`<DigestMethod Algorithm="` + algSHA1 + `"></DigestMethod><DigestValue>` +
+		sha1Digest(`<Object xmlns="`+nsXmlDsig+`" Id="idPackageObject">`+object+`</Object>`) + `</DigestValue></Reference>`
+	sv, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA1,
+		digest(crypto.SHA1, []byte(`<SignedInfo xmlns="`+nsXmlDsig+`">`+signedInfo+`</SignedInfo>`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := mustSelfSigned(t, key)
+	members["_xmlsignatures/sig1.xml"] = decl + `<Signature xmlns="` + nsXmlDsig + `" Id="idPackageSignature">` +
+		`<SignedInfo>` + signedInfo + `</SignedInfo>` +
+		`<SignatureValue>` + base64.StdEncoding.EncodeToString(sv) + `</SignatureValue>` +
+		`<KeyInfo><X509Data><X509Certificate>` + base64.StdEncoding.EncodeToString(cert.Raw) + `</X509Certificate></X509Data></KeyInfo>` +
+		`<Object Id="idPackageObject">` + object + `</Object></Signature>`
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, data := range members {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(data))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestVerify_WordLayout(t *testing.T) {
+	t.Parallel()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := opc.OpenBytes(wordLayoutPackage(t, key, "", "rId2"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigs := Signatures(pkg)
+	if len(sigs) != 1 {
+		t.Fatalf("expected 1 signature, got %d", len(sigs))
+	}
+	if err := sigs[0].Verify(); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+
+	// Saving keeps the relationship parts as stored, so the signature
+	// survives a round trip and a second signature.
+	saved, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkg, err = opc.OpenBytes(saved, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Sign(pkg, mustSelfSigned(t, key), key, nil); err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	for _, s := range Signatures(pkg) {
+		if err := s.Verify(); err != nil {
+			t.Errorf("%s after round trip: Verify: %v", s.PartName(), err)
+		}
+	}
+}
+
+func TestVerify_RequiresCoverage(t *testing.T) {
+	t.Parallel()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, tc := range map[string]struct{ omit, rel2 string }{
+		"part":         {omit: "/word/styles.xml", rel2: "rId2"},
+		"relationship": {rel2: ""},
+		"rels part":    {omit: "/word/_rels/", rel2: "rId2"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			pkg, err := opc.OpenBytes(wordLayoutPackage(t, key, tc.omit, tc.rel2), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = Signatures(pkg)[0].Verify()
+			if !errors.Is(err, ErrSignatureInvalid) {
+				t.Fatalf("expected ErrSignatureInvalid, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"fmt"
+	"sort"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// relSelector is one mdssi:RelationshipReference or
+// mdssi:RelationshipsGroupReference entry of a RelationshipTransform.
+type relSelector struct {
+	sourceID   string // select the relationship with this Id
+	sourceType string // select every relationship of this type
+}
+
+// relationshipTransform applies the OPC RelationshipTransform
+// (ECMA-376 Part 2, §13.2.4.24) to a .rels blob: only the selected
+// relationships are kept, TargetMode defaults to Internal, and entries are
+// sorted by Id. The result is already in canonical form.
+func relationshipTransform(relsBlob []byte, selectors []relSelector) ([]byte, error) {
+	srels, err := opc.ParseRelationships(relsBlob, "/")
+	if err != nil {
+		return nil, err
+	}
+	// ParseRelationships normalizes strict relationship types; the raw
+	// type is needed for digests, so read it back from the XML.
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(relsBlob); err != nil {
+		return nil, fmt.Errorf("signature: parsing relationships: %w", err)
+	}
+	rawType := map[string]string{}
+	if root := doc.Root(); root != nil {
+		for _, r := range root.ChildElements() {
+			rawType[r.SelectAttrValue("Id", "")] = r.SelectAttrValue("Type", "")
+		}
+	}
+
+	var kept []opc.SerializedRelationship
+	for _, sr := range srels {
+		for _, sel := range selectors {
+			if (sel.sourceID != "" && sel.sourceID == sr.RID) ||
+				(sel.sourceType != "" && sel.sourceType == rawType[sr.RID]) {
+				kept = append(kept, sr)
+				break
+			}
+		}
+	}
+	sort.Slice(kept, func(i, j int) bool { return kept[i].RID < kept[j].RID })
+
+	var buf bytes.Buffer
+	buf.WriteString(`<Relationships xmlns="` + opc.NsOpcRelationships + `">`)
+	for _, sr := range kept {
+		buf.WriteString(`<Relationship Id="` + escapeAttr(sr.RID) +
+			`" Target="` + escapeAttr(sr.TargetRef) +
+			`" TargetMode="` + escapeAttr(sr.TargetMode) +
+			`" Type="` + escapeAttr(rawType[sr.RID]) + `"></Relationship>`)
+	}
+	buf.WriteString(`</Relationships>`)
+	return buf.Bytes(), nil
+}
+
+// canonicalizeBlob parses an XML part blob and returns the canonical form
+// of its root element.
+func canonicalizeBlob(blob []byte) ([]byte, error) {
+	doc := etree.NewDocument()
+	doc.ReadSettings.Permissive = true
+	if err := doc.ReadFromBytes(blob); err != nil {
+		return nil, fmt.Errorf("signature: parsing XML for canonicalization: %w", err)
+	}
+	if doc.Root() == nil {
+		return nil, fmt.Errorf("signature: no root element to canonicalize")
+	}
+	return canonicalize(doc.Root()), nil
+}
+
+// digestAlgorithm maps an XML-DSig DigestMethod URI to a crypto.Hash.
+func digestAlgorithm(uri string) (crypto.Hash, error) {
+	switch uri {
+	case algSHA256:
+		return crypto.SHA256, nil
+	case algSHA1:
+		return crypto.SHA1, nil
+	}
+	return 0, fmt.Errorf("signature: unsupported digest method %q", uri)
+}
+
+// digest hashes data with h.
+func digest(h crypto.Hash, data []byte) []byte {
+	hh := h.New()
+	hh.Write(data)
+	return hh.Sum(nil)
+}
@@ -0,0 +1,154 @@
+package opc
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"sync/atomic"
+)
+
+// --------------------------------------------------------------------------
+// Incremental save
+//
+// A part loaded from a package remembers the ZIP member it was stored in.
+// The part is marked dirty (BasePart.MarkDirty) once its content may
+// change: when it is replaced with XmlPart.SetElement or BasePart.SetBlob,
+// or its tree is handed out for writing by XmlPart.Element. Readers use
+// XmlPart.ReadElement, which leaves the part clean. On save, a part that
+// is not dirty is written by copying the member's compressed bytes,
+// without serializing or compressing the part again. Saving is faster, and
+// parts that were not touched keep the exact bytes of the source, so round
+// trips do not produce spurious differences.
+//
+// The compressed bytes of a member are dropped when its part is replaced.
+// --------------------------------------------------------------------------
+
+// rawMember is a ZIP member as stored: its compressed bytes and what is
+// needed to write them back unchanged.
+type rawMember struct {
+	method uint16
+	crc32  uint32
+	size   uint64 // uncompressed
+	data   []byte // compressed
+}
+
+// rawFor returns the member at uri as stored, without decompressing it.
+func (p *PhysPkgReader) rawFor(uri PackURI) (*rawMember, error) {
+	membername := uri.Membername()
+	f, ok := p.files[membername]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMemberNotFound, membername)
+	}
+	m := &rawMember{method: f.Method, crc32: f.CRC32, size: f.UncompressedSize64}
+	r, err := f.OpenRaw()
+	if err != nil {
+		return nil, fmt.Errorf("opc: opening member %q: %w", membername, err)
+	}
+	if m.data, err = io.ReadAll(r); err != nil {
+		return nil, fmt.Errorf("opc: reading member %q: %w", membername, err)
+	}
+	return m, nil
+}
+
+// writeRaw adds a member stored as m, copying its compressed bytes.
+func (p *PhysPkgWriter) writeRaw(uri PackURI, m *rawMember) error {
+	membername := uri.Membername()
+	w, err := p.writer.CreateRaw(&zip.FileHeader{
+		Name:               membername,
+		Method:             m.method,
+		CRC32:              m.crc32,
+		CompressedSize64:   uint64(len(m.data)),
+		UncompressedSize64: m.size,
+		Modified:           p.ModTime,
+	})
+	if err != nil {
+		return fmt.Errorf("opc: creating zip member %q: %w", membername, err)
+	}
+	if _, err := w.Write(m.data); err != nil {
+		return fmt.Errorf("opc: writing zip member %q: %w", membername, err)
+	}
+	return nil
+}
+
+// storedPart is implemented by the parts that remember their stored form:
+// BasePart and, through it, XmlPart and the typed parts embedding them.
+type storedPart interface {
+	storedMember() *rawMember
+	setStoredMember(m *rawMember)
+}
+
+// storedMember returns the member the part was loaded from, or nil if it
+// was not loaded from a package or has been modified since.
+func (p *BasePart) storedMember() *rawMember {
+	if atomic.LoadUint32(&p.modified) != 0 {
+		return nil
+	}
+	return p.stored
+}
+
+// setStoredMember records m as the member the part was loaded from, and
+// the part as clean.
+func (p *BasePart) setStoredMember(m *rawMember) {
+	p.stored = m
+	atomic.StoreUint32(&p.modified, 0)
+}
+
+// storedMemberOf returns the stored form of part, or nil when it must be
+// serialized.
+func storedMemberOf(part Part) *rawMember {
+	if sp, ok := part.(storedPart); ok {
+		return sp.storedMember()
+	}
+	return nil
+}
+
+// ModifiedParts returns the reachable parts that Save would serialize
+// anew: those added since the package was opened and those modified, see
+// XmlPart.Element. Parts of a package built from scratch are all
+// modified. The error is always nil; it is kept for compatibility.
+func (p *OpcPackage) ModifiedParts() ([]Part, error) {
+	var result []Part
+	for _, part := range p.IterParts() {
+		if storedMemberOf(part) == nil {
+			result = append(result, part)
+		}
+	}
+	return result, nil
+}
+
+// setStored records blob, parsed as srels, as the .rels XML rs was read
+// from.
+func (rs *Relationships) setStored(blob []byte, srels []SerializedRelationship) {
+	rs.stored, rs.storedRels = blob, srels
+}
+
+// StoredXML returns the .rels XML the relationships were read from, as
+// stored in the package, while they still match it: the same
+// relationships in the same order, with the same ids, types, target modes
+// and targets, internal targets compared by the partname they resolve to.
+// It returns nil for relationships built in memory or changed since they
+// were read.
+//
+// Save writes the stored XML of unchanged relationships rather than
+// serializing them again, so a relationships part keeps its exact bytes,
+// which digital signatures cover.
+func (rs *Relationships) StoredXML() []byte {
+	if rs.stored == nil || len(rs.storedRels) != len(rs.rels) {
+		return nil
+	}
+	for i, rel := range rs.rels {
+		sr := rs.storedRels[i]
+		if sr.RID != rel.RID || sr.RelType != rel.RelType || sr.IsExternal() != rel.IsExternal {
+			return nil
+		}
+		switch {
+		case rel.IsExternal || rel.TargetPart == nil:
+			if sr.TargetRef != rel.TargetRef {
+				return nil
+			}
+		case sr.TargetPartname() != rel.TargetPart.PartName():
+			return nil
+		}
+	}
+	return rs.stored
+}
@@ -0,0 +1,303 @@
+package opc
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+)
+
+// rawMembers returns the stored bytes of every member of a ZIP package.
+func rawMembers(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := map[string][]byte{}
+	for _, f := range zr.File {
+		r, err := f.OpenRaw()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result[f.Name] = b
+	}
+	return result
+}
+
+// xmlPartFactory returns a factory loading every XML part as an XmlPart.
+func xmlPartFactory() *PartFactory {
+	factory := NewPartFactory()
+	factory.SetSelector(func(contentType, _ string) PartConstructor {
+		if !isXMLContentType(contentType) {
+			return nil
+		}
+		return func(pn PackURI, ct, _ string, blob []byte, pkg *OpcPackage) (Part, error) {
+			return NewXmlPart(pn, ct, blob, pkg)
+		}
+	})
+	return factory
+}
+
+func TestSave_CopiesUnchangedParts(t *testing.T) {
+	data := loadDefaultDocx(t)
+	pkg, err := OpenBytes(data, xmlPartFactory())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if modified, err := pkg.ModifiedParts(); err != nil || len(modified) != 0 {
+		t.Fatalf("ModifiedParts after open = %d, %v", len(modified), err)
+	}
+
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	main.(*XmlPart).Element().CreateAttr("changed", "1")
+	added := NewBasePart("/customXml/item9.xml", "application/xml", []byte("<a/>"), pkg)
+	pkg.RelateTo(added, RTCustomXml)
+
+	modified, err := pkg.ModifiedParts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := partNames(modified); len(got) != 2 || got[0] != "/word/document.xml" || got[1] != "/customXml/item9.xml" {
+		t.Errorf("ModifiedParts = %v", got)
+	}
+
+	saved, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	before, after := rawMembers(t, data), rawMembers(t, saved)
+	for name, b := range before {
+		if name == "[Content_Types].xml" || bytes.HasSuffix([]byte(name), []byte(".rels")) {
+			continue
+		}
+		switch {
+		case name == "word/document.xml":
+			if bytes.Equal(after[name], b) {
+				t.Errorf("%s was copied though modified", name)
+			}
+		case !bytes.Equal(after[name], b):
+			t.Errorf("%s was not copied byte for byte", name)
+		}
+	}
+	if _, err := OpenBytes(saved, nil); err != nil {
+		t.Fatalf("reopening: %v", err)
+	}
+
+	var canonical bytes.Buffer
+	if err := pkg.SaveWith(&canonical, &PackageWriter{Canonical: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenBytes(canonical.Bytes(), nil); err != nil {
+		t.Fatalf("reopening canonical: %v", err)
+	}
+}
+
+func TestModifiedParts_ReadElementAndMarkDirty(t *testing.T) {
+	pkg, err := OpenBytes(loadDefaultDocx(t), xmlPartFactory())
+	if err != nil {
+		t.Fatal(err)
+	}
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	xp := main.(*XmlPart)
+	if xp.ReadElement() == nil {
+		t.Fatal("ReadElement = nil")
+	}
+	if modified, _ := pkg.ModifiedParts(); len(modified) != 0 {
+		t.Errorf("ModifiedParts after ReadElement = %v", partNames(modified))
+	}
+	xp.MarkDirty()
+	if got, _ := pkg.ModifiedParts(); len(got) != 1 || got[0] != main {
+		t.Errorf("ModifiedParts after MarkDirty = %v", partNames(got))
+	}
+}
+
+func TestXmlPart_DecodesOnFirstRead(t *testing.T) {
+	data := loadDefaultDocx(t)
+	pkg, err := OpenBytes(data, xmlPartFactory())
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := func() []string {
+		var names []string
+		for _, part := range pkg.Parts() {
+			if xp, ok := part.(*XmlPart); ok && xp.raw == nil {
+				names = append(names, string(xp.PartName()))
+			}
+		}
+		return names
+	}
+	if got := decoded(); len(got) != 0 {
+		t.Errorf("decoded after open: %v", got)
+	}
+
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	main.(*XmlPart).Element().CreateAttr("changed", "1")
+	saved, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := decoded(); len(got) != 1 || got[0] != "/word/document.xml" {
+		t.Errorf("decoded after editing and saving the main part: %v", got)
+	}
+	before, after := rawMembers(t, data), rawMembers(t, saved)
+	if !bytes.Equal(before["word/styles.xml"], after["word/styles.xml"]) {
+		t.Error("styles.xml was not copied byte for byte")
+	}
+}
+
+func TestClone_SharesTreesUntilWritten(t *testing.T) {
+	pkg, err := OpenBytes(loadDefaultDocx(t), xmlPartFactory())
+	if err != nil {
+		t.Fatal(err)
+	}
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exposed := main.(*XmlPart).Element()
+	pkg.parts["/word/styles.xml"].(*XmlPart).ReadElement()
+
+	clone, err := pkg.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clone.parts["/word/document.xml"].(*XmlPart).ReadElement() == exposed {
+		t.Error("a tree handed out for writing is shared with the clone")
+	}
+	orig := pkg.parts["/word/styles.xml"].(*XmlPart)
+	cp := clone.parts["/word/styles.xml"].(*XmlPart)
+	if cp.ReadElement() != orig.ReadElement() {
+		t.Fatal("the untouched styles tree is not shared with the clone")
+	}
+
+	cp.Element().CreateAttr("clone", "1")
+	if orig.ReadElement().SelectAttr("clone") != nil {
+		t.Error("writing the clone changed the original")
+	}
+	orig.Element().CreateAttr("orig", "1")
+	if cp.ReadElement().SelectAttr("orig") != nil {
+		t.Error("writing the original changed the clone")
+	}
+	if modified, _ := clone.ModifiedParts(); len(modified) != 2 {
+		t.Errorf("clone ModifiedParts = %v, want the document and styles", partNames(modified))
+	}
+}
+
+func TestSave_CopiesUnchangedParts_ReaderAt(t *testing.T) {
+	data := loadDefaultDocx(t)
+	pkg, err := Open(bytes.NewReader(data), int64(len(data)), xmlPartFactory())
+	if err != nil {
+		t.Fatal(err)
+	}
+	styles := pkg.parts["/word/styles.xml"]
+	if _, err := styles.Blob(); err != nil { // reading does not modify
+		t.Fatal(err)
+	}
+	if modified, _ := pkg.ModifiedParts(); len(modified) != 0 {
+		t.Fatalf("ModifiedParts after reading a blob = %v", partNames(modified))
+	}
+	styles.(*XmlPart).SetElement(styles.(*XmlPart).Element().Copy())
+	if modified, _ := pkg.ModifiedParts(); len(modified) != 1 || modified[0] != styles {
+		t.Fatalf("ModifiedParts after SetElement = %v", partNames(modified))
+	}
+	saved, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	before, after := rawMembers(t, data), rawMembers(t, saved)
+	if !bytes.Equal(after["word/document.xml"], before["word/document.xml"]) {
+		t.Error("word/document.xml was not copied byte for byte")
+	}
+}
+
+func TestRelationships_StoredXML(t *testing.T) {
+	pkg, err := OpenBytes(loadDefaultDocx(t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := pkg.Rels().StoredXML()
+	if stored == nil {
+		t.Fatal("package StoredXML = nil after open")
+	}
+	saved, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reopened, err := OpenBytes(saved, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(reopened.Rels().StoredXML(), stored) {
+		t.Error("unchanged package relationships were not saved as stored")
+	}
+
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	main.Rels().GetOrAddExtRel(RTHyperlink, "https://example.com/")
+	if main.Rels().StoredXML() != nil {
+		t.Error("StoredXML != nil after adding a relationship")
+	}
+	main.(interface{ SetPartName(PackURI) }).SetPartName("/word/main.xml")
+	if pkg.Rels().StoredXML() != nil {
+		t.Error("StoredXML != nil after renaming a target part")
+	}
+}
+
+// BenchmarkSave_Unchanged compares saving an opened package whose parts
+// are untouched, which copies their stored members, with serializing every
+// part again.
+func BenchmarkSave_Unchanged(b *testing.B) {
+	pkg, err := OpenBytes(loadDefaultDocx(b), xmlPartFactory())
+	if err != nil {
+		b.Fatal(err)
+	}
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		b.Fatal(err)
+	}
+	body := main.(*XmlPart).Element().FindElement("//w:body")
+	for i := range 20000 {
+		body.CreateElement("w:p").CreateElement("w:r").CreateElement("w:t").SetText(fmt.Sprintf("paragraph %d", i))
+	}
+	data, err := pkg.SaveToBytes()
+	if err != nil {
+		b.Fatal(err)
+	}
+	pkg, err = OpenBytes(data, xmlPartFactory())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("copy", func(b *testing.B) {
+		for b.Loop() {
+			if _, err := pkg.SaveToBytes(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("serialize", func(b *testing.B) {
+		for b.Loop() {
+			var buf bytes.Buffer
+			if err := (&PackageWriter{}).Write(&buf, pkg.rels, pkg.Parts()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
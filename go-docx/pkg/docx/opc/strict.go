@@ -0,0 +1,93 @@
+package opc
+
+import (
+	"bytes"
+	"strings"
+)
+
+// --------------------------------------------------------------------------
+// ISO 29500 Strict
+//
+// Strict documents use namespace URIs of their own for the XML of their
+// parts. Parts are normalized to Transitional when read, so the rest of
+// the library sees one vocabulary; PackageWriter.Strict converts them back
+// on save.
+// --------------------------------------------------------------------------
+
+// strictNamespaces pairs the Strict namespace URIs with their Transitional
+// equivalents.
+var strictNamespaces = [][2]string{
+	{"http://purl.oclc.org/ooxml/wordprocessingml/main", "http://schemas.openxmlformats.org/wordprocessingml/2006/main"},
+	{"http://purl.oclc.org/ooxml/officeDocument/relationships", "http://schemas.openxmlformats.org/officeDocument/2006/relationships"},
+	{"http://purl.oclc.org/ooxml/officeDocument/math", "http://schemas.openxmlformats.org/officeDocument/2006/math"},
+	{"http://purl.oclc.org/ooxml/officeDocument/sharedTypes", "http://schemas.openxmlformats.org/officeDocument/2006/sharedTypes"},
+	{"http://purl.oclc.org/ooxml/officeDocument/extendedProperties", "http://schemas.openxmlformats.org/officeDocument/2006/extended-properties"},
+	{"http://purl.oclc.org/ooxml/officeDocument/customProperties", "http://schemas.openxmlformats.org/officeDocument/2006/custom-properties"},
+	{"http://purl.oclc.org/ooxml/officeDocument/docPropsVTypes", "http://schemas.openxmlformats.org/officeDocument/2006/docPropsVTypes"},
+	{"http://purl.oclc.org/ooxml/officeDocument/customXml", "http://schemas.openxmlformats.org/officeDocument/2006/customXml"},
+	{"http://purl.oclc.org/ooxml/officeDocument/bibliography", "http://schemas.openxmlformats.org/officeDocument/2006/bibliography"},
+	{"http://purl.oclc.org/ooxml/schemaLibrary/main", "http://schemas.openxmlformats.org/schemaLibrary/2006/main"},
+	{"http://purl.oclc.org/ooxml/drawingml/main", "http://schemas.openxmlformats.org/drawingml/2006/main"},
+	{"http://purl.oclc.org/ooxml/drawingml/wordprocessingDrawing", "http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing"},
+	{"http://purl.oclc.org/ooxml/drawingml/picture", "http://schemas.openxmlformats.org/drawingml/2006/picture"},
+	{"http://purl.oclc.org/ooxml/drawingml/chart", "http://schemas.openxmlformats.org/drawingml/2006/chart"},
+	{"http://purl.oclc.org/ooxml/drawingml/chartDrawing", "http://schemas.openxmlformats.org/drawingml/2006/chartDrawing"},
+	{"http://purl.oclc.org/ooxml/drawingml/diagram", "http://schemas.openxmlformats.org/drawingml/2006/diagram"},
+	{"http://purl.oclc.org/ooxml/drawingml/lockedCanvas", "http://schemas.openxmlformats.org/drawingml/2006/lockedCanvas"},
+	{"http://purl.oclc.org/ooxml/drawingml/compatibility", "http://schemas.openxmlformats.org/drawingml/2006/compatibility"},
+}
+
+// strictRelTypeNames pairs the relationship type names Strict spells
+// differently with their Transitional spelling.
+var strictRelTypeNames = [][2]string{
+	{"extendedProperties", "extended-properties"},
+	{"customProperties", "custom-properties"},
+}
+
+// strictMarker starts every Strict namespace URI.
+var strictMarker = []byte("http://purl.oclc.org/ooxml/")
+
+// normalizeStrictXML returns blob with the Strict namespace URIs of its
+// attributes, such as namespace declarations, replaced by their
+// Transitional equivalents, and whether it had any.
+func normalizeStrictXML(blob []byte) ([]byte, bool) {
+	if !bytes.Contains(blob, strictMarker) {
+		return blob, false
+	}
+	out := replaceNamespaces(blob, 0, 1)
+	return out, !bytes.Equal(out, blob)
+}
+
+// toStrictXML returns blob with the Transitional namespace URIs of its
+// attributes replaced by their Strict equivalents.
+func toStrictXML(blob []byte) []byte {
+	return replaceNamespaces(blob, 1, 0)
+}
+
+// replaceNamespaces replaces the quoted attribute values in blob that are
+// the namespace URIs at index from of strictNamespaces with those at
+// index to.
+func replaceNamespaces(blob []byte, from, to int) []byte {
+	for _, ns := range strictNamespaces {
+		for _, q := range []string{`"`, `'`} {
+			blob = bytes.ReplaceAll(blob, []byte(q+ns[from]+q), []byte(q+ns[to]+q))
+		}
+	}
+	return blob
+}
+
+// StrictRelType converts a Transitional relationship type URI to its
+// Strict equivalent; the inverse of NormalizeRelType. Types outside the
+// officeDocument relationships namespace pass through unchanged.
+func StrictRelType(relType string) string {
+	if !strings.HasPrefix(relType, nsTransitionalOfcRel) {
+		return relType
+	}
+	name := relType[len(nsTransitionalOfcRel):]
+	for _, n := range strictRelTypeNames {
+		if name == n[1] {
+			name = n[0]
+		}
+	}
+	return nsStrictOfcRel + name
+}
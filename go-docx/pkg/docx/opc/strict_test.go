@@ -1,6 +1,8 @@
 package opc
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 )
 
@@ -225,3 +227,87 @@ func TestOpenBytes_StrictPartLevelRels(t *testing.T) {
 		t.Errorf("expected normalized %q, got %q", RTSettings, settingsRel.RelType)
 	}
 }
+
+func TestStrictRelType_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, rt := range []string{RTOfficeDocument, RTStyles, RTExtendedProperties, RTCoreProperties} {
+		if got := NormalizeRelType(StrictRelType(rt)); got != rt {
+			t.Errorf("NormalizeRelType(StrictRelType(%q)) = %q", rt, got)
+		}
+	}
+	if got := StrictRelType(RTExtendedProperties); got != "http://purl.oclc.org/ooxml/officeDocument/relationships/extendedProperties" {
+		t.Errorf("StrictRelType(RTExtendedProperties) = %q", got)
+	}
+	if got := StrictRelType(RTCoreProperties); got != RTCoreProperties {
+		t.Errorf("StrictRelType(RTCoreProperties) = %q, want it unchanged", got)
+	}
+}
+
+func TestOpenBytes_StrictNamespaces(t *testing.T) {
+	t.Parallel()
+
+	pkgRels := `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://purl.oclc.org/ooxml/officeDocument/relationships/officeDocument" Target="word/document.xml"/></Relationships>`
+	documentXml := `<w:document xmlns:w="http://purl.oclc.org/ooxml/wordprocessingml/main"` +
+		` xmlns:r='http://purl.oclc.org/ooxml/officeDocument/relationships' w:conformance="strict">` +
+		`<w:body><w:p><w:r><w:t>http://purl.oclc.org/ooxml/wordprocessingml/main</w:t></w:r></w:p></w:body></w:document>`
+	data := buildTestZip(t, map[string]string{
+		"[Content_Types].xml": minimalContentTypes,
+		"_rels/.rels":         pkgRels,
+		"word/document.xml":   documentXml,
+	})
+
+	pkg, err := OpenBytes(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pkg.Strict() {
+		t.Error("Strict() = false for a Strict package")
+	}
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	blob, _ := main.Blob()
+	for _, want := range []string{
+		`xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"`,
+		`xmlns:r='http://schemas.openxmlformats.org/officeDocument/2006/relationships'`,
+		`<w:t>http://purl.oclc.org/ooxml/wordprocessingml/main</w:t>`, // text is not a namespace
+	} {
+		if !strings.Contains(string(blob), want) {
+			t.Errorf("normalized part lacks %s", want)
+		}
+	}
+
+	for _, strict := range []bool{false, true} {
+		var buf bytes.Buffer
+		if err := pkg.SaveWith(&buf, &PackageWriter{Strict: strict}); err != nil {
+			t.Fatal(err)
+		}
+		reader, err := NewPhysPkgReaderFromBytes(buf.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		doc, _ := reader.BlobFor("/word/document.xml")
+		rels, _ := reader.RelsXmlFor(PackageURI)
+		reader.Close()
+		hasStrictNs := bytes.Contains(doc, []byte(`xmlns:w="http://purl.oclc.org/ooxml/wordprocessingml/main"`))
+		hasStrictRel := bytes.Contains(rels, []byte(`http://purl.oclc.org/ooxml/officeDocument/relationships/officeDocument`))
+		if hasStrictNs != strict || hasStrictRel != strict {
+			t.Errorf("Strict: %v: strict namespace %v, strict relationship %v", strict, hasStrictNs, hasStrictRel)
+		}
+	}
+
+	transitional, err := OpenBytes(buildTestZip(t, map[string]string{
+		"[Content_Types].xml": minimalContentTypes,
+		"_rels/.rels":         limitsPkgRels,
+		"word/document.xml":   minimalDocumentXml,
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transitional.Strict() {
+		t.Error("Strict() = true for a Transitional package")
+	}
+}
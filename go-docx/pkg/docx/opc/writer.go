@@ -1,16 +1,56 @@
 package opc
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // PackageWriter writes an OPC package to a ZIP stream.
-type PackageWriter struct{}
+type PackageWriter struct {
+	// Canonical makes the output depend on the content only: parts and
+	// relationships are written in name order and the attributes of every
+	// XML element sorted, namespace declarations first.
+	Canonical bool
+
+	// ModTime is the modification time recorded for every ZIP member; the
+	// zero value records none.
+	ModTime time.Time
+
+	// Context stops writing when it is done, checked before each part;
+	// the error returned then wraps the context's error. nil means
+	// writing cannot be canceled.
+	Context context.Context
+
+	// Progress, if set, is called after each part is written, as stage
+	// "write".
+	Progress ProgressFunc
+
+	// Strict writes the XML parts and relationship types with the
+	// namespaces of ISO 29500 Strict instead of Transitional ones. Markup
+	// Strict does not define, such as VML, is written as it is.
+	Strict bool
+
+	// incremental copies the stored members of parts not modified since
+	// they were loaded instead of serializing them.
+	incremental bool
+}
 
 // Write serializes the package relationships and parts to the writer.
 func (pw *PackageWriter) Write(w io.Writer, pkgRels *Relationships, parts []Part) error {
 	physWriter := NewPhysPkgWriter(w)
+	physWriter.ModTime = pw.ModTime
+	if pw.Canonical {
+		parts = append([]Part(nil), parts...)
+		sort.Slice(parts, func(i, j int) bool { return parts[i].PartName() < parts[j].PartName() })
+	}
+
+	opaque := opaqueParts(parts)
 
 	// 1. Write [Content_Types].xml
 	if err := pw.writeContentTypes(physWriter, parts); err != nil {
@@ -23,19 +63,37 @@ func (pw *PackageWriter) Write(w io.Writer, pkgRels *Relationships, parts []Part
 	}
 
 	// 3. Write each part's blob and its .rels (if any)
-	for _, part := range parts {
-		blob, err := part.Blob()
-		if err != nil {
-			return fmt.Errorf("opc: serializing part %q: %w", part.PartName(), err)
+	for i, part := range parts {
+		if pw.Context != nil {
+			if err := pw.Context.Err(); err != nil {
+				return fmt.Errorf("opc: saving package: %w", err)
+			}
 		}
-		if err := physWriter.Write(part.PartName(), blob); err != nil {
-			return fmt.Errorf("opc: writing part %q: %w", part.PartName(), err)
+		toStrict := pw.Strict && isXMLContentType(part.ContentType()) && !opaque[part.PartName()]
+		if raw := pw.storedForm(part, toStrict); raw != nil {
+			if err := physWriter.writeRaw(part.PartName(), raw); err != nil {
+				return fmt.Errorf("opc: writing part %q: %w", part.PartName(), err)
+			}
+		} else {
+			blob, err := pw.blob(part)
+			if err != nil {
+				return fmt.Errorf("opc: serializing part %q: %w", part.PartName(), err)
+			}
+			if toStrict {
+				blob = toStrictXML(blob)
+			}
+			if err := physWriter.Write(part.PartName(), blob); err != nil {
+				return fmt.Errorf("opc: writing part %q: %w", part.PartName(), err)
+			}
 		}
 		if part.Rels() != nil && part.Rels().Len() > 0 {
 			if err := pw.writeRels(physWriter, part.PartName(), part.Rels()); err != nil {
 				return err
 			}
 		}
+		if pw.Progress != nil {
+			pw.Progress("write", i+1, len(parts))
+		}
 	}
 
 	return physWriter.Close()
@@ -56,11 +114,99 @@ func (pw *PackageWriter) writeContentTypes(physWriter *PhysPkgWriter, parts []Pa
 	return physWriter.Write(ContentTypesURI, blob)
 }
 
+// blob returns the serialized content of part.
+func (pw *PackageWriter) blob(part Part) ([]byte, error) {
+	if xp, ok := part.(interface{ xmlPart() *XmlPart }); ok && pw.Canonical {
+		return xp.xmlPart().canonicalBlob()
+	}
+	return part.Blob()
+}
+
+// opaqueParts returns the names of the parts among parts that another
+// relates to by an opaque relationship type (see isOpaqueRelType).
+func opaqueParts(parts []Part) map[PackURI]bool {
+	opaque := map[PackURI]bool{}
+	for _, part := range parts {
+		if part.Rels() == nil {
+			continue
+		}
+		for _, rel := range part.Rels().All() {
+			if !rel.IsExternal && isOpaqueRelType(rel.RelType) && rel.TargetPart != nil {
+				opaque[rel.TargetPart.PartName()] = true
+			}
+		}
+	}
+	return opaque
+}
+
+// storedForm returns the stored form of part to copy instead of
+// serializing it, or nil to serialize it. Parts to be converted to Strict
+// are serialized, as their stored form is Transitional.
+func (pw *PackageWriter) storedForm(part Part, toStrict bool) *rawMember {
+	if !pw.incremental || toStrict {
+		return nil
+	}
+	return storedMemberOf(part)
+}
+
+// storedRels returns the stored .rels XML of rels to copy instead of
+// serializing them, or nil to serialize them. Relationships stored with
+// Strict types are serialized unless the package is written Strict, and
+// the other way around.
+func (pw *PackageWriter) storedRels(rels *Relationships) []byte {
+	stored := rels.StoredXML()
+	if !pw.incremental || stored == nil || bytes.Contains(stored, []byte(nsStrictOfcRel)) != pw.Strict {
+		return nil
+	}
+	return stored
+}
+
 func (pw *PackageWriter) writeRels(physWriter *PhysPkgWriter, sourceURI PackURI, rels *Relationships) error {
-	blob, err := SerializeRelationships(rels)
+	if stored := pw.storedRels(rels); stored != nil {
+		return physWriter.Write(sourceURI.RelsURI(), stored)
+	}
+	list := rels.All()
+	if pw.Canonical {
+		list = append([]*Relationship(nil), list...)
+		sort.SliceStable(list, func(i, j int) bool { return rIDLess(list[i].RID, list[j].RID) })
+	}
+	if pw.Strict {
+		strict := make([]*Relationship, len(list))
+		for i, rel := range list {
+			cp := *rel
+			cp.RelType = StrictRelType(rel.RelType)
+			strict[i] = &cp
+		}
+		list = strict
+	}
+	blob, err := serializeRelationships(list, rels.BaseURI())
 	if err != nil {
 		return fmt.Errorf("opc: serializing rels for %q: %w", sourceURI, err)
 	}
 	relsURI := sourceURI.RelsURI()
 	return physWriter.Write(relsURI, blob)
 }
+
+// rIDLess orders relationship ids as Word numbers them, rId2 before rId10;
+// ids not of that form sort after them, by name.
+func rIDLess(a, b string) bool {
+	an, aok := rIDNumber(a)
+	bn, bok := rIDNumber(b)
+	switch {
+	case aok && bok:
+		return an < bn
+	case aok != bok:
+		return aok
+	}
+	return a < b
+}
+
+// rIDNumber returns n for an id of the form rId<n>.
+func rIDNumber(rID string) (int, bool) {
+	digits, ok := strings.CutPrefix(rID, "rId")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(digits)
+	return n, err == nil
+}
@@ -14,6 +14,8 @@ type StyleRef interface {
 }
 
 // StyleName references a style by its display name (e.g. "Heading 1").
+// A name no style has, other than a built-in one Word can add, gives a
+// *StyleNotFoundError suggesting similarly spelled styles.
 type StyleName string
 
 func (StyleName) isStyleRef() {}
@@ -82,11 +84,11 @@ func (u UnderlineVal) Style() enum.WdUnderline { return u.wdU }
 
 // LineSpacingVal represents a line spacing value.
 // It is either a multiple of the normal line height (e.g. 1.5) or an
-// absolute distance in twips.
+// absolute distance.
 type LineSpacingVal struct {
 	isMultiple bool
 	multiple   float64
-	twips      int
+	length     Length
 }
 
 // LineSpacingMultiple creates a LineSpacingVal expressed as a multiple of the
@@ -98,7 +100,13 @@ func LineSpacingMultiple(v float64) LineSpacingVal {
 // LineSpacingTwips creates a LineSpacingVal expressed as an absolute distance
 // in twips (twentieth of a point).
 func LineSpacingTwips(v int) LineSpacingVal {
-	return LineSpacingVal{isMultiple: false, twips: v}
+	return LineSpacingLength(Twips(float64(v)))
+}
+
+// LineSpacingLength creates a LineSpacingVal expressed as an absolute
+// distance, e.g. LineSpacingLength(Pt(18)).
+func LineSpacingLength(v Length) LineSpacingVal {
+	return LineSpacingVal{isMultiple: false, length: v}
 }
 
 // IsMultiple reports whether this value is a line-height multiple.
@@ -108,9 +116,13 @@ func (v LineSpacingVal) IsMultiple() bool { return v.isMultiple }
 // IsMultiple() is true.
 func (v LineSpacingVal) Multiple() float64 { return v.multiple }
 
-// Twips returns the absolute twips value. Only meaningful when IsMultiple()
+// Length returns the absolute distance. Only meaningful when IsMultiple()
 // is false.
-func (v LineSpacingVal) Twips() int { return v.twips }
+func (v LineSpacingVal) Length() Length { return v.length }
+
+// Twips returns the absolute distance in twips. Only meaningful when
+// IsMultiple() is false.
+func (v LineSpacingVal) Twips() int { return v.length.Twips() }
 
 // ---------------------------------------------------------------------------
 // InlineItem — replaces []interface{} for IterInnerContent
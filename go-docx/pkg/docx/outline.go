@@ -0,0 +1,173 @@
+package docx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// Heading is an entry of the document outline: a paragraph with an outline
+// level, and the headings of lower level that follow it up to the next
+// heading of its level or higher.
+type Heading struct {
+	// Level is the outline level, 1 for the outermost as in "Heading 1".
+	Level int
+	// Text is the text of the heading paragraph.
+	Text string
+	// Paragraph is the heading paragraph itself.
+	Paragraph *Paragraph
+	// Children are the subheadings.
+	Children []*Heading
+}
+
+// Walk calls fn for h and then for its descendants in document order,
+// depth first. It stops descending where fn returns false.
+func (h *Heading) Walk(fn func(*Heading) bool) {
+	if !fn(h) {
+		return
+	}
+	for _, c := range h.Children {
+		c.Walk(fn)
+	}
+}
+
+// Outline returns the headings of the document body as a tree, as Word's
+// navigation pane shows them: paragraphs with an outline level, whether
+// from a heading style or set directly, in document order. Empty headings
+// are left out. A heading whose level skips below its predecessor's, such
+// as a Heading 3 right after a Heading 1, becomes a child of it.
+func (d *Document) Outline() ([]*Heading, error) {
+	b, err := d.getBody()
+	if err != nil {
+		return nil, err
+	}
+	styles, err := d.part.Styles()
+	if err != nil {
+		return nil, fmt.Errorf("docx: outline: %w", err)
+	}
+	stylesEl := styles.RawElement()
+
+	var roots, stack []*Heading
+	var walk func(el *etree.Element)
+	walk = func(el *etree.Element) {
+		for _, child := range el.ChildElements() {
+			switch {
+			case child.Space == "mc" && child.Tag == "Fallback",
+				child.Space == "w" && (child.Tag == "txbxContent" || child.Tag == "del"):
+			case child.Space == "w" && child.Tag == "p":
+				level := outlineLevel(stylesEl, child)
+				if level == 0 {
+					continue
+				}
+				para := newParagraph(&oxml.CT_P{Element: oxml.WrapElement(child)}, &d.part.StoryPart)
+				text := strings.TrimSpace(para.Text())
+				if text == "" {
+					continue
+				}
+				h := &Heading{Level: level, Text: text, Paragraph: para}
+				for len(stack) > 0 && stack[len(stack)-1].Level >= level {
+					stack = stack[:len(stack)-1]
+				}
+				if len(stack) == 0 {
+					roots = append(roots, h)
+				} else {
+					parent := stack[len(stack)-1]
+					parent.Children = append(parent.Children, h)
+				}
+				stack = append(stack, h)
+			default:
+				walk(child)
+			}
+		}
+	}
+	walk(b.element)
+	return roots, nil
+}
+
+// OutlineLevel returns the outline level of the paragraph, 1 to 9, or 0
+// for body text. It is set directly on the paragraph or comes from its
+// style, as for the built-in heading styles.
+func (para *Paragraph) OutlineLevel() (int, error) {
+	dp, err := para.part.DocumentPart()
+	if err != nil {
+		return 0, fmt.Errorf("docx: outline level: %w", err)
+	}
+	styles, err := dp.Styles()
+	if err != nil {
+		return 0, fmt.Errorf("docx: outline level: %w", err)
+	}
+	return outlineLevel(styles.RawElement(), para.p.RawElement()), nil
+}
+
+// outlineLevel returns the outline level of paragraph p, 1 to 9, or 0 for
+// body text. Heading styles that do not declare a level, as in documents
+// from some other producers, take it from their built-in name.
+func outlineLevel(styles, p *etree.Element) int {
+	if el := p.FindElement("w:pPr/w:outlineLvl"); el != nil {
+		return outlineLevelValue(el)
+	}
+	for _, style := range paragraphStyleChain(styles, p) {
+		if el := style.FindElement("w:pPr/w:outlineLvl"); el != nil {
+			return outlineLevelValue(el)
+		}
+		if el := style.SelectElement("w:name"); el != nil {
+			name := strings.ToLower(el.SelectAttrValue("w:val", ""))
+			if len(name) == len("heading 1") && strings.HasPrefix(name, "heading ") && name[8] >= '1' && name[8] <= '9' {
+				return int(name[8] - '0')
+			}
+		}
+	}
+	return 0
+}
+
+// outlineLevelValue converts a w:outlineLvl, 0-based with 9 for body text,
+// to a 1-based level or 0.
+func outlineLevelValue(el *etree.Element) int {
+	v := attrInt(el, "w:val", 9)
+	if v < 0 || v > 8 {
+		return 0
+	}
+	return v + 1
+}
+
+// paragraphStyleChain returns the style of paragraph p, or the default
+// paragraph style, followed by the styles it is based on.
+func paragraphStyleChain(styles, p *etree.Element) []*etree.Element {
+	id := ""
+	if el := p.FindElement("w:pPr/w:pStyle"); el != nil {
+		id = el.SelectAttrValue("w:val", "")
+	}
+	var chain []*etree.Element
+	style := findStyle(styles, "paragraph", id)
+	if style == nil && id != "" {
+		style = findStyle(styles, "paragraph", "")
+	}
+	for style != nil && len(chain) < 16 {
+		chain = append(chain, style)
+		based := style.SelectElement("w:basedOn")
+		if based == nil || based.SelectAttrValue("w:val", "") == "" {
+			break
+		}
+		style = findStyle(styles, "paragraph", based.SelectAttrValue("w:val", ""))
+	}
+	return chain
+}
+
+// findStyle returns the style of type typ with the given id, or the
+// default style of the type for "".
+func findStyle(styles *etree.Element, typ, id string) *etree.Element {
+	if styles == nil {
+		return nil
+	}
+	for _, s := range styles.SelectElements("w:style") {
+		if s.SelectAttrValue("w:type", "paragraph") != typ {
+			continue
+		}
+		if id == "" && isOn(s.SelectAttrValue("w:default", "")) || id != "" && s.SelectAttrValue("w:styleId", "") == id {
+			return s
+		}
+	}
+	return nil
+}
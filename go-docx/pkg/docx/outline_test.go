@@ -0,0 +1,58 @@
+package docx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDocument_Outline(t *testing.T) {
+	d := mustNewDoc(t)
+	add := func(text string, level int) *Paragraph {
+		t.Helper()
+		p, err := d.AddHeading(text, level)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	add("Title", 0)
+	add("Intro", 1)
+	if _, err := d.AddParagraph("body text"); err != nil {
+		t.Fatal(err)
+	}
+	add("Scope", 2)
+	add("Detail", 4)
+	add("", 2)
+	add("Terms", 1)
+	add("Definitions", 2)
+	custom, err := d.AddParagraph("Annex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom.p.GetOrAddPPr().RawElement().CreateElement("w:outlineLvl").CreateAttr("w:val", "0")
+
+	outline, err := d.Outline()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, root := range outline {
+		root.Walk(func(h *Heading) bool {
+			lines = append(lines, strings.Repeat("-", h.Level)+h.Text)
+			return true
+		})
+	}
+	want := "-Intro --Scope ----Detail -Terms --Definitions -Annex"
+	if got := strings.Join(lines, " "); got != want {
+		t.Errorf("outline = %q, want %q", got, want)
+	}
+	if len(outline) != 3 || len(outline[0].Children) != 1 || outline[0].Children[0].Children[0].Text != "Detail" {
+		t.Fatalf("unexpected tree shape")
+	}
+	if outline[2].Paragraph.p.RawElement() != custom.p.RawElement() {
+		t.Error("heading does not link back to its paragraph")
+	}
+	if lvl, err := custom.OutlineLevel(); err != nil || lvl != 1 {
+		t.Errorf("OutlineLevel = %d, %v", lvl, err)
+	}
+}
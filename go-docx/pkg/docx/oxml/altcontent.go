@@ -0,0 +1,35 @@
+package oxml
+
+import "github.com/beevik/etree"
+
+// IsAlternateContent reports whether el is an <mc:AlternateContent>
+// element.
+func IsAlternateContent(el *etree.Element) bool {
+	return el.Space == "mc" && el.Tag == "AlternateContent"
+}
+
+// AlternateContentBranch returns the branch of <mc:AlternateContent> el
+// whose content is read: the first <mc:Choice>, which holds the markup of
+// current Word versions such as DrawingML shapes, or the <mc:Fallback>
+// when there is no choice. It returns nil for an empty element.
+//
+// Text, layout and content iteration all read this branch, so content
+// with an alternate is seen exactly once.
+func AlternateContentBranch(el *etree.Element) *etree.Element {
+	var fallback *etree.Element
+	for _, tok := range el.Child {
+		child, ok := tok.(*etree.Element)
+		if !ok || child.Space != "mc" {
+			continue
+		}
+		switch child.Tag {
+		case "Choice":
+			return child
+		case "Fallback":
+			if fallback == nil {
+				fallback = child
+			}
+		}
+	}
+	return fallback
+}
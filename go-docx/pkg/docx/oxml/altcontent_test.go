@@ -0,0 +1,77 @@
+package oxml
+
+import (
+	"strings"
+	"testing"
+)
+
+const altNs = ` xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"` +
+	` xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006"` +
+	` xmlns:wp="http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing"`
+
+func TestAlternateContentBranch(t *testing.T) {
+	tests := []struct {
+		xml  string
+		want string
+	}{
+		{`<mc:AlternateContent` + altNs + `><mc:Fallback/><mc:Choice Requires="wps"/><mc:Choice Requires="w14"/></mc:AlternateContent>`, "wps"},
+		{`<mc:AlternateContent` + altNs + `><mc:Fallback Ignorable="x"/></mc:AlternateContent>`, "fallback"},
+		{`<mc:AlternateContent` + altNs + `/>`, ""},
+	}
+	for _, tt := range tests {
+		el := mustParseSchemaXml(t, tt.xml).e
+		if !IsAlternateContent(el) {
+			t.Fatalf("IsAlternateContent(%s) = false", tt.xml)
+		}
+		got := ""
+		if b := AlternateContentBranch(el); b != nil {
+			got = b.SelectAttrValue("Requires", "fallback")
+		}
+		if got != tt.want {
+			t.Errorf("AlternateContentBranch(%s) = %q, want %q", tt.xml, got, tt.want)
+		}
+	}
+}
+
+func TestCT_P_AlternateContentTraversal(t *testing.T) {
+	p := &CT_P{*mustParseSchemaXml(t, `<w:p`+altNs+`><w:r><w:t>a </w:t></w:r>`+
+		`<mc:AlternateContent><mc:Choice Requires="w14"><w:r><w:t>new OLD</w:t></w:r></mc:Choice>`+
+		`<mc:Fallback><w:r><w:t>old OLD</w:t></w:r></mc:Fallback></mc:AlternateContent>`+
+		`<w:r><mc:AlternateContent><mc:Choice Requires="wps"><w:drawing><wp:inline/></w:drawing></mc:Choice>`+
+		`<mc:Fallback><w:t>[shape OLD]</w:t></mc:Fallback></mc:AlternateContent></w:r></w:p>`)}
+
+	if got := p.ParagraphText(); got != "a new OLD" {
+		t.Errorf("ParagraphText() = %q", got)
+	}
+	items := p.InnerContentElements()
+	if len(items) != 3 {
+		t.Fatalf("InnerContentElements() has %d items, want 3", len(items))
+	}
+	drawing := items[2].(*CT_R)
+	var kinds []string
+	for _, item := range drawing.InnerContentItems() {
+		switch item.(type) {
+		case *CT_Drawing:
+			kinds = append(kinds, "drawing")
+		case string:
+			kinds = append(kinds, "text")
+		}
+	}
+	if got := strings.Join(kinds, ","); got != "drawing" {
+		t.Errorf("run content = %s, want drawing", got)
+	}
+
+	if n := p.ReplaceText("OLD", "NEW"); n != 1 {
+		t.Errorf("ReplaceText() = %d, want 1", n)
+	}
+	if got := p.ParagraphText(); got != "a new NEW" {
+		t.Errorf("ParagraphText() after replace = %q", got)
+	}
+	var fallbacks []string
+	for _, t := range p.e.FindElements(".//mc:Fallback//w:t") {
+		fallbacks = append(fallbacks, t.Text())
+	}
+	if got := strings.Join(fallbacks, "|"); got != "old NEW|[shape NEW]" {
+		t.Errorf("fallback text = %q", got)
+	}
+}
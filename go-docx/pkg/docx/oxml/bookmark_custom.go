@@ -0,0 +1,115 @@
+package oxml
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// --------------------------------------------------------------------------
+// bookmark_custom.go — <w:bookmarkStart> / <w:bookmarkEnd> helpers
+// --------------------------------------------------------------------------
+
+// NextBookmarkID returns an unused bookmark id for the story rooted at root:
+// one more than the largest <w:bookmarkStart w:id> found.
+func NextBookmarkID(root *etree.Element) int {
+	maxID := -1
+	for _, bs := range root.FindElements(".//w:bookmarkStart") {
+		if v, err := strconv.Atoi(etreeAttrVal(bs, "w", "id")); err == nil && v > maxID {
+			maxID = v
+		}
+	}
+	return maxID + 1
+}
+
+// HasBookmark reports whether a bookmark named name starts under root.
+func HasBookmark(root *etree.Element, name string) bool {
+	return findBookmarkStart(root, name) != nil
+}
+
+// ParagraphBookmarkNames returns the names of the bookmarks starting
+// directly in the paragraph p, in document order.
+func ParagraphBookmarkNames(p *etree.Element) []string {
+	var names []string
+	for _, bs := range p.FindElements(".//w:bookmarkStart") {
+		names = append(names, etreeAttrVal(bs, "w", "name"))
+	}
+	return names
+}
+
+// WrapParagraphInBookmark brackets the content of paragraph p (everything
+// after <w:pPr>) with a bookmark named name.
+func WrapParagraphInBookmark(p *etree.Element, id int, name string) {
+	idStr := strconv.Itoa(id)
+	start := OxmlElement("w:bookmarkStart")
+	start.CreateAttr("w:id", idStr)
+	start.CreateAttr("w:name", name)
+	end := OxmlElement("w:bookmarkEnd")
+	end.CreateAttr("w:id", idStr)
+
+	idx := 0
+	if pPr := p.SelectElement("w:pPr"); pPr != nil {
+		idx = childIndex(p, pPr) + 1
+	}
+	p.InsertChildAt(idx, start)
+	p.AddChild(end)
+}
+
+// WrapRunsInBookmark brackets the sibling elements first through last with
+// a bookmark named name.
+func WrapRunsInBookmark(first, last *etree.Element, id int, name string) {
+	idStr := strconv.Itoa(id)
+	start := OxmlElement("w:bookmarkStart")
+	start.CreateAttr("w:id", idStr)
+	start.CreateAttr("w:name", name)
+	end := OxmlElement("w:bookmarkEnd")
+	end.CreateAttr("w:id", idStr)
+	insertElementBefore(first, start)
+	insertElementAfter(last, end)
+}
+
+// BookmarkText returns the text of the runs between the start and end of
+// the bookmark named name under root. ok is false if the bookmark is not
+// found.
+func BookmarkText(root *etree.Element, name string) (text string, ok bool) {
+	start := findBookmarkStart(root, name)
+	if start == nil {
+		return "", false
+	}
+	id := etreeAttrVal(start, "w", "id")
+	var sb strings.Builder
+	inside, done := false, false
+	var walk func(el *etree.Element)
+	walk = func(el *etree.Element) {
+		for _, child := range el.ChildElements() {
+			if done {
+				return
+			}
+			switch {
+			case child == start:
+				inside = true
+			case child.Space == "w" && child.Tag == "bookmarkEnd" && etreeAttrVal(child, "w", "id") == id:
+				done = true
+				return
+			case child.Space == "w" && child.Tag == "r":
+				if inside {
+					sb.WriteString((&CT_R{Element{e: child}}).RunText())
+				}
+			default:
+				walk(child)
+			}
+		}
+	}
+	walk(root)
+	return sb.String(), true
+}
+
+func findBookmarkStart(root *etree.Element, name string) *etree.Element {
+	for _, bs := range root.FindElements(".//w:bookmarkStart") {
+		if etreeAttrVal(bs, "w", "name") == name {
+			return bs
+		}
+	}
+	return nil
+}
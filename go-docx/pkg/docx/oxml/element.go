@@ -84,14 +84,52 @@ func (el *Element) FirstChildIn(tags ...string) *etree.Element {
 // InsertElementBefore inserts child before the first element matching one of the
 // successor tags. If no successor is found, child is appended to the end.
 // This is the core mechanism for maintaining correct element ordering in OOXML.
+//
+// A child the content model allows at most once, such as a property, is
+// also kept ahead of elements the model does not describe (extension
+// elements like w14:textOutline, mc:AlternateContent, tracked property
+// changes) that directly precede that position, so that those stay after
+// the modelled children as Word writes them.
 func (el *Element) InsertElementBefore(child *etree.Element, successors ...string) {
+	var ref *etree.Element
 	for _, succ := range successors {
 		if found := el.FindChild(succ); found != nil {
-			insertBefore(el.e, child, found)
-			return
+			ref = found
+			break
 		}
 	}
-	el.e.AddChild(child)
+	if len(successors) > 0 {
+		ref = el.skipUnmodelled(qualifiedTag(child), ref)
+	}
+	if ref == nil {
+		el.e.AddChild(child)
+		return
+	}
+	insertBefore(el.e, child, ref)
+}
+
+// skipUnmodelled returns the first of the children that directly precede
+// ref (or the end of el when ref is nil) and that el's content model does
+// not describe, when it allows tag at most once; otherwise it returns
+// ref.
+func (el *Element) skipUnmodelled(tag string, ref *etree.Element) *etree.Element {
+	rule, ok := schemaRules[qualifiedTag(el.e)]
+	if !ok || !rule.singleton(tag) {
+		return ref
+	}
+	children := el.e.ChildElements()
+	i := len(children)
+	if ref != nil {
+		for i = 0; i < len(children) && children[i] != ref; i++ {
+		}
+	}
+	for i > 0 && !rule.models(qualifiedTag(children[i-1])) {
+		i--
+	}
+	if i == len(children) {
+		return nil
+	}
+	return children[i]
 }
 
 // RemoveAll removes all child elements with the specified namespace-prefixed tags.
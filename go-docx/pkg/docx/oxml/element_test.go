@@ -1,6 +1,7 @@
 package oxml
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/beevik/etree"
@@ -375,3 +376,39 @@ func TestNewElement(t *testing.T) {
 		}
 	})
 }
+
+func TestInsertElementBefore_Unmodelled(t *testing.T) {
+	t.Parallel()
+	ns := ` xmlns:w="` + nsmap["w"] + `" xmlns:w14="` + nsmap["w14"] + `" xmlns:mc="` + nsmap["mc"] + `"`
+	childTags := func(el *Element) string {
+		var tags []string
+		for _, c := range el.e.ChildElements() {
+			tags = append(tags, qualifiedTag(c))
+		}
+		return strings.Join(tags, " ")
+	}
+
+	t.Run("property before extension elements", func(t *testing.T) {
+		rPr := &CT_RPr{*mustParseSchemaXml(t, `<w:rPr`+ns+`><w:b/><w14:textOutline/><w:rPrChange/></w:rPr>`)}
+		rPr.GetOrAddSz()
+		if got := childTags(&rPr.Element); got != "w:b w:sz w14:textOutline w:rPrChange" {
+			t.Errorf("children = %q", got)
+		}
+	})
+
+	t.Run("run properties before alternate content", func(t *testing.T) {
+		r := &CT_R{*mustParseSchemaXml(t, `<w:r`+ns+`><mc:AlternateContent/><w:t>x</w:t></w:r>`)}
+		r.GetOrAddRPr()
+		if got := childTags(&r.Element); got != "w:rPr mc:AlternateContent w:t" {
+			t.Errorf("children = %q", got)
+		}
+	})
+
+	t.Run("repeatable content stays after", func(t *testing.T) {
+		body := &CT_Body{*mustParseSchemaXml(t, `<w:body`+ns+`><w:p/><mc:AlternateContent/></w:body>`)}
+		body.AddP()
+		if got := childTags(&body.Element); got != "w:p mc:AlternateContent w:p" {
+			t.Errorf("children = %q", got)
+		}
+	})
+}
@@ -1,6 +1,46 @@
 package oxml
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrNotRectangularSpan is returned when the cells given for a merge do not
+// bound a rectangular region of the table grid.
+var ErrNotRectangularSpan = errors.New("oxml: requested span not rectangular")
+
+// ErrStyleNotFound is returned when no style has the requested name.
+var ErrStyleNotFound = errors.New("oxml: style not found")
+
+// ErrWrongStyleType is returned when a style of one type, such as a
+// paragraph style, is applied where another type is needed.
+var ErrWrongStyleType = errors.New("oxml: wrong style type")
+
+// StyleNotFoundError reports a style name that matches no style, with the
+// names of similarly spelled styles the caller may have meant. It matches
+// ErrStyleNotFound with errors.Is.
+type StyleNotFoundError struct {
+	Name        string   // the name looked up
+	Suggestions []string // UI names of close matches, closest first
+}
+
+func (e *StyleNotFoundError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("%v: %q", ErrStyleNotFound, e.Name)
+	}
+	quoted := make([]string, len(e.Suggestions))
+	for i, s := range e.Suggestions {
+		quoted[i] = strconv.Quote(s)
+	}
+	return fmt.Sprintf("%v: %q (did you mean %s?)", ErrStyleNotFound, e.Name, strings.Join(quoted, ", "))
+}
+
+// Is reports whether target is ErrStyleNotFound.
+func (e *StyleNotFoundError) Is(target error) bool {
+	return target == ErrStyleNotFound
+}
 
 // ParseAttrError indicates that an XML attribute value could not be parsed
 // into the expected Go type. It carries enough context for diagnostics:
@@ -0,0 +1,436 @@
+package oxml
+
+import (
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// --------------------------------------------------------------------------
+// field_custom.go — field code scanning and construction
+//
+// WordprocessingML has two field representations:
+//
+//   - simple fields: <w:fldSimple w:instr="..."> wrapping the result runs;
+//   - complex fields: a begin / separate / end triple of <w:fldChar> runs,
+//     with the instruction in <w:instrText> runs between begin and separate
+//     and the cached result in the runs between separate and end.
+//
+// Complex fields may nest (in the instruction or the result) and may span
+// paragraphs, so they are scanned over a whole story subtree in document
+// order rather than per paragraph.
+// --------------------------------------------------------------------------
+
+// FieldSpan records the XML making up one field found by ScanFields.
+//
+// For a simple field only Simple is set. For a complex field Begin is the
+// begin <w:fldChar>; Separate and End are nil when the field has no result
+// section or is not terminated within the scanned subtree.
+type FieldSpan struct {
+	Simple *etree.Element
+
+	Begin, Separate, End *etree.Element
+	// InstrTexts are the <w:instrText> elements of this field, excluding
+	// those of fields nested in the instruction.
+	InstrTexts []*etree.Element
+	// ResultRuns are the <w:r> elements between the separate and end
+	// <w:fldChar>, including the runs of nested fields.
+	ResultRuns []*etree.Element
+
+	inResult bool
+}
+
+// ScanFields returns the fields under root in document order of their
+// start. Content inside mc:Fallback and <w:del> is skipped.
+func ScanFields(root *etree.Element) []*FieldSpan {
+	var result, stack []*FieldSpan
+
+	var walk func(el *etree.Element)
+	walk = func(el *etree.Element) {
+		for _, child := range el.ChildElements() {
+			switch {
+			case child.Space == "mc" && child.Tag == "Fallback",
+				child.Space == "w" && child.Tag == "del":
+				continue
+			case child.Space == "w" && child.Tag == "fldSimple":
+				result = append(result, &FieldSpan{Simple: child})
+				walk(child)
+			case child.Space == "w" && child.Tag == "r":
+				stack = scanFieldRun(child, stack, &result)
+			default:
+				walk(child)
+			}
+		}
+	}
+	walk(root)
+	return result
+}
+
+// scanFieldRun processes the field characters and instruction text of run,
+// attributes run to the result of every open field that does not own one
+// of its field characters, and returns the updated stack of open fields.
+func scanFieldRun(run *etree.Element, stack []*FieldSpan, result *[]*FieldSpan) []*FieldSpan {
+	var owners []*FieldSpan
+	for _, rc := range run.ChildElements() {
+		if rc.Space != "w" {
+			continue
+		}
+		switch rc.Tag {
+		case "fldChar":
+			switch etreeAttrVal(rc, "w", "fldCharType") {
+			case "begin":
+				f := &FieldSpan{Begin: rc}
+				*result = append(*result, f)
+				stack = append(stack, f)
+				owners = append(owners, f)
+			case "separate":
+				if len(stack) > 0 {
+					top := stack[len(stack)-1]
+					top.Separate = rc
+					top.inResult = true
+					owners = append(owners, top)
+				}
+			case "end":
+				if len(stack) > 0 {
+					top := stack[len(stack)-1]
+					top.End = rc
+					owners = append(owners, top)
+					stack = stack[:len(stack)-1]
+				}
+			}
+		case "instrText":
+			if len(stack) > 0 {
+				if top := stack[len(stack)-1]; !top.inResult {
+					top.InstrTexts = append(top.InstrTexts, rc)
+				}
+			}
+		}
+	}
+	for _, f := range stack {
+		if f.inResult && !containsFieldSpan(owners, f) {
+			f.ResultRuns = append(f.ResultRuns, run)
+		}
+	}
+	return stack
+}
+
+func containsFieldSpan(list []*FieldSpan, f *FieldSpan) bool {
+	for _, x := range list {
+		if x == f {
+			return true
+		}
+	}
+	return false
+}
+
+// Instruction returns the field code of the field, with surrounding
+// whitespace trimmed.
+func (f *FieldSpan) Instruction() string {
+	if f.Simple != nil {
+		return strings.TrimSpace(etreeAttrVal(f.Simple, "w", "instr"))
+	}
+	var sb strings.Builder
+	for _, it := range f.InstrTexts {
+		sb.WriteString(it.Text())
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// SetInstruction replaces the field code. For a complex field the text is
+// placed in the first <w:instrText> and the others of this field are
+// removed; if there is none, a new instruction run is added after the
+// begin run.
+func (f *FieldSpan) SetInstruction(instr string) {
+	if f.Simple != nil {
+		f.Simple.CreateAttr("w:instr", " "+instr+" ")
+		return
+	}
+	if len(f.InstrTexts) == 0 {
+		run := newFieldRun(f.Begin.Parent())
+		it := run.CreateElement("w:instrText")
+		insertElementAfter(f.Begin.Parent(), run)
+		f.InstrTexts = []*etree.Element{it}
+	}
+	first := f.InstrTexts[0]
+	first.SetText(" " + instr + " ")
+	setPreserveSpace(first)
+	for _, it := range f.InstrTexts[1:] {
+		if p := it.Parent(); p != nil {
+			p.RemoveChild(it)
+			removeIfEmptyRun(p)
+		}
+	}
+	f.InstrTexts = f.InstrTexts[:1]
+}
+
+// Result returns the text of the cached field result.
+func (f *FieldSpan) Result() string {
+	var sb strings.Builder
+	for _, r := range f.resultRuns() {
+		sb.WriteString((&CT_R{Element{e: r}}).RunText())
+	}
+	return sb.String()
+}
+
+// resultRuns returns the result runs of the field; for a simple field these
+// are the <w:r> descendants of <w:fldSimple>.
+func (f *FieldSpan) resultRuns() []*etree.Element {
+	if f.Simple == nil {
+		return f.ResultRuns
+	}
+	return f.Simple.FindElements(".//w:r")
+}
+
+// SetResult replaces the cached field result with a single run containing
+// text. The new run takes the run properties of the first plain result run,
+// if any. Nested fields in the old result are removed. A complex field
+// without a separate <w:fldChar> gets one inserted before the end run.
+func (f *FieldSpan) SetResult(text string) {
+	old := f.resultRuns()
+	var rPr *etree.Element
+	for _, r := range old {
+		if r.SelectElement("w:fldChar") == nil && r.SelectElement("w:instrText") == nil {
+			rPr = r.SelectElement("w:rPr")
+			break
+		}
+	}
+
+	var run *etree.Element
+	if f.Simple != nil {
+		for _, c := range f.Simple.ChildElements() {
+			f.Simple.RemoveChild(c)
+		}
+		run = f.Simple.CreateElement("w:r")
+	} else {
+		if f.Separate == nil {
+			if f.End == nil {
+				return
+			}
+			sep := newFieldRun(f.End.Parent())
+			f.Separate = sep.CreateElement("w:fldChar")
+			f.Separate.CreateAttr("w:fldCharType", "separate")
+			insertElementBefore(f.End.Parent(), sep)
+		}
+		for _, r := range old {
+			if p := r.Parent(); p != nil {
+				p.RemoveChild(r)
+			}
+		}
+		if rPr == nil {
+			rPr = f.Separate.Parent().SelectElement("w:rPr")
+		}
+		run = OxmlElement("w:r")
+		insertElementAfter(f.Separate.Parent(), run)
+		f.ResultRuns = []*etree.Element{run}
+	}
+	if rPr != nil {
+		run.AddChild(rPr.Copy())
+	}
+	appendRunContentFromText(&CT_R{Element{e: run}}, text)
+}
+
+// Unlink replaces the field with its cached result, like Word's "Unlink
+// Field": the result runs stay in place as plain text and everything else
+// belonging to the field is removed. A complex field without a separate
+// <w:fldChar> is removed entirely.
+func (f *FieldSpan) Unlink() {
+	if f.Simple != nil {
+		parent := f.Simple.Parent()
+		if parent == nil {
+			return
+		}
+		idx := childIndex(parent, f.Simple)
+		parent.RemoveChild(f.Simple)
+		for i, c := range f.Simple.ChildElements() {
+			f.Simple.RemoveChild(c)
+			parent.InsertChildAt(idx+i, c)
+		}
+		return
+	}
+	// Begin through separate (or end) are normally siblings in one
+	// paragraph; instruction runs outside that range are removed below.
+	last := f.Separate
+	if last == nil {
+		last = f.End
+	}
+	removeRunRange(f.Begin.Parent(), lastParent(last))
+	for _, it := range f.InstrTexts {
+		if p := it.Parent(); p != nil && p.Parent() != nil {
+			p.Parent().RemoveChild(p)
+		}
+	}
+	if f.End != nil {
+		if r := f.End.Parent(); r != nil && r.Parent() != nil {
+			r.Parent().RemoveChild(r)
+		}
+	}
+	f.ResultRuns = nil
+}
+
+// Remove deletes the field together with its cached result.
+func (f *FieldSpan) Remove() {
+	for _, r := range f.resultRuns() {
+		if p := r.Parent(); p != nil {
+			p.RemoveChild(r)
+		}
+	}
+	if f.Simple != nil {
+		if p := f.Simple.Parent(); p != nil {
+			p.RemoveChild(f.Simple)
+		}
+		return
+	}
+	f.Unlink()
+}
+
+// lastParent returns the parent of el, or nil if el is nil.
+func lastParent(el *etree.Element) *etree.Element {
+	if el == nil {
+		return nil
+	}
+	return el.Parent()
+}
+
+// removeRunRange removes first, last and every sibling between them. When
+// the two are not siblings (or last is nil) only first and last are
+// removed.
+func removeRunRange(first, last *etree.Element) {
+	parent := first.Parent()
+	if parent == nil {
+		return
+	}
+	if last == nil || last.Parent() != parent {
+		parent.RemoveChild(first)
+		if last != nil && last.Parent() != nil {
+			last.Parent().RemoveChild(last)
+		}
+		return
+	}
+	start, end := childIndex(parent, first), childIndex(parent, last)
+	var doomed []etree.Token
+	for i := start; i <= end; i++ {
+		doomed = append(doomed, parent.Child[i])
+	}
+	for _, tok := range doomed {
+		parent.RemoveChild(tok)
+	}
+}
+
+// fieldFlagElement returns the element carrying the fldLock and dirty
+// attributes: <w:fldSimple> or the begin <w:fldChar>.
+func (f *FieldSpan) fieldFlagElement() *etree.Element {
+	if f.Simple != nil {
+		return f.Simple
+	}
+	return f.Begin
+}
+
+// Locked reports whether the field is locked against updates.
+func (f *FieldSpan) Locked() bool {
+	return parseOnOff(etreeAttrVal(f.fieldFlagElement(), "w", "fldLock"))
+}
+
+// SetLocked sets or clears the fldLock attribute.
+func (f *FieldSpan) SetLocked(v bool) {
+	setOnOffAttr(f.fieldFlagElement(), "w:fldLock", v)
+}
+
+// Dirty reports whether the field is flagged for update on next open.
+func (f *FieldSpan) Dirty() bool {
+	return parseOnOff(etreeAttrVal(f.fieldFlagElement(), "w", "dirty"))
+}
+
+// SetDirty sets or clears the dirty attribute.
+func (f *FieldSpan) SetDirty(v bool) {
+	setOnOffAttr(f.fieldFlagElement(), "w:dirty", v)
+}
+
+// NewComplexFieldRuns builds the runs of a complex field with the given
+// instruction and cached result: begin, instruction, separate, result and
+// end. rPr, if non-nil, is copied into every run. The returned span refers
+// to the new, still detached, runs.
+func NewComplexFieldRuns(instr, result string, rPr *etree.Element) ([]*etree.Element, *FieldSpan) {
+	f := &FieldSpan{}
+	mk := func() *etree.Element {
+		r := OxmlElement("w:r")
+		if rPr != nil {
+			r.AddChild(rPr.Copy())
+		}
+		return r
+	}
+	begin, instrRun, sep, res, end := mk(), mk(), mk(), mk(), mk()
+
+	f.Begin = begin.CreateElement("w:fldChar")
+	f.Begin.CreateAttr("w:fldCharType", "begin")
+	it := instrRun.CreateElement("w:instrText")
+	it.SetText(" " + instr + " ")
+	setPreserveSpace(it)
+	f.InstrTexts = []*etree.Element{it}
+	f.Separate = sep.CreateElement("w:fldChar")
+	f.Separate.CreateAttr("w:fldCharType", "separate")
+	appendRunContentFromText(&CT_R{Element{e: res}}, result)
+	f.ResultRuns = []*etree.Element{res}
+	f.End = end.CreateElement("w:fldChar")
+	f.End.CreateAttr("w:fldCharType", "end")
+
+	return []*etree.Element{begin, instrRun, sep, res, end}, f
+}
+
+// newFieldRun creates a detached <w:r>, copying the run properties of
+// like (a run of the same field) so the field renders consistently.
+func newFieldRun(like *etree.Element) *etree.Element {
+	r := OxmlElement("w:r")
+	if like != nil {
+		if rPr := like.SelectElement("w:rPr"); rPr != nil {
+			r.AddChild(rPr.Copy())
+		}
+	}
+	return r
+}
+
+// insertElementAfter inserts el immediately after ref in ref's parent.
+func insertElementAfter(ref, el *etree.Element) {
+	parent := ref.Parent()
+	parent.InsertChildAt(childIndex(parent, ref)+1, el)
+}
+
+// insertElementBefore inserts el immediately before ref in ref's parent.
+func insertElementBefore(ref, el *etree.Element) {
+	parent := ref.Parent()
+	parent.InsertChildAt(childIndex(parent, ref), el)
+}
+
+// removeIfEmptyRun removes r from its parent if it is a run with no
+// content other than run properties.
+func removeIfEmptyRun(r *etree.Element) {
+	if r.Space != "w" || r.Tag != "r" {
+		return
+	}
+	for _, c := range r.ChildElements() {
+		if !(c.Space == "w" && c.Tag == "rPr") {
+			return
+		}
+	}
+	if p := r.Parent(); p != nil {
+		p.RemoveChild(r)
+	}
+}
+
+// setPreserveSpace sets xml:space="preserve" on a text element.
+func setPreserveSpace(el *etree.Element) {
+	el.CreateAttr("xml:space", "preserve")
+}
+
+// parseOnOff interprets an ST_OnOff attribute value; empty means false.
+func parseOnOff(v string) bool {
+	return v == "1" || v == "true" || v == "on"
+}
+
+// setOnOffAttr sets attr to "1" when v is true and removes it otherwise.
+func setOnOffAttr(el *etree.Element, attr string, v bool) {
+	if v {
+		el.CreateAttr(attr, "1")
+	} else {
+		el.RemoveAttr(attr)
+	}
+}
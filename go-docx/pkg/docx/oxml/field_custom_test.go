@@ -0,0 +1,109 @@
+package oxml
+
+import (
+	"testing"
+)
+
+const fieldBodyXml = `<w:body xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+	`<w:p>` +
+	`<w:r><w:t>Date: </w:t></w:r>` +
+	`<w:fldSimple w:instr=" DATE \@ &quot;yyyy&quot; "><w:r><w:t>2024</w:t></w:r></w:fldSimple>` +
+	`</w:p>` +
+	`<w:p>` +
+	`<w:r><w:fldChar w:fldCharType="begin"/></w:r>` +
+	`<w:r><w:instrText xml:space="preserve"> IF </w:instrText></w:r>` +
+	`<w:r><w:fldChar w:fldCharType="begin"/></w:r>` +
+	`<w:r><w:instrText> MERGEFIELD Gender </w:instrText></w:r>` +
+	`<w:r><w:fldChar w:fldCharType="separate"/></w:r>` +
+	`<w:r><w:t>F</w:t></w:r>` +
+	`<w:r><w:fldChar w:fldCharType="end"/></w:r>` +
+	`<w:r><w:instrText xml:space="preserve"> = "F" "Ms" "Mr" </w:instrText></w:r>` +
+	`<w:r><w:fldChar w:fldCharType="separate"/></w:r>` +
+	`<w:r><w:rPr><w:b/></w:rPr><w:t>M</w:t></w:r>` +
+	`<w:r><w:rPr><w:b/></w:rPr><w:t>s</w:t></w:r>` +
+	`<w:r><w:fldChar w:fldCharType="end"/></w:r>` +
+	`</w:p>` +
+	`</w:body>`
+
+func TestScanFields_SimpleAndNested(t *testing.T) {
+	root, err := ParseXml([]byte(fieldBodyXml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := ScanFields(root)
+	if len(fields) != 3 {
+		t.Fatalf("expected 3 fields, got %d", len(fields))
+	}
+	cases := []struct{ instr, result string }{
+		{`DATE \@ "yyyy"`, "2024"},
+		{`IF  = "F" "Ms" "Mr"`, "Ms"},
+		{"MERGEFIELD Gender", "F"},
+	}
+	for i, c := range cases {
+		if got := fields[i].Instruction(); got != c.instr {
+			t.Errorf("field %d Instruction() = %q, want %q", i, got, c.instr)
+		}
+		if got := fields[i].Result(); got != c.result {
+			t.Errorf("field %d Result() = %q, want %q", i, got, c.result)
+		}
+	}
+	if len(fields[1].ResultRuns) != 2 {
+		t.Errorf("expected 2 result runs for IF field, got %d", len(fields[1].ResultRuns))
+	}
+}
+
+func TestFieldSpan_SetResultAndFlags(t *testing.T) {
+	root, err := ParseXml([]byte(fieldBodyXml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := ScanFields(root)
+
+	fields[0].SetResult("2025")
+	fields[1].SetResult("Mrs")
+	fields[1].SetLocked(true)
+	fields[0].SetDirty(true)
+
+	again := ScanFields(root)
+	if len(again) != 3 {
+		t.Fatalf("expected 3 fields after update, got %d", len(again))
+	}
+	if got := again[0].Result(); got != "2025" {
+		t.Errorf("simple Result() = %q, want 2025", got)
+	}
+	if got := again[1].Result(); got != "Mrs" {
+		t.Errorf("complex Result() = %q, want Mrs", got)
+	}
+	if again[1].ResultRuns[0].SelectElement("w:rPr") == nil {
+		t.Error("new result run should keep the old result formatting")
+	}
+	if !again[1].Locked() || again[0].Locked() {
+		t.Error("only the IF field should be locked")
+	}
+	if !again[0].Dirty() {
+		t.Error("simple field should be dirty")
+	}
+	if got := again[2].Result(); got != "F" {
+		t.Errorf("nested field in instruction should be untouched, got %q", got)
+	}
+}
+
+func TestNewComplexFieldRuns(t *testing.T) {
+	p := OxmlElement("w:p")
+	runs, span := NewComplexFieldRuns("SEQ Figure", "1", nil)
+	for _, r := range runs {
+		p.AddChild(r)
+	}
+	span.SetInstruction(`SEQ Table \* ARABIC`)
+
+	fields := ScanFields(p)
+	if len(fields) != 1 {
+		t.Fatalf("expected 1 field, got %d", len(fields))
+	}
+	if got := fields[0].Instruction(); got != `SEQ Table \* ARABIC` {
+		t.Errorf("Instruction() = %q", got)
+	}
+	if got := fields[0].Result(); got != "1" {
+		t.Errorf("Result() = %q, want 1", got)
+	}
+}
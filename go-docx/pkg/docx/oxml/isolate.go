@@ -0,0 +1,103 @@
+package oxml
+
+import (
+	"github.com/beevik/etree"
+)
+
+// --------------------------------------------------------------------------
+// isolate.go — splitting runs at text offsets
+//
+// Formatting a piece of paragraph text (a search match, a comment anchor)
+// needs runs that hold exactly that text. IsolateRuns splits the runs at
+// the edges of the piece, using the text atoms of replacetext.go to map
+// offsets to elements. Split runs keep a copy of their run properties.
+// --------------------------------------------------------------------------
+
+// InlineText returns the text of paragraph p as IsolateRuns and
+// ReplaceText see it: the text of its runs, including those in hyperlinks
+// and inline content controls, with breaks as "\n" and tabs as "\t".
+func InlineText(p *etree.Element) string {
+	_, text := collectTextAtoms(p)
+	return text
+}
+
+// IsolateRuns splits the runs of paragraph p so that the text between
+// byte offsets start and end of InlineText(p) is held by whole runs, and
+// returns those runs in document order. It returns nil for an empty or
+// out-of-range span.
+func IsolateRuns(p *etree.Element, start, end int) []*etree.Element {
+	if start < 0 || start >= end {
+		return nil
+	}
+	if _, text := collectTextAtoms(p); end > len(text) {
+		return nil
+	}
+	splitRunsAt(p, end)
+	splitRunsAt(p, start)
+
+	atoms, _ := collectTextAtoms(p)
+	var runs []*etree.Element
+	for _, a := range atoms {
+		if a.startPos >= end || a.startPos+len(a.text) <= start {
+			continue
+		}
+		if len(runs) == 0 || runs[len(runs)-1] != a.run {
+			runs = append(runs, a.run)
+		}
+	}
+	return runs
+}
+
+// splitRunsAt splits the run holding offset pos of the text of p, so that
+// pos falls on a run boundary.
+func splitRunsAt(p *etree.Element, pos int) {
+	atoms, _ := collectTextAtoms(p)
+	for _, a := range atoms {
+		if pos <= a.startPos || pos >= a.startPos+len(a.text) {
+			if pos == a.startPos {
+				splitRunBefore(a.run, a.elem)
+				return
+			}
+			continue
+		}
+		// pos falls inside a w:t; fixed atoms hold a single character.
+		tail := OxmlElement("w:t")
+		tail.SetText(a.text[pos-a.startPos:])
+		a.elem.SetText(a.text[:pos-a.startPos])
+		ensurePreserveSpace(a.elem)
+		ensurePreserveSpace(tail)
+		a.run.InsertChildAt(a.elem.Index()+1, tail)
+		splitRunBefore(a.run, tail)
+		return
+	}
+}
+
+// splitRunBefore moves child and the run content after it to a new run
+// inserted after run. Nothing is done when child is the first content of
+// the run.
+func splitRunBefore(run, child *etree.Element) {
+	children := run.ChildElements()
+	idx := -1
+	first := true
+	for i, c := range children {
+		if c == child {
+			idx = i
+			break
+		}
+		if !(c.Space == "w" && c.Tag == "rPr") {
+			first = false
+		}
+	}
+	if idx < 0 || first {
+		return
+	}
+	newRun := OxmlElement("w:r")
+	if rPr := run.SelectElement("w:rPr"); rPr != nil {
+		newRun.AddChild(rPr.Copy())
+	}
+	for _, c := range children[idx:] {
+		run.RemoveChild(c)
+		newRun.AddChild(c)
+	}
+	run.Parent().InsertChildAt(run.Index()+1, newRun)
+}
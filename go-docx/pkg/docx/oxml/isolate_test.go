@@ -0,0 +1,47 @@
+package oxml
+
+import (
+	"testing"
+)
+
+func TestIsolateRuns(t *testing.T) {
+	el, err := ParseXml([]byte(`<w:p xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:r><w:rPr><w:b/></w:rPr><w:t xml:space="preserve">Part AB-</w:t></w:r>` +
+		`<w:hyperlink><w:r><w:t>1234 here</w:t></w:r></w:hyperlink></w:p>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := InlineText(el)
+	if text != "Part AB-1234 here" {
+		t.Fatalf("InlineText = %q", text)
+	}
+	runs := IsolateRuns(el, 5, 12)
+	if len(runs) != 2 {
+		t.Fatalf("got %d runs, want 2", len(runs))
+	}
+	got := ""
+	for _, r := range runs {
+		for _, tx := range r.SelectElements("w:t") {
+			got += tx.Text()
+		}
+	}
+	if got != "AB-1234" {
+		t.Errorf("isolated text = %q, want AB-1234", got)
+	}
+	if runs[0].FindElement("w:rPr/w:b") == nil {
+		t.Error("split run lost its properties")
+	}
+	if runs[1].Parent().Tag != "hyperlink" {
+		t.Error("split run moved out of the hyperlink")
+	}
+	if InlineText(el) != text {
+		t.Errorf("text changed to %q", InlineText(el))
+	}
+	if len(el.FindElements(".//w:r")) != 4 {
+		t.Errorf("got %d runs after splitting, want 4", len(el.FindElements(".//w:r")))
+	}
+
+	if IsolateRuns(el, 3, 3) != nil || IsolateRuns(el, 0, 99) != nil {
+		t.Error("expected nil for an empty or out-of-range span")
+	}
+}
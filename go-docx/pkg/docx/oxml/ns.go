@@ -16,12 +16,16 @@ var nsmap = map[string]string{
 	"dcterms":  "http://purl.org/dc/terms/",
 	"dgm":      "http://schemas.openxmlformats.org/drawingml/2006/diagram",
 	"m":        "http://schemas.openxmlformats.org/officeDocument/2006/math",
+	"mc":       "http://schemas.openxmlformats.org/markup-compatibility/2006",
+	"o":        "urn:schemas-microsoft-com:office:office",
 	"pic":      "http://schemas.openxmlformats.org/drawingml/2006/picture",
 	"r":        "http://schemas.openxmlformats.org/officeDocument/2006/relationships",
 	"sl":       "http://schemas.openxmlformats.org/schemaLibrary/2006/main",
+	"v":        "urn:schemas-microsoft-com:vml",
 	"w":        "http://schemas.openxmlformats.org/wordprocessingml/2006/main",
 	"w14":      "http://schemas.microsoft.com/office/word/2010/wordml",
 	"wp":       "http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing",
+	"wps":      "http://schemas.microsoft.com/office/word/2010/wordprocessingShape",
 	"xml":      "http://www.w3.org/XML/1998/namespace",
 	"xsi":      "http://www.w3.org/2001/XMLSchema-instance",
 }
@@ -3,6 +3,9 @@ package oxml
 import (
 	"fmt"
 	"sort"
+	"strconv"
+
+	"github.com/beevik/etree"
 )
 
 // ===========================================================================
@@ -57,6 +60,56 @@ func (n *CT_Numbering) NextNumId() int {
 	return len(numIds) + 1
 }
 
+// AbstractNumElement returns the <w:abstractNum> with the given
+// abstractNumId, or nil if not found.
+func (n *CT_Numbering) AbstractNumElement(abstractNumId int) *etree.Element {
+	want := strconv.Itoa(abstractNumId)
+	for _, el := range n.e.SelectElements("w:abstractNum") {
+		if etreeAttrVal(el, "w", "abstractNumId") == want {
+			return el
+		}
+	}
+	return nil
+}
+
+// ImportNum adds copies of num and of its abstract definition abs, taken
+// from another numbering part, under unused ids and returns the new numId.
+// The w:nsid of the copied definition is dropped so Word does not take it
+// for an existing list of this part.
+func (n *CT_Numbering) ImportNum(num *CT_Num, abs *etree.Element) (int, error) {
+	absID := 0
+	for _, el := range n.e.SelectElements("w:abstractNum") {
+		if v, err := strconv.Atoi(etreeAttrVal(el, "w", "abstractNumId")); err == nil && v >= absID {
+			absID = v + 1
+		}
+	}
+	absCopy := abs.Copy()
+	absCopy.CreateAttr("w:abstractNumId", strconv.Itoa(absID))
+	if nsid := absCopy.SelectElement("w:nsid"); nsid != nil {
+		absCopy.RemoveChild(nsid)
+	}
+	if first := n.e.SelectElement("w:num"); first != nil {
+		insertElementBefore(first, absCopy)
+	} else {
+		n.InsertElementBefore(absCopy, "w:numIdMacAtCleanup")
+	}
+
+	numID := n.NextNumId()
+	numCopy := &CT_Num{Element{e: num.e.Copy()}}
+	if err := numCopy.SetNumId(numID); err != nil {
+		return 0, err
+	}
+	absRef, err := numCopy.AbstractNumId()
+	if err != nil {
+		return 0, err
+	}
+	if err := absRef.SetVal(absID); err != nil {
+		return 0, err
+	}
+	n.insertNum(numCopy)
+	return numID, nil
+}
+
 // ===========================================================================
 // CT_Num — custom methods
 // ===========================================================================
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/internal/xmlintern"
 )
 
 // ParseXml parses XML bytes into an *etree.Element.
@@ -19,6 +20,7 @@ func ParseXml(xmlBytes []byte) (*etree.Element, error) {
 	if root == nil {
 		return nil, fmt.Errorf("oxml.ParseXml: no root element found")
 	}
+	xmlintern.Tree(root)
 	// Detach root from document so it can be used independently
 	return root, nil
 }
@@ -28,18 +30,16 @@ func ParseXml(xmlBytes []byte) (*etree.Element, error) {
 // Output is compact (no insignificant whitespace), matching Python's
 // etree.tostring(elm, encoding="UTF-8", standalone=True).
 func SerializeXml(el *etree.Element) ([]byte, error) {
-	doc := etree.NewDocument()
-	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8" standalone="yes"`)
-	doc.SetRoot(el.Copy())
-
-	// No doc.Indent() — produce compact output without insignificant whitespace,
-	// matching Python's serialize_part_xml behavior.
-	doc.WriteSettings.CanonicalEndTags = true
+	// Write the element in place rather than through a Document, which
+	// would need a deep copy of el to adopt it as root. No indentation —
+	// compact output without insignificant whitespace, matching Python's
+	// serialize_part_xml behavior.
+	settings := etree.NewDocument().WriteSettings
+	settings.CanonicalEndTags = true
 
 	var buf bytes.Buffer
-	if _, err := doc.WriteTo(&buf); err != nil {
-		return nil, fmt.Errorf("oxml.SerializeXml: %w", err)
-	}
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	el.WriteTo(&buf, &settings)
 	return buf.Bytes(), nil
 }
 
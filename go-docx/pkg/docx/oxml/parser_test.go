@@ -207,3 +207,17 @@ func TestSerializeForReading(t *testing.T) {
 		t.Error("SerializeForReading should not contain XML declaration")
 	}
 }
+
+func BenchmarkSerializeXml(b *testing.B) {
+	tbl := NewTbl(50, 8, 9000)
+	for _, tc := range tbl.IterTcs() {
+		tc.RawElement().SelectElement("p").CreateElement("w:r").CreateElement("w:t").SetText("cell text")
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := SerializeXml(tbl.RawElement()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
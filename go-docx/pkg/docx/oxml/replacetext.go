@@ -24,8 +24,9 @@ import (
 // characters to the concatenated paragraph text.
 //
 //   - editable atoms (<w:t>): text can be changed arbitrarily via SetText.
-//   - fixed atoms (<w:br>, <w:cr>, <w:tab>, <w:noBreakHyphen>, <w:ptab>):
-//     produce exactly 1 character; can only be removed entirely.
+//   - fixed atoms (<w:br>, <w:cr>, <w:tab>, <w:noBreakHyphen>,
+//     <w:softHyphen>, <w:ptab>, <w:sym>): produce exactly 1 character; can
+//     only be removed entirely.
 type textAtom struct {
 	elem     *etree.Element // the concrete XML element
 	run      *etree.Element // parent <w:r> element, captured at collection time
@@ -37,38 +38,56 @@ type textAtom struct {
 // collectTextAtoms walks the children of a <w:p> element, building a slice
 // of text atoms and the concatenated full text of the paragraph.
 //
-// Traversal order: direct child <w:r> elements and <w:r> elements inside
-// <w:hyperlink> children, in document order.
+// Traversal order: direct child <w:r> elements, <w:r> elements inside
+// <w:hyperlink> children, runs inside the <w:sdtContent> of inline
+// <w:sdt> children and runs in the read branch of <mc:AlternateContent>
+// (recursively), in document order.
 //
 // Skipped at <w:p> level: <w:pPr>, <w:bookmarkStart>, <w:bookmarkEnd>,
 // <w:commentRangeStart>, <w:commentRangeEnd>, <w:proofErr>, <w:ins>,
-// <w:del>, <w:sdt>, and any other non-run/non-hyperlink children.
+// <w:del>, and any other non-run/non-hyperlink/non-sdt children.
 func collectTextAtoms(pElem *etree.Element) ([]textAtom, string) {
 	var atoms []textAtom
 	pos := 0
+	collectInlineAtoms(pElem, &atoms, &pos)
 
-	for _, child := range pElem.ChildElements() {
+	// Build the concatenated text from atoms.
+	var sb strings.Builder
+	for i := range atoms {
+		sb.WriteString(atoms[i].text)
+	}
+	return atoms, sb.String()
+}
+
+// collectInlineAtoms appends text atoms from the run-level children of
+// parent, which is a <w:p>, the <w:sdtContent> of an inline <w:sdt> or the
+// read branch of an <mc:AlternateContent>.
+func collectInlineAtoms(parent *etree.Element, atoms *[]textAtom, pos *int) {
+	for _, child := range parent.ChildElements() {
+		if IsAlternateContent(child) {
+			if branch := AlternateContentBranch(child); branch != nil {
+				collectInlineAtoms(branch, atoms, pos)
+			}
+			continue
+		}
 		if child.Space != "w" {
 			continue
 		}
 		switch child.Tag {
 		case "r":
-			collectRunAtoms(child, &atoms, &pos)
+			collectRunAtoms(child, atoms, pos)
 		case "hyperlink":
 			for _, grandchild := range child.ChildElements() {
 				if grandchild.Space == "w" && grandchild.Tag == "r" {
-					collectRunAtoms(grandchild, &atoms, &pos)
+					collectRunAtoms(grandchild, atoms, pos)
 				}
 			}
+		case "sdt":
+			if content := child.SelectElement("w:sdtContent"); content != nil {
+				collectInlineAtoms(content, atoms, pos)
+			}
 		}
 	}
-
-	// Build the concatenated text from atoms.
-	var sb strings.Builder
-	for i := range atoms {
-		sb.WriteString(atoms[i].text)
-	}
-	return atoms, sb.String()
 }
 
 // collectRunAtoms appends text atoms from a single <w:r> element.
@@ -78,14 +97,32 @@ func collectTextAtoms(pElem *etree.Element) ([]textAtom, string) {
 //   - <w:br type="">    → fixed, "\n"  (textWrapping or absent type)
 //   - <w:cr>            → fixed, "\n"
 //   - <w:tab>           → fixed, "\t"
-//   - <w:noBreakHyphen> → fixed, "-"
+//   - <w:noBreakHyphen> → fixed, U+2011
+//   - <w:softHyphen>    → fixed, U+00AD
 //   - <w:ptab>          → fixed, "\t"
+//   - <w:sym>           → fixed, its text equivalent (see SymText)
+//   - <w:ruby>          → the atoms of the runs of its base text
 //
 // Skipped: <w:rPr>, <w:drawing>, <w:lastRenderedPageBreak>,
 // <w:commentReference>, <w:footnoteReference>, <w:endnoteReference>,
 // <w:br type="page">, <w:br type="column">, and any other non-text children.
+//
+// Content with an alternate contributes the atoms of its read branch, so a
+// replacement there changes that branch only.
 func collectRunAtoms(rElem *etree.Element, atoms *[]textAtom, pos *int) {
-	for _, child := range rElem.ChildElements() {
+	collectRunContentAtoms(rElem, rElem, atoms, pos)
+}
+
+// collectRunContentAtoms appends text atoms from the children of parent,
+// which is run rElem or an alternate content branch inside it.
+func collectRunContentAtoms(rElem, parent *etree.Element, atoms *[]textAtom, pos *int) {
+	for _, child := range parent.ChildElements() {
+		if IsAlternateContent(child) {
+			if branch := AlternateContentBranch(child); branch != nil {
+				collectRunContentAtoms(rElem, branch, atoms, pos)
+			}
+			continue
+		}
 		if child.Space != "w" {
 			continue
 		}
@@ -135,15 +172,19 @@ func collectRunAtoms(rElem *etree.Element, atoms *[]textAtom, pos *int) {
 			})
 			*pos++
 
-		case "noBreakHyphen":
+		case "noBreakHyphen", "softHyphen":
+			text := NoBreakHyphen
+			if child.Tag == "softHyphen" {
+				text = SoftHyphen
+			}
 			*atoms = append(*atoms, textAtom{
 				elem:     child,
 				run:      rElem,
-				text:     "-",
+				text:     text,
 				startPos: *pos,
 				editable: false,
 			})
-			*pos++
+			*pos += len(text)
 
 		case "ptab":
 			*atoms = append(*atoms, textAtom{
@@ -154,6 +195,74 @@ func collectRunAtoms(rElem *etree.Element, atoms *[]textAtom, pos *int) {
 				editable: false,
 			})
 			*pos++
+
+		case "sym":
+			text := SymText(child)
+			*atoms = append(*atoms, textAtom{
+				elem:     child,
+				run:      rElem,
+				text:     text,
+				startPos: *pos,
+				editable: false,
+			})
+			*pos += len(text)
+
+		case "ruby":
+			for _, r := range RubyBaseRuns(child) {
+				collectRunAtoms(r, atoms, pos)
+			}
+		}
+	}
+}
+
+// replaceInOtherBranches applies the replacement to the branches of the
+// <mc:AlternateContent> elements among the run-level children of parent
+// that collectTextAtoms does not read, so that every rendering of the
+// content shows the same text. Each branch is replaced on its own and its
+// replacements are not counted. run is the enclosing <w:r> when parent is
+// inside one, or nil.
+func replaceInOtherBranches(parent, run *etree.Element, old, new string) {
+	for _, child := range parent.ChildElements() {
+		if IsAlternateContent(child) {
+			read := AlternateContentBranch(child)
+			for _, branch := range child.ChildElements() {
+				if branch.Space != "mc" || branch == read {
+					continue
+				}
+				var atoms []textAtom
+				pos := 0
+				if run != nil {
+					collectRunContentAtoms(run, branch, &atoms, &pos)
+				} else {
+					collectInlineAtoms(branch, &atoms, &pos)
+				}
+				var sb strings.Builder
+				for i := range atoms {
+					sb.WriteString(atoms[i].text)
+				}
+				applyReplacements(atoms, sb.String(), old, new)
+			}
+			if read != nil {
+				replaceInOtherBranches(read, run, old, new)
+			}
+			continue
+		}
+		if child.Space != "w" || run != nil {
+			continue
+		}
+		switch child.Tag {
+		case "r":
+			replaceInOtherBranches(child, child, old, new)
+		case "hyperlink":
+			for _, grandchild := range child.ChildElements() {
+				if grandchild.Space == "w" && grandchild.Tag == "r" {
+					replaceInOtherBranches(grandchild, grandchild, old, new)
+				}
+			}
+		case "sdt":
+			if content := child.SelectElement("w:sdtContent"); content != nil {
+				replaceInOtherBranches(content, nil, old, new)
+			}
 		}
 	}
 }
@@ -181,65 +290,78 @@ func findOccurrences(fullText, old string) []int {
 // valid. Returns the number of replacements performed.
 func applyReplacements(atoms []textAtom, fullText, old, new string) int {
 	matches := findOccurrences(fullText, old)
-	if len(matches) == 0 {
-		return 0
+	for i := len(matches) - 1; i >= 0; i-- {
+		replaceSpan(atoms, matches[i], matches[i]+len(old), new)
 	}
+	return len(matches)
+}
 
-	oldLen := len(old)
+// TextEdit replaces the bytes from Start to End of the inline text of a
+// paragraph, as InlineText returns it, with Text.
+type TextEdit struct {
+	Start, End int
+	Text       string
+}
 
-	// Process right-to-left to keep positions stable.
-	for i := len(matches) - 1; i >= 0; i-- {
-		matchStart := matches[i]
-		matchEnd := matchStart + oldLen
+// ApplyTextEdits applies edits, which must be in order of position and
+// not overlap, to paragraph p. Like ReplaceText it works across run
+// boundaries and keeps the formatting of the runs holding the text.
+func ApplyTextEdits(p *etree.Element, edits []TextEdit) {
+	atoms, _ := collectTextAtoms(p)
+	for i := len(edits) - 1; i >= 0; i-- {
+		replaceSpan(atoms, edits[i].Start, edits[i].End, edits[i].Text)
+	}
+}
 
-		replacementPlaced := false
+// replaceSpan replaces the bytes from matchStart to matchEnd of the text
+// of atoms with new. Spans to the left of it stay valid, so several are
+// replaced right-to-left.
+func replaceSpan(atoms []textAtom, matchStart, matchEnd int, new string) {
+	replacementPlaced := false
 
-		for j := range atoms {
-			atom := &atoms[j]
-			atomEnd := atom.startPos + len(atom.text)
+	for j := range atoms {
+		atom := &atoms[j]
+		atomEnd := atom.startPos + len(atom.text)
 
-			// No intersection with this atom?
-			if atom.startPos >= matchEnd || atomEnd <= matchStart {
-				continue
-			}
+		// No intersection with this atom?
+		if atom.startPos >= matchEnd || atomEnd <= matchStart {
+			continue
+		}
 
-			// Byte range within this atom covered by the match.
-			cutStart := matchStart - atom.startPos
-			if cutStart < 0 {
-				cutStart = 0
-			}
-			cutEnd := matchEnd - atom.startPos
-			if cutEnd > len(atom.text) {
-				cutEnd = len(atom.text)
-			}
+		// Byte range within this atom covered by the match.
+		cutStart := matchStart - atom.startPos
+		if cutStart < 0 {
+			cutStart = 0
+		}
+		cutEnd := matchEnd - atom.startPos
+		if cutEnd > len(atom.text) {
+			cutEnd = len(atom.text)
+		}
 
-			if atom.editable {
-				insert := ""
-				if !replacementPlaced {
-					insert = new
-					replacementPlaced = true
-				}
-				newText := atom.text[:cutStart] + insert + atom.text[cutEnd:]
-				atom.elem.SetText(newText)
-				ensurePreserveSpace(atom.elem)
-				atom.text = newText
-
-			} else {
-				// Fixed atom (1 char, fully covered): remove the element.
-				if parent := atom.elem.Parent(); parent != nil {
-					parent.RemoveChild(atom.elem)
-				}
+		if atom.editable {
+			insert := ""
+			if !replacementPlaced {
+				insert = new
+				replacementPlaced = true
 			}
-		}
+			newText := atom.text[:cutStart] + insert + atom.text[cutEnd:]
+			atom.elem.SetText(newText)
+			ensurePreserveSpace(atom.elem)
+			atom.text = newText
 
-		// Edge case: match consisted entirely of fixed atoms (e.g. "\t\t")
-		// and replacement is non-empty. We need to create a <w:t> to hold it.
-		if !replacementPlaced && new != "" {
-			insertReplacementText(atoms, matches[i], matchEnd, new)
+		} else {
+			// Fixed atom (1 char, fully covered): remove the element.
+			if parent := atom.elem.Parent(); parent != nil {
+				parent.RemoveChild(atom.elem)
+			}
 		}
 	}
 
-	return len(matches)
+	// Edge case: match consisted entirely of fixed atoms (e.g. "\t\t")
+	// and replacement is non-empty. We need to create a <w:t> to hold it.
+	if !replacementPlaced && new != "" {
+		insertReplacementText(atoms, matchStart, matchEnd, new)
+	}
 }
 
 // insertReplacementText handles the rare edge case where a match covers
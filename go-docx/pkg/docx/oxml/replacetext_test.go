@@ -154,6 +154,34 @@ func TestReplaceText_CrossRunHyperlink(t *testing.T) {
 	}
 }
 
+// --- Test: cross-run replacement into an inline content control ---
+
+func TestReplaceText_CrossRunInlineSdt(t *testing.T) {
+	p := buildP(func(p *CT_P) {
+		p.AddR().AddTWithText("Dear ")
+	})
+	sdt := OxmlElement("w:sdt")
+	content := sdt.CreateElement("w:sdtContent")
+	r := &CT_R{Element{e: content.CreateElement("w:r")}}
+	r.AddTWithText("NAME, hi")
+	p.e.AddChild(sdt)
+
+	n := p.ReplaceText("Dear NAME", "Hello Ada")
+	if n != 1 {
+		t.Fatalf("expected 1 replacement, got %d", n)
+	}
+	var sb strings.Builder
+	for _, el := range p.e.FindElements(".//w:t") {
+		sb.WriteString(el.Text())
+	}
+	if got := sb.String(); got != "Hello Ada, hi" {
+		t.Errorf("text = %q, want %q", got, "Hello Ada, hi")
+	}
+	if p.e.FindElement("./w:sdt/w:sdtContent/w:r") == nil {
+		t.Error("sdt content run should still exist")
+	}
+}
+
 // --- Test: replacement including <w:tab> → tab removed ---
 
 func TestReplaceText_IncludesTab(t *testing.T) {
@@ -0,0 +1,238 @@
+package oxml
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// --------------------------------------------------------------------------
+// Compiled content model
+// --------------------------------------------------------------------------
+//
+// The content model is compiled from the YAML schema by codegen: every
+// generated zz_gen_*.go file registers one schemaRule per element type in
+// an init function. Only element types described by the schema are checked;
+// unknown elements and extension namespaces are accepted as-is, so the
+// validator never rejects markup it does not model.
+
+// schemaRule is the compiled content model of one element type.
+type schemaRule struct {
+	tag           string
+	children      []schemaChildRule
+	requiredAttrs []string
+}
+
+// schemaChildRule constrains one child tag of an element.
+type schemaChildRule struct {
+	tag        string
+	min        int      // minimum occurrences
+	max        int      // maximum occurrences; -1 means unbounded
+	group      string   // choice group; at most one member of a group may appear
+	successors []string // tags that must not appear before this child
+}
+
+// models reports whether the rule describes child tag, as a child or as
+// the successor of one.
+func (r *schemaRule) models(tag string) bool {
+	for _, cr := range r.children {
+		if cr.tag == tag || slices.Contains(cr.successors, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// singleton reports whether the rule allows child tag at most once.
+func (r *schemaRule) singleton(tag string) bool {
+	for _, cr := range r.children {
+		if cr.tag == tag {
+			return cr.max == 1
+		}
+	}
+	return false
+}
+
+// schemaRules maps an element tag (e.g. "w:pPr") to its compiled rule.
+var schemaRules = map[string]*schemaRule{}
+
+// registerSchemaRule adds r to the compiled content model. Called from the
+// init functions of generated code.
+func registerSchemaRule(r schemaRule) {
+	schemaRules[r.tag] = &r
+}
+
+// --------------------------------------------------------------------------
+// Validation
+// --------------------------------------------------------------------------
+
+// SchemaViolation describes one structural violation found by
+// [ValidateAgainstSchema].
+type SchemaViolation struct {
+	Part string // partname, e.g. "/word/document.xml"
+	Path string // element location, e.g. "/w:document/w:body/w:p[2]/w:pPr"
+	Msg  string // human-readable description
+}
+
+// Error implements the error interface so a violation can be returned or
+// wrapped directly.
+func (v SchemaViolation) Error() string {
+	if v.Part == "" {
+		return fmt.Sprintf("oxml: %s: %s", v.Path, v.Msg)
+	}
+	return fmt.Sprintf("oxml: %s%s: %s", v.Part, v.Path, v.Msg)
+}
+
+// ValidateAgainstSchema checks the element tree rooted at root against the
+// compiled OOXML content model and returns every violation found, in
+// document order. partName is copied into each violation to locate it
+// within the package; pass "" when validating a detached element.
+//
+// The check covers child ordering, child cardinality, choice-group
+// exclusivity and required attributes for the element types modelled by
+// the schema. It is structural only — attribute value types are not
+// checked. A nil or empty result means no violations were found.
+func ValidateAgainstSchema(partName string, root *etree.Element) []SchemaViolation {
+	if root == nil {
+		return nil
+	}
+	var result []SchemaViolation
+	validateElement(partName, "/"+qualifiedTag(root), root, &result)
+	return result
+}
+
+// validateElement checks el against its rule (if any) and recurses into
+// its children.
+func validateElement(partName, path string, el *etree.Element, result *[]SchemaViolation) {
+	if rule, ok := schemaRules[qualifiedTag(el)]; ok {
+		rule.check(partName, path, el, result)
+	}
+	seen := map[string]int{}
+	for _, child := range el.ChildElements() {
+		tag := qualifiedTag(child)
+		seen[tag]++
+		childPath := path + "/" + tag + "[" + strconv.Itoa(seen[tag]) + "]"
+		validateElement(partName, childPath, child, result)
+	}
+}
+
+// check appends violations of this rule by el to result.
+func (r *schemaRule) check(partName, path string, el *etree.Element, result *[]SchemaViolation) {
+	report := func(format string, args ...any) {
+		*result = append(*result, SchemaViolation{Part: partName, Path: path, Msg: fmt.Sprintf(format, args...)})
+	}
+
+	for _, name := range r.requiredAttrs {
+		if !hasQualifiedAttr(el, name) {
+			report("missing required attribute %s", name)
+		}
+	}
+	if len(r.children) == 0 {
+		return
+	}
+
+	children := el.ChildElements()
+	tags := make([]string, len(children))
+	counts := make(map[string]int, len(children))
+	for i, c := range children {
+		tags[i] = qualifiedTag(c)
+		counts[tags[i]]++
+	}
+
+	groups := map[string][]string{}
+	for _, cr := range r.children {
+		n := counts[cr.tag]
+		if n < cr.min {
+			report("missing required child <%s>", cr.tag)
+		}
+		if cr.max >= 0 && n > cr.max {
+			report("child <%s> occurs %d times, at most %d allowed", cr.tag, n, cr.max)
+		}
+		if cr.group != "" && n > 0 {
+			groups[cr.group] = append(groups[cr.group], cr.tag)
+		}
+	}
+	for _, cr := range r.children {
+		if members := groups[cr.group]; cr.group != "" && len(members) > 1 && members[0] == cr.tag {
+			report("choice group %s has more than one member: %v", cr.group, members)
+		}
+	}
+
+	// Order: no successor of a child may appear before that child.
+	for _, cr := range r.children {
+		if len(cr.successors) == 0 || counts[cr.tag] == 0 {
+			continue
+		}
+		succ := make(map[string]bool, len(cr.successors))
+		for _, s := range cr.successors {
+			succ[s] = true
+		}
+	scan:
+		for i, tag := range tags {
+			if tag != cr.tag {
+				continue
+			}
+			for _, prev := range tags[:i] {
+				if succ[prev] {
+					report("child <%s> must precede <%s>", cr.tag, prev)
+					break scan
+				}
+			}
+		}
+	}
+}
+
+// qualifiedTag returns the tag of el with the prefix nsmap registers for
+// its namespace URI (e.g. "w:p"), whatever prefix the document declares for
+// it. An undeclared prefix is taken to mean the namespace nsmap registers
+// for it. Elements in a namespace nsmap does not know keep their literal
+// prefix when it is not bound, else read as "{uri}tag", so they never match
+// a rule.
+func qualifiedTag(el *etree.Element) string {
+	uri := el.NamespaceURI()
+	if uri == "" {
+		if el.Space == "" {
+			return el.Tag
+		}
+		if uri = nsmap[el.Space]; uri == "" {
+			return el.Space + ":" + el.Tag
+		}
+	}
+	if pfx, ok := pfxmap[uri]; ok {
+		return pfx + ":" + el.Tag
+	}
+	return "{" + uri + "}" + el.Tag
+}
+
+// hasQualifiedAttr reports whether el has attribute name, a prefixed name
+// like "w:val" matched by namespace URI, or an unprefixed one.
+func hasQualifiedAttr(el *etree.Element, name string) bool {
+	pfx, local, ok := strings.Cut(name, ":")
+	if !ok {
+		pfx, local = "", name
+	}
+	uri := nsmap[pfx]
+	for i := range el.Attr {
+		a := &el.Attr[i]
+		if a.Key != local {
+			continue
+		}
+		if pfx == "" {
+			if a.Space == "" {
+				return true
+			}
+			continue
+		}
+		got := a.NamespaceURI()
+		if got == "" {
+			got = nsmap[a.Space]
+		}
+		if got == uri {
+			return true
+		}
+	}
+	return false
+}
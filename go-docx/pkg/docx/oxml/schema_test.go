@@ -0,0 +1,101 @@
+package oxml
+
+import (
+	"strings"
+	"testing"
+)
+
+func mustParseSchemaXml(t *testing.T, xml string) *Element {
+	t.Helper()
+	el, err := ParseXml([]byte(xml))
+	if err != nil {
+		t.Fatalf("ParseXml: %v", err)
+	}
+	return &Element{e: el}
+}
+
+func TestValidateAgainstSchema_ValidParagraph(t *testing.T) {
+	t.Parallel()
+	el := mustParseSchemaXml(t, `<w:p xmlns:w="`+nsmap["w"]+`"><w:pPr><w:jc w:val="center"/></w:pPr><w:r><w:t>x</w:t></w:r></w:p>`)
+	if got := ValidateAgainstSchema("/word/document.xml", el.e); len(got) != 0 {
+		t.Errorf("expected no violations, got %v", got)
+	}
+}
+
+func TestValidateAgainstSchema_ChildOrder(t *testing.T) {
+	t.Parallel()
+	el := mustParseSchemaXml(t, `<w:p xmlns:w="`+nsmap["w"]+`"><w:r/><w:pPr/></w:p>`)
+	got := ValidateAgainstSchema("/word/document.xml", el.e)
+	if len(got) != 1 {
+		t.Fatalf("expected 1 violation, got %v", got)
+	}
+	if got[0].Part != "/word/document.xml" || got[0].Path != "/w:p" {
+		t.Errorf("location = %q%q, want /word/document.xml/w:p", got[0].Part, got[0].Path)
+	}
+	if !strings.Contains(got[0].Msg, "<w:pPr> must precede <w:r>") {
+		t.Errorf("Msg = %q", got[0].Msg)
+	}
+}
+
+func TestValidateAgainstSchema_Cardinality(t *testing.T) {
+	t.Parallel()
+	el := mustParseSchemaXml(t, `<w:p xmlns:w="`+nsmap["w"]+`"><w:pPr/><w:pPr/></w:p>`)
+	got := ValidateAgainstSchema("", el.e)
+	if len(got) != 1 || !strings.Contains(got[0].Msg, "occurs 2 times") {
+		t.Errorf("expected cardinality violation, got %v", got)
+	}
+}
+
+func TestValidateAgainstSchema_RequiredAttrAndNestedPath(t *testing.T) {
+	t.Parallel()
+	el := mustParseSchemaXml(t, `<w:body xmlns:w="`+nsmap["w"]+`"><w:p/><w:p><w:pPr><w:tabs><w:tab w:pos="720"/></w:tabs></w:pPr></w:p></w:body>`)
+	got := ValidateAgainstSchema("/word/document.xml", el.e)
+	if len(got) != 1 {
+		t.Fatalf("expected 1 violation, got %v", got)
+	}
+	want := "/w:body/w:p[2]/w:pPr[1]/w:tabs[1]/w:tab[1]"
+	if got[0].Path != want {
+		t.Errorf("Path = %q, want %q", got[0].Path, want)
+	}
+	if !strings.Contains(got[0].Error(), "missing required attribute w:val") {
+		t.Errorf("Error() = %q", got[0].Error())
+	}
+}
+
+func TestValidateAgainstSchema_UnknownElementsIgnored(t *testing.T) {
+	t.Parallel()
+	el := mustParseSchemaXml(t, `<w:p xmlns:w="`+nsmap["w"]+`" xmlns:x="urn:x"><x:ext/><w:pPr/><w:r/></w:p>`)
+	if got := ValidateAgainstSchema("", el.e); len(got) != 0 {
+		t.Errorf("expected no violations, got %v", got)
+	}
+	if got := ValidateAgainstSchema("", nil); got != nil {
+		t.Errorf("nil root: got %v", got)
+	}
+}
+
+func TestValidateAgainstSchema_MatchesNamespaceNotPrefix(t *testing.T) {
+	t.Parallel()
+	// The main namespace bound to another prefix is still validated.
+	el := mustParseSchemaXml(t, `<x:p xmlns:x="`+nsmap["w"]+`"><x:r/><x:pPr/></x:p>`)
+	got := ValidateAgainstSchema("", el.e)
+	if len(got) != 1 || got[0].Path != "/w:p" || !strings.Contains(got[0].Msg, "<w:pPr> must precede <w:r>") {
+		t.Errorf("other prefix: got %v", got)
+	}
+	// So is the main namespace as the default namespace, where unprefixed
+	// attributes are in no namespace.
+	el = mustParseSchemaXml(t, `<p xmlns="`+nsmap["w"]+`"><pPr><tabs><tab val="left" pos="720"/></tabs></pPr></p>`)
+	got = ValidateAgainstSchema("", el.e)
+	if len(got) != 2 || got[0].Path != "/w:p/w:pPr[1]/w:tabs[1]/w:tab[1]" || !strings.Contains(got[0].Msg, "missing required attribute w:val") {
+		t.Errorf("default namespace: got %v", got)
+	}
+	// Attributes are matched by namespace too.
+	el = mustParseSchemaXml(t, `<x:tabs xmlns:x="`+nsmap["w"]+`"><x:tab x:val="left" x:pos="720"/></x:tabs>`)
+	if got := ValidateAgainstSchema("", el.e); len(got) != 0 {
+		t.Errorf("prefixed attribute: got %v", got)
+	}
+	// The w prefix bound to another namespace is not.
+	el = mustParseSchemaXml(t, `<w:p xmlns:w="urn:other"><w:r/><w:pPr/></w:p>`)
+	if got := ValidateAgainstSchema("", el.e); len(got) != 0 {
+		t.Errorf("foreign namespace: got %v", got)
+	}
+}
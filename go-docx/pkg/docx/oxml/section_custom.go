@@ -172,6 +172,111 @@ func (sp *CT_SectPr) SetTitlePgVal(v bool) error {
 	return nil
 }
 
+// RtlGutterVal returns true if the gutter is on the right of the pages.
+func (sp *CT_SectPr) RtlGutterVal() bool {
+	rg := sp.RtlGutter()
+	if rg == nil {
+		return false
+	}
+	return rg.Val()
+}
+
+// SetRtlGutterVal sets the rtlGutter flag. Passing false removes the
+// element.
+func (sp *CT_SectPr) SetRtlGutterVal(v bool) error {
+	if !v {
+		sp.RemoveRtlGutter()
+		return nil
+	}
+	return sp.GetOrAddRtlGutter().SetVal(true)
+}
+
+// --- Text direction ---
+
+// TextDirectionVal returns the direction text flows in the section, or nil
+// if not set.
+func (sp *CT_SectPr) TextDirectionVal() (*enum.WdTextOrientation, error) {
+	return textDirectionVal(sp.e)
+}
+
+// SetTextDirectionVal sets the text direction. Passing nil removes it.
+func (sp *CT_SectPr) SetTextDirectionVal(v *enum.WdTextOrientation) error {
+	return setTextDirectionVal(&sp.Element, v, "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange")
+}
+
+// VAlignVal returns the vertical alignment of the text on the pages, or
+// nil if not set.
+func (sp *CT_SectPr) VAlignVal() (*enum.WdVerticalAlignment, error) {
+	el := sp.e.SelectElement("w:vAlign")
+	if el == nil {
+		return nil, nil
+	}
+	v, err := enum.WdVerticalAlignmentFromXml(el.SelectAttrValue("w:val", ""))
+	if err != nil {
+		return nil, fmt.Errorf("vAlign: %w", err)
+	}
+	return &v, nil
+}
+
+// SetVAlignVal sets the vertical alignment of the text on the pages.
+// Passing nil removes it.
+func (sp *CT_SectPr) SetVAlignVal(v *enum.WdVerticalAlignment) error {
+	el := sp.e.SelectElement("w:vAlign")
+	if v == nil {
+		if el != nil {
+			sp.e.RemoveChild(el)
+		}
+		return nil
+	}
+	xml, err := v.ToXml()
+	if err != nil {
+		return err
+	}
+	if el == nil {
+		el = OxmlElement("w:vAlign")
+		sp.InsertElementBefore(el, "w:noEndnote", "w:titlePg", "w:textDirection", "w:bidi",
+			"w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange")
+	}
+	el.CreateAttr("w:val", xml)
+	return nil
+}
+
+// textDirectionVal returns the w:textDirection value of the properties pr,
+// or nil if it has none.
+func textDirectionVal(pr *etree.Element) (*enum.WdTextOrientation, error) {
+	el := pr.SelectElement("w:textDirection")
+	if el == nil {
+		return nil, nil
+	}
+	v, err := enum.WdTextOrientationFromXml(el.SelectAttrValue("w:val", ""))
+	if err != nil {
+		return nil, fmt.Errorf("textDirection: %w", err)
+	}
+	return &v, nil
+}
+
+// setTextDirectionVal sets the w:textDirection of the properties pr,
+// inserting it before successors, or removes it for nil.
+func setTextDirectionVal(pr *Element, v *enum.WdTextOrientation, successors ...string) error {
+	el := pr.e.SelectElement("w:textDirection")
+	if v == nil {
+		if el != nil {
+			pr.e.RemoveChild(el)
+		}
+		return nil
+	}
+	xml, err := v.ToXml()
+	if err != nil {
+		return err
+	}
+	if el == nil {
+		el = OxmlElement("w:textDirection")
+		pr.InsertElementBefore(el, successors...)
+	}
+	el.CreateAttr("w:val", xml)
+	return nil
+}
+
 // --- Margins ---
 
 // TopMargin returns the top margin in twips, or nil if not present.
@@ -23,3 +23,189 @@ func (s *CT_Settings) SetEvenAndOddHeadersVal(v *bool) error {
 	}
 	return s.GetOrAddEvenAndOddHeaders().SetVal(true)
 }
+
+// AutoHyphenationVal returns the value of w:autoHyphenation/@w:val, or
+// false if the element is not present.
+func (s *CT_Settings) AutoHyphenationVal() bool {
+	ah := s.AutoHyphenation()
+	if ah == nil {
+		return false
+	}
+	return ah.Val()
+}
+
+// SetAutoHyphenationVal sets the autoHyphenation flag. Passing false
+// removes the element.
+func (s *CT_Settings) SetAutoHyphenationVal(v bool) error {
+	if !v {
+		s.RemoveAutoHyphenation()
+		return nil
+	}
+	return s.GetOrAddAutoHyphenation().SetVal(true)
+}
+
+// ConsecutiveHyphenLimitVal returns the value of
+// w:consecutiveHyphenLimit/@w:val, or 0 (no limit) if the element is not
+// present.
+func (s *CT_Settings) ConsecutiveHyphenLimitVal() (int, error) {
+	chl := s.ConsecutiveHyphenLimit()
+	if chl == nil {
+		return 0, nil
+	}
+	return chl.Val()
+}
+
+// SetConsecutiveHyphenLimitVal sets the consecutive hyphen limit. Passing
+// 0 removes the element.
+func (s *CT_Settings) SetConsecutiveHyphenLimitVal(v int) error {
+	if v == 0 {
+		s.RemoveConsecutiveHyphenLimit()
+		return nil
+	}
+	return s.GetOrAddConsecutiveHyphenLimit().SetVal(v)
+}
+
+// HyphenationZoneVal returns the value of w:hyphenationZone/@w:val in
+// twips, or nil if the element is not present.
+func (s *CT_Settings) HyphenationZoneVal() (*int, error) {
+	hz := s.HyphenationZone()
+	if hz == nil {
+		return nil, nil
+	}
+	v, err := hz.Val()
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// SetHyphenationZoneVal sets the hyphenation zone in twips. Passing nil
+// removes the element.
+func (s *CT_Settings) SetHyphenationZoneVal(v *int) error {
+	if v == nil {
+		s.RemoveHyphenationZone()
+		return nil
+	}
+	return s.GetOrAddHyphenationZone().SetVal(*v)
+}
+
+// DoNotHyphenateCapsVal returns the value of w:doNotHyphenateCaps/@w:val,
+// or false if the element is not present.
+func (s *CT_Settings) DoNotHyphenateCapsVal() bool {
+	dnhc := s.DoNotHyphenateCaps()
+	if dnhc == nil {
+		return false
+	}
+	return dnhc.Val()
+}
+
+// SetDoNotHyphenateCapsVal sets the doNotHyphenateCaps flag. Passing false
+// removes the element.
+func (s *CT_Settings) SetDoNotHyphenateCapsVal(v bool) error {
+	if !v {
+		s.RemoveDoNotHyphenateCaps()
+		return nil
+	}
+	return s.GetOrAddDoNotHyphenateCaps().SetVal(true)
+}
+
+// MirrorMarginsVal returns the value of w:mirrorMargins/@w:val, or false
+// if the element is not present.
+func (s *CT_Settings) MirrorMarginsVal() bool {
+	mm := s.MirrorMargins()
+	if mm == nil {
+		return false
+	}
+	return mm.Val()
+}
+
+// SetMirrorMarginsVal sets the mirrorMargins flag. Passing false removes
+// the element.
+func (s *CT_Settings) SetMirrorMarginsVal(v bool) error {
+	if !v {
+		s.RemoveMirrorMargins()
+		return nil
+	}
+	return s.GetOrAddMirrorMargins().SetVal(true)
+}
+
+// GutterAtTopVal returns the value of w:gutterAtTop/@w:val, or false if
+// the element is not present.
+func (s *CT_Settings) GutterAtTopVal() bool {
+	gt := s.GutterAtTop()
+	if gt == nil {
+		return false
+	}
+	return gt.Val()
+}
+
+// SetGutterAtTopVal sets the gutterAtTop flag. Passing false removes the
+// element.
+func (s *CT_Settings) SetGutterAtTopVal(v bool) error {
+	if !v {
+		s.RemoveGutterAtTop()
+		return nil
+	}
+	return s.GetOrAddGutterAtTop().SetVal(true)
+}
+
+// BookFoldPrintingVal returns the value of w:bookFoldPrinting/@w:val, or
+// false if the element is not present.
+func (s *CT_Settings) BookFoldPrintingVal() bool {
+	bf := s.BookFoldPrinting()
+	if bf == nil {
+		return false
+	}
+	return bf.Val()
+}
+
+// SetBookFoldPrintingVal sets the bookFoldPrinting flag. Passing false
+// removes the element.
+func (s *CT_Settings) SetBookFoldPrintingVal(v bool) error {
+	if !v {
+		s.RemoveBookFoldPrinting()
+		return nil
+	}
+	return s.GetOrAddBookFoldPrinting().SetVal(true)
+}
+
+// BookFoldRevPrintingVal returns the value of
+// w:bookFoldRevPrinting/@w:val, or false if the element is not present.
+func (s *CT_Settings) BookFoldRevPrintingVal() bool {
+	bf := s.BookFoldRevPrinting()
+	if bf == nil {
+		return false
+	}
+	return bf.Val()
+}
+
+// SetBookFoldRevPrintingVal sets the bookFoldRevPrinting flag. Passing
+// false removes the element.
+func (s *CT_Settings) SetBookFoldRevPrintingVal(v bool) error {
+	if !v {
+		s.RemoveBookFoldRevPrinting()
+		return nil
+	}
+	return s.GetOrAddBookFoldRevPrinting().SetVal(true)
+}
+
+// BookFoldPrintingSheetsVal returns the value of
+// w:bookFoldPrintingSheets/@w:val, or 0 (all pages in one booklet) if the
+// element is not present.
+func (s *CT_Settings) BookFoldPrintingSheetsVal() (int, error) {
+	bs := s.BookFoldPrintingSheets()
+	if bs == nil {
+		return 0, nil
+	}
+	return bs.Val()
+}
+
+// SetBookFoldPrintingSheetsVal sets the number of pages per booklet.
+// Passing 0 removes the element.
+func (s *CT_Settings) SetBookFoldPrintingSheetsVal(v int) error {
+	if v == 0 {
+		s.RemoveBookFoldPrintingSheets()
+		return nil
+	}
+	return s.GetOrAddBookFoldPrintingSheets().SetVal(v)
+}
@@ -43,3 +43,66 @@ func TestCT_Settings_EvenAndOddHeadersVal(t *testing.T) {
 		t.Error("expected false after setting nil")
 	}
 }
+
+func TestCT_Settings_HyphenationVals(t *testing.T) {
+	xml := `<w:settings xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:evenAndOddHeaders/></w:settings>`
+	el, _ := ParseXml([]byte(xml))
+	s := &CT_Settings{Element{e: el}}
+
+	if s.AutoHyphenationVal() || s.DoNotHyphenateCapsVal() {
+		t.Error("expected hyphenation flags false by default")
+	}
+	if n, err := s.ConsecutiveHyphenLimitVal(); err != nil || n != 0 {
+		t.Errorf("ConsecutiveHyphenLimitVal() = %d, %v; want 0, nil", n, err)
+	}
+	if z, err := s.HyphenationZoneVal(); err != nil || z != nil {
+		t.Errorf("HyphenationZoneVal() = %v, %v; want nil, nil", z, err)
+	}
+
+	zone := 283
+	if err := s.SetDoNotHyphenateCapsVal(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetHyphenationZoneVal(&zone); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetConsecutiveHyphenLimitVal(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetAutoHyphenationVal(true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Schema order: autoHyphenation, consecutiveHyphenLimit, hyphenationZone,
+	// doNotHyphenateCaps, evenAndOddHeaders.
+	var tags []string
+	for _, c := range s.e.ChildElements() {
+		tags = append(tags, c.Tag)
+	}
+	want := []string{"autoHyphenation", "consecutiveHyphenLimit", "hyphenationZone", "doNotHyphenateCaps", "evenAndOddHeaders"}
+	if len(tags) != len(want) {
+		t.Fatalf("children = %v, want %v", tags, want)
+	}
+	for i := range want {
+		if tags[i] != want[i] {
+			t.Fatalf("children = %v, want %v", tags, want)
+		}
+	}
+
+	if n, _ := s.ConsecutiveHyphenLimitVal(); n != 2 {
+		t.Errorf("ConsecutiveHyphenLimitVal() = %d, want 2", n)
+	}
+	if z, _ := s.HyphenationZoneVal(); z == nil || *z != 283 {
+		t.Errorf("HyphenationZoneVal() = %v, want 283", z)
+	}
+
+	if err := s.SetAutoHyphenationVal(false); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetConsecutiveHyphenLimitVal(0); err != nil {
+		t.Fatal(err)
+	}
+	if s.AutoHyphenation() != nil || s.ConsecutiveHyphenLimit() != nil {
+		t.Error("expected elements removed when cleared")
+	}
+}
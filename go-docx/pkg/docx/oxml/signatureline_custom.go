@@ -0,0 +1,105 @@
+package oxml
+
+import (
+	"fmt"
+
+	"github.com/beevik/etree"
+)
+
+// SignatureLineSpec describes an Office signature line built by
+// NewSignatureLinePict.
+type SignatureLineSpec struct {
+	ShapeID  int    // number of the VML shape, unique in the story
+	GUID     string // braced GUID identifying the signature line
+	ImageRId string // relationship id of the picture showing the line
+	// Width and Height are the size of the shape in points.
+	Width, Height float64
+
+	Signer, SignerTitle, SignerEmail string
+	Instructions                     string // shown to the signer; "" for none
+	AllowComments                    bool
+	HideSignDate                     bool
+
+	// OmitShapetype leaves out the <v:shapetype> the shape refers to, for
+	// a part that already defines it, see HasPictureShapetype. Word
+	// defines it once per part.
+	OmitShapetype bool
+}
+
+// pictureShapetypeID is the id of the VML shape type of pictures, which
+// the signature line shape refers to.
+const pictureShapetypeID = "_x0000_t75"
+
+// HasPictureShapetype reports whether the tree under root defines the VML
+// shape type of pictures, <v:shapetype id="_x0000_t75">.
+func HasPictureShapetype(root *etree.Element) bool {
+	return root != nil && root.FindElement(".//v:shapetype[@id='"+pictureShapetypeID+"']") != nil
+}
+
+// NewSignatureLinePict returns a <w:pict> holding the VML shape Word uses
+// for a signature line: a picture carrying <o:signatureline> with the
+// suggested signer. The <w:pict> also defines the VML shape type the
+// shape refers to unless spec.OmitShapetype is set.
+func NewSignatureLinePict(spec SignatureLineSpec) (*etree.Element, error) {
+	if spec.Width <= 0 || spec.Height <= 0 {
+		return nil, fmt.Errorf("oxml: signature line size must be positive, got %gx%g", spec.Width, spec.Height)
+	}
+	xml := fmt.Sprintf(
+		`<w:pict `+
+			`xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" `+
+			`xmlns:v="urn:schemas-microsoft-com:vml" `+
+			`xmlns:o="urn:schemas-microsoft-com:office:office" `+
+			`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">`+
+			`<v:shapetype id="_x0000_t75" coordsize="21600,21600" o:spt="75" o:preferrelative="t" `+
+			`path="m@4@5l@4@11@9@11@9@5xe" filled="f" stroked="f">`+
+			`<v:stroke joinstyle="miter"/>`+
+			`<v:formulas>`+
+			`<v:f eqn="if lineDrawn pixelLineWidth 0"/>`+
+			`<v:f eqn="sum @0 1 0"/>`+
+			`<v:f eqn="sum 0 0 @1"/>`+
+			`<v:f eqn="prod @2 1 2"/>`+
+			`<v:f eqn="prod @3 21600 pixelWidth"/>`+
+			`<v:f eqn="prod @3 21600 pixelHeight"/>`+
+			`<v:f eqn="sum @0 0 1"/>`+
+			`<v:f eqn="prod @6 1 2"/>`+
+			`<v:f eqn="prod @7 21600 pixelWidth"/>`+
+			`<v:f eqn="sum @8 21600 0"/>`+
+			`<v:f eqn="prod @7 21600 pixelHeight"/>`+
+			`<v:f eqn="sum @10 21600 0"/>`+
+			`</v:formulas>`+
+			`<v:path o:extrusionok="f" gradientshapeok="t" o:connecttype="rect"/>`+
+			`<o:lock v:ext="edit" aspectratio="t"/>`+
+			`</v:shapetype>`+
+			`<v:shape id="_x0000_i%d" type="#_x0000_t75" alt="Microsoft Office Signature Line..." style="width:%gpt;height:%gpt">`+
+			`<v:imagedata r:id="%s" o:title=""/>`+
+			`<o:lock v:ext="edit" ungrouping="t" rotation="t" cropping="t" verticies="t" text="t" grouping="t"/>`+
+			`<o:signatureline v:ext="edit" provid="{00000000-0000-0000-0000-000000000000}" issignatureline="t"/>`+
+			`</v:shape>`+
+			`</w:pict>`,
+		1024+spec.ShapeID, spec.Width, spec.Height, spec.ImageRId,
+	)
+	pict, err := ParseXml([]byte(xml))
+	if err != nil {
+		return nil, fmt.Errorf("oxml: failed to parse signature line XML: %w", err)
+	}
+	if spec.OmitShapetype {
+		pict.RemoveChild(pict.SelectElement("v:shapetype"))
+	}
+	// The signer details are set as attributes so they are escaped.
+	sl := pict.FindElement("v:shape/o:signatureline")
+	sl.CreateAttr("id", spec.GUID)
+	sl.CreateAttr("o:suggestedsigner", spec.Signer)
+	sl.CreateAttr("o:suggestedsigner2", spec.SignerTitle)
+	sl.CreateAttr("o:suggestedsigneremail", spec.SignerEmail)
+	if spec.Instructions != "" {
+		sl.CreateAttr("o:signinginstructionsset", "t")
+		sl.CreateAttr("o:signinginstructions", spec.Instructions)
+	}
+	if spec.AllowComments {
+		sl.CreateAttr("allowcomments", "t")
+	}
+	if spec.HideSignDate {
+		sl.CreateAttr("showsigndate", "f")
+	}
+	return pict, nil
+}
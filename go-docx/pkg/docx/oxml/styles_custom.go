@@ -2,6 +2,8 @@ package oxml
 
 import (
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/vortex/go-docx/pkg/docx/enum"
@@ -59,6 +61,64 @@ func (ss *CT_Styles) GetByName(name string) *CT_Style {
 	return nil
 }
 
+// maxStyleSuggestions bounds the names SimilarStyleNames returns.
+const maxStyleSuggestions = 3
+
+// SimilarStyleNames returns the UI names of the styles whose name or style
+// ID is within a small edit distance of name, ignoring case, closest first.
+// It suggests what a misspelled style name was meant to be.
+func (ss *CT_Styles) SimilarStyleNames(name string) []string {
+	want := []rune(strings.ToLower(name))
+	limit := len(want)/3 + 1
+	type match struct {
+		name string
+		dist int
+	}
+	var matches []match
+	for _, s := range ss.StyleList() {
+		ui := s.StyleId()
+		dist := editDistance(want, []rune(strings.ToLower(ui)))
+		if n, err := s.NameVal(); err == nil && n != "" {
+			ui = Internal2UI(n)
+			dist = min(dist, editDistance(want, []rune(strings.ToLower(ui))))
+		}
+		if dist <= limit && ui != "" {
+			matches = append(matches, match{ui, dist})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].dist < matches[j].dist })
+	var result []string
+	for _, m := range matches {
+		if len(result) == maxStyleSuggestions {
+			break
+		}
+		if !slices.Contains(result, m.name) {
+			result = append(result, m.name)
+		}
+	}
+	return result
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b []rune) int {
+	row := make([]int, len(b)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		diag := row[0]
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			diag, row[j] = row[j], min(row[j]+1, row[j-1]+1, diag+cost)
+		}
+	}
+	return row[len(b)]
+}
+
 // DefaultFor returns the default style for the given type, or nil.
 // If multiple defaults exist, returns the last one (per OOXML spec).
 func (ss *CT_Styles) DefaultFor(styleType enum.WdStyleType) (*CT_Style, error) {
@@ -167,6 +227,15 @@ func (s *CT_Style) SetNextVal(v string) error {
 	return nil
 }
 
+// LinkVal returns the style ID in w:link, naming the paragraph style a
+// character style is linked to or the other way round, or "" if absent.
+func (s *CT_Style) LinkVal() string {
+	if l := s.e.SelectElement("w:link"); l != nil {
+		return l.SelectAttrValue("w:val", "")
+	}
+	return ""
+}
+
 // LockedVal returns the value of w:locked, or false if not present.
 func (s *CT_Style) LockedVal() bool {
 	l := s.Locked()
@@ -283,6 +352,17 @@ func (s *CT_Style) BaseStyle() *CT_Style {
 	return styles.GetByID(basedOn)
 }
 
+// LinkedStyle returns the sibling CT_Style identified by w:link, or nil.
+func (s *CT_Style) LinkedStyle() *CT_Style {
+	link := s.LinkVal()
+	parent := s.e.Parent()
+	if link == "" || parent == nil {
+		return nil
+	}
+	styles := &CT_Styles{Element{e: parent}}
+	return styles.GetByID(link)
+}
+
 // NextStyle returns the sibling CT_Style identified by w:next, or nil.
 func (s *CT_Style) NextStyle() *CT_Style {
 	nextVal, err := s.NextVal()
@@ -338,16 +418,21 @@ func (ss *CT_Styles) GetStyleIDByName(uiName string, styleType enum.WdStyleType)
 
 	// 4. Not found → error (Python: raise KeyError)
 	if s == nil {
-		return nil, fmt.Errorf("oxml: no style with name %q", uiName)
+		return nil, &StyleNotFoundError{Name: uiName, Suggestions: ss.SimilarStyleNames(uiName)}
 	}
 
-	// 5. Type check (Python: _get_style_id_from_style raises ValueError)
+	// 5. Type check (Python: _get_style_id_from_style raises ValueError),
+	// taking the linked style of the other type as Word does
 	xmlType, err := styleType.ToXml()
 	if err != nil {
 		return nil, fmt.Errorf("oxml: invalid style type: %w", err)
 	}
 	if s.Type() != xmlType {
-		return nil, fmt.Errorf("oxml: style %q is type %q, need %q", uiName, s.Type(), xmlType)
+		linked := s.LinkedStyle()
+		if linked == nil || linked.Type() != xmlType {
+			return nil, fmt.Errorf("%w: %q is type %q, need %q", ErrWrongStyleType, uiName, s.Type(), xmlType)
+		}
+		s = linked
 	}
 
 	// 6. Default check → return nil (Python: if style == self.default(style_type): return None)
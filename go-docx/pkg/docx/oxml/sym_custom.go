@@ -0,0 +1,75 @@
+package oxml
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// --------------------------------------------------------------------------
+// sym_custom.go — symbol characters (<w:sym>)
+//
+// A <w:sym> draws one character of a font, given by its code in that
+// font rather than as text: Word's Insert > Symbol writes them, and
+// legacy forms use them for check boxes. Symbol fonts put their glyphs at
+// codes U+F020-U+F0FF, which stand for the font's 8-bit codes 0x20-0xFF.
+// --------------------------------------------------------------------------
+
+// symbolFontChars maps lowercase symbol font names to the Unicode text of
+// their 8-bit character codes.
+var symbolFontChars = map[string]map[byte]string{
+	"symbol": {
+		0x22: "∀", 0x24: "∃", 0x27: "∋", 0x2A: "∗", 0x2D: "−", 0x40: "≅", 0x5C: "∴", 0x5E: "⊥",
+		0x41: "Α", 0x42: "Β", 0x43: "Χ", 0x44: "Δ", 0x45: "Ε", 0x46: "Φ", 0x47: "Γ", 0x48: "Η",
+		0x49: "Ι", 0x4A: "ϑ", 0x4B: "Κ", 0x4C: "Λ", 0x4D: "Μ", 0x4E: "Ν", 0x4F: "Ο", 0x50: "Π",
+		0x51: "Θ", 0x52: "Ρ", 0x53: "Σ", 0x54: "Τ", 0x55: "Υ", 0x56: "ς", 0x57: "Ω", 0x58: "Ξ",
+		0x59: "Ψ", 0x5A: "Ζ",
+		0x61: "α", 0x62: "β", 0x63: "χ", 0x64: "δ", 0x65: "ε", 0x66: "φ", 0x67: "γ", 0x68: "η",
+		0x69: "ι", 0x6A: "ϕ", 0x6B: "κ", 0x6C: "λ", 0x6D: "μ", 0x6E: "ν", 0x6F: "ο", 0x70: "π",
+		0x71: "θ", 0x72: "ρ", 0x73: "σ", 0x74: "τ", 0x75: "υ", 0x76: "ϖ", 0x77: "ω", 0x78: "ξ",
+		0x79: "ψ", 0x7A: "ζ",
+		0xA0: "€", 0xA2: "′", 0xA3: "≤", 0xA5: "∞", 0xAC: "←", 0xAD: "↑", 0xAE: "→", 0xAF: "↓",
+		0xB0: "°", 0xB1: "±", 0xB2: "″", 0xB3: "≥", 0xB4: "×", 0xB6: "∂", 0xB7: "•", 0xB8: "÷",
+		0xB9: "≠", 0xBB: "≈", 0xC5: "⊕", 0xC6: "∅", 0xC7: "∩", 0xC8: "∪", 0xCE: "∈", 0xCF: "∉",
+		0xD2: "®", 0xD3: "©", 0xD4: "™", 0xD5: "∏", 0xD6: "√", 0xDB: "⇔", 0xDE: "⇒", 0xE5: "∑",
+		0xF2: "∫",
+	},
+	"wingdings": {
+		0x4A: "☺", 0x4C: "☹", 0x6C: "●", 0x6E: "■", 0x6F: "☐", 0xA7: "▪", 0xA8: "☐", 0xD8: "➢",
+		0xFB: "✗", 0xFC: "✓", 0xFD: "☒", 0xFE: "☑",
+	},
+	"wingdings 2": {
+		0x4F: "✗", 0x50: "✓", 0x52: "☑", 0x54: "☒", 0xA3: "☐",
+	},
+}
+
+// SymbolText returns the text equivalent of character code char, in
+// hexadecimal as in w:sym's w:char, of font: the Unicode character for
+// the glyph of a known symbol font, U+FFFD for other glyphs of those
+// fonts, and the character itself for other fonts.
+func SymbolText(font, char string) string {
+	code, err := strconv.ParseUint(char, 16, 32)
+	if err != nil {
+		return "�"
+	}
+	if code >= 0xF000 && code <= 0xF0FF {
+		code -= 0xF000
+	}
+	if chars, ok := symbolFontChars[strings.ToLower(font)]; ok {
+		if text, ok := chars[byte(code)]; ok && code <= 0xFF {
+			return text
+		}
+		if code >= 0x20 && code < 0x7F && strings.EqualFold(font, "Symbol") {
+			return string(rune(code)) // digits and punctuation
+		}
+		return "�"
+	}
+	return string(rune(code))
+}
+
+// SymText returns the text equivalent of symbol character sym (<w:sym>),
+// see SymbolText.
+func SymText(sym *etree.Element) string {
+	return SymbolText(etreeAttrVal(sym, "w", "font"), etreeAttrVal(sym, "w", "char"))
+}
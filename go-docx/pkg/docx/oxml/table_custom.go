@@ -211,9 +211,22 @@ func (t *CT_Tbl) ColCount() (int, error) {
 
 // IterTcs generates each w:tc element in this table, left to right, top to bottom.
 func (t *CT_Tbl) IterTcs() []*CT_Tc {
-	var result []*CT_Tc
-	for _, tr := range t.TrList() {
-		result = append(result, tr.TcList()...)
+	var tcs []*etree.Element
+	for _, tok := range t.e.Child {
+		if tr, ok := tok.(*etree.Element); ok && tr.Space == "w" && tr.Tag == "tr" {
+			for _, tok := range tr.Child {
+				if tc, ok := tok.(*etree.Element); ok && tc.Space == "w" && tc.Tag == "tc" {
+					tcs = append(tcs, tc)
+				}
+			}
+		}
+	}
+	// One backing array for the wrappers instead of an allocation each.
+	wrappers := make([]CT_Tc, len(tcs))
+	result := make([]*CT_Tc, len(tcs))
+	for i, tc := range tcs {
+		wrappers[i].e = tc
+		result[i] = &wrappers[i]
 	}
 	return result
 }
@@ -436,6 +449,71 @@ func (r *CT_Row) SetTrHeightHRule(rule *enum.WdRowHeightRule) error {
 	return h.SetHRule(*rule)
 }
 
+// TblHeaderVal returns true if the row repeats as a header row at the top
+// of each page the table spans.
+func (r *CT_Row) TblHeaderVal() bool {
+	return r.trPrOnOff("w:tblHeader")
+}
+
+// SetTblHeaderVal sets the repeat-as-header flag. False removes it.
+func (r *CT_Row) SetTblHeaderVal(v bool) {
+	r.setTrPrOnOff("w:tblHeader", v)
+}
+
+// CantSplitVal returns true if the row is kept from breaking across pages.
+func (r *CT_Row) CantSplitVal() bool {
+	return r.trPrOnOff("w:cantSplit")
+}
+
+// SetCantSplitVal sets the can't-split flag. False removes it.
+func (r *CT_Row) SetCantSplitVal(v bool) {
+	r.setTrPrOnOff("w:cantSplit", v)
+}
+
+// trPrOrder is the order Word writes the children of w:trPr in.
+var trPrOrder = []string{
+	"w:cnfStyle", "w:divId", "w:gridBefore", "w:gridAfter", "w:wBefore", "w:wAfter",
+	"w:cantSplit", "w:trHeight", "w:tblHeader", "w:tblCellSpacing", "w:jc", "w:hidden",
+	"w:ins", "w:del", "w:trPrChange",
+}
+
+// trPrOnOff returns the value of the on/off property tag of the row.
+func (r *CT_Row) trPrOnOff(tag string) bool {
+	trPr := r.TrPr()
+	if trPr == nil {
+		return false
+	}
+	el := trPr.e.SelectElement(tag)
+	if el == nil {
+		return false
+	}
+	v, ok := (&CT_OnOff{Element{e: el}}).GetAttr("w:val")
+	return !ok || parseBoolAttr(v)
+}
+
+// setTrPrOnOff adds the on/off property tag to the row, or removes it.
+func (r *CT_Row) setTrPrOnOff(tag string, v bool) {
+	if !v {
+		if trPr := r.TrPr(); trPr != nil {
+			if el := trPr.e.SelectElement(tag); el != nil {
+				trPr.e.RemoveChild(el)
+			}
+		}
+		return
+	}
+	trPr := r.GetOrAddTrPr()
+	if el := trPr.e.SelectElement(tag); el != nil {
+		el.RemoveAttr("w:val")
+		return
+	}
+	for i, t := range trPrOrder {
+		if t == tag {
+			trPr.InsertElementBefore(OxmlElement(tag), trPrOrder[i+1:]...)
+			return
+		}
+	}
+}
+
 // ===========================================================================
 // CT_TrPr — custom methods
 // ===========================================================================
@@ -592,6 +670,26 @@ func (tc *CT_Tc) SetVAlignVal(v *enum.WdCellVerticalAlignment) error {
 	return tcPr.SetVAlignValEnum(v)
 }
 
+// TextDirectionVal returns the direction text flows in the cell, or nil if
+// not set.
+func (tc *CT_Tc) TextDirectionVal() (*enum.WdTextOrientation, error) {
+	tcPr := tc.TcPr()
+	if tcPr == nil {
+		return nil, nil
+	}
+	return textDirectionVal(tcPr.e)
+}
+
+// SetTextDirectionVal sets the text direction. Passing nil removes it.
+func (tc *CT_Tc) SetTextDirectionVal(v *enum.WdTextOrientation) error {
+	if v == nil && tc.TcPr() == nil {
+		return nil
+	}
+	tcPr := tc.GetOrAddTcPr()
+	return setTextDirectionVal(&tcPr.Element, v, "w:tcFitText", "w:vAlign", "w:hideMark", "w:headers",
+		"w:cellIns", "w:cellDel", "w:cellMerge", "w:tcPrChange")
+}
+
 // InnerContentElements returns all w:p and w:tbl direct children in document order.
 func (tc *CT_Tc) InnerContentElements() []BlockItem {
 	var result []BlockItem
@@ -941,10 +1039,10 @@ func (tc *CT_Tc) spanDimensions(other *CT_Tc) (top, left, height, width int, err
 
 	// Check inverted-L
 	if aTop == bTop && aBottom != bBottom {
-		return 0, 0, 0, 0, fmt.Errorf("requested span not rectangular (inverted-L)")
+		return 0, 0, 0, 0, fmt.Errorf("%w (inverted-L)", ErrNotRectangularSpan)
 	}
 	if aLeft == bLeft && aRight != bRight {
-		return 0, 0, 0, 0, fmt.Errorf("requested span not rectangular (inverted-L)")
+		return 0, 0, 0, 0, fmt.Errorf("%w (inverted-L)", ErrNotRectangularSpan)
 	}
 	// Check tee-shaped (using already-extracted values to avoid re-calling failable methods)
 	topMostTop, otherTcTop := aTop, bTop
@@ -954,7 +1052,7 @@ func (tc *CT_Tc) spanDimensions(other *CT_Tc) (top, left, height, width int, err
 		topMostBottom, otherTcBottom = otherTcBottom, topMostBottom
 	}
 	if topMostTop < otherTcTop && topMostBottom > otherTcBottom {
-		return 0, 0, 0, 0, fmt.Errorf("requested span not rectangular (tee)")
+		return 0, 0, 0, 0, fmt.Errorf("%w (tee)", ErrNotRectangularSpan)
 	}
 	leftMostLeft, otherTc2Left := aLeft, bLeft
 	leftMostRight, otherTc2Right := aRight, bRight
@@ -963,7 +1061,7 @@ func (tc *CT_Tc) spanDimensions(other *CT_Tc) (top, left, height, width int, err
 		leftMostRight, otherTc2Right = otherTc2Right, leftMostRight
 	}
 	if leftMostLeft < otherTc2Left && leftMostRight > otherTc2Right {
-		return 0, 0, 0, 0, fmt.Errorf("requested span not rectangular (tee)")
+		return 0, 0, 0, 0, fmt.Errorf("%w (tee)", ErrNotRectangularSpan)
 	}
 
 	if aTop < bTop {
@@ -1014,7 +1112,7 @@ func (tc *CT_Tc) growTo(width, height int, topTc *CT_Tc) error {
 			return err
 		}
 		if gsv+nextSpan > width {
-			return fmt.Errorf("span is not rectangular")
+			return ErrNotRectangularSpan
 		}
 		next.MoveContentTo(topTc)
 		if err := tc.AddWidthOf(next); err != nil {
@@ -537,3 +537,12 @@ func TestCT_Tc_Merge_Horizontal(t *testing.T) {
 		t.Errorf("expected 1 remaining tc, got %d", len(remaining))
 	}
 }
+
+func BenchmarkCT_Tbl_IterTcs(b *testing.B) {
+	tbl := NewTbl(50, 8, 9000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = tbl.IterTcs()
+	}
+}
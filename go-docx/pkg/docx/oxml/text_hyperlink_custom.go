@@ -8,12 +8,8 @@ import "strings"
 // text from all child w:r elements.
 func (h *CT_Hyperlink) HyperlinkText() string {
 	var sb strings.Builder
-	for _, child := range h.e.ChildElements() {
-		if child.Space == "w" && child.Tag == "r" {
-			r := &CT_R{Element{e: child}}
-			sb.WriteString(r.RunText())
-		}
-	}
+	tw := textWriter{sb: &sb, conv: &defaultTextConversion}
+	tw.hyperlink(h.e)
 	return sb.String()
 }
 
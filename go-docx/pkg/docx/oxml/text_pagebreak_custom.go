@@ -267,13 +267,14 @@ func lastRunInP(p *etree.Element) *etree.Element {
 }
 
 // isRunInnerContent returns true if the element is a content-bearing run child
-// (w:br, w:cr, w:drawing, w:noBreakHyphen, w:ptab, w:t, w:tab).
+// (w:br, w:cr, w:drawing, w:noBreakHyphen, w:ptab, w:softHyphen, w:sym, w:t,
+// w:tab).
 func isRunInnerContent(e *etree.Element) bool {
 	if e.Space != "w" {
 		return false
 	}
 	switch e.Tag {
-	case "br", "cr", "drawing", "noBreakHyphen", "ptab", "t", "tab":
+	case "br", "cr", "drawing", "noBreakHyphen", "ptab", "softHyphen", "sym", "t", "tab":
 		return true
 	}
 	return false
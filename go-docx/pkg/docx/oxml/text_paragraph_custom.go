@@ -1,8 +1,6 @@
 package oxml
 
 import (
-	"strings"
-
 	"github.com/beevik/etree"
 	"github.com/vortex/go-docx/pkg/docx/enum"
 )
@@ -52,12 +50,23 @@ func (p *CT_P) ClearContent() {
 // InnerContentElements returns run and hyperlink children of the <w:p> element,
 // in document order.
 func (p *CT_P) InnerContentElements() []InlineItem {
-	var result []InlineItem
-	for _, child := range p.e.ChildElements() {
-		if child.Space == "w" && child.Tag == "r" {
+	return appendInlineItems(nil, p.e)
+}
+
+// appendInlineItems appends the runs and hyperlinks among the children of
+// parent to result, descending into the read branch of
+// <mc:AlternateContent>.
+func appendInlineItems(result []InlineItem, parent *etree.Element) []InlineItem {
+	for _, child := range parent.ChildElements() {
+		switch {
+		case child.Space == "w" && child.Tag == "r":
 			result = append(result, &CT_R{Element{e: child}})
-		} else if child.Space == "w" && child.Tag == "hyperlink" {
+		case child.Space == "w" && child.Tag == "hyperlink":
 			result = append(result, &CT_Hyperlink{Element{e: child}})
+		case IsAlternateContent(child):
+			if branch := AlternateContentBranch(child); branch != nil {
+				result = appendInlineItems(result, branch)
+			}
 		}
 	}
 	return result
@@ -118,17 +127,7 @@ func (p *CT_P) SetStyle(styleID *string) error {
 // all run and hyperlink children. Named ParagraphText to avoid conflict with
 // embedded Element.Text().
 func (p *CT_P) ParagraphText() string {
-	var sb strings.Builder
-	for _, child := range p.e.ChildElements() {
-		if child.Space == "w" && child.Tag == "r" {
-			r := &CT_R{Element{e: child}}
-			sb.WriteString(r.RunText())
-		} else if child.Space == "w" && child.Tag == "hyperlink" {
-			h := &CT_Hyperlink{Element{e: child}}
-			sb.WriteString(h.HyperlinkText())
-		}
-	}
-	return sb.String()
+	return p.ParagraphTextWith(&defaultTextConversion)
 }
 
 // ReplaceText replaces all non-overlapping occurrences of old with new in the
@@ -138,6 +137,8 @@ func (p *CT_P) ParagraphText() string {
 // Formatting (<w:rPr>), non-textual elements (<w:drawing>, <w:commentReference>),
 // and XML structure (hyperlinks, comment ranges, bookmarks) are preserved.
 // Replacement text inherits the formatting of the first affected run.
+// Content inside mc:AlternateContent is matched in its read branch, and
+// the other branches receive the same replacement uncounted.
 //
 // Returns the number of replacements performed.
 // Returns 0 if old == new (no-op optimization — XML is not modified even
@@ -146,6 +147,7 @@ func (p *CT_P) ReplaceText(old, new string) int {
 	if old == "" || old == new {
 		return 0
 	}
+	replaceInOtherBranches(p.e, nil, old, new)
 	atoms, fullText := collectTextAtoms(p.e)
 	if len(atoms) == 0 {
 		return 0
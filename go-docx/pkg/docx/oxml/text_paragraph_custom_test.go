@@ -183,3 +183,19 @@ func TestCT_P_InnerContentElements(t *testing.T) {
 		t.Error("third element should be *CT_R")
 	}
 }
+
+func BenchmarkCT_P_ParagraphText(b *testing.B) {
+	p := &CT_P{Element{e: OxmlElement("w:p")}}
+	for i := 0; i < 20; i++ {
+		r := p.AddR()
+		r.AddTWithText("Lorem ipsum dolor sit amet ")
+		r.AddTab()
+	}
+	h := p.AddHyperlink()
+	h.AddR().AddTWithText("link")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = p.ParagraphText()
+	}
+}
@@ -358,6 +358,23 @@ func (pPr *CT_PPr) SetWidowControlVal(v *bool) error {
 	return nil
 }
 
+// SuppressAutoHyphensVal returns the tri-state suppressAutoHyphens value.
+func (pPr *CT_PPr) SuppressAutoHyphensVal() *bool {
+	return pPr.pPrBoolVal("w:suppressAutoHyphens")
+}
+
+// SetSuppressAutoHyphensVal sets suppressAutoHyphens. nil removes the element.
+func (pPr *CT_PPr) SetSuppressAutoHyphensVal(v *bool) error {
+	if v == nil {
+		pPr.RemoveSuppressAutoHyphens()
+	} else {
+		if err := pPr.GetOrAddSuppressAutoHyphens().SetVal(*v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // --- CT_TabStops custom methods ---
 
 // InsertTabInOrder inserts a new <w:tab> child element in position order.
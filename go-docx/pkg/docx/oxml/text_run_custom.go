@@ -20,6 +20,18 @@ func (r *CT_R) AddTWithText(text string) *CT_Text {
 	return t
 }
 
+// AddNoBreakHyphen adds a <w:noBreakHyphen> element, a hyphen the line
+// does not break at.
+func (r *CT_R) AddNoBreakHyphen() {
+	r.e.AddChild(OxmlElement("w:noBreakHyphen"))
+}
+
+// AddSoftHyphen adds a <w:softHyphen> element, an optional hyphen shown
+// only where the line breaks at it.
+func (r *CT_R) AddSoftHyphen() {
+	r.e.AddChild(OxmlElement("w:softHyphen"))
+}
+
 // AddDrawingWithInline adds a <w:drawing> element containing the given inline element.
 func (r *CT_R) AddDrawingWithInline(inline *CT_Inline) *CT_Drawing {
 	drawing := r.addDrawing()
@@ -59,35 +71,27 @@ func (r *CT_R) SetStyle(styleID *string) error {
 }
 
 // RunText returns the textual content of this run by concatenating text equivalents
-// of all inner-content elements (w:t, w:br, w:cr, w:tab, w:noBreakHyphen, w:ptab).
+// of all inner-content elements (w:t, w:br, w:cr, w:tab, w:noBreakHyphen,
+// w:softHyphen, w:ptab, w:sym). A phonetic guide (w:ruby) contributes its
+// base text. Non-breaking and soft hyphens read as U+2011 and U+00AD, so
+// the text round-trips through SetRunText.
 func (r *CT_R) RunText() string {
-	var sb strings.Builder
-	for _, child := range r.e.ChildElements() {
-		if child.Space != "w" {
-			continue
-		}
-		switch child.Tag {
-		case "t":
-			sb.WriteString(child.Text())
-		case "br":
-			br := &CT_Br{Element{e: child}}
-			sb.WriteString(br.TextEquivalent())
-		case "cr":
-			sb.WriteString("\n")
-		case "tab":
-			sb.WriteString("\t")
-		case "noBreakHyphen":
-			sb.WriteString("-")
-		case "ptab":
-			sb.WriteString("\t")
-		}
+	return r.RunTextWith(&defaultTextConversion)
+}
+
+// RubyBaseRuns returns the runs of the base text of phonetic guide ruby
+// (<w:ruby>), the text the guide is set above.
+func RubyBaseRuns(ruby *etree.Element) []*etree.Element {
+	if base := ruby.SelectElement("w:rubyBase"); base != nil {
+		return base.SelectElements("w:r")
 	}
-	return sb.String()
+	return nil
 }
 
 // SetRunText replaces all run content with elements representing the given text.
 // Tab characters become <w:tab/>, newlines/carriage-returns become <w:br/>,
-// and regular characters are grouped into <w:t> elements.
+// U+2011 and U+00AD become <w:noBreakHyphen/> and <w:softHyphen/>, and
+// regular characters are grouped into <w:t> elements.
 func (r *CT_R) SetRunText(text string) {
 	r.ClearContent()
 	appendRunContentFromText(r, text)
@@ -140,9 +144,17 @@ func (cr *CT_Cr) TextEquivalent() string {
 
 // --- CT_NoBreakHyphen custom methods ---
 
-// TextEquivalent returns the text equivalent of a non-breaking hyphen: "-".
+// Text equivalents of the hyphen elements, as Word puts them on the
+// clipboard.
+const (
+	NoBreakHyphen = "\u2011" // <w:noBreakHyphen>
+	SoftHyphen    = "\u00ad" // <w:softHyphen>
+)
+
+// TextEquivalent returns the text equivalent of a non-breaking hyphen:
+// U+2011.
 func (nbh *CT_NoBreakHyphen) TextEquivalent() string {
-	return "-"
+	return NoBreakHyphen
 }
 
 // --- CT_PTab custom methods ---
@@ -171,9 +183,12 @@ func (t *CT_Text) SetPreserveSpace() {
 type RunInnerContentItem = interface{}
 
 // InnerContentItems returns the inner content items of this run in document order.
-// Text-like elements (w:t, w:br, w:cr, w:tab, w:noBreakHyphen, w:ptab) are
+// Text-like elements (w:t, w:br, w:cr, w:tab, w:noBreakHyphen, w:softHyphen,
+// w:ptab, w:sym) are
 // accumulated into contiguous strings. Drawing and LastRenderedPageBreak elements
-// are yielded individually, interrupting any accumulated text.
+// are yielded individually, interrupting any accumulated text. Content with
+// an alternate yields the items of its read branch, and a phonetic guide
+// those of its base text.
 //
 // Mirrors Python CT_R.inner_content_items using a TextAccumulator pattern.
 func (r *CT_R) InnerContentItems() []RunInnerContentItem {
@@ -187,38 +202,57 @@ func (r *CT_R) InnerContentItems() []RunInnerContentItem {
 		}
 	}
 
-	for _, child := range r.e.ChildElements() {
-		if child.Space != "w" {
-			continue
-		}
-		switch child.Tag {
-		case "drawing":
-			flushText()
-			result = append(result, &CT_Drawing{Element{e: child}})
-		case "lastRenderedPageBreak":
-			flushText()
-			result = append(result, &CT_LastRenderedPageBreak{Element{e: child}})
-		case "t":
-			textBuf.WriteString(child.Text())
-		case "br":
-			br := &CT_Br{Element{e: child}}
-			textBuf.WriteString(br.TextEquivalent())
-		case "cr":
-			textBuf.WriteString("\n")
-		case "tab":
-			textBuf.WriteString("\t")
-		case "noBreakHyphen":
-			textBuf.WriteString("-")
-		case "ptab":
-			textBuf.WriteString("\t")
+	var collect func(parent *etree.Element)
+	collect = func(parent *etree.Element) {
+		for _, child := range parent.ChildElements() {
+			if IsAlternateContent(child) {
+				if branch := AlternateContentBranch(child); branch != nil {
+					collect(branch)
+				}
+				continue
+			}
+			if child.Space != "w" {
+				continue
+			}
+			switch child.Tag {
+			case "drawing":
+				flushText()
+				result = append(result, &CT_Drawing{Element{e: child}})
+			case "lastRenderedPageBreak":
+				flushText()
+				result = append(result, &CT_LastRenderedPageBreak{Element{e: child}})
+			case "t":
+				textBuf.WriteString(child.Text())
+			case "br":
+				br := &CT_Br{Element{e: child}}
+				textBuf.WriteString(br.TextEquivalent())
+			case "cr":
+				textBuf.WriteString("\n")
+			case "tab":
+				textBuf.WriteString("\t")
+			case "noBreakHyphen":
+				textBuf.WriteString(NoBreakHyphen)
+			case "softHyphen":
+				textBuf.WriteString(SoftHyphen)
+			case "ptab":
+				textBuf.WriteString("\t")
+			case "sym":
+				textBuf.WriteString(SymText(child))
+			case "ruby":
+				for _, r := range RubyBaseRuns(child) {
+					collect(r)
+				}
+			}
 		}
 	}
+	collect(r.e)
 	flushText()
 	return result
 }
 
 // appendRunContentFromText translates a string into run content elements.
-// Tabs → <w:tab/>, newlines → <w:br/>, regular chars → <w:t>.
+// Tabs → <w:tab/>, newlines → <w:br/>, U+2011 → <w:noBreakHyphen/>,
+// U+00AD → <w:softHyphen/>, regular chars → <w:t>.
 func appendRunContentFromText(r *CT_R, text string) {
 	var buf strings.Builder
 	flush := func() {
@@ -235,6 +269,12 @@ func appendRunContentFromText(r *CT_R, text string) {
 		case '\n', '\r':
 			flush()
 			r.AddBr()
+		case '\u2011':
+			flush()
+			r.AddNoBreakHyphen()
+		case '\u00ad':
+			flush()
+			r.AddSoftHyphen()
 		default:
 			buf.WriteRune(ch)
 		}
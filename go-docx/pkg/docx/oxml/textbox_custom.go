@@ -0,0 +1,177 @@
+package oxml
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// ===========================================================================
+// Text boxes — wps:wsp shapes with a wps:txbx body, inside wp:anchor
+// ===========================================================================
+
+// TextBoxSpec describes a floating text box created by NewTextBoxAnchor.
+// Lengths are in EMU. Colors are six-digit hex strings; an empty string
+// means no fill or no outline.
+type TextBoxSpec struct {
+	ID        int    // unique drawing object id (wp:docPr/@id)
+	Cx, Cy    int64  // size
+	X, Y      int64  // offset from the column (X) and paragraph (Y)
+	FillHex   string // solid fill color
+	LineHex   string // outline color
+	LineWidth int64  // outline width; ignored when LineHex is empty
+	// Gradient, when set, fills the box instead of FillHex.
+	Gradient *GradientSpec
+}
+
+// GradientSpec describes a DrawingML gradient fill (a:gradFill).
+type GradientSpec struct {
+	// Path is the a:path/@path shape of a path gradient ("circle", "rect"
+	// or "shape"); "" for a linear gradient.
+	Path string
+	// Angle is the direction of a linear gradient, in 60,000ths of a
+	// degree clockwise from left to right.
+	Angle int
+	Stops []GradientStop
+}
+
+// GradientStop is one color of a gradient.
+type GradientStop struct {
+	Pos int    // position along the gradient, 0 to 100000
+	Hex string // color
+}
+
+// gradientFillXml returns the a:gradFill markup of g.
+func gradientFillXml(g *GradientSpec) (string, error) {
+	if len(g.Stops) < 2 {
+		return "", fmt.Errorf("oxml: gradient needs at least 2 stops, got %d", len(g.Stops))
+	}
+	var b strings.Builder
+	b.WriteString(`<a:gradFill rotWithShape="1"><a:gsLst>`)
+	for _, st := range g.Stops {
+		if st.Pos < 0 || st.Pos > 100000 {
+			return "", fmt.Errorf("oxml: gradient stop position %d out of range 0-100000", st.Pos)
+		}
+		fmt.Fprintf(&b, `<a:gs pos="%d"><a:srgbClr val="%s"/></a:gs>`, st.Pos, st.Hex)
+	}
+	b.WriteString(`</a:gsLst>`)
+	if g.Path == "" {
+		fmt.Fprintf(&b, `<a:lin ang="%d" scaled="0"/>`, g.Angle)
+	} else {
+		fmt.Fprintf(&b, `<a:path path="%s"><a:fillToRect l="50000" t="50000" r="50000" b="50000"/></a:path>`, g.Path)
+	}
+	b.WriteString(`</a:gradFill>`)
+	return b.String(), nil
+}
+
+// NewTextBoxAnchor creates a <wp:anchor> holding a rectangular text box
+// shape with an empty <w:txbxContent> (one empty paragraph). The anchor
+// wraps text squarely around the box.
+func NewTextBoxAnchor(spec TextBoxSpec) (*CT_Anchor, error) {
+	if spec.Cx <= 0 || spec.Cy <= 0 {
+		return nil, fmt.Errorf("oxml: text box size must be positive, got %dx%d", spec.Cx, spec.Cy)
+	}
+	fill := `<a:noFill/>`
+	if spec.Gradient != nil {
+		var err error
+		if fill, err = gradientFillXml(spec.Gradient); err != nil {
+			return nil, err
+		}
+	} else if spec.FillHex != "" {
+		fill = fmt.Sprintf(`<a:solidFill><a:srgbClr val="%s"/></a:solidFill>`, spec.FillHex)
+	}
+	line := `<a:ln><a:noFill/></a:ln>`
+	if spec.LineHex != "" {
+		line = fmt.Sprintf(`<a:ln w="%d"><a:solidFill><a:srgbClr val="%s"/></a:solidFill></a:ln>`, spec.LineWidth, spec.LineHex)
+	}
+	xml := fmt.Sprintf(
+		`<wp:anchor `+
+			`xmlns:wp="http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing" `+
+			`xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" `+
+			`xmlns:wps="http://schemas.microsoft.com/office/word/2010/wordprocessingShape" `+
+			`xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" `+
+			`distT="0" distB="0" distL="114300" distR="114300" simplePos="0" relativeHeight="%d" `+
+			`behindDoc="0" locked="0" layoutInCell="1" allowOverlap="1">`+
+			`<wp:simplePos x="0" y="0"/>`+
+			`<wp:positionH relativeFrom="column"><wp:posOffset>%d</wp:posOffset></wp:positionH>`+
+			`<wp:positionV relativeFrom="paragraph"><wp:posOffset>%d</wp:posOffset></wp:positionV>`+
+			`<wp:extent cx="%d" cy="%d"/>`+
+			`<wp:effectExtent l="0" t="0" r="0" b="0"/>`+
+			`<wp:wrapSquare wrapText="bothSides"/>`+
+			`<wp:docPr id="%d" name="Text Box %d"/>`+
+			`<wp:cNvGraphicFramePr/>`+
+			`<a:graphic>`+
+			`<a:graphicData uri="http://schemas.microsoft.com/office/word/2010/wordprocessingShape">`+
+			`<wps:wsp>`+
+			`<wps:cNvSpPr txBox="1"/>`+
+			`<wps:spPr>`+
+			`<a:xfrm><a:off x="0" y="0"/><a:ext cx="%d" cy="%d"/></a:xfrm>`+
+			`<a:prstGeom prst="rect"><a:avLst/></a:prstGeom>`+
+			`%s%s`+
+			`</wps:spPr>`+
+			`<wps:txbx><w:txbxContent><w:p/></w:txbxContent></wps:txbx>`+
+			`<wps:bodyPr rot="0" vert="horz" wrap="square" lIns="91440" tIns="45720" rIns="91440" bIns="45720" anchor="t" anchorCtr="0">`+
+			`<a:noAutofit/>`+
+			`</wps:bodyPr>`+
+			`</wps:wsp>`+
+			`</a:graphicData>`+
+			`</a:graphic>`+
+			`</wp:anchor>`,
+		251659264+spec.ID, spec.X, spec.Y, spec.Cx, spec.Cy, spec.ID, spec.ID,
+		spec.Cx, spec.Cy, fill, line,
+	)
+	el, err := ParseXml([]byte(xml))
+	if err != nil {
+		return nil, fmt.Errorf("oxml: failed to parse text box anchor XML: %w", err)
+	}
+	return &CT_Anchor{Element{e: el}}, nil
+}
+
+// FindTxbxContents returns the <w:txbxContent> elements under root in
+// document order, including nested ones. Content inside mc:Fallback is
+// skipped: it duplicates the preferred mc:Choice rendering (typically the
+// legacy VML copy of a wps text box).
+func FindTxbxContents(root *etree.Element) []*etree.Element {
+	var result []*etree.Element
+	var walk func(el *etree.Element)
+	walk = func(el *etree.Element) {
+		for _, child := range el.ChildElements() {
+			switch {
+			case child.Space == "mc" && child.Tag == "Fallback":
+				continue
+			case child.Space == "w" && child.Tag == "txbxContent":
+				result = append(result, child)
+			}
+			walk(child)
+		}
+	}
+	walk(root)
+	return result
+}
+
+// FindOuterTxbxContents returns the outermost <w:txbxContent> elements under
+// root in document order, without descending into the ones found: text
+// boxes nested in a text box are reached through that box's own content.
+// Boxes inside mc:Fallback are returned separately in fallback.
+func FindOuterTxbxContents(root *etree.Element) (preferred, fallback []*etree.Element) {
+	var walk func(el *etree.Element, inFallback bool)
+	walk = func(el *etree.Element, inFallback bool) {
+		for _, child := range el.ChildElements() {
+			switch {
+			case child.Space == "mc" && child.Tag == "Fallback":
+				walk(child, true)
+			case child.Space == "w" && child.Tag == "txbxContent":
+				if inFallback {
+					fallback = append(fallback, child)
+				} else {
+					preferred = append(preferred, child)
+				}
+			default:
+				walk(child, inFallback)
+			}
+		}
+	}
+	walk(root, false)
+	return preferred, fallback
+}
@@ -0,0 +1,180 @@
+package oxml
+
+import (
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// --------------------------------------------------------------------------
+// textconv_custom.go — plain text of runs and paragraphs
+//
+// RunText, ParagraphText and HyperlinkText read the content of runs as
+// plain text. Text is read as it is; the other content reads as the text
+// of a TextConversion, so a search indexer and a display can each get the
+// plain text they need.
+// --------------------------------------------------------------------------
+
+// TextConversion sets what the non-text content of runs reads as in plain
+// text.
+type TextConversion struct {
+	Tab         string // <w:tab>, <w:ptab>
+	LineBreak   string // <w:br> of type textWrapping, <w:cr>
+	PageBreak   string // <w:br w:type="page">
+	ColumnBreak string // <w:br w:type="column">
+	Drawing     string // <w:drawing>, <w:pict>, <w:object>
+
+	// LeaderWidth, if positive, makes a tab with a leader read as that
+	// many leader characters, e.g. "...." for a dotted leader, in place of
+	// Tab. The leader of a <w:ptab> is its own; that of the nth <w:tab>
+	// of the paragraph is the nth of TabLeaders.
+	LeaderWidth int
+	// TabLeaders lists the leaders (w:leader values) of the tab stops the
+	// tabs of the paragraph go to, in order.
+	TabLeaders []string
+}
+
+// DefaultTextConversion returns the conversion RunText and ParagraphText
+// use: tabs read as "\t", line breaks as "\n", and page and column breaks
+// and drawings as nothing.
+func DefaultTextConversion() TextConversion {
+	return defaultTextConversion
+}
+
+var defaultTextConversion = TextConversion{Tab: "\t", LineBreak: "\n"}
+
+// tabLeaderChars maps w:leader values to the character they repeat.
+var tabLeaderChars = map[string]string{
+	"dot":        ".",
+	"hyphen":     "-",
+	"underscore": "_",
+	"heavy":      "_",
+	"middleDot":  "·",
+}
+
+// RunTextWith returns the text of this run, see RunText, with its other
+// content read as conv sets.
+func (r *CT_R) RunTextWith(conv *TextConversion) string {
+	var sb strings.Builder
+	tw := textWriter{sb: &sb, conv: conv}
+	tw.run(r.e)
+	return sb.String()
+}
+
+// ParagraphTextWith returns the text of this paragraph, see ParagraphText,
+// with the other content of its runs read as conv sets.
+func (p *CT_P) ParagraphTextWith(conv *TextConversion) string {
+	var sb strings.Builder
+	tw := textWriter{sb: &sb, conv: conv}
+	tw.inline(p.e)
+	return sb.String()
+}
+
+// textWriter writes the plain text of runs to sb.
+type textWriter struct {
+	sb   *strings.Builder
+	conv *TextConversion
+	tabs int // <w:tab> elements written, indexing conv.TabLeaders
+}
+
+// inline writes the text of the runs and hyperlinks among the children of
+// parent, descending into the read branch of <mc:AlternateContent>.
+func (tw *textWriter) inline(parent *etree.Element) {
+	for _, tok := range parent.Child {
+		child, ok := tok.(*etree.Element)
+		if !ok {
+			continue
+		}
+		if child.Space != "w" {
+			if IsAlternateContent(child) {
+				if branch := AlternateContentBranch(child); branch != nil {
+					tw.inline(branch)
+				}
+			}
+			continue
+		}
+		switch child.Tag {
+		case "r":
+			tw.run(child)
+		case "hyperlink":
+			tw.hyperlink(child)
+		}
+	}
+}
+
+// hyperlink writes the text of the runs of hyperlink h.
+func (tw *textWriter) hyperlink(h *etree.Element) {
+	for _, tok := range h.Child {
+		if r, ok := tok.(*etree.Element); ok && r.Space == "w" && r.Tag == "r" {
+			tw.run(r)
+		}
+	}
+}
+
+// run writes the text of run r. It ranges over the child tokens rather
+// than ChildElements, which allocates a slice per call: paragraph text is
+// read for every run of a document.
+//
+// Content with an alternate contributes the text of its read branch.
+func (tw *textWriter) run(r *etree.Element) {
+	sb, conv := tw.sb, tw.conv
+	for _, tok := range r.Child {
+		child, ok := tok.(*etree.Element)
+		if !ok {
+			continue
+		}
+		if child.Space != "w" {
+			if IsAlternateContent(child) {
+				if branch := AlternateContentBranch(child); branch != nil {
+					tw.run(branch)
+				}
+			}
+			continue
+		}
+		switch child.Tag {
+		case "t":
+			sb.WriteString(child.Text())
+		case "br":
+			switch etreeAttrVal(child, "w", "type") {
+			case "", "textWrapping":
+				sb.WriteString(conv.LineBreak)
+			case "page":
+				sb.WriteString(conv.PageBreak)
+			case "column":
+				sb.WriteString(conv.ColumnBreak)
+			}
+		case "cr":
+			sb.WriteString(conv.LineBreak)
+		case "tab":
+			leader := ""
+			if tw.tabs < len(conv.TabLeaders) {
+				leader = conv.TabLeaders[tw.tabs]
+			}
+			tw.tabs++
+			tw.tab(leader)
+		case "ptab":
+			tw.tab(etreeAttrVal(child, "w", "leader"))
+		case "noBreakHyphen":
+			sb.WriteString(NoBreakHyphen)
+		case "softHyphen":
+			sb.WriteString(SoftHyphen)
+		case "sym":
+			sb.WriteString(SymText(child))
+		case "drawing", "pict", "object":
+			sb.WriteString(conv.Drawing)
+		case "ruby":
+			for _, r := range RubyBaseRuns(child) {
+				tw.run(r)
+			}
+		}
+	}
+}
+
+// tab writes a tab with leader, a w:leader value or "" for none.
+func (tw *textWriter) tab(leader string) {
+	if ch, ok := tabLeaderChars[leader]; ok && tw.conv.LeaderWidth > 0 {
+		tw.sb.WriteString(strings.Repeat(ch, tw.conv.LeaderWidth))
+		return
+	}
+	tw.sb.WriteString(tw.conv.Tab)
+}
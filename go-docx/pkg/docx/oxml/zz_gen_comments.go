@@ -214,3 +214,22 @@ func (e *CT_Comment) SetAuthor(v string) error {
 	e.SetAttr("w:author", s)
 	return nil
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:comments",
+		children: []schemaChildRule{
+			{tag: "w:comment", min: 0, max: -1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:comment",
+		children: []schemaChildRule{
+			{tag: "w:p", min: 0, max: -1},
+			{tag: "w:tbl", min: 0, max: -1},
+		},
+		requiredAttrs: []string{"w:id", "w:author"},
+	})
+}
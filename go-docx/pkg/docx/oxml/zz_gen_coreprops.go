@@ -652,3 +652,31 @@ func (e *CT_CoreProperties) insertVersion(child *CT_CorePropText) *CT_CorePropTe
 type CT_CorePropText struct {
 	Element
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "cp:coreProperties",
+		children: []schemaChildRule{
+			{tag: "cp:category", min: 0, max: 1},
+			{tag: "cp:contentStatus", min: 0, max: 1},
+			{tag: "dcterms:created", min: 0, max: 1},
+			{tag: "dc:creator", min: 0, max: 1},
+			{tag: "dc:description", min: 0, max: 1},
+			{tag: "dc:identifier", min: 0, max: 1},
+			{tag: "cp:keywords", min: 0, max: 1},
+			{tag: "dc:language", min: 0, max: 1},
+			{tag: "cp:lastModifiedBy", min: 0, max: 1},
+			{tag: "cp:lastPrinted", min: 0, max: 1},
+			{tag: "dcterms:modified", min: 0, max: 1},
+			{tag: "cp:revision", min: 0, max: 1},
+			{tag: "dc:subject", min: 0, max: 1},
+			{tag: "dc:title", min: 0, max: 1},
+			{tag: "cp:version", min: 0, max: 1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "cp:text",
+	})
+}
@@ -174,3 +174,22 @@ func (e *CT_Body) insertTbl(child *CT_Tbl) *CT_Tbl {
 	e.InsertElementBefore(child.e, "w:sectPr")
 	return child
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:document",
+		children: []schemaChildRule{
+			{tag: "w:body", min: 0, max: 1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:body",
+		children: []schemaChildRule{
+			{tag: "w:p", min: 0, max: -1, successors: []string{"w:sectPr"}},
+			{tag: "w:tbl", min: 0, max: -1, successors: []string{"w:sectPr"}},
+			{tag: "w:sectPr", min: 0, max: 1},
+		},
+	})
+}
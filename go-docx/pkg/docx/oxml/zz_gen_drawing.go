@@ -22,3 +22,14 @@ type CT_Drawing struct {
 type CT_LastRenderedPageBreak struct {
 	Element
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:drawing",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:lastRenderedPageBreak",
+	})
+}
@@ -195,3 +195,29 @@ func (e *CT_NumLvl) SetIlvl(v int) error {
 	e.SetAttr("w:ilvl", s)
 	return nil
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:numbering",
+		children: []schemaChildRule{
+			{tag: "w:num", min: 0, max: -1, successors: []string{"w:numIdMacAtCleanup"}},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:num",
+		children: []schemaChildRule{
+			{tag: "w:abstractNumId", min: 1, max: 1},
+			{tag: "w:lvlOverride", min: 0, max: -1},
+		},
+		requiredAttrs: []string{"w:numId"},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:lvlOverride",
+		children: []schemaChildRule{
+			{tag: "w:startOverride", min: 0, max: 1, successors: []string{"w:lvl"}},
+		},
+		requiredAttrs: []string{"w:ilvl"},
+	})
+}
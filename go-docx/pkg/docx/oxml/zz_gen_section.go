@@ -185,6 +185,48 @@ func (e *CT_SectPr) insertTitlePg(child *CT_OnOff) *CT_OnOff {
 	return child
 }
 
+// RtlGutter returns the <w:rtlGutter> child element, or nil if not present.
+func (e *CT_SectPr) RtlGutter() *CT_OnOff {
+	child := e.FindChild("w:rtlGutter")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{e: child}}
+}
+
+// GetOrAddRtlGutter returns <w:rtlGutter>, creating it if not present.
+func (e *CT_SectPr) GetOrAddRtlGutter() *CT_OnOff {
+	child := e.RtlGutter()
+	if child != nil {
+		return child
+	}
+	return e.addRtlGutter()
+}
+
+// RemoveRtlGutter removes all <w:rtlGutter> child elements.
+func (e *CT_SectPr) RemoveRtlGutter() {
+	e.RemoveAll("w:rtlGutter")
+}
+
+// addRtlGutter adds a new <w:rtlGutter> in correct sequence.
+func (e *CT_SectPr) addRtlGutter() *CT_OnOff {
+	child := e.newRtlGutter()
+	e.insertRtlGutter(child)
+	return child
+}
+
+// newRtlGutter creates a detached <w:rtlGutter> element.
+func (e *CT_SectPr) newRtlGutter() *CT_OnOff {
+	el := OxmlElement("w:rtlGutter")
+	return &CT_OnOff{Element{e: el}}
+}
+
+// insertRtlGutter inserts child before first successor.
+func (e *CT_SectPr) insertRtlGutter(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.e, "w:docGrid", "w:printerSettings", "w:sectPrChange")
+	return child
+}
+
 // HeaderReferenceList returns all <w:headerReference> child elements.
 func (e *CT_SectPr) HeaderReferenceList() []*CT_HdrFtrRef {
 	children := e.FindAllChildren("w:headerReference")
@@ -716,3 +758,40 @@ func (e *CT_SectType) SetVal(v enum.WdSectionStart) error {
 	e.SetAttr("w:val", s)
 	return nil
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:sectPr",
+		children: []schemaChildRule{
+			{tag: "w:headerReference", min: 0, max: -1, successors: []string{"w:footnotePr", "w:endnotePr", "w:type", "w:pgSz", "w:pgMar", "w:paperSrc", "w:pgBorders", "w:lnNumType", "w:pgNumType", "w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+			{tag: "w:footerReference", min: 0, max: -1, successors: []string{"w:footnotePr", "w:endnotePr", "w:type", "w:pgSz", "w:pgMar", "w:paperSrc", "w:pgBorders", "w:lnNumType", "w:pgNumType", "w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+			{tag: "w:type", min: 0, max: 1, successors: []string{"w:pgSz", "w:pgMar", "w:paperSrc", "w:pgBorders", "w:lnNumType", "w:pgNumType", "w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+			{tag: "w:pgSz", min: 0, max: 1, successors: []string{"w:pgMar", "w:paperSrc", "w:pgBorders", "w:lnNumType", "w:pgNumType", "w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+			{tag: "w:pgMar", min: 0, max: 1, successors: []string{"w:paperSrc", "w:pgBorders", "w:lnNumType", "w:pgNumType", "w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+			{tag: "w:titlePg", min: 0, max: 1, successors: []string{"w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+			{tag: "w:rtlGutter", min: 0, max: 1, successors: []string{"w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:hdr",
+		children: []schemaChildRule{
+			{tag: "w:p", min: 0, max: -1},
+			{tag: "w:tbl", min: 0, max: -1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag:           "w:headerReference",
+		requiredAttrs: []string{"w:type", "r:id"},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:pgMar",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:pgSz",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:type",
+	})
+}
@@ -16,6 +16,258 @@ type CT_Settings struct {
 	Element
 }
 
+// MirrorMargins returns the <w:mirrorMargins> child element, or nil if not present.
+func (e *CT_Settings) MirrorMargins() *CT_OnOff {
+	child := e.FindChild("w:mirrorMargins")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{e: child}}
+}
+
+// GetOrAddMirrorMargins returns <w:mirrorMargins>, creating it if not present.
+func (e *CT_Settings) GetOrAddMirrorMargins() *CT_OnOff {
+	child := e.MirrorMargins()
+	if child != nil {
+		return child
+	}
+	return e.addMirrorMargins()
+}
+
+// RemoveMirrorMargins removes all <w:mirrorMargins> child elements.
+func (e *CT_Settings) RemoveMirrorMargins() {
+	e.RemoveAll("w:mirrorMargins")
+}
+
+// addMirrorMargins adds a new <w:mirrorMargins> in correct sequence.
+func (e *CT_Settings) addMirrorMargins() *CT_OnOff {
+	child := e.newMirrorMargins()
+	e.insertMirrorMargins(child)
+	return child
+}
+
+// newMirrorMargins creates a detached <w:mirrorMargins> element.
+func (e *CT_Settings) newMirrorMargins() *CT_OnOff {
+	el := OxmlElement("w:mirrorMargins")
+	return &CT_OnOff{Element{e: el}}
+}
+
+// insertMirrorMargins inserts child before first successor.
+func (e *CT_Settings) insertMirrorMargins(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.e, "w:alignBordersAndEdges", "w:bordersDoNotSurroundHeader", "w:bordersDoNotSurroundFooter", "w:gutterAtTop", "w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids")
+	return child
+}
+
+// GutterAtTop returns the <w:gutterAtTop> child element, or nil if not present.
+func (e *CT_Settings) GutterAtTop() *CT_OnOff {
+	child := e.FindChild("w:gutterAtTop")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{e: child}}
+}
+
+// GetOrAddGutterAtTop returns <w:gutterAtTop>, creating it if not present.
+func (e *CT_Settings) GetOrAddGutterAtTop() *CT_OnOff {
+	child := e.GutterAtTop()
+	if child != nil {
+		return child
+	}
+	return e.addGutterAtTop()
+}
+
+// RemoveGutterAtTop removes all <w:gutterAtTop> child elements.
+func (e *CT_Settings) RemoveGutterAtTop() {
+	e.RemoveAll("w:gutterAtTop")
+}
+
+// addGutterAtTop adds a new <w:gutterAtTop> in correct sequence.
+func (e *CT_Settings) addGutterAtTop() *CT_OnOff {
+	child := e.newGutterAtTop()
+	e.insertGutterAtTop(child)
+	return child
+}
+
+// newGutterAtTop creates a detached <w:gutterAtTop> element.
+func (e *CT_Settings) newGutterAtTop() *CT_OnOff {
+	el := OxmlElement("w:gutterAtTop")
+	return &CT_OnOff{Element{e: el}}
+}
+
+// insertGutterAtTop inserts child before first successor.
+func (e *CT_Settings) insertGutterAtTop(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.e, "w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids")
+	return child
+}
+
+// AutoHyphenation returns the <w:autoHyphenation> child element, or nil if not present.
+func (e *CT_Settings) AutoHyphenation() *CT_OnOff {
+	child := e.FindChild("w:autoHyphenation")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{e: child}}
+}
+
+// GetOrAddAutoHyphenation returns <w:autoHyphenation>, creating it if not present.
+func (e *CT_Settings) GetOrAddAutoHyphenation() *CT_OnOff {
+	child := e.AutoHyphenation()
+	if child != nil {
+		return child
+	}
+	return e.addAutoHyphenation()
+}
+
+// RemoveAutoHyphenation removes all <w:autoHyphenation> child elements.
+func (e *CT_Settings) RemoveAutoHyphenation() {
+	e.RemoveAll("w:autoHyphenation")
+}
+
+// addAutoHyphenation adds a new <w:autoHyphenation> in correct sequence.
+func (e *CT_Settings) addAutoHyphenation() *CT_OnOff {
+	child := e.newAutoHyphenation()
+	e.insertAutoHyphenation(child)
+	return child
+}
+
+// newAutoHyphenation creates a detached <w:autoHyphenation> element.
+func (e *CT_Settings) newAutoHyphenation() *CT_OnOff {
+	el := OxmlElement("w:autoHyphenation")
+	return &CT_OnOff{Element{e: el}}
+}
+
+// insertAutoHyphenation inserts child before first successor.
+func (e *CT_Settings) insertAutoHyphenation(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.e, "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids")
+	return child
+}
+
+// ConsecutiveHyphenLimit returns the <w:consecutiveHyphenLimit> child element, or nil if not present.
+func (e *CT_Settings) ConsecutiveHyphenLimit() *CT_DecimalNumber {
+	child := e.FindChild("w:consecutiveHyphenLimit")
+	if child == nil {
+		return nil
+	}
+	return &CT_DecimalNumber{Element{e: child}}
+}
+
+// GetOrAddConsecutiveHyphenLimit returns <w:consecutiveHyphenLimit>, creating it if not present.
+func (e *CT_Settings) GetOrAddConsecutiveHyphenLimit() *CT_DecimalNumber {
+	child := e.ConsecutiveHyphenLimit()
+	if child != nil {
+		return child
+	}
+	return e.addConsecutiveHyphenLimit()
+}
+
+// RemoveConsecutiveHyphenLimit removes all <w:consecutiveHyphenLimit> child elements.
+func (e *CT_Settings) RemoveConsecutiveHyphenLimit() {
+	e.RemoveAll("w:consecutiveHyphenLimit")
+}
+
+// addConsecutiveHyphenLimit adds a new <w:consecutiveHyphenLimit> in correct sequence.
+func (e *CT_Settings) addConsecutiveHyphenLimit() *CT_DecimalNumber {
+	child := e.newConsecutiveHyphenLimit()
+	e.insertConsecutiveHyphenLimit(child)
+	return child
+}
+
+// newConsecutiveHyphenLimit creates a detached <w:consecutiveHyphenLimit> element.
+func (e *CT_Settings) newConsecutiveHyphenLimit() *CT_DecimalNumber {
+	el := OxmlElement("w:consecutiveHyphenLimit")
+	return &CT_DecimalNumber{Element{e: el}}
+}
+
+// insertConsecutiveHyphenLimit inserts child before first successor.
+func (e *CT_Settings) insertConsecutiveHyphenLimit(child *CT_DecimalNumber) *CT_DecimalNumber {
+	e.InsertElementBefore(child.e, "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids")
+	return child
+}
+
+// HyphenationZone returns the <w:hyphenationZone> child element, or nil if not present.
+func (e *CT_Settings) HyphenationZone() *CT_DecimalNumber {
+	child := e.FindChild("w:hyphenationZone")
+	if child == nil {
+		return nil
+	}
+	return &CT_DecimalNumber{Element{e: child}}
+}
+
+// GetOrAddHyphenationZone returns <w:hyphenationZone>, creating it if not present.
+func (e *CT_Settings) GetOrAddHyphenationZone() *CT_DecimalNumber {
+	child := e.HyphenationZone()
+	if child != nil {
+		return child
+	}
+	return e.addHyphenationZone()
+}
+
+// RemoveHyphenationZone removes all <w:hyphenationZone> child elements.
+func (e *CT_Settings) RemoveHyphenationZone() {
+	e.RemoveAll("w:hyphenationZone")
+}
+
+// addHyphenationZone adds a new <w:hyphenationZone> in correct sequence.
+func (e *CT_Settings) addHyphenationZone() *CT_DecimalNumber {
+	child := e.newHyphenationZone()
+	e.insertHyphenationZone(child)
+	return child
+}
+
+// newHyphenationZone creates a detached <w:hyphenationZone> element.
+func (e *CT_Settings) newHyphenationZone() *CT_DecimalNumber {
+	el := OxmlElement("w:hyphenationZone")
+	return &CT_DecimalNumber{Element{e: el}}
+}
+
+// insertHyphenationZone inserts child before first successor.
+func (e *CT_Settings) insertHyphenationZone(child *CT_DecimalNumber) *CT_DecimalNumber {
+	e.InsertElementBefore(child.e, "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids")
+	return child
+}
+
+// DoNotHyphenateCaps returns the <w:doNotHyphenateCaps> child element, or nil if not present.
+func (e *CT_Settings) DoNotHyphenateCaps() *CT_OnOff {
+	child := e.FindChild("w:doNotHyphenateCaps")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{e: child}}
+}
+
+// GetOrAddDoNotHyphenateCaps returns <w:doNotHyphenateCaps>, creating it if not present.
+func (e *CT_Settings) GetOrAddDoNotHyphenateCaps() *CT_OnOff {
+	child := e.DoNotHyphenateCaps()
+	if child != nil {
+		return child
+	}
+	return e.addDoNotHyphenateCaps()
+}
+
+// RemoveDoNotHyphenateCaps removes all <w:doNotHyphenateCaps> child elements.
+func (e *CT_Settings) RemoveDoNotHyphenateCaps() {
+	e.RemoveAll("w:doNotHyphenateCaps")
+}
+
+// addDoNotHyphenateCaps adds a new <w:doNotHyphenateCaps> in correct sequence.
+func (e *CT_Settings) addDoNotHyphenateCaps() *CT_OnOff {
+	child := e.newDoNotHyphenateCaps()
+	e.insertDoNotHyphenateCaps(child)
+	return child
+}
+
+// newDoNotHyphenateCaps creates a detached <w:doNotHyphenateCaps> element.
+func (e *CT_Settings) newDoNotHyphenateCaps() *CT_OnOff {
+	el := OxmlElement("w:doNotHyphenateCaps")
+	return &CT_OnOff{Element{e: el}}
+}
+
+// insertDoNotHyphenateCaps inserts child before first successor.
+func (e *CT_Settings) insertDoNotHyphenateCaps(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.e, "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids")
+	return child
+}
+
 // EvenAndOddHeaders returns the <w:evenAndOddHeaders> child element, or nil if not present.
 func (e *CT_Settings) EvenAndOddHeaders() *CT_OnOff {
 	child := e.FindChild("w:evenAndOddHeaders")
@@ -57,3 +309,149 @@ func (e *CT_Settings) insertEvenAndOddHeaders(child *CT_OnOff) *CT_OnOff {
 	e.InsertElementBefore(child.e, "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids")
 	return child
 }
+
+// BookFoldRevPrinting returns the <w:bookFoldRevPrinting> child element, or nil if not present.
+func (e *CT_Settings) BookFoldRevPrinting() *CT_OnOff {
+	child := e.FindChild("w:bookFoldRevPrinting")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{e: child}}
+}
+
+// GetOrAddBookFoldRevPrinting returns <w:bookFoldRevPrinting>, creating it if not present.
+func (e *CT_Settings) GetOrAddBookFoldRevPrinting() *CT_OnOff {
+	child := e.BookFoldRevPrinting()
+	if child != nil {
+		return child
+	}
+	return e.addBookFoldRevPrinting()
+}
+
+// RemoveBookFoldRevPrinting removes all <w:bookFoldRevPrinting> child elements.
+func (e *CT_Settings) RemoveBookFoldRevPrinting() {
+	e.RemoveAll("w:bookFoldRevPrinting")
+}
+
+// addBookFoldRevPrinting adds a new <w:bookFoldRevPrinting> in correct sequence.
+func (e *CT_Settings) addBookFoldRevPrinting() *CT_OnOff {
+	child := e.newBookFoldRevPrinting()
+	e.insertBookFoldRevPrinting(child)
+	return child
+}
+
+// newBookFoldRevPrinting creates a detached <w:bookFoldRevPrinting> element.
+func (e *CT_Settings) newBookFoldRevPrinting() *CT_OnOff {
+	el := OxmlElement("w:bookFoldRevPrinting")
+	return &CT_OnOff{Element{e: el}}
+}
+
+// insertBookFoldRevPrinting inserts child before first successor.
+func (e *CT_Settings) insertBookFoldRevPrinting(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.e, "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids")
+	return child
+}
+
+// BookFoldPrinting returns the <w:bookFoldPrinting> child element, or nil if not present.
+func (e *CT_Settings) BookFoldPrinting() *CT_OnOff {
+	child := e.FindChild("w:bookFoldPrinting")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{e: child}}
+}
+
+// GetOrAddBookFoldPrinting returns <w:bookFoldPrinting>, creating it if not present.
+func (e *CT_Settings) GetOrAddBookFoldPrinting() *CT_OnOff {
+	child := e.BookFoldPrinting()
+	if child != nil {
+		return child
+	}
+	return e.addBookFoldPrinting()
+}
+
+// RemoveBookFoldPrinting removes all <w:bookFoldPrinting> child elements.
+func (e *CT_Settings) RemoveBookFoldPrinting() {
+	e.RemoveAll("w:bookFoldPrinting")
+}
+
+// addBookFoldPrinting adds a new <w:bookFoldPrinting> in correct sequence.
+func (e *CT_Settings) addBookFoldPrinting() *CT_OnOff {
+	child := e.newBookFoldPrinting()
+	e.insertBookFoldPrinting(child)
+	return child
+}
+
+// newBookFoldPrinting creates a detached <w:bookFoldPrinting> element.
+func (e *CT_Settings) newBookFoldPrinting() *CT_OnOff {
+	el := OxmlElement("w:bookFoldPrinting")
+	return &CT_OnOff{Element{e: el}}
+}
+
+// insertBookFoldPrinting inserts child before first successor.
+func (e *CT_Settings) insertBookFoldPrinting(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.e, "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids")
+	return child
+}
+
+// BookFoldPrintingSheets returns the <w:bookFoldPrintingSheets> child element, or nil if not present.
+func (e *CT_Settings) BookFoldPrintingSheets() *CT_DecimalNumber {
+	child := e.FindChild("w:bookFoldPrintingSheets")
+	if child == nil {
+		return nil
+	}
+	return &CT_DecimalNumber{Element{e: child}}
+}
+
+// GetOrAddBookFoldPrintingSheets returns <w:bookFoldPrintingSheets>, creating it if not present.
+func (e *CT_Settings) GetOrAddBookFoldPrintingSheets() *CT_DecimalNumber {
+	child := e.BookFoldPrintingSheets()
+	if child != nil {
+		return child
+	}
+	return e.addBookFoldPrintingSheets()
+}
+
+// RemoveBookFoldPrintingSheets removes all <w:bookFoldPrintingSheets> child elements.
+func (e *CT_Settings) RemoveBookFoldPrintingSheets() {
+	e.RemoveAll("w:bookFoldPrintingSheets")
+}
+
+// addBookFoldPrintingSheets adds a new <w:bookFoldPrintingSheets> in correct sequence.
+func (e *CT_Settings) addBookFoldPrintingSheets() *CT_DecimalNumber {
+	child := e.newBookFoldPrintingSheets()
+	e.insertBookFoldPrintingSheets(child)
+	return child
+}
+
+// newBookFoldPrintingSheets creates a detached <w:bookFoldPrintingSheets> element.
+func (e *CT_Settings) newBookFoldPrintingSheets() *CT_DecimalNumber {
+	el := OxmlElement("w:bookFoldPrintingSheets")
+	return &CT_DecimalNumber{Element{e: el}}
+}
+
+// insertBookFoldPrintingSheets inserts child before first successor.
+func (e *CT_Settings) insertBookFoldPrintingSheets(child *CT_DecimalNumber) *CT_DecimalNumber {
+	e.InsertElementBefore(child.e, "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids")
+	return child
+}
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:settings",
+		children: []schemaChildRule{
+			{tag: "w:mirrorMargins", min: 0, max: 1, successors: []string{"w:alignBordersAndEdges", "w:bordersDoNotSurroundHeader", "w:bordersDoNotSurroundFooter", "w:gutterAtTop", "w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids"}},
+			{tag: "w:gutterAtTop", min: 0, max: 1, successors: []string{"w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids"}},
+			{tag: "w:autoHyphenation", min: 0, max: 1, successors: []string{"w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids"}},
+			{tag: "w:consecutiveHyphenLimit", min: 0, max: 1, successors: []string{"w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids"}},
+			{tag: "w:hyphenationZone", min: 0, max: 1, successors: []string{"w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids"}},
+			{tag: "w:doNotHyphenateCaps", min: 0, max: 1, successors: []string{"w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids"}},
+			{tag: "w:evenAndOddHeaders", min: 0, max: 1, successors: []string{"w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids"}},
+			{tag: "w:bookFoldRevPrinting", min: 0, max: 1, successors: []string{"w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids"}},
+			{tag: "w:bookFoldPrinting", min: 0, max: 1, successors: []string{"w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids"}},
+			{tag: "w:bookFoldPrintingSheets", min: 0, max: 1, successors: []string{"w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids"}},
+		},
+	})
+}
@@ -114,6 +114,96 @@ type CT_NonVisualDrawingProps struct {
 	Element
 }
 
+// HlinkClick returns the <a:hlinkClick> child element, or nil if not present.
+func (e *CT_NonVisualDrawingProps) HlinkClick() *CT_HyperlinkClick {
+	child := e.FindChild("a:hlinkClick")
+	if child == nil {
+		return nil
+	}
+	return &CT_HyperlinkClick{Element{e: child}}
+}
+
+// GetOrAddHlinkClick returns <a:hlinkClick>, creating it if not present.
+func (e *CT_NonVisualDrawingProps) GetOrAddHlinkClick() *CT_HyperlinkClick {
+	child := e.HlinkClick()
+	if child != nil {
+		return child
+	}
+	return e.addHlinkClick()
+}
+
+// RemoveHlinkClick removes all <a:hlinkClick> child elements.
+func (e *CT_NonVisualDrawingProps) RemoveHlinkClick() {
+	e.RemoveAll("a:hlinkClick")
+}
+
+// addHlinkClick adds a new <a:hlinkClick> in correct sequence.
+func (e *CT_NonVisualDrawingProps) addHlinkClick() *CT_HyperlinkClick {
+	child := e.newHlinkClick()
+	e.insertHlinkClick(child)
+	return child
+}
+
+// newHlinkClick creates a detached <a:hlinkClick> element.
+func (e *CT_NonVisualDrawingProps) newHlinkClick() *CT_HyperlinkClick {
+	el := OxmlElement("a:hlinkClick")
+	return &CT_HyperlinkClick{Element{e: el}}
+}
+
+// insertHlinkClick inserts child before first successor.
+func (e *CT_NonVisualDrawingProps) insertHlinkClick(child *CT_HyperlinkClick) *CT_HyperlinkClick {
+	e.InsertElementBefore(child.e, "a:hlinkHover", "a:extLst")
+	return child
+}
+
+// Descr returns the value of the "descr" attribute, or "" if absent.
+func (e *CT_NonVisualDrawingProps) Descr() string {
+	val, ok := e.GetAttr("descr")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetDescr sets the "descr" attribute.
+// Passing "" removes it.
+func (e *CT_NonVisualDrawingProps) SetDescr(v string) error {
+	if v == "" {
+		e.RemoveAttr("descr")
+		return nil
+	}
+	s, err := formatStringAttr(v)
+	if err != nil {
+		return fmt.Errorf("CT_NonVisualDrawingProps.SetDescr: %w", err)
+	}
+	e.SetAttr("descr", s)
+	return nil
+}
+
+// Title returns the value of the "title" attribute, or "" if absent.
+func (e *CT_NonVisualDrawingProps) Title() string {
+	val, ok := e.GetAttr("title")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetTitle sets the "title" attribute.
+// Passing "" removes it.
+func (e *CT_NonVisualDrawingProps) SetTitle(v string) error {
+	if v == "" {
+		e.RemoveAttr("title")
+		return nil
+	}
+	s, err := formatStringAttr(v)
+	if err != nil {
+		return fmt.Errorf("CT_NonVisualDrawingProps.SetTitle: %w", err)
+	}
+	e.SetAttr("title", s)
+	return nil
+}
+
 // Id returns the value of the required "id" attribute.
 func (e *CT_NonVisualDrawingProps) Id() (int, error) {
 	val, ok := e.GetAttr("id")
@@ -156,6 +246,37 @@ func (e *CT_NonVisualDrawingProps) SetName(v string) error {
 	return nil
 }
 
+// --- CT_HyperlinkClick ---
+
+// CT_HyperlinkClick — drawing click hyperlink element
+type CT_HyperlinkClick struct {
+	Element
+}
+
+// RId returns the value of the "r:id" attribute, or "" if absent.
+func (e *CT_HyperlinkClick) RId() string {
+	val, ok := e.GetAttr("r:id")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetRId sets the "r:id" attribute.
+// Passing "" removes it.
+func (e *CT_HyperlinkClick) SetRId(v string) error {
+	if v == "" {
+		e.RemoveAttr("r:id")
+		return nil
+	}
+	s, err := formatStringAttr(v)
+	if err != nil {
+		return fmt.Errorf("CT_HyperlinkClick.SetRId: %w", err)
+	}
+	e.SetAttr("r:id", s)
+	return nil
+}
+
 // --- CT_NonVisualPictureProperties ---
 
 // CT_NonVisualPictureProperties — non-visual picture-specific properties
@@ -297,6 +418,48 @@ func (e *CT_BlipFillProperties) insertBlip(child *CT_Blip) *CT_Blip {
 	return child
 }
 
+// SrcRect returns the <a:srcRect> child element, or nil if not present.
+func (e *CT_BlipFillProperties) SrcRect() *CT_RelativeRect {
+	child := e.FindChild("a:srcRect")
+	if child == nil {
+		return nil
+	}
+	return &CT_RelativeRect{Element{e: child}}
+}
+
+// GetOrAddSrcRect returns <a:srcRect>, creating it if not present.
+func (e *CT_BlipFillProperties) GetOrAddSrcRect() *CT_RelativeRect {
+	child := e.SrcRect()
+	if child != nil {
+		return child
+	}
+	return e.addSrcRect()
+}
+
+// RemoveSrcRect removes all <a:srcRect> child elements.
+func (e *CT_BlipFillProperties) RemoveSrcRect() {
+	e.RemoveAll("a:srcRect")
+}
+
+// addSrcRect adds a new <a:srcRect> in correct sequence.
+func (e *CT_BlipFillProperties) addSrcRect() *CT_RelativeRect {
+	child := e.newSrcRect()
+	e.insertSrcRect(child)
+	return child
+}
+
+// newSrcRect creates a detached <a:srcRect> element.
+func (e *CT_BlipFillProperties) newSrcRect() *CT_RelativeRect {
+	el := OxmlElement("a:srcRect")
+	return &CT_RelativeRect{Element{e: el}}
+}
+
+// insertSrcRect inserts child before first successor.
+func (e *CT_BlipFillProperties) insertSrcRect(child *CT_RelativeRect) *CT_RelativeRect {
+	e.InsertElementBefore(child.e, "a:tile", "a:stretch")
+	return child
+}
+
 // --- CT_Blip ---
 
 // CT_Blip — blip element specifying image source
@@ -492,6 +655,35 @@ func (e *CT_Transform2D) insertExt(child *CT_PositiveSize2D) *CT_PositiveSize2D
 	return child
 }
 
+// Rot returns the value of the "rot" attribute, or nil if absent.
+// Returns an error if the attribute is present but cannot be parsed.
+func (e *CT_Transform2D) Rot() (*int, error) {
+	val, ok := e.GetAttr("rot")
+	if !ok {
+		return nil, nil
+	}
+	parsed, err := parseIntAttr(val)
+	if err != nil {
+		return nil, &ParseAttrError{Element: e.Tag(), Attr: "rot", RawValue: val, Err: err}
+	}
+	return &parsed, nil
+}
+
+// SetRot sets the "rot" attribute.
+// Passing nil removes it.
+func (e *CT_Transform2D) SetRot(v *int) error {
+	if v == nil {
+		e.RemoveAttr("rot")
+		return nil
+	}
+	s, err := formatIntAttr(*v)
+	if err != nil {
+		return fmt.Errorf("CT_Transform2D.SetRot: %w", err)
+	}
+	e.SetAttr("rot", s)
+	return nil
+}
+
 // --- CT_PositiveSize2D ---
 
 // CT_PositiveSize2D — positive size 2D element
@@ -612,9 +804,221 @@ type CT_RelativeRect struct {
 	Element
 }
 
+// L returns the value of the "l" attribute, or nil if absent.
+// Returns an error if the attribute is present but cannot be parsed.
+func (e *CT_RelativeRect) L() (*int, error) {
+	val, ok := e.GetAttr("l")
+	if !ok {
+		return nil, nil
+	}
+	parsed, err := parseIntAttr(val)
+	if err != nil {
+		return nil, &ParseAttrError{Element: e.Tag(), Attr: "l", RawValue: val, Err: err}
+	}
+	return &parsed, nil
+}
+
+// SetL sets the "l" attribute.
+// Passing nil removes it.
+func (e *CT_RelativeRect) SetL(v *int) error {
+	if v == nil {
+		e.RemoveAttr("l")
+		return nil
+	}
+	s, err := formatIntAttr(*v)
+	if err != nil {
+		return fmt.Errorf("CT_RelativeRect.SetL: %w", err)
+	}
+	e.SetAttr("l", s)
+	return nil
+}
+
+// T returns the value of the "t" attribute, or nil if absent.
+// Returns an error if the attribute is present but cannot be parsed.
+func (e *CT_RelativeRect) T() (*int, error) {
+	val, ok := e.GetAttr("t")
+	if !ok {
+		return nil, nil
+	}
+	parsed, err := parseIntAttr(val)
+	if err != nil {
+		return nil, &ParseAttrError{Element: e.Tag(), Attr: "t", RawValue: val, Err: err}
+	}
+	return &parsed, nil
+}
+
+// SetT sets the "t" attribute.
+// Passing nil removes it.
+func (e *CT_RelativeRect) SetT(v *int) error {
+	if v == nil {
+		e.RemoveAttr("t")
+		return nil
+	}
+	s, err := formatIntAttr(*v)
+	if err != nil {
+		return fmt.Errorf("CT_RelativeRect.SetT: %w", err)
+	}
+	e.SetAttr("t", s)
+	return nil
+}
+
+// R returns the value of the "r" attribute, or nil if absent.
+// Returns an error if the attribute is present but cannot be parsed.
+func (e *CT_RelativeRect) R() (*int, error) {
+	val, ok := e.GetAttr("r")
+	if !ok {
+		return nil, nil
+	}
+	parsed, err := parseIntAttr(val)
+	if err != nil {
+		return nil, &ParseAttrError{Element: e.Tag(), Attr: "r", RawValue: val, Err: err}
+	}
+	return &parsed, nil
+}
+
+// SetR sets the "r" attribute.
+// Passing nil removes it.
+func (e *CT_RelativeRect) SetR(v *int) error {
+	if v == nil {
+		e.RemoveAttr("r")
+		return nil
+	}
+	s, err := formatIntAttr(*v)
+	if err != nil {
+		return fmt.Errorf("CT_RelativeRect.SetR: %w", err)
+	}
+	e.SetAttr("r", s)
+	return nil
+}
+
+// B returns the value of the "b" attribute, or nil if absent.
+// Returns an error if the attribute is present but cannot be parsed.
+func (e *CT_RelativeRect) B() (*int, error) {
+	val, ok := e.GetAttr("b")
+	if !ok {
+		return nil, nil
+	}
+	parsed, err := parseIntAttr(val)
+	if err != nil {
+		return nil, &ParseAttrError{Element: e.Tag(), Attr: "b", RawValue: val, Err: err}
+	}
+	return &parsed, nil
+}
+
+// SetB sets the "b" attribute.
+// Passing nil removes it.
+func (e *CT_RelativeRect) SetB(v *int) error {
+	if v == nil {
+		e.RemoveAttr("b")
+		return nil
+	}
+	s, err := formatIntAttr(*v)
+	if err != nil {
+		return fmt.Errorf("CT_RelativeRect.SetB: %w", err)
+	}
+	e.SetAttr("b", s)
+	return nil
+}
+
 // --- CT_StretchInfoProperties ---
 
 // CT_StretchInfoProperties — stretch info properties
 type CT_StretchInfoProperties struct {
 	Element
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "wp:inline",
+		children: []schemaChildRule{
+			{tag: "wp:extent", min: 1, max: 1},
+			{tag: "wp:docPr", min: 1, max: 1},
+			{tag: "a:graphic", min: 1, max: 1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "wp:anchor",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "pic:pic",
+		children: []schemaChildRule{
+			{tag: "pic:nvPicPr", min: 1, max: 1},
+			{tag: "pic:blipFill", min: 1, max: 1},
+			{tag: "pic:spPr", min: 1, max: 1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "pic:nvPicPr",
+		children: []schemaChildRule{
+			{tag: "pic:cNvPr", min: 1, max: 1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "wp:docPr",
+		children: []schemaChildRule{
+			{tag: "a:hlinkClick", min: 0, max: 1, successors: []string{"a:hlinkHover", "a:extLst"}},
+		},
+		requiredAttrs: []string{"id", "name"},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "a:hlinkClick",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "pic:cNvPicPr",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "a:graphic",
+		children: []schemaChildRule{
+			{tag: "a:graphicData", min: 1, max: 1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "a:graphicData",
+		children: []schemaChildRule{
+			{tag: "pic:pic", min: 0, max: 1},
+		},
+		requiredAttrs: []string{"uri"},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "pic:blipFill",
+		children: []schemaChildRule{
+			{tag: "a:blip", min: 0, max: 1, successors: []string{"a:srcRect", "a:tile", "a:stretch"}},
+			{tag: "a:srcRect", min: 0, max: 1, successors: []string{"a:tile", "a:stretch"}},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "a:blip",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "pic:spPr",
+		children: []schemaChildRule{
+			{tag: "a:xfrm", min: 0, max: 1, successors: []string{"a:custGeom", "a:prstGeom", "a:ln", "a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst"}},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "a:xfrm",
+		children: []schemaChildRule{
+			{tag: "a:off", min: 0, max: 1, successors: []string{"a:ext"}},
+			{tag: "a:ext", min: 0, max: 1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag:           "wp:extent",
+		requiredAttrs: []string{"cx", "cy"},
+	})
+	registerSchemaRule(schemaRule{
+		tag:           "a:off",
+		requiredAttrs: []string{"x", "y"},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "a:prstGeom",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "a:fillRect",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "a:stretch",
+	})
+}
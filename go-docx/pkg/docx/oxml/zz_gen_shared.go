@@ -95,3 +95,19 @@ func (e *CT_String) SetVal(v string) error {
 	e.SetAttr("w:val", s)
 	return nil
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag:           "w:decimalNumber",
+		requiredAttrs: []string{"w:val"},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:onOff",
+	})
+	registerSchemaRule(schemaRule{
+		tag:           "w:string",
+		requiredAttrs: []string{"w:val"},
+	})
+}
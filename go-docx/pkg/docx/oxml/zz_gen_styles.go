@@ -960,3 +960,40 @@ func (e *CT_LsdException) SetName(v string) error {
 	e.SetAttr("w:name", s)
 	return nil
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:styles",
+		children: []schemaChildRule{
+			{tag: "w:latentStyles", min: 0, max: 1, successors: []string{"w:style"}},
+			{tag: "w:style", min: 0, max: -1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:style",
+		children: []schemaChildRule{
+			{tag: "w:name", min: 0, max: 1, successors: []string{"w:aliases", "w:basedOn", "w:next", "w:link", "w:autoRedefine", "w:hidden", "w:uiPriority", "w:semiHidden", "w:unhideWhenUsed", "w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:basedOn", min: 0, max: 1, successors: []string{"w:next", "w:link", "w:autoRedefine", "w:hidden", "w:uiPriority", "w:semiHidden", "w:unhideWhenUsed", "w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:next", min: 0, max: 1, successors: []string{"w:link", "w:autoRedefine", "w:hidden", "w:uiPriority", "w:semiHidden", "w:unhideWhenUsed", "w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:uiPriority", min: 0, max: 1, successors: []string{"w:semiHidden", "w:unhideWhenUsed", "w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:semiHidden", min: 0, max: 1, successors: []string{"w:unhideWhenUsed", "w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:unhideWhenUsed", min: 0, max: 1, successors: []string{"w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:qFormat", min: 0, max: 1, successors: []string{"w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:locked", min: 0, max: 1, successors: []string{"w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:pPr", min: 0, max: 1, successors: []string{"w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:rPr", min: 0, max: 1, successors: []string{"w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:latentStyles",
+		children: []schemaChildRule{
+			{tag: "w:lsdException", min: 0, max: -1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag:           "w:lsdException",
+		requiredAttrs: []string{"w:name"},
+	})
+}
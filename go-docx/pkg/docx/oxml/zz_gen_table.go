@@ -245,6 +245,40 @@ func (e *CT_Tc) insertTcPr(child *CT_TcPr) *CT_TcPr {
 	return child
 }
 
+// TblList returns all <w:tbl> child elements.
+func (e *CT_Tc) TblList() []*CT_Tbl {
+	children := e.FindAllChildren("w:tbl")
+	result := make([]*CT_Tbl, len(children))
+	for i, c := range children {
+		result[i] = &CT_Tbl{Element{e: c}}
+	}
+	return result
+}
+
+// AddTbl adds a new <w:tbl> in correct sequence.
+func (e *CT_Tc) AddTbl() *CT_Tbl {
+	return e.addTbl()
+}
+
+// addTbl adds a new <w:tbl> unconditionally in correct sequence.
+func (e *CT_Tc) addTbl() *CT_Tbl {
+	child := e.newTbl()
+	e.insertTbl(child)
+	return child
+}
+
+// newTbl creates a detached <w:tbl> element.
+func (e *CT_Tc) newTbl() *CT_Tbl {
+	el := OxmlElement("w:tbl")
+	return &CT_Tbl{Element{e: el}}
+}
+
+// insertTbl inserts child before first successor.
+func (e *CT_Tc) insertTbl(child *CT_Tbl) *CT_Tbl {
+	e.InsertElementBefore(child.e)
+	return child
+}
+
 // PList returns all <w:p> child elements.
 // At least one must be present in valid XML.
 func (e *CT_Tc) PList() []*CT_P {
@@ -280,41 +314,6 @@ func (e *CT_Tc) insertP(child *CT_P) *CT_P {
 	return child
 }
 
-// TblList returns all <w:tbl> child elements.
-// At least one must be present in valid XML.
-func (e *CT_Tc) TblList() []*CT_Tbl {
-	children := e.FindAllChildren("w:tbl")
-	result := make([]*CT_Tbl, len(children))
-	for i, c := range children {
-		result[i] = &CT_Tbl{Element{e: c}}
-	}
-	return result
-}
-
-// AddTbl adds a new <w:tbl> in correct sequence.
-func (e *CT_Tc) AddTbl() *CT_Tbl {
-	return e.addTbl()
-}
-
-// addTbl adds a new <w:tbl> unconditionally in correct sequence.
-func (e *CT_Tc) addTbl() *CT_Tbl {
-	child := e.newTbl()
-	e.insertTbl(child)
-	return child
-}
-
-// newTbl creates a detached <w:tbl> element.
-func (e *CT_Tc) newTbl() *CT_Tbl {
-	el := OxmlElement("w:tbl")
-	return &CT_Tbl{Element{e: el}}
-}
-
-// insertTbl inserts child before first successor.
-func (e *CT_Tc) insertTbl(child *CT_Tbl) *CT_Tbl {
-	e.InsertElementBefore(child.e)
-	return child
-}
-
 // --- CT_TblPr ---
 
 // CT_TblPr — table properties element
@@ -1087,3 +1086,87 @@ func (e *CT_VMerge) SetVal(v string) error {
 	e.SetAttr("w:val", s)
 	return nil
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:tbl",
+		children: []schemaChildRule{
+			{tag: "w:tblPr", min: 1, max: 1},
+			{tag: "w:tblGrid", min: 1, max: 1},
+			{tag: "w:tr", min: 0, max: -1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:tr",
+		children: []schemaChildRule{
+			{tag: "w:tblPrEx", min: 0, max: 1, successors: []string{"w:trPr", "w:tc"}},
+			{tag: "w:trPr", min: 0, max: 1, successors: []string{"w:tc"}},
+			{tag: "w:tc", min: 0, max: -1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:tc",
+		children: []schemaChildRule{
+			{tag: "w:tcPr", min: 0, max: 1, successors: []string{"w:p", "w:tbl"}},
+			{tag: "w:p", min: 1, max: -1},
+			{tag: "w:tbl", min: 0, max: -1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:tblPr",
+		children: []schemaChildRule{
+			{tag: "w:tblStyle", min: 0, max: 1, successors: []string{"w:tblpPr", "w:tblOverlap", "w:bidiVisual", "w:tblStyleRowBandSize", "w:tblStyleColBandSize", "w:tblW", "w:jc", "w:tblCellSpacing", "w:tblInd", "w:tblBorders", "w:shd", "w:tblLayout", "w:tblCellMar", "w:tblLook", "w:tblCaption", "w:tblDescription", "w:tblPrChange"}},
+			{tag: "w:bidiVisual", min: 0, max: 1, successors: []string{"w:tblStyleRowBandSize", "w:tblStyleColBandSize", "w:tblW", "w:jc", "w:tblCellSpacing", "w:tblInd", "w:tblBorders", "w:shd", "w:tblLayout", "w:tblCellMar", "w:tblLook", "w:tblCaption", "w:tblDescription", "w:tblPrChange"}},
+			{tag: "w:jc", min: 0, max: 1, successors: []string{"w:tblCellSpacing", "w:tblInd", "w:tblBorders", "w:shd", "w:tblLayout", "w:tblCellMar", "w:tblLook", "w:tblCaption", "w:tblDescription", "w:tblPrChange"}},
+			{tag: "w:tblLayout", min: 0, max: 1, successors: []string{"w:tblCellMar", "w:tblLook", "w:tblCaption", "w:tblDescription", "w:tblPrChange"}},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:tcPr",
+		children: []schemaChildRule{
+			{tag: "w:tcW", min: 0, max: 1, successors: []string{"w:gridSpan", "w:hMerge", "w:vMerge", "w:tcBorders", "w:shd", "w:noWrap", "w:tcMar", "w:textDirection", "w:tcFitText", "w:vAlign", "w:hideMark", "w:headers", "w:cellIns", "w:cellDel", "w:cellMerge", "w:tcPrChange"}},
+			{tag: "w:gridSpan", min: 0, max: 1, successors: []string{"w:hMerge", "w:vMerge", "w:tcBorders", "w:shd", "w:noWrap", "w:tcMar", "w:textDirection", "w:tcFitText", "w:vAlign", "w:hideMark", "w:headers", "w:cellIns", "w:cellDel", "w:cellMerge", "w:tcPrChange"}},
+			{tag: "w:vMerge", min: 0, max: 1, successors: []string{"w:tcBorders", "w:shd", "w:noWrap", "w:tcMar", "w:textDirection", "w:tcFitText", "w:vAlign", "w:hideMark", "w:headers", "w:cellIns", "w:cellDel", "w:cellMerge", "w:tcPrChange"}},
+			{tag: "w:vAlign", min: 0, max: 1, successors: []string{"w:hideMark", "w:headers", "w:cellIns", "w:cellDel", "w:cellMerge", "w:tcPrChange"}},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:trPr",
+		children: []schemaChildRule{
+			{tag: "w:gridBefore", min: 0, max: 1, successors: []string{"w:gridAfter", "w:wBefore", "w:wAfter", "w:cantSplit", "w:trHeight", "w:tblHeader", "w:tblCellSpacing", "w:jc", "w:hidden", "w:ins", "w:del", "w:trPrChange"}},
+			{tag: "w:gridAfter", min: 0, max: 1, successors: []string{"w:wBefore", "w:wAfter", "w:cantSplit", "w:trHeight", "w:tblHeader", "w:tblCellSpacing", "w:jc", "w:hidden", "w:ins", "w:del", "w:trPrChange"}},
+			{tag: "w:trHeight", min: 0, max: 1, successors: []string{"w:tblHeader", "w:tblCellSpacing", "w:jc", "w:hidden", "w:ins", "w:del", "w:trPrChange"}},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:tblGrid",
+		children: []schemaChildRule{
+			{tag: "w:gridCol", min: 0, max: -1, successors: []string{"w:tblGridChange"}},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:gridCol",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:trHeight",
+	})
+	registerSchemaRule(schemaRule{
+		tag:           "w:tblW",
+		requiredAttrs: []string{"w:w", "w:type"},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:tblLayout",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:tblPrEx",
+	})
+	registerSchemaRule(schemaRule{
+		tag:           "w:vAlign",
+		requiredAttrs: []string{"w:val"},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:vMerge",
+	})
+}
@@ -121,3 +121,14 @@ func (e *CT_Hyperlink) SetHistory(v bool) error {
 	e.SetAttr("w:history", s)
 	return nil
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:hyperlink",
+		children: []schemaChildRule{
+			{tag: "w:r", min: 0, max: -1},
+		},
+	})
+}
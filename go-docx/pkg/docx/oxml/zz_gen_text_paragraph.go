@@ -125,3 +125,16 @@ func (e *CT_P) insertR(child *CT_R) *CT_R {
 	e.InsertElementBefore(child.e)
 	return child
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:p",
+		children: []schemaChildRule{
+			{tag: "w:pPr", min: 0, max: 1, successors: []string{"w:hyperlink", "w:r"}},
+			{tag: "w:hyperlink", min: 0, max: -1},
+			{tag: "w:r", min: 0, max: -1},
+		},
+	})
+}
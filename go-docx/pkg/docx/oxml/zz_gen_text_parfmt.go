@@ -311,6 +311,48 @@ func (e *CT_PPr) insertTabs(child *CT_TabStops) *CT_TabStops {
 	return child
 }
 
+// SuppressAutoHyphens returns the <w:suppressAutoHyphens> child element, or nil if not present.
+func (e *CT_PPr) SuppressAutoHyphens() *CT_OnOff {
+	child := e.FindChild("w:suppressAutoHyphens")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{e: child}}
+}
+
+// GetOrAddSuppressAutoHyphens returns <w:suppressAutoHyphens>, creating it if not present.
+func (e *CT_PPr) GetOrAddSuppressAutoHyphens() *CT_OnOff {
+	child := e.SuppressAutoHyphens()
+	if child != nil {
+		return child
+	}
+	return e.addSuppressAutoHyphens()
+}
+
+// RemoveSuppressAutoHyphens removes all <w:suppressAutoHyphens> child elements.
+func (e *CT_PPr) RemoveSuppressAutoHyphens() {
+	e.RemoveAll("w:suppressAutoHyphens")
+}
+
+// addSuppressAutoHyphens adds a new <w:suppressAutoHyphens> in correct sequence.
+func (e *CT_PPr) addSuppressAutoHyphens() *CT_OnOff {
+	child := e.newSuppressAutoHyphens()
+	e.insertSuppressAutoHyphens(child)
+	return child
+}
+
+// newSuppressAutoHyphens creates a detached <w:suppressAutoHyphens> element.
+func (e *CT_PPr) newSuppressAutoHyphens() *CT_OnOff {
+	el := OxmlElement("w:suppressAutoHyphens")
+	return &CT_OnOff{Element{e: el}}
+}
+
+// insertSuppressAutoHyphens inserts child before first successor.
+func (e *CT_PPr) insertSuppressAutoHyphens(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.e, "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange")
+	return child
+}
+
 // Spacing returns the <w:spacing> child element, or nil if not present.
 func (e *CT_PPr) Spacing() *CT_Spacing {
 	child := e.FindChild("w:spacing")
@@ -1006,3 +1048,53 @@ func (e *CT_NumPr) insertNumId(child *CT_DecimalNumber) *CT_DecimalNumber {
 	e.InsertElementBefore(child.e, "w:numberingChange", "w:ins")
 	return child
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:pPr",
+		children: []schemaChildRule{
+			{tag: "w:pStyle", min: 0, max: 1, successors: []string{"w:keepNext", "w:keepLines", "w:pageBreakBefore", "w:framePr", "w:widowControl", "w:numPr", "w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:keepNext", min: 0, max: 1, successors: []string{"w:keepLines", "w:pageBreakBefore", "w:framePr", "w:widowControl", "w:numPr", "w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:keepLines", min: 0, max: 1, successors: []string{"w:pageBreakBefore", "w:framePr", "w:widowControl", "w:numPr", "w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:pageBreakBefore", min: 0, max: 1, successors: []string{"w:framePr", "w:widowControl", "w:numPr", "w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:widowControl", min: 0, max: 1, successors: []string{"w:numPr", "w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:numPr", min: 0, max: 1, successors: []string{"w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:tabs", min: 0, max: 1, successors: []string{"w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:suppressAutoHyphens", min: 0, max: 1, successors: []string{"w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:spacing", min: 0, max: 1, successors: []string{"w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:ind", min: 0, max: 1, successors: []string{"w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:jc", min: 0, max: 1, successors: []string{"w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:outlineLvl", min: 0, max: 1, successors: []string{"w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:sectPr", min: 0, max: 1, successors: []string{"w:pPrChange"}},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:ind",
+	})
+	registerSchemaRule(schemaRule{
+		tag:           "w:jc",
+		requiredAttrs: []string{"w:val"},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:spacing",
+	})
+	registerSchemaRule(schemaRule{
+		tag:           "w:tab",
+		requiredAttrs: []string{"w:val", "w:pos"},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:tabs",
+		children: []schemaChildRule{
+			{tag: "w:tab", min: 1, max: -1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:numPr",
+		children: []schemaChildRule{
+			{tag: "w:ilvl", min: 0, max: 1, successors: []string{"w:numId", "w:numberingChange", "w:ins"}},
+			{tag: "w:numId", min: 0, max: 1, successors: []string{"w:numberingChange", "w:ins"}},
+		},
+	})
+}
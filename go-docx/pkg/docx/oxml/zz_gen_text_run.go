@@ -310,3 +310,34 @@ type CT_PTab struct {
 type CT_Text struct {
 	Element
 }
+
+// --- Schema rules ---
+
+func init() {
+	registerSchemaRule(schemaRule{
+		tag: "w:r",
+		children: []schemaChildRule{
+			{tag: "w:rPr", min: 0, max: 1, successors: []string{"w:br", "w:cr", "w:drawing", "w:noBreakHyphen", "w:ptab", "w:t", "w:tab"}},
+			{tag: "w:br", min: 0, max: -1},
+			{tag: "w:cr", min: 0, max: -1},
+			{tag: "w:drawing", min: 0, max: -1},
+			{tag: "w:t", min: 0, max: -1},
+			{tag: "w:tab", min: 0, max: -1},
+		},
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:br",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:cr",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:noBreakHyphen",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:ptab",
+	})
+	registerSchemaRule(schemaRule{
+		tag: "w:t",
+	})
+}
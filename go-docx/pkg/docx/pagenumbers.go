@@ -0,0 +1,95 @@
+package docx
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// PageNumberFormat is how the pages of a section are numbered, as in
+// Word's Page Number Format dialog.
+type PageNumberFormat struct {
+	// Format is the number format: "decimal", "lowerRoman", "upperRoman",
+	// "lowerLetter", "upperLetter" or another ST_NumberFormat value. Empty
+	// means decimal.
+	Format string
+	// Start restarts the numbering at this value; nil continues from the
+	// previous section.
+	Start *int
+	// ChapterStyle, if not zero, is the heading level (1-9) whose number
+	// prefixes the page numbers, as in "2-5".
+	ChapterStyle int
+	// ChapterSeparator separates the chapter number from the page number:
+	// "hyphen" (the default), "period", "colon", "emDash" or "enDash".
+	ChapterSeparator string
+}
+
+// PageNumberFormat returns the page numbering of this section, or nil if
+// the section numbers its pages in decimal, continuing from the previous
+// section.
+func (s *Section) PageNumberFormat() (*PageNumberFormat, error) {
+	el := s.sectPr.RawElement().SelectElement("w:pgNumType")
+	if el == nil {
+		return nil, nil
+	}
+	f := &PageNumberFormat{
+		Format:           el.SelectAttrValue("w:fmt", ""),
+		ChapterSeparator: el.SelectAttrValue("w:chapSep", ""),
+	}
+	if v := el.SelectAttrValue("w:start", ""); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("docx: invalid page number start %q", v)
+		}
+		f.Start = &n
+	}
+	if v := el.SelectAttrValue("w:chapStyle", ""); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("docx: invalid chapter style %q", v)
+		}
+		f.ChapterStyle = n
+	}
+	return f, nil
+}
+
+// SetPageNumberFormat sets the page numbering of this section. Passing nil
+// goes back to decimal numbers continuing from the previous section.
+//
+// Front matter numbered i, ii, iii followed by a body starting at 1 takes a
+// "lowerRoman" format on the first section and a Start of 1 on the next.
+func (s *Section) SetPageNumberFormat(f *PageNumberFormat) error {
+	if f != nil {
+		if f.ChapterStyle < 0 || f.ChapterStyle > 9 {
+			return fmt.Errorf("docx: chapter style %d out of range 1-9", f.ChapterStyle)
+		}
+		if !validAlign(f.ChapterSeparator, "hyphen", "period", "colon", "emDash", "enDash") {
+			return fmt.Errorf("docx: invalid chapter separator %q", f.ChapterSeparator)
+		}
+	}
+	sectPr := s.sectPr.RawElement()
+	if el := sectPr.SelectElement("w:pgNumType"); el != nil {
+		sectPr.RemoveChild(el)
+	}
+	if f == nil {
+		return nil
+	}
+	el := oxml.OxmlElement("w:pgNumType")
+	if f.Format != "" {
+		el.CreateAttr("w:fmt", f.Format)
+	}
+	if f.Start != nil {
+		el.CreateAttr("w:start", strconv.Itoa(*f.Start))
+	}
+	if f.ChapterStyle != 0 {
+		el.CreateAttr("w:chapStyle", strconv.Itoa(f.ChapterStyle))
+		if f.ChapterSeparator != "" {
+			el.CreateAttr("w:chapSep", f.ChapterSeparator)
+		}
+	}
+	s.sectPr.InsertElementBefore(el,
+		"w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection",
+		"w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange")
+	return nil
+}
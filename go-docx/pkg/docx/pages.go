@@ -0,0 +1,135 @@
+package docx
+
+import (
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// Page is the content of one page of the body as Word last laid it out.
+type Page struct {
+	// Number is the position of the page in the document, from 1.
+	Number int
+	// Paragraphs are the paragraphs on the page, including those in
+	// tables, in document order. A paragraph split across pages appears
+	// on each of them as a fragment: a detached copy holding the part on
+	// that page. Paragraphs that are not split are the document's own.
+	Paragraphs []*Paragraph
+}
+
+// Text returns the text of the page, one line per paragraph.
+func (pg *Page) Text() string {
+	texts := make([]string, len(pg.Paragraphs))
+	for i, p := range pg.Paragraphs {
+		texts[i] = p.Text()
+	}
+	return strings.Join(texts, "\n")
+}
+
+// Pages splits the body into pages at the rendered page breaks
+// (w:lastRenderedPageBreak) Word records where it last broke pages when
+// saving. This recovers the pagination the author saw without laying the
+// document out again; it is exact for documents saved by Word and not
+// edited since. A document never saved by Word is a single page; see
+// EstimateLayout for an estimate in that case.
+func (d *Document) Pages() ([]*Page, error) {
+	b, err := d.getBody()
+	if err != nil {
+		return nil, err
+	}
+	ps := &pageSplitter{d: d, pages: []*Page{{Number: 1}}}
+	ps.blocks(b.element)
+	return ps.pages, nil
+}
+
+// pageSplitter distributes paragraphs and their fragments over pages.
+type pageSplitter struct {
+	d     *Document
+	pages []*Page
+}
+
+// page returns page n, adding pages up to it.
+func (ps *pageSplitter) page(n int) *Page {
+	for len(ps.pages) < n {
+		ps.pages = append(ps.pages, &Page{Number: len(ps.pages) + 1})
+	}
+	return ps.pages[n-1]
+}
+
+// blocks adds the paragraphs and tables in container, starting on the
+// last page.
+func (ps *pageSplitter) blocks(container *etree.Element) {
+	for _, child := range container.ChildElements() {
+		switch {
+		case child.Space != "w":
+		case child.Tag == "p":
+			ps.paragraph(child, len(ps.pages))
+		case child.Tag == "tbl":
+			ps.table(child, len(ps.pages))
+		case child.Tag == "sdt":
+			if content := child.SelectElement("w:sdtContent"); content != nil {
+				ps.blocks(content)
+			}
+		case child.Tag == "customXml":
+			ps.blocks(child)
+		}
+	}
+}
+
+// table adds the rows of tbl starting on page n and returns the page it
+// ends on. The cells of a row that crosses a page boundary each record the
+// break, so a row advances by the most breaks found in any one cell.
+func (ps *pageSplitter) table(tbl *etree.Element, n int) int {
+	for _, tr := range tbl.SelectElements("w:tr") {
+		end := n
+		for _, tc := range tr.SelectElements("w:tc") {
+			page := n
+			for _, child := range tc.ChildElements() {
+				switch {
+				case child.Space != "w":
+				case child.Tag == "p":
+					page = ps.paragraph(child, page)
+				case child.Tag == "tbl":
+					page = ps.table(child, page)
+				}
+			}
+			end = max(end, page)
+		}
+		n = end
+		ps.page(n)
+	}
+	return n
+}
+
+// paragraph adds paragraph p, or its fragments, starting on page n, and
+// returns the page it ends on.
+func (ps *pageSplitter) paragraph(p *etree.Element, n int) int {
+	part := &ps.d.part.StoryPart
+	cur := &oxml.CT_P{Element: oxml.WrapElement(p)}
+	for {
+		breaks := cur.LastRenderedPageBreaks()
+		if len(breaks) == 0 {
+			pg := ps.page(n)
+			pg.Paragraphs = append(pg.Paragraphs, newParagraph(cur, part))
+			return n
+		}
+		lrpb := breaks[0]
+		if !lrpb.PrecedesAllContent() {
+			if frag, err := lrpb.PrecedingFragmentP(); err == nil {
+				pg := ps.page(n)
+				pg.Paragraphs = append(pg.Paragraphs, newParagraph(frag, part))
+			}
+		}
+		n++
+		ps.page(n)
+		if lrpb.FollowsAllContent() {
+			return n
+		}
+		next, err := lrpb.FollowingFragmentP()
+		if err != nil {
+			return n
+		}
+		cur = next
+	}
+}
@@ -0,0 +1,87 @@
+package docx
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/beevik/etree"
+)
+
+// addRenderedText appends a run to p holding text, with a rendered page
+// break wherever text has a "|".
+func addRenderedText(p *etree.Element, text string) {
+	r := p.CreateElement("w:r")
+	for i, s := range strings.Split(text, "|") {
+		if i > 0 {
+			r.CreateElement("w:lastRenderedPageBreak")
+		}
+		if s != "" {
+			r.CreateElement("w:t").SetText(s)
+		}
+	}
+}
+
+func TestDocument_Pages(t *testing.T) {
+	d := mustNewDoc(t)
+	add := func(text string) *Paragraph {
+		t.Helper()
+		p, err := d.AddParagraph("")
+		if err != nil {
+			t.Fatal(err)
+		}
+		addRenderedText(p.p.RawElement(), text)
+		return p
+	}
+	first := add("one")
+	add("two a |two b")
+	add("|three")
+	tbl, err := d.AddTable(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cells := tbl.Rows().Iter()[0].Cells()
+	addRenderedText(cells[0].Paragraphs()[0].p.RawElement(), "c1 |c1 next")
+	addRenderedText(cells[1].Paragraphs()[0].p.RawElement(), "c2 |c2 next")
+	add("four|five|six")
+
+	pages, err := d.Pages()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"one\ntwo a ",
+		"two b",
+		"three\nc1 \nc2 ",
+		"c1 next\nc2 next\nfour",
+		"five",
+		"six",
+	}
+	if len(pages) != len(want) {
+		t.Fatalf("got %d pages, want %d", len(pages), len(want))
+	}
+	for i, pg := range pages {
+		if pg.Number != i+1 {
+			t.Errorf("page %d: Number = %d", i, pg.Number)
+		}
+		if got := pg.Text(); got != want[i] {
+			t.Errorf("page %d text = %q, want %q", i+1, got, want[i])
+		}
+	}
+	if pages[0].Paragraphs[0].p.RawElement() != first.p.RawElement() {
+		t.Error("unsplit paragraph is not the document's own")
+	}
+}
+
+func TestDocument_Pages_NoBreaks(t *testing.T) {
+	d := mustNewDoc(t)
+	if _, err := d.AddParagraph("alpha"); err != nil {
+		t.Fatal(err)
+	}
+	pages, err := d.Pages()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pages) != 1 || pages[0].Text() != "alpha" {
+		t.Fatalf("pages = %d, first %q", len(pages), pages[0].Text())
+	}
+}
@@ -0,0 +1,117 @@
+package docx
+
+import (
+	"github.com/vortex/go-docx/pkg/docx/enum"
+)
+
+// PageSetup is a page setup profile: the settings of Word's Page Setup
+// dialog that a section takes in one call with ApplyPageSetup. Nil fields
+// are left as they are in the section.
+type PageSetup struct {
+	// Size is the paper size in portrait orientation.
+	Size *PageSize
+	// Orientation turns Size to landscape; it applies only with Size.
+	Orientation enum.WdOrientation
+	// TopMargin, BottomMargin, LeftMargin and RightMargin are the page
+	// margins.
+	TopMargin, BottomMargin, LeftMargin, RightMargin *Length
+	// HeaderDistance and FooterDistance are the distances of the header and
+	// footer from the page edge.
+	HeaderDistance, FooterDistance *Length
+	// Gutter is the extra margin left for binding.
+	Gutter *Length
+}
+
+// PageSetup returns the page setup of this section, for applying to other
+// sections. Settings the section does not specify are nil.
+func (s *Section) PageSetup() (*PageSetup, error) {
+	var ps PageSetup
+	var err error
+	if ps.Size, err = s.PageSize(); err != nil {
+		return nil, err
+	}
+	if ps.Orientation, err = s.Orientation(); err != nil {
+		return nil, err
+	}
+	for _, f := range []struct {
+		dst *(*Length)
+		get func() (*Length, error)
+	}{
+		{&ps.TopMargin, s.TopMarginLength},
+		{&ps.BottomMargin, s.BottomMarginLength},
+		{&ps.LeftMargin, s.LeftMarginLength},
+		{&ps.RightMargin, s.RightMarginLength},
+		{&ps.HeaderDistance, s.HeaderDistanceLength},
+		{&ps.FooterDistance, s.FooterDistanceLength},
+		{&ps.Gutter, s.GutterLength},
+	} {
+		if *f.dst, err = f.get(); err != nil {
+			return nil, err
+		}
+	}
+	return &ps, nil
+}
+
+// ApplyPageSetup gives this section the non-nil settings of ps.
+func (s *Section) ApplyPageSetup(ps *PageSetup) error {
+	if ps == nil {
+		return nil
+	}
+	if ps.Size != nil {
+		if err := s.SetPageSize(*ps.Size, ps.Orientation); err != nil {
+			return err
+		}
+	}
+	for _, f := range []struct {
+		v   *Length
+		set func(*Length) error
+	}{
+		{ps.TopMargin, s.SetTopMarginLength},
+		{ps.BottomMargin, s.SetBottomMarginLength},
+		{ps.LeftMargin, s.SetLeftMarginLength},
+		{ps.RightMargin, s.SetRightMarginLength},
+		{ps.HeaderDistance, s.SetHeaderDistanceLength},
+		{ps.FooterDistance, s.SetFooterDistanceLength},
+		{ps.Gutter, s.SetGutterLength},
+	} {
+		if f.v == nil {
+			continue
+		}
+		if err := f.set(f.v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sectPrOwnTags are the section property children that CopyFormatFrom
+// leaves alone: the header and footer references and the printer settings
+// refer to parts of the section's own document, and tracked changes belong
+// to the section they were made in.
+var sectPrOwnTags = map[string]bool{
+	"headerReference": true,
+	"footerReference": true,
+	"printerSettings": true,
+	"sectPrChange":    true,
+}
+
+// CopyFormatFrom replaces the formatting of this section with that of
+// other: page size and margins, columns, borders, line numbering, page
+// numbering, vertical alignment, start type and the like. The section keeps
+// its own headers and footers; other may belong to another document.
+func (s *Section) CopyFormatFrom(other *Section) {
+	dst, src := s.sectPr.RawElement(), other.sectPr.RawElement()
+	if dst == src {
+		return
+	}
+	for _, child := range dst.ChildElements() {
+		if !sectPrOwnTags[child.Tag] {
+			dst.RemoveChild(child)
+		}
+	}
+	for _, child := range src.ChildElements() {
+		if !sectPrOwnTags[child.Tag] {
+			s.sectPr.InsertElementBefore(child.Copy(), "w:printerSettings", "w:sectPrChange")
+		}
+	}
+}
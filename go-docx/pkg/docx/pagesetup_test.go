@@ -0,0 +1,93 @@
+package docx
+
+import (
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+)
+
+func TestSection_PageSetupRoundTrip(t *testing.T) {
+	src := newSection(makeSectPr(t, ``), nil)
+	if err := src.ApplyPageSetup(&PageSetup{
+		Size:           &PageSizeA4,
+		Orientation:    enum.WdOrientationLandscape,
+		TopMargin:      Ptr(Inches(1)),
+		LeftMargin:     Ptr(Inches(1.5)),
+		HeaderDistance: Ptr(Inches(0.5)),
+		Gutter:         Ptr(Inches(0.25)),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	ps, err := src.PageSetup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ps.Size == nil || ps.Size.Name != "A4" || ps.Orientation != enum.WdOrientationLandscape {
+		t.Errorf("Size = %+v, Orientation = %v", ps.Size, ps.Orientation)
+	}
+	if ps.BottomMargin != nil || ps.FooterDistance != nil {
+		t.Errorf("unset settings = %v, %v, want nil", ps.BottomMargin, ps.FooterDistance)
+	}
+
+	dst := newSection(makeSectPr(t, `<w:pgMar w:top="720" w:bottom="720" w:left="720" w:right="720"/>`), nil)
+	if err := dst.ApplyPageSetup(ps); err != nil {
+		t.Fatal(err)
+	}
+	w, _ := dst.PageWidthLength()
+	if w == nil || w.Twips() != 16838 {
+		t.Errorf("PageWidth = %v, want 16838 twips", w)
+	}
+	for _, tt := range []struct {
+		name string
+		get  func() (*Length, error)
+		want int
+	}{
+		{"TopMargin", dst.TopMarginLength, 1440},
+		{"LeftMargin", dst.LeftMarginLength, 2160},
+		{"HeaderDistance", dst.HeaderDistanceLength, 720},
+		{"Gutter", dst.GutterLength, 360},
+	} {
+		if v, err := tt.get(); err != nil || v == nil || v.Twips() != tt.want {
+			t.Errorf("%s = %v, %v; want %d twips", tt.name, v, err, tt.want)
+		}
+	}
+	if v, _ := dst.BottomMarginLength(); v == nil || v.Twips() != 720 {
+		t.Errorf("BottomMargin = %v, want it left at 720 twips", v)
+	}
+}
+
+func TestSection_CopyFormatFrom(t *testing.T) {
+	src := newSection(makeSectPr(t,
+		`<w:type w:val="evenPage"/><w:pgSz w:w="11906" w:h="16838"/>`+
+			`<w:pgMar w:top="1000" w:bottom="1000" w:left="1000" w:right="1000"/>`+
+			`<w:cols w:num="2"/><w:vAlign w:val="center"/>`), nil)
+	dst := newSection(makeSectPr(t,
+		`<w:headerReference w:type="default" r:id="rId7" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"/>`+
+			`<w:pgSz w:w="12240" w:h="15840"/><w:titlePg/><w:docGrid w:linePitch="360"/>`), nil)
+
+	dst.CopyFormatFrom(src)
+
+	var tags []string
+	for _, child := range dst.sectPr.RawElement().ChildElements() {
+		tags = append(tags, child.Tag)
+	}
+	want := []string{"headerReference", "type", "pgSz", "pgMar", "cols", "vAlign"}
+	if len(tags) != len(want) {
+		t.Fatalf("children = %v, want %v", tags, want)
+	}
+	for i := range want {
+		if tags[i] != want[i] {
+			t.Fatalf("children = %v, want %v", tags, want)
+		}
+	}
+	if st, _ := dst.StartType(); st != enum.WdSectionStartEvenPage {
+		t.Errorf("StartType = %v, want EVEN_PAGE", st)
+	}
+	// The copies are independent of the source.
+	if err := dst.SetTopMarginLength(Ptr(Twips(500))); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := src.TopMarginLength(); v == nil || v.Twips() != 1000 {
+		t.Errorf("source TopMargin = %v, want 1000 twips", v)
+	}
+}
@@ -0,0 +1,72 @@
+package docx
+
+import (
+	"fmt"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+)
+
+// PageSize is a paper size, with Width and Height given in portrait
+// orientation.
+type PageSize struct {
+	Name          string
+	Width, Height Length
+}
+
+// Common paper sizes, with the exact twip values Word writes for them.
+var (
+	PageSizeA3     = PageSize{Name: "A3", Width: Twips(16838), Height: Twips(23811)}
+	PageSizeA4     = PageSize{Name: "A4", Width: Twips(11906), Height: Twips(16838)}
+	PageSizeA5     = PageSize{Name: "A5", Width: Twips(8391), Height: Twips(11906)}
+	PageSizeLetter = PageSize{Name: "Letter", Width: Twips(12240), Height: Twips(15840)}
+	PageSizeLegal  = PageSize{Name: "Legal", Width: Twips(12240), Height: Twips(20160)}
+)
+
+// PageSizes lists the preset paper sizes.
+var PageSizes = []PageSize{PageSizeA3, PageSizeA4, PageSizeA5, PageSizeLetter, PageSizeLegal}
+
+// SetPageSize sets the page size of this section to size in the given
+// orientation, swapping width and height for landscape.
+func (s *Section) SetPageSize(size PageSize, orientation enum.WdOrientation) error {
+	if size.Width <= 0 || size.Height <= 0 {
+		return fmt.Errorf("docx: invalid page size %v x %v", size.Width, size.Height)
+	}
+	w, h := size.Width, size.Height
+	if orientation == enum.WdOrientationLandscape {
+		w, h = h, w
+	}
+	if err := s.SetPageWidthLength(&w); err != nil {
+		return err
+	}
+	if err := s.SetPageHeightLength(&h); err != nil {
+		return err
+	}
+	return s.SetOrientation(orientation)
+}
+
+// PageSize returns the page size of this section in portrait orientation.
+// The Name is set when the size matches one of PageSizes in either
+// orientation. Returns nil when the section does not specify a page size.
+func (s *Section) PageSize() (*PageSize, error) {
+	w, err := s.PageWidthLength()
+	if err != nil {
+		return nil, err
+	}
+	h, err := s.PageHeightLength()
+	if err != nil {
+		return nil, err
+	}
+	if w == nil || h == nil {
+		return nil, nil
+	}
+	size := PageSize{Width: *w, Height: *h}
+	if size.Width > size.Height {
+		size.Width, size.Height = size.Height, size.Width
+	}
+	for _, preset := range PageSizes {
+		if preset.Width.Twips() == size.Width.Twips() && preset.Height.Twips() == size.Height.Twips() {
+			return &preset, nil
+		}
+	}
+	return &size, nil
+}
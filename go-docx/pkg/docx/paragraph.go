@@ -2,6 +2,7 @@ package docx
 
 import (
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/vortex/go-docx/pkg/docx/enum"
 	"github.com/vortex/go-docx/pkg/docx/oxml"
@@ -68,6 +69,14 @@ func (para *Paragraph) ContainsPageBreak() bool {
 	return len(para.p.LastRenderedPageBreaks()) > 0
 }
 
+// IsSectionBreak reports whether the paragraph ends a section: it carries
+// the section break (w:sectPr) holding the page setup of the section it
+// ends, and the next block starts a new section.
+func (para *Paragraph) IsSectionBreak() bool {
+	pPr := para.p.PPr()
+	return pPr != nil && pPr.SectPr() != nil
+}
+
 // Hyperlinks returns a Hyperlink for each hyperlink in this paragraph.
 //
 // Mirrors Python Paragraph.hyperlinks.
@@ -102,6 +111,9 @@ func (para *Paragraph) InsertParagraphBefore(text string, style ...StyleRef) (*P
 			return nil, err
 		}
 	}
+	if err := paragraphAdded(p); err != nil {
+		return nil, err
+	}
 	return p, nil
 }
 
@@ -140,17 +152,42 @@ func (para *Paragraph) RenderedPageBreaks() []*RenderedPageBreak {
 	return result
 }
 
-// Runs returns all runs in this paragraph.
+// Runs returns all runs in this paragraph, including those in the read
+// branch of mc:AlternateContent.
 //
 // Mirrors Python Paragraph.runs.
 func (para *Paragraph) Runs() []*Run {
 	var result []*Run
-	for _, r := range para.p.RList() {
-		result = append(result, newRun(r, para.part))
+	for _, item := range para.p.InnerContentElements() {
+		if r, ok := item.(*oxml.CT_R); ok {
+			result = append(result, newRun(r, para.part))
+		}
 	}
 	return result
 }
 
+// IsolateRuns splits the runs of the paragraph so that the text between
+// byte offsets start and end is held by whole runs, and returns those runs
+// in document order. A run split in two keeps its formatting in both
+// halves. The offsets index the text of the paragraph's runs, including
+// those in hyperlinks and inline content controls, which is Text() for a
+// paragraph without content controls.
+func (para *Paragraph) IsolateRuns(start, end int) ([]*Run, error) {
+	p := para.p.RawElement()
+	text := oxml.InlineText(p)
+	if start < 0 || start >= end || end > len(text) {
+		return nil, fmt.Errorf("docx: text range [%d, %d) out of range (len=%d): %w", start, end, len(text), ErrInvalidIndex)
+	}
+	if !utf8.RuneStart(text[start]) || end < len(text) && !utf8.RuneStart(text[end]) {
+		return nil, fmt.Errorf("docx: text range [%d, %d) splits a character", start, end)
+	}
+	var result []*Run
+	for _, r := range oxml.IsolateRuns(p, start, end) {
+		result = append(result, newRun(&oxml.CT_R{Element: oxml.WrapElement(r)}, para.part))
+	}
+	return result, nil
+}
+
 // Style returns the paragraph style, delegating to the part for resolution.
 //
 // Mirrors Python Paragraph.style (getter).
@@ -162,7 +199,13 @@ func (para *Paragraph) Style() (*oxml.CT_Style, error) {
 	return para.part.GetStyle(styleID, enum.WdStyleTypeParagraph)
 }
 
-// SetStyle sets the paragraph style. style can be a string name or nil.
+// SetStyle sets the paragraph style, given by its UI name (a StyleName),
+// as a style object, or nil for the default paragraph style. Built-in
+// names such as "Heading 1" are translated to their internal spelling. A
+// character style linked to a paragraph style applies that paragraph
+// style, as in Word. An unknown name gives an error matching
+// ErrStyleNotFound, and a style of another type one matching
+// ErrWrongStyleType.
 //
 // Mirrors Python Paragraph.style (setter).
 func (para *Paragraph) SetStyle(style StyleRef) error {
@@ -175,14 +218,29 @@ func (para *Paragraph) setStyleRaw(raw any) error {
 	if err != nil {
 		return err
 	}
-	return para.p.SetStyle(styleID)
+	prev, err := para.p.Style()
+	if err != nil {
+		return err
+	}
+	if err := para.p.SetStyle(styleID); err != nil {
+		return err
+	}
+	return styleApplied(para.part, para, styleID, func() error { return para.p.SetStyle(prev) })
 }
 
-// Text returns the full textual content of this paragraph.
+// Text returns the full textual content of this paragraph. Options set
+// what tabs, breaks and drawings read as, see TextOption.
 //
 // Mirrors Python Paragraph.text (getter).
-func (para *Paragraph) Text() string {
-	return para.p.ParagraphText()
+func (para *Paragraph) Text(opts ...TextOption) string {
+	if len(opts) == 0 {
+		return para.p.ParagraphText()
+	}
+	conv := newTextConversion(opts)
+	if conv.LeaderWidth > 0 {
+		conv.TabLeaders = para.tabLeaders()
+	}
+	return para.p.ParagraphTextWith(conv)
 }
 
 // SetText replaces all paragraph content with a single run containing text.
@@ -59,11 +59,26 @@ func (pf *ParagraphFormat) FirstLineIndent() (*int, error) {
 	return pPr.FirstLineIndent()
 }
 
+// FirstLineIndentLength returns the first-line indent as a Length, or nil
+// if inherited. A negative value is a hanging indent.
+func (pf *ParagraphFormat) FirstLineIndentLength() (*Length, error) {
+	pPr := pf.provider.PPr()
+	if pPr == nil {
+		return nil, nil
+	}
+	return twipsLength(pPr.FirstLineIndent())
+}
+
 // SetFirstLineIndent sets the first-line indent in twips. Passing nil removes it.
 func (pf *ParagraphFormat) SetFirstLineIndent(v *int) error {
 	return pf.provider.GetOrAddPPr().SetFirstLineIndent(v)
 }
 
+// SetFirstLineIndentLength sets the first-line indent. Passing nil removes it.
+func (pf *ParagraphFormat) SetFirstLineIndentLength(v *Length) error {
+	return pf.provider.GetOrAddPPr().SetFirstLineIndent(lengthTwips(v))
+}
+
 // KeepTogether returns the tri-state keep-together value, or nil if inherited.
 func (pf *ParagraphFormat) KeepTogether() *bool {
 	pPr := pf.provider.PPr()
@@ -101,11 +116,25 @@ func (pf *ParagraphFormat) LeftIndent() (*int, error) {
 	return pPr.IndLeft()
 }
 
+// LeftIndentLength returns the left indent as a Length, or nil if inherited.
+func (pf *ParagraphFormat) LeftIndentLength() (*Length, error) {
+	pPr := pf.provider.PPr()
+	if pPr == nil {
+		return nil, nil
+	}
+	return twipsLength(pPr.IndLeft())
+}
+
 // SetLeftIndent sets the left indent in twips. Passing nil removes it.
 func (pf *ParagraphFormat) SetLeftIndent(v *int) error {
 	return pf.provider.GetOrAddPPr().SetIndLeft(v)
 }
 
+// SetLeftIndentLength sets the left indent. Passing nil removes it.
+func (pf *ParagraphFormat) SetLeftIndentLength(v *Length) error {
+	return pf.provider.GetOrAddPPr().SetIndLeft(lengthTwips(v))
+}
+
 // RightIndent returns the right indent in twips, or nil if inherited.
 func (pf *ParagraphFormat) RightIndent() (*int, error) {
 	pPr := pf.provider.PPr()
@@ -115,11 +144,26 @@ func (pf *ParagraphFormat) RightIndent() (*int, error) {
 	return pPr.IndRight()
 }
 
+// RightIndentLength returns the right indent as a Length, or nil if
+// inherited.
+func (pf *ParagraphFormat) RightIndentLength() (*Length, error) {
+	pPr := pf.provider.PPr()
+	if pPr == nil {
+		return nil, nil
+	}
+	return twipsLength(pPr.IndRight())
+}
+
 // SetRightIndent sets the right indent in twips. Passing nil removes it.
 func (pf *ParagraphFormat) SetRightIndent(v *int) error {
 	return pf.provider.GetOrAddPPr().SetIndRight(v)
 }
 
+// SetRightIndentLength sets the right indent. Passing nil removes it.
+func (pf *ParagraphFormat) SetRightIndentLength(v *Length) error {
+	return pf.provider.GetOrAddPPr().SetIndRight(lengthTwips(v))
+}
+
 // SpaceAfter returns the space after in twips, or nil if inherited.
 func (pf *ParagraphFormat) SpaceAfter() (*int, error) {
 	pPr := pf.provider.PPr()
@@ -129,11 +173,25 @@ func (pf *ParagraphFormat) SpaceAfter() (*int, error) {
 	return pPr.SpacingAfter()
 }
 
+// SpaceAfterLength returns the space after as a Length, or nil if inherited.
+func (pf *ParagraphFormat) SpaceAfterLength() (*Length, error) {
+	pPr := pf.provider.PPr()
+	if pPr == nil {
+		return nil, nil
+	}
+	return twipsLength(pPr.SpacingAfter())
+}
+
 // SetSpaceAfter sets the space after in twips. Passing nil removes it.
 func (pf *ParagraphFormat) SetSpaceAfter(v *int) error {
 	return pf.provider.GetOrAddPPr().SetSpacingAfter(v)
 }
 
+// SetSpaceAfterLength sets the space after. Passing nil removes it.
+func (pf *ParagraphFormat) SetSpaceAfterLength(v *Length) error {
+	return pf.provider.GetOrAddPPr().SetSpacingAfter(lengthTwips(v))
+}
+
 // SpaceBefore returns the space before in twips, or nil if inherited.
 func (pf *ParagraphFormat) SpaceBefore() (*int, error) {
 	pPr := pf.provider.PPr()
@@ -143,14 +201,29 @@ func (pf *ParagraphFormat) SpaceBefore() (*int, error) {
 	return pPr.SpacingBefore()
 }
 
+// SpaceBeforeLength returns the space before as a Length, or nil if
+// inherited.
+func (pf *ParagraphFormat) SpaceBeforeLength() (*Length, error) {
+	pPr := pf.provider.PPr()
+	if pPr == nil {
+		return nil, nil
+	}
+	return twipsLength(pPr.SpacingBefore())
+}
+
 // SetSpaceBefore sets the space before in twips. Passing nil removes it.
 func (pf *ParagraphFormat) SetSpaceBefore(v *int) error {
 	return pf.provider.GetOrAddPPr().SetSpacingBefore(v)
 }
 
+// SetSpaceBeforeLength sets the space before. Passing nil removes it.
+func (pf *ParagraphFormat) SetSpaceBeforeLength(v *Length) error {
+	return pf.provider.GetOrAddPPr().SetSpacingBefore(lengthTwips(v))
+}
+
 // LineSpacing returns the line spacing value.
-// Returns a float64 (multiple of line height) when rule is MULTIPLE,
-// or an int (raw twips) for absolute height. Returns nil if inherited.
+// The value is a multiple of the line height when the rule is MULTIPLE,
+// and an absolute Length otherwise. Returns nil if inherited.
 //
 // Mirrors Python ParagraphFormat.line_spacing.
 func (pf *ParagraphFormat) LineSpacing() (*LineSpacingVal, error) {
@@ -172,8 +245,9 @@ func (pf *ParagraphFormat) LineSpacing() (*LineSpacingVal, error) {
 	return toLineSpacingVal(*line, rule), nil
 }
 
-// SetLineSpacing sets the line spacing. Pass nil to inherit, a float64 for
-// multiple (e.g. 2.0), or an int for absolute twips.
+// SetLineSpacing sets the line spacing. Pass nil to inherit, a
+// LineSpacingMultiple (e.g. 2.0) or a LineSpacingLength for an absolute
+// height.
 //
 // Mirrors Python ParagraphFormat.line_spacing setter.
 func (pf *ParagraphFormat) SetLineSpacing(v *LineSpacingVal) error {
@@ -192,7 +266,7 @@ func (pf *ParagraphFormat) SetLineSpacing(v *LineSpacingVal) error {
 		}
 		return pPr.SetSpacingLineRule(wdlsPtr(enum.WdLineSpacingMultiple))
 	}
-	// Absolute twips.
+	// Absolute height.
 	tw := v.Twips()
 	if err := pPr.SetSpacingLine(&tw); err != nil {
 		return err
@@ -283,6 +357,22 @@ func (pf *ParagraphFormat) SetWidowControl(v *bool) error {
 	return pf.provider.GetOrAddPPr().SetWidowControlVal(v)
 }
 
+// SuppressAutoHyphens returns the tri-state suppress-auto-hyphens value,
+// or nil if inherited. When true, the paragraph is excluded from automatic
+// hyphenation even if it is enabled in the document settings.
+func (pf *ParagraphFormat) SuppressAutoHyphens() *bool {
+	pPr := pf.provider.PPr()
+	if pPr == nil {
+		return nil
+	}
+	return pPr.SuppressAutoHyphensVal()
+}
+
+// SetSuppressAutoHyphens sets the suppress-auto-hyphens value.
+func (pf *ParagraphFormat) SetSuppressAutoHyphens(v *bool) error {
+	return pf.provider.GetOrAddPPr().SetSuppressAutoHyphensVal(v)
+}
+
 // TabStops returns the TabStops providing access to tab stop definitions.
 //
 // Mirrors Python ParagraphFormat.tab_stops (lazyproperty).
@@ -303,7 +393,7 @@ func toLineSpacingVal(spacingLine int, spacingLineRule *enum.WdLineSpacing) *Lin
 		v := LineSpacingMultiple(float64(spacingLine) / 240.0)
 		return &v
 	}
-	v := LineSpacingTwips(spacingLine)
+	v := LineSpacingLength(Twips(float64(spacingLine)))
 	return &v
 }
 
@@ -162,6 +162,44 @@ func TestParagraphFormat_RightIndent(t *testing.T) {
 	}
 }
 
+func TestParagraphFormat_LengthVariants(t *testing.T) {
+	p := makeP(t, `<w:pPr><w:spacing w:before="120"/><w:ind w:left="720"/></w:pPr>`)
+	pf := newParagraph(p, nil).ParagraphFormat()
+
+	if got, _ := pf.SpaceBeforeLength(); got == nil || *got != Pt(6) {
+		t.Errorf("SpaceBeforeLength() = %v, want %v", got, Pt(6))
+	}
+	if got, _ := pf.LeftIndentLength(); got == nil || *got != Inches(0.5) {
+		t.Errorf("LeftIndentLength() = %v, want %v", got, Inches(0.5))
+	}
+
+	if err := pf.SetSpaceAfterLength(Ptr(Pt(12))); err != nil {
+		t.Fatal(err)
+	}
+	if err := pf.SetFirstLineIndentLength(Ptr(Twips(-360))); err != nil {
+		t.Fatal(err)
+	}
+	if err := pf.SetRightIndentLength(Ptr(Inches(1))); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := pf.SpaceAfter(); got == nil || *got != 240 {
+		t.Errorf("SpaceAfter() = %v, want 240", got)
+	}
+	if got, _ := pf.FirstLineIndent(); got == nil || *got != -360 {
+		t.Errorf("FirstLineIndent() = %v, want -360", got)
+	}
+	if got, _ := pf.RightIndentLength(); got == nil || *got != Inches(1) {
+		t.Errorf("RightIndentLength() = %v, want %v", got, Inches(1))
+	}
+
+	if err := pf.SetLineSpacing(Ptr(LineSpacingTwips(480))); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := pf.LineSpacing(); got == nil || got.Twips() != 480 || got.Length() != Pt(24) {
+		t.Errorf("LineSpacing() = %v, want 480 twips", got)
+	}
+}
+
 // Mirrors Python: it_knows_its_line_spacing (complete cases)
 func TestParagraphFormat_LineSpacing(t *testing.T) {
 	tests := []struct {
@@ -289,6 +327,7 @@ func TestParagraphFormat_OnOffProps(t *testing.T) {
 		{"KeepWithNext", "keepNext", (*ParagraphFormat).KeepWithNext, (*ParagraphFormat).SetKeepWithNext},
 		{"PageBreakBefore", "pageBreakBefore", (*ParagraphFormat).PageBreakBefore, (*ParagraphFormat).SetPageBreakBefore},
 		{"WidowControl", "widowControl", (*ParagraphFormat).WidowControl, (*ParagraphFormat).SetWidowControl},
+		{"SuppressAutoHyphens", "suppressAutoHyphens", (*ParagraphFormat).SuppressAutoHyphens, (*ParagraphFormat).SetSuppressAutoHyphens},
 	}
 
 	for _, prop := range props {
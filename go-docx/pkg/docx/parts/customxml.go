@@ -0,0 +1,118 @@
+package parts
+
+import (
+	"fmt"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// dsNS is the namespace of custom XML data store item properties.
+const dsNS = "http://schemas.openxmlformats.org/officeDocument/2006/customXml"
+
+// CustomXmlPart is a custom XML data part (/customXml/itemN.xml): XML of
+// the author's own schema stored with the document, which content controls
+// can be bound to. A properties part related to it names it with a GUID,
+// its item ID, and lists the schemas it conforms to.
+type CustomXmlPart struct {
+	*opc.XmlPart
+}
+
+// NewCustomXmlPart creates a custom XML part holding root, with a
+// properties part giving it itemID and schemaRefs. Both parts are added to
+// pkg; the caller relates the data part to the document part.
+func NewCustomXmlPart(pkg *opc.OpcPackage, root *etree.Element, itemID string, schemaRefs []string) *CustomXmlPart {
+	pn := pkg.NextPartname("/customXml/item%d.xml")
+	cp := &CustomXmlPart{XmlPart: opc.NewXmlPartFromElement(pn, opc.CTXml, root, pkg)}
+	pkg.AddPart(cp)
+
+	props := etree.NewElement("ds:datastoreItem")
+	props.CreateAttr("xmlns:ds", dsNS)
+	props.CreateAttr("ds:itemID", itemID)
+	refs := props.CreateElement("ds:schemaRefs")
+	for _, uri := range schemaRefs {
+		refs.CreateElement("ds:schemaRef").CreateAttr("ds:uri", uri)
+	}
+	propsPn := opc.PackURI(fmt.Sprintf("/customXml/itemProps%s", pn[len("/customXml/item"):]))
+	if _, taken := pkg.PartByName(propsPn); taken {
+		propsPn = pkg.NextPartname("/customXml/itemProps%d.xml")
+	}
+	pp := opc.NewXmlPartFromElement(propsPn, opc.CTOfcCustomXmlProperties, props, pkg)
+	pkg.AddPart(pp)
+	cp.Rels().GetOrAdd(opc.RTCustomXmlProps, pp)
+	return cp
+}
+
+// LoadCustomXmlPart is a PartConstructor for loading CustomXmlPart from a
+// package.
+func LoadCustomXmlPart(partName opc.PackURI, contentType, _ string, blob []byte, pkg *opc.OpcPackage) (opc.Part, error) {
+	xp, err := opc.NewXmlPart(partName, contentType, blob, pkg)
+	if err != nil {
+		return nil, fmt.Errorf("parts: loading custom XML part %q: %w", partName, err)
+	}
+	return &CustomXmlPart{XmlPart: xp}, nil
+}
+
+// LoadCustomXmlPropertiesPart is a PartConstructor for the properties part
+// of a custom XML part.
+func LoadCustomXmlPropertiesPart(partName opc.PackURI, contentType, _ string, blob []byte, pkg *opc.OpcPackage) (opc.Part, error) {
+	xp, err := opc.NewXmlPart(partName, contentType, blob, pkg)
+	if err != nil {
+		return nil, fmt.Errorf("parts: loading custom XML properties part %q: %w", partName, err)
+	}
+	return xp, nil
+}
+
+// propertiesElement returns the ds:datastoreItem root of the properties
+// part, or nil.
+func (cp *CustomXmlPart) propertiesElement() *etree.Element {
+	rel, err := cp.Rels().GetByRelType(opc.RTCustomXmlProps)
+	if err != nil || rel.TargetPart == nil {
+		return nil
+	}
+	xp, ok := rel.TargetPart.(interface{ ReadElement() *etree.Element })
+	if !ok {
+		return nil
+	}
+	return xp.ReadElement()
+}
+
+// ItemID returns the GUID naming this part, e.g.
+// "{5F6B1A9E-3C1D-4E7A-9B52-0D1C2E3F4A5B}", or "" if it has no properties.
+func (cp *CustomXmlPart) ItemID() string {
+	if props := cp.propertiesElement(); props != nil {
+		return dsAttr(props, "itemID")
+	}
+	return ""
+}
+
+// SchemaRefs returns the namespaces of the schemas the data conforms to.
+func (cp *CustomXmlPart) SchemaRefs() []string {
+	props := cp.propertiesElement()
+	if props == nil {
+		return nil
+	}
+	var result []string
+	for _, refs := range props.ChildElements() {
+		if refs.Tag != "schemaRefs" {
+			continue
+		}
+		for _, ref := range refs.ChildElements() {
+			if ref.Tag == "schemaRef" {
+				result = append(result, dsAttr(ref, "uri"))
+			}
+		}
+	}
+	return result
+}
+
+// dsAttr returns the value of the data store attribute key of el, whatever
+// prefix it is written with.
+func dsAttr(el *etree.Element, key string) string {
+	for _, attr := range el.Attr {
+		if attr.Key == key && attr.NamespaceURI() == dsNS {
+			return attr.Value
+		}
+	}
+	return ""
+}
@@ -22,8 +22,20 @@ type DocumentPart struct {
 	// lazyproperty) in Python — they re-check the relationship each call.
 	// The relationship graph itself acts as the cache.
 	numberingPart *NumberingPart
+
+	// appData is state the docx layer keeps per document, such as its
+	// event hooks. The parts layer does not look at it.
+	appData any
 }
 
+// AppData returns the value stored with SetAppData, or nil.
+func (dp *DocumentPart) AppData() any { return dp.appData }
+
+// SetAppData stores application-level state with the document part, where
+// proxies of any story of the document can reach it through
+// StoryPart.DocumentPart.
+func (dp *DocumentPart) SetAppData(v any) { dp.appData = v }
+
 // NewDocumentPart creates a DocumentPart wrapping the given XmlPart.
 func NewDocumentPart(xp *opc.XmlPart) *DocumentPart {
 	dp := &DocumentPart{
@@ -112,10 +124,10 @@ func (dp *DocumentPart) DropHeaderPart(rId string) {
 func (dp *DocumentPart) HeaderPartByRID(rId string) (*HeaderPart, error) {
 	rel := dp.Rels().GetByRID(rId)
 	if rel == nil {
-		return nil, fmt.Errorf("parts: no relationship %q", rId)
+		return nil, fmt.Errorf("parts: no relationship %q: %w", rId, opc.ErrPartNotFound)
 	}
 	if rel.TargetPart == nil {
-		return nil, fmt.Errorf("parts: relationship %q has no target part", rId)
+		return nil, fmt.Errorf("parts: relationship %q has no target part: %w", rId, opc.ErrPartNotFound)
 	}
 	hp, ok := rel.TargetPart.(*HeaderPart)
 	if !ok {
@@ -130,10 +142,10 @@ func (dp *DocumentPart) HeaderPartByRID(rId string) (*HeaderPart, error) {
 func (dp *DocumentPart) FooterPartByRID(rId string) (*FooterPart, error) {
 	rel := dp.Rels().GetByRID(rId)
 	if rel == nil {
-		return nil, fmt.Errorf("parts: no relationship %q", rId)
+		return nil, fmt.Errorf("parts: no relationship %q: %w", rId, opc.ErrPartNotFound)
 	}
 	if rel.TargetPart == nil {
-		return nil, fmt.Errorf("parts: relationship %q has no target part", rId)
+		return nil, fmt.Errorf("parts: relationship %q has no target part: %w", rId, opc.ErrPartNotFound)
 	}
 	fp, ok := rel.TargetPart.(*FooterPart)
 	if !ok {
@@ -202,7 +214,7 @@ func (dp *DocumentPart) NumberingPart() (*NumberingPart, error) {
 		return nil, fmt.Errorf("parts: no numbering part: %w", err)
 	}
 	if rel.TargetPart == nil {
-		return nil, fmt.Errorf("parts: numbering relationship has no target part")
+		return nil, fmt.Errorf("parts: numbering relationship has no target part: %w", opc.ErrPartNotFound)
 	}
 	np, ok := rel.TargetPart.(*NumberingPart)
 	if !ok {
@@ -395,6 +407,13 @@ func (dp *DocumentPart) GetStyleID(styleOrName any, styleType enum.WdStyleType)
 		if err != nil {
 			return nil, err
 		}
+		// A built-in style the document lacks is added from the catalog
+		// rather than failing, as Word does when it is applied.
+		if ss.GetByName(oxml.UI2Internal(v)) == nil && ss.GetByID(v) == nil && IsBuiltinStyle(v) {
+			if _, err := EnsureBuiltinStyle(ss, v); err != nil {
+				return nil, err
+			}
+		}
 		return ss.GetStyleIDByName(v, styleType)
 	case styledObject:
 		// Validate type (Python: _get_style_id_from_style raises ValueError).
@@ -402,28 +421,76 @@ func (dp *DocumentPart) GetStyleID(styleOrName any, styleType enum.WdStyleType)
 		if err != nil {
 			return nil, err
 		}
-		if st != styleType {
-			return nil, fmt.Errorf("parts: assigned style is type %v, need type %v", st, styleType)
-		}
 		// Default check (Python: if style == self.default(style_type): return None).
 		ss, err := dp.Styles()
 		if err != nil {
 			return nil, err
 		}
+		id := v.StyleID()
+		if st != styleType {
+			// A linked style of the needed type stands in, as in Word.
+			linked := ss.GetByID(id)
+			if linked != nil {
+				linked = linked.LinkedStyle()
+			}
+			xmlType, err := styleType.ToXml()
+			if err != nil {
+				return nil, fmt.Errorf("parts: invalid style type: %w", err)
+			}
+			if linked == nil || linked.Type() != xmlType {
+				return nil, fmt.Errorf("parts: assigned style is type %v, need type %v: %w", st, styleType, oxml.ErrWrongStyleType)
+			}
+			id = linked.StyleId()
+		}
 		def, err := ss.DefaultFor(styleType)
 		if err != nil {
 			return nil, err
 		}
-		if def != nil && def.StyleId() == v.StyleID() {
+		if def != nil && def.StyleId() == id {
 			return nil, nil
 		}
-		id := v.StyleID()
 		return &id, nil
 	default:
 		return nil, fmt.Errorf("parts: GetStyleID expects string, style object, or nil, got %T", styleOrName)
 	}
 }
 
+// --------------------------------------------------------------------------
+// Alternative format chunks (w:altChunk)
+// --------------------------------------------------------------------------
+
+// altChunkExtensions maps the content types accepted for alternative
+// format import parts to the partname extension Word uses for them.
+var altChunkExtensions = map[string]string{
+	opc.CTHtml:            "html",
+	opc.CTXhtml:           "xhtml",
+	opc.CTMht:             "mht",
+	opc.CTRtf:             "rtf",
+	opc.CTTextPlain:       "txt",
+	opc.CTWmlDocumentMain: "docx",
+}
+
+// AddAltChunkPart stores blob as an alternative format import part
+// (/word/afchunkN.ext), relates it to this document part and returns the
+// relationship ID for a w:altChunk element. contentType must be one of
+// text/html, application/xhtml+xml, message/rfc822 (MHT),
+// application/rtf, text/plain or the WordprocessingML main content type.
+func (dp *DocumentPart) AddAltChunkPart(blob []byte, contentType string) (string, error) {
+	ext, ok := altChunkExtensions[contentType]
+	if !ok {
+		return "", fmt.Errorf("parts: unsupported altChunk content type %q", contentType)
+	}
+	pkg := dp.Package()
+	if pkg == nil {
+		return "", fmt.Errorf("parts: document part has no package")
+	}
+	pn := pkg.NextPartname("/word/afchunk%d." + ext)
+	part := opc.NewBasePart(pn, contentType, blob, pkg)
+	pkg.AddPart(part)
+	rel := dp.Rels().GetOrAdd(opc.RTAFChunk, part)
+	return rel.RID, nil
+}
+
 // --------------------------------------------------------------------------
 // InlineShapes (element access only — domain object is MR-11)
 // --------------------------------------------------------------------------
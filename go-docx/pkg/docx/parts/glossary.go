@@ -0,0 +1,64 @@
+package parts
+
+import (
+	"fmt"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// GlossaryDocumentPart holds the building blocks of a document or
+// template: named pieces of content, such as AutoText entries and Quick
+// Parts, kept in w:docPart elements. It has relationships of its own, to
+// its styles, numbering and images; its paragraphs resolve style names
+// through the main document part.
+type GlossaryDocumentPart struct {
+	StoryPart
+}
+
+// defaultGlossaryXml is the content of a new glossary document part.
+const defaultGlossaryXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+	`<w:glossaryDocument xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"` +
+	` xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><w:docParts/></w:glossaryDocument>`
+
+// LoadGlossaryDocumentPart is a PartConstructor for loading
+// GlossaryDocumentPart from a package.
+func LoadGlossaryDocumentPart(partName opc.PackURI, contentType, _ string, blob []byte, pkg *opc.OpcPackage) (opc.Part, error) {
+	xp, err := opc.NewXmlPart(partName, contentType, blob, pkg)
+	if err != nil {
+		return nil, fmt.Errorf("parts: loading glossary document part %q: %w", partName, err)
+	}
+	return &GlossaryDocumentPart{StoryPart: StoryPart{XmlPart: xp}}, nil
+}
+
+// GlossaryDocumentPart returns the glossary document part of this
+// document, or nil if the document has none.
+func (dp *DocumentPart) GlossaryDocumentPart() *GlossaryDocumentPart {
+	rel, err := dp.Rels().GetByRelType(opc.RTGlossaryDocument)
+	if err != nil || rel.TargetPart == nil {
+		return nil
+	}
+	gp, _ := rel.TargetPart.(*GlossaryDocumentPart)
+	return gp
+}
+
+// GetOrAddGlossaryDocumentPart returns the glossary document part of this
+// document, creating an empty one if not present.
+func (dp *DocumentPart) GetOrAddGlossaryDocumentPart() (*GlossaryDocumentPart, error) {
+	if gp := dp.GlossaryDocumentPart(); gp != nil {
+		return gp, nil
+	}
+	pkg := dp.Package()
+	if pkg == nil {
+		return nil, fmt.Errorf("parts: document part has no package")
+	}
+	el, err := oxml.ParseXml([]byte(defaultGlossaryXml))
+	if err != nil {
+		return nil, fmt.Errorf("parts: parsing default glossary document: %w", err)
+	}
+	pn := opc.PackURI("/word/glossary/document.xml")
+	gp := &GlossaryDocumentPart{StoryPart: StoryPart{XmlPart: opc.NewXmlPartFromElement(pn, opc.CTWmlDocumentGlossary, el, pkg)}}
+	pkg.AddPart(gp)
+	dp.Rels().GetOrAdd(opc.RTGlossaryDocument, gp)
+	return gp, nil
+}
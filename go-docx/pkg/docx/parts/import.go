@@ -0,0 +1,142 @@
+package parts
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// PartImporter copies parts referenced by content moved from one package
+// into another. Images are deduplicated against the images already in the
+// destination; other parts are copied once each, along with the parts they
+// relate to, and keep their relationship IDs.
+type PartImporter struct {
+	dst     *WmlPackage
+	factory *opc.PartFactory
+	copies  map[opc.Part]opc.Part
+
+	// OnCopy, when set, is called for every part copied into the
+	// destination (images excepted) before it is related to anything, so
+	// the caller can adapt its content, e.g. the styles a header uses.
+	OnCopy func(src, dst opc.Part) error
+}
+
+// NewPartImporter returns an importer copying parts into dst.
+func NewPartImporter(dst *WmlPackage) *PartImporter {
+	return &PartImporter{
+		dst:     dst,
+		factory: NewDocxPartFactory(),
+		copies:  map[opc.Part]opc.Part{},
+	}
+}
+
+// ImportRefs rewrites the relationship references (r:id, r:embed, ...)
+// under root, which were resolved against srcRels, so they resolve against
+// dstRels: external targets are related again and internal targets are
+// imported with ImportPart. References to unknown relationships are left
+// as they are.
+func (pi *PartImporter) ImportRefs(root *etree.Element, srcRels, dstRels *opc.Relationships) error {
+	mapped := map[string]string{}
+	stack := []*etree.Element{root}
+	for len(stack) > 0 {
+		el := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for i := range el.Attr {
+			attr := &el.Attr[i]
+			if !isRelNS(attr.Space) {
+				continue
+			}
+			if rId, ok := mapped[attr.Value]; ok {
+				attr.Value = rId
+				continue
+			}
+			rel := srcRels.GetByRID(attr.Value)
+			if rel == nil {
+				continue
+			}
+			var rId string
+			switch {
+			case rel.IsExternal:
+				rId = dstRels.GetOrAddExtRel(rel.RelType, rel.TargetRef)
+			case rel.TargetPart == nil:
+				continue
+			default:
+				part, err := pi.ImportPart(rel.TargetPart, rel.RelType)
+				if err != nil {
+					return err
+				}
+				rId = dstRels.GetOrAdd(rel.RelType, part).RID
+			}
+			mapped[attr.Value] = rId
+			attr.Value = rId
+		}
+		stack = append(stack, el.ChildElements()...)
+	}
+	return nil
+}
+
+// ImportPart returns the destination counterpart of src, a part of another
+// package, copying it (and the parts it relates to) on first use. relType
+// is the type of the relationship through which src was reached.
+func (pi *PartImporter) ImportPart(src opc.Part, relType string) (opc.Part, error) {
+	if cp, ok := pi.copies[src]; ok {
+		return cp, nil
+	}
+	blob, err := src.Blob()
+	if err != nil {
+		return nil, fmt.Errorf("parts: importing %q: %w", src.PartName(), err)
+	}
+
+	if img, ok := src.(*ImagePart); ok {
+		ip, err := pi.dst.GetOrAddImagePart(NewImagePart(img.PartName(), img.ContentType(), blob, nil))
+		if err != nil {
+			return nil, fmt.Errorf("parts: importing %q: %w", src.PartName(), err)
+		}
+		pi.copies[src] = ip
+		return ip, nil
+	}
+
+	pn := pi.dst.NextPartname(partnameTemplate(src.PartName()))
+	cp, err := pi.factory.New(pn, src.ContentType(), relType, blob, pi.dst.OpcPackage)
+	if err != nil {
+		return nil, fmt.Errorf("parts: importing %q: %w", src.PartName(), err)
+	}
+	pi.dst.AddPart(cp)
+	pi.copies[src] = cp
+
+	// Keep the rIds so the copied XML needs no rewriting.
+	rels := opc.NewRelationships(pn.BaseURI())
+	for _, rel := range src.Rels().All() {
+		var target opc.Part
+		if !rel.IsExternal && rel.TargetPart != nil {
+			if target, err = pi.ImportPart(rel.TargetPart, rel.RelType); err != nil {
+				return nil, err
+			}
+		}
+		rels.Load(rel.RID, rel.RelType, rel.TargetRef, target, rel.IsExternal)
+	}
+	cp.SetRels(rels)
+
+	if pi.OnCopy != nil {
+		if err := pi.OnCopy(src, cp); err != nil {
+			return nil, err
+		}
+	}
+	return cp, nil
+}
+
+var partnameNumber = regexp.MustCompile(`\d*$`)
+
+// partnameTemplate turns a partname such as /word/header2.xml into the
+// NextPartname template /word/header%d.xml.
+func partnameTemplate(pn opc.PackURI) string {
+	s := strings.ReplaceAll(string(pn), "%", "%%")
+	ext := ""
+	if i := strings.LastIndex(s, "."); i > strings.LastIndex(s, "/") {
+		s, ext = s[:i], s[i:]
+	}
+	return partnameNumber.ReplaceAllString(s, "") + "%d" + ext
+}
@@ -0,0 +1,61 @@
+package parts
+
+import (
+	"fmt"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// --------------------------------------------------------------------------
+// FootnotesPart / EndnotesPart
+// --------------------------------------------------------------------------
+
+// FootnotesPart holds the w:footnote stories of the document.
+type FootnotesPart struct {
+	StoryPart
+}
+
+// EndnotesPart holds the w:endnote stories of the document.
+type EndnotesPart struct {
+	StoryPart
+}
+
+// LoadFootnotesPart is a PartConstructor for loading FootnotesPart from a package.
+func LoadFootnotesPart(partName opc.PackURI, contentType, _ string, blob []byte, pkg *opc.OpcPackage) (opc.Part, error) {
+	xp, err := opc.NewXmlPart(partName, contentType, blob, pkg)
+	if err != nil {
+		return nil, fmt.Errorf("parts: loading footnotes part %q: %w", partName, err)
+	}
+	return &FootnotesPart{StoryPart: StoryPart{XmlPart: xp}}, nil
+}
+
+// LoadEndnotesPart is a PartConstructor for loading EndnotesPart from a package.
+func LoadEndnotesPart(partName opc.PackURI, contentType, _ string, blob []byte, pkg *opc.OpcPackage) (opc.Part, error) {
+	xp, err := opc.NewXmlPart(partName, contentType, blob, pkg)
+	if err != nil {
+		return nil, fmt.Errorf("parts: loading endnotes part %q: %w", partName, err)
+	}
+	return &EndnotesPart{StoryPart: StoryPart{XmlPart: xp}}, nil
+}
+
+// FootnotesPart returns the footnotes part of this document, or nil if the
+// document has none. Unlike StylesPart, no part is created when absent.
+func (dp *DocumentPart) FootnotesPart() *FootnotesPart {
+	rel, err := dp.Rels().GetByRelType(opc.RTFootnotes)
+	if err != nil || rel.TargetPart == nil {
+		return nil
+	}
+	fp, _ := rel.TargetPart.(*FootnotesPart)
+	return fp
+}
+
+// EndnotesPart returns the endnotes part of this document, or nil if the
+// document has none.
+func (dp *DocumentPart) EndnotesPart() *EndnotesPart {
+	rel, err := dp.Rels().GetByRelType(opc.RTEndnotes)
+	if err != nil || rel.TargetPart == nil {
+		return nil
+	}
+	ep, _ := rel.TargetPart.(*EndnotesPart)
+	return ep
+}
@@ -10,6 +10,13 @@ import (
 // constructors. This factory is used when opening a .docx package so that
 // the generic OPC unmarshaller produces the correct part types.
 //
+// Parts of any other content type — people.xml,
+// commentsExtended.xml, w15/w16 extension parts, themes and font tables —
+// load as opc.BasePart, whose blob is written back byte for byte on save,
+// and keep their relationships. Typed parts keep the markup they do not
+// model: unknown elements stay in place, and new properties are inserted
+// ahead of extension elements (see oxml.Element.InsertElementBefore).
+//
 // Mirrors Python PartFactory configuration in docx/__init__.py.
 func NewDocxPartFactory() *opc.PartFactory {
 	f := opc.NewPartFactory()
@@ -18,18 +25,29 @@ func NewDocxPartFactory() *opc.PartFactory {
 	// Mirrors Python: PartFactory.part_type_for[CT.*] = *Part
 	f.Register(opc.CTOpcCoreProperties, LoadCorePropertiesPart)
 	f.Register(opc.CTWmlDocumentMain, LoadDocumentPart)
+	f.Register(opc.CTWmlDocumentMacroEnabled, LoadDocumentPart)
+	f.Register(opc.CTWmlTemplateMain, LoadDocumentPart)
+	f.Register(opc.CTWmlTemplateMacroEnabled, LoadDocumentPart)
 	f.Register(opc.CTWmlStyles, LoadStylesPart)
 	f.Register(opc.CTWmlSettings, LoadSettingsPart)
 	f.Register(opc.CTWmlComments, LoadCommentsPart)
 	f.Register(opc.CTWmlHeader, LoadHeaderPart)
 	f.Register(opc.CTWmlFooter, LoadFooterPart)
 	f.Register(opc.CTWmlNumbering, LoadNumberingPart)
+	f.Register(opc.CTWmlFootnotes, LoadFootnotesPart)
+	f.Register(opc.CTWmlEndnotes, LoadEndnotesPart)
+	f.Register(opc.CTWmlDocumentGlossary, LoadGlossaryDocumentPart)
+	f.Register(opc.CTOfcCustomXmlProperties, LoadCustomXmlPropertiesPart)
 
-	// Selector: image/* content types with RTImage reltype → ImagePart
+	// Selector: image/* content types with RTImage reltype → ImagePart;
+	// any part reached through RTCustomXml → CustomXmlPart
 	f.SetSelector(func(contentType, relType string) opc.PartConstructor {
 		if relType == opc.RTImage && strings.HasPrefix(contentType, "image/") {
 			return LoadImagePart
 		}
+		if relType == opc.RTCustomXml {
+			return LoadCustomXmlPart
+		}
 		return nil
 	})
 
@@ -145,6 +145,28 @@ func TestDocxPartFactory_CommentsPart(t *testing.T) {
 	}
 }
 
+func TestDocxPartFactory_GlossaryDocumentPart(t *testing.T) {
+	f := NewDocxPartFactory()
+	pkg := opc.NewOpcPackage(nil)
+
+	blob := []byte(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:glossaryDocument xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:docParts/></w:glossaryDocument>`)
+
+	part, err := f.New(
+		opc.PackURI("/word/glossary/document.xml"),
+		opc.CTWmlDocumentGlossary,
+		opc.RTGlossaryDocument,
+		blob,
+		pkg,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := part.(*GlossaryDocumentPart); !ok {
+		t.Errorf("factory returned %T, want *GlossaryDocumentPart", part)
+	}
+}
+
 func TestDocxPartFactory_NumberingPart(t *testing.T) {
 	f := NewDocxPartFactory()
 	pkg := opc.NewOpcPackage(nil)
@@ -137,6 +137,12 @@ func (sp *StoryPart) documentPart() (*DocumentPart, error) {
 	return dp, nil
 }
 
+// DocumentPart returns the main document part of the package this story
+// part belongs to, whose numbering and styles its paragraphs use.
+func (sp *StoryPart) DocumentPart() (*DocumentPart, error) {
+	return sp.documentPart()
+}
+
 // SetDocumentPart sets the cached document part reference. Used by
 // DocumentPart to set itself as its own document part.
 func (sp *StoryPart) SetDocumentPart(dp *DocumentPart) {
@@ -222,6 +228,33 @@ func (sp *StoryPart) DropRel(rId string) {
 	}
 }
 
+// HasRelRef reports whether any attribute in the relationships namespace
+// of this part's XML (r:id, r:embed, r:link, ...) refers to rId. Unlike
+// DropRel's count, this covers image references, which use r:embed.
+func (sp *StoryPart) HasRelRef(rId string) bool {
+	return hasRelRef(sp.Element(), rId)
+}
+
+// hasRelRef reports whether an attribute in the relationships namespace
+// under el refers to rId.
+func hasRelRef(el *etree.Element, rId string) bool {
+	if el == nil {
+		return false
+	}
+	stack := []*etree.Element{el}
+	for len(stack) > 0 {
+		e := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, attr := range e.Attr {
+			if attr.Value == rId && isRelNS(attr.Space) {
+				return true
+			}
+		}
+		stack = append(stack, e.ChildElements()...)
+	}
+	return false
+}
+
 // relRefCount returns the count of references to rId in this part's XML.
 // Mirrors Python XmlPart._rel_ref_count which counts //@r:id occurrences.
 func (sp *StoryPart) relRefCount(rId string) int {
@@ -0,0 +1,138 @@
+package parts
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/templates"
+)
+
+// builtinCatalog holds the style definitions of the default template,
+// parsed on first use.
+var builtinCatalog struct {
+	once   sync.Once
+	styles *oxml.CT_Styles
+	err    error
+}
+
+// builtinStyles returns the styles of the default template, the catalog
+// built-in styles are materialized from. The result must not be modified.
+func builtinStyles() (*oxml.CT_Styles, error) {
+	builtinCatalog.once.Do(func() {
+		builtinCatalog.styles, builtinCatalog.err = loadBuiltinStyles()
+	})
+	return builtinCatalog.styles, builtinCatalog.err
+}
+
+// loadBuiltinStyles reads word/styles.xml from the default template.
+func loadBuiltinStyles() (*oxml.CT_Styles, error) {
+	blob, err := templates.FS.ReadFile("default.docx")
+	if err != nil {
+		return nil, fmt.Errorf("parts: reading default.docx: %w", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(blob), int64(len(blob)))
+	if err != nil {
+		return nil, fmt.Errorf("parts: reading default.docx: %w", err)
+	}
+	for _, f := range zr.File {
+		if f.Name != "word/styles.xml" {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("parts: reading built-in styles: %w", err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("parts: reading built-in styles: %w", err)
+		}
+		el, err := oxml.ParseXml(data)
+		if err != nil {
+			return nil, fmt.Errorf("parts: parsing built-in styles: %w", err)
+		}
+		return &oxml.CT_Styles{Element: oxml.WrapElement(el)}, nil
+	}
+	return nil, fmt.Errorf("parts: default.docx has no styles part")
+}
+
+// IsBuiltinStyle reports whether the catalog has a definition for the
+// style with the given UI name.
+func IsBuiltinStyle(name string) bool {
+	catalog, err := builtinStyles()
+	return err == nil && catalog.GetByName(oxml.UI2Internal(name)) != nil
+}
+
+// EnsureBuiltinStyle returns the style with the given UI name from ss,
+// first copying its definition from the built-in catalog if ss lacks it.
+// The styles it is based on, links to or is followed by are copied too
+// when missing. Paragraph numbering is left out of copied styles, since
+// it refers to list definitions of the template rather than of ss.
+func EnsureBuiltinStyle(ss *oxml.CT_Styles, name string) (*oxml.CT_Style, error) {
+	internal := oxml.UI2Internal(name)
+	if s := ss.GetByName(internal); s != nil {
+		return s, nil
+	}
+	catalog, err := builtinStyles()
+	if err != nil {
+		return nil, err
+	}
+	src := catalog.GetByName(internal)
+	if src == nil {
+		return nil, fmt.Errorf("parts: %q is not a known built-in style", name)
+	}
+	if err := copyBuiltinStyle(ss, catalog, src, map[string]bool{}); err != nil {
+		return nil, err
+	}
+	return ss.GetByName(internal), nil
+}
+
+// copyBuiltinStyle copies src, and the catalog styles it refers to, into
+// ss unless a style with its id is already there.
+func copyBuiltinStyle(ss, catalog *oxml.CT_Styles, src *oxml.CT_Style, seen map[string]bool) error {
+	id := src.StyleId()
+	if seen[id] {
+		return nil
+	}
+	seen[id] = true
+	if existing := ss.GetByID(id); existing != nil {
+		if existing.Type() != src.Type() {
+			return fmt.Errorf("parts: style id %q is taken by a %s style", id, existing.Type())
+		}
+		return nil
+	}
+	el := src.RawElement().Copy()
+	for _, np := range el.FindElements("w:pPr/w:numPr") {
+		np.Parent().RemoveChild(np)
+	}
+	insertStyle(ss.RawElement(), el)
+	for _, tag := range []string{"w:basedOn", "w:next", "w:link"} {
+		ref := el.SelectElement(tag)
+		if ref == nil {
+			continue
+		}
+		if dep := catalog.GetByID(ref.SelectAttrValue("w:val", "")); dep != nil {
+			if err := copyBuiltinStyle(ss, catalog, dep, seen); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// insertStyle adds style el to the styles element root, after the last
+// style.
+func insertStyle(root, el *etree.Element) {
+	styles := root.SelectElements("w:style")
+	if len(styles) == 0 {
+		root.AddChild(el)
+		return
+	}
+	last := styles[len(styles)-1]
+	root.InsertChildAt(last.Index()+1, el)
+}
@@ -0,0 +1,34 @@
+package parts
+
+import (
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+func TestEnsureBuiltinStyle(t *testing.T) {
+	sp, err := DefaultStylesPart(opc.NewOpcPackage(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss, err := sp.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ss.GetByName("List Bullet") != nil {
+		t.Fatal("default styles part already has List Bullet")
+	}
+	st, err := EnsureBuiltinStyle(ss, "List Bullet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.StyleId() != "ListBullet" {
+		t.Errorf("styleId = %q", st.StyleId())
+	}
+	if st.RawElement().FindElement("w:pPr/w:numPr") != nil {
+		t.Error("template numbering copied")
+	}
+	if !IsBuiltinStyle("Table Grid") || IsBuiltinStyle("Not A Style") {
+		t.Error("IsBuiltinStyle wrong")
+	}
+}
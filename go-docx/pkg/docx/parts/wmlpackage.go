@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/beevik/etree"
 	"github.com/vortex/go-docx/pkg/docx/opc"
 )
 
@@ -84,6 +85,91 @@ func (wp *WmlPackage) AfterUnmarshal() {
 	}
 }
 
+// MediaStats reports the effect of CompactMedia.
+type MediaStats struct {
+	// DuplicatesMerged is the number of image parts found to be identical
+	// to an earlier one and replaced by it in relationships that are still
+	// in use. Identical copies nothing refers to count as removed parts
+	// only.
+	DuplicatesMerged int
+	// RelsRemoved is the number of image relationships dropped because the
+	// XML of their source part no longer refers to them.
+	RelsRemoved int
+	// PartsRemoved is the number of parts dropped from the package because
+	// nothing refers to them any more.
+	PartsRemoved int
+	// BytesSaved is the total size of the image data removed.
+	BytesSaved int64
+}
+
+// CompactMedia deduplicates identical image parts and removes unused ones.
+// Relationships to an image whose content (SHA-256) matches an earlier
+// image are repointed to that image; image relationships not referenced by
+// the XML of their source part are dropped; parts no longer reachable from
+// the package relationships are removed.
+func (wp *WmlPackage) CompactMedia() (MediaStats, error) {
+	var stats MediaStats
+	canonical := map[string]*ImagePart{}
+	// redirected maps each repointed relationship to the duplicate it
+	// targeted before.
+	redirected := map[*opc.Relationship]*ImagePart{}
+	for _, rel := range wp.OpcPackage.IterRels() {
+		ip, ok := rel.TargetPart.(*ImagePart)
+		if rel.IsExternal || !ok {
+			continue
+		}
+		hash, err := ip.Hash()
+		if err != nil {
+			return stats, fmt.Errorf("parts: hashing %s: %w", ip.PartName(), err)
+		}
+		if first, ok := canonical[hash]; !ok {
+			canonical[hash] = ip
+		} else if first != ip {
+			rel.TargetPart = first
+			redirected[rel] = ip
+		}
+	}
+
+	for _, part := range wp.OpcPackage.Parts() {
+		xp, ok := part.(interface{ ReadElement() *etree.Element })
+		if !ok {
+			continue
+		}
+		el := xp.ReadElement()
+		var unused []string
+		for _, rel := range part.Rels().All() {
+			if rel.RelType == opc.RTImage && !hasRelRef(el, rel.RID) {
+				unused = append(unused, rel.RID)
+			}
+		}
+		for _, rId := range unused {
+			part.Rels().Delete(rId)
+		}
+		stats.RelsRemoved += len(unused)
+	}
+
+	merged := map[*ImagePart]bool{}
+	for _, rel := range wp.OpcPackage.IterRels() {
+		if dup, ok := redirected[rel]; ok {
+			merged[dup] = true
+		}
+	}
+	stats.DuplicatesMerged = len(merged)
+
+	for _, part := range wp.OpcPackage.DropUnreachableParts() {
+		stats.PartsRemoved++
+		ip, ok := part.(*ImagePart)
+		if !ok {
+			continue
+		}
+		if blob, err := ip.Blob(); err == nil {
+			stats.BytesSaved += int64(len(blob))
+		}
+		wp.ImageParts().Remove(ip)
+	}
+	return stats, nil
+}
+
 // --------------------------------------------------------------------------
 // ImageParts — collection with SHA-256 deduplication
 // --------------------------------------------------------------------------
@@ -106,6 +192,16 @@ func (ips *ImageParts) Append(ip *ImagePart) {
 	ips.parts = append(ips.parts, ip)
 }
 
+// Remove removes ip from the collection.
+func (ips *ImageParts) Remove(ip *ImagePart) {
+	for i, p := range ips.parts {
+		if p == ip {
+			ips.parts = append(ips.parts[:i], ips.parts[i+1:]...)
+			return
+		}
+	}
+}
+
 // Contains returns true if ip is already in the collection (by pointer identity).
 func (ips *ImageParts) Contains(ip *ImagePart) bool {
 	for _, p := range ips.parts {
@@ -0,0 +1,178 @@
+package docx
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// ErrPathNotFound is returned by Document.Resolve when no content is at
+// the path.
+var ErrPathNotFound = errors.New("docx: no content at path")
+
+// Path returns the content path of the paragraph, which Document.Resolve
+// finds again. It fails for a paragraph no longer in its part.
+func (para *Paragraph) Path() (string, error) {
+	return contentPath(para.p.RawElement(), para.part)
+}
+
+// Path returns the content path of the run; see Paragraph.Path.
+func (run *Run) Path() (string, error) {
+	return contentPath(run.r.RawElement(), run.part)
+}
+
+// Path returns the content path of the table; see Paragraph.Path.
+func (t *Table) Path() (string, error) {
+	return contentPath(t.tbl.RawElement(), t.part)
+}
+
+// Resolve returns the paragraph, run or table at a content path, as a
+// *Paragraph, *Run or *Table. A path that addresses nothing gives an error
+// wrapping ErrPathNotFound.
+//
+// A content path addresses a paragraph, run or table by position, so that
+// it can be stored outside the document and found again after the document
+// is saved and reopened. It lists the elements from the root of the part
+// down to the item, each as its tag and 1-based position among the
+// siblings with that tag: "body/p[12]/r[3]" is the third run of the
+// twelfth paragraph of the body. The position is left out for an element
+// that is the only one with its tag, and a step without one means the
+// first: "body/tbl[2]/tr[2]/tc[1]/p" is the paragraph of a table cell. Tags in the WordprocessingML namespace
+// have no prefix; others keep theirs, as in "mc:AlternateContent[1]".
+// Content of parts other than the main document, such as headers, notes
+// and comments, has the partname first: "/word/header1.xml:p[1]".
+//
+// A path stays valid as long as no content before the item, at any level
+// of the path, is added or removed.
+func (d *Document) Resolve(path string) (any, error) {
+	sp := &d.part.StoryPart
+	steps := path
+	if strings.HasPrefix(path, "/") {
+		partName, rest, ok := strings.Cut(path, ":")
+		if !ok {
+			return nil, fmt.Errorf("docx: path %q has a partname but no steps", path)
+		}
+		part, _ := d.part.Package().PartByName(opc.PackURI(partName))
+		if sp = storyPartOf(part); sp == nil {
+			return nil, fmt.Errorf("docx: resolving %q: %w", path, ErrPathNotFound)
+		}
+		steps = rest
+	}
+	el := sp.Element()
+	for _, step := range strings.Split(steps, "/") {
+		space, tag, n, err := parsePathStep(step)
+		if err != nil {
+			return nil, fmt.Errorf("docx: path %q: %w", path, err)
+		}
+		if el = nthChild(el, space, tag, n); el == nil {
+			return nil, fmt.Errorf("docx: resolving %q: %w", path, ErrPathNotFound)
+		}
+	}
+	switch {
+	case el.Space == "w" && el.Tag == "p":
+		return newParagraph(&oxml.CT_P{Element: oxml.WrapElement(el)}, sp), nil
+	case el.Space == "w" && el.Tag == "r":
+		return newRun(&oxml.CT_R{Element: oxml.WrapElement(el)}, sp), nil
+	case el.Space == "w" && el.Tag == "tbl":
+		return newTable(&oxml.CT_Tbl{Element: oxml.WrapElement(el)}, sp), nil
+	}
+	return nil, fmt.Errorf("docx: path %q addresses a %s, not a paragraph, run or table", path, el.FullTag())
+}
+
+// contentPath returns the content path of el within part.
+func contentPath(el *etree.Element, part *parts.StoryPart) (string, error) {
+	root := part.Element()
+	var steps []string
+	for e := el; e != root; e = e.Parent() {
+		parent := e.Parent()
+		if parent == nil {
+			return "", fmt.Errorf("docx: element is not in its part")
+		}
+		n, count := 0, 0
+		for _, sib := range parent.ChildElements() {
+			if sib.Space == e.Space && sib.Tag == e.Tag {
+				count++
+				if n == 0 && sib == e {
+					n = count
+				}
+			}
+		}
+		step := e.Tag
+		if e.Space != "w" {
+			step = e.FullTag()
+		}
+		if count > 1 {
+			step += "[" + strconv.Itoa(n) + "]"
+		}
+		steps = append(steps, step)
+	}
+	slices.Reverse(steps)
+	path := strings.Join(steps, "/")
+	if root.Space == "w" && root.Tag == "document" {
+		return path, nil
+	}
+	return string(part.PartName()) + ":" + path, nil
+}
+
+// parsePathStep splits a path step such as "p[12]", "body" or
+// "mc:Choice".
+func parsePathStep(step string) (space, tag string, n int, err error) {
+	name, index, indexed := strings.Cut(step, "[")
+	n = 1
+	if indexed {
+		if !strings.HasSuffix(index, "]") {
+			return "", "", 0, fmt.Errorf("malformed step %q", step)
+		}
+		if n, err = strconv.Atoi(strings.TrimSuffix(index, "]")); err != nil || n < 1 {
+			return "", "", 0, fmt.Errorf("malformed step %q", step)
+		}
+	}
+	if name == "" {
+		return "", "", 0, fmt.Errorf("malformed step %q", step)
+	}
+	space, tag, ok := strings.Cut(name, ":")
+	if !ok {
+		space, tag = "w", name
+	}
+	return space, tag, n, nil
+}
+
+// nthChild returns the nth (1-based) child of el with the given tag, or
+// nil.
+func nthChild(el *etree.Element, space, tag string, n int) *etree.Element {
+	for _, child := range el.ChildElements() {
+		if child.Space == space && child.Tag == tag {
+			if n--; n == 0 {
+				return child
+			}
+		}
+	}
+	return nil
+}
+
+// storyPartOf returns the story part of a part holding document content,
+// or nil for any other part.
+func storyPartOf(part opc.Part) *parts.StoryPart {
+	switch p := part.(type) {
+	case *parts.DocumentPart:
+		return &p.StoryPart
+	case *parts.HeaderPart:
+		return &p.StoryPart
+	case *parts.FooterPart:
+		return &p.StoryPart
+	case *parts.FootnotesPart:
+		return &p.StoryPart
+	case *parts.EndnotesPart:
+		return &p.StoryPart
+	case *parts.CommentsPart:
+		return &p.StoryPart
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package docx
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestContentPath_RoundTrip(t *testing.T) {
+	doc := mustNewDoc(t)
+	if _, err := doc.AddParagraph("first"); err != nil {
+		t.Fatal(err)
+	}
+	para, err := doc.AddParagraph("second")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run, err := para.AddRun(" tail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tbl, err := doc.AddTable(2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cell, err := tbl.CellAt(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cell.SetText("cell text")
+	sect, err := doc.Sections().Get(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hp, err := sect.Header().AddParagraph("header text")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paraPath, err := para.Path()
+	if err != nil {
+		t.Fatal(err)
+	}
+	runPath, err := run.Path()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tblPath, err := tbl.Path()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cellPath, err := cell.Paragraphs()[0].Path()
+	if err != nil {
+		t.Fatal(err)
+	}
+	headerPath, err := hp.Path()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := len(mustParagraphs(t, doc)) // "second" is the last body paragraph
+	if want := "body/p[" + strconv.Itoa(n) + "]"; paraPath != want {
+		t.Errorf("paragraph path = %q, want %q", paraPath, want)
+	}
+	if runPath != paraPath+"/r[2]" {
+		t.Errorf("run path = %q", runPath)
+	}
+	if cellPath != tblPath+"/tr[2]/tc[1]/p" {
+		t.Errorf("cell paragraph path = %q, table path %q", cellPath, tblPath)
+	}
+
+	saved, err := doc.SaveBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reopened, err := OpenBytes(saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range map[string]string{paraPath: "second tail", cellPath: "cell text", headerPath: "header text"} {
+		got, err := reopened.Resolve(path)
+		if err != nil {
+			t.Errorf("Resolve(%q): %v", path, err)
+			continue
+		}
+		if p, ok := got.(*Paragraph); !ok || p.Text() != want {
+			t.Errorf("Resolve(%q) = %v, want paragraph %q", path, got, want)
+		}
+	}
+	if got, err := reopened.Resolve(runPath); err != nil || got.(*Run).Text() != " tail" {
+		t.Errorf("Resolve(run) = %v, %v", got, err)
+	}
+	if got, err := reopened.Resolve(tblPath); err != nil {
+		t.Errorf("Resolve(table): %v", err)
+	} else if _, ok := got.(*Table); !ok {
+		t.Errorf("Resolve(table) = %T", got)
+	}
+
+	for _, path := range []string{"body/p[999]", "/word/nothere.xml:p[1]"} {
+		if _, err := reopened.Resolve(path); !errors.Is(err, ErrPathNotFound) {
+			t.Errorf("Resolve(%q): err = %v, want ErrPathNotFound", path, err)
+		}
+	}
+	for _, path := range []string{"body/p[0]", "", "body/p[x]", "body/p[2", "body//p"} {
+		if _, err := reopened.Resolve(path); err == nil || errors.Is(err, ErrPathNotFound) {
+			t.Errorf("Resolve(%q): err = %v, want a malformed path error", path, err)
+		}
+	}
+}
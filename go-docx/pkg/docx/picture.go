@@ -0,0 +1,104 @@
+package docx
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// Pictures returns the inline pictures in this run, in document order.
+// Charts, SmartArt and floating (anchored) shapes are not included.
+func (run *Run) Pictures() []*InlineShape {
+	var result []*InlineShape
+	for _, drawing := range run.r.RawElement().ChildElements() {
+		if !(drawing.Space == "w" && drawing.Tag == "drawing") {
+			continue
+		}
+		for _, inline := range drawing.ChildElements() {
+			if inline.Space == "wp" && inline.Tag == "inline" && findPicInGraphicData(inline) {
+				result = append(result, newInlineShape(&oxml.CT_Inline{Element: oxml.WrapElement(inline)}, run.part))
+			}
+		}
+	}
+	return result
+}
+
+// ImagePart returns the image part holding the picture of this shape.
+// Returns an error for shapes that are not embedded pictures.
+func (is *InlineShape) ImagePart() (*parts.ImagePart, error) {
+	rId := findBlipRId(is.inline.RawElement())
+	if rId == "" {
+		return nil, fmt.Errorf("docx: inline shape does not contain an embedded picture")
+	}
+	if is.part == nil {
+		return nil, fmt.Errorf("docx: inline shape has no story part (required for image resolution)")
+	}
+	p, ok := is.part.Rels().RelatedParts()[rId]
+	if !ok {
+		return nil, fmt.Errorf("docx: no related part for rId %q: %w", rId, ErrPartNotFound)
+	}
+	ip, ok := p.(*parts.ImagePart)
+	if !ok {
+		return nil, fmt.Errorf("docx: related part for rId %q is not an ImagePart", rId)
+	}
+	return ip, nil
+}
+
+// ImageBytes returns the image data of the picture.
+func (is *InlineShape) ImageBytes() ([]byte, error) {
+	ip, err := is.ImagePart()
+	if err != nil {
+		return nil, err
+	}
+	blob, err := ip.Blob()
+	if err != nil {
+		return nil, fmt.Errorf("docx: reading image blob: %w", err)
+	}
+	return blob, nil
+}
+
+// ImageContentType returns the MIME type of the picture, e.g. "image/png".
+func (is *InlineShape) ImageContentType() (string, error) {
+	ip, err := is.ImagePart()
+	if err != nil {
+		return "", err
+	}
+	return ip.ContentType(), nil
+}
+
+// ReplaceImage swaps the picture for the image read from r. The shape keeps
+// its display size, cropping, effects and alternative text; only the
+// image data changes. Identical images are shared with other pictures in
+// the package. The old image is dropped from the package when nothing else
+// refers to it.
+func (is *InlineShape) ReplaceImage(r io.ReadSeeker) error {
+	blip := is.blip()
+	if blip == nil {
+		return fmt.Errorf("docx: inline shape does not contain an embedded picture")
+	}
+	if is.part == nil {
+		return fmt.Errorf("docx: inline shape has no story part (required for image replacement)")
+	}
+	oldRId := findBlipRId(is.inline.RawElement())
+	newRId, _, err := is.part.GetOrAddImageFromReader(r)
+	if err != nil {
+		return fmt.Errorf("docx: replacing image: %w", err)
+	}
+	blip.CreateAttr("r:embed", newRId)
+	if oldRId != newRId && !is.part.HasRelRef(oldRId) {
+		is.part.Rels().Delete(oldRId)
+	}
+	return nil
+}
+
+// blip returns the a:blip element of the picture, or nil.
+func (is *InlineShape) blip() *etree.Element {
+	blips := walkPath(is.inline.RawElement(), "graphic", "graphicData", "pic", "blipFill", "blip")
+	if len(blips) == 0 {
+		return nil
+	}
+	return blips[0]
+}
@@ -0,0 +1,74 @@
+package docx
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+)
+
+func encodePNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestRun_Pictures_ReplaceImage(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	run, err := p.AddRun("")
+	if err != nil {
+		t.Fatalf("AddRun: %v", err)
+	}
+	width := int64(Inches(2))
+	if _, err := run.AddPicture(bytes.NewReader(minimalPNG()), &width, nil); err != nil {
+		t.Fatalf("AddPicture: %v", err)
+	}
+
+	pics := run.Pictures()
+	if len(pics) != 1 {
+		t.Fatalf("Pictures() = %d, want 1", len(pics))
+	}
+	if ct, err := pics[0].ImageContentType(); err != nil || ct != "image/png" {
+		t.Errorf("ImageContentType() = %q, %v", ct, err)
+	}
+	oldRels := len(doc.part.Rels().All())
+
+	logo := encodePNG(t, 3, 2)
+	if err := pics[0].ReplaceImage(bytes.NewReader(logo)); err != nil {
+		t.Fatalf("ReplaceImage: %v", err)
+	}
+	if got, _ := pics[0].Width(); got != Inches(2) {
+		t.Errorf("width after replace = %d, want %d", got, Inches(2))
+	}
+	if n := len(doc.part.Rels().All()); n != oldRels {
+		t.Errorf("relationship count = %d, want %d (old image rel dropped)", n, oldRels)
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	paras := mustParagraphs(t, doc2)
+	pics = paras[len(paras)-1].Runs()[0].Pictures()
+	if len(pics) != 1 {
+		t.Fatalf("Pictures() after round-trip = %d, want 1", len(pics))
+	}
+	data, err := pics[0].ImageBytes()
+	if err != nil {
+		t.Fatalf("ImageBytes: %v", err)
+	}
+	if !bytes.Equal(data, logo) {
+		t.Error("image bytes do not match the replacement image")
+	}
+}
@@ -0,0 +1,208 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// rewriteZip returns data with each member passed through edit and the
+// members of extra added.
+func rewriteZip(t *testing.T, data []byte, edit func(name, body string) string, extra map[string]string) []byte {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	write := func(name, body string) {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		write(f.Name, edit(f.Name, string(b)))
+	}
+	for name, body := range extra {
+		write(name, body)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// zipMember returns the content of member name of data.
+func zipMember(t *testing.T, data []byte, name string) string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range zr.File {
+		if f.Name == name {
+			rc, err := f.Open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rc.Close()
+			b, err := io.ReadAll(rc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return string(b)
+		}
+	}
+	t.Fatalf("no member %s", name)
+	return ""
+}
+
+const preservationBody = `<w:p w14:paraId="1A2B3C4D" w14:textId="77777777">` +
+	`<w:pPr><w:rPr><w14:ligatures w14:val="all"/></w:rPr></w:pPr>` +
+	`<w:r><w:rPr><w:i/><w14:textOutline w14:w="9525"><w14:noFill/></w14:textOutline></w:rPr><w:t>outlined</w:t></w:r></w:p>` +
+	`<w:p><w:r><mc:AlternateContent><mc:Choice Requires="w14"><w14:contentPart/></mc:Choice>` +
+	`<mc:Fallback><w:t>fallback</w:t></mc:Fallback></mc:AlternateContent></w:r></w:p>` +
+	`<w:p><w:r><w:rPr><w:sz w:val="20"/><w:rPrChange w:id="1" w:author="A"><w:rPr/></w:rPrChange></w:rPr><w:t>tracked</w:t></w:r></w:p>` +
+	`<w:sdt><w:sdtPr><w15:appearance w15:val="hidden"/><w:id w:val="5"/></w:sdtPr>` +
+	`<w:sdtContent><w:p><w:r><w:t>control</w:t></w:r></w:p></w:sdtContent></w:sdt>`
+
+// preservationParts are parts of a Word document beyond those New creates.
+// Those without typed support are kept byte for byte.
+var preservationParts = map[string]string{
+	"word/people.xml": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+		`<w15:people xmlns:w15="http://schemas.microsoft.com/office/word/2012/wordml"><w15:person w15:author="A"><w15:presenceInfo w15:providerId="None" w15:userId="A"/></w15:person></w15:people>`,
+	"word/commentsExtended.xml": `<w15:commentsEx xmlns:w15="http://schemas.microsoft.com/office/word/2012/wordml"/>`,
+	"word/glossary/document.xml": `<w:glossaryDocument xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:docParts><w:docPart><w:docPartPr><w:name w:val="Block"/></w:docPartPr><w:docPartBody><w:p/></w:docPartBody></w:docPart></w:docParts></w:glossaryDocument>`,
+	"word/glossary/_rels/document.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/></Relationships>`,
+	"word/glossary/styles.xml": `<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:style w:type="paragraph" w:styleId="G"><w:name w:val="G"/></w:style></w:styles>`,
+}
+
+// newPreservationDoc returns a package with extension markup in its body
+// and parts without typed support.
+func newPreservationDoc(t *testing.T) []byte {
+	t.Helper()
+	data, err := mustNewDoc(t).SaveBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rewriteZip(t, data, func(name, body string) string {
+		switch name {
+		case "word/document.xml":
+			body = strings.Replace(body, "<w:body>", "<w:body>"+preservationBody, 1)
+			if !strings.Contains(body, "xmlns:w15=") {
+				body = strings.Replace(body, "<w:document ", `<w:document xmlns:w15="http://schemas.microsoft.com/office/word/2012/wordml" `, 1)
+			}
+		case "word/_rels/document.xml.rels":
+			body = strings.Replace(body, "</Relationships>",
+				`<Relationship Id="rId90" Type="`+opc.RTPeople+`" Target="people.xml"/>`+
+					`<Relationship Id="rId91" Type="`+opc.RTGlossaryDocument+`" Target="glossary/document.xml"/>`+
+					`<Relationship Id="rId92" Type="`+opc.RTCommentsExtended+`" Target="commentsExtended.xml"/></Relationships>`, 1)
+		case "[Content_Types].xml":
+			body = strings.Replace(body, "</Types>",
+				`<Override PartName="/word/people.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.people+xml"/>`+
+					`<Override PartName="/word/commentsExtended.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.commentsExtended+xml"/>`+
+					`<Override PartName="/word/glossary/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.glossary+xml"/>`+
+					`<Override PartName="/word/glossary/styles.xml" ContentType="`+opc.CTWmlStyles+`"/></Types>`, 1)
+		}
+		return body
+	}, preservationParts)
+}
+
+func TestPreservation_ModifiedDocument(t *testing.T) {
+	original := newPreservationDoc(t)
+	d, err := OpenBytes(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paras, err := d.Paragraphs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	yes := true
+	center := enum.WdParagraphAlignmentCenter
+	size := Pt(14)
+	for _, p := range paras {
+		if err := p.SetAlignment(&center); err != nil {
+			t.Fatal(err)
+		}
+		if err := p.ParagraphFormat().SetKeepWithNext(&yes); err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range p.Runs() {
+			if err := r.Font().SetBold(&yes); err != nil {
+				t.Fatal(err)
+			}
+			if err := r.Font().SetSize(&size); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := p.AddRun("added"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := d.AddParagraph("last"); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := d.SaveBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := opc.CompareFidelity(original, saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Lossless() {
+		t.Errorf("modified document lost content: %+v", r)
+	}
+	for name, body := range preservationParts {
+		if strings.HasSuffix(name, ".rels") || strings.HasPrefix(name, "word/glossary/") {
+			continue // reserialized; compared by CompareFidelity
+		}
+		if got := zipMember(t, saved, name); got != body {
+			t.Errorf("%s changed:\n%s", name, got)
+		}
+	}
+
+	doc := zipMember(t, saved, "word/document.xml")
+	for _, want := range []string{
+		// New run properties go before the extension elements…
+		`<w:rPr><w:b></w:b><w:i></w:i><w:sz w:val="28"></w:sz><w14:textOutline`,
+		// …before alternate content in the run…
+		`<w:r><w:rPr><w:b></w:b><w:sz w:val="28"></w:sz></w:rPr><mc:AlternateContent>`,
+		// …and before tracked property changes.
+		`<w:sz w:val="28"></w:sz><w:rPrChange`,
+		`<w15:appearance w15:val="hidden"></w15:appearance>`,
+	} {
+		if !strings.Contains(stripXmlnsW(doc), want) {
+			t.Errorf("document.xml lacks %s", want)
+		}
+	}
+}
+
+// stripXmlnsW removes the redundant declarations of the w prefix on
+// elements created by the API.
+func stripXmlnsW(s string) string {
+	return strings.ReplaceAll(s, ` xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"`, "")
+}
@@ -0,0 +1,269 @@
+package docx
+
+import (
+	"fmt"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// anonymousAuthor replaces the names recorded on comments and tracked
+// changes, as Word's Document Inspector does.
+const anonymousAuthor = "Author"
+
+// PersonalInfoOptions selects the optional steps of
+// RemovePersonalInformation.
+type PersonalInfoOptions struct {
+	// RemoveComments deletes all comments and their anchors.
+	RemoveComments bool
+	// AcceptRevisions accepts all tracked changes, leaving the text as it
+	// reads with the changes applied and no revision marks.
+	AcceptRevisions bool
+}
+
+// RemovePersonalInformation strips the information Word's Document
+// Inspector reports under "Document Properties and Personal Information"
+// and "Custom XML Data":
+//
+//   - the author, last-modified-by and last-printed core properties, and
+//     the company and manager extended properties;
+//   - the names on comments and tracked changes, which become "Author",
+//     and the list of people who took part in them;
+//   - the revision-session ids (w:rsid* attributes, w:rsids);
+//   - all custom XML data parts, including their bindings to content
+//     controls, which keep their current text;
+//   - the embedded thumbnail.
+//
+// opts may be nil; its options remove comments and tracked changes
+// altogether.
+func (d *Document) RemovePersonalInformation(opts *PersonalInfoOptions) error {
+	if opts == nil {
+		opts = &PersonalInfoOptions{}
+	}
+
+	cpp, err := d.part.CoreProperties()
+	if err != nil {
+		return fmt.Errorf("docx: getting core properties: %w", err)
+	}
+	ct, err := cpp.CT()
+	if err != nil {
+		return fmt.Errorf("docx: getting core properties element: %w", err)
+	}
+	ct.RemoveCreator()
+	ct.RemoveLastModifiedBy()
+	ct.RemoveLastPrinted()
+
+	pkgRels := d.wmlPkg.Rels()
+	for _, rel := range pkgRels.AllByRelType(opc.RTThumbnail) {
+		pkgRels.Delete(rel.RID)
+	}
+	for _, rel := range pkgRels.AllByRelType(opc.RTExtendedProperties) {
+		if err := scrubExtendedProperties(rel.TargetPart); err != nil {
+			return err
+		}
+	}
+
+	docRels := d.part.Rels()
+	drop := []string{opc.RTCustomXml, opc.RTPeople}
+	if opts.RemoveComments {
+		drop = append(drop, opc.RTComments, opc.RTCommentsExtended, opc.RTCommentsIds, opc.RTCommentsExtensible)
+	}
+	for _, relType := range drop {
+		for _, rel := range docRels.AllByRelType(relType) {
+			docRels.Delete(rel.RID)
+		}
+	}
+
+	for _, part := range d.wmlPkg.IterParts() {
+		xp, ok := part.(interface{ Element() *etree.Element })
+		if !ok || xp.Element() == nil {
+			continue
+		}
+		root := xp.Element()
+		if opts.RemoveComments {
+			removeCommentMarks(root)
+		}
+		if opts.AcceptRevisions {
+			acceptRevisions(root)
+		}
+		scrubPersonalInfo(root)
+	}
+	return nil
+}
+
+// scrubPersonalInfo anonymizes the authors and drops the rsids and custom
+// XML bindings under root.
+func scrubPersonalInfo(root *etree.Element) {
+	var dropped []*etree.Element
+	stack := []*etree.Element{root}
+	for len(stack) > 0 {
+		el := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if el.Space == "w" {
+			switch el.Tag {
+			case "rsids", "rsid", "dataBinding":
+				dropped = append(dropped, el)
+				continue
+			}
+		}
+		removeRsidAttrs(el)
+		if el.SelectAttr("w:author") != nil {
+			el.CreateAttr("w:author", anonymousAuthor)
+		}
+		el.RemoveAttr("w:initials")
+		stack = append(stack, el.ChildElements()...)
+	}
+	for _, el := range dropped {
+		el.Parent().RemoveChild(el)
+	}
+}
+
+// scrubExtendedProperties removes the company and manager from the
+// extended properties part (docProps/app.xml).
+func scrubExtendedProperties(part opc.Part) error {
+	bp, ok := part.(interface{ SetBlob([]byte) })
+	if part == nil || !ok {
+		return nil
+	}
+	blob, err := part.Blob()
+	if err != nil {
+		return fmt.Errorf("docx: reading extended properties: %w", err)
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(blob); err != nil {
+		return fmt.Errorf("docx: parsing extended properties: %w", err)
+	}
+	root := doc.Root()
+	if root == nil {
+		return nil
+	}
+	changed := false
+	for _, child := range root.ChildElements() {
+		if child.Tag == "Company" || child.Tag == "Manager" {
+			root.RemoveChild(child)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	out, err := doc.WriteToBytes()
+	if err != nil {
+		return fmt.Errorf("docx: writing extended properties: %w", err)
+	}
+	bp.SetBlob(out)
+	return nil
+}
+
+// removeCommentMarks removes the comment ranges and references under root.
+func removeCommentMarks(root *etree.Element) {
+	for _, tag := range []string{"commentRangeStart", "commentRangeEnd", "commentReference"} {
+		for _, el := range root.FindElements(".//w:" + tag) {
+			parent := el.Parent()
+			parent.RemoveChild(el)
+			if parent.Space == "w" && parent.Tag == "r" && len(runContent(parent)) == 0 {
+				parent.Parent().RemoveChild(parent)
+			}
+		}
+	}
+}
+
+// runContent returns the children of run r other than its properties.
+func runContent(r *etree.Element) []*etree.Element {
+	var result []*etree.Element
+	for _, child := range r.ChildElements() {
+		if !(child.Space == "w" && child.Tag == "rPr") {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+// revisionChangeTags are the elements recording the properties content
+// had before a tracked formatting change.
+var revisionChangeTags = []string{
+	"pPrChange", "rPrChange", "sectPrChange", "tblPrChange", "tblPrExChange",
+	"trPrChange", "tcPrChange", "tblGridChange", "numberingChange",
+}
+
+// acceptRevisions accepts the tracked changes under root: inserted content
+// stays, deleted content goes, and the old formatting of formatting
+// changes is discarded.
+func acceptRevisions(root *etree.Element) {
+	for _, tag := range revisionChangeTags {
+		for _, el := range root.FindElements(".//w:" + tag) {
+			el.Parent().RemoveChild(el)
+		}
+	}
+	for _, tag := range []string{"moveFromRangeStart", "moveFromRangeEnd", "moveToRangeStart", "moveToRangeEnd", "cellIns"} {
+		for _, el := range root.FindElements(".//w:" + tag) {
+			el.Parent().RemoveChild(el)
+		}
+	}
+	for _, el := range root.FindElements(".//w:cellDel") {
+		if tc := el.Parent().Parent(); tc != nil && tc.Parent() != nil {
+			tc.Parent().RemoveChild(tc)
+		}
+	}
+
+	for _, tag := range []string{"del", "moveFrom", "ins", "moveTo"} {
+		deleted := tag == "del" || tag == "moveFrom"
+		for _, el := range root.FindElements(".//w:" + tag) {
+			parent := el.Parent()
+			if parent == nil {
+				continue // inside content removed earlier
+			}
+			switch {
+			case parent.Tag == "trPr" && deleted:
+				if tr := parent.Parent(); tr.Parent() != nil {
+					tr.Parent().RemoveChild(tr)
+				}
+			case parent.Tag == "rPr" && deleted && parent.Parent().Tag == "pPr":
+				parent.RemoveChild(el)
+				mergeWithNextParagraph(parent.Parent().Parent())
+			case parent.Tag == "rPr" || parent.Tag == "trPr" || parent.Tag == "numPr":
+				parent.RemoveChild(el)
+			case deleted:
+				parent.RemoveChild(el)
+			default:
+				idx := childIndex(parent, el)
+				parent.RemoveChild(el)
+				for i, child := range el.ChildElements() {
+					parent.InsertChildAt(idx+i, child)
+				}
+			}
+		}
+	}
+}
+
+// mergeWithNextParagraph joins paragraph p, whose paragraph mark was
+// deleted, to the paragraph after it, which keeps its own properties.
+func mergeWithNextParagraph(p *etree.Element) {
+	parent := p.Parent()
+	if parent == nil {
+		return
+	}
+	siblings := parent.ChildElements()
+	idx := -1
+	for i, el := range siblings {
+		if el == p {
+			idx = i
+		}
+	}
+	if idx < 0 || idx+1 >= len(siblings) || siblings[idx+1].Space != "w" || siblings[idx+1].Tag != "p" {
+		return
+	}
+	next := siblings[idx+1]
+	at := 0
+	if pPr := next.SelectElement("w:pPr"); pPr != nil {
+		at = childIndex(next, pPr) + 1
+	}
+	for _, child := range p.ChildElements() {
+		if child.Space == "w" && child.Tag == "pPr" {
+			continue
+		}
+		next.InsertChildAt(at, child)
+		at++
+	}
+	parent.RemoveChild(p)
+}
@@ -0,0 +1,134 @@
+package docx
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+const personalAppXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Properties xmlns="http://schemas.openxmlformats.org/officeDocument/2006/extended-properties"><Application>Microsoft Office Word</Application><Manager>Mary Major</Manager><Company>Acme Corp</Company><Pages>1</Pages></Properties>`
+
+// personalDoc builds a document carrying personal information of every
+// kind RemovePersonalInformation removes.
+func personalDoc(t *testing.T) *Document {
+	t.Helper()
+	d := mustNewDoc(t)
+	cp, err := d.CoreProperties()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cp.SetAuthor("Jane Roe"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cp.SetLastModifiedBy("John Doe"); err != nil {
+		t.Fatal(err)
+	}
+	p, err := d.AddParagraph("kept")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.p.RawElement().CreateAttr("w:rsidR", "00AB12CD")
+	if _, err := d.AddComment(p.Runs(), "private note", "Jane Roe", Ptr("JR")); err != nil {
+		t.Fatal(err)
+	}
+	ins := p.p.RawElement().CreateElement("w:ins")
+	ins.CreateAttr("w:id", "90")
+	ins.CreateAttr("w:author", "John Doe")
+	ins.CreateElement("w:r").CreateElement("w:t").SetText(" added")
+	del := p.p.RawElement().CreateElement("w:del")
+	del.CreateAttr("w:id", "91")
+	del.CreateAttr("w:author", "John Doe")
+	del.CreateElement("w:r").CreateElement("w:delText").SetText(" removed")
+
+	thumb := opc.NewBasePart("/docProps/thumbnail.jpeg", "image/jpeg", []byte{0xFF, 0xD8}, d.wmlPkg.OpcPackage)
+	d.wmlPkg.AddPart(thumb)
+	d.wmlPkg.Rels().GetOrAdd(opc.RTThumbnail, thumb)
+
+	for _, rel := range d.wmlPkg.Rels().AllByRelType(opc.RTExtendedProperties) {
+		d.wmlPkg.Rels().Delete(rel.RID)
+	}
+	app := opc.NewBasePart("/docProps/app.xml", opc.CTOfcExtendedProperties, []byte(personalAppXML), d.wmlPkg.OpcPackage)
+	d.wmlPkg.AddPart(app)
+	d.wmlPkg.Rels().GetOrAdd(opc.RTExtendedProperties, app)
+	return d
+}
+
+func TestDocument_RemovePersonalInformation(t *testing.T) {
+	d := personalDoc(t)
+	if err := d.RemovePersonalInformation(nil); err != nil {
+		t.Fatalf("RemovePersonalInformation: %v", err)
+	}
+
+	cp, err := d.CoreProperties()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.Author() != "" || cp.LastModifiedBy() != "" {
+		t.Errorf("author = %q, lastModifiedBy = %q", cp.Author(), cp.LastModifiedBy())
+	}
+	if len(d.wmlPkg.Rels().AllByRelType(opc.RTThumbnail)) != 0 {
+		t.Error("thumbnail kept")
+	}
+
+	body := oxml.SerializeForReading(d.element.RawElement())
+	for _, s := range []string{"rsidR", "John Doe"} {
+		if strings.Contains(body, s) {
+			t.Errorf("body still contains %q", s)
+		}
+	}
+	comments, err := d.Comments()
+	if err != nil || comments.Len() != 1 {
+		t.Fatalf("comments = %v, %v; want the comment kept", comments, err)
+	}
+	author, _ := comments.Iter()[0].Author()
+	if author != anonymousAuthor || comments.Iter()[0].Initials() != "" {
+		t.Errorf("comment author = %q, initials = %q", author, comments.Iter()[0].Initials())
+	}
+	if got := mustParagraphs(t, d)[0].p.RawElement().FindElement("w:ins"); got == nil {
+		t.Error("tracked change accepted without AcceptRevisions")
+	}
+
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte("thumbnail")) {
+		t.Error("saved package still has the thumbnail")
+	}
+	app := zipMember(t, buf.Bytes(), "docProps/app.xml")
+	for _, s := range []string{"Company", "Acme Corp", "Manager", "Mary Major"} {
+		if strings.Contains(app, s) {
+			t.Errorf("docProps/app.xml still contains %q", s)
+		}
+	}
+	for _, s := range []string{"<Application>Microsoft Office Word</Application>", "<Pages>1</Pages>"} {
+		if !strings.Contains(app, s) {
+			t.Errorf("docProps/app.xml lost %q: %s", s, app)
+		}
+	}
+}
+
+func TestDocument_RemovePersonalInformation_Options(t *testing.T) {
+	d := personalDoc(t)
+	err := d.RemovePersonalInformation(&PersonalInfoOptions{RemoveComments: true, AcceptRevisions: true})
+	if err != nil {
+		t.Fatalf("RemovePersonalInformation: %v", err)
+	}
+	if d.part.HasCommentsPart() {
+		t.Error("comments part kept")
+	}
+	p := mustParagraphs(t, d)[0]
+	if got := p.Text(); got != "kept added" {
+		t.Errorf("text = %q, want %q", got, "kept added")
+	}
+	el := p.p.RawElement()
+	for _, tag := range []string{"w:ins", "w:del", ".//w:commentReference", "w:commentRangeStart"} {
+		if el.FindElement(tag) != nil {
+			t.Errorf("%s kept", tag)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package docx
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// progressLog records the calls of a ProgressFunc, checking that each
+// stage counts up to its total.
+type progressLog struct {
+	t      *testing.T
+	stages []string
+	last   map[string][2]int
+}
+
+func newProgressLog(t *testing.T) *progressLog {
+	return &progressLog{t: t, last: map[string][2]int{}}
+}
+
+func (l *progressLog) report(stage string, done, total int) {
+	prev, seen := l.last[stage]
+	if !seen {
+		l.stages = append(l.stages, stage)
+	} else if done < prev[0] {
+		l.t.Errorf("%s went back from %d to %d", stage, prev[0], done)
+	}
+	if done > total {
+		l.t.Errorf("%s: done %d > total %d", stage, done, total)
+	}
+	l.last[stage] = [2]int{done, total}
+}
+
+// check verifies the stages were reported in order and finished.
+func (l *progressLog) check(want ...string) {
+	l.t.Helper()
+	if fmt.Sprint(l.stages) != fmt.Sprint(want) {
+		l.t.Errorf("stages = %v, want %v", l.stages, want)
+	}
+	for stage, last := range l.last {
+		if last[0] != last[1] || last[1] == 0 {
+			l.t.Errorf("%s ended at %d of %d", stage, last[0], last[1])
+		}
+	}
+}
+
+func TestProgress(t *testing.T) {
+	d := mustNewDoc(t)
+	for i := range 5 {
+		if _, err := d.AddParagraph(fmt.Sprintf("paragraph %d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	save := newProgressLog(t)
+	var buf bytes.Buffer
+	if err := d.SaveWithOptions(&buf, &SaveOptions{Progress: save.report}); err != nil {
+		t.Fatal(err)
+	}
+	save.check("write")
+
+	open := newProgressLog(t)
+	other, err := OpenBytesWithOptions(buf.Bytes(), &opc.OpenOptions{Progress: open.report})
+	if err != nil {
+		t.Fatal(err)
+	}
+	open.check("read", "load")
+
+	if _, err := other.AddParagraph("added"); err != nil {
+		t.Fatal(err)
+	}
+	cmp := newProgressLog(t)
+	if _, err := CompareWithOptions(d, other, &CompareOptions{Progress: cmp.report}); err != nil {
+		t.Fatal(err)
+	}
+	cmp.check("index", "diff")
+
+	app := newProgressLog(t)
+	if err := d.AppendDocument(other, &AppendOptions{Progress: app.report}); err != nil {
+		t.Fatal(err)
+	}
+	app.check("copy", "import", "insert")
+}
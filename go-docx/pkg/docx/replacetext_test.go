@@ -0,0 +1,123 @@
+package docx
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+func TestDocument_ReplaceText_ReachesBlockSdt(t *testing.T) {
+	doc := mustNewDoc(t)
+	b, err := doc.getBody()
+	if err != nil {
+		t.Fatalf("getBody: %v", err)
+	}
+	sdt := mustParseXml(t, `<w:sdt `+wNS+`><w:sdtPr/><w:sdtContent>`+
+		`<w:p><w:r><w:t>Hello OLD</w:t></w:r></w:p></w:sdtContent></w:sdt>`)
+	b.insertBeforeSectPr(sdt.RawElement())
+
+	n, err := doc.ReplaceText("OLD", "NEW")
+	if err != nil {
+		t.Fatalf("ReplaceText: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("expected 1 replacement, got %d", n)
+	}
+	if got := sdt.RawElement().FindElement(".//w:t").Text(); got != "Hello NEW" {
+		t.Errorf("sdt text = %q, want %q", got, "Hello NEW")
+	}
+}
+
+func TestDocument_ReplaceText_ReachesTextBoxes(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("OLD outside")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	run, err := p.AddRun("")
+	if err != nil {
+		t.Fatalf("AddRun: %v", err)
+	}
+	tb, err := run.AddTextBox(TextBoxOptions{Width: Inches(2), Height: Inches(1)})
+	if err != nil {
+		t.Fatalf("AddTextBox: %v", err)
+	}
+	if _, err := tb.AddParagraph("OLD inside"); err != nil {
+		t.Fatalf("AddParagraph in text box: %v", err)
+	}
+
+	// Wrap the drawing in mc:AlternateContent with a VML fallback copy, as
+	// Word does; the fallback must be updated but not counted.
+	drawing := run.r.RawElement().SelectElement("w:drawing")
+	ac := run.r.RawElement().CreateElement("mc:AlternateContent")
+	choice := ac.CreateElement("mc:Choice")
+	choice.CreateAttr("Requires", "wps")
+	run.r.RawElement().RemoveChild(drawing)
+	choice.AddChild(drawing)
+	fallback := ac.CreateElement("mc:Fallback")
+	fallback.AddChild(tb.Element().Copy())
+
+	n, err := doc.ReplaceText("OLD", "NEW")
+	if err != nil {
+		t.Fatalf("ReplaceText: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("expected 2 replacements, got %d", n)
+	}
+	if got := tb.Text(); got != "\nNEW inside" {
+		t.Errorf("text box text = %q, want %q", got, "\nNEW inside")
+	}
+	for _, el := range fallback.FindElements(".//w:t") {
+		if strings.Contains(el.Text(), "OLD") {
+			t.Errorf("fallback copy not updated: %q", el.Text())
+		}
+	}
+}
+
+func TestDocument_ReplaceText_ReachesFootnotesAndEndnotes(t *testing.T) {
+	doc := mustNewDoc(t)
+	pkg := doc.part.Package()
+	for _, n := range []struct{ partName, ct, rt, xml string }{
+		{"/word/footnotes.xml", opc.CTWmlFootnotes, opc.RTFootnotes,
+			`<w:footnotes ` + wNS + `><w:footnote w:type="separator" w:id="-1"><w:p><w:r><w:separator/></w:r></w:p></w:footnote>` +
+				`<w:footnote w:id="1"><w:p><w:r><w:t>foot OLD</w:t></w:r></w:p></w:footnote></w:footnotes>`},
+		{"/word/endnotes.xml", opc.CTWmlEndnotes, opc.RTEndnotes,
+			`<w:endnotes ` + wNS + `><w:endnote w:id="1"><w:p><w:r><w:t>end OLD</w:t></w:r></w:p></w:endnote></w:endnotes>`},
+	} {
+		part := opc.NewBasePart(opc.PackURI(n.partName), n.ct, []byte(n.xml), pkg)
+		pkg.AddPart(part)
+		doc.part.Rels().GetOrAdd(n.rt, part)
+	}
+
+	// Round-trip so the parts are loaded through the part factory.
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	fp, ep := doc2.part.FootnotesPart(), doc2.part.EndnotesPart()
+	if fp == nil || ep == nil {
+		t.Fatalf("notes parts not loaded: footnotes=%v endnotes=%v", fp != nil, ep != nil)
+	}
+
+	n, err := doc2.ReplaceText("OLD", "NEW")
+	if err != nil {
+		t.Fatalf("ReplaceText: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("expected 2 replacements, got %d", n)
+	}
+	for _, el := range []string{
+		fp.Element().FindElement(".//w:t").Text(),
+		ep.Element().FindElement(".//w:t").Text(),
+	} {
+		if !strings.HasSuffix(el, "NEW") {
+			t.Errorf("note text = %q, want suffix NEW", el)
+		}
+	}
+}
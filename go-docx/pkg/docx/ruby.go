@@ -0,0 +1,128 @@
+package docx
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// Ruby is a phonetic guide (w:ruby): small guide text, such as Japanese
+// furigana, set above its base text. The base text is part of the text of
+// the run and paragraph; the guide text is not.
+type Ruby struct {
+	ruby *etree.Element
+}
+
+// Ruby returns the phonetic guide of the run, or nil if it has none.
+func (run *Run) Ruby() *Ruby {
+	if ruby := run.r.RawElement().SelectElement("w:ruby"); ruby != nil {
+		return &Ruby{ruby: ruby}
+	}
+	return nil
+}
+
+// Base returns the base text the guide is set above.
+func (rb *Ruby) Base() string {
+	var sb strings.Builder
+	for _, r := range oxml.RubyBaseRuns(rb.ruby) {
+		sb.WriteString((&oxml.CT_R{Element: oxml.WrapElement(r)}).RunText())
+	}
+	return sb.String()
+}
+
+// Guide returns the guide text, e.g. the furigana reading of the base.
+func (rb *Ruby) Guide() string {
+	var sb strings.Builder
+	if rt := rb.ruby.SelectElement("w:rt"); rt != nil {
+		for _, r := range rt.SelectElements("w:r") {
+			sb.WriteString((&oxml.CT_R{Element: oxml.WrapElement(r)}).RunText())
+		}
+	}
+	return sb.String()
+}
+
+// SetGuide replaces the guide text, in the formatting of its first run.
+func (rb *Ruby) SetGuide(text string) {
+	setRubyText(rb.ruby, "w:rt", text)
+}
+
+// SetBase replaces the base text, in the formatting of its first run.
+func (rb *Ruby) SetBase(text string) {
+	setRubyText(rb.ruby, "w:rubyBase", text)
+}
+
+// Language returns the language of the guide, such as "ja-JP", or "".
+func (rb *Ruby) Language() string {
+	if lid := rb.ruby.FindElement("w:rubyPr/w:lid"); lid != nil {
+		return lid.SelectAttrValue("w:val", "")
+	}
+	return ""
+}
+
+// Default phonetic guide layout, that of Word for 10.5 pt Japanese text:
+// sizes and the raise are in half-points.
+const (
+	rubyGuideSize = 10
+	rubyRaise     = 18
+	rubyBaseSize  = 21
+)
+
+// AddRuby appends a run of base text with the phonetic guide text above
+// it, as Word's Phonetic Guide command does, and returns the run. The
+// guide is laid out for Japanese furigana over 10.5 pt text, centred.
+func (para *Paragraph) AddRuby(base, guide string) (*Run, error) {
+	run, err := para.AddRun("")
+	if err != nil {
+		return nil, err
+	}
+	ruby := run.r.RawElement().CreateElement("w:ruby")
+	pr := ruby.CreateElement("w:rubyPr")
+	for _, prop := range []struct{ tag, val string }{
+		{"w:rubyAlign", "center"},
+		{"w:hps", strconv.Itoa(rubyGuideSize)},
+		{"w:hpsRaise", strconv.Itoa(rubyRaise)},
+		{"w:hpsBaseText", strconv.Itoa(rubyBaseSize)},
+		{"w:lid", "ja-JP"},
+	} {
+		pr.CreateElement(prop.tag).CreateAttr("w:val", prop.val)
+	}
+	rt := ruby.CreateElement("w:rt").CreateElement("w:r")
+	rt.CreateElement("w:rPr").CreateElement("w:sz").CreateAttr("w:val", strconv.Itoa(rubyGuideSize))
+	(&oxml.CT_R{Element: oxml.WrapElement(rt)}).SetRunText(guide)
+	rb := ruby.CreateElement("w:rubyBase").CreateElement("w:r")
+	(&oxml.CT_R{Element: oxml.WrapElement(rb)}).SetRunText(base)
+	return run, nil
+}
+
+// setRubyText replaces the runs of the w:rt or w:rubyBase child of ruby
+// with one run of text, keeping the properties of the first.
+func setRubyText(ruby *etree.Element, tag, text string) {
+	parent := ruby.SelectElement(tag)
+	if parent == nil {
+		parent = etree.NewElement(tag)
+		if tag == "w:rt" {
+			ruby.InsertChildAt(rubyRtIndex(ruby), parent)
+		} else {
+			ruby.AddChild(parent)
+		}
+	}
+	r := etree.NewElement("w:r")
+	if rPr := parent.FindElement("w:r/w:rPr"); rPr != nil {
+		r.AddChild(rPr.Copy())
+	}
+	for _, c := range parent.ChildElements() {
+		parent.RemoveChild(c)
+	}
+	parent.AddChild(r)
+	(&oxml.CT_R{Element: oxml.WrapElement(r)}).SetRunText(text)
+}
+
+// rubyRtIndex returns where w:rt goes in ruby: after w:rubyPr.
+func rubyRtIndex(ruby *etree.Element) int {
+	if pr := ruby.SelectElement("w:rubyPr"); pr != nil {
+		return pr.Index() + 1
+	}
+	return 0
+}
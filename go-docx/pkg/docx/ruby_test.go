@@ -0,0 +1,63 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParagraph_AddRuby(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	run, err := p.AddRuby("漢字", "かんじ")
+	if err != nil {
+		t.Fatalf("AddRuby: %v", err)
+	}
+	if _, err := p.AddRun("を読む"); err != nil {
+		t.Fatalf("AddRun: %v", err)
+	}
+	if got := p.Text(); got != "漢字を読む" {
+		t.Errorf("Text() = %q, want the base text only", got)
+	}
+	if got := run.Text(); got != "漢字" {
+		t.Errorf("run Text() = %q, want %q", got, "漢字")
+	}
+	rb := run.Ruby()
+	if rb == nil {
+		t.Fatal("Ruby() = nil")
+	}
+	if rb.Base() != "漢字" || rb.Guide() != "かんじ" || rb.Language() != "ja-JP" {
+		t.Errorf("ruby = %q over %q in %q", rb.Guide(), rb.Base(), rb.Language())
+	}
+	rb.SetGuide("カンジ")
+	if rb.Guide() != "カンジ" {
+		t.Errorf("Guide() after SetGuide = %q", rb.Guide())
+	}
+	if sz := rb.ruby.FindElement("w:rt/w:r/w:rPr/w:sz"); sz == nil {
+		t.Error("SetGuide dropped the guide run formatting")
+	}
+	if p.Runs()[1].Ruby() != nil {
+		t.Error("plain run reports a ruby")
+	}
+
+	if n, err := doc.ReplaceText("漢字を", "日本語を"); err != nil || n != 1 {
+		t.Fatalf("ReplaceText = %d, %v", n, err)
+	}
+	if got := p.Text(); got != "日本語を読む" {
+		t.Errorf("Text() after ReplaceText = %q", got)
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	text, err := ExtractTextFast(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ExtractTextFast: %v", err)
+	}
+	if text != "日本語を読む" {
+		t.Errorf("ExtractTextFast = %q, want the base text only", text)
+	}
+}
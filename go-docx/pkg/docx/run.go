@@ -3,6 +3,7 @@ package docx
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/vortex/go-docx/pkg/docx/enum"
 	"github.com/vortex/go-docx/pkg/docx/oxml"
@@ -80,7 +81,7 @@ func (run *Run) AddPicture(r io.ReadSeeker, width, height *int64) (*InlineShape,
 		return nil, fmt.Errorf("docx: creating pic inline from stream: %w", err)
 	}
 	run.r.AddDrawingWithInline(inline)
-	return newInlineShape(inline), nil
+	return newInlineShape(inline, run.part), nil
 }
 
 // AddPictureFromPart adds an inline picture from a pre-built ImagePart.
@@ -91,7 +92,7 @@ func (run *Run) AddPictureFromPart(imgPart *parts.ImagePart, width, height *int6
 		return nil, fmt.Errorf("docx: creating pic inline: %w", err)
 	}
 	run.r.AddDrawingWithInline(inline)
-	return newInlineShape(inline), nil
+	return newInlineShape(inline, run.part), nil
 }
 
 // AddTab adds a <w:tab/> element at the end of the run.
@@ -101,11 +102,54 @@ func (run *Run) AddTab() {
 	run.r.AddTab()
 }
 
-// AddText appends a <w:t> element with the given text to the run.
+// AddSymbol appends character char of font to the run as a symbol
+// (w:sym), as Word's Insert > Symbol does for symbol fonts such as Symbol
+// and Wingdings: it is drawn in font whatever the font of the run. Codes
+// 0x20-0xFF are stored in the U+F0xx form Word uses for symbol fonts. Its
+// text equivalent, in Text, is the Unicode character of the glyph where
+// known, e.g. "☑" for Wingdings 0xFE.
+func (run *Run) AddSymbol(font string, char rune) error {
+	if font == "" {
+		return fmt.Errorf("docx: symbol needs a font")
+	}
+	if char < 0x20 || char > 0xFFFF {
+		return fmt.Errorf("docx: invalid symbol character code %#x", char)
+	}
+	if char <= 0xFF {
+		char |= 0xF000
+	}
+	sym := run.r.RawElement().CreateElement("w:sym")
+	sym.CreateAttr("w:font", font)
+	sym.CreateAttr("w:char", fmt.Sprintf("%04X", char))
+	return nil
+}
+
+// AddText appends a <w:t> element with the given text to the run. A
+// non-breaking hyphen (U+2011) or soft hyphen (U+00AD) in text becomes a
+// <w:noBreakHyphen> or <w:softHyphen> element, as Word stores them.
 //
 // Mirrors Python Run.add_text.
 func (run *Run) AddText(text string) {
-	run.r.AddTWithText(text)
+	for {
+		i := strings.IndexAny(text, oxml.NoBreakHyphen+oxml.SoftHyphen)
+		if i < 0 {
+			run.r.AddTWithText(text)
+			return
+		}
+		if i > 0 {
+			run.r.AddTWithText(text[:i])
+		}
+		if strings.HasPrefix(text[i:], oxml.NoBreakHyphen) {
+			run.r.AddNoBreakHyphen()
+			text = text[i+len(oxml.NoBreakHyphen):]
+		} else {
+			run.r.AddSoftHyphen()
+			text = text[i+len(oxml.SoftHyphen):]
+		}
+		if text == "" {
+			return
+		}
+	}
 }
 
 // Bold returns the tri-state bold value (delegates to Font).
@@ -178,7 +222,12 @@ func (run *Run) Style() (*oxml.CT_Style, error) {
 	return run.part.GetStyle(styleID, enum.WdStyleTypeCharacter)
 }
 
-// SetStyle sets the character style. style can be a string name or nil.
+// SetStyle sets the character style, given by its UI name (a StyleName),
+// as a style object, or nil for the default character style. Naming a
+// paragraph style that is linked to a character style, such as "Heading
+// 1", applies the linked style ("Heading 1 Char"), as in Word. An unknown
+// name gives an error matching ErrStyleNotFound, and a style of another
+// type with no linked character style one matching ErrWrongStyleType.
 //
 // Mirrors Python Run.style (setter).
 func (run *Run) SetStyle(style StyleRef) error {
@@ -191,14 +240,25 @@ func (run *Run) setStyleRaw(raw any) error {
 	if err != nil {
 		return err
 	}
-	return run.r.SetStyle(styleID)
+	prev, err := run.r.Style()
+	if err != nil {
+		return err
+	}
+	if err := run.r.SetStyle(styleID); err != nil {
+		return err
+	}
+	return styleApplied(run.part, run, styleID, func() error { return run.r.SetStyle(prev) })
 }
 
-// Text returns the textual content of this run.
+// Text returns the textual content of this run. Options set what tabs,
+// breaks and drawings read as, see TextOption.
 //
 // Mirrors Python Run.text (getter).
-func (run *Run) Text() string {
-	return run.r.RunText()
+func (run *Run) Text(opts ...TextOption) string {
+	if len(opts) == 0 {
+		return run.r.RunText()
+	}
+	return run.r.RunTextWith(newTextConversion(opts))
 }
 
 // SetText replaces all run content with elements representing the given text.
@@ -1,6 +1,7 @@
 package docx
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/vortex/go-docx/pkg/docx/enum"
@@ -105,6 +106,7 @@ func TestRun_AddText(t *testing.T) {
 		{"add_to_existing", `<w:t>foo</w:t>`, "bar"},
 		{"add_trailing_space", ``, "fo "},
 		{"add_mid_space", ``, "f o"},
+		{"add_hyphens", ``, "e\u2011mail hy\u00adphen"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -126,6 +128,23 @@ func TestRun_AddText(t *testing.T) {
 	}
 }
 
+func TestRun_AddText_Hyphens(t *testing.T) {
+	r := makeR(t, ``)
+	run := newRun(r, nil)
+	run.AddText("e\u2011mail hy\u00adphen")
+	var tags []string
+	for _, child := range r.RawElement().ChildElements() {
+		tags = append(tags, child.Tag)
+	}
+	want := []string{"t", "noBreakHyphen", "t", "softHyphen", "t"}
+	if strings.Join(tags, " ") != strings.Join(want, " ") {
+		t.Errorf("run children = %v, want %v", tags, want)
+	}
+	if got := r.RawElement().ChildElements()[2].Text(); got != "mail hy" {
+		t.Errorf("middle w:t = %q, want %q", got, "mail hy")
+	}
+}
+
 // Mirrors Python: it_can_add_a_break (6 break types)
 func TestRun_AddBreak(t *testing.T) {
 	tests := []struct {
@@ -207,6 +226,7 @@ func TestRun_Text(t *testing.T) {
 		{"simple", `<w:t>foobar</w:t>`, "foobar"},
 		{"mixed_tab_cr", `<w:t>abc</w:t><w:tab/><w:t>def</w:t><w:cr/>`, "abc\tdef\n"},
 		{"page_break_and_tab", `<w:br w:type="page"/><w:t>abc</w:t><w:t>def</w:t><w:tab/>`, "abcdef\t"},
+		{"hyphens", `<w:t>e</w:t><w:noBreakHyphen/><w:t>mail</w:t><w:softHyphen/>`, "e\u2011mail\u00ad"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -230,6 +250,7 @@ func TestRun_SetText(t *testing.T) {
 		{"with_tab", "abc\tdef", "abc\tdef"},
 		{"with_newline", "abc\ndef", "abc\ndef"},
 		{"with_cr", "abc\rdef", "abc\ndef"},
+		{"with_hyphens", "e\u2011mail hy\u00adphen", "e\u2011mail hy\u00adphen"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
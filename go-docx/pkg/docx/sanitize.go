@@ -0,0 +1,340 @@
+package docx
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// SanitizePolicy selects what Sanitize leaves in place. The zero policy
+// removes everything Sanitize knows how to remove.
+type SanitizePolicy struct {
+	// KeepMacros keeps the VBA project and the macro-enabled content type.
+	KeepMacros bool
+	// KeepWebLinks keeps external hyperlinks to http, https and mailto
+	// targets. Other external targets are removed either way.
+	KeepWebLinks bool
+	// KeepEmbeddedObjects keeps embedded and linked OLE objects and
+	// imported alternative-format chunks (w:altChunk).
+	KeepEmbeddedObjects bool
+	// KeepActiveX keeps ActiveX controls.
+	KeepActiveX bool
+	// KeepFields keeps the fields that fetch content when updated:
+	// INCLUDETEXT, INCLUDEPICTURE, LINK, DDE and DDEAUTO.
+	KeepFields bool
+}
+
+// SanitizeKind identifies what a SanitizedItem was.
+type SanitizeKind int
+
+const (
+	// SanitizedMacros is a VBA project or key bindings to its macros.
+	SanitizedMacros SanitizeKind = iota
+	// SanitizedExternalTarget is a relationship to a target outside the
+	// package: a hyperlink, linked image, attached template and the like.
+	SanitizedExternalTarget
+	// SanitizedEmbeddedObject is an OLE object or an alternative-format
+	// chunk.
+	SanitizedEmbeddedObject
+	// SanitizedField is a field that fetches content when updated.
+	SanitizedField
+	// SanitizedActiveX is an ActiveX control.
+	SanitizedActiveX
+)
+
+// sanitizeKindNames are the kind names, indexed by SanitizeKind.
+var sanitizeKindNames = [...]string{"macros", "external target", "embedded object", "field", "ActiveX control"}
+
+// String returns a short description of the kind, e.g. "field".
+func (k SanitizeKind) String() string {
+	if k < 0 || int(k) >= len(sanitizeKindNames) {
+		return fmt.Sprintf("SanitizeKind(%d)", int(k))
+	}
+	return sanitizeKindNames[k]
+}
+
+// SanitizedItem is one thing Sanitize removed.
+type SanitizedItem struct {
+	Kind SanitizeKind
+	// Part is the partname of the part it was removed from, or "/" for a
+	// package relationship.
+	Part string
+	// Detail is the external target or the partname of the removed part,
+	// or the instruction of a removed field.
+	Detail string
+}
+
+// SanitizeReport lists what Sanitize removed, in the order it did so.
+type SanitizeReport struct {
+	Items []SanitizedItem
+}
+
+// Count returns the number of items of kind k removed.
+func (r *SanitizeReport) Count(k SanitizeKind) int {
+	n := 0
+	for _, it := range r.Items {
+		if it.Kind == k {
+			n++
+		}
+	}
+	return n
+}
+
+// Clean reports whether nothing was removed.
+func (r *SanitizeReport) Clean() bool { return len(r.Items) == 0 }
+
+func (r *SanitizeReport) add(k SanitizeKind, part opc.PackURI, detail string) {
+	r.Items = append(r.Items, SanitizedItem{Kind: k, Part: string(part), Detail: detail})
+}
+
+// autoUpdateFields are the field types that fetch content from outside the
+// document when Word updates them, which it may do on open.
+var autoUpdateFields = map[string]bool{
+	"INCLUDETEXT": true, "INCLUDE": true, "INCLUDEPICTURE": true, "IMPORT": true,
+	"LINK": true, "DDE": true, "DDEAUTO": true,
+}
+
+// Sanitize removes the active and external content of d, for documents
+// from untrusted sources:
+//
+//   - the VBA project and macro key bindings; a macro-enabled document or
+//     template becomes a plain one;
+//   - ActiveX controls;
+//   - embedded and linked OLE objects and w:altChunk imports;
+//   - INCLUDETEXT, INCLUDEPICTURE, LINK, DDE and DDEAUTO fields, which
+//     are replaced by their cached result;
+//   - every relationship to an external target. Hyperlinks lose their
+//     link but keep their text; linked images lose their link; other
+//     elements referring to a removed target, such as an attached
+//     template, are removed.
+//
+// All parts are sanitized, headers, footers, notes and comments included.
+// policy may be nil; its fields keep some of the content. The returned
+// report lists what was removed.
+func Sanitize(d *Document, policy *SanitizePolicy) *SanitizeReport {
+	if policy == nil {
+		policy = &SanitizePolicy{}
+	}
+	report := &SanitizeReport{}
+	if !policy.KeepMacros {
+		d.sanitizeMacros(report)
+	}
+	for _, part := range d.wmlPkg.IterParts() {
+		rels := part.Rels()
+		if rels == nil {
+			continue
+		}
+		var root *etree.Element
+		if xp, ok := part.(interface{ Element() *etree.Element }); ok {
+			root = xp.Element()
+		}
+		s := &sanitizer{policy: policy, report: report, source: part.PartName(), rels: rels}
+		if root != nil {
+			s.objects(root)
+			if !policy.KeepFields {
+				s.fields(root)
+			}
+		}
+		s.externals(root)
+		s.deleteRels()
+	}
+	s := &sanitizer{policy: policy, report: report, source: opc.PackageURI, rels: d.wmlPkg.Rels()}
+	s.externals(nil)
+	s.deleteRels()
+	return report
+}
+
+// sanitizeMacros drops the VBA project and the key bindings, and gives the
+// main part the content type of the macro-free flavor.
+func (d *Document) sanitizeMacros(report *SanitizeReport) {
+	kind := d.ContentTypeKind()
+	rels := d.part.Rels()
+	for _, relType := range []string{opc.RTVbaProject, opc.RTKeyMapCustomizations} {
+		for _, rel := range rels.AllByRelType(relType) {
+			report.add(SanitizedMacros, d.part.PartName(), relTarget(rel))
+			rels.Delete(rel.RID)
+		}
+	}
+	if kind.IsMacroEnabled() {
+		d.stripMacros()
+		if kind.IsTemplate() {
+			d.part.SetContentType(opc.CTWmlTemplateMain)
+		}
+	}
+}
+
+// relTarget returns the partname of the target of rel, or its reference
+// if it is external or missing.
+func relTarget(rel *opc.Relationship) string {
+	if !rel.IsExternal && rel.TargetPart != nil {
+		return string(rel.TargetPart.PartName())
+	}
+	return rel.TargetRef
+}
+
+// sanitizer removes content from the XML of one part and records the
+// relationships to delete once the XML no longer refers to them.
+type sanitizer struct {
+	policy *SanitizePolicy
+	report *SanitizeReport
+	source opc.PackURI
+	rels   *opc.Relationships
+	drop   []string
+}
+
+// dropRel records the relationship rID for deletion and reports it as
+// kind k. An unknown id is reported without a target; one already dropped
+// is ignored.
+func (s *sanitizer) dropRel(rID string, k SanitizeKind) {
+	rel := s.rels.GetByRID(rID)
+	if rel == nil {
+		s.report.add(k, s.source, "")
+		return
+	}
+	for _, id := range s.drop {
+		if id == rID {
+			return
+		}
+	}
+	s.report.add(k, s.source, relTarget(rel))
+	s.drop = append(s.drop, rID)
+}
+
+func (s *sanitizer) deleteRels() {
+	for _, rID := range s.drop {
+		s.rels.Delete(rID)
+	}
+	s.drop = nil
+}
+
+// objects removes the ActiveX controls, OLE objects and alternative-format
+// chunks under root the policy does not keep. A control or object goes
+// with its enclosing w:object, preview image included.
+func (s *sanitizer) objects(root *etree.Element) {
+	var removed []*etree.Element
+	seen := map[*etree.Element]bool{}
+	remove := func(el *etree.Element) {
+		target := el
+		for a := el.Parent(); a != nil; a = a.Parent() {
+			if a.Space == "w" && a.Tag == "object" {
+				target = a
+				break
+			}
+		}
+		if !seen[target] {
+			seen[target] = true
+			removed = append(removed, target)
+		}
+	}
+	stack := []*etree.Element{root}
+	for len(stack) > 0 {
+		el := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for i := len(el.Child) - 1; i >= 0; i-- {
+			if child, ok := el.Child[i].(*etree.Element); ok {
+				stack = append(stack, child)
+			}
+		}
+		switch {
+		case el.Space == "w" && el.Tag == "control":
+			if !s.policy.KeepActiveX {
+				s.dropRel(el.SelectAttrValue("r:id", ""), SanitizedActiveX)
+				remove(el)
+			}
+		case el.Tag == "OLEObject" && el.NamespaceURI() == officeNS,
+			el.Space == "w" && (el.Tag == "objectEmbed" || el.Tag == "objectLink" || el.Tag == "altChunk"):
+			if !s.policy.KeepEmbeddedObjects {
+				s.dropRel(el.SelectAttrValue("r:id", ""), SanitizedEmbeddedObject)
+				remove(el)
+			}
+		}
+	}
+	for _, el := range removed {
+		if p := el.Parent(); p != nil {
+			p.RemoveChild(el)
+		}
+	}
+}
+
+// fields replaces the fields under root that fetch outside content with
+// their cached result.
+func (s *sanitizer) fields(root *etree.Element) {
+	for _, f := range oxml.ScanFields(root) {
+		instr := f.Instruction()
+		words := strings.Fields(instr)
+		if len(words) == 0 || !autoUpdateFields[strings.ToUpper(words[0])] {
+			continue
+		}
+		s.report.add(SanitizedField, s.source, strings.TrimSpace(instr))
+		f.Unlink()
+	}
+}
+
+// externals drops the external relationships the policy does not keep and
+// clears the references to them under root, which may be nil.
+func (s *sanitizer) externals(root *etree.Element) {
+	gone := map[string]bool{}
+	for _, rel := range s.rels.All() {
+		if !rel.IsExternal {
+			continue
+		}
+		if s.policy.KeepWebLinks && rel.RelType == opc.RTHyperlink && isWebTarget(rel.TargetRef) {
+			continue
+		}
+		s.dropRel(rel.RID, SanitizedExternalTarget)
+		gone[rel.RID] = true
+	}
+	if root == nil || len(gone) == 0 {
+		return
+	}
+	var removed []*etree.Element
+	stack := []*etree.Element{root}
+	for len(stack) > 0 {
+		el := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		drop := false
+		for i := len(el.Attr) - 1; i >= 0; i-- {
+			a := el.Attr[i]
+			if !isRelAttr(a) || !gone[a.Value] {
+				continue
+			}
+			if a.Key == "link" || (el.Space == "w" && el.Tag == "hyperlink") {
+				el.RemoveAttr(a.FullKey())
+			} else {
+				drop = true
+			}
+		}
+		if drop {
+			removed = append(removed, el)
+			continue
+		}
+		stack = append(stack, el.ChildElements()...)
+	}
+	for _, el := range removed {
+		if p := el.Parent(); p != nil {
+			p.RemoveChild(el)
+		}
+	}
+}
+
+// isRelAttr reports whether a is in the relationships namespace.
+func isRelAttr(a etree.Attr) bool {
+	return a.Space == "r" || (a.Space != "" && a.NamespaceURI() == opc.NsOfcRelationships)
+}
+
+// isWebTarget reports whether target is an http, https or mailto URL.
+func isWebTarget(target string) bool {
+	u, err := url.Parse(strings.TrimSpace(target))
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "mailto":
+		return true
+	}
+	return false
+}
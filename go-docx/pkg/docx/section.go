@@ -1,6 +1,7 @@
 package docx
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/beevik/etree"
@@ -28,42 +29,108 @@ func (s *Section) BottomMargin() (*int, error) { return s.sectPr.BottomMargin()
 // SetBottomMargin sets the bottom margin in twips.
 func (s *Section) SetBottomMargin(v *int) error { return s.sectPr.SetBottomMargin(v) }
 
+// BottomMarginLength returns the bottom margin as a Length, or nil if not set.
+func (s *Section) BottomMarginLength() (*Length, error) { return twipsLength(s.sectPr.BottomMargin()) }
+
+// SetBottomMarginLength sets the bottom margin. Passing nil removes it.
+func (s *Section) SetBottomMarginLength(v *Length) error {
+	return s.sectPr.SetBottomMargin(lengthTwips(v))
+}
+
 // TopMargin returns the top margin in twips, or nil if not set.
 func (s *Section) TopMargin() (*int, error) { return s.sectPr.TopMargin() }
 
 // SetTopMargin sets the top margin in twips.
 func (s *Section) SetTopMargin(v *int) error { return s.sectPr.SetTopMargin(v) }
 
+// TopMarginLength returns the top margin as a Length, or nil if not set.
+func (s *Section) TopMarginLength() (*Length, error) { return twipsLength(s.sectPr.TopMargin()) }
+
+// SetTopMarginLength sets the top margin. Passing nil removes it.
+func (s *Section) SetTopMarginLength(v *Length) error { return s.sectPr.SetTopMargin(lengthTwips(v)) }
+
 // LeftMargin returns the left margin in twips, or nil if not set.
 func (s *Section) LeftMargin() (*int, error) { return s.sectPr.LeftMargin() }
 
 // SetLeftMargin sets the left margin in twips.
 func (s *Section) SetLeftMargin(v *int) error { return s.sectPr.SetLeftMargin(v) }
 
+// LeftMarginLength returns the left margin as a Length, or nil if not set.
+func (s *Section) LeftMarginLength() (*Length, error) { return twipsLength(s.sectPr.LeftMargin()) }
+
+// SetLeftMarginLength sets the left margin. Passing nil removes it.
+func (s *Section) SetLeftMarginLength(v *Length) error { return s.sectPr.SetLeftMargin(lengthTwips(v)) }
+
 // RightMargin returns the right margin in twips, or nil if not set.
 func (s *Section) RightMargin() (*int, error) { return s.sectPr.RightMargin() }
 
 // SetRightMargin sets the right margin in twips.
 func (s *Section) SetRightMargin(v *int) error { return s.sectPr.SetRightMargin(v) }
 
+// RightMarginLength returns the right margin as a Length, or nil if not set.
+func (s *Section) RightMarginLength() (*Length, error) { return twipsLength(s.sectPr.RightMargin()) }
+
+// SetRightMarginLength sets the right margin. Passing nil removes it.
+func (s *Section) SetRightMarginLength(v *Length) error {
+	return s.sectPr.SetRightMargin(lengthTwips(v))
+}
+
 // PageWidth returns the page width in twips, or nil if not set.
 func (s *Section) PageWidth() (*int, error) { return s.sectPr.PageWidth() }
 
 // SetPageWidth sets the page width in twips.
 func (s *Section) SetPageWidth(v *int) error { return s.sectPr.SetPageWidth(v) }
 
+// PageWidthLength returns the page width as a Length, or nil if not set.
+func (s *Section) PageWidthLength() (*Length, error) { return twipsLength(s.sectPr.PageWidth()) }
+
+// SetPageWidthLength sets the page width. Passing nil removes it.
+func (s *Section) SetPageWidthLength(v *Length) error { return s.sectPr.SetPageWidth(lengthTwips(v)) }
+
 // PageHeight returns the page height in twips, or nil if not set.
 func (s *Section) PageHeight() (*int, error) { return s.sectPr.PageHeight() }
 
 // SetPageHeight sets the page height in twips.
 func (s *Section) SetPageHeight(v *int) error { return s.sectPr.SetPageHeight(v) }
 
+// PageHeightLength returns the page height as a Length, or nil if not set.
+func (s *Section) PageHeightLength() (*Length, error) { return twipsLength(s.sectPr.PageHeight()) }
+
+// SetPageHeightLength sets the page height. Passing nil removes it.
+func (s *Section) SetPageHeightLength(v *Length) error { return s.sectPr.SetPageHeight(lengthTwips(v)) }
+
 // Orientation returns the page orientation.
 func (s *Section) Orientation() (enum.WdOrientation, error) { return s.sectPr.Orientation() }
 
 // SetOrientation sets the page orientation.
 func (s *Section) SetOrientation(v enum.WdOrientation) error { return s.sectPr.SetOrientation(v) }
 
+// TextDirection returns the direction text flows on the pages of the
+// section, or nil if not set.
+func (s *Section) TextDirection() (*enum.WdTextOrientation, error) {
+	return s.sectPr.TextDirectionVal()
+}
+
+// SetTextDirection sets the direction text flows on the pages of the
+// section. Passing nil removes it.
+func (s *Section) SetTextDirection(v *enum.WdTextOrientation) error {
+	return s.sectPr.SetTextDirectionVal(v)
+}
+
+// VerticalAlignment returns how the text is placed between the top and
+// bottom margins of the pages of the section, or nil if not set, which
+// means at the top.
+func (s *Section) VerticalAlignment() (*enum.WdVerticalAlignment, error) {
+	return s.sectPr.VAlignVal()
+}
+
+// SetVerticalAlignment sets how the text is placed between the top and
+// bottom margins, such as WdVerticalAlignmentCenter for a title page in a
+// section of its own. Passing nil removes it.
+func (s *Section) SetVerticalAlignment(v *enum.WdVerticalAlignment) error {
+	return s.sectPr.SetVAlignVal(v)
+}
+
 // StartType returns the section start type.
 func (s *Section) StartType() (enum.WdSectionStart, error) { return s.sectPr.StartType() }
 
@@ -76,18 +143,53 @@ func (s *Section) Gutter() (*int, error) { return s.sectPr.GutterMargin() }
 // SetGutter sets the gutter in twips.
 func (s *Section) SetGutter(v *int) error { return s.sectPr.SetGutterMargin(v) }
 
+// GutterLength returns the gutter as a Length, or nil if not set.
+func (s *Section) GutterLength() (*Length, error) { return twipsLength(s.sectPr.GutterMargin()) }
+
+// SetGutterLength sets the gutter. Passing nil removes it.
+func (s *Section) SetGutterLength(v *Length) error { return s.sectPr.SetGutterMargin(lengthTwips(v)) }
+
+// GutterOnRight returns true if the gutter is on the right of the pages.
+func (s *Section) GutterOnRight() bool { return s.sectPr.RtlGutterVal() }
+
+// SetGutterOnRight sets whether the gutter is on the right of the pages
+// rather than the left, for documents bound on the right as in
+// right-to-left languages. With mirror margins the gutter is on the
+// inside either way, and Settings.SetGutterAtTop moves it to the top.
+func (s *Section) SetGutterOnRight(v bool) error { return s.sectPr.SetRtlGutterVal(v) }
+
 // HeaderDistance returns the header distance in twips, or nil if not set.
 func (s *Section) HeaderDistance() (*int, error) { return s.sectPr.HeaderMargin() }
 
 // SetHeaderDistance sets the header distance.
 func (s *Section) SetHeaderDistance(v *int) error { return s.sectPr.SetHeaderMargin(v) }
 
+// HeaderDistanceLength returns the header distance as a Length, or nil if not set.
+func (s *Section) HeaderDistanceLength() (*Length, error) {
+	return twipsLength(s.sectPr.HeaderMargin())
+}
+
+// SetHeaderDistanceLength sets the header distance. Passing nil removes it.
+func (s *Section) SetHeaderDistanceLength(v *Length) error {
+	return s.sectPr.SetHeaderMargin(lengthTwips(v))
+}
+
 // FooterDistance returns the footer distance in twips, or nil if not set.
 func (s *Section) FooterDistance() (*int, error) { return s.sectPr.FooterMargin() }
 
 // SetFooterDistance sets the footer distance.
 func (s *Section) SetFooterDistance(v *int) error { return s.sectPr.SetFooterMargin(v) }
 
+// FooterDistanceLength returns the footer distance as a Length, or nil if not set.
+func (s *Section) FooterDistanceLength() (*Length, error) {
+	return twipsLength(s.sectPr.FooterMargin())
+}
+
+// SetFooterDistanceLength sets the footer distance. Passing nil removes it.
+func (s *Section) SetFooterDistanceLength(v *Length) error {
+	return s.sectPr.SetFooterMargin(lengthTwips(v))
+}
+
 // DifferentFirstPageHeaderFooter returns true if this section displays a distinct
 // first-page header and footer.
 func (s *Section) DifferentFirstPageHeaderFooter() bool { return s.sectPr.TitlePgVal() }
@@ -133,56 +235,65 @@ func (s *Section) FirstPageFooter() *Footer {
 // _SectBlockElementIterator. Only returns block-items belonging to THIS section,
 // not the entire document body.
 func (s *Section) IterInnerContent() []*InnerContentItem {
-	body := s.sectPr.BodyElement()
-	if body == nil {
-		return nil
-	}
+	return collectBlockItems(s.blockElements(), s.docPart)
+}
+
+// Content returns the paragraphs and tables of the section, in document
+// order: those after the previous section break, up to and including the
+// paragraph carrying this section's break. It is IterInnerContent.
+func (s *Section) Content() []*InnerContentItem {
+	return s.IterInnerContent()
+}
 
-	// Determine section boundaries. Sections are delimited by sectPr elements:
-	// - Paragraph-based: w:p/w:pPr/w:sectPr marks end of a section (the p itself belongs to that section)
-	// - Body-based: w:body/w:sectPr marks the last section
-	//
-	// We walk body children once, collecting (start, end) ranges for each section.
+// FirstParagraph returns the first paragraph of the section outside
+// tables, or nil if it has none.
+func (s *Section) FirstParagraph() *Paragraph {
+	for _, item := range s.IterInnerContent() {
+		if item.IsParagraph() {
+			return item.Paragraph()
+		}
+	}
+	return nil
+}
 
-	type sectionRange struct {
-		startIdx int // inclusive index into body children
-		endIdx   int // exclusive index into body children
-		sectPrEl *etree.Element
+// LastParagraph returns the last paragraph of the section outside tables,
+// or nil if it has none. For every section but the last it is the
+// paragraph carrying the section break (see Paragraph.IsSectionBreak).
+func (s *Section) LastParagraph() *Paragraph {
+	items := s.IterInnerContent()
+	for i := len(items) - 1; i >= 0; i-- {
+		if items[i].IsParagraph() {
+			return items[i].Paragraph()
+		}
 	}
+	return nil
+}
 
+// blockElements returns the body children belonging to the section.
+// Sections are delimited by sectPr elements: w:p/w:pPr/w:sectPr ends a
+// section, the paragraph included, and w:body/w:sectPr ends the last one.
+func (s *Section) blockElements() []*etree.Element {
+	body := s.sectPr.BodyElement()
+	if body == nil {
+		return nil
+	}
 	children := body.ChildElements()
-	var ranges []sectionRange
-	rangeStart := 0
-
+	start := 0
 	for i, child := range children {
-		if child.Space == "w" && child.Tag == "p" {
-			// Check if this paragraph contains w:pPr/w:sectPr
+		switch {
+		case child.Space == "w" && child.Tag == "p":
 			if pSectPr := findParagraphSectPr(child); pSectPr != nil {
-				// This paragraph (inclusive) ends a section
-				ranges = append(ranges, sectionRange{
-					startIdx: rangeStart,
-					endIdx:   i + 1, // include this p
-					sectPrEl: pSectPr,
-				})
-				rangeStart = i + 1
+				if pSectPr == s.sectPr.RawElement() {
+					return children[start : i+1]
+				}
+				start = i + 1
+			}
+		case child.Space == "w" && child.Tag == "sectPr":
+			if child == s.sectPr.RawElement() {
+				return children[start:i]
 			}
-		} else if child.Space == "w" && child.Tag == "sectPr" {
-			// Body-level sectPr: last section
-			ranges = append(ranges, sectionRange{
-				startIdx: rangeStart,
-				endIdx:   i, // exclude the sectPr itself
-				sectPrEl: child,
-			})
-		}
-	}
-
-	// Find which range matches our sectPr
-	for _, sr := range ranges {
-		if sr.sectPrEl == s.sectPr.RawElement() {
-			return collectBlockItems(children[sr.startIdx:sr.endIdx], s.docPart)
 		}
 	}
-
 	return nil
 }
 
@@ -247,7 +358,7 @@ func (ss *Sections) Len() int {
 func (ss *Sections) Get(idx int) (*Section, error) {
 	lst := ss.docElm.SectPrList()
 	if idx < 0 || idx >= len(lst) {
-		return nil, fmt.Errorf("docx: section index [%d] out of range", idx)
+		return nil, fmt.Errorf("docx: section index [%d] out of range: %w", idx, ErrInvalidIndex)
 	}
 	return newSection(lst[idx], ss.docPart), nil
 }
@@ -340,7 +451,7 @@ func (b *baseHeaderFooter) AddParagraph(text string, style ...StyleRef) (*Paragr
 	return bic.AddParagraph(text, style...)
 }
 
-// AddTable appends a new table to this header/footer.
+// AddTable appends a new table to this header/footer, width in twips.
 //
 // Mirrors Python BlockItemContainer.add_table (inherited by _BaseHeaderFooter).
 func (b *baseHeaderFooter) AddTable(rows, cols int, widthTwips int) (*Table, error) {
@@ -351,6 +462,11 @@ func (b *baseHeaderFooter) AddTable(rows, cols int, widthTwips int) (*Table, err
 	return bic.AddTable(rows, cols, widthTwips)
 }
 
+// AddTableLength is AddTable with the total width given as a Length.
+func (b *baseHeaderFooter) AddTableLength(rows, cols int, width Length) (*Table, error) {
+	return b.AddTable(rows, cols, width.Twips())
+}
+
 // Paragraphs returns the paragraphs in this header/footer.
 //
 // Mirrors Python BlockItemContainer.paragraphs (inherited by _BaseHeaderFooter).
@@ -422,30 +538,37 @@ func (b *baseHeaderFooter) replaceText(old, new string) (int, error) {
 // StoryPart without creating one) instead of Part() (which calls
 // getOrAddDefinition() and may create an empty definition as a
 // side effect).
-func (b *baseHeaderFooter) replaceTextDedup(old, new string, seen map[*parts.StoryPart]bool) (int, error) {
+func (b *baseHeaderFooter) replaceTextDedup(ctx context.Context, old, new string, seen map[*parts.StoryPart]bool) (int, error) {
+	bic, err := b.definedContainerDedup(seen)
+	if err != nil || bic == nil {
+		return 0, err
+	}
+	return bic.replaceText(ctx, old, new)
+}
+
+// definedContainerDedup returns the block-item container of this
+// header/footer's own definition, or nil if it has none (linked to
+// previous) or its part is already in seen. The part is added to seen.
+func (b *baseHeaderFooter) definedContainerDedup(seen map[*parts.StoryPart]bool) (*BlockItemContainer, error) {
 	has, err := b.ops.hasDefinition()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	if !has {
-		return 0, nil
+		return nil, nil
 	}
 	sp, err := b.ops.definition()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	if sp == nil {
-		return 0, nil
+		return nil, nil
 	}
 	if seen[sp] {
-		return 0, nil
+		return nil, nil
 	}
 	seen[sp] = true
-	bic, err := b.blockItemContainer()
-	if err != nil {
-		return 0, err
-	}
-	return bic.ReplaceText(old, new), nil
+	return b.blockItemContainer()
 }
 
 // blockItemContainer creates a BlockItemContainer backed by the header/footer
@@ -190,6 +190,31 @@ func TestSection_Margins_SetGet(t *testing.T) {
 	}
 }
 
+func TestSection_LengthVariants(t *testing.T) {
+	sectPr := makeSectPr(t, `<w:pgSz w:w="12240" w:h="15840"/><w:pgMar w:top="1440" w:left="1800"/>`)
+	sec := newSection(sectPr, nil)
+
+	if w, _ := sec.PageWidthLength(); w == nil || *w != Inches(8.5) {
+		t.Errorf("PageWidthLength() = %v, want %v", w, Inches(8.5))
+	}
+	if top, _ := sec.TopMarginLength(); top == nil || *top != Inches(1) {
+		t.Errorf("TopMarginLength() = %v, want %v", top, Inches(1))
+	}
+
+	if err := sec.SetLeftMarginLength(Ptr(Cm(2))); err != nil {
+		t.Fatal(err)
+	}
+	if left, _ := sec.LeftMargin(); left == nil || *left != Cm(2).Twips() {
+		t.Errorf("LeftMargin() = %v, want %d", left, Cm(2).Twips())
+	}
+	if err := sec.SetGutter(Ptr(720)); err != nil {
+		t.Fatal(err)
+	}
+	if g, _ := sec.GutterLength(); g == nil || *g != Inches(0.5) {
+		t.Errorf("GutterLength() = %v, want %v", g, Inches(0.5))
+	}
+}
+
 // Mirrors Python: it_knows_when_it_displays_a_distinct_first_page_header
 func TestSection_DifferentFirstPageHeaderFooter(t *testing.T) {
 	// Without titlePg
@@ -518,3 +543,153 @@ func TestHeaderGetOrAddDef_UnlinkCreatesOwnDefinition(t *testing.T) {
 		}
 	}
 }
+
+func TestSection_SetPageSize(t *testing.T) {
+	sec := newSection(makeSectPr(t, ``), nil)
+
+	if size, err := sec.PageSize(); err != nil || size != nil {
+		t.Fatalf("PageSize() without pgSz = %v, %v, want nil", size, err)
+	}
+	if err := sec.SetPageSize(PageSizeA4, enum.WdOrientationLandscape); err != nil {
+		t.Fatal(err)
+	}
+	w, _ := sec.PageWidthLength()
+	h, _ := sec.PageHeightLength()
+	if w == nil || h == nil || w.Twips() != 16838 || h.Twips() != 11906 {
+		t.Errorf("landscape A4 = %v x %v, want 16838 x 11906 twips", w, h)
+	}
+	if o, _ := sec.Orientation(); o != enum.WdOrientationLandscape {
+		t.Errorf("Orientation() = %v, want LANDSCAPE", o)
+	}
+	size, err := sec.PageSize()
+	if err != nil || size == nil || size.Name != "A4" {
+		t.Errorf("PageSize() = %+v, %v, want A4", size, err)
+	}
+
+	custom := Mm(100)
+	if err := sec.SetPageWidthLength(&custom); err != nil {
+		t.Fatal(err)
+	}
+	if size, _ := sec.PageSize(); size == nil || size.Name != "" || size.Width != Twips(float64(Mm(100).Twips())) {
+		t.Errorf("PageSize() for a custom size = %+v", size)
+	}
+}
+
+func TestSection_TextDirection(t *testing.T) {
+	sectPr := makeSectPr(t, `<w:pgSz w:w="12240" w:h="15840"/><w:titlePg/><w:docGrid w:linePitch="360"/>`)
+	sec := newSection(sectPr, nil)
+	if v, err := sec.TextDirection(); err != nil || v != nil {
+		t.Fatalf("TextDirection = %v, %v; want nil", v, err)
+	}
+	if err := sec.SetTextDirection(Ptr(enum.WdTextOrientationVerticalFarEast)); err != nil {
+		t.Fatal(err)
+	}
+	v, err := sec.TextDirection()
+	if err != nil || v == nil || *v != enum.WdTextOrientationVerticalFarEast {
+		t.Errorf("TextDirection = %v, %v", v, err)
+	}
+	var tags []string
+	for _, el := range sectPr.RawElement().ChildElements() {
+		tags = append(tags, el.Tag)
+	}
+	if len(tags) != 4 || tags[2] != "textDirection" {
+		t.Errorf("sectPr children = %v", tags)
+	}
+	if err := sec.SetTextDirection(nil); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := sec.TextDirection(); v != nil {
+		t.Error("text direction not removed")
+	}
+}
+
+func TestSection_VerticalAlignment(t *testing.T) {
+	sectPr := makeSectPr(t, `<w:pgSz w:w="12240" w:h="15840"/><w:titlePg/><w:docGrid w:linePitch="360"/>`)
+	sec := newSection(sectPr, nil)
+	if v, err := sec.VerticalAlignment(); err != nil || v != nil {
+		t.Fatalf("VerticalAlignment = %v, %v; want nil", v, err)
+	}
+	if err := sec.SetVerticalAlignment(Ptr(enum.WdVerticalAlignmentCenter)); err != nil {
+		t.Fatal(err)
+	}
+	if err := sec.SetVerticalAlignment(Ptr(enum.WdVerticalAlignmentJustify)); err != nil {
+		t.Fatal(err)
+	}
+	v, err := sec.VerticalAlignment()
+	if err != nil || v == nil || *v != enum.WdVerticalAlignmentJustify {
+		t.Errorf("VerticalAlignment = %v, %v", v, err)
+	}
+	var tags []string
+	for _, el := range sectPr.RawElement().ChildElements() {
+		tags = append(tags, el.Tag)
+	}
+	if len(tags) != 4 || tags[1] != "vAlign" {
+		t.Errorf("sectPr children = %v", tags)
+	}
+	if val := sectPr.RawElement().SelectElement("w:vAlign").SelectAttrValue("w:val", ""); val != "both" {
+		t.Errorf("w:vAlign/@w:val = %q, want both", val)
+	}
+	if err := sec.SetVerticalAlignment(nil); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := sec.VerticalAlignment(); v != nil {
+		t.Error("vertical alignment not removed")
+	}
+}
+
+func TestSection_PageNumberFormat(t *testing.T) {
+	sec := newSection(makeSectPr(t, `<w:pgSz w:w="12240" w:h="15840"/><w:cols w:space="720"/><w:docGrid w:linePitch="360"/>`), nil)
+	if f, err := sec.PageNumberFormat(); err != nil || f != nil {
+		t.Fatalf("PageNumberFormat = %+v, %v; want nil", f, err)
+	}
+	if err := sec.SetPageNumberFormat(&PageNumberFormat{Format: "lowerRoman", Start: Ptr(1), ChapterStyle: 1, ChapterSeparator: "enDash"}); err != nil {
+		t.Fatal(err)
+	}
+	children := sec.sectPr.RawElement().ChildElements()
+	if len(children) != 4 || children[1].Tag != "pgNumType" {
+		t.Fatalf("pgNumType not inserted before w:cols")
+	}
+	f, err := sec.PageNumberFormat()
+	if err != nil || f == nil {
+		t.Fatalf("PageNumberFormat = %+v, %v", f, err)
+	}
+	if f.Format != "lowerRoman" || f.Start == nil || *f.Start != 1 || f.ChapterStyle != 1 || f.ChapterSeparator != "enDash" {
+		t.Errorf("PageNumberFormat = %+v", f)
+	}
+
+	if err := sec.SetPageNumberFormat(&PageNumberFormat{ChapterStyle: 10}); err == nil {
+		t.Error("expected error for chapter style 10")
+	}
+	if err := sec.SetPageNumberFormat(nil); err != nil {
+		t.Fatal(err)
+	}
+	if f, _ := sec.PageNumberFormat(); f != nil {
+		t.Errorf("PageNumberFormat after removal = %+v", f)
+	}
+}
+
+func TestSection_FirstLastParagraph(t *testing.T) {
+	sections := newSections(makeMultiSectionDoc(t), nil)
+	for i, want := range [][2]string{{"P1", "P3"}, {"P5", "P6"}, {"P7", "P9"}} {
+		sec, err := sections.Get(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := len(sec.Content()); n != 3 {
+			t.Errorf("section %d: len(Content()) = %d, want 3", i, n)
+		}
+		first, last := sec.FirstParagraph(), sec.LastParagraph()
+		if first == nil || last == nil {
+			t.Fatalf("section %d: FirstParagraph/LastParagraph = %v, %v", i, first, last)
+		}
+		if first.Text() != want[0] || last.Text() != want[1] {
+			t.Errorf("section %d: paragraphs %q..%q, want %q..%q", i, first.Text(), last.Text(), want[0], want[1])
+		}
+		if first.IsSectionBreak() {
+			t.Errorf("section %d: %q should not be a section break", i, first.Text())
+		}
+		if got, want := last.IsSectionBreak(), i < 2; got != want {
+			t.Errorf("section %d: %q IsSectionBreak() = %v, want %v", i, last.Text(), got, want)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package docx
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// SectionMerge selects the section that takes over the content of a
+// section removed by Document.RemoveSection.
+type SectionMerge int
+
+const (
+	// MergeWithPrevious lays the content out with the page setup, headers
+	// and footers of the previous section.
+	MergeWithPrevious SectionMerge = iota
+	// MergeWithNext lays the content out with the page setup, headers and
+	// footers of the next section.
+	MergeWithNext
+)
+
+// hdrFtrIndexes are the header and footer types a section can define.
+var hdrFtrIndexes = []enum.WdHeaderFooterIndex{
+	enum.WdHeaderFooterIndexPrimary,
+	enum.WdHeaderFooterIndexFirstPage,
+	enum.WdHeaderFooterIndexEvenPage,
+}
+
+// RemoveSection removes the section break ending section i, 0-based, so
+// its content joins the previous or the next section. No content is
+// removed, except the empty paragraph that only carried the break, as
+// AddSection adds.
+//
+// With MergeWithPrevious the joined section keeps the page setup, headers
+// and footers of the previous one. With MergeWithNext it keeps those of
+// the next one, but starts as section i did (see Section.StartType).
+// Either way a section after the removed one that showed the headers and
+// footers of section i, by being linked to previous, shows them still.
+//
+// The first section has no previous section and the last no next one;
+// merging into them fails, as does removing the only section.
+func (d *Document) RemoveSection(i int, into SectionMerge) error {
+	lst := d.element.SectPrList()
+	if i < 0 || i >= len(lst) {
+		return fmt.Errorf("docx: section index [%d] out of range: %w", i, ErrInvalidIndex)
+	}
+	if len(lst) == 1 {
+		return errors.New("docx: cannot remove the only section")
+	}
+	switch {
+	case into != MergeWithPrevious && into != MergeWithNext:
+		return fmt.Errorf("docx: invalid section merge %d", into)
+	case into == MergeWithPrevious && i == 0:
+		return errors.New("docx: the first section has no previous section to merge with")
+	case into == MergeWithNext && i == len(lst)-1:
+		return errors.New("docx: the last section has no next section to merge with")
+	}
+	removed := lst[i]
+	rIds := hdrFtrRIds(removed)
+	if i+1 < len(lst) {
+		if err := inheritHdrFtrRefs(removed, lst[i+1]); err != nil {
+			return err
+		}
+	}
+	if into == MergeWithPrevious {
+		prev := lst[i-1]
+		carrier := detachSectPr(prev)
+		if p := removed.RawElement().Parent(); p.Space == "w" && p.Tag == "body" {
+			d.element.Body().SetSectPr(prev)
+		} else {
+			(&oxml.CT_P{Element: oxml.WrapElement(p.Parent())}).SetSectPr(prev)
+		}
+		dropEmptyParagraph(carrier)
+	} else {
+		start, err := removed.StartType()
+		if err != nil {
+			return fmt.Errorf("docx: reading section start: %w", err)
+		}
+		if err := lst[i+1].SetStartType(start); err != nil {
+			return fmt.Errorf("docx: setting section start: %w", err)
+		}
+		dropEmptyParagraph(detachSectPr(removed))
+	}
+	for _, rId := range rIds {
+		if !d.part.HasRelRef(rId) {
+			d.part.Rels().Delete(rId)
+		}
+	}
+	return nil
+}
+
+// InsertSectionBefore starts a new section at paragraph para, a paragraph
+// of the body outside tables, and returns it. The section break goes into
+// the paragraph before para, or, when a table or other block precedes
+// para, into an empty paragraph inserted for it, as Word does.
+//
+// Both sections keep the page setup, headers and footers of the section
+// split; the new one starts as startType says. Inserting before the first
+// paragraph of the document, or before one that already starts a
+// section, fails.
+func (d *Document) InsertSectionBefore(para *Paragraph, startType enum.WdSectionStart) (*Section, error) {
+	body := d.element.Body()
+	p := para.p.RawElement()
+	if body == nil || p.Parent() != body.RawElement() {
+		return nil, errors.New("docx: paragraph is not in the document body")
+	}
+	siblings := body.RawElement().ChildElements()
+	at := p.Index()
+	var prev *etree.Element
+	var governing *oxml.CT_SectPr
+	for _, el := range siblings {
+		if el.Index() < at {
+			prev = el
+			continue
+		}
+		if el.Space == "w" && el.Tag == "p" {
+			if sp := findParagraphSectPr(el); sp != nil {
+				governing = &oxml.CT_SectPr{Element: oxml.WrapElement(sp)}
+				break
+			}
+		}
+	}
+	if governing == nil {
+		governing = body.GetOrAddSectPr()
+	}
+	switch {
+	case prev == nil:
+		return nil, errors.New("docx: cannot start a section before the first paragraph of the document")
+	case prev.Space == "w" && prev.Tag == "p" && findParagraphSectPr(prev) != nil:
+		return nil, errors.New("docx: paragraph already starts a section")
+	case prev.Space == "w" && prev.Tag == "p":
+	default:
+		prev = oxml.OxmlElement("w:p")
+		body.RawElement().InsertChildAt(at, prev)
+	}
+
+	// The part before para takes a full copy; the new section links to its
+	// headers and footers, as after AddSection.
+	clone := &oxml.CT_SectPr{Element: oxml.WrapElement(governing.RawElement().Copy())}
+	(&oxml.CT_P{Element: oxml.WrapElement(prev)}).SetSectPr(clone)
+	for _, idx := range hdrFtrIndexes {
+		if _, err := governing.RemoveHeaderRef(idx); err != nil {
+			return nil, err
+		}
+		if _, err := governing.RemoveFooterRef(idx); err != nil {
+			return nil, err
+		}
+	}
+	if err := governing.SetStartType(startType); err != nil {
+		return nil, fmt.Errorf("docx: setting section start type: %w", err)
+	}
+	return newSection(governing, d.part), nil
+}
+
+// inheritHdrFtrRefs gives to the header and footer references of from
+// that it lacks, so it shows the same headers and footers once it no
+// longer follows from.
+func inheritHdrFtrRefs(from, to *oxml.CT_SectPr) error {
+	for _, idx := range hdrFtrIndexes {
+		if ref, err := from.GetHeaderRef(idx); err != nil {
+			return err
+		} else if own, _ := to.GetHeaderRef(idx); ref != nil && own == nil {
+			rId, err := ref.RId()
+			if err != nil {
+				return err
+			}
+			if _, err := to.AddHeaderRef(idx, rId); err != nil {
+				return err
+			}
+		}
+		if ref, err := from.GetFooterRef(idx); err != nil {
+			return err
+		} else if own, _ := to.GetFooterRef(idx); ref != nil && own == nil {
+			rId, err := ref.RId()
+			if err != nil {
+				return err
+			}
+			if _, err := to.AddFooterRef(idx, rId); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hdrFtrRIds returns the relationship IDs of the header and footer
+// references of sectPr.
+func hdrFtrRIds(sectPr *oxml.CT_SectPr) []string {
+	var rIds []string
+	for _, ref := range append(sectPr.HeaderReferenceList(), sectPr.FooterReferenceList()...) {
+		if rId, err := ref.RId(); err == nil && rId != "" {
+			rIds = append(rIds, rId)
+		}
+	}
+	return rIds
+}
+
+// detachSectPr removes a paragraph's sectPr from its w:pPr and returns
+// the paragraph.
+func detachSectPr(sectPr *oxml.CT_SectPr) *etree.Element {
+	pPr := sectPr.RawElement().Parent()
+	pPr.RemoveChild(sectPr.RawElement())
+	return pPr.Parent()
+}
+
+// dropEmptyParagraph removes paragraph p if it has no content, leaving
+// paragraphs with text, runs or anything but properties alone.
+func dropEmptyParagraph(p *etree.Element) {
+	for _, child := range p.ChildElements() {
+		if child.Space != "w" || child.Tag != "pPr" {
+			return
+		}
+	}
+	if parent := p.Parent(); parent != nil {
+		parent.RemoveChild(p)
+	}
+}
@@ -0,0 +1,220 @@
+package docx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// threeSectionDoc builds a document with paragraphs a, b and c in three
+// sections 6, 7 and 8 inches wide; section 1 starts on an odd page and
+// section 2 continuously.
+func threeSectionDoc(t *testing.T) *Document {
+	t.Helper()
+	doc := mustNewDoc(t)
+	for i, start := range []enum.WdSectionStart{enum.WdSectionStartOddPage, enum.WdSectionStartContinuous, -1} {
+		if _, err := doc.AddParagraph(string(rune('a' + i))); err != nil {
+			t.Fatal(err)
+		}
+		if start < 0 {
+			break
+		}
+		if _, err := doc.AddSection(start); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		w := Inches(float64(6 + i))
+		if err := mustGetSection(t, doc, i).SetPageWidthLength(&w); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return doc
+}
+
+func sectionWidths(t *testing.T, doc *Document) []float64 {
+	t.Helper()
+	var widths []float64
+	for _, s := range doc.Sections().Iter() {
+		w, err := s.PageWidthLength()
+		if err != nil {
+			t.Fatal(err)
+		}
+		widths = append(widths, w.Inches())
+	}
+	return widths
+}
+
+func bodyTexts(t *testing.T, doc *Document) []string {
+	t.Helper()
+	var texts []string
+	for _, p := range mustParagraphs(t, doc) {
+		texts = append(texts, p.Text())
+	}
+	return texts
+}
+
+func headerRelCount(doc *Document) int {
+	n := 0
+	for _, rel := range doc.Part().Rels().All() {
+		if rel.RelType == opc.RTHeader {
+			n++
+		}
+	}
+	return n
+}
+
+func TestRemoveSection_MergeWithPrevious(t *testing.T) {
+	doc := threeSectionDoc(t)
+	if err := doc.RemoveSection(1, MergeWithPrevious); err != nil {
+		t.Fatal(err)
+	}
+	if got := sectionWidths(t, doc); len(got) != 2 || got[0] != 6 || got[1] != 8 {
+		t.Errorf("widths = %v, want [6 8]", got)
+	}
+	// The break of section 0 moved into the empty paragraph that carried
+	// the break of section 1; its own carrier is gone.
+	if got := bodyTexts(t, doc); len(got) != 4 || got[0] != "a" || got[1] != "b" || got[2] != "" || got[3] != "c" {
+		t.Errorf("paragraphs = %q, want [a b \"\" c]", got)
+	}
+}
+
+func TestRemoveSection_MergeWithNext(t *testing.T) {
+	doc := threeSectionDoc(t)
+	if err := doc.RemoveSection(1, MergeWithNext); err != nil {
+		t.Fatal(err)
+	}
+	if got := sectionWidths(t, doc); len(got) != 2 || got[0] != 6 || got[1] != 8 {
+		t.Errorf("widths = %v, want [6 8]", got)
+	}
+	if got := bodyTexts(t, doc); len(got) != 4 || got[0] != "a" || got[1] != "" || got[2] != "b" || got[3] != "c" {
+		t.Errorf("paragraphs = %q, want [a \"\" b c]", got)
+	}
+	start, err := mustGetSection(t, doc, 1).StartType()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if start != enum.WdSectionStartOddPage {
+		t.Errorf("StartType = %v, want OddPage from the removed section", start)
+	}
+}
+
+func TestRemoveSection_KeepsLinkedHeaders(t *testing.T) {
+	doc := threeSectionDoc(t)
+	hdr := mustGetSection(t, doc, 1).Header()
+	if err := hdr.SetIsLinkedToPrevious(false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := hdr.AddParagraph("sec1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := doc.RemoveSection(1, MergeWithPrevious); err != nil {
+		t.Fatal(err)
+	}
+	paras, err := mustGetSection(t, doc, 1).Header().Paragraphs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paras) == 0 || paras[len(paras)-1].Text() != "sec1" {
+		t.Error("the section after the removed one should still show its header")
+	}
+	if n := headerRelCount(doc); n != 1 {
+		t.Errorf("header relationships = %d, want 1", n)
+	}
+}
+
+func TestRemoveSection_DropsUnusedHeaders(t *testing.T) {
+	doc := threeSectionDoc(t)
+	for i := 1; i < 3; i++ {
+		if err := mustGetSection(t, doc, i).Header().SetIsLinkedToPrevious(false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := headerRelCount(doc); n != 2 {
+		t.Fatalf("header relationships = %d, want 2", n)
+	}
+	if err := doc.RemoveSection(1, MergeWithNext); err != nil {
+		t.Fatal(err)
+	}
+	if n := headerRelCount(doc); n != 1 {
+		t.Errorf("header relationships = %d, want 1", n)
+	}
+}
+
+func TestRemoveSection_Errors(t *testing.T) {
+	doc := threeSectionDoc(t)
+	if err := doc.RemoveSection(3, MergeWithPrevious); !errors.Is(err, ErrInvalidIndex) {
+		t.Errorf("RemoveSection(3) = %v, want ErrInvalidIndex", err)
+	}
+	if err := doc.RemoveSection(0, MergeWithPrevious); err == nil {
+		t.Error("expected error merging the first section with its previous")
+	}
+	if err := doc.RemoveSection(2, MergeWithNext); err == nil {
+		t.Error("expected error merging the last section with its next")
+	}
+	if n := doc.Sections().Len(); n != 3 {
+		t.Errorf("failed removals changed the section count to %d", n)
+	}
+	if err := mustNewDoc(t).RemoveSection(0, MergeWithNext); err == nil {
+		t.Error("expected error removing the only section")
+	}
+}
+
+func TestInsertSectionBefore(t *testing.T) {
+	doc := mustNewDoc(t)
+	for _, text := range []string{"a", "b", "c"} {
+		if _, err := doc.AddParagraph(text); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w := Inches(7)
+	if err := mustGetSection(t, doc, 0).SetPageWidthLength(&w); err != nil {
+		t.Fatal(err)
+	}
+	paras := mustParagraphs(t, doc)
+	sec, err := doc.InsertSectionBefore(paras[len(paras)-1], enum.WdSectionStartContinuous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if start, _ := sec.StartType(); start != enum.WdSectionStartContinuous {
+		t.Errorf("StartType = %v, want Continuous", start)
+	}
+	if got := sectionWidths(t, doc); len(got) != 2 || got[0] != 7 || got[1] != 7 {
+		t.Errorf("widths = %v, want [7 7]", got)
+	}
+	if got := bodyTexts(t, doc); len(got) != len(paras) {
+		t.Errorf("paragraphs = %q, want no paragraph added", got)
+	}
+	if _, err := doc.InsertSectionBefore(paras[len(paras)-1], enum.WdSectionStartNewPage); err == nil {
+		t.Error("expected error for a paragraph that already starts a section")
+	}
+	if _, err := doc.InsertSectionBefore(paras[0], enum.WdSectionStartNewPage); err == nil {
+		t.Error("expected error for the first paragraph")
+	}
+}
+
+func TestInsertSectionBefore_AfterTable(t *testing.T) {
+	doc := mustNewDoc(t)
+	if _, err := doc.AddParagraph("a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := doc.AddTable(1, 1); err != nil {
+		t.Fatal(err)
+	}
+	p, err := doc.AddParagraph("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := len(mustParagraphs(t, doc))
+	if _, err := doc.InsertSectionBefore(p, enum.WdSectionStartNewPage); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(mustParagraphs(t, doc)); got != before+1 {
+		t.Errorf("paragraphs = %d, want %d with the inserted break carrier", got, before+1)
+	}
+	if n := doc.Sections().Len(); n != 2 {
+		t.Errorf("Sections().Len() = %d, want 2", n)
+	}
+}
@@ -1,6 +1,10 @@
 package docx
 
-import "github.com/vortex/go-docx/pkg/docx/oxml"
+import (
+	"fmt"
+
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
 
 // Settings provides access to document-level settings.
 //
@@ -29,3 +33,135 @@ func (s *Settings) OddAndEvenPagesHeaderFooter() bool {
 func (s *Settings) SetOddAndEvenPagesHeaderFooter(v bool) error {
 	return s.settings.SetEvenAndOddHeadersVal(&v)
 }
+
+// AutoHyphenation returns true if automatic hyphenation is enabled for the
+// document.
+func (s *Settings) AutoHyphenation() bool {
+	return s.settings.AutoHyphenationVal()
+}
+
+// SetAutoHyphenation enables or disables automatic hyphenation. Individual
+// paragraphs can opt out with ParagraphFormat.SetSuppressAutoHyphens.
+func (s *Settings) SetAutoHyphenation(v bool) error {
+	return s.settings.SetAutoHyphenationVal(v)
+}
+
+// ConsecutiveHyphenLimit returns the maximum number of consecutive lines
+// that may end with a hyphen. 0 means no limit.
+func (s *Settings) ConsecutiveHyphenLimit() (int, error) {
+	return s.settings.ConsecutiveHyphenLimitVal()
+}
+
+// SetConsecutiveHyphenLimit sets the maximum number of consecutive
+// hyphenated lines. 0 removes the limit.
+func (s *Settings) SetConsecutiveHyphenLimit(n int) error {
+	if n < 0 {
+		return fmt.Errorf("docx: consecutive hyphen limit must be >= 0, got %d", n)
+	}
+	return s.settings.SetConsecutiveHyphenLimitVal(n)
+}
+
+// HyphenationZone returns the distance from the right margin within which
+// words are hyphenated, or nil if not set (Word then uses 0.25").
+func (s *Settings) HyphenationZone() (*Length, error) {
+	return twipsLength(s.settings.HyphenationZoneVal())
+}
+
+// SetHyphenationZone sets the hyphenation zone. nil removes the setting.
+func (s *Settings) SetHyphenationZone(v *Length) error {
+	if v != nil && *v < 0 {
+		return fmt.Errorf("docx: hyphenation zone must be >= 0, got %d EMU", int64(*v))
+	}
+	return s.settings.SetHyphenationZoneVal(lengthTwips(v))
+}
+
+// DoNotHyphenateCaps returns true if words in all capital letters are
+// excluded from automatic hyphenation.
+func (s *Settings) DoNotHyphenateCaps() bool {
+	return s.settings.DoNotHyphenateCapsVal()
+}
+
+// SetDoNotHyphenateCaps sets whether words in all capital letters are
+// excluded from automatic hyphenation.
+func (s *Settings) SetDoNotHyphenateCaps(v bool) error {
+	return s.settings.SetDoNotHyphenateCapsVal(v)
+}
+
+// MirrorMargins returns true if the inside and outside margins of facing
+// pages mirror each other, for printing on both sides and binding.
+func (s *Settings) MirrorMargins() bool {
+	return s.settings.MirrorMarginsVal()
+}
+
+// SetMirrorMargins sets whether facing pages have mirrored margins: the
+// left margin and gutter of a section become the inside ones, on the
+// right of even pages. Word offers mirror margins and book fold printing
+// as alternatives, so turning mirror margins on turns book fold printing
+// off.
+func (s *Settings) SetMirrorMargins(v bool) error {
+	if v {
+		if err := s.settings.SetBookFoldPrintingVal(false); err != nil {
+			return err
+		}
+	}
+	return s.settings.SetMirrorMarginsVal(v)
+}
+
+// GutterAtTop returns true if the gutter of every section is at the top
+// of the page rather than at the side.
+func (s *Settings) GutterAtTop() bool {
+	return s.settings.GutterAtTopVal()
+}
+
+// SetGutterAtTop sets whether the gutter, the binding space set with
+// Section.SetGutter, is at the top of the pages, for documents bound
+// along the top edge. It applies to every section.
+func (s *Settings) SetGutterAtTop(v bool) error {
+	return s.settings.SetGutterAtTopVal(v)
+}
+
+// BookFoldPrinting returns true if pages print two to a sheet, folded into
+// a booklet.
+func (s *Settings) BookFoldPrinting() bool {
+	return s.settings.BookFoldPrintingVal()
+}
+
+// SetBookFoldPrinting sets whether pages print two to a sheet in booklet
+// order, so the printed sheets fold into a book. Turning it on turns
+// mirror margins off; see SetMirrorMargins.
+func (s *Settings) SetBookFoldPrinting(v bool) error {
+	if v {
+		if err := s.settings.SetMirrorMarginsVal(false); err != nil {
+			return err
+		}
+	}
+	return s.settings.SetBookFoldPrintingVal(v)
+}
+
+// BookFoldReversePrinting returns true if booklets print in reverse, for
+// right-to-left languages.
+func (s *Settings) BookFoldReversePrinting() bool {
+	return s.settings.BookFoldRevPrintingVal()
+}
+
+// SetBookFoldReversePrinting sets whether booklets print in reverse, so
+// they open from the left as books in right-to-left languages do.
+func (s *Settings) SetBookFoldReversePrinting(v bool) error {
+	return s.settings.SetBookFoldRevPrintingVal(v)
+}
+
+// BookFoldPrintingSheets returns the number of pages in each booklet of a
+// book fold print, or 0 for all pages in one booklet.
+func (s *Settings) BookFoldPrintingSheets() (int, error) {
+	return s.settings.BookFoldPrintingSheetsVal()
+}
+
+// SetBookFoldPrintingSheets splits a book fold print into booklets of n
+// pages, a multiple of 4 as each folded sheet holds four. 0 prints all
+// pages in one booklet.
+func (s *Settings) SetBookFoldPrintingSheets(n int) error {
+	if n < 0 || n%4 != 0 {
+		return fmt.Errorf("docx: book fold pages per booklet must be a multiple of 4, got %d", n)
+	}
+	return s.settings.SetBookFoldPrintingSheetsVal(n)
+}
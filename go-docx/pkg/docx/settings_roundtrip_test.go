@@ -130,3 +130,117 @@ func TestSettings_MultipleRoundTrips(t *testing.T) {
 		t.Error("round-trip 2: expected true")
 	}
 }
+
+func TestSettings_Hyphenation_RoundTrip(t *testing.T) {
+	doc := mustNewDoc(t)
+	settings, err := doc.Settings()
+	if err != nil {
+		t.Fatalf("Settings(): %v", err)
+	}
+
+	zone := Pt(18)
+	if err := settings.SetAutoHyphenation(true); err != nil {
+		t.Fatalf("SetAutoHyphenation: %v", err)
+	}
+	if err := settings.SetConsecutiveHyphenLimit(3); err != nil {
+		t.Fatalf("SetConsecutiveHyphenLimit: %v", err)
+	}
+	if err := settings.SetHyphenationZone(&zone); err != nil {
+		t.Fatalf("SetHyphenationZone: %v", err)
+	}
+	if err := settings.SetDoNotHyphenateCaps(true); err != nil {
+		t.Fatalf("SetDoNotHyphenateCaps: %v", err)
+	}
+	if err := settings.SetConsecutiveHyphenLimit(-1); err == nil {
+		t.Error("expected error for negative consecutive hyphen limit")
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	settings2, err := doc2.Settings()
+	if err != nil {
+		t.Fatalf("Settings(): %v", err)
+	}
+
+	if !settings2.AutoHyphenation() {
+		t.Error("expected AutoHyphenation()=true after round-trip")
+	}
+	if n, err := settings2.ConsecutiveHyphenLimit(); err != nil || n != 3 {
+		t.Errorf("ConsecutiveHyphenLimit() = %d, %v; want 3", n, err)
+	}
+	if z, err := settings2.HyphenationZone(); err != nil || z == nil || z.Twips() != 360 {
+		t.Errorf("HyphenationZone() = %v, %v; want 360 twips", z, err)
+	}
+	if !settings2.DoNotHyphenateCaps() {
+		t.Error("expected DoNotHyphenateCaps()=true after round-trip")
+	}
+}
+
+func TestSettings_PrintAndBind_RoundTrip(t *testing.T) {
+	doc := mustNewDoc(t)
+	settings, err := doc.Settings()
+	if err != nil {
+		t.Fatalf("Settings(): %v", err)
+	}
+	if err := settings.SetMirrorMargins(true); err != nil {
+		t.Fatalf("SetMirrorMargins: %v", err)
+	}
+	if err := settings.SetGutterAtTop(true); err != nil {
+		t.Fatalf("SetGutterAtTop: %v", err)
+	}
+	if err := settings.SetBookFoldPrintingSheets(6); err == nil {
+		t.Error("expected error for booklet size not a multiple of 4")
+	}
+	sect, err := doc.Sections().Get(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sect.SetGutterOnRight(true); err != nil {
+		t.Fatalf("SetGutterOnRight: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	settings2, err := doc2.Settings()
+	if err != nil {
+		t.Fatalf("Settings(): %v", err)
+	}
+	if !settings2.MirrorMargins() || !settings2.GutterAtTop() || settings2.BookFoldPrinting() {
+		t.Errorf("MirrorMargins=%v GutterAtTop=%v BookFoldPrinting=%v; want true true false",
+			settings2.MirrorMargins(), settings2.GutterAtTop(), settings2.BookFoldPrinting())
+	}
+	if sect2, _ := doc2.Sections().Get(0); !sect2.GutterOnRight() {
+		t.Error("expected GutterOnRight()=true after round-trip")
+	}
+
+	if err := settings2.SetBookFoldPrinting(true); err != nil {
+		t.Fatalf("SetBookFoldPrinting: %v", err)
+	}
+	if err := settings2.SetBookFoldReversePrinting(true); err != nil {
+		t.Fatalf("SetBookFoldReversePrinting: %v", err)
+	}
+	if err := settings2.SetBookFoldPrintingSheets(16); err != nil {
+		t.Fatalf("SetBookFoldPrintingSheets: %v", err)
+	}
+	if settings2.MirrorMargins() || !settings2.BookFoldPrinting() || !settings2.BookFoldReversePrinting() {
+		t.Error("book fold printing did not replace mirror margins")
+	}
+	if n, err := settings2.BookFoldPrintingSheets(); err != nil || n != 16 {
+		t.Errorf("BookFoldPrintingSheets() = %d, %v; want 16", n, err)
+	}
+	if v := doc2.ValidateSchema(); len(v) != 0 {
+		t.Errorf("unexpected schema violations: %v", v)
+	}
+}
@@ -0,0 +1,178 @@
+package docx
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// Shading is the background of a table cell: a fill color, optionally
+// overlaid with a pattern of dots or lines drawn in a second color, as on
+// the Shading tab of Word's Borders and Shading dialog.
+type Shading struct {
+	// Pattern is the pattern drawn over the fill; WdTextureNone for a
+	// plain fill, WdTextureSolid to cover it with the pattern color.
+	Pattern enum.WdTextureIndex
+	// Color is the color of the pattern; nil for automatic.
+	Color *RGBColor
+	// Fill is the background color; nil for none.
+	Fill *RGBColor
+}
+
+// tcPrShdSuccessors are the tcPr children that follow w:shd.
+var tcPrShdSuccessors = []string{
+	"w:noWrap", "w:tcMar", "w:textDirection", "w:tcFitText", "w:vAlign",
+	"w:hideMark", "w:headers", "w:cellIns", "w:cellDel", "w:cellMerge", "w:tcPrChange",
+}
+
+// Shading returns the shading of the cell, or nil if it has none of its
+// own and takes the table style's.
+func (c *Cell) Shading() (*Shading, error) {
+	tcPr := c.tc.TcPr()
+	if tcPr == nil {
+		return nil, nil
+	}
+	return readShading(tcPr.RawElement().SelectElement("w:shd"))
+}
+
+// SetShading sets the shading of the cell. Passing nil removes it, so the
+// table style's shows again.
+func (c *Cell) SetShading(s *Shading) error {
+	if s == nil && c.tc.TcPr() == nil {
+		return nil
+	}
+	tcPr := c.tc.GetOrAddTcPr()
+	return writeShading(&tcPr.Element, s, tcPrShdSuccessors...)
+}
+
+// ShadingPattern returns the full shading of the cells, pattern included,
+// or nil if none. Shading returns just the fill color.
+func (f *TableConditionalFormat) ShadingPattern() (*Shading, error) {
+	return readShading(f.el.FindElement("w:tcPr/w:shd"))
+}
+
+// SetShadingPattern sets the shading of the cells, pattern included.
+// Passing nil removes it.
+func (f *TableConditionalFormat) SetShadingPattern(s *Shading) error {
+	if s == nil {
+		f.SetShading(nil)
+		return nil
+	}
+	tcPr := oxml.WrapElement(f.child("w:tcPr"))
+	return writeShading(&tcPr, s, tcPrShdSuccessors...)
+}
+
+// readShading reads a w:shd element; nil reads as nil.
+func readShading(shd *etree.Element) (*Shading, error) {
+	if shd == nil {
+		return nil, nil
+	}
+	pattern, err := enum.WdTextureIndexFromXml(shd.SelectAttrValue("w:val", "clear"))
+	if err != nil {
+		return nil, fmt.Errorf("docx: reading shading: %w", err)
+	}
+	s := &Shading{Pattern: pattern}
+	for _, c := range []struct {
+		attr string
+		dst  **RGBColor
+	}{{"w:color", &s.Color}, {"w:fill", &s.Fill}} {
+		v := shd.SelectAttrValue(c.attr, "auto")
+		if strings.EqualFold(v, "auto") {
+			continue
+		}
+		rgb, err := RGBColorFromString(v)
+		if err != nil {
+			return nil, fmt.Errorf("docx: reading shading: %w", err)
+		}
+		*c.dst = &rgb
+	}
+	return s, nil
+}
+
+// writeShading replaces the w:shd child of pr, which sits before the
+// given successors, with s, or removes it for nil. Theme colors of the old
+// shading are dropped, as they would override the new colors.
+func writeShading(pr *oxml.Element, s *Shading, successors ...string) error {
+	old := pr.RawElement().SelectElement("w:shd")
+	if s == nil {
+		if old != nil {
+			pr.RawElement().RemoveChild(old)
+		}
+		return nil
+	}
+	val, err := s.Pattern.ToXml()
+	if err != nil {
+		return fmt.Errorf("docx: invalid shading pattern %d: %w", s.Pattern, err)
+	}
+	shd := oxml.OxmlElement("w:shd")
+	shd.CreateAttr("w:val", val)
+	shd.CreateAttr("w:color", rgbOrAuto(s.Color))
+	shd.CreateAttr("w:fill", rgbOrAuto(s.Fill))
+	if old != nil {
+		pr.RawElement().InsertChildAt(old.Index(), shd)
+		pr.RawElement().RemoveChild(old)
+		return nil
+	}
+	pr.InsertElementBefore(shd, successors...)
+	return nil
+}
+
+// rgbOrAuto returns the hex value of c, or "auto" for nil.
+func rgbOrAuto(c *RGBColor) string {
+	if c == nil {
+		return "auto"
+	}
+	return c.String()
+}
+
+// GradientFill is a fill of a shape or text box that blends from one color
+// into the next.
+type GradientFill struct {
+	// Type is how the colors blend: along a line, or outward from the
+	// center.
+	Type enum.MsoGradientType
+	// Angle is the direction of a linear gradient in degrees, clockwise
+	// from left to right: 90 blends from top to bottom.
+	Angle float64
+	// Stops are the colors, at least two, in order of position.
+	Stops []GradientStop
+}
+
+// GradientStop is one color of a gradient fill.
+type GradientStop struct {
+	// Position is where the color is pure, from 0 at the start of the
+	// gradient to 1 at the end.
+	Position float64
+	Color    RGBColor
+}
+
+// spec converts g for the oxml layer.
+func (g *GradientFill) spec() (*oxml.GradientSpec, error) {
+	spec := &oxml.GradientSpec{}
+	if g.Type != enum.MsoGradientLinear {
+		path, err := g.Type.ToXml()
+		if err != nil {
+			return nil, fmt.Errorf("docx: invalid gradient type %d: %w", g.Type, err)
+		}
+		spec.Path = path
+	}
+	angle := math.Mod(g.Angle, 360)
+	if angle < 0 {
+		angle += 360
+	}
+	spec.Angle = int(math.Round(angle * 60000))
+	for _, st := range g.Stops {
+		if st.Position < 0 || st.Position > 1 {
+			return nil, fmt.Errorf("docx: gradient stop position %g not between 0 and 1", st.Position)
+		}
+		spec.Stops = append(spec.Stops, oxml.GradientStop{Pos: int(math.Round(st.Position * 100000)), Hex: st.Color.String()})
+	}
+	if len(spec.Stops) < 2 {
+		return nil, fmt.Errorf("docx: gradient needs at least 2 stops, got %d", len(spec.Stops))
+	}
+	return spec, nil
+}
@@ -2,10 +2,13 @@ package docx
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/beevik/etree"
 	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/opc"
 	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
 )
 
 // Namespace URIs for shape type detection.
@@ -20,11 +23,12 @@ const (
 // Mirrors Python InlineShapes(Parented).
 type InlineShapes struct {
 	body *etree.Element // CT_Body element
+	part *parts.StoryPart
 }
 
 // newInlineShapes creates a new InlineShapes proxy.
-func newInlineShapes(body *etree.Element) *InlineShapes {
-	return &InlineShapes{body: body}
+func newInlineShapes(body *etree.Element, part *parts.StoryPart) *InlineShapes {
+	return &InlineShapes{body: body, part: part}
 }
 
 // Len returns the number of inline shapes in the document.
@@ -38,7 +42,7 @@ func (iss *InlineShapes) Get(idx int) (*InlineShape, error) {
 	if idx < 0 || idx >= len(list) {
 		return nil, errIndexOutOfRange("InlineShapes", idx, len(list))
 	}
-	return &InlineShape{inline: list[idx]}, nil
+	return newInlineShape(list[idx], iss.part), nil
 }
 
 // Iter returns all inline shapes in the document.
@@ -46,7 +50,7 @@ func (iss *InlineShapes) Iter() []*InlineShape {
 	list := iss.inlineList()
 	result := make([]*InlineShape, len(list))
 	for i, il := range list {
-		result[i] = &InlineShape{inline: il}
+		result[i] = newInlineShape(il, iss.part)
 	}
 	return result
 }
@@ -83,11 +87,14 @@ func (iss *InlineShapes) inlineList() []*oxml.CT_Inline {
 // Mirrors Python InlineShape.
 type InlineShape struct {
 	inline *oxml.CT_Inline
+	part   *parts.StoryPart
 }
 
-// newInlineShape creates a new InlineShape proxy.
-func newInlineShape(elm *oxml.CT_Inline) *InlineShape {
-	return &InlineShape{inline: elm}
+// newInlineShape creates a new InlineShape proxy. part is the story part
+// owning the shape, used to resolve the picture's image part; it may be
+// nil for detached shapes.
+func newInlineShape(elm *oxml.CT_Inline, part *parts.StoryPart) *InlineShape {
+	return &InlineShape{inline: elm, part: part}
 }
 
 // Height returns the display height of this inline shape as a Length (EMU).
@@ -216,3 +223,278 @@ func (is *InlineShape) Type() (enum.WdInlineShapeType, error) {
 		return enum.WdInlineShapeTypeNotImplemented, nil
 	}
 }
+
+// Resize sets the display size of this inline shape. A zero width or
+// height is computed from the other dimension to keep the current aspect
+// ratio, so Resize(Inches(2), 0) scales the shape to 2 inches wide.
+func (is *InlineShape) Resize(width, height Length) error {
+	if width < 0 || height < 0 || (width == 0 && height == 0) {
+		return fmt.Errorf("docx: invalid inline shape size %d x %d", width, height)
+	}
+	if width == 0 || height == 0 {
+		cx, err := is.Width()
+		if err != nil {
+			return err
+		}
+		cy, err := is.Height()
+		if err != nil {
+			return err
+		}
+		if cx <= 0 || cy <= 0 {
+			return fmt.Errorf("docx: inline shape has no size to keep the aspect ratio of")
+		}
+		if width == 0 {
+			width = Length(math.Round(float64(height) * float64(cx) / float64(cy)))
+		} else {
+			height = Length(math.Round(float64(width) * float64(cy) / float64(cx)))
+		}
+	}
+	if err := is.SetWidth(width); err != nil {
+		return err
+	}
+	return is.SetHeight(height)
+}
+
+// Crop is the cropping of a picture, given for each edge as the fraction
+// of the image cut off, e.g. 0.1 for 10%. Negative values add blank space
+// on that side.
+type Crop struct {
+	Left, Top, Right, Bottom float64
+}
+
+// cropUnit is the a:srcRect unit: 1/1000 of a percent.
+const cropUnit = 100000
+
+// Crop returns the cropping of this picture. A picture without cropping,
+// or a shape that is not a picture, returns the zero Crop.
+func (is *InlineShape) Crop() (Crop, error) {
+	bf := is.blipFill()
+	if bf == nil {
+		return Crop{}, nil
+	}
+	rect := bf.SrcRect()
+	if rect == nil {
+		return Crop{}, nil
+	}
+	var c Crop
+	for _, side := range []struct {
+		get func() (*int, error)
+		dst *float64
+	}{
+		{rect.L, &c.Left}, {rect.T, &c.Top}, {rect.R, &c.Right}, {rect.B, &c.Bottom},
+	} {
+		v, err := side.get()
+		if err != nil {
+			return Crop{}, fmt.Errorf("docx: reading picture crop: %w", err)
+		}
+		if v != nil {
+			*side.dst = float64(*v) / cropUnit
+		}
+	}
+	return c, nil
+}
+
+// SetCrop sets the cropping of this picture. The zero Crop removes any
+// cropping. The display size is not changed, so the visible part of the
+// image is stretched to fill it; call Resize afterwards to keep the
+// original scale.
+func (is *InlineShape) SetCrop(c Crop) error {
+	bf := is.blipFill()
+	if bf == nil {
+		return fmt.Errorf("docx: inline shape is not a picture")
+	}
+	if c.Left+c.Right >= 1 || c.Top+c.Bottom >= 1 {
+		return fmt.Errorf("docx: crop %+v leaves nothing visible", c)
+	}
+	if c == (Crop{}) {
+		bf.RemoveSrcRect()
+		return nil
+	}
+	rect := bf.GetOrAddSrcRect()
+	for _, side := range []struct {
+		set func(*int) error
+		v   float64
+	}{
+		{rect.SetL, c.Left}, {rect.SetT, c.Top}, {rect.SetR, c.Right}, {rect.SetB, c.Bottom},
+	} {
+		var p *int
+		if side.v != 0 {
+			n := int(math.Round(side.v * cropUnit))
+			p = &n
+		}
+		if err := side.set(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rotation returns the clockwise rotation of this picture in degrees.
+func (is *InlineShape) Rotation() (float64, error) {
+	pic := is.pic()
+	if pic == nil {
+		return 0, nil
+	}
+	spPr, err := pic.SpPr()
+	if err != nil {
+		return 0, err
+	}
+	xfrm := spPr.Xfrm()
+	if xfrm == nil {
+		return 0, nil
+	}
+	rot, err := xfrm.Rot()
+	if err != nil || rot == nil {
+		return 0, err
+	}
+	return float64(*rot) / 60000, nil
+}
+
+// SetRotation rotates this picture clockwise by deg degrees; values are
+// normalised to [0, 360). Word rotates the picture about its centre
+// without changing the space it takes up in the line.
+func (is *InlineShape) SetRotation(deg float64) error {
+	pic := is.pic()
+	if pic == nil {
+		return fmt.Errorf("docx: inline shape is not a picture")
+	}
+	spPr, err := pic.SpPr()
+	if err != nil {
+		return err
+	}
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	rot := int(math.Round(deg * 60000))
+	if rot == 0 || rot == 360*60000 {
+		if xfrm := spPr.Xfrm(); xfrm != nil {
+			return xfrm.SetRot(nil)
+		}
+		return nil
+	}
+	return spPr.GetOrAddXfrm().SetRot(&rot)
+}
+
+// AltText returns the alternative text (description) of this shape, read
+// by screen readers in place of the image.
+func (is *InlineShape) AltText() string {
+	docPr, err := is.inline.DocPr()
+	if err != nil {
+		return ""
+	}
+	return docPr.Descr()
+}
+
+// SetAltText sets the alternative text of this shape. "" removes it.
+func (is *InlineShape) SetAltText(text string) error {
+	return is.setNonVisualProps(func(p *oxml.CT_NonVisualDrawingProps) error { return p.SetDescr(text) })
+}
+
+// Title returns the title of this shape, shown by Word as a tooltip.
+func (is *InlineShape) Title() string {
+	docPr, err := is.inline.DocPr()
+	if err != nil {
+		return ""
+	}
+	return docPr.Title()
+}
+
+// SetTitle sets the title of this shape. "" removes it.
+func (is *InlineShape) SetTitle(title string) error {
+	return is.setNonVisualProps(func(p *oxml.CT_NonVisualDrawingProps) error { return p.SetTitle(title) })
+}
+
+// Hyperlink returns the URL opened when this shape is clicked, or "".
+func (is *InlineShape) Hyperlink() string {
+	docPr, err := is.inline.DocPr()
+	if err != nil || is.part == nil {
+		return ""
+	}
+	h := docPr.HlinkClick()
+	if h == nil {
+		return ""
+	}
+	rel := is.part.Rels().GetByRID(h.RId())
+	if rel == nil || !rel.IsExternal {
+		return ""
+	}
+	return rel.TargetRef
+}
+
+// SetHyperlink makes this shape a link to url. "" removes the link.
+func (is *InlineShape) SetHyperlink(url string) error {
+	if is.part == nil {
+		return fmt.Errorf("docx: inline shape has no story part (required for hyperlinks)")
+	}
+	var rId string
+	if url != "" {
+		rId = is.part.Rels().GetOrAddExtRel(opc.RTHyperlink, url)
+	}
+	return is.setNonVisualProps(func(p *oxml.CT_NonVisualDrawingProps) error {
+		if old := p.HlinkClick(); old != nil {
+			oldRId := old.RId()
+			p.RemoveHlinkClick()
+			defer func() {
+				if oldRId != rId && !is.part.HasRelRef(oldRId) {
+					is.part.Rels().Delete(oldRId)
+				}
+			}()
+		}
+		if rId == "" {
+			return nil
+		}
+		return p.GetOrAddHlinkClick().SetRId(rId)
+	})
+}
+
+// setNonVisualProps applies set to the shape's wp:docPr and, for pictures,
+// to the picture's own pic:cNvPr, which Word keeps in step.
+func (is *InlineShape) setNonVisualProps(set func(*oxml.CT_NonVisualDrawingProps) error) error {
+	docPr, err := is.inline.DocPr()
+	if err != nil {
+		return err
+	}
+	if err := set(docPr); err != nil {
+		return err
+	}
+	pic := is.pic()
+	if pic == nil {
+		return nil
+	}
+	nv, err := pic.NvPicPr()
+	if err != nil {
+		return nil
+	}
+	cNvPr, err := nv.CNvPr()
+	if err != nil {
+		return nil
+	}
+	return set(cNvPr)
+}
+
+// pic returns the pic:pic element of a picture shape, or nil.
+func (is *InlineShape) pic() *oxml.CT_Picture {
+	graphic, err := is.inline.Graphic()
+	if err != nil {
+		return nil
+	}
+	gd, err := graphic.GraphicData()
+	if err != nil {
+		return nil
+	}
+	return gd.Pic()
+}
+
+// blipFill returns the pic:blipFill element of a picture shape, or nil.
+func (is *InlineShape) blipFill() *oxml.CT_BlipFillProperties {
+	pic := is.pic()
+	if pic == nil {
+		return nil
+	}
+	bf, err := pic.BlipFill()
+	if err != nil {
+		return nil
+	}
+	return bf
+}
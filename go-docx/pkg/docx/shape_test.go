@@ -1,9 +1,11 @@
 package docx
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/opc"
 	"github.com/vortex/go-docx/pkg/docx/oxml"
 )
 
@@ -30,7 +32,7 @@ func makeInlineShapesBody(t *testing.T, shapeCount int) *InlineShapes {
 	if err != nil {
 		t.Fatal(err)
 	}
-	return newInlineShapes(el)
+	return newInlineShapes(el, nil)
 }
 
 // Mirrors Python: it_can_iterate_over_InlineShape_instances
@@ -122,7 +124,7 @@ func TestInlineShape_Type_XML(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			is := newInlineShape(&oxml.CT_Inline{Element: oxml.WrapElement(el)})
+			is := newInlineShape(&oxml.CT_Inline{Element: oxml.WrapElement(el)}, nil)
 			gotType, err := is.Type()
 				if err != nil {
 					t.Fatal(err)
@@ -143,7 +145,7 @@ func TestInlineShape_Dimensions_XML(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	is := newInlineShape(&oxml.CT_Inline{Element: oxml.WrapElement(el)})
+	is := newInlineShape(&oxml.CT_Inline{Element: oxml.WrapElement(el)}, nil)
 
 	w, err := is.Width()
 	if err != nil {
@@ -183,7 +185,7 @@ func TestInlineShape_SetDimensions_XML(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		is := newInlineShape(&oxml.CT_Inline{Element: oxml.WrapElement(el)})
+		is := newInlineShape(&oxml.CT_Inline{Element: oxml.WrapElement(el)}, nil)
 
 		newWidth := Inches(2)
 		if err := is.SetWidth(newWidth); err != nil {
@@ -216,7 +218,7 @@ func TestInlineShape_SetDimensions_XML(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		is := newInlineShape(&oxml.CT_Inline{Element: oxml.WrapElement(el)})
+		is := newInlineShape(&oxml.CT_Inline{Element: oxml.WrapElement(el)}, nil)
 
 		newWidth := Inches(4)
 		if err := is.SetWidth(newWidth); err != nil {
@@ -237,3 +239,94 @@ func TestInlineShape_SetDimensions_XML(t *testing.T) {
 		}
 	})
 }
+
+func TestInlineShape_ResizeCropRotationAltText(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run, err := p.AddRun("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, h := int64(Inches(2)), int64(Inches(1))
+	shape, err := run.AddPicture(bytes.NewReader(minimalPNG()), &w, &h)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := shape.Resize(Inches(4), 0); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := shape.Height(); got != Inches(2) {
+		t.Errorf("Height() after Resize = %v, want %v", got, Inches(2))
+	}
+
+	crop := Crop{Left: 0.1, Bottom: 0.25}
+	if err := shape.SetCrop(crop); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := shape.Crop(); err != nil || got != crop {
+		t.Errorf("Crop() = %+v, %v, want %+v", got, err, crop)
+	}
+	if err := shape.SetCrop(Crop{Left: 0.6, Right: 0.5}); err == nil {
+		t.Error("SetCrop accepted a crop leaving nothing visible")
+	}
+
+	if err := shape.SetRotation(-90); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := shape.Rotation(); got != 270 {
+		t.Errorf("Rotation() = %v, want 270", got)
+	}
+
+	if err := shape.SetAltText("Company logo"); err != nil {
+		t.Fatal(err)
+	}
+	if err := shape.SetTitle("Logo"); err != nil {
+		t.Fatal(err)
+	}
+	if err := shape.SetHyperlink("https://example.com/"); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	paras := mustParagraphs(t, doc2)
+	pics := paras[len(paras)-1].Runs()[0].Pictures()
+	if len(pics) != 1 {
+		t.Fatalf("Pictures() = %d, want 1", len(pics))
+	}
+	got := pics[0]
+	if got.AltText() != "Company logo" || got.Title() != "Logo" {
+		t.Errorf("AltText/Title = %q/%q", got.AltText(), got.Title())
+	}
+	if got.Hyperlink() != "https://example.com/" {
+		t.Errorf("Hyperlink() = %q", got.Hyperlink())
+	}
+	if c, _ := got.Crop(); c != crop {
+		t.Errorf("Crop() after round-trip = %+v, want %+v", c, crop)
+	}
+
+	if err := got.SetHyperlink(""); err != nil {
+		t.Fatal(err)
+	}
+	for _, rel := range got.part.Rels().All() {
+		if rel.RelType == opc.RTHyperlink {
+			t.Error("hyperlink relationship not removed with the link")
+		}
+	}
+	if err := got.SetCrop(Crop{}); err != nil {
+		t.Fatal(err)
+	}
+	if bf := got.blipFill(); bf.SrcRect() != nil {
+		t.Error("zero Crop did not remove a:srcRect")
+	}
+}
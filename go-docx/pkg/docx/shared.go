@@ -2,8 +2,10 @@ package docx
 
 import (
 	"fmt"
+	"image/color"
 	"math"
 	"strconv"
+	"strings"
 )
 
 // EMU conversion constants.
@@ -37,9 +39,66 @@ func (l Length) Twips() int { return int(math.Round(float64(l) / float64(EmusPer
 // Emu returns the raw EMU value.
 func (l Length) Emu() int64 { return int64(l) }
 
-// String returns a human-readable representation of the length.
+// lengthUnits are the units ParseLength reads, in the order String tries
+// them. A whole number of twips is always one of points with at most two
+// decimals, so String writes twips as points.
+var lengthUnits = []struct {
+	name string
+	emus int64
+}{
+	{"in", EmusPerInch},
+	{"cm", EmusPerCm},
+	{"mm", EmusPerMm},
+	{"pt", EmusPerPt},
+	{"twip", EmusPerTwip},
+	{"emu", 1},
+}
+
+// String returns the length in the first of inches, centimeters,
+// millimeters and points that expresses it exactly with at most three
+// decimals, falling back to EMU, with the unit appended as
+// ParseLength reads it: "1in", "2.5cm", "12pt", "17emu".
 func (l Length) String() string {
-	return fmt.Sprintf("%d EMU", int64(l))
+	if l > math.MaxInt64/1000 || l < math.MinInt64/1000 {
+		return strconv.FormatInt(int64(l), 10) + "emu"
+	}
+	for _, u := range lengthUnits {
+		if int64(l)*1000%u.emus == 0 {
+			return strconv.FormatFloat(float64(l)/float64(u.emus), 'f', -1, 64) + u.name
+		}
+	}
+	return strconv.FormatInt(int64(l), 10) + "emu"
+}
+
+// ParseLength parses a number followed by a unit, such as "2.5cm", "1in",
+// "12pt", "360twip" or "914400emu", as written in configuration files.
+// The unit is one of in, cm, mm, pt, twip and emu, in any case, with
+// "inch", "inches" and "twips" also accepted, and may be separated from
+// the number by spaces. The result is rounded to the nearest EMU.
+func ParseLength(s string) (Length, error) {
+	t := strings.TrimSpace(s)
+	i := strings.LastIndexAny(t, "0123456789.") + 1
+	num, unit := strings.TrimSpace(t[:i]), strings.ToLower(strings.TrimSpace(t[i:]))
+	switch unit {
+	case "inch", "inches":
+		unit = "in"
+	case "twips":
+		unit = "twip"
+	}
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("docx: invalid length %q", s)
+	}
+	for _, u := range lengthUnits {
+		if u.name == unit {
+			emu := math.Round(v * float64(u.emus))
+			if math.Abs(emu) > math.MaxInt64/2 {
+				return 0, fmt.Errorf("docx: length %q out of range", s)
+			}
+			return Length(emu), nil
+		}
+	}
+	return 0, fmt.Errorf("docx: length %q has no unit of in, cm, mm, pt, twip or emu", s)
 }
 
 // Inches creates a Length from a value in inches.
@@ -60,6 +119,51 @@ func Twips(v float64) Length { return Length(int64(v * float64(EmusPerTwip))) }
 // Emu creates a Length from a raw EMU value.
 func Emu(v int64) Length { return Length(v) }
 
+// Ptr returns a pointer to v. Setters of optional (tri-state) properties,
+// such as Font.SetBold, ParagraphFormat.SetSpaceAfterLength or
+// Section.SetTopMargin, take a pointer so that nil can clear the setting
+// and let it be inherited again; Ptr supplies the non-nil case inline:
+//
+//	font.SetBold(docx.Ptr(true))
+//	pf.SetSpaceAfterLength(docx.Ptr(docx.Pt(6)))
+func Ptr[T any](v T) *T { return &v }
+
+// Must returns v, panicking if err is not nil. It is meant for reads that
+// cannot fail on a well-formed document, such as cells of a table the
+// caller has just built, where checking every error obscures the code:
+//
+//	cell := docx.Must(tbl.CellAt(0, 1))
+//	width := docx.Must(sec.PageWidth())
+//
+// Do not use it on documents from untrusted sources; a malformed attribute
+// value then panics instead of returning an error.
+func Must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// twipsLength converts an optional twips value read from the oxml layer,
+// which stores most WordprocessingML distances in twips, to a Length.
+func twipsLength(v *int, err error) (*Length, error) {
+	if err != nil || v == nil {
+		return nil, err
+	}
+	l := Twips(float64(*v))
+	return &l, nil
+}
+
+// lengthTwips converts an optional Length to the twips stored by the oxml
+// layer, rounding to the nearest twip.
+func lengthTwips(v *Length) *int {
+	if v == nil {
+		return nil
+	}
+	tw := v.Twips()
+	return &tw
+}
+
 // RGBColor represents an RGB color as three bytes (red, green, blue).
 type RGBColor [3]byte
 
@@ -85,6 +189,34 @@ func RGBColorFromString(hex string) (RGBColor, error) {
 	return c, nil
 }
 
+// ColorFromHex parses a CSS-style hex color, "#1A2B3C" or the short form
+// "#ABC", in any case and with or without the leading "#".
+func ColorFromHex(hex string) (RGBColor, error) {
+	h := strings.TrimPrefix(strings.TrimSpace(hex), "#")
+	if len(h) == 3 {
+		h = string([]byte{h[0], h[0], h[1], h[1], h[2], h[2]})
+	}
+	c, err := RGBColorFromString(h)
+	if err != nil {
+		return RGBColor{}, fmt.Errorf("docx: invalid hex color %q", hex)
+	}
+	return c, nil
+}
+
+// RGBColorFromColor converts a color from the image/color package,
+// dropping its alpha channel: a translucent color gives its opaque
+// equivalent, as Word has no text transparency.
+func RGBColorFromColor(c color.Color) RGBColor {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return RGBColor{n.R, n.G, n.B}
+}
+
+// RGBA implements color.Color, so an RGBColor can be used with the image
+// packages directly. The color is opaque.
+func (c RGBColor) RGBA() (r, g, b, a uint32) {
+	return color.RGBA{c[0], c[1], c[2], 0xFF}.RGBA()
+}
+
 // String returns the six-character uppercase hex representation (e.g. "3C2F80").
 func (c RGBColor) String() string {
 	return fmt.Sprintf("%02X%02X%02X", c[0], c[1], c[2])
@@ -2,8 +2,11 @@ package docx
 
 import (
 	"errors"
+	"fmt"
+	"image/color"
 	"io"
 	"math"
+	"slices"
 	"testing"
 )
 
@@ -84,6 +87,47 @@ func TestLengthTwipsRoundTrip(t *testing.T) {
 	}
 }
 
+func TestParseLength_String(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		in   string
+		want Length
+		str  string
+	}{
+		{"2.5cm", Cm(2.5), "2.5cm"},
+		{"1in", Inches(1), "1in"},
+		{" 1 Inch ", Inches(1), "1in"},
+		{"12pt", Pt(12), "12pt"},
+		{"360twip", Twips(360), "0.25in"},
+		{"7twips", Twips(7), "0.35pt"},
+		{"5mm", Mm(5), "0.5cm"},
+		{"-0.5in", Inches(-0.5), "-0.5in"},
+		{"17EMU", Emu(17), "17emu"},
+		{"0.1in", Emu(91440), "0.1in"},
+		{"0pt", 0, "0in"},
+	} {
+		got, err := ParseLength(tc.in)
+		if err != nil {
+			t.Errorf("ParseLength(%q): %v", tc.in, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("ParseLength(%q) = %d, want %d", tc.in, got, tc.want)
+		}
+		if s := got.String(); s != tc.str {
+			t.Errorf("ParseLength(%q).String() = %q, want %q", tc.in, s, tc.str)
+		}
+		if back, err := ParseLength(got.String()); err != nil || back != got {
+			t.Errorf("ParseLength(%q) = %d, %v; want %d", got.String(), back, err, got)
+		}
+	}
+	for _, bad := range []string{"", "12", "cm", "12px", "1.2.3cm", "in12"} {
+		if _, err := ParseLength(bad); err == nil {
+			t.Errorf("ParseLength(%q) succeeded", bad)
+		}
+	}
+}
+
 func TestLengthEmu(t *testing.T) {
 	t.Parallel()
 	l := Emu(914400)
@@ -171,6 +215,57 @@ func TestRGBColorZeroValue(t *testing.T) {
 	}
 }
 
+func TestColorFromHex(t *testing.T) {
+	t.Parallel()
+	for in, want := range map[string]RGBColor{
+		"#1A2B3C": {0x1A, 0x2B, 0x3C},
+		"1a2b3c":  {0x1A, 0x2B, 0x3C},
+		"#abc":    {0xAA, 0xBB, 0xCC},
+		" #FFF ":  {0xFF, 0xFF, 0xFF},
+	} {
+		got, err := ColorFromHex(in)
+		if err != nil || got != want {
+			t.Errorf("ColorFromHex(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	for _, bad := range []string{"", "#12345", "#GGGGGG", "##123456"} {
+		if _, err := ColorFromHex(bad); err == nil {
+			t.Errorf("ColorFromHex(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestRGBColorImageColor(t *testing.T) {
+	t.Parallel()
+	c := NewRGBColor(0x1A, 0x2B, 0x3C)
+	var _ color.Color = c
+	if got := color.RGBAModel.Convert(c).(color.RGBA); got != (color.RGBA{0x1A, 0x2B, 0x3C, 0xFF}) {
+		t.Errorf("RGBA = %v", got)
+	}
+	if got := RGBColorFromColor(color.NRGBA{0x1A, 0x2B, 0x3C, 0x80}); got != c {
+		t.Errorf("RGBColorFromColor(translucent) = %v, want %v", got, c)
+	}
+	if got := RGBColorFromColor(color.Gray{0x80}); got != (RGBColor{0x80, 0x80, 0x80}) {
+		t.Errorf("RGBColorFromColor(gray) = %v", got)
+	}
+}
+
+func TestColorByName(t *testing.T) {
+	t.Parallel()
+	for name, want := range map[string]RGBColor{"Dark Red": ColorDarkRed, "lightblue": ColorLightBlue, "GREEN": ColorGreen, "navy": {0, 0, 0x80}} {
+		if got, ok := ColorByName(name); !ok || got != want {
+			t.Errorf("ColorByName(%q) = %v, %v; want %v", name, got, ok, want)
+		}
+	}
+	if _, ok := ColorByName("chartreuse-ish"); ok {
+		t.Error("ColorByName found an unknown name")
+	}
+	names := ColorNames()
+	if !slices.IsSorted(names) || !slices.Contains(names, "darkblue") {
+		t.Errorf("ColorNames() = %v", names)
+	}
+}
+
 func TestErrorTypes(t *testing.T) {
 	t.Parallel()
 
@@ -208,4 +303,117 @@ func TestErrorTypes(t *testing.T) {
 	if !errors.Is(xmlErr, io.EOF) {
 		t.Error("errors.Is should find io.EOF through InvalidXmlError → DocxError.Unwrap")
 	}
+
+	// errors.As finds the DocxError of every typed error, wrapped or not.
+	for _, err := range []error{
+		xmlErr,
+		NewPackageNotFoundError(nil, "not found"),
+		NewInvalidSpanError(nil, "bad span"),
+		NewValidationError("/word/document.xml", "/w:document", "bad"),
+		&ValidationError{Part: "/word/document.xml", XPath: "/w:document", Msg: "bad"},
+	} {
+		var de *DocxError
+		if !errors.As(fmt.Errorf("context: %w", err), &de) || de.Error() != err.Error() {
+			t.Errorf("errors.As(%T, *DocxError) = %v", err, de)
+		}
+	}
+	ve := NewValidationError("/word/document.xml", "/w:document/w:body", "missing required child <w:sectPr>")
+	if want := "docx: invalid markup at /word/document.xml/w:document/w:body: missing required child <w:sectPr>"; ve.Error() != want {
+		t.Errorf("ValidationError.Error() = %q, want %q", ve.Error(), want)
+	}
+	literal := &ValidationError{Part: ve.Part, XPath: ve.XPath, Msg: ve.Msg}
+	if literal.Error() != ve.Error() {
+		t.Errorf("literal ValidationError.Error() = %q, want %q", literal.Error(), ve.Error())
+	}
+}
+
+func TestErrorKinds(t *testing.T) {
+	doc := mustNewDoc(t)
+	if _, err := doc.Sections().Get(99); !errors.Is(err, ErrInvalidIndex) {
+		t.Errorf("Sections().Get(99): err = %v, want ErrInvalidIndex", err)
+	}
+
+	styles, err := doc.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := styles.Get("No Such Style"); !errors.Is(err, ErrStyleNotFound) {
+		t.Errorf("Styles.Get: err = %v, want ErrStyleNotFound", err)
+	}
+	if _, err := doc.AddParagraph("x", StyleName("No Such Style")); !errors.Is(err, ErrStyleNotFound) {
+		t.Errorf("AddParagraph with unknown style: err = %v, want ErrStyleNotFound", err)
+	}
+
+	tbl, err := doc.AddTable(3, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	top, _ := tbl.CellAt(0, 0)
+	wide, _ := tbl.CellAt(0, 1)
+	if _, err := top.Merge(wide); err != nil {
+		t.Fatal(err)
+	}
+	below, _ := tbl.CellAt(1, 0)
+	if _, err := below.Merge(top); !errors.Is(err, ErrNotRectangularSpan) {
+		t.Errorf("Merge into an L shape: err = %v, want ErrNotRectangularSpan", err)
+	}
+
+	if _, err := doc.part.HeaderPartByRID("rId999"); !errors.Is(err, ErrPartNotFound) {
+		t.Errorf("HeaderPartByRID: err = %v, want ErrPartNotFound", err)
+	}
+}
+
+func TestLength_TwipsRoundTrip(t *testing.T) {
+	v := Cm(2.54)
+	if got := lengthTwips(&v); got == nil || *got != 1440 {
+		t.Errorf("lengthTwips(Cm(2.54)) = %v, want 1440", got)
+	}
+	tw := 567
+	if got, _ := twipsLength(&tw, nil); got == nil || *got != Twips(567) {
+		t.Errorf("twipsLength(567) = %v, want %v", got, Twips(567))
+	}
+	if got, err := twipsLength(nil, nil); got != nil || err != nil {
+		t.Errorf("twipsLength(nil) = %v, %v, want nil", got, err)
+	}
+}
+
+func TestPtr(t *testing.T) {
+	doc := mustNewDoc(t)
+	para, err := doc.AddParagraph("x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run := para.Runs()[0]
+	if err := run.Font().SetBold(Ptr(true)); err != nil {
+		t.Fatal(err)
+	}
+	if b := run.Font().Bold(); b == nil || !*b {
+		t.Errorf("Bold() = %v, want true", b)
+	}
+	if err := para.ParagraphFormat().SetSpaceAfterLength(Ptr(Pt(6))); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := para.ParagraphFormat().SpaceAfterLength(); v == nil || *v != Pt(6) {
+		t.Errorf("SpaceAfter() = %v, want %v", v, Pt(6))
+	}
+	p1, p2 := Ptr(1), Ptr(1)
+	if p1 == p2 {
+		t.Error("Ptr returned the same pointer twice")
+	}
+}
+
+func TestMust(t *testing.T) {
+	doc := mustNewDoc(t)
+	tbl := Must(doc.AddTable(2, 2))
+	Must(tbl.CellAt(1, 1)).SetText("x")
+	if got := Must(tbl.CellAt(1, 1)).Text(); got != "x" {
+		t.Errorf("cell text = %q, want x", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Must did not panic on error")
+		}
+	}()
+	Must(tbl.CellAt(5, 5))
 }
@@ -0,0 +1,77 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+func TestDocument_AddSignatureLine(t *testing.T) {
+	doc := mustNewDoc(t)
+	para, err := doc.AddSignatureLine("Jane Roe", "CFO, Smith & Co", "jane@example.com")
+	if err != nil {
+		t.Fatalf("AddSignatureLine: %v", err)
+	}
+	shape := para.p.RawElement().FindElement("w:r/w:pict/v:shape")
+	if shape == nil {
+		t.Fatal("no VML shape in the signature line paragraph")
+	}
+	sl := shape.SelectElement("o:signatureline")
+	if sl == nil {
+		t.Fatal("no o:signatureline")
+	}
+	for attr, want := range map[string]string{
+		"o:suggestedsigner":      "Jane Roe",
+		"o:suggestedsigner2":     "CFO, Smith & Co",
+		"o:suggestedsigneremail": "jane@example.com",
+		"issignatureline":        "t",
+	} {
+		if got := sl.SelectAttrValue(attr, ""); got != want {
+			t.Errorf("%s = %q, want %q", attr, got, want)
+		}
+	}
+	if id := sl.SelectAttrValue("id", ""); len(id) != 38 {
+		t.Errorf("id = %q, want a braced GUID", id)
+	}
+	rId := shape.FindElement("v:imagedata").SelectAttrValue("r:id", "")
+	if _, ok := doc.part.Rels().RelatedParts()[rId].(*parts.ImagePart); !ok {
+		t.Errorf("v:imagedata r:id %q does not refer to an image part", rId)
+	}
+
+	run, err := para.AddRun("")
+	if err != nil {
+		t.Fatalf("AddRun: %v", err)
+	}
+	err = run.AddSignatureLine(SignatureLineOptions{Signer: "John Doe", Instructions: "Sign here", HideSignDate: true})
+	if err != nil {
+		t.Fatalf("Run.AddSignatureLine: %v", err)
+	}
+	shapes := para.p.RawElement().FindElements(".//v:shape")
+	if len(shapes) != 2 || shapes[0].SelectAttrValue("id", "") == shapes[1].SelectAttrValue("id", "") {
+		t.Fatalf("want 2 shapes with distinct ids, got %d", len(shapes))
+	}
+	if n := len(doc.part.Element().FindElements(".//v:shapetype[@id='_x0000_t75']")); n != 1 {
+		t.Errorf("part defines the picture shape type %d times, want once", n)
+	}
+	sl2 := shapes[1].SelectElement("o:signatureline")
+	if sl2.SelectAttrValue("o:signinginstructions", "") != "Sign here" || sl2.SelectAttrValue("showsigndate", "") != "f" {
+		t.Error("signing instructions or sign date option missing")
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	b, err := doc2.getBody()
+	if err != nil {
+		t.Fatalf("getBody: %v", err)
+	}
+	if n := len(b.element.FindElements(".//o:signatureline")); n != 2 {
+		t.Errorf("reopened document has %d signature lines, want 2", n)
+	}
+}
@@ -0,0 +1,188 @@
+package docx
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// Statistics are counts computed from the content of a document or of one
+// of its sections, as Word's Word Count dialog shows them. Unlike the
+// figures in docProps/app.xml, which are only as fresh as the last save by
+// Word, they always match the content.
+type Statistics struct {
+	// Words is the number of runs of non-space characters.
+	Words int
+	// Characters is the number of characters other than white space.
+	Characters int
+	// CharactersWithSpaces is the number of characters including spaces
+	// and tabs, but not paragraph marks or breaks.
+	CharactersWithSpaces int
+	// Paragraphs is the number of paragraphs holding any text.
+	Paragraphs int
+	// Tables is the number of tables, nested ones included.
+	Tables int
+	// Images is the number of pictures, DrawingML or VML.
+	Images int
+	// Comments is the number of comments anchored in the content.
+	Comments int
+	// Revisions is the number of tracked changes: insertions, deletions,
+	// moves and property changes.
+	Revisions int
+	// Sections breaks the counts down by section. It is only set on the
+	// document totals.
+	Sections []Statistics
+}
+
+// Statistics counts the words, characters, paragraphs, tables, images,
+// comments and tracked changes of the document body, in total and per
+// section. Text in tables, content controls and text boxes counts; text
+// in headers, footers and notes, deleted text and field codes do not.
+// Inserted text that is not yet accepted counts.
+func (d *Document) Statistics() (*Statistics, error) {
+	b, err := d.getBody()
+	if err != nil {
+		return nil, err
+	}
+	total := &Statistics{}
+	sec := &statCounter{comments: map[string]bool{}}
+	pending := false
+	for _, child := range b.element.ChildElements() {
+		sec.block(child)
+		pending = true
+		if child.Space == "w" && ((child.Tag == "p" && findParagraphSectPr(child) != nil) || child.Tag == "sectPr") {
+			total.add(sec.Statistics)
+			total.Sections = append(total.Sections, sec.Statistics)
+			sec = &statCounter{comments: map[string]bool{}}
+			pending = false
+		}
+	}
+	if pending {
+		// Content after the last section break, in a body without sectPr.
+		total.add(sec.Statistics)
+		total.Sections = append(total.Sections, sec.Statistics)
+	}
+	return total, nil
+}
+
+// add adds the counts of s to st.
+func (st *Statistics) add(s Statistics) {
+	st.Words += s.Words
+	st.Characters += s.Characters
+	st.CharactersWithSpaces += s.CharactersWithSpaces
+	st.Paragraphs += s.Paragraphs
+	st.Tables += s.Tables
+	st.Images += s.Images
+	st.Comments += s.Comments
+	st.Revisions += s.Revisions
+}
+
+// statCounter accumulates the statistics of a run of block content.
+type statCounter struct {
+	Statistics
+	comments map[string]bool
+}
+
+// revisionTags are the elements that record tracked changes.
+var revisionTags = map[string]bool{
+	"ins": true, "del": true, "moveFrom": true, "moveTo": true,
+	"rPrChange": true, "pPrChange": true, "sectPrChange": true, "tblPrChange": true,
+	"tblGridChange": true, "trPrChange": true, "tcPrChange": true, "numberingChange": true,
+	"cellIns": true, "cellDel": true, "cellMerge": true,
+}
+
+// block counts block-level element el and everything in it.
+func (sc *statCounter) block(el *etree.Element) {
+	sc.scan(el)
+	sc.text(el)
+}
+
+// scan counts the tables, images, comments and revisions in el. Fallback
+// content, which repeats its alternative for older readers, is skipped.
+func (sc *statCounter) scan(el *etree.Element) {
+	switch {
+	case el.Space == "mc" && el.Tag == "Fallback":
+		return
+	case el.Space == "w" && el.Tag == "tbl":
+		sc.Tables++
+	case el.Space == "w" && el.Tag == "commentReference":
+		if id := el.SelectAttrValue("w:id", ""); !sc.comments[id] {
+			sc.comments[id] = true
+			sc.Comments++
+		}
+	case el.Space == "w" && revisionTags[el.Tag]:
+		sc.Revisions++
+	case el.Space == "pic" && el.Tag == "pic", el.Space == "v" && el.Tag == "imagedata":
+		sc.Images++
+	}
+	for _, child := range el.ChildElements() {
+		sc.scan(child)
+	}
+}
+
+// text counts the paragraphs, words and characters of the paragraphs in
+// el.
+func (sc *statCounter) text(el *etree.Element) {
+	if el.Space == "mc" && el.Tag == "Fallback" {
+		return
+	}
+	if el.Space == "w" && el.Tag == "p" {
+		var sb strings.Builder
+		sc.paragraphText(el, &sb)
+		sc.countParagraph(sb.String())
+		return
+	}
+	for _, child := range el.ChildElements() {
+		sc.text(child)
+	}
+}
+
+// paragraphText writes the visible text of paragraph content el to sb,
+// with breaks as newlines. Paragraphs of text boxes are counted on their
+// own.
+func (sc *statCounter) paragraphText(el *etree.Element, sb *strings.Builder) {
+	for _, child := range el.ChildElements() {
+		switch {
+		case child.Space == "mc" && child.Tag == "Fallback":
+		case child.Space == "w" && child.Tag == "txbxContent":
+			sc.text(child)
+		case child.Space != "w":
+			sc.paragraphText(child, sb)
+		case child.Tag == "del", child.Tag == "moveFrom", child.Tag == "instrText",
+			child.Tag == "delText", child.Tag == "pPr", child.Tag == "rPr", child.Tag == "rt":
+		case child.Tag == "t":
+			sb.WriteString(child.Text())
+		case child.Tag == "tab", child.Tag == "ptab":
+			sb.WriteByte('\t')
+		case child.Tag == "br", child.Tag == "cr":
+			sb.WriteByte('\n')
+		case child.Tag == "noBreakHyphen":
+			sb.WriteString(oxml.NoBreakHyphen)
+		case child.Tag == "sym":
+			sb.WriteString(oxml.SymText(child))
+		default:
+			sc.paragraphText(child, sb)
+		}
+	}
+}
+
+// countParagraph adds the text of one paragraph to the counts.
+func (sc *statCounter) countParagraph(text string) {
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	sc.Paragraphs++
+	sc.Words += len(strings.Fields(text))
+	for _, r := range text {
+		switch {
+		case r == '\n':
+		case unicode.IsSpace(r):
+			sc.CharactersWithSpaces++
+		default:
+			sc.Characters++
+			sc.CharactersWithSpaces++
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package docx
+
+import (
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+func TestDocument_Statistics(t *testing.T) {
+	d := mustNewDoc(t)
+	p, err := d.AddParagraph("Hello  brave world")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.AddComment(p.Runs(), "check", "Ann", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.AddParagraph(""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.AddSection(enum.WdSectionStartNewPage); err != nil {
+		t.Fatal(err)
+	}
+
+	tbl, err := d.AddTable(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tbl.Rows().Iter()[0].Cells()[0].Paragraphs()[0].AddRun("in cell"); err != nil {
+		t.Fatal(err)
+	}
+	p, err = d.AddParagraph("kept ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, xml := range []string{
+		`<w:ins ` + wNS + ` w:id="1" w:author="A"><w:r><w:t>added</w:t></w:r></w:ins>`,
+		`<w:del ` + wNS + ` w:id="2" w:author="A"><w:r><w:delText>gone words</w:delText></w:r></w:del>`,
+		`<w:r ` + wNS + `><w:fldChar w:fldCharType="begin"/></w:r>`,
+		`<w:r ` + wNS + `><w:instrText> PAGE </w:instrText></w:r>`,
+		`<w:r ` + wNS + `><w:fldChar w:fldCharType="separate"/></w:r>`,
+		`<w:r ` + wNS + `><w:t>7</w:t></w:r>`,
+		`<w:r ` + wNS + `><w:fldChar w:fldCharType="end"/></w:r>`,
+	} {
+		el, err := oxml.ParseXml([]byte(xml))
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.p.RawElement().AddChild(el)
+	}
+
+	st, err := d.Statistics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(st.Sections) != 2 {
+		t.Fatalf("got %d sections, want 2", len(st.Sections))
+	}
+	first, second := st.Sections[0], st.Sections[1]
+	if first.Words != 3 || first.Characters != 15 || first.CharactersWithSpaces != 18 || first.Paragraphs != 1 || first.Comments != 1 {
+		t.Errorf("first section = %+v", first)
+	}
+	// "in cell" and "kept added7": the deleted text and field code do not count.
+	if second.Words != 4 || second.Paragraphs != 2 || second.Tables != 1 || second.Revisions != 2 {
+		t.Errorf("second section = %+v", second)
+	}
+	if st.Words != 7 || st.Tables != 1 || st.Comments != 1 || st.Characters != first.Characters+second.Characters {
+		t.Errorf("totals = %+v", st)
+	}
+}
@@ -0,0 +1,618 @@
+package docx
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// Structure is a simplified model of the body of a document: its sections,
+// their paragraphs and tables, and the runs of the paragraphs with their
+// character formatting. MarshalStructure encodes it as JSON, and
+// NewFromStructure builds a new document from it, so that programs such as
+// web editors can work on the model and save the result as Word.
+//
+// The model is lossy. Anything it does not describe, such as direct
+// paragraph formatting other than alignment, lists, images, fields,
+// content controls, headers and footers, is not exported and cannot be
+// loaded. Vertically merged table cells are exported as separate cells.
+type Structure struct {
+	Sections []StructureSection `json:"sections"`
+}
+
+// StructureSection is a section of a Structure. Lengths are in points;
+// zero values are left at the defaults when loading.
+type StructureSection struct {
+	// Start is where the section starts, as in the w:type of its section
+	// properties: "nextPage", "continuous", "evenPage", "oddPage" or
+	// "nextColumn".
+	Start string `json:"start,omitempty"`
+	// Orientation is "portrait" or "landscape".
+	Orientation  string           `json:"orientation,omitempty"`
+	PageWidth    float64          `json:"pageWidth,omitempty"`
+	PageHeight   float64          `json:"pageHeight,omitempty"`
+	TopMargin    float64          `json:"topMargin,omitempty"`
+	BottomMargin float64          `json:"bottomMargin,omitempty"`
+	LeftMargin   float64          `json:"leftMargin,omitempty"`
+	RightMargin  float64          `json:"rightMargin,omitempty"`
+	Blocks       []StructureBlock `json:"blocks"`
+}
+
+// StructureBlock is a paragraph or a table.
+type StructureBlock struct {
+	// Type is "paragraph" or "table".
+	Type string `json:"type"`
+	// Style is the name of the paragraph or table style, as Word shows it.
+	Style string `json:"style,omitempty"`
+	// Alignment is the paragraph alignment as in w:jc, such as "center".
+	Alignment string `json:"alignment,omitempty"`
+	// Text is the text of a paragraph. It is set when exporting for
+	// convenience, and used when loading a paragraph without Runs.
+	Text string         `json:"text,omitempty"`
+	Runs []StructureRun `json:"runs,omitempty"`
+	Rows []StructureRow `json:"rows,omitempty"`
+}
+
+// StructureRow is a row of a table.
+type StructureRow struct {
+	Cells []StructureCell `json:"cells"`
+}
+
+// StructureCell is a cell of a table row.
+type StructureCell struct {
+	// Span is the number of grid columns the cell spans, when more than 1.
+	Span   int              `json:"span,omitempty"`
+	Blocks []StructureBlock `json:"blocks"`
+}
+
+// StructureRun is a run of text with its character formatting. Formatting
+// is the direct formatting of the run: values inherited from styles are
+// not included.
+type StructureRun struct {
+	Text      string `json:"text"`
+	Style     string `json:"style,omitempty"`
+	Bold      bool   `json:"bold,omitempty"`
+	Italic    bool   `json:"italic,omitempty"`
+	Underline bool   `json:"underline,omitempty"`
+	Font      string `json:"font,omitempty"`
+	// Size is the font size in points.
+	Size float64 `json:"size,omitempty"`
+	// Color is the font color as six hex digits, such as "FF0000".
+	Color string `json:"color,omitempty"`
+	// Link is the target of the hyperlink holding the run: a URL, or "#"
+	// followed by a bookmark name.
+	Link string `json:"link,omitempty"`
+}
+
+// Structure returns the model of the document body.
+func (d *Document) Structure() (*Structure, error) {
+	s := &Structure{Sections: []StructureSection{}}
+	for _, sect := range d.Sections().Iter() {
+		ss, err := structureSection(sect)
+		if err != nil {
+			return nil, err
+		}
+		if ss.Blocks, err = structureBlocks(sect.IterInnerContent()); err != nil {
+			return nil, err
+		}
+		s.Sections = append(s.Sections, ss)
+	}
+	return s, nil
+}
+
+// MarshalStructure returns the model of the document body as JSON.
+func (d *Document) MarshalStructure() ([]byte, error) {
+	s, err := d.Structure()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalStructure builds a new document from the JSON encoding of a
+// Structure, as NewFromStructure does.
+func UnmarshalStructure(data []byte) (*Document, error) {
+	var s Structure
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("docx: decoding structure: %w", err)
+	}
+	return NewFromStructure(&s)
+}
+
+// NewFromStructure builds a new document from the default template with
+// the content of s. Styles must exist in the template or be built-in
+// styles, which are added as needed.
+func NewFromStructure(s *Structure) (*Document, error) {
+	d, err := New()
+	if err != nil {
+		return nil, err
+	}
+	body, err := d.getBody()
+	if err != nil {
+		return nil, err
+	}
+	for i, ss := range s.Sections {
+		sect, err := d.Sections().Get(d.Sections().Len() - 1)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			if sect, err = addStructureSectionBreak(d, s.Sections[i-1].Blocks); err != nil {
+				return nil, err
+			}
+		}
+		if err := applyStructureSection(sect, ss); err != nil {
+			return nil, fmt.Errorf("docx: section %d: %w", i+1, err)
+		}
+		width, err := d.blockWidth()
+		if err != nil {
+			return nil, err
+		}
+		if err := addStructureBlocks(&body.BlockItemContainer, ss.Blocks, Twips(float64(width))); err != nil {
+			return nil, fmt.Errorf("docx: section %d: %w", i+1, err)
+		}
+	}
+	return d, nil
+}
+
+// addStructureSectionBreak ends the current section after blocks, its
+// content, and returns the new section. The section properties go on the
+// last paragraph of the content when there is one, so that exporting the
+// document gives back the same blocks.
+func addStructureSectionBreak(d *Document, blocks []StructureBlock) (*Section, error) {
+	sect, err := d.AddSection(enum.WdSectionStartNewPage)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 || blocks[len(blocks)-1].Type != "paragraph" {
+		return sect, nil
+	}
+	children := d.element.Body().RawElement().ChildElements()
+	// children end with the last paragraph, the break paragraph added by
+	// AddSection, and the body's w:sectPr.
+	if len(children) < 3 {
+		return sect, nil
+	}
+	last, brk := children[len(children)-3], children[len(children)-2]
+	sectPr := findParagraphSectPr(brk)
+	if last.Space != "w" || last.Tag != "p" || sectPr == nil {
+		return sect, nil
+	}
+	sectPr.Parent().RemoveChild(sectPr)
+	brk.Parent().RemoveChild(brk)
+	lastP := &oxml.CT_P{Element: oxml.WrapElement(last)}
+	lastP.SetSectPr(&oxml.CT_SectPr{Element: oxml.WrapElement(sectPr)})
+	return sect, nil
+}
+
+// structureSection returns the page setup of sect.
+func structureSection(sect *Section) (StructureSection, error) {
+	var ss StructureSection
+	start, err := sect.StartType()
+	if err != nil {
+		return ss, err
+	}
+	if ss.Start, err = start.ToXml(); err != nil {
+		return ss, err
+	}
+	orient, err := sect.Orientation()
+	if err != nil {
+		return ss, err
+	}
+	if ss.Orientation, err = orient.ToXml(); err != nil {
+		return ss, err
+	}
+	for _, f := range []struct {
+		get func() (*Length, error)
+		dst *float64
+	}{
+		{sect.PageWidthLength, &ss.PageWidth},
+		{sect.PageHeightLength, &ss.PageHeight},
+		{sect.TopMarginLength, &ss.TopMargin},
+		{sect.BottomMarginLength, &ss.BottomMargin},
+		{sect.LeftMarginLength, &ss.LeftMargin},
+		{sect.RightMarginLength, &ss.RightMargin},
+	} {
+		v, err := f.get()
+		if err != nil {
+			return ss, err
+		}
+		if v != nil {
+			*f.dst = v.Pt()
+		}
+	}
+	ss.Blocks = []StructureBlock{}
+	return ss, nil
+}
+
+// applyStructureSection sets the page setup of sect from ss.
+func applyStructureSection(sect *Section, ss StructureSection) error {
+	if ss.Start != "" {
+		start, err := enum.WdSectionStartFromXml(ss.Start)
+		if err != nil {
+			return err
+		}
+		if err := sect.SetStartType(start); err != nil {
+			return err
+		}
+	}
+	if ss.Orientation != "" {
+		orient, err := enum.WdOrientationFromXml(ss.Orientation)
+		if err != nil {
+			return err
+		}
+		if err := sect.SetOrientation(orient); err != nil {
+			return err
+		}
+	}
+	for _, f := range []struct {
+		set func(*Length) error
+		v   float64
+	}{
+		{sect.SetPageWidthLength, ss.PageWidth},
+		{sect.SetPageHeightLength, ss.PageHeight},
+		{sect.SetTopMarginLength, ss.TopMargin},
+		{sect.SetBottomMarginLength, ss.BottomMargin},
+		{sect.SetLeftMarginLength, ss.LeftMargin},
+		{sect.SetRightMarginLength, ss.RightMargin},
+	} {
+		if f.v == 0 {
+			continue
+		}
+		if err := f.set(Ptr(Pt(f.v))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// structureBlocks returns the model of block content.
+func structureBlocks(items []*InnerContentItem) ([]StructureBlock, error) {
+	blocks := make([]StructureBlock, 0, len(items))
+	for _, it := range items {
+		var (
+			b   StructureBlock
+			err error
+		)
+		if it.IsTable() {
+			b, err = structureTable(it.Table())
+		} else {
+			b, err = structureParagraph(it.Paragraph())
+		}
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks, nil
+}
+
+func structureParagraph(para *Paragraph) (StructureBlock, error) {
+	b := StructureBlock{Type: "paragraph", Text: para.Text()}
+	id, err := para.p.Style()
+	if err != nil {
+		return b, err
+	}
+	if id != nil {
+		if b.Style, err = structureStyleName(para.Style()); err != nil {
+			return b, err
+		}
+	}
+	align, err := para.Alignment()
+	if err != nil {
+		return b, err
+	}
+	if align != nil {
+		if b.Alignment, err = align.ToXml(); err != nil {
+			return b, err
+		}
+	}
+	for _, item := range para.IterInnerContent() {
+		if !item.IsHyperlink() {
+			sr, err := structureRun(item.Run())
+			if err != nil {
+				return b, err
+			}
+			b.Runs = append(b.Runs, sr)
+			continue
+		}
+		h := item.Hyperlink()
+		link := h.URL()
+		if link == "" && h.Fragment() != "" {
+			link = "#" + h.Fragment()
+		}
+		for _, r := range h.Runs() {
+			sr, err := structureRun(r)
+			if err != nil {
+				return b, err
+			}
+			sr.Link = link
+			b.Runs = append(b.Runs, sr)
+		}
+	}
+	return b, nil
+}
+
+func structureRun(run *Run) (StructureRun, error) {
+	sr := StructureRun{Text: run.Text()}
+	id, err := run.r.Style()
+	if err != nil {
+		return sr, err
+	}
+	if id != nil {
+		if sr.Style, err = structureStyleName(run.Style()); err != nil {
+			return sr, err
+		}
+	}
+	sr.Bold = isTrue(run.Bold())
+	sr.Italic = isTrue(run.Italic())
+	u, err := run.Underline()
+	if err != nil {
+		return sr, err
+	}
+	sr.Underline = u != nil && !u.IsNone()
+	font := run.Font()
+	if name := font.Name(); name != nil {
+		sr.Font = *name
+	}
+	size, err := font.Size()
+	if err != nil {
+		return sr, err
+	}
+	if size != nil {
+		sr.Size = size.Pt()
+	}
+	color, err := font.Color().RGB()
+	if err != nil {
+		return sr, err
+	}
+	if color != nil {
+		sr.Color = color.String()
+	}
+	return sr, nil
+}
+
+// structureStyleName returns the UI name of the style returned by a Style
+// method.
+func structureStyleName(style *oxml.CT_Style, err error) (string, error) {
+	if err != nil || style == nil {
+		return "", err
+	}
+	name, err := style.NameVal()
+	if err != nil {
+		return "", err
+	}
+	return Internal2UI(name), nil
+}
+
+func structureTable(t *Table) (StructureBlock, error) {
+	b := StructureBlock{Type: "table"}
+	id, err := t.tbl.TblStyleVal()
+	if err != nil {
+		return b, err
+	}
+	if id != "" {
+		if b.Style, err = structureStyleName(t.Style()); err != nil {
+			return b, err
+		}
+	}
+	for _, row := range t.Rows().Iter() {
+		var sr StructureRow
+		for _, tc := range row.tr.TcList() {
+			cell := newCell(tc, t)
+			blocks, err := structureBlocks(cell.IterInnerContent())
+			if err != nil {
+				return b, err
+			}
+			sc := StructureCell{Blocks: blocks}
+			if span := cell.GridSpan(); span > 1 {
+				sc.Span = span
+			}
+			sr.Cells = append(sr.Cells, sc)
+		}
+		b.Rows = append(b.Rows, sr)
+	}
+	return b, nil
+}
+
+// addStructureBlocks appends blocks to c. width is the width available
+// to tables.
+func addStructureBlocks(c *BlockItemContainer, blocks []StructureBlock, width Length) error {
+	for i, b := range blocks {
+		var err error
+		switch b.Type {
+		case "paragraph":
+			err = addStructureParagraph(c, b)
+		case "table":
+			err = addStructureTable(c, b, width)
+		default:
+			err = fmt.Errorf("unknown block type %q", b.Type)
+		}
+		if err != nil {
+			return fmt.Errorf("block %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func addStructureParagraph(c *BlockItemContainer, b StructureBlock) error {
+	var style []StyleRef
+	if b.Style != "" {
+		style = append(style, StyleName(b.Style))
+	}
+	para, err := c.AddParagraph("", style...)
+	if err != nil {
+		return err
+	}
+	if b.Alignment != "" {
+		align, err := enum.WdParagraphAlignmentFromXml(b.Alignment)
+		if err != nil {
+			return err
+		}
+		if err := para.SetAlignment(&align); err != nil {
+			return err
+		}
+	}
+	runs := b.Runs
+	if len(runs) == 0 && b.Text != "" {
+		runs = []StructureRun{{Text: b.Text}}
+	}
+	var (
+		link     *oxml.CT_Hyperlink
+		linkHref string
+	)
+	for _, sr := range runs {
+		var r *oxml.CT_R
+		switch {
+		case sr.Link == "":
+			link = nil
+			r = para.p.AddR()
+		case link != nil && sr.Link == linkHref:
+			r = link.AddR()
+		default:
+			link, linkHref = para.p.AddHyperlink(), sr.Link
+			if err := setStructureLink(link, sr.Link, para); err != nil {
+				return err
+			}
+			r = link.AddR()
+		}
+		if err := applyStructureRun(newRun(r, para.part), sr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setStructureLink points hyperlink h at link, a URL or "#" followed by a
+// bookmark name.
+func setStructureLink(h *oxml.CT_Hyperlink, link string, para *Paragraph) error {
+	if link[0] == '#' {
+		return h.SetAnchor(link[1:])
+	}
+	return h.SetRId(para.part.Rels().GetOrAddExtRel(opc.RTHyperlink, link))
+}
+
+func applyStructureRun(run *Run, sr StructureRun) error {
+	if sr.Text != "" {
+		run.SetText(sr.Text)
+	}
+	if sr.Style != "" {
+		if err := run.SetStyle(StyleName(sr.Style)); err != nil {
+			return err
+		}
+	}
+	if sr.Bold {
+		if err := run.SetBold(Ptr(true)); err != nil {
+			return err
+		}
+	}
+	if sr.Italic {
+		if err := run.SetItalic(Ptr(true)); err != nil {
+			return err
+		}
+	}
+	if sr.Underline {
+		if err := run.SetUnderline(Ptr(UnderlineSingle())); err != nil {
+			return err
+		}
+	}
+	font := run.Font()
+	if sr.Font != "" {
+		if err := font.SetName(&sr.Font); err != nil {
+			return err
+		}
+	}
+	if sr.Size != 0 {
+		if err := font.SetSize(Ptr(Pt(sr.Size))); err != nil {
+			return err
+		}
+	}
+	if sr.Color != "" {
+		color, err := RGBColorFromString(sr.Color)
+		if err != nil {
+			return err
+		}
+		if err := font.Color().SetRGB(&color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addStructureTable(c *BlockItemContainer, b StructureBlock, width Length) error {
+	cols := 0
+	for _, row := range b.Rows {
+		n := 0
+		for _, cell := range row.Cells {
+			n += max(cell.Span, 1)
+		}
+		cols = max(cols, n)
+	}
+	if cols == 0 {
+		return fmt.Errorf("table has no cells")
+	}
+	t, err := c.AddTableLength(len(b.Rows), cols, width)
+	if err != nil {
+		return err
+	}
+	if b.Style != "" {
+		if err := t.SetStyle(StyleName(b.Style)); err != nil {
+			return err
+		}
+	}
+	colWidth := width / Length(cols)
+	for i, row := range b.Rows {
+		col := 0
+		for _, sc := range row.Cells {
+			span := max(sc.Span, 1)
+			cell, err := t.CellAt(i, col)
+			if err != nil {
+				return err
+			}
+			if span > 1 {
+				last, err := t.CellAt(i, col+span-1)
+				if err != nil {
+					return err
+				}
+				if cell, err = cell.Merge(last); err != nil {
+					return err
+				}
+			}
+			if err := fillStructureCell(cell, sc.Blocks, colWidth*Length(span)); err != nil {
+				return err
+			}
+			col += span
+		}
+	}
+	return nil
+}
+
+// fillStructureCell replaces the empty paragraph of a new cell with
+// blocks, keeping the paragraph a cell must end with.
+func fillStructureCell(cell *Cell, blocks []StructureBlock, width Length) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+	placeholder := cell.IterInnerContent()
+	if err := addStructureBlocks(&cell.BlockItemContainer, blocks, width); err != nil {
+		return err
+	}
+	if blocks[len(blocks)-1].Type == "table" {
+		return nil
+	}
+	for _, it := range placeholder {
+		if it.IsParagraph() {
+			el := it.Paragraph().p.RawElement()
+			el.Parent().RemoveChild(el)
+		}
+	}
+	return nil
+}
+
+// isTrue reports whether a tri-state property is set and true.
+func isTrue(v *bool) bool {
+	return v != nil && *v
+}
@@ -0,0 +1,139 @@
+package docx
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestStructure_Export(t *testing.T) {
+	doc := mustNewDoc(t)
+	if _, err := doc.AddHeading("Title", 1); err != nil {
+		t.Fatal(err)
+	}
+	p, err := doc.AddParagraph("plain ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _ := p.AddRun("bold")
+	r.SetBold(Ptr(true))
+	r.Font().SetSize(Ptr(Pt(14)))
+	tbl, err := doc.AddTable(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, _ := tbl.CellAt(0, 0)
+	c.SetText("a")
+
+	s, err := doc.Structure()
+	if err != nil {
+		t.Fatalf("Structure: %v", err)
+	}
+	if len(s.Sections) != 1 {
+		t.Fatalf("sections = %d", len(s.Sections))
+	}
+	blocks := s.Sections[0].Blocks
+	if len(blocks) != 3 {
+		t.Fatalf("blocks = %+v", blocks)
+	}
+	if blocks[0].Style != "Heading 1" || blocks[0].Text != "Title" {
+		t.Errorf("heading = %+v", blocks[0])
+	}
+	want := []StructureRun{{Text: "plain "}, {Text: "bold", Bold: true, Size: 14}}
+	if !reflect.DeepEqual(blocks[1].Runs, want) {
+		t.Errorf("runs = %+v, want %+v", blocks[1].Runs, want)
+	}
+	if blocks[2].Type != "table" || len(blocks[2].Rows[0].Cells) != 2 || blocks[2].Rows[0].Cells[0].Blocks[0].Text != "a" {
+		t.Errorf("table = %+v", blocks[2])
+	}
+	if s.Sections[0].PageWidth == 0 || s.Sections[0].Start != "nextPage" {
+		t.Errorf("section = %+v", s.Sections[0])
+	}
+}
+
+func TestStructure_RoundTrip(t *testing.T) {
+	in := `{"sections": [
+		{"orientation": "landscape", "pageWidth": 792, "pageHeight": 612, "blocks": [
+			{"type": "paragraph", "style": "Heading 2", "text": "Intro"},
+			{"type": "paragraph", "alignment": "center", "runs": [
+				{"text": "see "},
+				{"text": "the site", "italic": true, "link": "https://example.com/"},
+				{"text": " now", "color": "FF0000", "font": "Arial", "underline": true}
+			]}
+		]},
+		{"start": "continuous", "blocks": [
+			{"type": "table", "rows": [
+				{"cells": [{"span": 2, "blocks": [{"type": "paragraph", "text": "wide"}]}]},
+				{"cells": [{"blocks": []}, {"blocks": [{"type": "paragraph", "text": "x"}]}]}
+			]}
+		]}
+	]}`
+	doc, err := UnmarshalStructure([]byte(in))
+	if err != nil {
+		t.Fatalf("UnmarshalStructure: %v", err)
+	}
+	s, err := doc.Structure()
+	if err != nil {
+		t.Fatalf("Structure: %v", err)
+	}
+	if len(s.Sections) != 2 {
+		t.Fatalf("sections = %+v", s.Sections)
+	}
+	first, second := s.Sections[0], s.Sections[1]
+	if first.Orientation != "landscape" || first.PageWidth != 792 || second.Start != "continuous" {
+		t.Errorf("sections = %+v / %+v", first, second)
+	}
+	if len(first.Blocks) != 2 {
+		t.Fatalf("first section blocks = %+v", first.Blocks)
+	}
+	if b := first.Blocks[0]; b.Style != "Heading 2" || b.Text != "Intro" {
+		t.Errorf("heading = %+v", b)
+	}
+	p := first.Blocks[1]
+	if p.Alignment != "center" || p.Text != "see the site now" || len(p.Runs) != 3 {
+		t.Fatalf("paragraph = %+v", p)
+	}
+	if r := p.Runs[1]; !r.Italic || r.Link != "https://example.com/" {
+		t.Errorf("link run = %+v", r)
+	}
+	if r := p.Runs[2]; r.Color != "FF0000" || r.Font != "Arial" || !r.Underline || r.Link != "" {
+		t.Errorf("last run = %+v", r)
+	}
+
+	if len(second.Blocks) != 1 || second.Blocks[0].Type != "table" {
+		t.Fatalf("second section blocks = %+v", second.Blocks)
+	}
+	rows := second.Blocks[0].Rows
+	if len(rows) != 2 || len(rows[0].Cells) != 1 || rows[0].Cells[0].Span != 2 || rows[0].Cells[0].Blocks[0].Text != "wide" {
+		t.Errorf("first row = %+v", rows[0])
+	}
+	if len(rows[1].Cells) != 2 || rows[1].Cells[1].Blocks[0].Text != "x" || len(rows[1].Cells[1].Blocks) != 1 {
+		t.Errorf("second row = %+v", rows[1])
+	}
+
+	data, err := doc.MarshalStructure()
+	if err != nil {
+		t.Fatalf("MarshalStructure: %v", err)
+	}
+	var again Structure
+	if err := json.Unmarshal(data, &again); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(&again, s) {
+		t.Errorf("JSON round trip changed the structure")
+	}
+}
+
+func TestStructure_Errors(t *testing.T) {
+	for _, in := range []string{
+		`{"sections": [{"blocks": [{"type": "list"}]}]}`,
+		`{"sections": [{"blocks": [{"type": "paragraph", "alignment": "sideways"}]}]}`,
+		`{"sections": [{"blocks": [{"type": "table", "rows": []}]}]}`,
+		`{"sections": [{"blocks": [{"type": "paragraph", "runs": [{"text": "x", "color": "red"}]}]}]}`,
+		`{"sections": [`,
+	} {
+		if _, err := UnmarshalStructure([]byte(in)); err == nil {
+			t.Errorf("%s: no error", in)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package docx
+
+import (
+	"fmt"
+	"sort"
+)
+
+// defaultUIPriority is the sort priority Word gives a style that has none.
+const defaultUIPriority = 99
+
+// GalleryStyle is an entry of the style gallery, Word's Quick Styles.
+type GalleryStyle struct {
+	// Name is the UI name of the style.
+	Name string
+	// Priority orders the gallery, lowest first.
+	Priority int
+	// Defined reports whether the document defines the style. A style that
+	// is only latent is added to the document when first applied.
+	Defined bool
+}
+
+// Gallery returns the styles Word shows in its style gallery, in gallery
+// order: defined styles marked as quick styles and not hidden, and latent
+// styles that are quick styles by their exception or the latent defaults
+// and are not hidden. Ties in priority are ordered by name.
+func (s *Styles) Gallery() []GalleryStyle {
+	var gallery []GalleryStyle
+	defined := map[string]bool{}
+	for _, st := range s.Iter() {
+		name, err := st.Name()
+		if err != nil {
+			continue
+		}
+		defined[name] = true
+		if !st.QuickStyle() || st.Hidden() {
+			continue
+		}
+		gallery = append(gallery, GalleryStyle{Name: name, Priority: s.stylePriority(st, name), Defined: true})
+	}
+	if latent := s.element.LatentStyles(); latent != nil {
+		ls := &LatentStyles{element: latent}
+		for _, l := range ls.Iter() {
+			name := l.Name()
+			if defined[name] || !l.EffectiveQuickStyle() || l.EffectiveHidden() {
+				continue
+			}
+			gallery = append(gallery, GalleryStyle{Name: name, Priority: l.EffectivePriority()})
+		}
+	}
+	sort.SliceStable(gallery, func(i, j int) bool {
+		if gallery[i].Priority != gallery[j].Priority {
+			return gallery[i].Priority < gallery[j].Priority
+		}
+		return gallery[i].Name < gallery[j].Name
+	})
+	return gallery
+}
+
+// SetInGallery shows or hides the named style in the style gallery. It
+// updates the style's definition if the document has one and its latent
+// style exception, adding one if needed, so the choice also holds for a
+// built-in style added later. Showing a style unhides it.
+func (s *Styles) SetInGallery(name string, show bool) error {
+	if st := s.element.GetByName(UI2Internal(name)); st != nil {
+		style := styleFactory(st)
+		style.SetQuickStyle(show)
+		if show {
+			if err := style.SetHidden(false); err != nil {
+				return fmt.Errorf("docx: set style %q in gallery: %w", name, err)
+			}
+		}
+	}
+	l := s.LatentStyles().GetOrAdd(name)
+	if err := l.SetQuickStyle(&show); err != nil {
+		return fmt.Errorf("docx: set style %q in gallery: %w", name, err)
+	}
+	if show {
+		hidden := false
+		if err := l.SetHidden(&hidden); err != nil {
+			return fmt.Errorf("docx: set style %q in gallery: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// stylePriority returns the sort priority of defined style st, falling
+// back to its latent style and then to the default.
+func (s *Styles) stylePriority(st *BaseStyle, name string) int {
+	if v, err := st.Priority(); err == nil && v != nil {
+		return *v
+	}
+	if latent := s.element.LatentStyles(); latent != nil {
+		if exc := latent.GetByName(UI2Internal(name)); exc != nil {
+			return (&LatentStyle{element: exc}).EffectivePriority()
+		}
+		if v, err := latent.DefUIPriority(); err == nil && v != nil {
+			return *v
+		}
+	}
+	return defaultUIPriority
+}
@@ -0,0 +1,51 @@
+package docx
+
+import "testing"
+
+func TestStyles_Gallery(t *testing.T) {
+	d := mustNewDoc(t)
+	styles, err := d.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	inGallery := func(name string) bool {
+		for _, g := range styles.Gallery() {
+			if g.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+	gallery := styles.Gallery()
+	if len(gallery) == 0 {
+		t.Fatal("empty gallery")
+	}
+	for i := 1; i < len(gallery); i++ {
+		if gallery[i].Priority < gallery[i-1].Priority {
+			t.Fatalf("gallery out of order at %d: %+v", i, gallery[i])
+		}
+	}
+
+	if !inGallery("Heading 1") {
+		t.Fatal("Heading 1 not in gallery")
+	}
+	if err := styles.SetInGallery("Heading 1", false); err != nil {
+		t.Fatal(err)
+	}
+	if inGallery("Heading 1") {
+		t.Error("Heading 1 still in gallery")
+	}
+	if l, err := styles.LatentStyles().Get("Heading 1"); err != nil || l.EffectiveQuickStyle() {
+		t.Errorf("latent Heading 1 not updated: %v", err)
+	}
+
+	if inGallery("Bibliography") {
+		t.Fatal("Bibliography in gallery before being shown")
+	}
+	if err := styles.SetInGallery("Bibliography", true); err != nil {
+		t.Fatal(err)
+	}
+	if !inGallery("Bibliography") {
+		t.Error("Bibliography not added to gallery")
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/vortex/go-docx/pkg/docx/enum"
 	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
 )
 
 // --------------------------------------------------------------------------
@@ -70,7 +71,33 @@ func (s *Styles) Get(name string) (*BaseStyle, error) {
 	if st != nil {
 		return styleFactory(st), nil
 	}
-	return nil, fmt.Errorf("docx: no style with name %q", name)
+	return nil, fmt.Errorf("docx: getting style: %w", s.notFound(name))
+}
+
+// Exists reports whether Get finds a style for name, which is a UI name
+// or, failing that, a style ID.
+func (s *Styles) Exists(name string) bool {
+	return s.element.GetByName(UI2Internal(name)) != nil || s.element.GetByID(name) != nil
+}
+
+// List returns the UI names of all styles, in document order. A style
+// without a name is listed by its style ID.
+func (s *Styles) List() []string {
+	var names []string
+	for _, st := range s.element.StyleList() {
+		if nm, err := st.NameVal(); err == nil && nm != "" {
+			names = append(names, Internal2UI(nm))
+		} else if id := st.StyleId(); id != "" {
+			names = append(names, id)
+		}
+	}
+	return names
+}
+
+// notFound returns the error for a name no style has, suggesting the
+// styles it may be a misspelling of.
+func (s *Styles) notFound(name string) *StyleNotFoundError {
+	return &StyleNotFoundError{Name: name, Suggestions: s.element.SimilarStyleNames(name)}
 }
 
 // Iter returns all styles.
@@ -103,6 +130,18 @@ func (s *Styles) AddStyle(name string, styleType enum.WdStyleType, builtin bool)
 	return styleFactory(st), nil
 }
 
+// EnsureBuiltin returns the built-in style with the given UI name, such as
+// "Table Grid" or "Intense Quote", adding its definition from the catalog
+// of Word's built-in styles if the document lacks it. Styles it depends on
+// are added too. Applying a built-in style by name adds it the same way.
+func (s *Styles) EnsureBuiltin(name string) (*BaseStyle, error) {
+	st, err := parts.EnsureBuiltinStyle(s.element, name)
+	if err != nil {
+		return nil, fmt.Errorf("docx: %w", err)
+	}
+	return styleFactory(st), nil
+}
+
 // Default returns the default style for the given type, or nil.
 //
 // Mirrors Python Styles.default.
@@ -176,7 +215,14 @@ func (s *Styles) getStyleIDFromStyle(style *BaseStyle, styleType enum.WdStyleTyp
 		return nil, err
 	}
 	if st != styleType {
-		return nil, fmt.Errorf("docx: assigned style is type %v, need type %v", st, styleType)
+		linked := style.LinkedStyle()
+		if linked == nil {
+			return nil, fmt.Errorf("docx: assigned style is type %v, need type %v: %w", st, styleType, ErrWrongStyleType)
+		}
+		if lt, err := linked.Type(); err != nil || lt != styleType {
+			return nil, fmt.Errorf("docx: assigned style is type %v, need type %v: %w", st, styleType, ErrWrongStyleType)
+		}
+		style = linked
 	}
 	def, err := s.Default(styleType)
 	if err != nil {
@@ -346,6 +392,18 @@ func (s *BaseStyle) NextParagraphStyle() *BaseStyle {
 	return styleFactory(next)
 }
 
+// LinkedStyle returns the style this one is linked to: the character
+// style of a paragraph style, or the paragraph style of a character style.
+// It returns nil when the style has no link or the linked style is
+// missing.
+func (s *BaseStyle) LinkedStyle() *BaseStyle {
+	linked := s.element.LinkedStyle()
+	if linked == nil {
+		return nil
+	}
+	return styleFactory(linked)
+}
+
 // CT_Style returns the underlying oxml element.
 func (s *BaseStyle) CT_Style() *oxml.CT_Style { return s.element }
 
@@ -365,7 +423,7 @@ func (ls *LatentStyles) Get(name string) (*LatentStyle, error) {
 	internalName := UI2Internal(name)
 	exc := ls.element.GetByName(internalName)
 	if exc == nil {
-		return nil, fmt.Errorf("docx: no latent style with name %q", name)
+		return nil, fmt.Errorf("docx: no latent style with name %q: %w", name, ErrStyleNotFound)
 	}
 	return &LatentStyle{element: exc}, nil
 }
@@ -452,6 +510,15 @@ func (ls *LatentStyles) SetLoadCount(v *int) error {
 	return ls.element.SetCount(v)
 }
 
+// GetOrAdd returns the latent style with the given UI name, adding an
+// exception for it if there is none.
+func (ls *LatentStyles) GetOrAdd(name string) *LatentStyle {
+	if exc := ls.element.GetByName(UI2Internal(name)); exc != nil {
+		return &LatentStyle{element: exc}
+	}
+	return ls.AddLatentStyle(name)
+}
+
 // --------------------------------------------------------------------------
 // LatentStyle
 // --------------------------------------------------------------------------
@@ -523,3 +590,64 @@ func (ls *LatentStyle) UnhideWhenUsed() *bool {
 func (ls *LatentStyle) SetUnhideWhenUsed(v *bool) error {
 	return ls.element.SetOnOffProp("w:unhideWhenUsed", v)
 }
+
+// latentStyles returns the w:latentStyles element holding the defaults
+// this exception overrides, or nil if it is detached.
+func (ls *LatentStyle) latentStyles() *oxml.CT_LatentStyles {
+	parent := ls.element.RawElement().Parent()
+	if parent == nil {
+		return nil
+	}
+	return &oxml.CT_LatentStyles{Element: oxml.WrapElement(parent)}
+}
+
+// effective returns the on/off attribute attr of the exception, or the
+// default defAttr of the latent styles when the exception leaves it out.
+func (ls *LatentStyle) effective(attr, defAttr string) bool {
+	if v := ls.element.OnOffProp(attr); v != nil {
+		return *v
+	}
+	if defaults := ls.latentStyles(); defaults != nil {
+		return defaults.BoolProp(defAttr)
+	}
+	return false
+}
+
+// EffectiveHidden reports whether the style is hidden, taking the latent
+// style default when the exception does not say.
+func (ls *LatentStyle) EffectiveHidden() bool {
+	return ls.effective("w:semiHidden", "defSemiHidden")
+}
+
+// EffectiveLocked reports whether the style is locked, taking the latent
+// style default when the exception does not say.
+func (ls *LatentStyle) EffectiveLocked() bool {
+	return ls.effective("w:locked", "defLockedState")
+}
+
+// EffectiveQuickStyle reports whether the style appears in the style
+// gallery, taking the latent style default when the exception does not say.
+func (ls *LatentStyle) EffectiveQuickStyle() bool {
+	return ls.effective("w:qFormat", "defQFormat")
+}
+
+// EffectiveUnhideWhenUsed reports whether the style is unhidden once used,
+// taking the latent style default when the exception does not say.
+func (ls *LatentStyle) EffectiveUnhideWhenUsed() bool {
+	return ls.effective("w:unhideWhenUsed", "defUnhideWhenUsed")
+}
+
+// EffectivePriority returns the sort priority of the style, taking the
+// latent style default when the exception does not give one, and Word's
+// own default of 99 when neither does.
+func (ls *LatentStyle) EffectivePriority() int {
+	if v, err := ls.element.UiPriority(); err == nil && v != nil {
+		return *v
+	}
+	if defaults := ls.latentStyles(); defaults != nil {
+		if v, err := defaults.DefUIPriority(); err == nil && v != nil {
+			return *v
+		}
+	}
+	return defaultUIPriority
+}
@@ -1,6 +1,9 @@
 package docx
 
 import (
+	"errors"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/vortex/go-docx/pkg/docx/enum"
@@ -53,6 +56,103 @@ func TestStyles_Get(t *testing.T) {
 	}
 }
 
+func TestStyles_Exists_List(t *testing.T) {
+	ss := makeStylesFromDoc(t)
+	names := ss.List()
+	if len(names) != ss.Len() {
+		t.Errorf("List() has %d names, Len() = %d", len(names), ss.Len())
+	}
+	if !slices.Contains(names, "Normal") {
+		t.Errorf("List() = %q, missing Normal", names)
+	}
+	for _, name := range []string{"Normal", "Default Paragraph Font"} {
+		if !ss.Exists(name) {
+			t.Errorf("Exists(%q) = false", name)
+		}
+	}
+	if ss.Exists("Nonexistent Style XYZ") {
+		t.Error("Exists(Nonexistent) = true")
+	}
+}
+
+func TestStyles_NotFoundSuggestions(t *testing.T) {
+	doc := mustNewDoc(t)
+	ss, err := doc.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var nf *StyleNotFoundError
+	_, err = ss.Get("Normall")
+	if !errors.As(err, &nf) || nf.Name != "Normall" || len(nf.Suggestions) == 0 || nf.Suggestions[0] != "Normal" {
+		t.Fatalf("Get(Normall): err = %v, want suggestion Normal", err)
+	}
+	if !errors.Is(err, ErrStyleNotFound) || !strings.Contains(err.Error(), `did you mean "Normal"`) {
+		t.Errorf("err = %v", err)
+	}
+
+	_, err = doc.AddParagraph("x", StyleName("Normall"))
+	if !errors.As(err, &nf) || nf.Suggestions[0] != "Normal" {
+		t.Errorf("AddParagraph: err = %v, want suggestion Normal", err)
+	}
+	_, err = doc.AddTable(1, 1, StyleName("Tabel Grid"))
+	if !errors.As(err, &nf) || !slices.Contains(nf.Suggestions, "Table Grid") {
+		t.Errorf("AddTable: err = %v, want suggestion Table Grid", err)
+	}
+
+	_, err = ss.Get("Qwertyuiop")
+	if !errors.As(err, &nf) || len(nf.Suggestions) != 0 {
+		t.Errorf("Get(Qwertyuiop): err = %v, want no suggestions", err)
+	}
+}
+
+func TestSetStyle_LinkedAndWrongType(t *testing.T) {
+	doc := mustNewDoc(t)
+	para, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run, err := para.AddRun("title")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss, err := doc.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	heading, err := ss.Get("Heading 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	headingChar, err := ss.Get("Heading 1 Char")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range []StyleRef{StyleName("Heading 1"), heading} {
+		if err := run.SetStyle(ref); err != nil {
+			t.Fatalf("Run.SetStyle(%v): %v", ref, err)
+		}
+		if id, _ := run.r.Style(); id == nil || *id != "Heading1Char" {
+			t.Errorf("run style = %v, want Heading1Char", id)
+		}
+	}
+	if err := para.SetStyle(headingChar); err != nil {
+		t.Fatalf("Paragraph.SetStyle(Heading 1 Char): %v", err)
+	}
+	if id, _ := para.p.Style(); id == nil || *id != "Heading1" {
+		t.Errorf("paragraph style = %v, want Heading1", id)
+	}
+
+	if err := run.SetStyle(StyleName("List Paragraph")); !errors.Is(err, ErrWrongStyleType) {
+		t.Errorf("Run.SetStyle(List Paragraph): err = %v, want ErrWrongStyleType", err)
+	}
+	if err := para.SetStyle(StyleName("Table Grid")); !errors.Is(err, ErrWrongStyleType) {
+		t.Errorf("Paragraph.SetStyle(Table Grid): err = %v, want ErrWrongStyleType", err)
+	}
+	if err := run.SetStyle(StyleName("Heading 1 Chra")); !errors.Is(err, ErrStyleNotFound) {
+		t.Errorf("Run.SetStyle(typo): err = %v, want ErrStyleNotFound", err)
+	}
+}
+
 // Mirrors Python: Styles.iter / Styles.len
 func TestStyles_Iter_Len(t *testing.T) {
 	ss := makeStylesFromDoc(t)
@@ -290,3 +390,45 @@ func TestStyles_GetStyleID(t *testing.T) {
 		t.Errorf("GetStyleID(nil) = %v, want nil", *id)
 	}
 }
+
+func TestStyles_EnsureBuiltin(t *testing.T) {
+	d := mustNewDoc(t)
+	ss, err := d.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Intense Quote", "Intense Quote Char", "Table Grid"} {
+		st, err := ss.Get(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		st.Delete()
+	}
+
+	iq, err := ss.EnsureBuiltin("Intense Quote")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := iq.Name(); name != "Intense Quote" || !ss.Contains("Intense Quote Char") {
+		t.Errorf("Intense Quote not materialized with its linked style: %q", name)
+	}
+	again, err := ss.EnsureBuiltin("Intense Quote")
+	if err != nil || again.StyleID() != iq.StyleID() || ss.Len() != len(ss.Iter()) {
+		t.Error("EnsureBuiltin added a second copy")
+	}
+	if _, err := ss.EnsureBuiltin("No Such Style"); err == nil {
+		t.Error("unknown style accepted")
+	}
+
+	// Applying a missing built-in style by name adds it.
+	tbl, err := d.AddTable(1, 1, StyleName("Table Grid"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ss.Contains("Table Grid") {
+		t.Fatal("Table Grid not materialized")
+	}
+	if st, err := tbl.Style(); err != nil || st.StyleId() != "TableGrid" {
+		t.Errorf("table style = %v, %v", st, err)
+	}
+}
@@ -0,0 +1,73 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+func TestSymbolText(t *testing.T) {
+	for _, tt := range []struct{ font, char, want string }{
+		{"Wingdings", "F0FE", "☑"},
+		{"Wingdings", "F0A8", "☐"},
+		{"Wingdings", "00FD", "☒"},
+		{"Wingdings 2", "F052", "☑"},
+		{"Symbol", "F0B7", "•"},
+		{"Symbol", "F061", "α"},
+		{"symbol", "F033", "3"},
+		{"Wingdings", "F021", "�"},
+		{"Times New Roman", "00E9", "é"},
+		{"Arial", "F041", "A"},
+		{"Symbol", "zz", "�"},
+	} {
+		if got := oxml.SymbolText(tt.font, tt.char); got != tt.want {
+			t.Errorf("SymbolText(%q, %q) = %q, want %q", tt.font, tt.char, got, tt.want)
+		}
+	}
+}
+
+func TestRun_AddSymbol(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatalf("AddParagraph: %v", err)
+	}
+	run, err := p.AddRun("")
+	if err != nil {
+		t.Fatalf("AddRun: %v", err)
+	}
+	if err := run.AddSymbol("Wingdings", 0xFE); err != nil {
+		t.Fatalf("AddSymbol: %v", err)
+	}
+	run.AddText(" Agreed ")
+	if err := run.AddSymbol("Wingdings", 0xA8); err != nil {
+		t.Fatalf("AddSymbol: %v", err)
+	}
+	run.AddText(" Declined")
+	if got := run.r.RawElement().SelectElement("w:sym").SelectAttrValue("w:char", ""); got != "F0FE" {
+		t.Errorf("w:char = %q, want F0FE", got)
+	}
+	want := "☑ Agreed ☐ Declined"
+	if got := p.Text(); got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+	if err := run.AddSymbol("", 0x41); err == nil {
+		t.Error("expected error for missing font")
+	}
+
+	if n, err := doc.ReplaceText("Agreed", "Accepted"); err != nil || n != 1 {
+		t.Fatalf("ReplaceText = %d, %v", n, err)
+	}
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	text, err := ExtractTextFast(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ExtractTextFast: %v", err)
+	}
+	if want := "☑ Accepted ☐ Declined"; text != want {
+		t.Errorf("ExtractTextFast = %q, want %q", text, want)
+	}
+}
@@ -0,0 +1,74 @@
+package docx
+
+import (
+	"io"
+	"sync"
+)
+
+// SyncDocument shares a Document between goroutines. Reads run in
+// parallel under Read; changes run one at a time under Write, excluding
+// all readers.
+//
+// Paragraphs, runs, tables and other objects obtained inside a callback
+// are views of the shared document: use them only inside that callback
+// and do not keep them for later, as other goroutines may change the
+// content they refer to.
+//
+// Calls that create missing parts on first use, such as Comments or
+// CoreProperties on a document that has none, modify the document and
+// belong in Write.
+type SyncDocument struct {
+	mu  sync.RWMutex
+	doc *Document
+}
+
+// NewConcurrent creates a new document from the default template, ready
+// for use from several goroutines.
+func NewConcurrent() (*SyncDocument, error) {
+	d, err := New()
+	if err != nil {
+		return nil, err
+	}
+	return d.Synchronized()
+}
+
+// Synchronized returns a SyncDocument guarding d. From then on, d and the
+// objects derived from it must only be used through the SyncDocument.
+//
+// The parts that are otherwise created or cached on first access (body,
+// styles, settings and numbering) are resolved here, so that reads do not
+// modify the document.
+func (d *Document) Synchronized() (*SyncDocument, error) {
+	if _, err := d.getBody(); err != nil {
+		return nil, err
+	}
+	if _, err := d.part.StylesPart(); err != nil {
+		return nil, err
+	}
+	if _, err := d.part.SettingsPart(); err != nil {
+		return nil, err
+	}
+	_, _ = d.part.NumberingPart() // absent in many documents
+	return &SyncDocument{doc: d}, nil
+}
+
+// Read calls fn with the document while holding a read lock. fn must not
+// modify the document; several Read calls may run at the same time.
+func (s *SyncDocument) Read(fn func(d *Document) error) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return fn(s.doc)
+}
+
+// Write calls fn with the document while holding the write lock.
+func (s *SyncDocument) Write(fn func(d *Document) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.doc)
+}
+
+// Save writes the document to w. Saving prepares the parts for
+// serialization, so it takes the write lock.
+func (s *SyncDocument) Save(w io.Writer) error {
+	return s.Write(func(d *Document) error { return d.Save(w) })
+}
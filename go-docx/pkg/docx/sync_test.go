@@ -0,0 +1,94 @@
+package docx
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestSyncDocument_ConcurrentReadsAndWrites(t *testing.T) {
+	sd, err := NewConcurrent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const writers, readers = 4, 8
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := sd.Write(func(d *Document) error {
+				_, err := d.AddParagraph(fmt.Sprintf("paragraph %d", i))
+				return err
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	for i := 0; i < readers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := sd.Read(func(d *Document) error {
+				paras, err := d.Paragraphs()
+				if err != nil {
+					return err
+				}
+				for _, p := range paras {
+					_ = p.Text()
+				}
+				_, err = d.Styles()
+				return err
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var n int
+	_ = sd.Read(func(d *Document) error {
+		paras, err := d.Paragraphs()
+		n = len(paras)
+		return err
+	})
+	if n != writers {
+		t.Errorf("paragraphs = %d, want %d", n, writers)
+	}
+	var buf bytes.Buffer
+	if err := sd.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+}
+
+func TestSyncDocument_ConcurrentReads(t *testing.T) {
+	sd, err := NewConcurrent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := sd.Read(func(d *Document) error {
+				if _, err := d.Styles(); err != nil {
+					return err
+				}
+				if _, err := d.Settings(); err != nil {
+					return err
+				}
+				_ = d.EmbeddedObjects()
+				_ = d.ValidateSchema()
+				return nil
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
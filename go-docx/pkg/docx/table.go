@@ -1,6 +1,7 @@
 package docx
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -44,6 +45,11 @@ func (t *Table) AddColumn(widthTwips int) (*Column, error) {
 	return &Column{gridCol: gridCol, table: t}, nil
 }
 
+// AddColumnLength is AddColumn with the width given as a Length.
+func (t *Table) AddColumnLength(width Length) (*Column, error) {
+	return t.AddColumn(width.Twips())
+}
+
 // AddRow adds a new row at the bottom of the table.
 //
 // Mirrors Python Table.add_row.
@@ -99,7 +105,7 @@ func (t *Table) CellAt(rowIdx, colIdx int) (*Cell, error) {
 	}
 	idx := colIdx + (rowIdx * colCount)
 	if idx < 0 || idx >= len(cells) {
-		return nil, fmt.Errorf("docx: cell index (%d, %d) out of range", rowIdx, colIdx)
+		return nil, fmt.Errorf("docx: cell index (%d, %d) out of range: %w", rowIdx, colIdx, ErrInvalidIndex)
 	}
 	return cells[idx], nil
 }
@@ -143,7 +149,7 @@ func (t *Table) RowCells(rowIdx int) ([]*Cell, error) {
 	start := rowIdx * colCount
 	end := start + colCount
 	if start < 0 || end > len(cells) {
-		return nil, fmt.Errorf("docx: row index [%d] out of range", rowIdx)
+		return nil, fmt.Errorf("docx: row index [%d] out of range: %w", rowIdx, ErrInvalidIndex)
 	}
 	return cells[start:end], nil
 }
@@ -176,10 +182,18 @@ func (t *Table) setStyleRaw(raw any) error {
 	if err != nil {
 		return err
 	}
-	if styleID == nil {
-		return t.tbl.SetTblStyleVal("")
+	prev, err := t.tbl.TblStyleVal()
+	if err != nil {
+		return err
+	}
+	val := ""
+	if styleID != nil {
+		val = *styleID
 	}
-	return t.tbl.SetTblStyleVal(*styleID)
+	if err := t.tbl.SetTblStyleVal(val); err != nil {
+		return err
+	}
+	return styleApplied(t.part, t, styleID, func() error { return t.tbl.SetTblStyleVal(prev) })
 }
 
 // TableDirection returns the cell-ordering direction, or nil if inherited.
@@ -197,12 +211,23 @@ func (t *Table) SetTableDirection(v *bool) error {
 // is processed exactly once via IterTcs() (no duplicates from merged cells).
 // Returns the total number of replacements performed.
 func (t *Table) ReplaceText(old, new string) int {
+	count, _ := t.replaceText(context.Background(), old, new)
+	return count
+}
+
+// replaceText is ReplaceText, stopping with the context's error once ctx
+// is done.
+func (t *Table) replaceText(ctx context.Context, old, new string) (int, error) {
 	count := 0
 	for _, tc := range t.tbl.IterTcs() {
 		bic := newBlockItemContainer(tc.RawElement(), t.part)
-		count += bic.ReplaceText(old, new)
+		n, err := bic.replaceText(ctx, old, new)
+		count += n
+		if err != nil {
+			return count, err
+		}
 	}
-	return count
+	return count, nil
 }
 
 // CT_Tbl returns the underlying oxml element.
@@ -309,11 +334,12 @@ func (c *Cell) Merge(other *Cell) (*Cell, error) {
 }
 
 // Text returns the text content of this cell, paragraphs joined by newlines.
-func (c *Cell) Text() string {
+// Options set what tabs, breaks and drawings read as, see TextOption.
+func (c *Cell) Text(opts ...TextOption) string {
 	paras := c.Paragraphs()
 	texts := make([]string, len(paras))
 	for i, p := range paras {
-		texts[i] = p.Text()
+		texts[i] = p.Text(opts...)
 	}
 	return strings.Join(texts, "\n")
 }
@@ -343,6 +369,19 @@ func (c *Cell) SetVerticalAlignment(v *enum.WdCellVerticalAlignment) error {
 	return c.tc.SetVAlignVal(v)
 }
 
+// TextDirection returns the direction text flows in the cell, or nil if
+// inherited.
+func (c *Cell) TextDirection() (*enum.WdTextOrientation, error) {
+	return c.tc.TextDirectionVal()
+}
+
+// SetTextDirection sets the direction text flows in the cell, such as
+// WdTextOrientationUpward for a rotated column header. Passing nil removes
+// it.
+func (c *Cell) SetTextDirection(v *enum.WdTextOrientation) error {
+	return c.tc.SetTextDirectionVal(v)
+}
+
 // Width returns the cell width in twips, or nil if not set.
 func (c *Cell) Width() (*int, error) {
 	return c.tc.WidthTwips()
@@ -353,6 +392,16 @@ func (c *Cell) SetWidth(twips int) error {
 	return c.tc.SetWidthTwips(twips)
 }
 
+// WidthLength returns the cell width as a Length, or nil if not set.
+func (c *Cell) WidthLength() (*Length, error) {
+	return twipsLength(c.tc.WidthTwips())
+}
+
+// SetWidthLength sets the cell width.
+func (c *Cell) SetWidthLength(v Length) error {
+	return c.tc.SetWidthTwips(v.Twips())
+}
+
 // --------------------------------------------------------------------------
 // Row
 // --------------------------------------------------------------------------
@@ -463,6 +512,16 @@ func (r *Row) SetHeight(twips *int) error {
 	return r.tr.SetTrHeightVal(twips)
 }
 
+// HeightLength returns the row height as a Length, or nil if not set.
+func (r *Row) HeightLength() (*Length, error) {
+	return twipsLength(r.tr.TrHeightVal())
+}
+
+// SetHeightLength sets the row height. Passing nil removes it.
+func (r *Row) SetHeightLength(v *Length) error {
+	return r.tr.SetTrHeightVal(lengthTwips(v))
+}
+
 // HeightRule returns the height rule, or nil if not set.
 func (r *Row) HeightRule() (*enum.WdRowHeightRule, error) {
 	return r.tr.TrHeightHRule()
@@ -473,6 +532,28 @@ func (r *Row) SetHeightRule(v *enum.WdRowHeightRule) error {
 	return r.tr.SetTrHeightHRule(v)
 }
 
+// IsHeader returns true if the row repeats at the top of each page the
+// table spans.
+func (r *Row) IsHeader() bool {
+	return r.tr.TblHeaderVal()
+}
+
+// SetIsHeader sets whether the row repeats at the top of each page. Word
+// repeats only an unbroken run of header rows from the top of the table.
+func (r *Row) SetIsHeader(v bool) {
+	r.tr.SetTblHeaderVal(v)
+}
+
+// CantSplit returns true if the row is kept from breaking across pages.
+func (r *Row) CantSplit() bool {
+	return r.tr.CantSplitVal()
+}
+
+// SetCantSplit sets whether the row is kept from breaking across pages.
+func (r *Row) SetCantSplit(v bool) {
+	r.tr.SetCantSplitVal(v)
+}
+
 // Table returns the Table this row belongs to.
 func (r *Row) Table() *Table { return r.table }
 
@@ -498,6 +579,16 @@ func (c *Column) SetWidth(twips *int) error {
 	return c.gridCol.SetW(twips)
 }
 
+// WidthLength returns the column width as a Length, or nil if not set.
+func (c *Column) WidthLength() (*Length, error) {
+	return twipsLength(c.gridCol.W())
+}
+
+// SetWidthLength sets the column width. Passing nil removes it.
+func (c *Column) SetWidthLength(v *Length) error {
+	return c.gridCol.SetW(lengthTwips(v))
+}
+
 // Cells returns the cells in this column.
 func (c *Column) Cells() ([]*Cell, error) {
 	idx, err := c.index()
@@ -542,7 +633,7 @@ func (rs *Rows) Len() int { return len(rs.tbl.TrList()) }
 func (rs *Rows) Get(idx int) (*Row, error) {
 	lst := rs.tbl.TrList()
 	if idx < 0 || idx >= len(lst) {
-		return nil, fmt.Errorf("docx: row index [%d] out of range", idx)
+		return nil, fmt.Errorf("docx: row index [%d] out of range: %w", idx, ErrInvalidIndex)
 	}
 	return &Row{tr: lst[idx], table: rs.table}, nil
 }
@@ -579,7 +670,7 @@ func (cs *Columns) Len() int { return len(cs.grid.GridColList()) }
 func (cs *Columns) Get(idx int) (*Column, error) {
 	lst := cs.grid.GridColList()
 	if idx < 0 || idx >= len(lst) {
-		return nil, fmt.Errorf("docx: column index [%d] out of range", idx)
+		return nil, fmt.Errorf("docx: column index [%d] out of range: %w", idx, ErrInvalidIndex)
 	}
 	return &Column{gridCol: lst[idx], table: cs.table}, nil
 }
@@ -1,6 +1,8 @@
 package docx
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/vortex/go-docx/pkg/docx/enum"
@@ -56,6 +58,43 @@ func TestTable_AddColumn(t *testing.T) {
 	}
 }
 
+func TestTable_LengthVariants(t *testing.T) {
+	table := newTable(makeTbl(t, twoByTwoGrid()), nil)
+
+	col, err := table.AddColumnLength(Inches(1))
+	if err != nil {
+		t.Fatalf("AddColumnLength: %v", err)
+	}
+	if got, _ := col.Width(); got == nil || *got != 1440 {
+		t.Errorf("Column.Width() = %v, want 1440", got)
+	}
+	if err := col.SetWidthLength(Ptr(Inches(2))); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := col.WidthLength(); got == nil || *got != Inches(2) {
+		t.Errorf("Column.WidthLength() = %v, want %v", got, Inches(2))
+	}
+
+	cell, err := table.CellAt(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cell.SetWidthLength(Cm(3)); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := cell.Width(); got == nil || *got != Cm(3).Twips() {
+		t.Errorf("Cell.Width() = %v, want %d", got, Cm(3).Twips())
+	}
+
+	row := table.Rows().Iter()[0]
+	if err := row.SetHeight(Ptr(567)); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := row.HeightLength(); got == nil || *got != Twips(567) {
+		t.Errorf("Row.HeightLength() = %v, want %v", got, Twips(567))
+	}
+}
+
 // Mirrors Python: it_knows_its_alignment_setting (getter, 4 cases)
 func TestTable_Alignment_Getter(t *testing.T) {
 	wdTblAlignPtr := func(v enum.WdTableAlignment) *enum.WdTableAlignment { return &v }
@@ -477,3 +516,135 @@ func TestTcAbove_GridSpanPlusVMerge(t *testing.T) {
 		}
 	}
 }
+
+func TestRow_HeaderAndCantSplit(t *testing.T) {
+	d := mustNewDoc(t)
+	tbl, err := d.AddTable(3, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := tbl.Rows().Iter()
+	if rows[0].IsHeader() || rows[0].CantSplit() {
+		t.Fatal("new row has header or can't-split set")
+	}
+	rows[0].SetIsHeader(true)
+	rows[0].SetCantSplit(true)
+	rows[1].SetCantSplit(true)
+	h := Inches(0.5)
+	if err := rows[0].SetHeightLength(&h); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	d2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tables, err := d2.Tables()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows = tables[len(tables)-1].Rows().Iter()
+	if !rows[0].IsHeader() || !rows[0].CantSplit() || rows[1].IsHeader() || !rows[1].CantSplit() {
+		t.Error("row properties lost on round trip")
+	}
+	var tags []string
+	for _, el := range rows[0].tr.TrPr().RawElement().ChildElements() {
+		tags = append(tags, el.Tag)
+	}
+	if got := strings.Join(tags, " "); got != "cantSplit trHeight tblHeader" {
+		t.Errorf("trPr children = %q", got)
+	}
+
+	rows[0].SetIsHeader(false)
+	if rows[0].IsHeader() {
+		t.Error("header flag not cleared")
+	}
+	rows[1].tr.TrPr().RawElement().SelectElement("w:cantSplit").CreateAttr("w:val", "0")
+	if rows[1].CantSplit() {
+		t.Error(`w:val="0" read as on`)
+	}
+}
+
+func TestCell_TextDirection(t *testing.T) {
+	d := mustNewDoc(t)
+	tbl, err := d.AddTable(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cell := tbl.Rows().Iter()[0].Cells()[0]
+	if v, err := cell.TextDirection(); err != nil || v != nil {
+		t.Fatalf("TextDirection = %v, %v; want nil", v, err)
+	}
+	if err := cell.SetVerticalAlignment(Ptr(enum.WdCellVerticalAlignmentCenter)); err != nil {
+		t.Fatal(err)
+	}
+	if err := cell.SetTextDirection(Ptr(enum.WdTextOrientationUpward)); err != nil {
+		t.Fatal(err)
+	}
+	v, err := cell.TextDirection()
+	if err != nil || v == nil || *v != enum.WdTextOrientationUpward {
+		t.Errorf("TextDirection = %v, %v", v, err)
+	}
+	var tags []string
+	for _, el := range cell.tc.TcPr().RawElement().ChildElements() {
+		tags = append(tags, el.Tag)
+	}
+	if got := strings.Join(tags, " "); got != "tcW textDirection vAlign" {
+		t.Errorf("tcPr children = %q", got)
+	}
+	if err := cell.SetTextDirection(nil); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := cell.TextDirection(); v != nil {
+		t.Error("text direction not removed")
+	}
+}
+
+func TestCell_Shading(t *testing.T) {
+	d := mustNewDoc(t)
+	tbl, err := d.AddTable(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cell := tbl.Rows().Iter()[0].Cells()[0]
+	if s, err := cell.Shading(); err != nil || s != nil {
+		t.Fatalf("Shading = %v, %v; want nil", s, err)
+	}
+	if err := cell.SetVerticalAlignment(Ptr(enum.WdCellVerticalAlignmentCenter)); err != nil {
+		t.Fatal(err)
+	}
+	red, yellow := NewRGBColor(0xFF, 0, 0), NewRGBColor(0xFF, 0xFF, 0)
+	if err := cell.SetShading(&Shading{Pattern: enum.WdTextureDarkDiagonalDown, Color: &red, Fill: &yellow}); err != nil {
+		t.Fatal(err)
+	}
+	shd := cell.tc.TcPr().RawElement().SelectElement("w:shd")
+	if shd == nil || shd.SelectAttrValue("w:val", "") != "diagStripe" || shd.SelectAttrValue("w:fill", "") != "FFFF00" {
+		t.Fatalf("w:shd = %v", shd)
+	}
+	if shd.Index() > cell.tc.TcPr().RawElement().SelectElement("w:vAlign").Index() {
+		t.Error("w:shd after w:vAlign")
+	}
+	if err := cell.SetShading(&Shading{Pattern: enum.WdTexture10Percent}); err != nil {
+		t.Fatal(err)
+	}
+	s, err := cell.Shading()
+	if err != nil || s == nil || s.Pattern != enum.WdTexture10Percent || s.Color != nil || s.Fill != nil {
+		t.Errorf("Shading = %+v, %v", s, err)
+	}
+	if n := len(cell.tc.TcPr().RawElement().SelectElements("w:shd")); n != 1 {
+		t.Errorf("%d w:shd elements", n)
+	}
+	if err := cell.SetShading(&Shading{Pattern: enum.WdTextureIndex(7)}); err == nil {
+		t.Error("SetShading accepted an unknown pattern")
+	}
+	if err := cell.SetShading(nil); err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := cell.Shading(); s != nil {
+		t.Error("shading not removed")
+	}
+}
@@ -0,0 +1,238 @@
+package docx
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// tblPrSuccessors returns the w:tblPr children that follow tag in schema
+// order, for inserting tag in place.
+func tblPrSuccessors(tag string) []string {
+	order := []string{
+		"w:tblStyle", "w:tblpPr", "w:tblOverlap", "w:bidiVisual", "w:tblStyleRowBandSize",
+		"w:tblStyleColBandSize", "w:tblW", "w:jc", "w:tblCellSpacing", "w:tblInd",
+		"w:tblBorders", "w:shd", "w:tblLayout", "w:tblCellMar", "w:tblLook",
+		"w:tblCaption", "w:tblDescription", "w:tblPrChange",
+	}
+	for i, t := range order {
+		if t == tag {
+			return order[i+1:]
+		}
+	}
+	return nil
+}
+
+// tblPrChild returns the w:tblPr child tag of the table, adding it in
+// schema order if add is set. It returns nil if the child is absent and
+// add is not set.
+func (t *Table) tblPrChild(tag string, add bool) (*etree.Element, error) {
+	tblPr, err := t.tbl.TblPr()
+	if err != nil {
+		return nil, err
+	}
+	if el := tblPr.RawElement().SelectElement(tag); el != nil || !add {
+		return el, nil
+	}
+	el := oxml.OxmlElement(tag)
+	tblPr.InsertElementBefore(el, tblPrSuccessors(tag)...)
+	return el, nil
+}
+
+// removeTblPrChild removes the w:tblPr child tag of the table, if any.
+func (t *Table) removeTblPrChild(tag string) error {
+	tblPr, err := t.tbl.TblPr()
+	if err != nil {
+		return err
+	}
+	if el := tblPr.RawElement().SelectElement(tag); el != nil {
+		tblPr.RawElement().RemoveChild(el)
+	}
+	return nil
+}
+
+// Indent returns the distance of the table's leading edge from the margin,
+// or nil if inherited.
+func (t *Table) Indent() (*Length, error) {
+	ind, err := t.tblPrChild("w:tblInd", false)
+	if err != nil || ind == nil {
+		return nil, err
+	}
+	if typ := ind.SelectAttrValue("w:type", "dxa"); typ != "dxa" {
+		return nil, nil
+	}
+	v, err := strconv.Atoi(ind.SelectAttrValue("w:w", "0"))
+	if err != nil {
+		return nil, fmt.Errorf("docx: invalid table indent: %w", err)
+	}
+	l := Twips(float64(v))
+	return &l, nil
+}
+
+// SetIndent sets the distance of the table's leading edge from the margin;
+// negative values move it into the margin. Passing nil removes it.
+func (t *Table) SetIndent(v *Length) error {
+	if v == nil {
+		return t.removeTblPrChild("w:tblInd")
+	}
+	ind, err := t.tblPrChild("w:tblInd", true)
+	if err != nil {
+		return err
+	}
+	ind.CreateAttr("w:w", strconv.Itoa(v.Twips()))
+	ind.CreateAttr("w:type", "dxa")
+	return nil
+}
+
+// TableAnchor is what the position of a floating table is measured from.
+type TableAnchor int
+
+const (
+	// TableAnchorText measures from the paragraph or column the table is
+	// anchored in.
+	TableAnchorText TableAnchor = iota
+	// TableAnchorMargin measures from the page margins.
+	TableAnchorMargin
+	// TableAnchorPage measures from the page edges.
+	TableAnchorPage
+)
+
+// tableAnchorNames are the ST_HAnchor and ST_VAnchor values, indexed by
+// TableAnchor.
+var tableAnchorNames = [...]string{"text", "margin", "page"}
+
+// String returns the XML value of the anchor.
+func (a TableAnchor) String() string {
+	if a < 0 || int(a) >= len(tableAnchorNames) {
+		return fmt.Sprintf("TableAnchor(%d)", int(a))
+	}
+	return tableAnchorNames[a]
+}
+
+// parseTableAnchor returns the anchor named s, or def.
+func parseTableAnchor(s string, def TableAnchor) TableAnchor {
+	for i, name := range tableAnchorNames {
+		if name == s {
+			return TableAnchor(i)
+		}
+	}
+	return def
+}
+
+// TablePosition places a floating table, one that text wraps around, as
+// Word's Table Positioning dialog does.
+type TablePosition struct {
+	// HorizontalAnchor and VerticalAnchor are what X and Y are measured
+	// from.
+	HorizontalAnchor TableAnchor
+	VerticalAnchor   TableAnchor
+	// X and Y are the offsets of the table from its anchors.
+	X, Y Length
+	// XAlign, if set, aligns the table horizontally instead of X: "left",
+	// "center", "right", "inside" or "outside".
+	XAlign string
+	// YAlign, if set, aligns the table vertically instead of Y: "top",
+	// "center", "bottom", "inside", "outside" or "inline".
+	YAlign string
+	// LeftFromText, RightFromText, TopFromText and BottomFromText are the
+	// distances kept between the table and the text around it.
+	LeftFromText, RightFromText, TopFromText, BottomFromText Length
+	// NoOverlap keeps the table from overlapping other floating tables.
+	NoOverlap bool
+}
+
+// Position returns the position of a floating table, or nil if the table
+// is inline with the text.
+func (t *Table) Position() (*TablePosition, error) {
+	el, err := t.tblPrChild("w:tblpPr", false)
+	if err != nil || el == nil {
+		return nil, err
+	}
+	twips := func(name string) Length {
+		v, _ := strconv.Atoi(el.SelectAttrValue(name, "0"))
+		return Twips(float64(v))
+	}
+	pos := &TablePosition{
+		HorizontalAnchor: parseTableAnchor(el.SelectAttrValue("w:horzAnchor", ""), TableAnchorText),
+		VerticalAnchor:   parseTableAnchor(el.SelectAttrValue("w:vertAnchor", ""), TableAnchorMargin),
+		X:                twips("w:tblpX"),
+		Y:                twips("w:tblpY"),
+		XAlign:           el.SelectAttrValue("w:tblpXSpec", ""),
+		YAlign:           el.SelectAttrValue("w:tblpYSpec", ""),
+		LeftFromText:     twips("w:leftFromText"),
+		RightFromText:    twips("w:rightFromText"),
+		TopFromText:      twips("w:topFromText"),
+		BottomFromText:   twips("w:bottomFromText"),
+	}
+	if ov, err := t.tblPrChild("w:tblOverlap", false); err == nil && ov != nil {
+		pos.NoOverlap = ov.SelectAttrValue("w:val", "overlap") == "never"
+	}
+	return pos, nil
+}
+
+// SetPosition makes the table float at pos, with text wrapping around it.
+// Passing nil puts the table back inline with the text.
+func (t *Table) SetPosition(pos *TablePosition) error {
+	if pos == nil {
+		if err := t.removeTblPrChild("w:tblpPr"); err != nil {
+			return err
+		}
+		return t.removeTblPrChild("w:tblOverlap")
+	}
+	if !validAlign(pos.XAlign, "left", "center", "right", "inside", "outside") {
+		return fmt.Errorf("docx: invalid horizontal table alignment %q", pos.XAlign)
+	}
+	if !validAlign(pos.YAlign, "top", "center", "bottom", "inside", "outside", "inline") {
+		return fmt.Errorf("docx: invalid vertical table alignment %q", pos.YAlign)
+	}
+	el, err := t.tblPrChild("w:tblpPr", true)
+	if err != nil {
+		return err
+	}
+	el.Attr = nil
+	setTwips := func(name string, v Length) {
+		if v != 0 {
+			el.CreateAttr(name, strconv.Itoa(v.Twips()))
+		}
+	}
+	setTwips("w:leftFromText", pos.LeftFromText)
+	setTwips("w:rightFromText", pos.RightFromText)
+	setTwips("w:topFromText", pos.TopFromText)
+	setTwips("w:bottomFromText", pos.BottomFromText)
+	el.CreateAttr("w:vertAnchor", pos.VerticalAnchor.String())
+	el.CreateAttr("w:horzAnchor", pos.HorizontalAnchor.String())
+	if pos.XAlign != "" {
+		el.CreateAttr("w:tblpXSpec", pos.XAlign)
+	} else {
+		el.CreateAttr("w:tblpX", strconv.Itoa(pos.X.Twips()))
+	}
+	if pos.YAlign != "" {
+		el.CreateAttr("w:tblpYSpec", pos.YAlign)
+	} else {
+		el.CreateAttr("w:tblpY", strconv.Itoa(pos.Y.Twips()))
+	}
+	if !pos.NoOverlap {
+		return t.removeTblPrChild("w:tblOverlap")
+	}
+	ov, err := t.tblPrChild("w:tblOverlap", true)
+	if err != nil {
+		return err
+	}
+	ov.CreateAttr("w:val", "never")
+	return nil
+}
+
+// validAlign reports whether v is empty or one of values.
+func validAlign(v string, values ...string) bool {
+	if v == "" {
+		return true
+	}
+	for _, s := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
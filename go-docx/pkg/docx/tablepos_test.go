@@ -0,0 +1,105 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTable_Indent(t *testing.T) {
+	d := mustNewDoc(t)
+	tbl, err := d.AddTable(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ind, err := tbl.Indent(); err != nil || ind != nil {
+		t.Fatalf("Indent = %v, %v; want nil", ind, err)
+	}
+	if err := tbl.SetIndent(Ptr(Inches(0.5))); err != nil {
+		t.Fatal(err)
+	}
+	if ind, err := tbl.Indent(); err != nil || ind == nil || ind.Twips() != 720 {
+		t.Fatalf("Indent = %v, %v; want 720 twips", ind, err)
+	}
+	if err := tbl.SetIndent(nil); err != nil {
+		t.Fatal(err)
+	}
+	if ind, _ := tbl.Indent(); ind != nil {
+		t.Error("indent not removed")
+	}
+}
+
+func TestTable_Position(t *testing.T) {
+	d := mustNewDoc(t)
+	tbl, err := d.AddTable(2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos, err := tbl.Position(); err != nil || pos != nil {
+		t.Fatalf("new table floats: %v, %v", pos, err)
+	}
+	if err := tbl.SetIndent(Ptr(Inches(0.25))); err != nil {
+		t.Fatal(err)
+	}
+	want := TablePosition{
+		HorizontalAnchor: TableAnchorMargin,
+		VerticalAnchor:   TableAnchorPage,
+		XAlign:           "right",
+		Y:                Inches(2),
+		LeftFromText:     Pt(9),
+		BottomFromText:   Pt(9),
+		NoOverlap:        true,
+	}
+	if err := tbl.SetPosition(&want); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.SetPosition(&TablePosition{XAlign: "middle"}); err == nil {
+		t.Error("invalid alignment accepted")
+	}
+
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	d2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tables, err := d2.Tables()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tbl2 := tables[len(tables)-1]
+	got, err := tbl2.Position()
+	if err != nil || got == nil {
+		t.Fatalf("Position = %v, %v", got, err)
+	}
+	if *got != want {
+		t.Errorf("Position = %+v, want %+v", *got, want)
+	}
+
+	// Children of w:tblPr stay in schema order.
+	var tags []string
+	for _, el := range tbl2.tbl.RawElement().SelectElement("tblPr").ChildElements() {
+		tags = append(tags, el.Tag)
+	}
+	order := map[string]int{}
+	for i, tag := range tblPrSuccessors("w:tblStyle") {
+		order[tag[2:]] = i + 1
+	}
+	for i := 1; i < len(tags); i++ {
+		if order[tags[i-1]] > order[tags[i]] {
+			t.Errorf("tblPr children out of order: %v", tags)
+			break
+		}
+	}
+
+	if err := tbl2.SetPosition(nil); err != nil {
+		t.Fatal(err)
+	}
+	if pos, _ := tbl2.Position(); pos != nil {
+		t.Error("table still floats")
+	}
+	if ov, _ := tbl2.tblPrChild("w:tblOverlap", false); ov != nil {
+		t.Error("tblOverlap left behind")
+	}
+}
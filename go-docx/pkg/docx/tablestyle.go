@@ -0,0 +1,364 @@
+package docx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// --------------------------------------------------------------------------
+// Table style options (w:tblLook)
+// --------------------------------------------------------------------------
+
+// TableStyleOptions chooses which conditional formats of its style a table
+// shows, as the Table Style Options group of Word's ribbon does.
+type TableStyleOptions struct {
+	tbl *oxml.CT_Tbl
+}
+
+// StyleOptions returns the style options of the table.
+func (t *Table) StyleOptions() *TableStyleOptions {
+	return &TableStyleOptions{tbl: t.tbl}
+}
+
+// tblLook bits of the legacy w:val bitmask, which Word still writes
+// alongside the attributes and older readers use alone.
+const (
+	lookFirstRow    = 0x0020
+	lookLastRow     = 0x0040
+	lookFirstColumn = 0x0080
+	lookLastColumn  = 0x0100
+	lookNoHBand     = 0x0200
+	lookNoVBand     = 0x0400
+)
+
+// FirstRow reports whether the header row format applies.
+func (o *TableStyleOptions) FirstRow() bool { return o.get("firstRow", lookFirstRow) }
+
+// SetFirstRow sets whether the header row format applies.
+func (o *TableStyleOptions) SetFirstRow(v bool) error { return o.set("firstRow", lookFirstRow, v) }
+
+// LastRow reports whether the total row format applies.
+func (o *TableStyleOptions) LastRow() bool { return o.get("lastRow", lookLastRow) }
+
+// SetLastRow sets whether the total row format applies.
+func (o *TableStyleOptions) SetLastRow(v bool) error { return o.set("lastRow", lookLastRow, v) }
+
+// FirstColumn reports whether the first column format applies.
+func (o *TableStyleOptions) FirstColumn() bool { return o.get("firstColumn", lookFirstColumn) }
+
+// SetFirstColumn sets whether the first column format applies.
+func (o *TableStyleOptions) SetFirstColumn(v bool) error {
+	return o.set("firstColumn", lookFirstColumn, v)
+}
+
+// LastColumn reports whether the last column format applies.
+func (o *TableStyleOptions) LastColumn() bool { return o.get("lastColumn", lookLastColumn) }
+
+// SetLastColumn sets whether the last column format applies.
+func (o *TableStyleOptions) SetLastColumn(v bool) error {
+	return o.set("lastColumn", lookLastColumn, v)
+}
+
+// BandedRows reports whether rows alternate between the band formats.
+func (o *TableStyleOptions) BandedRows() bool { return !o.get("noHBand", lookNoHBand) }
+
+// SetBandedRows sets whether rows alternate between the band formats.
+func (o *TableStyleOptions) SetBandedRows(v bool) error { return o.set("noHBand", lookNoHBand, !v) }
+
+// BandedColumns reports whether columns alternate between the band formats.
+func (o *TableStyleOptions) BandedColumns() bool { return !o.get("noVBand", lookNoVBand) }
+
+// SetBandedColumns sets whether columns alternate between the band formats.
+func (o *TableStyleOptions) SetBandedColumns(v bool) error {
+	return o.set("noVBand", lookNoVBand, !v)
+}
+
+// look returns the w:tblLook element, or nil.
+func (o *TableStyleOptions) look() *etree.Element {
+	tblPr, err := o.tbl.TblPr()
+	if err != nil || tblPr == nil {
+		return nil
+	}
+	return tblPr.RawElement().SelectElement("w:tblLook")
+}
+
+// get reads option attr, falling back to bit of the w:val bitmask. Without
+// a w:tblLook Word shows the header row, first column and row bands.
+func (o *TableStyleOptions) get(attr string, bit int) bool {
+	look := o.look()
+	if look == nil {
+		return bit == lookFirstRow || bit == lookFirstColumn || bit == lookNoVBand
+	}
+	if v := look.SelectAttr("w:" + attr); v != nil {
+		return isOn(v.Value)
+	}
+	return lookBits(look)&bit != 0
+}
+
+// set writes option attr and the matching bit of the w:val bitmask.
+func (o *TableStyleOptions) set(attr string, bit int, v bool) error {
+	tblPr, err := o.tbl.TblPr()
+	if err != nil {
+		return fmt.Errorf("docx: table style options: %w", err)
+	}
+	look := tblPr.RawElement().SelectElement("w:tblLook")
+	if look == nil {
+		look = oxml.OxmlElement("w:tblLook")
+		// Spell out the defaults Word assumes, so that writing one option
+		// leaves the others as they were shown.
+		look.CreateAttr("w:firstRow", "1")
+		look.CreateAttr("w:lastRow", "0")
+		look.CreateAttr("w:firstColumn", "1")
+		look.CreateAttr("w:lastColumn", "0")
+		look.CreateAttr("w:noHBand", "0")
+		look.CreateAttr("w:noVBand", "1")
+		look.CreateAttr("w:val", "04A0")
+		tblPr.InsertElementBefore(look, tblPrSuccessors("w:tblLook")...)
+	}
+	bits := lookBits(look)
+	if v {
+		look.CreateAttr("w:"+attr, "1")
+		bits |= bit
+	} else {
+		look.CreateAttr("w:"+attr, "0")
+		bits &^= bit
+	}
+	look.CreateAttr("w:val", fmt.Sprintf("%04X", bits))
+	return nil
+}
+
+// lookBits returns the w:val bitmask of look, 0 when absent or invalid.
+func lookBits(look *etree.Element) int {
+	v, err := strconv.ParseInt(look.SelectAttrValue("w:val", "0"), 16, 32)
+	if err != nil {
+		return 0
+	}
+	return int(v)
+}
+
+// --------------------------------------------------------------------------
+// Conditional formats (w:tblStylePr)
+// --------------------------------------------------------------------------
+
+// TableConditionType identifies the part of a table a conditional format
+// of a table style applies to.
+type TableConditionType int
+
+const (
+	// TableConditionWholeTable applies to the whole table.
+	TableConditionWholeTable TableConditionType = iota
+	// TableConditionFirstRow applies to the header row.
+	TableConditionFirstRow
+	// TableConditionLastRow applies to the total row.
+	TableConditionLastRow
+	// TableConditionFirstColumn applies to the first column.
+	TableConditionFirstColumn
+	// TableConditionLastColumn applies to the last column.
+	TableConditionLastColumn
+	// TableConditionBand1Vertical applies to odd column bands.
+	TableConditionBand1Vertical
+	// TableConditionBand2Vertical applies to even column bands.
+	TableConditionBand2Vertical
+	// TableConditionBand1Horizontal applies to odd row bands.
+	TableConditionBand1Horizontal
+	// TableConditionBand2Horizontal applies to even row bands.
+	TableConditionBand2Horizontal
+	// TableConditionTopRightCell applies to the top right cell.
+	TableConditionTopRightCell
+	// TableConditionTopLeftCell applies to the top left cell.
+	TableConditionTopLeftCell
+	// TableConditionBottomRightCell applies to the bottom right cell.
+	TableConditionBottomRightCell
+	// TableConditionBottomLeftCell applies to the bottom left cell.
+	TableConditionBottomLeftCell
+)
+
+// tableConditionNames are the w:type values of w:tblStylePr, indexed by
+// TableConditionType.
+var tableConditionNames = [...]string{
+	"wholeTable", "firstRow", "lastRow", "firstCol", "lastCol",
+	"band1Vert", "band2Vert", "band1Horz", "band2Horz",
+	"neCell", "nwCell", "seCell", "swCell",
+}
+
+// String returns the w:type value of the condition.
+func (c TableConditionType) String() string {
+	if c < 0 || int(c) >= len(tableConditionNames) {
+		return fmt.Sprintf("TableConditionType(%d)", int(c))
+	}
+	return tableConditionNames[c]
+}
+
+// TableConditionalFormat is the formatting a table style gives one part of
+// a table, a w:tblStylePr element.
+type TableConditionalFormat struct {
+	el *etree.Element
+}
+
+// Type returns the part of the table the format applies to.
+func (f *TableConditionalFormat) Type() TableConditionType {
+	typ := f.el.SelectAttrValue("w:type", "")
+	for i, name := range tableConditionNames {
+		if name == typ {
+			return TableConditionType(i)
+		}
+	}
+	return TableConditionWholeTable
+}
+
+// Font returns the character formatting of the format.
+func (f *TableConditionalFormat) Font() *Font {
+	return &Font{rPrOwner: f}
+}
+
+// ParagraphFormat returns the paragraph formatting of the format.
+func (f *TableConditionalFormat) ParagraphFormat() *ParagraphFormat {
+	return &ParagraphFormat{provider: f}
+}
+
+// Shading returns the cell fill color, or nil if none.
+func (f *TableConditionalFormat) Shading() (*RGBColor, error) {
+	shd := f.el.FindElement("w:tcPr/w:shd")
+	if shd == nil {
+		return nil, nil
+	}
+	fill := shd.SelectAttrValue("w:fill", "")
+	if fill == "" || strings.EqualFold(fill, "auto") {
+		return nil, nil
+	}
+	c, err := RGBColorFromString(fill)
+	if err != nil {
+		return nil, fmt.Errorf("docx: table style shading: %w", err)
+	}
+	return &c, nil
+}
+
+// SetShading fills the cells with color. Passing nil removes the fill.
+func (f *TableConditionalFormat) SetShading(color *RGBColor) {
+	if color == nil {
+		if tcPr := f.el.SelectElement("w:tcPr"); tcPr != nil {
+			if shd := tcPr.SelectElement("w:shd"); shd != nil {
+				tcPr.RemoveChild(shd)
+			}
+		}
+		return
+	}
+	tcPr := f.child("w:tcPr")
+	shd := tcPr.SelectElement("w:shd")
+	if shd == nil {
+		shd = oxml.OxmlElement("w:shd")
+		tcPr.AddChild(shd)
+	}
+	shd.CreateAttr("w:val", "clear")
+	shd.CreateAttr("w:color", "auto")
+	shd.CreateAttr("w:fill", color.String())
+}
+
+// RPr implements rPrProvider.
+func (f *TableConditionalFormat) RPr() *oxml.CT_RPr {
+	el := f.el.SelectElement("w:rPr")
+	if el == nil {
+		return nil
+	}
+	return &oxml.CT_RPr{Element: oxml.WrapElement(el)}
+}
+
+// GetOrAddRPr implements rPrProvider.
+func (f *TableConditionalFormat) GetOrAddRPr() *oxml.CT_RPr {
+	return &oxml.CT_RPr{Element: oxml.WrapElement(f.child("w:rPr"))}
+}
+
+// PPr implements pPrProvider.
+func (f *TableConditionalFormat) PPr() *oxml.CT_PPr {
+	el := f.el.SelectElement("w:pPr")
+	if el == nil {
+		return nil
+	}
+	return &oxml.CT_PPr{Element: oxml.WrapElement(el)}
+}
+
+// GetOrAddPPr implements pPrProvider.
+func (f *TableConditionalFormat) GetOrAddPPr() *oxml.CT_PPr {
+	return &oxml.CT_PPr{Element: oxml.WrapElement(f.child("w:pPr"))}
+}
+
+// tblStylePrOrder is the schema order of the children of w:tblStylePr.
+var tblStylePrOrder = []string{"w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr"}
+
+// child returns the child tag of the format, adding it in schema order.
+func (f *TableConditionalFormat) child(tag string) *etree.Element {
+	if el := f.el.SelectElement(tag); el != nil {
+		return el
+	}
+	el := oxml.OxmlElement(tag)
+	wrapped := oxml.WrapElement(f.el)
+	for i, t := range tblStylePrOrder {
+		if t == tag {
+			wrapped.InsertElementBefore(el, tblStylePrOrder[i+1:]...)
+			return el
+		}
+	}
+	f.el.AddChild(el)
+	return el
+}
+
+// ConditionalFormats returns the conditional formats of a table style, in
+// document order.
+func (s *BaseStyle) ConditionalFormats() []*TableConditionalFormat {
+	var formats []*TableConditionalFormat
+	for _, el := range s.element.RawElement().SelectElements("w:tblStylePr") {
+		formats = append(formats, &TableConditionalFormat{el: el})
+	}
+	return formats
+}
+
+// ConditionalFormat returns the conditional format of the table style for
+// typ, adding an empty one if it has none. It fails for styles of other
+// types.
+func (s *BaseStyle) ConditionalFormat(typ TableConditionType) (*TableConditionalFormat, error) {
+	st, err := s.Type()
+	if err != nil {
+		return nil, err
+	}
+	if st != enum.WdStyleTypeTable {
+		return nil, fmt.Errorf("docx: conditional formats need a table style")
+	}
+	if typ < 0 || int(typ) >= len(tableConditionNames) {
+		return nil, fmt.Errorf("docx: invalid table condition %d", int(typ))
+	}
+	style := s.element.RawElement()
+	var next *etree.Element
+	for _, el := range style.SelectElements("w:tblStylePr") {
+		f := &TableConditionalFormat{el: el}
+		if el.SelectAttrValue("w:type", "") == typ.String() {
+			return f, nil
+		}
+		if next == nil && f.Type() > typ {
+			next = el
+		}
+	}
+	el := oxml.OxmlElement("w:tblStylePr")
+	el.CreateAttr("w:type", typ.String())
+	if next != nil {
+		style.InsertChildAt(next.Index(), el)
+	} else {
+		style.AddChild(el)
+	}
+	return &TableConditionalFormat{el: el}, nil
+}
+
+// RemoveConditionalFormat removes the conditional format of the style for
+// typ, if any.
+func (s *BaseStyle) RemoveConditionalFormat(typ TableConditionType) {
+	style := s.element.RawElement()
+	for _, el := range style.SelectElements("w:tblStylePr") {
+		if el.SelectAttrValue("w:type", "") == typ.String() {
+			style.RemoveChild(el)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+)
+
+func TestTable_StyleOptions(t *testing.T) {
+	d := mustNewDoc(t)
+	tbl, err := d.AddTable(2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := tbl.StyleOptions()
+	if !o.FirstRow() || o.LastRow() || !o.FirstColumn() || o.LastColumn() || !o.BandedRows() || o.BandedColumns() {
+		t.Fatal("unexpected defaults")
+	}
+	if err := o.SetFirstRow(false); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.SetLastRow(true); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.SetBandedRows(false); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.SetBandedColumns(true); err != nil {
+		t.Fatal(err)
+	}
+	if o.FirstRow() || !o.LastRow() || o.BandedRows() || !o.BandedColumns() {
+		t.Error("options not updated")
+	}
+	look := o.look()
+	if got := look.SelectAttrValue("w:val", ""); got != "02C0" {
+		t.Errorf("w:val = %q, want 02C0", got)
+	}
+
+	// A look with only the bitmask, as older producers write it.
+	look.RemoveAttr("w:firstRow")
+	look.RemoveAttr("w:lastRow")
+	look.CreateAttr("w:val", "0020")
+	if !o.FirstRow() || o.LastRow() {
+		t.Error("bitmask not honored")
+	}
+}
+
+func TestBaseStyle_ConditionalFormat(t *testing.T) {
+	d := mustNewDoc(t)
+	styles, err := d.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	style, err := styles.AddStyle("Report Table", enum.WdStyleTypeTable, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	band, err := style.ConditionalFormat(TableConditionBand1Horizontal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	band.SetShading(Ptr(NewRGBColor(0xF2, 0xF2, 0xF2)))
+	if err := band.SetShadingPattern(&Shading{Pattern: enum.WdTexture5Percent, Fill: Ptr(NewRGBColor(0xF2, 0xF2, 0xF2))}); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := band.ShadingPattern(); err != nil || s.Pattern != enum.WdTexture5Percent || s.Color != nil || *s.Fill != NewRGBColor(0xF2, 0xF2, 0xF2) {
+		t.Errorf("ShadingPattern = %+v, %v", s, err)
+	}
+	header, err := style.ConditionalFormat(TableConditionFirstRow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bold := true
+	if err := header.Font().SetBold(&bold); err != nil {
+		t.Fatal(err)
+	}
+	header.SetShading(Ptr(NewRGBColor(0x1F, 0x38, 0x64)))
+	if again, _ := style.ConditionalFormat(TableConditionFirstRow); again.el != header.el {
+		t.Error("ConditionalFormat added a duplicate")
+	}
+
+	formats := style.ConditionalFormats()
+	if len(formats) != 2 || formats[0].Type() != TableConditionFirstRow || formats[1].Type() != TableConditionBand1Horizontal {
+		t.Fatalf("formats not in schema order: %d", len(formats))
+	}
+	tcPr := header.el.SelectElement("w:tcPr")
+	if rPr := header.el.SelectElement("w:rPr"); rPr == nil || tcPr == nil || rPr.Index() > tcPr.Index() {
+		t.Error("tblStylePr children out of order")
+	}
+
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	d2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	styles2, _ := d2.Styles()
+	style2, err := styles2.Get("Report Table")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, _ := style2.ConditionalFormat(TableConditionFirstRow)
+	if b := h.Font().Bold(); b == nil || !*b {
+		t.Error("header bold lost on round trip")
+	}
+	if c, err := h.Shading(); err != nil || c == nil || c.String() != "1F3864" {
+		t.Errorf("header shading = %v, %v", c, err)
+	}
+
+	band2, _ := style2.ConditionalFormat(TableConditionBand1Horizontal)
+	band2.SetShading(nil)
+	if c, _ := band2.Shading(); c != nil {
+		t.Error("shading not removed")
+	}
+	style2.RemoveConditionalFormat(TableConditionFirstRow)
+	if len(style2.ConditionalFormats()) != 1 {
+		t.Error("format not removed")
+	}
+	para, _ := styles2.Get("Normal")
+	if _, err := para.ConditionalFormat(TableConditionFirstRow); err == nil {
+		t.Error("paragraph style accepted a conditional format")
+	}
+}
@@ -0,0 +1,388 @@
+package docx
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// pctUnit is the number of w:w units in one percent of a pct width, which
+// WordprocessingML counts in fiftieths of a percent.
+const pctUnit = 50
+
+// readPct returns the percentage of a pct-type width element, written as
+// fiftieths of a percent or, in Strict documents, as "50%". It returns
+// nil for other width types.
+func readPct(w *etree.Element) (*float64, error) {
+	if w == nil || w.SelectAttrValue("w:type", "") != "pct" {
+		return nil, nil
+	}
+	s := w.SelectAttrValue("w:w", "0")
+	var v float64
+	var err error
+	if strings.HasSuffix(s, "%") {
+		v, err = strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+	} else {
+		v, err = strconv.ParseFloat(s, 64)
+		v /= pctUnit
+	}
+	if err != nil {
+		return nil, fmt.Errorf("docx: invalid percentage width %q", s)
+	}
+	return &v, nil
+}
+
+// writePct sets width element w to pct percent.
+func writePct(w *etree.Element, pct float64) error {
+	if pct <= 0 || pct > 100 || math.IsNaN(pct) {
+		return fmt.Errorf("docx: width percentage %v out of range", pct)
+	}
+	w.CreateAttr("w:w", strconv.Itoa(int(math.Round(pct*pctUnit))))
+	w.CreateAttr("w:type", "pct")
+	return nil
+}
+
+// Width returns the preferred width of the table, or nil if it is
+// automatic or a percentage.
+func (t *Table) Width() (*Length, error) {
+	w, err := t.tblPrChild("w:tblW", false)
+	if err != nil || w == nil {
+		return nil, err
+	}
+	return twipsLength((&oxml.CT_TblWidth{Element: oxml.WrapElement(w)}).WidthTwips())
+}
+
+// SetWidth sets the preferred width of the table. Passing nil makes it
+// automatic.
+func (t *Table) SetWidth(v *Length) error {
+	w, err := t.tblPrChild("w:tblW", true)
+	if err != nil {
+		return err
+	}
+	tw := &oxml.CT_TblWidth{Element: oxml.WrapElement(w)}
+	if v == nil {
+		w.CreateAttr("w:w", "0")
+		return tw.SetType("auto")
+	}
+	return tw.SetWidthDxa(v.Twips())
+}
+
+// WidthPercent returns the preferred width of the table as a percentage
+// of the text width, or nil if it is not given as one.
+func (t *Table) WidthPercent() (*float64, error) {
+	w, err := t.tblPrChild("w:tblW", false)
+	if err != nil {
+		return nil, err
+	}
+	return readPct(w)
+}
+
+// SetWidthPercent sets the preferred width of the table to pct percent of
+// the text width, so it follows the page or column it is in.
+func (t *Table) SetWidthPercent(pct float64) error {
+	w, err := t.tblPrChild("w:tblW", true)
+	if err != nil {
+		return err
+	}
+	return writePct(w, pct)
+}
+
+// WidthPercent returns the preferred width of the cell as a percentage of
+// the table width, or nil if it is not given as one.
+func (c *Cell) WidthPercent() (*float64, error) {
+	tcPr := c.tc.TcPr()
+	if tcPr == nil || tcPr.TcW() == nil {
+		return nil, nil
+	}
+	return readPct(tcPr.TcW().RawElement())
+}
+
+// SetWidthPercent sets the preferred width of the cell to pct percent of
+// the table width.
+func (c *Cell) SetWidthPercent(pct float64) error {
+	return writePct(c.tc.GetOrAddTcPr().GetOrAddTcW().RawElement(), pct)
+}
+
+// SetFixedColumnWidths gives the columns of the table the given widths and
+// fixes the layout, so Word keeps them whatever the content. Cells that
+// span columns get the sum of their widths.
+func (t *Table) SetFixedColumnWidths(widths ...Length) error {
+	twips := make([]int, len(widths))
+	total := 0
+	for i, w := range widths {
+		if w <= 0 {
+			return fmt.Errorf("docx: column width %d must be positive", i)
+		}
+		twips[i] = w.Twips()
+		total += twips[i]
+	}
+	if err := t.applyColumnWidths(twips); err != nil {
+		return err
+	}
+	width := Twips(float64(total))
+	if err := t.SetWidth(&width); err != nil {
+		return err
+	}
+	return t.SetAutofit(false)
+}
+
+// AutoFitContents sizes the columns of the table to their content, as
+// Word's AutoFit to Contents does: each column gets the width of its
+// longest line when everything fits the text width, and otherwise room in
+// proportion to what it lacks, but never less than its longest word. A
+// percentage table width is kept and caps the columns; any other table
+// width becomes automatic. Text is measured as in EstimateLayout; opts may
+// be nil.
+func (t *Table) AutoFitContents(opts *LayoutOptions) error {
+	if opts == nil {
+		opts = &LayoutOptions{}
+	}
+	dp, err := t.part.DocumentPart()
+	if err != nil {
+		return fmt.Errorf("docx: autofit: %w", err)
+	}
+	styles, err := dp.Styles()
+	if err != nil {
+		return fmt.Errorf("docx: autofit: %w", err)
+	}
+	major, minor := themeFonts(dp)
+	lo := &layouter{res: newFormatResolver(styles.RawElement(), major, minor), fonts: opts.Fonts}
+
+	avail, err := t.availableWidth()
+	if err != nil {
+		return err
+	}
+	cols, err := t.tbl.ColCount()
+	if err != nil {
+		return fmt.Errorf("docx: autofit: %w", err)
+	}
+	minW := make([]float64, cols)
+	maxW := make([]float64, cols)
+	type spanned struct {
+		start, span int
+		min, max    float64
+	}
+	var spans []spanned
+	for _, tr := range t.tbl.TrList() {
+		col, _ := tr.GridBeforeVal()
+		for _, tc := range tr.TcList() {
+			span, _ := tc.GridSpanVal()
+			if span < 1 {
+				span = 1
+			}
+			cmin, cmax := lo.contentWidths(tc.RawElement())
+			cmin += 2 * cellMargin
+			cmax += 2 * cellMargin
+			switch {
+			case col+span > cols:
+			case span == 1:
+				minW[col] = math.Max(minW[col], cmin)
+				maxW[col] = math.Max(maxW[col], cmax)
+			default:
+				spans = append(spans, spanned{col, span, cmin, cmax})
+			}
+			col += span
+		}
+	}
+	// Spanning cells widen the columns they cover only by what those
+	// columns lack.
+	for _, s := range spans {
+		widen(minW[s.start:s.start+s.span], s.min)
+		widen(maxW[s.start:s.start+s.span], s.max)
+	}
+	for i := range maxW {
+		minW[i] = math.Max(minW[i], 2*cellMargin+60)
+		maxW[i] = math.Max(maxW[i], minW[i])
+	}
+
+	sumMin, sumMax := sum(minW), sum(maxW)
+	widths := make([]int, cols)
+	for i := range widths {
+		var w float64
+		switch {
+		case sumMax <= avail:
+			w = maxW[i]
+		case sumMin >= avail:
+			w = minW[i]
+		default:
+			w = minW[i] + (maxW[i]-minW[i])*(avail-sumMin)/(sumMax-sumMin)
+		}
+		widths[i] = int(math.Round(w))
+	}
+	if err := t.applyColumnWidths(widths); err != nil {
+		return err
+	}
+	if pct, err := t.WidthPercent(); err != nil || pct == nil {
+		if err := t.SetWidth(nil); err != nil {
+			return err
+		}
+	}
+	return t.SetAutofit(true)
+}
+
+// applyColumnWidths writes twips as the grid column widths and the widths
+// of the cells, summing them for cells that span columns.
+func (t *Table) applyColumnWidths(twips []int) error {
+	grid, err := t.tbl.TblGrid()
+	if err != nil {
+		return fmt.Errorf("docx: column widths: %w", err)
+	}
+	gridEl := grid.RawElement()
+	for _, gc := range gridEl.SelectElements("w:gridCol") {
+		gridEl.RemoveChild(gc)
+	}
+	for _, w := range twips {
+		gc := oxml.OxmlElement("w:gridCol")
+		gc.CreateAttr("w:w", strconv.Itoa(w))
+		gridEl.AddChild(gc)
+	}
+	for _, tr := range t.tbl.TrList() {
+		col, _ := tr.GridBeforeVal()
+		for _, tc := range tr.TcList() {
+			span, _ := tc.GridSpanVal()
+			if span < 1 {
+				span = 1
+			}
+			w := 0
+			for i := col; i < col+span && i < len(twips); i++ {
+				w += twips[i]
+			}
+			if err := tc.SetWidthTwips(w); err != nil {
+				return err
+			}
+			col += span
+		}
+	}
+	return nil
+}
+
+// availableWidth returns the width in twips the table may take: the width
+// of the enclosing cell or the text width of its section, or the table's
+// percentage of it.
+func (t *Table) availableWidth() (float64, error) {
+	avail := textWidth(t.tbl.RawElement(), nil)
+	if pct, err := t.WidthPercent(); err != nil {
+		return 0, err
+	} else if pct != nil {
+		avail *= *pct / 100
+	}
+	return math.Max(avail, 720), nil
+}
+
+// textWidth returns the width in twips text at el may take: the width of
+// the enclosing cell less its margins or the column width of its section.
+// fallback gives the section properties to use when el is outside the
+// body, such as in a header; nil means Word's default page.
+func textWidth(el, fallback *etree.Element) float64 {
+	if tc := enclosingCell(el); tc != nil {
+		avail := float64(2 * cellMargin)
+		if w := tc.FindElement("w:tcPr/w:tcW"); w != nil && w.SelectAttrValue("w:type", "") == "dxa" {
+			avail = float64(attrInt(w, "w:w", 0))
+		}
+		return avail - 2*cellMargin
+	}
+	sectPr := governingSectPr(el)
+	if sectPr == nil {
+		sectPr = fallback
+	}
+	return sectionGeometry(sectPr).width
+}
+
+// enclosingCell returns the w:tc el is nested in, or nil.
+func enclosingCell(el *etree.Element) *etree.Element {
+	for p := el.Parent(); p != nil; p = p.Parent() {
+		if p.Space == "w" && p.Tag == "tc" {
+			return p
+		}
+	}
+	return nil
+}
+
+// governingSectPr returns the section properties that apply to el: those
+// of the first following paragraph that ends a section, or the body's.
+func governingSectPr(el *etree.Element) *etree.Element {
+	for el.Parent() != nil && !(el.Parent().Space == "w" && el.Parent().Tag == "body") {
+		el = el.Parent()
+	}
+	body := el.Parent()
+	if body == nil {
+		return nil
+	}
+	for _, sib := range body.ChildElements()[childIndex(body, el):] {
+		if sib.Space == "w" && sib.Tag == "p" {
+			if sectPr := sib.FindElement("w:pPr/w:sectPr"); sectPr != nil {
+				return sectPr
+			}
+		}
+	}
+	return body.SelectElement("w:sectPr")
+}
+
+// contentWidths returns the width of the longest unbreakable word and of
+// the longest line of the paragraphs in container, in twips.
+func (lo *layouter) contentWidths(container *etree.Element) (minW, maxW float64) {
+	for _, child := range container.ChildElements() {
+		if child.Space != "w" {
+			continue
+		}
+		switch child.Tag {
+		case "p":
+			glyphs, _ := lo.paragraphGlyphs(child)
+			indent := chainTwips(lo.res.pPrChain(child), "w:ind", "w:left", 0) +
+				chainTwips(lo.res.pPrChain(child), "w:ind", "w:right", 0)
+			word, line := 0.0, 0.0
+			for _, g := range glyphs {
+				switch {
+				case g.brk == "tab":
+					line += defaultTabStop
+					word = 0
+				case g.brk != "":
+					line, word = 0, 0
+				case g.space:
+					line += g.width
+					word = 0
+				default:
+					line += g.width
+					word += g.width
+				}
+				minW = math.Max(minW, word+indent)
+				maxW = math.Max(maxW, line+indent)
+			}
+		case "sdt":
+			if content := child.SelectElement("w:sdtContent"); content != nil {
+				cmin, cmax := lo.contentWidths(content)
+				minW, maxW = math.Max(minW, cmin), math.Max(maxW, cmax)
+			}
+		case "tbl":
+			// A nested table needs at least the widths of its grid.
+			w := 0.0
+			for _, gc := range child.FindElements("w:tblGrid/w:gridCol") {
+				w += float64(attrInt(gc, "w:w", 0))
+			}
+			minW, maxW = math.Max(minW, w), math.Max(maxW, w)
+		}
+	}
+	return minW, maxW
+}
+
+// widen spreads what cols lack of total evenly over them.
+func widen(cols []float64, total float64) {
+	if lack := total - sum(cols); lack > 0 {
+		for i := range cols {
+			cols[i] += lack / float64(len(cols))
+		}
+	}
+}
+
+// sum returns the sum of v.
+func sum(v []float64) float64 {
+	s := 0.0
+	for _, x := range v {
+		s += x
+	}
+	return s
+}
@@ -0,0 +1,126 @@
+package docx
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTable_WidthPercent(t *testing.T) {
+	d := mustNewDoc(t)
+	tbl, err := d.AddTable(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.SetWidthPercent(50); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.SetWidthPercent(120); err == nil {
+		t.Error("120% accepted")
+	}
+	cell := tbl.Rows().Iter()[0].Cells()[0]
+	if err := cell.SetWidthPercent(25); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := d.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	d2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tables, _ := d2.Tables()
+	tbl2 := tables[len(tables)-1]
+	if pct, err := tbl2.WidthPercent(); err != nil || pct == nil || *pct != 50 {
+		t.Errorf("WidthPercent = %v, %v", pct, err)
+	}
+	if w, _ := tbl2.Width(); w != nil {
+		t.Errorf("Width = %v for a pct table", *w)
+	}
+	if pct, err := tbl2.Rows().Iter()[0].Cells()[0].WidthPercent(); err != nil || pct == nil || *pct != 25 {
+		t.Errorf("cell WidthPercent = %v, %v", pct, err)
+	}
+
+	// Strict documents write percentages with a percent sign.
+	tblW, _ := tbl2.tblPrChild("w:tblW", false)
+	tblW.CreateAttr("w:w", "33.5%")
+	if pct, err := tbl2.WidthPercent(); err != nil || *pct != 33.5 {
+		t.Errorf("strict WidthPercent = %v, %v", pct, err)
+	}
+}
+
+func TestTable_SetFixedColumnWidths(t *testing.T) {
+	d := mustNewDoc(t)
+	tbl, err := d.AddTable(2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.SetFixedColumnWidths(Inches(1), Inches(2), Inches(0.5)); err != nil {
+		t.Fatal(err)
+	}
+	if fit, _ := tbl.Autofit(); fit {
+		t.Error("layout not fixed")
+	}
+	if w, _ := tbl.Width(); w == nil || w.Twips() != 5040 {
+		t.Errorf("Width = %v", w)
+	}
+	if w, _ := tbl.Rows().Iter()[1].Cells()[1].WidthLength(); w == nil || w.Twips() != 2880 {
+		t.Errorf("cell width = %v", w)
+	}
+	if err := tbl.SetFixedColumnWidths(Inches(1), 0); err == nil {
+		t.Error("zero width accepted")
+	}
+}
+
+func TestTable_AutoFitContents(t *testing.T) {
+	d := mustNewDoc(t)
+	tbl, err := d.AddTable(2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := [][]string{
+		{"Id", "Description", "Qty"},
+		{"7", strings.Repeat("a long description of the item ", 3), "12"},
+	}
+	for i, row := range tbl.Rows().Iter() {
+		for j, c := range row.Cells() {
+			c.Paragraphs()[0].AddRun(content[i][j])
+		}
+	}
+	if err := tbl.AutoFitContents(nil); err != nil {
+		t.Fatal(err)
+	}
+	widths, err := tbl.tbl.ColWidths()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if widths[1] <= widths[0] || widths[1] <= widths[2] {
+		t.Errorf("description column not widest: %v", widths)
+	}
+	if widths[0] > 720 {
+		t.Errorf("id column too wide: %v", widths)
+	}
+	total := widths[0] + widths[1] + widths[2]
+	text := int(sectionGeometry(governingSectPr(tbl.tbl.RawElement())).width)
+	if total > text || total < text-10 {
+		t.Errorf("table width %d, want the %d twip text width", total, text)
+	}
+	if fit, _ := tbl.Autofit(); !fit {
+		t.Error("layout not autofit")
+	}
+
+	// Short content leaves the table narrower than the text width.
+	small, err := d.AddTable(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	small.Rows().Iter()[0].Cells()[0].Paragraphs()[0].AddRun("a")
+	if err := small.AutoFitContents(nil); err != nil {
+		t.Fatal(err)
+	}
+	if w, _ := small.tbl.ColWidths(); w[0]+w[1] > 1440 {
+		t.Errorf("small table widths %v", w)
+	}
+}
@@ -2,6 +2,9 @@ package docx
 
 import (
 	"fmt"
+	"maps"
+	"math"
+	"slices"
 
 	"github.com/vortex/go-docx/pkg/docx/enum"
 	"github.com/vortex/go-docx/pkg/docx/oxml"
@@ -33,11 +36,11 @@ func (ts *TabStops) Len() int {
 func (ts *TabStops) Get(idx int) (*TabStop, error) {
 	tabs := ts.pPr.Tabs()
 	if tabs == nil {
-		return nil, fmt.Errorf("docx: tab index out of range")
+		return nil, fmt.Errorf("docx: tab index out of range: %w", ErrInvalidIndex)
 	}
 	lst := tabs.TabList()
 	if idx < 0 || idx >= len(lst) {
-		return nil, fmt.Errorf("docx: tab index [%d] out of range", idx)
+		return nil, fmt.Errorf("docx: tab index [%d] out of range: %w", idx, ErrInvalidIndex)
 	}
 	return newTabStop(lst[idx]), nil
 }
@@ -46,11 +49,11 @@ func (ts *TabStops) Get(idx int) (*TabStop, error) {
 func (ts *TabStops) Delete(idx int) error {
 	tabs := ts.pPr.Tabs()
 	if tabs == nil {
-		return fmt.Errorf("docx: tab index out of range")
+		return fmt.Errorf("docx: tab index out of range: %w", ErrInvalidIndex)
 	}
 	lst := tabs.TabList()
 	if idx < 0 || idx >= len(lst) {
-		return fmt.Errorf("docx: tab index [%d] out of range", idx)
+		return fmt.Errorf("docx: tab index [%d] out of range: %w", idx, ErrInvalidIndex)
 	}
 	tabs.RawElement().RemoveChild(lst[idx].RawElement())
 	if len(tabs.TabList()) == 0 {
@@ -59,6 +62,18 @@ func (ts *TabStops) Delete(idx int) error {
 	return nil
 }
 
+// Remove removes the tab stop at the given index, as Delete does.
+func (ts *TabStops) Remove(idx int) error {
+	return ts.Delete(idx)
+}
+
+// ClearInherited keeps a tab stop inherited from a style at position from
+// applying, by adding a clear tab stop there. ClearAll removes clear tab
+// stops as well, letting the inherited ones apply again.
+func (ts *TabStops) ClearInherited(position Length) (*TabStop, error) {
+	return ts.AddTabStopLength(position, enum.WdTabAlignmentClear, enum.WdTabLeaderSpaces)
+}
+
 // Iter returns all tab stops in document order.
 func (ts *TabStops) Iter() []*TabStop {
 	tabs := ts.pPr.Tabs()
@@ -73,11 +88,27 @@ func (ts *TabStops) Iter() []*TabStop {
 	return result
 }
 
-// AddTabStop adds a new tab stop at the given position (EMU) with alignment
-// and leader. Defaults: alignment=LEFT, leader=SPACES.
+// maxTabPosition is the farthest a tab stop can be from the indent in
+// either direction, 22 inches in twips, as Word allows.
+const maxTabPosition = 31680
+
+// AddTabStop adds a new tab stop at the given position in twips, measured
+// from the left indent, with alignment and leader. A bar tab (WdTabAlignmentBar)
+// draws a vertical line at the position instead of stopping the text; a
+// num tab (WdTabAlignmentNum) positions list numbers. The position must be
+// within 22 inches of the indent, either way.
 //
 // Mirrors Python TabStops.add_tab_stop.
 func (ts *TabStops) AddTabStop(position int, alignment enum.WdTabAlignment, leader enum.WdTabLeader) (*TabStop, error) {
+	if _, err := alignment.ToXml(); err != nil {
+		return nil, fmt.Errorf("docx: invalid tab alignment %d: %w", alignment, err)
+	}
+	if _, err := leader.ToXml(); err != nil {
+		return nil, fmt.Errorf("docx: invalid tab leader %d: %w", leader, err)
+	}
+	if position < -maxTabPosition || position > maxTabPosition {
+		return nil, fmt.Errorf("docx: tab position %s more than 22in from the indent", Twips(float64(position)))
+	}
 	tabs := ts.pPr.GetOrAddTabs()
 	tab, err := tabs.InsertTabInOrder(position, alignment, leader)
 	if err != nil {
@@ -86,6 +117,83 @@ func (ts *TabStops) AddTabStop(position int, alignment enum.WdTabAlignment, lead
 	return newTabStop(tab), nil
 }
 
+// AddTabStopLength is AddTabStop with the position given as a Length.
+func (ts *TabStops) AddTabStopLength(position Length, alignment enum.WdTabAlignment, leader enum.WdTabLeader) (*TabStop, error) {
+	return ts.AddTabStop(position.Twips(), alignment, leader)
+}
+
+// AddRightTabAtMargin adds a right-aligned tab stop at the right edge of
+// the paragraph's text: the text width of its section column or table cell
+// less its effective right indent. With a dotted leader this makes the
+// "title ........ page" lines of a table of contents without hard-coding
+// a page width. Paragraphs in headers and footers use the last section of
+// the document.
+func (para *Paragraph) AddRightTabAtMargin(leader enum.WdTabLeader) (*TabStop, error) {
+	dp, err := para.part.DocumentPart()
+	if err != nil {
+		return nil, fmt.Errorf("docx: right tab: %w", err)
+	}
+	styles, err := dp.Styles()
+	if err != nil {
+		return nil, fmt.Errorf("docx: right tab: %w", err)
+	}
+	p := para.p.RawElement()
+	width := textWidth(p, dp.Element().FindElement("w:body/w:sectPr"))
+	res := newFormatResolver(styles.RawElement(), "", "")
+	width -= chainTwips(res.pPrChain(p), "w:ind", "w:right", 0)
+	return para.ParagraphFormat().TabStops().AddTabStopLength(Twips(math.Max(width, 0)), enum.WdTabAlignmentRight, leader)
+}
+
+// AddDotLeaderTabAtMargin adds a right-aligned tab stop with a dotted
+// leader at the right edge of the paragraph's text, for the
+// "title ........ page" lines of a table of contents. It is
+// AddRightTabAtMargin with WdTabLeaderDots.
+func (para *Paragraph) AddDotLeaderTabAtMargin() (*TabStop, error) {
+	return para.AddRightTabAtMargin(enum.WdTabLeaderDots)
+}
+
+// EffectiveTabStops returns the tab stops in effect for the paragraph, in
+// position order: its own and those of its paragraph style and the styles
+// that style is based on, less any a nearer definition clears or moves.
+// Clear tab stops are not returned. Changing an inherited tab stop changes
+// its style; ParagraphFormat.TabStops holds only the paragraph's own.
+func (para *Paragraph) EffectiveTabStops() ([]*TabStop, error) {
+	dp, err := para.part.DocumentPart()
+	if err != nil {
+		return nil, fmt.Errorf("docx: effective tab stops: %w", err)
+	}
+	styles, err := dp.Styles()
+	if err != nil {
+		return nil, fmt.Errorf("docx: effective tab stops: %w", err)
+	}
+	chain := newFormatResolver(styles.RawElement(), "", "").pPrChain(para.p.RawElement())
+	byPos := map[int]*oxml.CT_TabStop{}
+	for i := len(chain) - 1; i >= 0; i-- {
+		tabs := chain[i].SelectElement("w:tabs")
+		if tabs == nil {
+			continue
+		}
+		for _, el := range tabs.SelectElements("w:tab") {
+			tab := &oxml.CT_TabStop{Element: oxml.WrapElement(el)}
+			pos, err := tab.Pos()
+			if err != nil {
+				return nil, fmt.Errorf("docx: effective tab stops: %w", err)
+			}
+			if align, err := tab.Val(); err == nil && align == enum.WdTabAlignmentClear {
+				delete(byPos, pos)
+			} else {
+				byPos[pos] = tab
+			}
+		}
+	}
+	positions := slices.Sorted(maps.Keys(byPos))
+	result := make([]*TabStop, len(positions))
+	for i, pos := range positions {
+		result[i] = newTabStop(byPos[pos])
+	}
+	return result, nil
+}
+
 // ClearAll removes all custom tab stops.
 //
 // Mirrors Python TabStops.clear_all.
@@ -125,12 +233,21 @@ func (t *TabStop) SetLeader(v enum.WdTabLeader) error {
 	return t.tab.SetLeader(v)
 }
 
-// Position returns the tab position in EMU.
+// Position returns the tab position in twips.
 func (t *TabStop) Position() (int, error) {
 	return t.tab.Pos()
 }
 
-// SetPosition changes the position of this tab stop.
+// PositionLength returns the tab position as a Length.
+func (t *TabStop) PositionLength() (Length, error) {
+	pos, err := t.tab.Pos()
+	if err != nil {
+		return 0, err
+	}
+	return Twips(float64(pos)), nil
+}
+
+// SetPosition changes the position of this tab stop, in twips.
 // The tab is re-inserted in position order.
 //
 // Mirrors Python TabStop.position setter.
@@ -156,3 +273,8 @@ func (t *TabStop) SetPosition(v int) error {
 	t.tab = newTab
 	return nil
 }
+
+// SetPositionLength is SetPosition with the position given as a Length.
+func (t *TabStop) SetPositionLength(v Length) error {
+	return t.SetPosition(v.Twips())
+}
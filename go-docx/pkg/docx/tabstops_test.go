@@ -113,6 +113,31 @@ func TestTabStop_Position(t *testing.T) {
 	}
 }
 
+func TestTabStop_PositionLength(t *testing.T) {
+	ts := makeTestTabStops(t, `<w:tabs><w:tab w:val="left" w:pos="720"/></w:tabs>`)
+	tab, err := ts.Get(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos, _ := tab.PositionLength(); pos != Inches(0.5) {
+		t.Errorf("PositionLength() = %v, want %v", pos, Inches(0.5))
+	}
+	if err := tab.SetPositionLength(Inches(1)); err != nil {
+		t.Fatal(err)
+	}
+	if pos, _ := tab.Position(); pos != 1440 {
+		t.Errorf("Position() after SetPositionLength = %d, want 1440", pos)
+	}
+
+	added, err := ts.AddTabStopLength(Cm(1), enum.WdTabAlignmentLeft, enum.WdTabLeaderSpaces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos, _ := added.Position(); pos != Cm(1).Twips() {
+		t.Errorf("Position() of added stop = %d, want %d", pos, Cm(1).Twips())
+	}
+}
+
 // Mirrors Python: TabStop.alignment get/set
 func TestTabStop_Alignment(t *testing.T) {
 	ts := makeTestTabStops(t, `<w:tabs><w:tab w:val="center" w:pos="4680"/></w:tabs>`)
@@ -152,3 +177,141 @@ func TestTabStop_Leader(t *testing.T) {
 		t.Errorf("Leader() = %v, want DOTS", leader)
 	}
 }
+
+func TestParagraph_AddRightTabAtMargin(t *testing.T) {
+	doc := mustNewDoc(t)
+	sec := doc.Sections().Iter()[0]
+	if err := sec.SetPageSize(PageSizeA4, enum.WdOrientationPortrait); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []func(*Length) error{sec.SetLeftMarginLength, sec.SetRightMarginLength} {
+		if err := m(Ptr(Twips(1000))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p, err := doc.AddParagraph("Introduction\t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.ParagraphFormat().SetRightIndentLength(Ptr(Twips(200))); err != nil {
+		t.Fatal(err)
+	}
+	tab, err := p.AddRightTabAtMargin(enum.WdTabLeaderDots)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pos, _ := tab.PositionLength()
+	if want := 11906 - 2*1000 - 200; pos.Twips() != want {
+		t.Errorf("tab position = %d twips, want %d", pos.Twips(), want)
+	}
+	if a, _ := tab.Alignment(); a != enum.WdTabAlignmentRight {
+		t.Errorf("alignment = %v, want RIGHT", a)
+	}
+	if l, _ := tab.Leader(); l != enum.WdTabLeaderDots {
+		t.Errorf("leader = %v, want DOTS", l)
+	}
+}
+
+func TestParagraph_EffectiveTabStops(t *testing.T) {
+	doc := mustNewDoc(t)
+	styles, err := doc.Styles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	style, err := styles.AddStyle("Tabbed", enum.WdStyleTypeParagraph, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pos := range []int{720, 1440, 2160} {
+		if _, err := style.ParagraphFormat().TabStops().AddTabStopLength(Twips(float64(pos)), enum.WdTabAlignmentLeft, enum.WdTabLeaderSpaces); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p, err := doc.AddParagraph("a\tb", StyleName("Tabbed"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := p.ParagraphFormat().TabStops()
+	if _, err := ts.ClearInherited(Twips(1440)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ts.AddTabStopLength(Twips(2160), enum.WdTabAlignmentRight, enum.WdTabLeaderDots); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ts.AddTabStopLength(Twips(360), enum.WdTabAlignmentCenter, enum.WdTabLeaderSpaces); err != nil {
+		t.Fatal(err)
+	}
+
+	tabs, err := p.EffectiveTabStops()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		pos   int
+		align enum.WdTabAlignment
+	}{{360, enum.WdTabAlignmentCenter}, {720, enum.WdTabAlignmentLeft}, {2160, enum.WdTabAlignmentRight}}
+	if len(tabs) != len(want) {
+		t.Fatalf("len(EffectiveTabStops()) = %d, want %d", len(tabs), len(want))
+	}
+	for i, w := range want {
+		pos, _ := tabs[i].PositionLength()
+		align, _ := tabs[i].Alignment()
+		if pos.Twips() != w.pos || align != w.align {
+			t.Errorf("tab %d = %d %v, want %d %v", i, pos.Twips(), align, w.pos, w.align)
+		}
+	}
+
+	// Removing the clear stop lets the style's tab stop apply again.
+	if err := ts.Remove(1); err != nil {
+		t.Fatal(err)
+	}
+	if tabs, _ := p.EffectiveTabStops(); len(tabs) != 4 {
+		t.Errorf("len(EffectiveTabStops()) after Remove = %d, want 4", len(tabs))
+	}
+	ts.ClearAll()
+	if tabs, _ := p.EffectiveTabStops(); len(tabs) != 3 {
+		t.Errorf("len(EffectiveTabStops()) after ClearAll = %d, want 3", len(tabs))
+	}
+}
+
+func TestTabStops_AddTabStop_Kinds(t *testing.T) {
+	ts := makeTestTabStops(t, ``)
+	for i, c := range []struct {
+		align  enum.WdTabAlignment
+		leader enum.WdTabLeader
+	}{
+		{enum.WdTabAlignmentBar, enum.WdTabLeaderSpaces},
+		{enum.WdTabAlignmentNum, enum.WdTabLeaderMiddleDot},
+		{enum.WdTabAlignmentRight, enum.WdTabLeaderHeavy},
+	} {
+		tab, err := ts.AddTabStopLength(Twips(float64(720*(i+1))), c.align, c.leader)
+		if err != nil {
+			t.Fatalf("AddTabStop(%v, %v): %v", c.align, c.leader, err)
+		}
+		if a, _ := tab.Alignment(); a != c.align {
+			t.Errorf("Alignment() = %v, want %v", a, c.align)
+		}
+		if l, _ := tab.Leader(); l != c.leader {
+			t.Errorf("Leader() = %v, want %v", l, c.leader)
+		}
+	}
+
+	empty := makeTestTabStops(t, ``)
+	for _, c := range []struct {
+		pos    Length
+		align  enum.WdTabAlignment
+		leader enum.WdTabLeader
+	}{
+		{Twips(720), enum.WdTabAlignment(99), enum.WdTabLeaderSpaces},
+		{Twips(720), enum.WdTabAlignmentLeft, enum.WdTabLeader(99)},
+		{Inches(23), enum.WdTabAlignmentLeft, enum.WdTabLeaderSpaces},
+		{Inches(-23), enum.WdTabAlignmentLeft, enum.WdTabLeaderSpaces},
+	} {
+		if _, err := empty.AddTabStopLength(c.pos, c.align, c.leader); err == nil {
+			t.Errorf("AddTabStop(%v, %v, %v): expected error", c.pos, c.align, c.leader)
+		}
+	}
+	if empty.pPr.Tabs() != nil {
+		t.Error("failed AddTabStop left a w:tabs element")
+	}
+}
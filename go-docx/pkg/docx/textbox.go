@@ -0,0 +1,108 @@
+package docx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// TextBox is a proxy for the <w:txbxContent> story of a text box shape.
+// It is a block-item container: paragraphs and tables inside the box are
+// read and edited with the usual BlockItemContainer methods.
+type TextBox struct {
+	BlockItemContainer
+}
+
+// TextBoxOptions configures Run.AddTextBox.
+type TextBoxOptions struct {
+	Width, Height Length // box size; both required
+	// OffsetX and OffsetY position the box relative to the column and to
+	// the anchoring paragraph.
+	OffsetX, OffsetY Length
+	Fill             *RGBColor     // solid fill; nil for no fill
+	Gradient         *GradientFill // gradient fill; replaces Fill when set
+	Border           *RGBColor     // outline color; nil for no outline
+	BorderWidth      Length        // outline width; defaults to 0.75pt
+}
+
+// AddTextBox adds a floating text box anchored at this run and returns it.
+// The box starts with one empty paragraph; use AddParagraph or the first
+// paragraph to fill it.
+func (run *Run) AddTextBox(opts TextBoxOptions) (*TextBox, error) {
+	if run.part == nil {
+		return nil, fmt.Errorf("docx: run has no story part (required for text box ids)")
+	}
+	spec := oxml.TextBoxSpec{
+		ID: run.part.NextID(),
+		Cx: opts.Width.Emu(), Cy: opts.Height.Emu(),
+		X: opts.OffsetX.Emu(), Y: opts.OffsetY.Emu(),
+	}
+	if opts.Fill != nil {
+		spec.FillHex = opts.Fill.String()
+	}
+	if opts.Gradient != nil {
+		g, err := opts.Gradient.spec()
+		if err != nil {
+			return nil, err
+		}
+		spec.Gradient = g
+	}
+	if opts.Border != nil {
+		spec.LineHex = opts.Border.String()
+		spec.LineWidth = opts.BorderWidth.Emu()
+		if spec.LineWidth == 0 {
+			spec.LineWidth = Pt(0.75).Emu()
+		}
+	}
+	anchor, err := oxml.NewTextBoxAnchor(spec)
+	if err != nil {
+		return nil, fmt.Errorf("docx: creating text box: %w", err)
+	}
+	drawing := run.r.RawElement().CreateElement("w:drawing")
+	drawing.AddChild(anchor.RawElement())
+
+	content := oxml.FindTxbxContents(drawing)[0]
+	return &TextBox{BlockItemContainer: newBlockItemContainer(content, run.part)}, nil
+}
+
+// Text returns the text of the paragraphs in this text box, separated by
+// newlines. Text in tables inside the box is not included.
+func (tb *TextBox) Text() string {
+	paras := tb.Paragraphs()
+	texts := make([]string, len(paras))
+	for i, p := range paras {
+		texts[i] = p.Text()
+	}
+	return strings.Join(texts, "\n")
+}
+
+// TextBoxes returns the text boxes in this container in document order,
+// including text boxes nested in tables and in other text boxes. Legacy
+// VML copies kept for older readers (mc:Fallback) are not reported.
+func (c *BlockItemContainer) TextBoxes() []*TextBox {
+	contents := oxml.FindTxbxContents(c.element)
+	result := make([]*TextBox, len(contents))
+	for i, el := range contents {
+		result[i] = &TextBox{BlockItemContainer: newBlockItemContainer(el, c.part)}
+	}
+	return result
+}
+
+// TextBoxes returns the text boxes in the document body.
+func (d *Document) TextBoxes() ([]*TextBox, error) {
+	b, err := d.getBody()
+	if err != nil {
+		return nil, err
+	}
+	return b.TextBoxes(), nil
+}
+
+// TextBoxes returns the text boxes in this header/footer.
+func (b *baseHeaderFooter) TextBoxes() ([]*TextBox, error) {
+	bic, err := b.blockItemContainer()
+	if err != nil {
+		return nil, fmt.Errorf("docx: %s text boxes: %w", b.ops.kind(), err)
+	}
+	return bic.TextBoxes(), nil
+}
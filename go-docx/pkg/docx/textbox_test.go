@@ -0,0 +1,138 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+)
+
+func TestRun_AddTextBox(t *testing.T) {
+	doc := mustNewDoc(t)
+	para, err := doc.AddParagraph("anchor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run, err := para.AddRun("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fill, border := NewRGBColor(0xFF, 0xFF, 0xCC), NewRGBColor(0, 0, 0)
+	tb, err := run.AddTextBox(TextBoxOptions{
+		Width: Inches(2), Height: Inches(1),
+		OffsetX: Inches(0.5), Fill: &fill, Border: &border,
+	})
+	if err != nil {
+		t.Fatalf("AddTextBox: %v", err)
+	}
+	first := tb.Paragraphs()[0]
+	if _, err := first.AddRun("Dear {name},"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tb.AddParagraph("second line"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tb.Text(), "Dear {name},\nsecond line"; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	doc2, err := OpenBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	boxes, err := doc2.TextBoxes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(boxes) != 1 {
+		t.Fatalf("expected 1 text box, got %d", len(boxes))
+	}
+	if n := boxes[0].ReplaceText("{name}", "Ada"); n != 1 {
+		t.Errorf("ReplaceText count = %d, want 1", n)
+	}
+	if got := boxes[0].Paragraphs()[0].Text(); got != "Dear Ada," {
+		t.Errorf("after replace = %q", got)
+	}
+	if v := doc2.ValidateSchema(); len(v) != 0 {
+		t.Errorf("unexpected schema violations: %v", v)
+	}
+}
+
+func TestRun_AddTextBox_RequiresSize(t *testing.T) {
+	doc := mustNewDoc(t)
+	para, _ := doc.AddParagraph("")
+	run, _ := para.AddRun("")
+	if _, err := run.AddTextBox(TextBoxOptions{}); err == nil {
+		t.Error("expected error for zero-size text box")
+	}
+}
+
+func TestBlockItemContainer_TextBoxes_SkipsFallback(t *testing.T) {
+	body := mustParseXml(t, `<w:body xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" `+
+		`xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006">`+
+		`<w:p><w:r><mc:AlternateContent>`+
+		`<mc:Choice Requires="wps"><w:drawing><w:txbxContent><w:p><w:r><w:t>modern</w:t></w:r></w:p></w:txbxContent></w:drawing></mc:Choice>`+
+		`<mc:Fallback><w:pict><w:txbxContent><w:p><w:r><w:t>legacy</w:t></w:r></w:p></w:txbxContent></w:pict></mc:Fallback>`+
+		`</mc:AlternateContent></w:r></w:p></w:body>`)
+	bic := newBlockItemContainer(body.RawElement(), nil)
+	boxes := bic.TextBoxes()
+	if len(boxes) != 1 || boxes[0].Text() != "modern" {
+		t.Fatalf("TextBoxes() = %d boxes", len(boxes))
+	}
+}
+
+func TestRun_AddTextBox_Gradient(t *testing.T) {
+	doc := mustNewDoc(t)
+	para, _ := doc.AddParagraph("")
+	run, _ := para.AddRun("")
+	tb, err := run.AddTextBox(TextBoxOptions{
+		Width: Inches(2), Height: Inches(1),
+		Fill: Ptr(NewRGBColor(0xFF, 0, 0)),
+		Gradient: &GradientFill{Angle: 90, Stops: []GradientStop{
+			{0, NewRGBColor(0xFF, 0xFF, 0xFF)}, {1, NewRGBColor(0x1F, 0x4E, 0x79)},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("AddTextBox: %v", err)
+	}
+	spPr := tb.element.Parent().Parent().SelectElement("wps:spPr")
+	if spPr.SelectElement("a:solidFill") != nil {
+		t.Error("gradient box also has a solid fill")
+	}
+	lin := spPr.FindElement("a:gradFill/a:lin")
+	if lin == nil || lin.SelectAttrValue("ang", "") != "5400000" {
+		t.Errorf("a:lin = %v", lin)
+	}
+	if gs := spPr.FindElements("a:gradFill/a:gsLst/a:gs"); len(gs) != 2 || gs[1].SelectAttrValue("pos", "") != "100000" {
+		t.Errorf("%d gradient stops", len(gs))
+	}
+
+	radial, err := run.AddTextBox(TextBoxOptions{
+		Width: Inches(1), Height: Inches(1),
+		Gradient: &GradientFill{Type: enum.MsoGradientRadial, Stops: []GradientStop{
+			{0, NewRGBColor(0xFF, 0xFF, 0)}, {0.5, NewRGBColor(0xFF, 0xC0, 0)}, {1, NewRGBColor(0xC0, 0, 0)},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path := radial.element.Parent().Parent().FindElement("wps:spPr/a:gradFill/a:path"); path == nil || path.SelectAttrValue("path", "") != "circle" {
+		t.Errorf("a:path = %v", path)
+	}
+	if v := doc.ValidateSchema(); len(v) != 0 {
+		t.Errorf("unexpected schema violations: %v", v)
+	}
+
+	for _, g := range []*GradientFill{
+		{Stops: []GradientStop{{0, NewRGBColor(0, 0, 0)}}},
+		{Stops: []GradientStop{{0, NewRGBColor(0, 0, 0)}, {1.5, NewRGBColor(0, 0, 0)}}},
+	} {
+		if _, err := run.AddTextBox(TextBoxOptions{Width: Inches(1), Height: Inches(1), Gradient: g}); err == nil {
+			t.Errorf("AddTextBox accepted gradient %+v", g)
+		}
+	}
+}
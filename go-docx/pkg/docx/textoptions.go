@@ -0,0 +1,87 @@
+package docx
+
+import (
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// TextOption configures the plain text Run.Text, Paragraph.Text and
+// Cell.Text return. Without options tabs read as "\t", line breaks as
+// "\n", and page and column breaks and drawings as nothing.
+type TextOption func(*textConfig)
+
+// textConfig holds the settings TextOption functions apply.
+type textConfig struct {
+	conv oxml.TextConversion
+}
+
+// WithTabAs makes tabs read as s.
+func WithTabAs(s string) TextOption {
+	return func(c *textConfig) { c.conv.Tab = s }
+}
+
+// WithBreakAs makes line breaks, including carriage returns, read as s.
+func WithBreakAs(s string) TextOption {
+	return func(c *textConfig) { c.conv.LineBreak = s }
+}
+
+// WithPageBreakAs makes page breaks read as s.
+func WithPageBreakAs(s string) TextOption {
+	return func(c *textConfig) { c.conv.PageBreak = s }
+}
+
+// WithColumnBreakAs makes column breaks read as s.
+func WithColumnBreakAs(s string) TextOption {
+	return func(c *textConfig) { c.conv.ColumnBreak = s }
+}
+
+// WithDrawingAs makes pictures, charts, shapes and other drawings read as
+// s, e.g. "[image]".
+func WithDrawingAs(s string) TextOption {
+	return func(c *textConfig) { c.conv.Drawing = s }
+}
+
+// WithTabLeaders makes a tab to a tab stop with a leader read as width
+// leader characters, as it shows, e.g. "Introduction....3" for a table of
+// contents entry. The nth tab of a paragraph is taken to go to its nth
+// effective tab stop, which holds unless text runs past a stop; Run.Text,
+// which has no paragraph, only reads the leaders of absolute-position
+// tabs.
+func WithTabLeaders(width int) TextOption {
+	return func(c *textConfig) { c.conv.LeaderWidth = width }
+}
+
+// newTextConversion returns the conversion opts configure.
+func newTextConversion(opts []TextOption) *oxml.TextConversion {
+	cfg := textConfig{conv: oxml.DefaultTextConversion()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &cfg.conv
+}
+
+// tabLeaders returns the leaders (w:leader values) of the effective tab
+// stops of the paragraph, in position order, for
+// oxml.TextConversion.TabLeaders. Bar tabs, which tabs do not go to, are
+// left out.
+func (para *Paragraph) tabLeaders() []string {
+	if para.part == nil {
+		return nil
+	}
+	stops, err := para.EffectiveTabStops()
+	if err != nil {
+		return nil
+	}
+	var leaders []string
+	for _, stop := range stops {
+		if align, err := stop.Alignment(); err == nil && align == enum.WdTabAlignmentBar {
+			continue
+		}
+		leader := ""
+		if v, err := stop.Leader(); err == nil {
+			leader, _ = v.ToXml()
+		}
+		leaders = append(leaders, leader)
+	}
+	return leaders
+}
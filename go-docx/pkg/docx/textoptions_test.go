@@ -0,0 +1,79 @@
+package docx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+)
+
+func TestParagraph_TextOptions(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run, err := p.AddRun("a\tb\nc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := run.AddBreak(enum.WdBreakTypePage); err != nil {
+		t.Fatal(err)
+	}
+	if err := run.AddBreak(enum.WdBreakTypeColumn); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := run.AddPicture(bytes.NewReader(minimalPNG()), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	run.AddText("d")
+
+	if got, want := p.Text(), "a\tb\ncd"; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+	got := p.Text(WithTabAs(" "), WithBreakAs(" "), WithPageBreakAs("\f"), WithColumnBreakAs("|"), WithDrawingAs("[image]"))
+	if want := "a b c\f|[image]d"; got != want {
+		t.Errorf("Text(opts) = %q, want %q", got, want)
+	}
+	if got, want := run.Text(WithDrawingAs("[image]")), "a\tb\nc[image]d"; got != want {
+		t.Errorf("Run.Text(opts) = %q, want %q", got, want)
+	}
+
+	tbl, err := doc.AddTable(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cell, err := tbl.CellAt(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cell.SetText("x\ny")
+	if got, want := cell.Text(WithBreakAs(" / ")), "x / y"; got != want {
+		t.Errorf("Cell.Text(opts) = %q, want %q", got, want)
+	}
+}
+
+func TestParagraph_TextTabLeaders(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph("1.1\tIntroduction\t3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stops := p.ParagraphFormat().TabStops()
+	if _, err := stops.AddTabStopLength(Inches(0.5), enum.WdTabAlignmentLeft, enum.WdTabLeaderSpaces); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stops.AddTabStopLength(Inches(6), enum.WdTabAlignmentRight, enum.WdTabLeaderDots); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := p.Text(WithTabLeaders(4)), "1.1\tIntroduction....3"; got != want {
+		t.Errorf("Text(WithTabLeaders) = %q, want %q", got, want)
+	}
+	if got, want := p.Text(WithTabLeaders(2), WithTabAs(" ")), "1.1 Introduction..3"; got != want {
+		t.Errorf("Text(WithTabLeaders, WithTabAs) = %q, want %q", got, want)
+	}
+	if got, want := p.Text(), "1.1\tIntroduction\t3"; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,149 @@
+package docx
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+	"github.com/vortex/go-docx/pkg/docx/parts"
+)
+
+// TypographyOptions configures ApplyTypography. The zero value makes
+// every replacement, with the quotation marks of each paragraph's
+// language.
+type TypographyOptions struct {
+	// Language chooses the quotation marks, as a BCP 47 tag such as
+	// "en-US", "de-DE" or "fr-FR". Empty uses the language of each
+	// paragraph: that of its first run, from direct formatting, styles or
+	// the document defaults. Languages without marks of their own, or
+	// none at all, use English marks.
+	Language string
+	// SkipQuotes leaves straight quotes and apostrophes as they are.
+	SkipQuotes bool
+	// SkipDashes leaves double hyphens as they are.
+	SkipDashes bool
+	// SkipEllipses leaves runs of three periods as they are.
+	SkipEllipses bool
+}
+
+// quoteMarks are the quotation marks of a language: the outer (double)
+// pair and the inner (single) pair.
+type quoteMarks struct {
+	open, close, openInner, closeInner string
+}
+
+// quotesByLanguage maps lowercase language tags, with a region where it
+// changes the marks, to their quotation marks. French sets its marks off
+// with no-break spaces, as Word does.
+var quotesByLanguage = map[string]quoteMarks{
+	"en":    {"“", "”", "‘", "’"},
+	"cs":    {"„", "“", "‚", "‘"},
+	"da":    {"»", "«", "›", "‹"},
+	"de":    {"„", "“", "‚", "‘"},
+	"de-ch": {"«", "»", "‹", "›"},
+	"es":    {"«", "»", "“", "”"},
+	"fi":    {"”", "”", "’", "’"},
+	"fr":    {"«\u00a0", "\u00a0»", "‹\u00a0", "\u00a0›"},
+	"it":    {"«", "»", "“", "”"},
+	"ja":    {"「", "」", "『", "』"},
+	"nl":    {"“", "”", "‘", "’"},
+	"pl":    {"„", "”", "‚", "’"},
+	"pt":    {"«", "»", "“", "”"},
+	"pt-br": {"“", "”", "‘", "’"},
+	"ru":    {"«", "»", "„", "“"},
+	"sk":    {"„", "“", "‚", "‘"},
+	"sv":    {"”", "”", "’", "’"},
+	"uk":    {"«", "»", "„", "“"},
+}
+
+// quotesFor returns the quotation marks of language tag lang.
+func quotesFor(lang string) quoteMarks {
+	if q, ok := lookupLanguage(quotesByLanguage, lang); ok {
+		return q
+	}
+	return quotesByLanguage["en"]
+}
+
+// ApplyTypography gives the text of the document typographic polish, as
+// Word's AutoFormat As You Type does while typing: straight quotes become
+// the opening and closing quotation marks of the language and
+// apostrophes become ’, "--" becomes an em dash (—) and "..." an
+// ellipsis (…). It searches the same stories as ReplaceText, each
+// paragraph on its own, and keeps the formatting of the text even where a
+// replacement spans runs. Field codes are not changed. opts may be nil.
+// It returns the number of replacements made.
+func (d *Document) ApplyTypography(opts *TypographyOptions) (int, error) {
+	if opts == nil {
+		opts = &TypographyOptions{}
+	}
+	styles, err := d.part.Styles()
+	if err != nil {
+		return 0, fmt.Errorf("docx: typography: %w", err)
+	}
+	fr := newFormatResolver(styles.RawElement(), "", "")
+	count := 0
+	d.eachStory(allStories, func(root *etree.Element, _ *parts.StoryPart) bool {
+		for _, p := range root.FindElements(".//w:p") {
+			lang := opts.Language
+			if lang == "" {
+				lang, _ = chainAttr(fr.rPrChain(p, p.SelectElement("w:r")), "w:lang", "w:val")
+			}
+			edits := typographyEdits(oxml.InlineText(p), quotesFor(lang), opts)
+			oxml.ApplyTextEdits(p, edits)
+			count += len(edits)
+		}
+		return true
+	})
+	return count, nil
+}
+
+// typographyEdits returns the replacements ApplyTypography makes in text.
+// A quote opens at the start of the text and after a space, an opening
+// bracket, a dash or an opening quote, and closes anywhere else; a single
+// quote between letters or digits is an apostrophe.
+func typographyEdits(text string, q quoteMarks, opts *TypographyOptions) []oxml.TextEdit {
+	var edits []oxml.TextEdit
+	prev := ' '
+	prevOpened := false
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		opened := false
+		switch {
+		case (r == '"' || r == '\'') && !opts.SkipQuotes:
+			next, _ := utf8.DecodeRuneInString(text[i+size:])
+			opening := unicode.IsSpace(prev) || strings.ContainsRune("([{<“‘„‚«‹-–—/", prev) ||
+				((prev == '"' || prev == '\'') && prevOpened)
+			var mark string
+			switch {
+			case r == '\'' && isWordRune(prev) && isWordRune(next):
+				mark = "’"
+			case r == '"' && opening:
+				mark, opened = q.open, true
+			case r == '"':
+				mark = q.close
+			case opening:
+				mark, opened = q.openInner, true
+			default:
+				mark = q.closeInner
+			}
+			edits = append(edits, oxml.TextEdit{Start: i, End: i + size, Text: mark})
+		case r == '-' && !opts.SkipDashes && strings.HasPrefix(text[i:], "--"):
+			edits = append(edits, oxml.TextEdit{Start: i, End: i + 2, Text: "—"})
+			size, r = 2, '—'
+		case r == '.' && !opts.SkipEllipses && strings.HasPrefix(text[i:], "..."):
+			edits = append(edits, oxml.TextEdit{Start: i, End: i + 3, Text: "…"})
+			size, r = 3, '…'
+		}
+		prev, prevOpened = r, opened
+		i += size
+	}
+	return edits
+}
+
+// isWordRune reports whether r is a letter or digit.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,70 @@
+package docx
+
+import (
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+func TestTypographyEdits(t *testing.T) {
+	tests := []struct {
+		lang, in, want string
+	}{
+		{"en-US", `"Hello," she said -- 'it's fine'...`, `“Hello,” she said — ‘it’s fine’…`},
+		{"de-DE", `Er sagte "ja" und 'nein'.`, `Er sagte „ja“ und ‚nein‘.`},
+		{"fr-FR", `Il a dit "oui".`, "Il a dit «\u00a0oui\u00a0»."},
+		{"pt-BR", `"sim"`, `“sim”`},
+		{"xx", `("quoted")`, `(“quoted”)`},
+		{"en", `"'nested'"`, `“‘nested’”`},
+	}
+	for _, tt := range tests {
+		p := makeP(t, `<w:r><w:t xml:space="preserve">`+tt.in+`</w:t></w:r>`)
+		edits := typographyEdits(tt.in, quotesFor(tt.lang), &TypographyOptions{})
+		oxml.ApplyTextEdits(p.RawElement(), edits)
+		if got := newParagraph(p, nil).Text(); got != tt.want {
+			t.Errorf("%s: %q → %q, want %q", tt.lang, tt.in, got, tt.want)
+		}
+	}
+
+	if edits := typographyEdits(`"a" -- b...`, quotesFor("en"), &TypographyOptions{SkipQuotes: true, SkipDashes: true, SkipEllipses: true}); len(edits) != 0 {
+		t.Errorf("edits with everything skipped = %v", edits)
+	}
+}
+
+func TestDocument_ApplyTypography(t *testing.T) {
+	doc := mustNewDoc(t)
+	p, err := doc.AddParagraph(`She said "`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := p.AddRun(`stop"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SetBold(Ptr(true)); err != nil {
+		t.Fatal(err)
+	}
+	de, err := doc.AddParagraph(`"Halt"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rPr := de.Runs()[0].r.GetOrAddRPr().RawElement()
+	rPr.CreateElement("w:lang").CreateAttr("w:val", "de-DE")
+
+	n, err := doc.ApplyTypography(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 4 {
+		t.Errorf("ApplyTypography() = %d, want 4", n)
+	}
+	if got := p.Text(); got != "She said “stop”" {
+		t.Errorf("Text() = %q", got)
+	}
+	if runs := p.Runs(); len(runs) != 2 || runs[1].Text() != "stop”" {
+		t.Errorf("runs not kept: %d", len(runs))
+	}
+	if got := de.Text(); got != "„Halt“" {
+		t.Errorf("German Text() = %q", got)
+	}
+}
@@ -0,0 +1,253 @@
+package docx
+
+import (
+	"errors"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx/oxml"
+)
+
+// SkipChildren can be returned by the Table, Paragraph and Run callbacks
+// of a Visitor to skip the content of the item: the cells of a table, or
+// the runs, fields and images of a paragraph or run. Walk goes on with the
+// next item.
+var SkipChildren = errors.New("docx: skip children")
+
+// StopWalk can be returned by a Visitor callback to end Walk early. Walk
+// then returns nil.
+var StopWalk = errors.New("docx: stop walk")
+
+// Visitor holds the callbacks Walk calls for the content of a document.
+// Any callback may be nil. A callback returning an error other than
+// SkipChildren or StopWalk ends Walk, which returns that error.
+type Visitor struct {
+	// Section is called at the start of each section, before its first
+	// block, and for a trailing section with no content.
+	Section func(s *Section) error
+	// Paragraph is called for each paragraph, including those in tables,
+	// block content controls and text boxes.
+	Paragraph func(p *Paragraph) error
+	// Table is called for each table, before the content of its cells;
+	// nested tables included.
+	Table func(t *Table) error
+	// Run is called for each run of a paragraph, including runs in
+	// hyperlinks, inline content controls and field results. Deleted runs
+	// are not visited.
+	Run func(r *Run) error
+	// Field is called for each field, before the run or element that
+	// starts it.
+	Field func(f *Field) error
+	// Image is called for each DrawingML drawing holding a picture, after
+	// the run containing it.
+	Image func(d *Drawing) error
+}
+
+// Walk calls the callbacks of v for the content of the document body in
+// document order, without collecting it first. Fallback content and
+// deleted runs are skipped.
+func (d *Document) Walk(v *Visitor) error {
+	b, err := d.getBody()
+	if err != nil {
+		return err
+	}
+	w := &walker{d: d, v: v, sections: d.element.SectPrList(), fields: map[*etree.Element]*oxml.FieldSpan{}}
+	if v.Field != nil {
+		for _, span := range oxml.ScanFields(b.element) {
+			start := span.Simple
+			if start == nil {
+				start = span.Begin
+			}
+			w.fields[start] = span
+		}
+	}
+	err = w.body(b.element)
+	if errors.Is(err, StopWalk) {
+		return nil
+	}
+	return err
+}
+
+// walker holds the state of one Walk.
+type walker struct {
+	d        *Document
+	v        *Visitor
+	sections []*oxml.CT_SectPr
+	next     int  // index of the next section to report
+	inSect   bool // whether the current section has been reported
+	fields   map[*etree.Element]*oxml.FieldSpan
+}
+
+// descendAfter applies the SkipChildren convention to the result of a
+// callback: it reports whether to visit the children, and the error that
+// ends the walk.
+func descendAfter(err error) (bool, error) {
+	if errors.Is(err, SkipChildren) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// body walks the body blocks, reporting sections as they start.
+func (w *walker) body(body *etree.Element) error {
+	for _, child := range body.ChildElements() {
+		if child.Space == "w" && child.Tag == "sectPr" {
+			continue
+		}
+		if err := w.startSection(); err != nil {
+			return err
+		}
+		if err := w.block(child); err != nil {
+			return err
+		}
+		if child.Space == "w" && child.Tag == "p" && findParagraphSectPr(child) != nil {
+			w.inSect = false
+		}
+	}
+	for w.next < len(w.sections) {
+		if err := w.startSection(); err != nil {
+			return err
+		}
+		w.inSect = false
+	}
+	return nil
+}
+
+// startSection reports the next section unless the current one has been.
+func (w *walker) startSection() error {
+	if w.inSect || w.next >= len(w.sections) {
+		return nil
+	}
+	w.inSect = true
+	sectPr := w.sections[w.next]
+	w.next++
+	if w.v.Section == nil {
+		return nil
+	}
+	if err := w.v.Section(newSection(sectPr, w.d.part)); !errors.Is(err, SkipChildren) {
+		return err
+	}
+	return nil
+}
+
+// block walks a block-level element: a paragraph, a table, or a container
+// of blocks such as a content control.
+func (w *walker) block(el *etree.Element) error {
+	switch {
+	case el.Space == "mc" && el.Tag == "Fallback", el.Space == "w" && el.Tag == "del":
+		return nil
+	case el.Space == "w" && el.Tag == "p":
+		return w.paragraph(el)
+	case el.Space == "w" && el.Tag == "tbl":
+		if w.v.Table != nil {
+			tbl := newTable(&oxml.CT_Tbl{Element: oxml.WrapElement(el)}, &w.d.part.StoryPart)
+			if descend, err := descendAfter(w.v.Table(tbl)); !descend {
+				return err
+			}
+		}
+	case el.Space == "w" && (el.Tag == "pPr" || el.Tag == "sdtPr" || el.Tag == "tblPr" ||
+		el.Tag == "tblGrid" || el.Tag == "trPr" || el.Tag == "tcPr"):
+		return nil
+	}
+	for _, child := range el.ChildElements() {
+		if err := w.block(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// paragraph walks a paragraph and its inline content.
+func (w *walker) paragraph(el *etree.Element) error {
+	if w.v.Paragraph != nil {
+		para := newParagraph(&oxml.CT_P{Element: oxml.WrapElement(el)}, &w.d.part.StoryPart)
+		if descend, err := descendAfter(w.v.Paragraph(para)); !descend {
+			return err
+		}
+	}
+	return w.inline(el)
+}
+
+// inline walks the runs and fields under paragraph content el.
+func (w *walker) inline(el *etree.Element) error {
+	for _, child := range el.ChildElements() {
+		if err := w.field(child); err != nil {
+			return err
+		}
+		switch {
+		case child.Space == "mc" && child.Tag == "Fallback",
+			child.Space == "w" && (child.Tag == "del" || child.Tag == "moveFrom" || child.Tag == "pPr"):
+		case child.Space == "w" && child.Tag == "r":
+			if err := w.run(child); err != nil {
+				return err
+			}
+		default:
+			if err := w.inline(child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// run walks a run, its fields, its pictures and the paragraphs of its text
+// boxes.
+func (w *walker) run(el *etree.Element) error {
+	for _, child := range el.ChildElements() {
+		if child.Space == "w" && child.Tag == "fldChar" {
+			if err := w.field(child); err != nil {
+				return err
+			}
+		}
+	}
+	if w.v.Run != nil {
+		run := newRun(&oxml.CT_R{Element: oxml.WrapElement(el)}, &w.d.part.StoryPart)
+		if descend, err := descendAfter(w.v.Run(run)); !descend {
+			return err
+		}
+	}
+	for _, child := range el.ChildElements() {
+		if child.Space == "w" && child.Tag == "drawing" && w.v.Image != nil {
+			dr := newDrawing(&oxml.CT_Drawing{Element: oxml.WrapElement(child)}, &w.d.part.StoryPart)
+			if dr.HasPicture() {
+				if err := w.v.Image(dr); err != nil && !errors.Is(err, SkipChildren) {
+					return err
+				}
+			}
+		}
+		if err := w.textBoxes(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// textBoxes walks the blocks of the text boxes under el.
+func (w *walker) textBoxes(el *etree.Element) error {
+	for _, child := range el.ChildElements() {
+		var err error
+		switch {
+		case child.Space == "mc" && child.Tag == "Fallback":
+		case child.Space == "w" && child.Tag == "txbxContent":
+			err = w.block(child)
+		default:
+			err = w.textBoxes(child)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// field reports the field starting at el, if any.
+func (w *walker) field(el *etree.Element) error {
+	span := w.fields[el]
+	if span == nil {
+		return nil
+	}
+	err := w.v.Field(&Field{span: span, part: &w.d.part.StoryPart})
+	if errors.Is(err, SkipChildren) {
+		return nil
+	}
+	return err
+}
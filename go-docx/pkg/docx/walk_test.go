@@ -0,0 +1,106 @@
+package docx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/vortex/go-docx/pkg/docx/enum"
+)
+
+// walkDocument holds two sections: a paragraph with a field and a picture,
+// then a table, then a trailing paragraph.
+func walkDocument(t *testing.T) *Document {
+	t.Helper()
+	d := mustNewDoc(t)
+	p, err := d.AddParagraph("first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.AddField("PAGE", "1"); err != nil {
+		t.Fatal(err)
+	}
+	r, err := p.AddRun("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.AddPicture(bytes.NewReader(minimalPNG()), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.AddSection(enum.WdSectionStartNewPage); err != nil {
+		t.Fatal(err)
+	}
+	tbl, err := d.AddTable(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := tbl.CellAt(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetText("cell")
+	if _, err := d.AddParagraph("last"); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestWalk(t *testing.T) {
+	d := walkDocument(t)
+	var events []string
+	log := func(format string, args ...any) { events = append(events, fmt.Sprintf(format, args...)) }
+	err := d.Walk(&Visitor{
+		Section:   func(s *Section) error { log("section"); return nil },
+		Paragraph: func(p *Paragraph) error { log("p %q", p.Text()); return nil },
+		Table:     func(*Table) error { log("table"); return nil },
+		Run:       func(r *Run) error { log("r %q", r.Text()); return nil },
+		Field:     func(f *Field) error { log("field %s", f.Type()); return nil },
+		Image:     func(*Drawing) error { log("image"); return nil },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"section",
+		`p "first1"`, `r "first"`, "field PAGE", `r ""`, `r ""`, `r ""`, `r "1"`, `r ""`, `r ""`, "image",
+		// AddSection ends the first section with an empty paragraph.
+		`p ""`,
+		"section",
+		"table", `p "cell"`, `r "cell"`,
+		`p "last"`, `r "last"`,
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("events =\n%q\nwant\n%q", events, want)
+	}
+}
+
+func TestWalk_SkipAndStop(t *testing.T) {
+	d := walkDocument(t)
+	var paras []string
+	err := d.Walk(&Visitor{
+		Table: func(*Table) error { return SkipChildren },
+		Paragraph: func(p *Paragraph) error {
+			paras = append(paras, p.Text())
+			if p.Text() == "last" {
+				return StopWalk
+			}
+			return SkipChildren
+		},
+		Run: func(*Run) error { t.Error("run visited"); return nil },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"first1", "", "last"}; !reflect.DeepEqual(paras, want) {
+		t.Errorf("paragraphs = %q, want %q", paras, want)
+	}
+
+	boom := errors.New("boom")
+	n := 0
+	err = d.Walk(&Visitor{Run: func(*Run) error { n++; return boom }})
+	if !errors.Is(err, boom) || n != 1 {
+		t.Errorf("err = %v after %d runs", err, n)
+	}
+}
@@ -0,0 +1,197 @@
+package docx
+
+import (
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// TrimOptions configures TrimEmptyParagraphs. The zero value collapses
+// every run of empty paragraphs into one and removes those at the ends.
+type TrimOptions struct {
+	// MaxEmpty is the most empty paragraphs left in a row. Zero means 1;
+	// a negative value removes all empty paragraphs.
+	MaxEmpty int
+	// KeepEnds keeps the empty paragraphs at the start and end of the
+	// body and of table cells, which are otherwise removed.
+	KeepEnds bool
+	// SkipWhitespace leaves trailing spaces and tabs and empty runs as
+	// they are, instead of first normalizing every paragraph with
+	// Paragraph.NormalizeWhitespace.
+	SkipWhitespace bool
+}
+
+// NormalizeWhitespace removes the spaces and tabs at the end of the
+// paragraph, and the runs and text elements left empty, as template
+// merges tend to leave behind. Runs of tracked changes are left alone,
+// and so is whitespace before a break, picture or field ending the
+// paragraph. It reports whether it changed the paragraph.
+func (para *Paragraph) NormalizeWhitespace() bool {
+	return normalizeWhitespace(para.p.RawElement())
+}
+
+// normalizeWhitespace is Paragraph.NormalizeWhitespace on paragraph p.
+func normalizeWhitespace(p *etree.Element) bool {
+	runs := paragraphRunElements(p)
+	changed := false
+trailing:
+	for i := len(runs) - 1; i >= 0; i-- {
+		children := runs[i].ChildElements()
+		for j := len(children) - 1; j >= 0; j-- {
+			c := children[j]
+			switch {
+			case c.Space == "w" && c.Tag == "rPr":
+			case c.Space == "w" && c.Tag == "tab":
+				runs[i].RemoveChild(c)
+				changed = true
+			case c.Space == "w" && c.Tag == "t":
+				text := strings.TrimRight(c.Text(), " \t")
+				if text != c.Text() {
+					c.SetText(text)
+					changed = true
+				}
+				if text != "" {
+					break trailing
+				}
+			default:
+				break trailing
+			}
+		}
+	}
+	for _, r := range runs {
+		empty := true
+		for _, c := range r.ChildElements() {
+			switch {
+			case c.Space == "w" && c.Tag == "t" && c.Text() == "":
+				r.RemoveChild(c)
+				changed = true
+			case c.Space != "w" || c.Tag != "rPr":
+				empty = false
+			}
+		}
+		if empty {
+			r.Parent().RemoveChild(r)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// paragraphRunElements returns the runs of paragraph p, in document
+// order: its own and those of its hyperlinks and simple fields.
+func paragraphRunElements(p *etree.Element) []*etree.Element {
+	var runs []*etree.Element
+	for _, c := range p.ChildElements() {
+		switch {
+		case c.Space == "w" && c.Tag == "r":
+			runs = append(runs, c)
+		case c.Space == "w" && (c.Tag == "hyperlink" || c.Tag == "fldSimple"):
+			runs = append(runs, c.SelectElements("w:r")...)
+		}
+	}
+	return runs
+}
+
+// TrimEmptyParagraphs cleans up the paragraphs of the body and its tables
+// after a template merge: it normalizes their whitespace (see
+// Paragraph.NormalizeWhitespace), then removes empty paragraphs beyond
+// TrimOptions.MaxEmpty in a row, and those at the start and end of the
+// body and of each cell. A paragraph is empty when it has nothing but
+// paragraph properties; paragraphs ending a section or showing a list
+// number are never removed, nor is the last paragraph of a cell. opts may
+// be nil. It returns the number of paragraphs removed.
+func (d *Document) TrimEmptyParagraphs(opts *TrimOptions) (int, error) {
+	if opts == nil {
+		opts = &TrimOptions{}
+	}
+	b, err := d.getBody()
+	if err != nil {
+		return 0, err
+	}
+	maxEmpty := opts.MaxEmpty
+	if maxEmpty == 0 {
+		maxEmpty = 1
+	} else if maxEmpty < 0 {
+		maxEmpty = 0
+	}
+	return trimEmptyParagraphs(b.element, maxEmpty, opts, false), nil
+}
+
+// trimEmptyParagraphs trims the block container el, a w:body or w:tc, and
+// the cells of its tables, and returns the number of paragraphs removed.
+func trimEmptyParagraphs(el *etree.Element, maxEmpty int, opts *TrimOptions, cell bool) int {
+	removed := 0
+	var blocks []*etree.Element
+	for _, c := range el.ChildElements() {
+		switch {
+		case c.Space == "w" && c.Tag == "p":
+			if !opts.SkipWhitespace {
+				normalizeWhitespace(c)
+			}
+			blocks = append(blocks, c)
+		case c.Space == "w" && c.Tag == "tbl":
+			for _, tr := range c.SelectElements("w:tr") {
+				for _, tc := range tr.SelectElements("w:tc") {
+					removed += trimEmptyParagraphs(tc, maxEmpty, opts, true)
+				}
+			}
+			blocks = append(blocks, c)
+		}
+	}
+
+	drop := make([]bool, len(blocks))
+	for i := 0; i < len(blocks); {
+		if !isEmptyParagraph(blocks[i]) {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(blocks) && isEmptyParagraph(blocks[j]) {
+			j++
+		}
+		keep := maxEmpty
+		if !opts.KeepEnds && (i == 0 || j == len(blocks)) {
+			keep = 0
+		}
+		for k := i + keep; k < j; k++ {
+			drop[k] = true
+		}
+		i = j
+	}
+	if last := len(blocks) - 1; cell && last >= 0 && drop[last] {
+		// A cell must end with a paragraph.
+		kept := -1
+		for i := last; i >= 0 && kept < 0; i-- {
+			if !drop[i] {
+				kept = i
+			}
+		}
+		if kept < 0 || blocks[kept].Tag != "p" {
+			drop[last] = false
+		}
+	}
+	for i, blk := range blocks {
+		if drop[i] {
+			el.RemoveChild(blk)
+			removed++
+		}
+	}
+	return removed
+}
+
+// isEmptyParagraph reports whether el is a paragraph with nothing but
+// properties, other than a section break or a list number.
+func isEmptyParagraph(el *etree.Element) bool {
+	if el.Space != "w" || el.Tag != "p" {
+		return false
+	}
+	for _, c := range el.ChildElements() {
+		if c.Space != "w" || c.Tag != "pPr" {
+			return false
+		}
+		if c.SelectElement("w:sectPr") != nil || c.SelectElement("w:numPr") != nil {
+			return false
+		}
+	}
+	return true
+}
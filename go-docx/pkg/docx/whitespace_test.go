@@ -0,0 +1,101 @@
+package docx
+
+import (
+	"testing"
+)
+
+func TestParagraph_NormalizeWhitespace(t *testing.T) {
+	p := newParagraph(makeP(t,
+		`<w:r><w:t>a </w:t></w:r>`+
+			`<w:r><w:rPr><w:b/></w:rPr><w:t></w:t></w:r>`+
+			`<w:r><w:t>b</w:t><w:t xml:space="preserve">  </w:t></w:r>`+
+			`<w:hyperlink><w:r><w:tab/><w:t xml:space="preserve"> </w:t></w:r></w:hyperlink>`+
+			`<w:r><w:tab/></w:r>`), nil)
+	if !p.NormalizeWhitespace() {
+		t.Fatal("NormalizeWhitespace() = false, want true")
+	}
+	if got := p.Text(); got != "a b" {
+		t.Errorf("Text() = %q, want %q", got, "a b")
+	}
+	if n := len(p.p.RawElement().SelectElements("w:r")); n != 2 {
+		t.Errorf("runs = %d, want 2", n)
+	}
+	if p.NormalizeWhitespace() {
+		t.Error("second NormalizeWhitespace() = true, want false")
+	}
+
+	brk := newParagraph(makeP(t, `<w:r><w:t xml:space="preserve">a </w:t><w:br w:type="page"/></w:r>`), nil)
+	if brk.NormalizeWhitespace() {
+		t.Error("whitespace before a trailing break should be kept")
+	}
+}
+
+func TestDocument_TrimEmptyParagraphs(t *testing.T) {
+	build := func() *Document {
+		doc := mustNewDoc(t)
+		for _, text := range []string{"", "a\t", "", " ", "", "b"} {
+			if _, err := doc.AddParagraph(text); err != nil {
+				t.Fatal(err)
+			}
+		}
+		tbl, err := doc.AddTable(1, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cell, err := tbl.CellAt(0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range 2 {
+			if _, err := cell.AddParagraph(""); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, text := range []string{"c", "", ""} {
+			if _, err := doc.AddParagraph(text); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return doc
+	}
+
+	for _, tt := range []struct {
+		name    string
+		opts    *TrimOptions
+		want    []string
+		removed int
+	}{
+		{"default", nil, []string{"a", "", "b", "c"}, 7},
+		{"remove all", &TrimOptions{MaxEmpty: -1}, []string{"a", "b", "c"}, 8},
+		{"keep ends", &TrimOptions{KeepEnds: true}, []string{"", "a", "", "b", "c", ""}, 5},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := build()
+			removed, err := doc.TrimEmptyParagraphs(tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := bodyTexts(t, doc)
+			if len(got) != len(tt.want) {
+				t.Fatalf("paragraphs = %q, want %q", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("paragraphs = %q, want %q", got, tt.want)
+					break
+				}
+			}
+			if removed != tt.removed {
+				t.Errorf("removed = %d, want %d", removed, tt.removed)
+			}
+			tables, err := doc.Tables()
+			if err != nil {
+				t.Fatal(err)
+			}
+			cell, _ := tables[0].CellAt(0, 0)
+			if n := len(cell.Paragraphs()); n < 1 {
+				t.Error("cell left without a paragraph")
+			}
+		})
+	}
+}
@@ -26,7 +26,7 @@ import (
 
 // TestCase is one generated document.
 type TestCase struct {
-	Name string                       // output filename (without .docx)
+	Name string                         // output filename (without .docx)
 	Gen  func() (*docx.Document, error) // generator
 }
 
@@ -38,12 +38,6 @@ type FileResult struct {
 	Elapsed string `json:"elapsed"`
 }
 
-// helpers
-func boolPtr(v bool) *bool                { return &v }
-func intPtr(v int) *int                   { return &v }
-func strPtr(v string) *string             { return &v }
-func int64Ptr(v int64) *int64             { return &v }
-
 func main() {
 	outputDir := flag.String("output", "", "directory for generated .docx files")
 	flag.Parse()
@@ -214,7 +208,7 @@ func genFontBasic() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := r1.SetBold(boolPtr(true)); err != nil {
+	if err := r1.SetBold(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 
@@ -223,7 +217,7 @@ func genFontBasic() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := r2.SetItalic(boolPtr(true)); err != nil {
+	if err := r2.SetItalic(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 
@@ -242,10 +236,10 @@ func genFontBasic() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := r4.SetBold(boolPtr(true)); err != nil {
+	if err := r4.SetBold(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
-	if err := r4.SetItalic(boolPtr(true)); err != nil {
+	if err := r4.SetItalic(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 
@@ -254,10 +248,10 @@ func genFontBasic() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := r5.SetBold(boolPtr(true)); err != nil {
+	if err := r5.SetBold(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
-	if err := r5.SetItalic(boolPtr(true)); err != nil {
+	if err := r5.SetItalic(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 	if err := r5.SetUnderline(&u); err != nil {
@@ -278,19 +272,19 @@ func genFontAdvanced() (*docx.Document, error) {
 	}
 
 	type fontTest struct {
-		label  string
-		apply  func(f *docx.Font) error
+		label string
+		apply func(f *docx.Font) error
 	}
 	tests := []fontTest{
-		{"Strikethrough", func(f *docx.Font) error { return f.SetStrike(boolPtr(true)) }},
-		{"Double Strikethrough", func(f *docx.Font) error { return f.SetDoubleStrike(boolPtr(true)) }},
-		{"ALL CAPS", func(f *docx.Font) error { return f.SetAllCaps(boolPtr(true)) }},
-		{"Small Caps", func(f *docx.Font) error { return f.SetSmallCaps(boolPtr(true)) }},
-		{"Shadow", func(f *docx.Font) error { return f.SetShadow(boolPtr(true)) }},
-		{"Emboss", func(f *docx.Font) error { return f.SetEmboss(boolPtr(true)) }},
-		{"Outline", func(f *docx.Font) error { return f.SetOutline(boolPtr(true)) }},
-		{"Imprint (engrave)", func(f *docx.Font) error { return f.SetImprint(boolPtr(true)) }},
-		{"Hidden text", func(f *docx.Font) error { return f.SetHidden(boolPtr(true)) }},
+		{"Strikethrough", func(f *docx.Font) error { return f.SetStrike(docx.Ptr(true)) }},
+		{"Double Strikethrough", func(f *docx.Font) error { return f.SetDoubleStrike(docx.Ptr(true)) }},
+		{"ALL CAPS", func(f *docx.Font) error { return f.SetAllCaps(docx.Ptr(true)) }},
+		{"Small Caps", func(f *docx.Font) error { return f.SetSmallCaps(docx.Ptr(true)) }},
+		{"Shadow", func(f *docx.Font) error { return f.SetShadow(docx.Ptr(true)) }},
+		{"Emboss", func(f *docx.Font) error { return f.SetEmboss(docx.Ptr(true)) }},
+		{"Outline", func(f *docx.Font) error { return f.SetOutline(docx.Ptr(true)) }},
+		{"Imprint (engrave)", func(f *docx.Font) error { return f.SetImprint(docx.Ptr(true)) }},
+		{"Hidden text", func(f *docx.Font) error { return f.SetHidden(docx.Ptr(true)) }},
 	}
 
 	for _, tt := range tests {
@@ -320,7 +314,7 @@ func genFontColor() (*docx.Document, error) {
 	}
 
 	colors := []struct {
-		label string
+		label   string
 		r, g, b byte
 	}{
 		{"Red text", 0xFF, 0x00, 0x00},
@@ -439,7 +433,7 @@ func genParagraphIndent() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p1.ParagraphFormat().SetLeftIndent(intPtr(720)); err != nil {
+	if err := p1.ParagraphFormat().SetLeftIndentLength(docx.Ptr(docx.Twips(720))); err != nil {
 		return nil, err
 	}
 
@@ -448,7 +442,7 @@ func genParagraphIndent() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p2.ParagraphFormat().SetRightIndent(intPtr(720)); err != nil {
+	if err := p2.ParagraphFormat().SetRightIndentLength(docx.Ptr(docx.Twips(720))); err != nil {
 		return nil, err
 	}
 
@@ -457,10 +451,10 @@ func genParagraphIndent() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p3.ParagraphFormat().SetLeftIndent(intPtr(1440)); err != nil {
+	if err := p3.ParagraphFormat().SetLeftIndentLength(docx.Ptr(docx.Twips(1440))); err != nil {
 		return nil, err
 	}
-	if err := p3.ParagraphFormat().SetRightIndent(intPtr(1440)); err != nil {
+	if err := p3.ParagraphFormat().SetRightIndentLength(docx.Ptr(docx.Twips(1440))); err != nil {
 		return nil, err
 	}
 
@@ -469,7 +463,7 @@ func genParagraphIndent() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p4.ParagraphFormat().SetFirstLineIndent(intPtr(360)); err != nil {
+	if err := p4.ParagraphFormat().SetFirstLineIndentLength(docx.Ptr(docx.Twips(360))); err != nil {
 		return nil, err
 	}
 
@@ -478,10 +472,10 @@ func genParagraphIndent() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p5.ParagraphFormat().SetLeftIndent(intPtr(720)); err != nil {
+	if err := p5.ParagraphFormat().SetLeftIndentLength(docx.Ptr(docx.Twips(720))); err != nil {
 		return nil, err
 	}
-	if err := p5.ParagraphFormat().SetFirstLineIndent(intPtr(-360)); err != nil {
+	if err := p5.ParagraphFormat().SetFirstLineIndentLength(docx.Ptr(docx.Twips(-360))); err != nil {
 		return nil, err
 	}
 
@@ -502,7 +496,7 @@ func genParagraphSpacing() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p1.ParagraphFormat().SetSpaceBefore(intPtr(480)); err != nil {
+	if err := p1.ParagraphFormat().SetSpaceBeforeLength(docx.Ptr(docx.Twips(480))); err != nil {
 		return nil, err
 	}
 
@@ -510,7 +504,7 @@ func genParagraphSpacing() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p2.ParagraphFormat().SetSpaceAfter(intPtr(480)); err != nil {
+	if err := p2.ParagraphFormat().SetSpaceAfterLength(docx.Ptr(docx.Twips(480))); err != nil {
 		return nil, err
 	}
 
@@ -518,10 +512,10 @@ func genParagraphSpacing() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p3.ParagraphFormat().SetSpaceBefore(intPtr(240)); err != nil {
+	if err := p3.ParagraphFormat().SetSpaceBeforeLength(docx.Ptr(docx.Twips(240))); err != nil {
 		return nil, err
 	}
-	if err := p3.ParagraphFormat().SetSpaceAfter(intPtr(240)); err != nil {
+	if err := p3.ParagraphFormat().SetSpaceAfterLength(docx.Ptr(docx.Twips(240))); err != nil {
 		return nil, err
 	}
 
@@ -579,7 +573,7 @@ func genLineSpacing() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	ls4 := docx.LineSpacingTwips(360)
+	ls4 := docx.LineSpacingLength(docx.Twips(360))
 	if err := p4.ParagraphFormat().SetLineSpacing(&ls4); err != nil {
 		return nil, err
 	}
@@ -612,7 +606,7 @@ func genTabStops() (*docx.Document, error) {
 		return nil, err
 	}
 	ts1 := p1.ParagraphFormat().TabStops()
-	if _, err := ts1.AddTabStop(2880, enum.WdTabAlignmentLeft, enum.WdTabLeaderSpaces); err != nil {
+	if _, err := ts1.AddTabStopLength(docx.Twips(2880), enum.WdTabAlignmentLeft, enum.WdTabLeaderSpaces); err != nil {
 		return nil, err
 	}
 	r1, err := p1.AddRun("Before tab")
@@ -628,7 +622,7 @@ func genTabStops() (*docx.Document, error) {
 		return nil, err
 	}
 	ts2 := p2.ParagraphFormat().TabStops()
-	if _, err := ts2.AddTabStop(4680, enum.WdTabAlignmentCenter, enum.WdTabLeaderDots); err != nil {
+	if _, err := ts2.AddTabStopLength(docx.Twips(4680), enum.WdTabAlignmentCenter, enum.WdTabLeaderDots); err != nil {
 		return nil, err
 	}
 	r2, err := p2.AddRun("Item")
@@ -644,7 +638,7 @@ func genTabStops() (*docx.Document, error) {
 		return nil, err
 	}
 	ts3 := p3.ParagraphFormat().TabStops()
-	if _, err := ts3.AddTabStop(8640, enum.WdTabAlignmentRight, enum.WdTabLeaderDashes); err != nil {
+	if _, err := ts3.AddTabStopLength(docx.Twips(8640), enum.WdTabAlignmentRight, enum.WdTabLeaderDashes); err != nil {
 		return nil, err
 	}
 	r3, err := p3.AddRun("Left text")
@@ -660,7 +654,7 @@ func genTabStops() (*docx.Document, error) {
 		return nil, err
 	}
 	ts4 := p4.ParagraphFormat().TabStops()
-	if _, err := ts4.AddTabStop(4320, enum.WdTabAlignmentDecimal, enum.WdTabLeaderSpaces); err != nil {
+	if _, err := ts4.AddTabStopLength(docx.Twips(4320), enum.WdTabAlignmentDecimal, enum.WdTabLeaderSpaces); err != nil {
 		return nil, err
 	}
 	r4, err := p4.AddRun("")
@@ -703,7 +697,7 @@ func genPageBreaks() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p3.ParagraphFormat().SetPageBreakBefore(boolPtr(true)); err != nil {
+	if err := p3.ParagraphFormat().SetPageBreakBefore(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 
@@ -946,7 +940,7 @@ func genTableCellVerticalAlign() (*docx.Document, error) {
 	// Set row height
 	rows := tbl.Rows()
 	row, _ := rows.Get(0)
-	if err := row.SetHeight(intPtr(1440)); err != nil { // 1 inch
+	if err := row.SetHeightLength(docx.Ptr(docx.Twips(1440))); err != nil { // 1 inch
 		return nil, err
 	}
 	rule := enum.WdRowHeightRuleExactly
@@ -1006,7 +1000,7 @@ func genTableAddRowCol() (*docx.Document, error) {
 	}
 
 	// Add column
-	if _, err := tbl.AddColumn(1440); err != nil {
+	if _, err := tbl.AddColumnLength(docx.Inches(1)); err != nil {
 		return nil, err
 	}
 	// Fill new column
@@ -1073,10 +1067,10 @@ func genSectionLandscape() (*docx.Document, error) {
 			return nil, err
 		}
 		// Swap width and height for landscape
-		if err := sect.SetPageWidth(intPtr(docx.Inches(11).Twips())); err != nil {
+		if err := sect.SetPageWidthLength(docx.Ptr(docx.Inches(11))); err != nil {
 			return nil, err
 		}
-		if err := sect.SetPageHeight(intPtr(docx.Inches(8.5).Twips())); err != nil {
+		if err := sect.SetPageHeightLength(docx.Ptr(docx.Inches(8.5))); err != nil {
 			return nil, err
 		}
 	}
@@ -1099,16 +1093,16 @@ func genSectionMargins() (*docx.Document, error) {
 
 	sections := doc.Sections()
 	sect, _ := sections.Get(sections.Len() - 1)
-	if err := sect.SetTopMargin(intPtr(docx.Inches(2).Twips())); err != nil {
+	if err := sect.SetTopMarginLength(docx.Ptr(docx.Inches(2))); err != nil {
 		return nil, err
 	}
-	if err := sect.SetBottomMargin(intPtr(docx.Inches(2).Twips())); err != nil {
+	if err := sect.SetBottomMarginLength(docx.Ptr(docx.Inches(2))); err != nil {
 		return nil, err
 	}
-	if err := sect.SetLeftMargin(intPtr(docx.Inches(1.5).Twips())); err != nil {
+	if err := sect.SetLeftMarginLength(docx.Ptr(docx.Inches(1.5))); err != nil {
 		return nil, err
 	}
-	if err := sect.SetRightMargin(intPtr(docx.Inches(1.5).Twips())); err != nil {
+	if err := sect.SetRightMarginLength(docx.Ptr(docx.Inches(1.5))); err != nil {
 		return nil, err
 	}
 
@@ -1225,7 +1219,7 @@ func genComments() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if _, err := doc.AddComment([]*docx.Run{run1}, "This is an important comment.", "Reviewer", strPtr("R")); err != nil {
+	if _, err := doc.AddComment([]*docx.Run{run1}, "This is an important comment.", "Reviewer", docx.Ptr("R")); err != nil {
 		return nil, err
 	}
 
@@ -1233,7 +1227,7 @@ func genComments() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if _, err := doc.AddComment([]*docx.Run{run2}, "Another comment by a different author.", "Editor", strPtr("E")); err != nil {
+	if _, err := doc.AddComment([]*docx.Run{run2}, "Another comment by a different author.", "Editor", docx.Ptr("E")); err != nil {
 		return nil, err
 	}
 
@@ -1314,17 +1308,17 @@ func genCustomStyles() (*docx.Document, error) {
 	if err := ps.Font().SetSize(&sz); err != nil {
 		return nil, err
 	}
-	if err := ps.Font().SetBold(boolPtr(true)); err != nil {
+	if err := ps.Font().SetBold(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 	rgb := docx.NewRGBColor(0x00, 0x66, 0xCC)
 	if err := ps.Font().Color().SetRGB(&rgb); err != nil {
 		return nil, err
 	}
-	if err := ps.ParagraphFormat().SetSpaceBefore(intPtr(240)); err != nil {
+	if err := ps.ParagraphFormat().SetSpaceBeforeLength(docx.Ptr(docx.Twips(240))); err != nil {
 		return nil, err
 	}
-	if err := ps.ParagraphFormat().SetSpaceAfter(intPtr(120)); err != nil {
+	if err := ps.ParagraphFormat().SetSpaceAfterLength(docx.Ptr(docx.Twips(120))); err != nil {
 		return nil, err
 	}
 
@@ -1333,7 +1327,7 @@ func genCustomStyles() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := cs.Font().SetItalic(boolPtr(true)); err != nil {
+	if err := cs.Font().SetItalic(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 	rgb2 := docx.NewRGBColor(0xCC, 0x00, 0x00)
@@ -1450,7 +1444,7 @@ func genParagraphFormatFlow() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p1.ParagraphFormat().SetWidowControl(boolPtr(true)); err != nil {
+	if err := p1.ParagraphFormat().SetWidowControl(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 
@@ -1458,7 +1452,7 @@ func genParagraphFormatFlow() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p2.ParagraphFormat().SetKeepTogether(boolPtr(true)); err != nil {
+	if err := p2.ParagraphFormat().SetKeepTogether(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 
@@ -1466,7 +1460,7 @@ func genParagraphFormatFlow() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := p3.ParagraphFormat().SetKeepWithNext(boolPtr(true)); err != nil {
+	if err := p3.ParagraphFormat().SetKeepWithNext(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 	if _, err := doc.AddParagraph("This paragraph follows the keep-with-next paragraph."); err != nil {
@@ -1680,7 +1674,7 @@ func genTableRowHeight() (*docx.Document, error) {
 	rows := tbl.Rows()
 	for i, h := range heights {
 		row, _ := rows.Get(i)
-		if err := row.SetHeight(intPtr(h.twips)); err != nil {
+		if err := row.SetHeightLength(docx.Ptr(docx.Twips(float64(h.twips)))); err != nil {
 			return nil, err
 		}
 		if err := row.SetHeightRule(&h.rule); err != nil {
@@ -1717,7 +1711,7 @@ func genTableColumnWidth() (*docx.Document, error) {
 	widths := []int{1440, 2880, 4320} // 1", 2", 3"
 	for i, w := range widths {
 		col, _ := cols.Get(i)
-		if err := col.SetWidth(intPtr(w)); err != nil {
+		if err := col.SetWidthLength(docx.Ptr(docx.Twips(float64(w)))); err != nil {
 			return nil, err
 		}
 	}
@@ -1747,10 +1741,10 @@ func genSectionHeaderDistance() (*docx.Document, error) {
 
 	sections := doc.Sections()
 	sect, _ := sections.Get(sections.Len() - 1)
-	if err := sect.SetHeaderDistance(intPtr(docx.Inches(0.3).Twips())); err != nil {
+	if err := sect.SetHeaderDistanceLength(docx.Ptr(docx.Inches(0.3))); err != nil {
 		return nil, err
 	}
-	if err := sect.SetFooterDistance(intPtr(docx.Inches(0.3).Twips())); err != nil {
+	if err := sect.SetFooterDistanceLength(docx.Ptr(docx.Inches(0.3))); err != nil {
 		return nil, err
 	}
 
@@ -1865,7 +1859,7 @@ func genMultipleRuns() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := r1.SetBold(boolPtr(true)); err != nil {
+	if err := r1.SetBold(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 	rgb1 := docx.NewRGBColor(0xFF, 0, 0)
@@ -1877,7 +1871,7 @@ func genMultipleRuns() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := r2.SetItalic(boolPtr(true)); err != nil {
+	if err := r2.SetItalic(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 	rgb2 := docx.NewRGBColor(0, 0, 0xFF)
@@ -2024,7 +2018,7 @@ func genTableBidi() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := tbl.SetTableDirection(boolPtr(true)); err != nil {
+	if err := tbl.SetTableDirection(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 
@@ -2094,7 +2088,7 @@ func genFontSubSuperscript() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := r1.Font().SetSuperscript(boolPtr(true)); err != nil {
+	if err := r1.Font().SetSuperscript(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 
@@ -2110,7 +2104,7 @@ func genFontSubSuperscript() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := r2.Font().SetSubscript(boolPtr(true)); err != nil {
+	if err := r2.Font().SetSubscript(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 	if _, err := p2.AddRun("O"); err != nil {
@@ -2129,7 +2123,7 @@ func genFontSubSuperscript() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := rSup.Font().SetSuperscript(boolPtr(true)); err != nil {
+	if err := rSup.Font().SetSuperscript(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 	if _, err := p3.AddRun(" + y"); err != nil {
@@ -2139,7 +2133,7 @@ func genFontSubSuperscript() (*docx.Document, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := rSub.Font().SetSubscript(boolPtr(true)); err != nil {
+	if err := rSub.Font().SetSubscript(docx.Ptr(true)); err != nil {
 		return nil, err
 	}
 
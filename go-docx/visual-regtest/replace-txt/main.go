@@ -32,8 +32,6 @@ import (
 	"github.com/vortex/go-docx/pkg/docx/enum"
 )
 
-func boolPtr(v bool) *bool { return &v }
-
 // FileResult captures the outcome of one generation.
 type FileResult struct {
 	Name    string `json:"name"`
@@ -236,7 +234,7 @@ func buildBeforeDocument() (*docx.Document, error) {
 	// Primary header: highlighted text + table with placeholders
 	hdr := sect.Header()
 	buildHighlightedParagraph(hdr, "HEADER_PLACEHOLDER")
-	hdrTbl, err := hdr.AddTable(1, 2, 9000)
+	hdrTbl, err := hdr.AddTableLength(1, 2, docx.Twips(9000))
 	if err != nil {
 		return nil, err
 	}
@@ -248,7 +246,7 @@ func buildBeforeDocument() (*docx.Document, error) {
 	// Primary footer: highlighted text + table with placeholders
 	ftr := sect.Footer()
 	buildHighlightedParagraph(ftr, "FOOTER_PLACEHOLDER")
-	ftrTbl, err := ftr.AddTable(1, 2, 9000)
+	ftrTbl, err := ftr.AddTableLength(1, 2, docx.Twips(9000))
 	if err != nil {
 		return nil, err
 	}
@@ -272,11 +270,11 @@ func buildBeforeDocument() (*docx.Document, error) {
 	lp1, _ := doc.AddParagraph("")
 	addPlain(lp1, "How to read this document:  ")
 	r, _ := lp1.AddRun("Yellow highlight")
-	_ = r.SetBold(boolPtr(true))
+	_ = r.SetBold(docx.Ptr(true))
 	setHighlightYellow(r)
 	addPlain(lp1, " = placeholder (will be replaced).  ")
 	r, _ = lp1.AddRun("Green highlight")
-	_ = r.SetBold(boolPtr(true))
+	_ = r.SetBold(docx.Ptr(true))
 	setHighlightGreen(r)
 	addPlain(lp1, " = expected result.")
 
@@ -311,12 +309,12 @@ func buildBeforeDocument() (*docx.Document, error) {
 	p2, _ := doc.AddParagraph("")
 	addPlain(p2, "Before marker: ")
 	cr1, _ := p2.AddRun("CROSS")
-	_ = cr1.SetBold(boolPtr(true))
+	_ = cr1.SetBold(docx.Ptr(true))
 	c1 := docx.NewRGBColor(0xFF, 0, 0)
 	_ = cr1.Font().Color().SetRGB(&c1)
 	setHighlightYellow(cr1)
 	cr2, _ := p2.AddRun("RUN_RE")
-	_ = cr2.SetItalic(boolPtr(true))
+	_ = cr2.SetItalic(docx.Ptr(true))
 	c2 := docx.NewRGBColor(0, 0, 0xFF)
 	_ = cr2.Font().Color().SetRGB(&c2)
 	setHighlightYellow(cr2)
@@ -501,10 +499,10 @@ func buildBeforeDocument() (*docx.Document, error) {
 	p18, _ := doc.AddParagraph("")
 	addPlain(p18, "Before: ")
 	kr1, _ := p18.AddRun("КРОСС")
-	_ = kr1.SetBold(boolPtr(true))
+	_ = kr1.SetBold(docx.Ptr(true))
 	setHighlightYellow(kr1)
 	kr2, _ := p18.AddRun("РАН")
-	_ = kr2.SetItalic(boolPtr(true))
+	_ = kr2.SetItalic(docx.Ptr(true))
 	setHighlightYellow(kr2)
 	addPlain(p18, " — кросс-рановая кириллица")
 
@@ -627,10 +625,10 @@ func buildBeforeDocument() (*docx.Document, error) {
 
 	p28, _ := doc.AddParagraph("")
 	fr1, _ := p28.AddRun("Bold CELL_OLD text")
-	_ = fr1.SetBold(boolPtr(true))
+	_ = fr1.SetBold(docx.Ptr(true))
 	setHighlightYellow(fr1)
 	fr2, _ := p28.AddRun(" then italic text")
-	_ = fr2.SetItalic(boolPtr(true))
+	_ = fr2.SetItalic(docx.Ptr(true))
 
 	// ================================================================
 	// §29 — Replacement inside comment body
@@ -710,7 +708,7 @@ func spec(doc *docx.Document, old, new string) {
 	// Old value: yellow highlight + strikethrough + dark red
 	rOld, _ := p.AddRun(old)
 	setHighlightYellow(rOld)
-	_ = rOld.Font().SetStrike(boolPtr(true))
+	_ = rOld.Font().SetStrike(docx.Ptr(true))
 	_ = rOld.Font().Color().SetRGB(&colorDarkRed)
 
 	// Arrow
@@ -720,7 +718,7 @@ func spec(doc *docx.Document, old, new string) {
 	// New value: green highlight + bold + dark green
 	rNew, _ := p.AddRun(new)
 	setHighlightGreen(rNew)
-	_ = rNew.SetBold(boolPtr(true))
+	_ = rNew.SetBold(docx.Ptr(true))
 	_ = rNew.Font().Color().SetRGB(&colorDarkGreen)
 }
 
@@ -754,7 +752,7 @@ func addPlain(p *docx.Paragraph, text string) {
 
 func addBold(p *docx.Paragraph, text string) {
 	r, _ := p.AddRun(text)
-	_ = r.SetBold(boolPtr(true))
+	_ = r.SetBold(docx.Ptr(true))
 }
 
 func addHighlighted(p *docx.Paragraph, text string) {
@@ -10,6 +10,11 @@
 // Exit code 0  = all files processed (some may have had errors).
 // A per-file JSON manifest is written to --output/manifest.json so
 // downstream tools know which files succeeded and which failed.
+//
+// With --fidelity, each saved file is compared with its original and the
+// manifest records what the roundtrip lost: parts dropped, elements and
+// attributes lost, relationship changes. --output/fidelity.json then
+// counts the files showing each kind of loss.
 package main
 
 import (
@@ -24,6 +29,7 @@ import (
 	"time"
 
 	"github.com/vortex/go-docx/pkg/docx"
+	"github.com/vortex/go-docx/pkg/docx/opc"
 )
 
 // FileResult captures the outcome of one roundtrip.
@@ -32,12 +38,15 @@ type FileResult struct {
 	OK      bool   `json:"ok"`
 	Error   string `json:"error,omitempty"`
 	Elapsed string `json:"elapsed"`
+
+	Fidelity *opc.FidelityReport `json:"fidelity,omitempty"`
 }
 
 func main() {
 	inputDir := flag.String("input", "", "directory containing original .docx files")
 	outputDir := flag.String("output", "", "directory for roundtripped .docx files")
 	workers := flag.Int("workers", 8, "parallel workers")
+	fidelity := flag.Bool("fidelity", false, "compare each saved file with its original and report what was lost")
 	flag.Parse()
 
 	if *inputDir == "" || *outputDir == "" {
@@ -84,7 +93,7 @@ func main() {
 		go func() {
 			defer wg.Done()
 			for j := range jobs {
-				r := processFile(j.name, *inputDir, *outputDir)
+				r := processFile(j.name, *inputDir, *outputDir, *fidelity)
 				mu.Lock()
 				results = append(results, r)
 				mu.Unlock()
@@ -104,15 +113,27 @@ func main() {
 	}
 
 	okCount := 0
+	var summary opc.FidelitySummary
 	for _, r := range results {
 		if r.OK {
 			okCount++
 		}
+		if r.Fidelity != nil {
+			summary.Add(r.Fidelity)
+		}
 	}
 	log.Printf("done: %d/%d succeeded", okCount, len(results))
+
+	if *fidelity {
+		data, _ := json.MarshalIndent(summary, "", "  ")
+		if err := os.WriteFile(filepath.Join(*outputDir, "fidelity.json"), data, 0o644); err != nil {
+			log.Fatalf("writing fidelity summary: %v", err)
+		}
+		log.Printf("fidelity: %d/%d lossless", summary.Lossless, summary.Files)
+	}
 }
 
-func processFile(name, inputDir, outputDir string) FileResult {
+func processFile(name, inputDir, outputDir string, fidelity bool) FileResult {
 	start := time.Now()
 	srcPath := filepath.Join(inputDir, name)
 	dstPath := filepath.Join(outputDir, name)
@@ -126,5 +147,28 @@ func processFile(name, inputDir, outputDir string) FileResult {
 		return FileResult{Name: name, OK: false, Error: fmt.Sprintf("save: %v", err), Elapsed: time.Since(start).String()}
 	}
 
-	return FileResult{Name: name, OK: true, Elapsed: time.Since(start).String()}
+	r := FileResult{Name: name, OK: true}
+	if fidelity {
+		report, err := compareFiles(srcPath, dstPath)
+		if err != nil {
+			r.OK, r.Error = false, fmt.Sprintf("fidelity: %v", err)
+		}
+		r.Fidelity = report
+	}
+	r.Elapsed = time.Since(start).String()
+	return r
+}
+
+// compareFiles reports what the roundtrip file dstPath lost compared to
+// srcPath.
+func compareFiles(srcPath, dstPath string) (*opc.FidelityReport, error) {
+	original, err := os.ReadFile(srcPath)
+	if err != nil {
+		return nil, err
+	}
+	roundtrip, err := os.ReadFile(dstPath)
+	if err != nil {
+		return nil, err
+	}
+	return opc.CompareFidelity(original, roundtrip)
 }
@@ -5,6 +5,7 @@
 # Two roundtrip binaries are pre-built:
 #   /usr/local/bin/roundtrip-opc   (OPC layer)
 #   /usr/local/bin/roundtrip-docx  (docx layer)
+# and /usr/local/bin/structdiff, the structural comparison.
 #
 # Report output goes to /output (bind-mounted from the host).
 #
@@ -61,10 +62,22 @@ fi
 # --------------------------------------------------------------------------
 mkdir -p "${RT_DIR}"
 echo "[entrypoint] running ${LABEL} roundtrip …"
-"${ROUNDTRIP_BIN}" --input="${ORIG_DIR}" --output="${RT_DIR}" --workers="${WORKERS}"
+"${ROUNDTRIP_BIN}" --input="${ORIG_DIR}" --output="${RT_DIR}" --workers="${WORKERS}" --fidelity
+cp "${RT_DIR}/manifest.json" "${REPORT_DIR}/roundtrip.json"
+cp "${RT_DIR}/fidelity.json" "${REPORT_DIR}/fidelity.json"
 
 # --------------------------------------------------------------------------
-# Step 2: SSIM comparison + report.
+# Step 2: Structural comparison.
+# --------------------------------------------------------------------------
+echo "[entrypoint] running structural comparison …"
+/usr/local/bin/structdiff \
+    --original="${ORIG_DIR}" \
+    --roundtrip="${RT_DIR}" \
+    --report="${REPORT_DIR}/structdiff.json" \
+    || true  # differences are in the report
+
+# --------------------------------------------------------------------------
+# Step 3: SSIM comparison + report.
 # --------------------------------------------------------------------------
 echo "[entrypoint] running SSIM comparison …"
 python3 /opt/scripts/compare_ssim.py \
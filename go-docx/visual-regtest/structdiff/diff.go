@@ -0,0 +1,422 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/vortex/go-docx/pkg/docx"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// Difference is one semantic difference between two packages. Path is the
+// element path in the original part, or in the revised part for added
+// elements.
+type Difference struct {
+	Part    string `json:"part"`
+	Path    string `json:"path,omitempty"`
+	Message string `json:"message"`
+}
+
+func (d Difference) String() string {
+	if d.Path == "" {
+		return d.Part + ": " + d.Message
+	}
+	return d.Part + ": " + d.Path + ": " + d.Message
+}
+
+// Options selects what the comparison ignores.
+type Options struct {
+	// IgnoreAttrs and IgnoreElems are path.Match patterns for qualified
+	// names as written, such as "w:rsid*".
+	IgnoreAttrs []string
+	IgnoreElems []string
+	// MaxPerPart limits the differences reported for one part; the rest
+	// are summed up in a final difference.
+	MaxPerPart int
+}
+
+// DefaultIgnoreAttrs are attributes that Word rewrites on every save.
+var DefaultIgnoreAttrs = []string{"w:rsid*", "w14:paraId", "w14:textId"}
+
+// DefaultIgnoreElems are elements that record editing sessions, proofing
+// state, layout caches and save times rather than content.
+var DefaultIgnoreElems = []string{
+	"w:rsids", "w:proofErr", "w:lastRenderedPageBreak",
+	"dcterms:created", "dcterms:modified", "cp:lastModifiedBy", "cp:revision",
+	"TotalTime", "Application", "AppVersion",
+}
+
+// differ compares the parts of two documents.
+type differ struct {
+	opts   *Options
+	hashes map[*etree.Element]uint64
+	diffs  []Difference
+	part   string
+	count  int // differences found in the current part
+}
+
+// Compare returns the differences between the packages of a and b: parts
+// present in only one of them, content types, relationships, the XML of
+// XML parts and the bytes of the others.
+func Compare(a, b *docx.Document, opts *Options) []Difference {
+	df := &differ{opts: opts, hashes: map[*etree.Element]uint64{}}
+	pa, pb := a.Part().Package(), b.Part().Package()
+
+	df.startPart("[package]")
+	df.rels(pa.Rels(), pb.Rels())
+	df.endPart()
+
+	byName := map[opc.PackURI]opc.Part{}
+	for _, p := range pb.Parts() {
+		byName[p.PartName()] = p
+	}
+	for _, p := range pa.Parts() {
+		q, ok := byName[p.PartName()]
+		if !ok {
+			df.diffs = append(df.diffs, Difference{Part: string(p.PartName()), Message: "part removed"})
+			continue
+		}
+		delete(byName, p.PartName())
+		df.startPart(string(p.PartName()))
+		df.parts(p, q)
+		df.endPart()
+	}
+	added := make([]string, 0, len(byName))
+	for name := range byName {
+		added = append(added, string(name))
+	}
+	sort.Strings(added)
+	for _, name := range added {
+		df.diffs = append(df.diffs, Difference{Part: name, Message: "part added"})
+	}
+	return df.diffs
+}
+
+func (df *differ) startPart(name string) {
+	df.part, df.count = name, 0
+}
+
+// endPart sums up the differences of the part beyond the limit.
+func (df *differ) endPart() {
+	if limit := df.opts.MaxPerPart; limit > 0 && df.count > limit {
+		df.diffs = append(df.diffs, Difference{Part: df.part, Message: fmt.Sprintf("... and %d more differences", df.count-limit)})
+	}
+}
+
+func (df *differ) report(path, format string, args ...any) {
+	df.count++
+	if limit := df.opts.MaxPerPart; limit > 0 && df.count > limit {
+		return
+	}
+	df.diffs = append(df.diffs, Difference{Part: df.part, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// parts compares two parts of the same name.
+func (df *differ) parts(a, b opc.Part) {
+	if a.ContentType() != b.ContentType() {
+		df.report("", "content type %q != %q", a.ContentType(), b.ContentType())
+	}
+	if ta, tb := fmt.Sprintf("%T", a), fmt.Sprintf("%T", b); ta != tb {
+		df.report("", "part loaded as %s != %s", ta, tb)
+	}
+	df.rels(a.Rels(), b.Rels())
+
+	type xmlPart interface{ Element() *etree.Element }
+	xa, aok := a.(xmlPart)
+	xb, bok := b.(xmlPart)
+	if aok && bok && xa.Element() != nil && xb.Element() != nil {
+		ea, eb := xa.Element(), xb.Element()
+		df.element(ea, eb, "/"+ea.FullTag())
+		return
+	}
+	ba, err := a.Blob()
+	if err != nil {
+		df.report("", "reading original: %v", err)
+		return
+	}
+	bb, err := b.Blob()
+	if err != nil {
+		df.report("", "reading revised: %v", err)
+		return
+	}
+	if !bytes.Equal(ba, bb) {
+		df.report("", "content differs (%d bytes != %d bytes)", len(ba), len(bb))
+	}
+}
+
+// rels compares relationships by rId.
+func (df *differ) rels(a, b *opc.Relationships) {
+	if a == nil || b == nil {
+		if (a == nil) != (b == nil) {
+			df.report("[rels]", "relationships present in only one package")
+		}
+		return
+	}
+	target := func(r *opc.Relationship) string {
+		if r.IsExternal || r.TargetPart == nil {
+			return r.TargetRef + " (external)"
+		}
+		return string(r.TargetPart.PartName())
+	}
+	for _, ra := range a.All() {
+		rb := b.GetByRID(ra.RID)
+		switch {
+		case rb == nil:
+			df.report("[rels]", "%s to %s removed", ra.RID, target(ra))
+		case ra.RelType != rb.RelType:
+			df.report("[rels]", "%s type %s != %s", ra.RID, path.Base(ra.RelType), path.Base(rb.RelType))
+		case target(ra) != target(rb):
+			df.report("[rels]", "%s target %s != %s", ra.RID, target(ra), target(rb))
+		}
+	}
+	for _, rb := range b.All() {
+		if a.GetByRID(rb.RID) == nil {
+			df.report("[rels]", "%s to %s added", rb.RID, target(rb))
+		}
+	}
+}
+
+// element compares two elements at path p.
+func (df *differ) element(a, b *etree.Element, p string) {
+	if df.hash(a) == df.hash(b) {
+		return
+	}
+	if key(a) != key(b) {
+		df.report(p, "element %s != %s", a.FullTag(), b.FullTag())
+		return
+	}
+	df.attrs(a, b, p)
+	if ta, tb := text(a), text(b); ta != tb {
+		df.report(p, "text %q != %q", ta, tb)
+	}
+	df.children(df.childElements(a), df.childElements(b), p)
+}
+
+func (df *differ) attrs(a, b *etree.Element, p string) {
+	av, bv := df.attrMap(a), df.attrMap(b)
+	names := make([]string, 0, len(av)+len(bv))
+	for name := range av {
+		names = append(names, name)
+	}
+	for name := range bv {
+		if _, ok := av[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		va, aok := av[name]
+		vb, bok := bv[name]
+		switch {
+		case !bok:
+			df.report(p, "attribute %s=%q removed", name, va)
+		case !aok:
+			df.report(p, "attribute %s=%q added", name, vb)
+		case va != vb:
+			df.report(p, "attribute %s %q != %q", name, va, vb)
+		}
+	}
+}
+
+// children compares child element lists. Children equal on both sides at
+// the start and end are skipped, so that an inserted or removed element
+// is reported once instead of as a difference of every sibling after it.
+func (df *differ) children(a, b []*etree.Element, p string) {
+	for len(a) > 0 && len(b) > 0 && df.hash(a[0]) == df.hash(b[0]) {
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && df.hash(a[len(a)-1]) == df.hash(b[len(b)-1]) {
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+	if len(a) == len(b) || len(a)*len(b) > maxAlign {
+		n := min(len(a), len(b))
+		for i := 0; i < n; i++ {
+			df.element(a[i], b[i], p+"/"+step(a[i]))
+		}
+		for _, el := range a[n:] {
+			df.report(p+"/"+step(el), "element removed")
+		}
+		for _, el := range b[n:] {
+			df.report(p+"/"+step(el), "element added")
+		}
+		return
+	}
+	// Pair the children with the same name and text, which are the same
+	// content with other changes, and report the rest as removed or added.
+	i, j := 0, 0
+	for _, m := range align(looseKeys(a), looseKeys(b)) {
+		for ; i < m[0]; i++ {
+			df.report(p+"/"+step(a[i]), "element removed")
+		}
+		for ; j < m[1]; j++ {
+			df.report(p+"/"+step(b[j]), "element added")
+		}
+		df.element(a[i], b[j], p+"/"+step(a[i]))
+		i, j = i+1, j+1
+	}
+	for ; i < len(a); i++ {
+		df.report(p+"/"+step(a[i]), "element removed")
+	}
+	for ; j < len(b); j++ {
+		df.report(p+"/"+step(b[j]), "element added")
+	}
+}
+
+// maxAlign bounds the size of the table align fills.
+const maxAlign = 1 << 20
+
+// looseKeys returns for each element its name and the text it holds,
+// which survive changes of formatting.
+func looseKeys(els []*etree.Element) []string {
+	keys := make([]string, len(els))
+	for i, el := range els {
+		var sb strings.Builder
+		sb.WriteString(key(el))
+		var walk func(*etree.Element)
+		walk = func(e *etree.Element) {
+			sb.WriteString(text(e))
+			for _, c := range e.ChildElements() {
+				walk(c)
+			}
+		}
+		walk(el)
+		keys[i] = sb.String()
+	}
+	return keys
+}
+
+// align returns the index pairs of a longest common subsequence of a and
+// b.
+func align(a, b []string) [][2]int {
+	// lcs[i][j] is the length of the LCS of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var pairs [][2]int
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			pairs = append(pairs, [2]int{i, j})
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return pairs
+}
+
+// step returns the path step of el: its name and its 1-based position
+// among the siblings of the same name.
+func step(el *etree.Element) string {
+	n := 1
+	if parent := el.Parent(); parent != nil {
+		for _, sib := range parent.ChildElements() {
+			if sib == el {
+				break
+			}
+			if sib.Space == el.Space && sib.Tag == el.Tag {
+				n++
+			}
+		}
+	}
+	return el.FullTag() + "[" + strconv.Itoa(n) + "]"
+}
+
+// key returns the namespace-qualified name of el, which does not depend on
+// the prefix used.
+func key(el *etree.Element) string {
+	return "{" + el.NamespaceURI() + "}" + el.Tag
+}
+
+// text returns the character data directly inside el. White space around
+// the children of an element holding elements is formatting and dropped.
+func text(el *etree.Element) string {
+	var sb strings.Builder
+	for _, tok := range el.Child {
+		if cd, ok := tok.(*etree.CharData); ok {
+			sb.WriteString(cd.Data)
+		}
+	}
+	if len(el.ChildElements()) > 0 {
+		return strings.TrimSpace(sb.String())
+	}
+	return sb.String()
+}
+
+func (df *differ) childElements(el *etree.Element) []*etree.Element {
+	var out []*etree.Element
+	for _, c := range el.ChildElements() {
+		if !matchAny(df.opts.IgnoreElems, c.FullTag()) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// attrMap returns the attributes of el that are compared, by qualified
+// name. Namespace declarations are not compared.
+func (df *differ) attrMap(el *etree.Element) map[string]string {
+	m := make(map[string]string, len(el.Attr))
+	for _, a := range el.Attr {
+		if a.Space == "xmlns" || (a.Space == "" && a.Key == "xmlns") {
+			continue
+		}
+		if matchAny(df.opts.IgnoreAttrs, a.FullKey()) {
+			continue
+		}
+		m[a.FullKey()] = a.Value
+	}
+	return m
+}
+
+// hash returns a hash of the compared content of el.
+func (df *differ) hash(el *etree.Element) uint64 {
+	if h, ok := df.hashes[el]; ok {
+		return h
+	}
+	h := fnv.New64a()
+	h.Write([]byte(key(el)))
+	attrs := df.attrMap(el)
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(h, "\x00%s=%s", name, attrs[name])
+	}
+	fmt.Fprintf(h, "\x01%s", text(el))
+	for _, c := range df.childElements(el) {
+		fmt.Fprintf(h, "\x02%x", df.hash(c))
+	}
+	sum := h.Sum64()
+	df.hashes[el] = sum
+	return sum
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
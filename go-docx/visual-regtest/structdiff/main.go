@@ -0,0 +1,136 @@
+// structdiff compares .docx files structurally: part by part, as loaded by
+// the docx layer, ignoring attributes and elements that change on every
+// save such as rsids. It reports semantic differences (parts, content
+// types, relationships, elements, attributes and text) so that roundtrip
+// and generator regressions are caught without looking at renderings.
+//
+// Compare two files:
+//
+//	go run ./visual-regtest/structdiff original.docx roundtrip.docx
+//
+// Compare every .docx of --original with the file of the same name in
+// --roundtrip, writing a JSON report:
+//
+//	go run ./visual-regtest/structdiff --original DIR --roundtrip DIR --report structdiff.json
+//
+// Exit code 0 = no differences, 1 = differences found, 2 = error.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/vortex/go-docx/pkg/docx"
+)
+
+// FileResult captures the comparison of one pair of files.
+type FileResult struct {
+	Name        string       `json:"name"`
+	OK          bool         `json:"ok"`
+	Error       string       `json:"error,omitempty"`
+	Differences []Difference `json:"differences,omitempty"`
+}
+
+func main() {
+	origDir := flag.String("original", "", "directory containing original .docx files")
+	rtDir := flag.String("roundtrip", "", "directory containing the files to compare them with")
+	report := flag.String("report", "", "write a JSON report of all files to this path")
+	ignoreAttrs := flag.String("ignore-attrs", strings.Join(DefaultIgnoreAttrs, ","), "comma-separated attribute name patterns to ignore")
+	ignoreElems := flag.String("ignore-elems", strings.Join(DefaultIgnoreElems, ","), "comma-separated element name patterns to ignore")
+	maxPerPart := flag.Int("max", 20, "maximum differences listed per part; 0 for all")
+	flag.Parse()
+
+	opts := &Options{
+		IgnoreAttrs: splitList(*ignoreAttrs),
+		IgnoreElems: splitList(*ignoreElems),
+		MaxPerPart:  *maxPerPart,
+	}
+
+	var pairs [][2]string
+	switch {
+	case *origDir != "" && *rtDir != "":
+		entries, err := os.ReadDir(*origDir)
+		if err != nil {
+			log.Printf("reading original dir: %v", err)
+			os.Exit(2)
+		}
+		for _, e := range entries {
+			if !e.IsDir() && strings.HasSuffix(strings.ToLower(e.Name()), ".docx") {
+				pairs = append(pairs, [2]string{filepath.Join(*origDir, e.Name()), filepath.Join(*rtDir, e.Name())})
+			}
+		}
+	case flag.NArg() == 2:
+		pairs = append(pairs, [2]string{flag.Arg(0), flag.Arg(1)})
+	default:
+		fmt.Fprintf(os.Stderr, "Usage: structdiff [flags] <original.docx> <revised.docx>\n")
+		fmt.Fprintf(os.Stderr, "       structdiff [flags] --original <dir> --roundtrip <dir>\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	var results []FileResult
+	failed, errored := 0, 0
+	for _, pair := range pairs {
+		r := compareFiles(pair[0], pair[1], opts)
+		results = append(results, r)
+		switch {
+		case r.Error != "":
+			errored++
+			fmt.Printf("ERROR %s: %s\n", r.Name, r.Error)
+		case !r.OK:
+			failed++
+			fmt.Printf("DIFF  %s: %d differences\n", r.Name, len(r.Differences))
+			for _, d := range r.Differences {
+				fmt.Printf("  %s\n", d)
+			}
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+
+	if *report != "" {
+		data, _ := json.MarshalIndent(results, "", "  ")
+		if err := os.WriteFile(*report, data, 0o644); err != nil {
+			log.Printf("writing report: %v", err)
+			os.Exit(2)
+		}
+	}
+
+	log.Printf("done: %d/%d identical, %d with differences, %d errors",
+		len(results)-failed-errored, len(results), failed, errored)
+	switch {
+	case errored > 0:
+		os.Exit(2)
+	case failed > 0:
+		os.Exit(1)
+	}
+}
+
+func compareFiles(origPath, rtPath string, opts *Options) FileResult {
+	name := filepath.Base(origPath)
+	a, err := docx.OpenFile(origPath)
+	if err != nil {
+		return FileResult{Name: name, Error: fmt.Sprintf("open original: %v", err)}
+	}
+	b, err := docx.OpenFile(rtPath)
+	if err != nil {
+		return FileResult{Name: name, Error: fmt.Sprintf("open revised: %v", err)}
+	}
+	diffs := Compare(a, b, opts)
+	return FileResult{Name: name, OK: len(diffs) == 0, Differences: diffs}
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			out = append(out, f)
+		}
+	}
+	return out
+}
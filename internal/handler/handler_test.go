@@ -2,6 +2,7 @@ package handler_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"mime/multipart"
@@ -18,6 +19,7 @@ type mockService struct {
 	openFn     func([]byte) (*service.DocumentInfo, error)
 	roundTrip  func([]byte) ([]byte, error)
 	validateFn func([]byte) (*service.ValidationResult, error)
+	mergeFn    func([][]byte, service.MergeOptions) ([]byte, error)
 }
 
 func (m *mockService) Open(data []byte) (*service.DocumentInfo, error) {
@@ -46,6 +48,13 @@ func (m *mockService) Validate(data []byte) (*service.ValidationResult, error) {
 	}, nil
 }
 
+func (m *mockService) Merge(_ context.Context, files [][]byte, opts service.MergeOptions) ([]byte, error) {
+	if m.mergeFn != nil {
+		return m.mergeFn(files, opts)
+	}
+	return bytes.Join(files, nil), nil
+}
+
 func newMultipartRequest(t *testing.T, url string, fileData []byte) *http.Request {
 	t.Helper()
 	var buf bytes.Buffer
@@ -176,3 +185,80 @@ func TestValidateHandler_Success(t *testing.T) {
 		t.Error("expected success=true")
 	}
 }
+
+func newMergeRequest(t *testing.T, fields map[string]string, files ...[]byte) *http.Request {
+	t.Helper()
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for _, data := range files {
+		fw, err := w.CreateFormFile("files", "part.docx")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for k, v := range fields {
+		if err := w.WriteField(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/documents/merge", &buf)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return req
+}
+
+func TestMergeHandler_PassesFilesAndOptions(t *testing.T) {
+	t.Parallel()
+	var gotFiles [][]byte
+	var gotOpts service.MergeOptions
+	svc := &mockService{
+		mergeFn: func(files [][]byte, opts service.MergeOptions) ([]byte, error) {
+			gotFiles, gotOpts = files, opts
+			return []byte("merged"), nil
+		},
+	}
+	h := handler.NewPackagingHandler(svc)
+
+	req := newMergeRequest(t, map[string]string{
+		"section_break":           "odd_page",
+		"inherit_headers_footers": "true",
+	}, []byte("a"), []byte("b"))
+	rec := httptest.NewRecorder()
+
+	h.Merge(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if len(gotFiles) != 2 || string(gotFiles[0]) != "a" || string(gotFiles[1]) != "b" {
+		t.Errorf("unexpected files %q", gotFiles)
+	}
+	if gotOpts.SectionBreak != service.SectionBreakOddPage || !gotOpts.InheritHeadersFooters {
+		t.Errorf("unexpected options %+v", gotOpts)
+	}
+	if body, _ := io.ReadAll(rec.Body); string(body) != "merged" {
+		t.Errorf("unexpected body %q", body)
+	}
+}
+
+func TestMergeHandler_BadRequest(t *testing.T) {
+	t.Parallel()
+	h := handler.NewPackagingHandler(&mockService{})
+
+	cases := map[string]*http.Request{
+		"no files":      newMergeRequest(t, nil),
+		"section break": newMergeRequest(t, map[string]string{"section_break": "sideways"}, []byte("a")),
+		"inherit flag":  newMergeRequest(t, map[string]string{"inherit_headers_footers": "maybe"}, []byte("a")),
+	}
+	for name, req := range cases {
+		rec := httptest.NewRecorder()
+		h.Merge(rec, req)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", name, rec.Code)
+		}
+	}
+}
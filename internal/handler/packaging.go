@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/vortex/docx-api/internal/service"
 	"github.com/vortex/docx-api/pkg/response"
@@ -85,6 +88,47 @@ func (h *PackagingHandler) Validate(w http.ResponseWriter, r *http.Request) {
 	response.JSON(w, http.StatusOK, result)
 }
 
+// Merge handles POST /api/v1/documents/merge
+// Accepts a multipart form with one or more "files" fields, merged in the
+// order given, and the optional fields "section_break" (new_page,
+// even_page, odd_page, continuous or none; default new_page) and
+// "inherit_headers_footers" (true to apply the first document's headers
+// and footers throughout). Returns the merged .docx file.
+func (h *PackagingHandler) Merge(w http.ResponseWriter, r *http.Request) {
+	files, err := readUploadedFiles(r, "files")
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	opts := service.MergeOptions{SectionBreak: service.SectionBreak(r.FormValue("section_break"))}
+	if !opts.SectionBreak.Valid() {
+		response.Error(w, http.StatusBadRequest, fmt.Sprintf("unknown section_break %q", opts.SectionBreak))
+		return
+	}
+	if v := r.FormValue("inherit_headers_footers"); v != "" {
+		if opts.InheritHeadersFooters, err = strconv.ParseBool(v); err != nil {
+			response.Error(w, http.StatusBadRequest, fmt.Sprintf("invalid inherit_headers_footers %q", v))
+			return
+		}
+	}
+
+	output, err := h.svc.Merge(r.Context(), files, opts)
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, service.ErrNoFiles) {
+			status = http.StatusBadRequest
+		}
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.wordprocessingml.document")
+	w.Header().Set("Content-Disposition", `attachment; filename="merged.docx"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(output)
+}
+
 // readUploadedFile extracts the file bytes from a multipart upload.
 // It looks for a form field named "file".
 func readUploadedFile(r *http.Request) ([]byte, error) {
@@ -100,3 +144,30 @@ func readUploadedFile(r *http.Request) ([]byte, error) {
 
 	return io.ReadAll(file)
 }
+
+// readUploadedFiles extracts the bytes of every file uploaded under the
+// form field name, in order.
+func readUploadedFiles(r *http.Request, name string) ([][]byte, error) {
+	if err := r.ParseMultipartForm(100 << 20); err != nil { // 100 MB max
+		return nil, err
+	}
+	headers := r.MultipartForm.File[name]
+	if len(headers) == 0 {
+		return nil, fmt.Errorf("no files uploaded in field %q", name)
+	}
+
+	files := make([][]byte, 0, len(headers))
+	for _, fh := range headers {
+		f, err := fh.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, data)
+	}
+	return files, nil
+}
@@ -23,6 +23,9 @@ func NewRouter(logger *slog.Logger, svc service.PackagingService, maxBodyBytes i
 	mux.HandleFunc("POST /api/v1/documents/roundtrip", pkg.RoundTrip)
 	mux.HandleFunc("POST /api/v1/documents/validate", pkg.Validate)
 
+	// Document operations
+	mux.HandleFunc("POST /api/v1/documents/merge", pkg.Merge)
+
 	// Apply middleware chain (outermost first)
 	var h http.Handler = mux
 	h = middleware.MaxBodySize(maxBodyBytes)(h)
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/vortex/go-docx/pkg/docx"
+	"github.com/vortex/go-docx/pkg/docx/enum"
+	"github.com/vortex/go-docx/pkg/docx/opc"
+)
+
+// SectionBreak selects how each merged document starts.
+type SectionBreak string
+
+// Section break kinds accepted by Merge.
+const (
+	SectionBreakNewPage    SectionBreak = "new_page"
+	SectionBreakEvenPage   SectionBreak = "even_page"
+	SectionBreakOddPage    SectionBreak = "odd_page"
+	SectionBreakContinuous SectionBreak = "continuous"
+	// SectionBreakNone continues the previous document's last section.
+	SectionBreakNone SectionBreak = "none"
+)
+
+// Valid reports whether b is a known section break kind; the empty value
+// stands for SectionBreakNewPage.
+func (b SectionBreak) Valid() bool {
+	switch b {
+	case "", SectionBreakNewPage, SectionBreakEvenPage, SectionBreakOddPage,
+		SectionBreakContinuous, SectionBreakNone:
+		return true
+	}
+	return false
+}
+
+// MergeOptions configures Merge.
+type MergeOptions struct {
+	// SectionBreak separates the merged documents; defaults to
+	// SectionBreakNewPage.
+	SectionBreak SectionBreak
+
+	// InheritHeadersFooters drops the headers and footers of every document
+	// but the first, so the first document's apply throughout.
+	InheritHeadersFooters bool
+}
+
+// ErrNoFiles is returned by Merge when given no documents.
+var ErrNoFiles = errors.New("service: no documents to merge")
+
+// Resource limits for opening uploaded documents. Uploads are capped at
+// tens of megabytes compressed; these bound what they may decompress to.
+const (
+	maxDocumentSize  = 512 << 20 // all parts, decompressed
+	maxPartSize      = 128 << 20 // each part, decompressed
+	maxDocumentParts = 10000
+	maxXMLDepth      = 512
+)
+
+// openDocument opens an uploaded document with the resource limits above,
+// stopping once ctx is done.
+func openDocument(ctx context.Context, data []byte) (*docx.Document, error) {
+	return docx.OpenBytesWithOptions(data, &opc.OpenOptions{
+		Context:      ctx,
+		MaxTotalSize: maxDocumentSize,
+		MaxPartSize:  maxPartSize,
+		MaxParts:     maxDocumentParts,
+		MaxXMLDepth:  maxXMLDepth,
+	})
+}
+
+func (s *packagingService) Merge(ctx context.Context, files [][]byte, opts MergeOptions) ([]byte, error) {
+	if len(files) == 0 {
+		return nil, ErrNoFiles
+	}
+	if !opts.SectionBreak.Valid() {
+		return nil, fmt.Errorf("service: unknown section break %q", opts.SectionBreak)
+	}
+	appendOpts := &docx.AppendOptions{
+		NoSectionBreak:        opts.SectionBreak == SectionBreakNone,
+		InheritHeadersFooters: opts.InheritHeadersFooters,
+	}
+	var start enum.WdSectionStart
+	switch opts.SectionBreak {
+	case SectionBreakEvenPage:
+		start = enum.WdSectionStartEvenPage
+	case SectionBreakOddPage:
+		start = enum.WdSectionStartOddPage
+	case SectionBreakContinuous:
+		start = enum.WdSectionStartContinuous
+	default:
+		start = enum.WdSectionStartNewPage
+	}
+	appendOpts.SectionStart = &start
+
+	out, err := openDocument(ctx, files[0])
+	if err != nil {
+		return nil, fmt.Errorf("service: open document 1: %w", err)
+	}
+	for i, data := range files[1:] {
+		src, err := openDocument(ctx, data)
+		if err != nil {
+			return nil, fmt.Errorf("service: open document %d: %w", i+2, err)
+		}
+		if err := out.AppendDocument(src, appendOpts); err != nil {
+			return nil, fmt.Errorf("service: append document %d: %w", i+2, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := out.SaveContext(ctx, &buf); err != nil {
+		return nil, fmt.Errorf("service: save document: %w", err)
+	}
+	return buf.Bytes(), nil
+}
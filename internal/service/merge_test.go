@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vortex/docx-api/internal/service"
+	"github.com/vortex/go-docx/pkg/docx"
+)
+
+// newDocx builds a .docx with one paragraph per text.
+func newDocx(t *testing.T, texts ...string) []byte {
+	t.Helper()
+	doc, err := docx.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, text := range texts {
+		if _, err := doc.AddParagraph(text); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var buf bytes.Buffer
+	if err := doc.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestMerge_ConcatenatesInOrder(t *testing.T) {
+	t.Parallel()
+	svc := service.NewPackagingService()
+
+	out, err := svc.Merge(context.Background(), [][]byte{newDocx(t, "one"), newDocx(t, "two"), newDocx(t, "three")}, service.MergeOptions{})
+	if err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	doc, err := docx.OpenBytes(out)
+	if err != nil {
+		t.Fatalf("opening merged document: %v", err)
+	}
+	if got := doc.Sections().Len(); got != 3 {
+		t.Errorf("expected 3 sections, got %d", got)
+	}
+	paras, err := doc.Paragraphs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var texts []string
+	for _, p := range paras {
+		if text := p.Text(); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	if len(texts) != 3 || texts[0] != "one" || texts[1] != "two" || texts[2] != "three" {
+		t.Errorf("unexpected merged text %q", texts)
+	}
+}
+
+func TestMerge_NoSectionBreak(t *testing.T) {
+	t.Parallel()
+	svc := service.NewPackagingService()
+
+	out, err := svc.Merge(context.Background(), [][]byte{newDocx(t, "one"), newDocx(t, "two")}, service.MergeOptions{
+		SectionBreak: service.SectionBreakNone,
+	})
+	if err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	doc, err := docx.OpenBytes(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := doc.Sections().Len(); got != 1 {
+		t.Errorf("expected 1 section, got %d", got)
+	}
+}
+
+func TestMerge_Errors(t *testing.T) {
+	t.Parallel()
+	svc := service.NewPackagingService()
+
+	if _, err := svc.Merge(context.Background(), nil, service.MergeOptions{}); !errors.Is(err, service.ErrNoFiles) {
+		t.Errorf("expected ErrNoFiles, got %v", err)
+	}
+	if _, err := svc.Merge(context.Background(), [][]byte{newDocx(t)}, service.MergeOptions{SectionBreak: "sideways"}); err == nil {
+		t.Error("expected error for unknown section break")
+	}
+	if _, err := svc.Merge(context.Background(), [][]byte{newDocx(t), []byte("not a zip")}, service.MergeOptions{}); err == nil {
+		t.Error("expected error for invalid data")
+	}
+}
+
+func TestMerge_Canceled(t *testing.T) {
+	t.Parallel()
+	svc := service.NewPackagingService()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := svc.Merge(ctx, [][]byte{newDocx(t, "one"), newDocx(t, "two")}, service.MergeOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
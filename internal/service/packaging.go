@@ -2,6 +2,7 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 
@@ -43,6 +44,10 @@ type PackagingService interface {
 	// Validate opens a .docx, saves it, and returns both metadata and
 	// a comparison summary (original size vs output size).
 	Validate(data []byte) (*ValidationResult, error)
+
+	// Merge concatenates the given .docx files, in order, into a single
+	// document and returns it. It stops with ctx's error once ctx is done.
+	Merge(ctx context.Context, files [][]byte, opts MergeOptions) ([]byte, error)
 }
 
 // ValidationResult holds the result of a validate operation.